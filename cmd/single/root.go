@@ -13,7 +13,7 @@ import (
 
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/config/viper"
-	_ "github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 var (
@@ -65,8 +65,9 @@ func init() {
 
 func initConfig(flags *pflag.FlagSet) error {
 	configAccessor = viper.NewAccessor(config.Options{
-		SearchPaths: []string{cfgFile, ".", "/etc/flyte/config", "$GOPATH/src/github.com/flyteorg/flyte"},
-		StrictMode:  false,
+		SearchPaths:  []string{cfgFile, ".", "/etc/flyte/config", "$GOPATH/src/github.com/flyteorg/flyte"},
+		StrictMode:   false,
+		MetricsScope: promutils.NewScope("flyte:single"),
 	})
 
 	logger.Infof(context.TODO(), "Using config file: %v", configAccessor.ConfigFilesUsed())
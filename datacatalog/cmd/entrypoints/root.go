@@ -12,6 +12,7 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/config/viper"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 var (
@@ -66,8 +67,9 @@ To get started run the serve subcommand which will start a server on localhost:8
 
 func initConfig(flags *pflag.FlagSet) error {
 	configAccessor = viper.NewAccessor(config.Options{
-		SearchPaths: []string{cfgFile, ".", "/etc/flyte/config", "$GOPATH/src/github.com/flyteorg/datacatalog"},
-		StrictMode:  false,
+		SearchPaths:  []string{cfgFile, ".", "/etc/flyte/config", "$GOPATH/src/github.com/flyteorg/datacatalog"},
+		StrictMode:   false,
+		MetricsScope: promutils.NewScope("flyte:datacatalog"),
 	})
 
 	logger.Infof(context.TODO(), "Using config file: %v", configAccessor.ConfigFilesUsed())
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"golang.org/x/oauth2"
@@ -255,13 +256,23 @@ func GetCallbackHandler(ctx context.Context, authCtx interfaces.AuthenticationCo
 func AuthenticationLoggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	// Invoke 'handler' to use your gRPC server implementation and get
 	// the response.
+	logAuthenticatedMethod(ctx, info.FullMethod)
+	return handler(ctx, req)
+}
+
+// AuthenticationLoggingStreamInterceptor is the streaming counterpart of AuthenticationLoggingInterceptor.
+func AuthenticationLoggingStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	logAuthenticatedMethod(stream.Context(), info.FullMethod)
+	return handler(srv, stream)
+}
+
+func logAuthenticatedMethod(ctx context.Context, method string) {
 	identityContext := IdentityContextFromContext(ctx)
 	var emailPlaceholder string
 	if len(identityContext.UserInfo().GetEmail()) > 0 {
 		emailPlaceholder = fmt.Sprintf(" (%s) ", identityContext.UserInfo().GetEmail())
 	}
-	logger.Debugf(ctx, "gRPC server info in logging interceptor [%s]%smethod [%s]\n", identityContext.UserID(), emailPlaceholder, info.FullMethod)
-	return handler(ctx, req)
+	logger.Debugf(ctx, "gRPC server info in logging interceptor [%s]%smethod [%s]\n", identityContext.UserID(), emailPlaceholder, method)
 }
 
 // GetAuthenticationCustomMetadataInterceptor produces a gRPC middleware interceptor intended to be used when running
@@ -271,24 +282,38 @@ func AuthenticationLoggingInterceptor(ctx context.Context, req interface{}, info
 // string, which the downstream auth/auditing interceptors will detect and validate.
 func GetAuthenticationCustomMetadataInterceptor(authCtx interfaces.AuthenticationContext) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if authCtx.Options().GrpcAuthorizationHeader != DefaultAuthorizationHeader {
-			md, ok := metadata.FromIncomingContext(ctx)
-			if ok {
-				grpcAuthzHeader := authCtx.Options().GrpcAuthorizationHeader
-				existingHeader := md.Get(grpcAuthzHeader)
-				if len(existingHeader) > 0 {
-					logger.Debugf(ctx, "Found existing metadata header %s", grpcAuthzHeader)
-					newAuthorizationMetadata := metadata.Pairs(DefaultAuthorizationHeader, existingHeader[0])
-					joinedMetadata := metadata.Join(md, newAuthorizationMetadata)
-					newCtx := metadata.NewIncomingContext(ctx, joinedMetadata)
-					return handler(newCtx, req)
-				}
-			} else {
-				logger.Debugf(ctx, "Could not extract incoming metadata from context, continuing with original ctx...")
-			}
-		}
-		return handler(ctx, req)
+		return handler(withDefaultAuthorizationHeader(ctx, authCtx), req)
+	}
+}
+
+// GetAuthenticationCustomMetadataStreamInterceptor is the streaming counterpart of
+// GetAuthenticationCustomMetadataInterceptor.
+func GetAuthenticationCustomMetadataStreamInterceptor(authCtx interfaces.AuthenticationContext) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcmiddleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withDefaultAuthorizationHeader(stream.Context(), authCtx)
+		return handler(srv, wrapped)
+	}
+}
+
+func withDefaultAuthorizationHeader(ctx context.Context, authCtx interfaces.AuthenticationContext) context.Context {
+	if authCtx.Options().GrpcAuthorizationHeader == DefaultAuthorizationHeader {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		logger.Debugf(ctx, "Could not extract incoming metadata from context, continuing with original ctx...")
+		return ctx
+	}
+	grpcAuthzHeader := authCtx.Options().GrpcAuthorizationHeader
+	existingHeader := md.Get(grpcAuthzHeader)
+	if len(existingHeader) == 0 {
+		return ctx
 	}
+	logger.Debugf(ctx, "Found existing metadata header %s", grpcAuthzHeader)
+	newAuthorizationMetadata := metadata.Pairs(DefaultAuthorizationHeader, existingHeader[0])
+	joinedMetadata := metadata.Join(md, newAuthorizationMetadata)
+	return metadata.NewIncomingContext(ctx, joinedMetadata)
 }
 
 func SetContextForIdentity(ctx context.Context, identityContext interfaces.IdentityContext) context.Context {
@@ -12,10 +12,13 @@ import (
 	"testing"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/flyteorg/flyte/flyteadmin/auth/config"
@@ -533,3 +536,21 @@ func TestUserInfoForwardResponseHander(t *testing.T) {
 	assert.NoError(t, handler(ctx, w, &unrelatedResp))
 	assert.NotContains(t, w.Result().Header, "X-User-Subject")
 }
+
+func TestGetAuthenticationCustomMetadataStreamInterceptor(t *testing.T) {
+	mockAuthCtx := mocks.AuthenticationContext{}
+	mockAuthCtx.EXPECT().Options().Return(&config.Config{
+		GrpcAuthorizationHeader: "custom-header",
+	})
+	interceptor := GetAuthenticationCustomMetadataStreamInterceptor(&mockAuthCtx)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("custom-header", "Bearer a.b.c"))
+
+	err := interceptor(nil, &grpcmiddleware.WrappedServerStream{WrappedContext: ctx}, &grpc.StreamServerInfo{},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			md, ok := metadata.FromIncomingContext(stream.Context())
+			assert.True(t, ok)
+			assert.Equal(t, []string{"Bearer a.b.c"}, md.Get(DefaultAuthorizationHeader))
+			return nil
+		})
+	assert.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,17 +14,33 @@ import (
 func BlanketAuthorization(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
 	resp interface{}, err error) {
 
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// BlanketAuthorizationStream is the streaming counterpart of BlanketAuthorization.
+func BlanketAuthorizationStream(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authorize(stream.Context()); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// authorize fails if the authenticated user, if any, doesn't have all the scopes.
+func authorize(ctx context.Context) error {
 	identityContext := IdentityContextFromContext(ctx)
 	if identityContext.IsEmpty() {
-		return handler(ctx, req)
+		return nil
 	}
 
 	if !identityContext.Scopes().Has(ScopeAll) {
 		logger.Debugf(ctx, "authenticated user doesn't have required scope")
-		return nil, status.Errorf(codes.Unauthenticated, "authenticated user doesn't have required scope")
+		return status.Errorf(codes.Unauthenticated, "authenticated user doesn't have required scope")
 	}
 
-	return handler(ctx, req)
+	return nil
 }
 
 // ExecutionUserIdentifierInterceptor injects identityContext.UserID() to identityContext.executionIdentity
@@ -34,3 +51,13 @@ func ExecutionUserIdentifierInterceptor(ctx context.Context, req interface{}, _
 	ctx = identityContext.WithContext(ctx)
 	return handler(ctx, req)
 }
+
+// ExecutionUserIdentifierStreamInterceptor is the streaming counterpart of ExecutionUserIdentifierInterceptor.
+func ExecutionUserIdentifierStreamInterceptor(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	identityContext := IdentityContextFromContext(stream.Context())
+	identityContext = identityContext.WithExecutionUserIdentifier(identityContext.UserID())
+	wrapped := grpcmiddleware.WrapServerStream(stream)
+	wrapped.WrappedContext = identityContext.WithContext(stream.Context())
+	return handler(srv, wrapped)
+}
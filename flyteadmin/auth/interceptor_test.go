@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -60,6 +62,25 @@ func TestBlanketAuthorization(t *testing.T) {
 	})
 }
 
+func TestBlanketAuthorizationStream(t *testing.T) {
+	identityCtx := IdentityContext{
+		audience: "aud",
+		userID:   "uid",
+		appID:    "appid",
+	}
+	handlerCalled := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		return nil
+	}
+	ctx := context.WithValue(context.TODO(), ContextKeyIdentityContext, identityCtx)
+	err := BlanketAuthorizationStream(nil, &grpcmiddleware.WrappedServerStream{WrappedContext: ctx}, nil, handler)
+	asStatus, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, asStatus.Code(), codes.Unauthenticated)
+	assert.False(t, handlerCalled)
+}
+
 func TestGetUserIdentityFromContext(t *testing.T) {
 	identityContext := IdentityContext{
 		userID: "yeee",
@@ -77,3 +98,20 @@ func TestGetUserIdentityFromContext(t *testing.T) {
 	_, err := ExecutionUserIdentifierInterceptor(ctx, nil, nil, handler)
 	assert.NoError(t, err)
 }
+
+func TestExecutionUserIdentifierStreamInterceptor(t *testing.T) {
+	identityContext := IdentityContext{
+		userID: "yeee",
+	}
+
+	ctx := identityContext.WithContext(context.Background())
+
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		identityContext := IdentityContextFromContext(stream.Context())
+		assert.Equal(t, "yeee", identityContext.ExecutionIdentity())
+		return nil
+	}
+
+	err := ExecutionUserIdentifierStreamInterceptor(nil, &grpcmiddleware.WrappedServerStream{WrappedContext: ctx}, nil, handler)
+	assert.NoError(t, err)
+}
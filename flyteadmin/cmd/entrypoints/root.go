@@ -13,6 +13,7 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/config/viper"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 var (
@@ -75,8 +76,9 @@ func init() {
 
 func initConfig(flags *pflag.FlagSet) error {
 	configAccessor = viper.NewAccessor(config.Options{
-		SearchPaths: []string{cfgFile, ".", "/etc/flyte/config", "$GOPATH/src/github.com/flyteorg/flyte/flyteadmin"},
-		StrictMode:  false,
+		SearchPaths:  []string{cfgFile, ".", "/etc/flyte/config", "$GOPATH/src/github.com/flyteorg/flyte/flyteadmin"},
+		StrictMode:   false,
+		MetricsScope: promutils.NewScope("flyte:admin"),
 	})
 
 	logger.Infof(context.TODO(), "Using config file: %v", configAccessor.ConfigFilesUsed())
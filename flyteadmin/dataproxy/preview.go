@@ -0,0 +1,252 @@
+package dataproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/flyteorg/flyte/flyteadmin/dataproxy/tabular"
+	flyteAdminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+// errSchemaMismatch is returned when the files of a dataset don't hold the same columns.
+var errSchemaMismatch = errors.New("the files of the dataset have different schemas")
+
+const (
+	formatParquet = "parquet"
+	formatCSV     = "csv"
+	// maxPreviewFiles bounds the files listed under the uri of a dataset stored as multiple files.
+	maxPreviewFiles = 1000
+)
+
+// PreviewStructuredDataset reads the first rows and the column schema of a structured dataset or a blob, stored as
+// parquet or CSV, addressed by a flyte url.
+func (s Service) PreviewStructuredDataset(ctx context.Context, req *service.PreviewStructuredDatasetRequest) (
+	*service.PreviewStructuredDatasetResponse, error) {
+	data, err := s.GetData(ctx, &service.GetDataRequest{FlyteUrl: req.GetFlyteUrl()})
+	if err != nil {
+		return nil, err
+	}
+	literal := data.GetLiteral()
+	if literal == nil {
+		return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"flyte url [%v] must address a single input or output", req.GetFlyteUrl())
+	}
+	uri, format, declared, err := getDatasetLocation(literal)
+	if err != nil {
+		return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "cannot preview [%v]. Error: %v",
+			req.GetFlyteUrl(), err)
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > s.cfg.Preview.MaxRows {
+		limit = s.cfg.Preview.MaxRows
+	}
+	files, err := s.getDatasetFiles(ctx, storage.DataReference(uri))
+	if err != nil {
+		return nil, err
+	}
+	table, err := s.readDataset(ctx, files, format, declared, tabular.Options{
+		Limit:       limit,
+		Columns:     req.GetColumns(),
+		MemoryLimit: s.cfg.Preview.MemoryLimit.Value(),
+	})
+	if err != nil {
+		return nil, getPreviewError(err)
+	}
+
+	rows := make([]*service.StructuredDatasetRow, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		rows = append(rows, &service.StructuredDatasetRow{Values: row})
+	}
+	return &service.PreviewStructuredDatasetResponse{
+		Schema: &core.StructuredDatasetType{
+			Columns: tabular.DatasetColumns(table.Columns),
+			Format:  format,
+		},
+		Rows:           rows,
+		Truncated:      table.Truncated,
+		OmittedColumns: table.Omitted,
+	}, nil
+}
+
+// getDatasetLocation returns the uri and the format of the structured dataset or blob held by the literal, along with
+// the columns declared by the type of the dataset.
+func getDatasetLocation(literal *core.Literal) (
+	uri, format string, declared []*core.StructuredDatasetType_DatasetColumn, err error) {
+	if dataset := literal.GetScalar().GetStructuredDataset(); dataset != nil {
+		datasetType := dataset.GetMetadata().GetStructuredDatasetType()
+		uri, format, declared = dataset.GetUri(), strings.ToLower(datasetType.GetFormat()), datasetType.GetColumns()
+		// Datasets of the generic format are written as parquet by default.
+		if len(format) == 0 {
+			format = formatParquet
+		}
+	} else if blob := literal.GetScalar().GetBlob(); blob != nil {
+		uri, format = blob.GetUri(), strings.ToLower(blob.GetMetadata().GetType().GetFormat())
+	} else {
+		return "", "", nil, fmt.Errorf("the literal is neither a structured dataset nor a blob")
+	}
+	if len(uri) == 0 {
+		return "", "", nil, fmt.Errorf("the literal holds no uri")
+	}
+	if format != formatParquet && format != formatCSV {
+		return "", "", nil, fmt.Errorf("unsupported format [%v], only parquet and csv can be previewed", format)
+	}
+	return uri, format, declared, nil
+}
+
+// getDatasetFiles returns the files of a dataset, which is either a single file or a directory of files. Files with
+// names starting with '_' or '.', which hold the markers and checksums of writers such as spark, are skipped.
+func (s Service) getDatasetFiles(ctx context.Context, uri storage.DataReference) ([]storage.DataReference, error) {
+	metadata, err := s.dataStore.Head(ctx, uri)
+	if err != nil {
+		return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.Internal, "failed to head [%v]. Error: %v", uri, err)
+	}
+	if metadata.Exists() {
+		return []storage.DataReference{uri}, nil
+	}
+
+	var files []storage.DataReference
+	cursor := storage.NewCursorAtStart()
+	for !storage.IsCursorEnd(cursor) && len(files) < maxPreviewFiles {
+		var items []storage.DataReference
+		items, cursor, err = s.dataStore.List(ctx, storage.DataReference(strings.TrimSuffix(uri.String(), "/")+"/"),
+			maxPreviewFiles, cursor)
+		if storage.IsNotFound(err) {
+			break
+		} else if err != nil {
+			return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.Internal, "failed to list [%v]. Error: %v", uri, err)
+		}
+		for _, item := range items {
+			if name := path.Base(item.String()); !strings.HasPrefix(name, "_") && !strings.HasPrefix(name, ".") {
+				files = append(files, item)
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "no data found at [%v]", uri)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i] < files[j]
+	})
+	return files, nil
+}
+
+// sameSchema returns whether two files of a dataset hold the same columns.
+func sameSchema(a, b *tabular.Table) bool {
+	if len(a.Columns) != len(b.Columns) || !slices.Equal(a.Omitted, b.Omitted) {
+		return false
+	}
+	for i := range a.Columns {
+		if a.Columns[i].Name != b.Columns[i].Name || !proto.Equal(a.Columns[i].Type, b.Columns[i].Type) {
+			return false
+		}
+	}
+	return true
+}
+
+// readDataset reads the first rows of the files of a dataset in order, up to the limit of the options. The files share
+// the memory limit of the options, such that the rows of later files are left out once earlier files have used it up.
+func (s Service) readDataset(ctx context.Context, files []storage.DataReference, format string,
+	declared []*core.StructuredDatasetType_DatasetColumn, opts tabular.Options) (*tabular.Table, error) {
+	var table *tabular.Table
+	for i, file := range files {
+		fileTable, err := s.readDatasetFile(ctx, file, format, declared, opts)
+		if table != nil && len(table.Rows) > 0 && errors.Is(err, tabular.ErrMemoryLimit) {
+			table.Truncated = true
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read [%v]. Error: %w", file, err)
+		}
+		if table == nil {
+			table = fileTable
+		} else {
+			if !sameSchema(table, fileTable) {
+				return nil, fmt.Errorf("%w: [%v] and [%v]", errSchemaMismatch, files[0], file)
+			}
+			table.Rows = append(table.Rows, fileTable.Rows...)
+			table.Truncated = fileTable.Truncated
+		}
+		opts.Limit -= len(fileTable.Rows)
+		opts.MemoryLimit -= fileTable.MemoryUsed
+		if fileTable.Truncated {
+			break
+		}
+		if opts.Limit <= 0 || opts.MemoryLimit <= 0 {
+			table.Truncated = i < len(files)-1
+			break
+		}
+	}
+	return table, nil
+}
+
+func (s Service) readDatasetFile(ctx context.Context, file storage.DataReference, format string,
+	declared []*core.StructuredDatasetType_DatasetColumn, opts tabular.Options) (*tabular.Table, error) {
+	if format == formatCSV {
+		reader, err := s.dataStore.ReadRaw(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return tabular.ReadCSV(reader, declared, opts)
+	}
+
+	// Parquet files are read in ranges, from their end where their metadata is stored.
+	metadata, err := s.dataStore.Head(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	return tabular.ReadParquet(storageReaderAt{ctx: ctx, dataStore: s.dataStore, reference: file}, metadata.Size(),
+		opts)
+}
+
+// storageReaderAt reads ranges of a file from storage, such that only the parts of a file a reader seeks to are
+// downloaded.
+type storageReaderAt struct {
+	ctx       context.Context
+	dataStore *storage.DataStore
+	reference storage.DataReference
+}
+
+func (r storageReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	reader, err := r.dataStore.ReadRawRange(r.ctx, r.reference, off, int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			logger.Warningf(r.ctx, "Failed to close range of [%v] with err: %v", r.reference, err)
+		}
+	}()
+	n, err := io.ReadFull(reader, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func getPreviewError(err error) error {
+	switch {
+	case errors.Is(err, tabular.ErrUnknownColumn):
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, tabular.ErrMemoryLimit):
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.ResourceExhausted, "%v", err)
+	case errors.Is(err, tabular.ErrUnsupported):
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.Unimplemented, "%v", err)
+	case errors.Is(err, errSchemaMismatch):
+		return flyteAdminErrors.NewFlyteAdminErrorf(codes.FailedPrecondition, "%v", err)
+	}
+	return flyteAdminErrors.NewFlyteAdminErrorf(codes.Internal, "failed to preview structured dataset. Error: %v", err)
+}
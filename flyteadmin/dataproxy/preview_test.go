@@ -0,0 +1,222 @@
+package dataproxy
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteadmin/dataproxy/tabular"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+func getPreviewLiteral(scalar *core.Scalar) *core.Literal {
+	return &core.Literal{Value: &core.Literal_Scalar{Scalar: scalar}}
+}
+
+type previewParquetRow struct {
+	ID   int64    `parquet:"id"`
+	Tags []string `parquet:"tags"`
+}
+
+func getPreviewService(t *testing.T) Service {
+	dataStore, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	ctx := context.Background()
+	for reference, content := range map[storage.DataReference]string{
+		"/datasets/csv/00000":    "id,name\n1,a\n2,b\n",
+		"/datasets/csv/00001":    "id,name\n3,c\n",
+		"/datasets/csv/_SUCCESS": "",
+		"/datasets/mixed/00000":  "id,name\n1,a\n",
+		"/datasets/mixed/00001":  "id,label\n2,b\n",
+		"/blobs/file.csv":        "x\n1\n",
+	} {
+		assert.NoError(t, dataStore.WriteRaw(ctx, reference, int64(len(content)), storage.Options{},
+			bytes.NewReader([]byte(content))))
+	}
+	var parquetFile bytes.Buffer
+	assert.NoError(t, parquet.Write(&parquetFile, []previewParquetRow{{ID: 1, Tags: []string{"a"}}, {ID: 2}}))
+	assert.NoError(t, dataStore.WriteRaw(ctx, "/datasets/parquet", int64(parquetFile.Len()), storage.Options{},
+		bytes.NewReader(parquetFile.Bytes())))
+
+	nodeExecutionManager := &mocks.NodeExecutionInterface{}
+	nodeExecutionManager.EXPECT().GetNodeExecutionData(mock.Anything, mock.Anything).Return(
+		&admin.NodeExecutionGetDataResponse{
+			FullOutputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+				"dataset": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_StructuredDataset{
+					StructuredDataset: &core.StructuredDataset{
+						Uri: "/datasets/csv",
+						Metadata: &core.StructuredDatasetMetadata{
+							StructuredDatasetType: &core.StructuredDatasetType{
+								Format: "csv",
+								Columns: []*core.StructuredDatasetType_DatasetColumn{
+									{Name: "id", LiteralType: &core.LiteralType{
+										Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER},
+									}},
+								},
+							},
+						},
+					},
+				}}),
+				"blob": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_Blob{Blob: &core.Blob{
+					Uri:      "/blobs/file.csv",
+					Metadata: &core.BlobMetadata{Type: &core.BlobType{Format: "CSV"}},
+				}}}),
+				"mixed": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_StructuredDataset{
+					StructuredDataset: &core.StructuredDataset{
+						Uri: "/datasets/mixed",
+						Metadata: &core.StructuredDatasetMetadata{
+							StructuredDatasetType: &core.StructuredDatasetType{Format: "csv"},
+						},
+					},
+				}}),
+				"parquet": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_StructuredDataset{
+					StructuredDataset: &core.StructuredDataset{Uri: "/datasets/parquet"},
+				}}),
+				"missing": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_StructuredDataset{
+					StructuredDataset: &core.StructuredDataset{Uri: "/datasets/missing"},
+				}}),
+				"json": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_Blob{Blob: &core.Blob{
+					Uri:      "/blobs/file.json",
+					Metadata: &core.BlobMetadata{Type: &core.BlobType{Format: "json"}},
+				}}}),
+				"number": getPreviewLiteral(&core.Scalar{Value: &core.Scalar_Primitive{Primitive: &core.Primitive{
+					Value: &core.Primitive_Integer{Integer: 1},
+				}}}),
+			}},
+		}, nil)
+
+	s, err := NewService(config.DataProxyConfig{
+		Preview: config.DataProxyPreviewConfig{
+			MaxRows:     10,
+			MemoryLimit: resource.MustParse("4Mi"),
+		},
+	}, nodeExecutionManager, dataStore, &mocks.TaskExecutionInterface{}, nil)
+	assert.NoError(t, err)
+	return s
+}
+
+func getStringScalar(value string) *core.Scalar {
+	return &core.Scalar{Value: &core.Scalar_Primitive{Primitive: &core.Primitive{
+		Value: &core.Primitive_StringValue{StringValue: value},
+	}}}
+}
+
+func getIntegerScalar(value int64) *core.Scalar {
+	return &core.Scalar{Value: &core.Scalar_Primitive{Primitive: &core.Primitive{
+		Value: &core.Primitive_Integer{Integer: value},
+	}}}
+}
+
+func TestService_PreviewStructuredDataset(t *testing.T) {
+	s := getPreviewService(t)
+	ctx := context.Background()
+
+	t.Run("dataset of multiple files", func(t *testing.T) {
+		resp, err := s.PreviewStructuredDataset(ctx, &service.PreviewStructuredDatasetRequest{
+			FlyteUrl: "flyte://v1/proj/dev/wfexecid/n0-d0/o/dataset",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "csv", resp.GetSchema().GetFormat())
+		assert.Len(t, resp.GetSchema().GetColumns(), 2)
+		assert.Equal(t, "id", resp.GetSchema().GetColumns()[0].GetName())
+		assert.Equal(t, core.SimpleType_INTEGER, resp.GetSchema().GetColumns()[0].GetLiteralType().GetSimple())
+		assert.Equal(t, core.SimpleType_STRING, resp.GetSchema().GetColumns()[1].GetLiteralType().GetSimple())
+		assert.False(t, resp.GetTruncated())
+		assert.Len(t, resp.GetRows(), 3)
+		assert.Equal(t, []*core.Scalar{getIntegerScalar(3), getStringScalar("c")}, resp.GetRows()[2].GetValues())
+	})
+
+	t.Run("limit and projection", func(t *testing.T) {
+		resp, err := s.PreviewStructuredDataset(ctx, &service.PreviewStructuredDatasetRequest{
+			FlyteUrl: "flyte://v1/proj/dev/wfexecid/n0-d0/o/dataset",
+			Limit:    2,
+			Columns:  []string{"name"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetSchema().GetColumns(), 1)
+		assert.True(t, resp.GetTruncated())
+		assert.Len(t, resp.GetRows(), 2)
+		assert.Equal(t, []*core.Scalar{getStringScalar("b")}, resp.GetRows()[1].GetValues())
+	})
+
+	t.Run("blob", func(t *testing.T) {
+		resp, err := s.PreviewStructuredDataset(ctx, &service.PreviewStructuredDatasetRequest{
+			FlyteUrl: "flyte://v1/proj/dev/wfexecid/n0-d0/o/blob",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*core.Scalar{getStringScalar("1")}, resp.GetRows()[0].GetValues())
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		resp, err := s.PreviewStructuredDataset(ctx, &service.PreviewStructuredDatasetRequest{
+			FlyteUrl: "flyte://v1/proj/dev/wfexecid/n0-d0/o/parquet",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "parquet", resp.GetSchema().GetFormat())
+		assert.Len(t, resp.GetSchema().GetColumns(), 1)
+		assert.Equal(t, []string{"tags"}, resp.GetOmittedColumns())
+		assert.False(t, resp.GetTruncated())
+		assert.Equal(t, []*core.Scalar{getIntegerScalar(2)}, resp.GetRows()[1].GetValues())
+	})
+
+	for _, tc := range []struct {
+		name     string
+		flyteURL string
+		columns  []string
+		code     codes.Code
+	}{
+		{name: "literal map", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o", code: codes.InvalidArgument},
+		{name: "not a dataset", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o/number", code: codes.InvalidArgument},
+		{name: "unsupported format", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o/json", code: codes.InvalidArgument},
+		{name: "missing data", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o/missing", code: codes.NotFound},
+		{name: "unknown column", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o/dataset", columns: []string{"other"},
+			code: codes.InvalidArgument},
+		{name: "repeated column", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o/parquet", columns: []string{"tags"},
+			code: codes.Unimplemented},
+		{name: "files with different schemas", flyteURL: "flyte://v1/proj/dev/wfexecid/n0-d0/o/mixed",
+			code: codes.FailedPrecondition},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.PreviewStructuredDataset(ctx, &service.PreviewStructuredDatasetRequest{
+				FlyteUrl: tc.flyteURL,
+				Columns:  tc.columns,
+			})
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tc.code, st.Code())
+		})
+	}
+}
+
+func TestService_ReadDataset_MemoryLimit(t *testing.T) {
+	s := getPreviewService(t)
+	files := []storage.DataReference{"/datasets/csv/00000", "/datasets/csv/00001"}
+
+	// The first file takes 16 of the 20 bytes, leaving too few for the header of the second.
+	table, err := s.readDataset(context.Background(), files, formatCSV, nil, tabular.Options{
+		Limit:       10,
+		MemoryLimit: 20,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, table.Rows, 2)
+	assert.True(t, table.Truncated)
+
+	_, err = s.readDataset(context.Background(), files, formatCSV, nil, tabular.Options{
+		Limit:       10,
+		MemoryLimit: 4,
+	})
+	assert.ErrorIs(t, err, tabular.ErrMemoryLimit)
+}
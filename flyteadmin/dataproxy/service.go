@@ -25,6 +25,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/storage"
@@ -39,6 +40,17 @@ type Service struct {
 	shardSelector        ioutils.ShardSelector
 	nodeExecutionManager interfaces.NodeExecutionInterface
 	taskExecutionManager interfaces.TaskExecutionInterface
+	streamSigner         *streamSigner
+}
+
+// createSignedURL creates a signed url of the storage backend, or one of the streaming endpoints of the data proxy when
+// configured to stream data.
+func (s Service) createSignedURL(ctx context.Context, reference storage.DataReference,
+	properties storage.SignedURLProperties) (storage.SignedURLResponse, error) {
+	if s.streamSigner != nil {
+		return s.streamSigner.createURL(reference, properties)
+	}
+	return s.dataStore.CreateSignedURL(ctx, reference, properties)
 }
 
 // CreateUploadLocation creates a temporary signed url to allow callers to upload content.
@@ -132,7 +144,7 @@ func (s Service) CreateUploadLocation(ctx context.Context, req *service.CreateUp
 		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to create shardedStorageLocation, Error: %v", err)
 	}
 
-	resp, err := s.dataStore.CreateSignedURL(ctx, storagePath, storage.SignedURLProperties{
+	resp, err := s.createSignedURL(ctx, storagePath, storage.SignedURLProperties{
 		Scope:                 stow.ClientMethodPut,
 		ExpiresIn:             req.GetExpiresIn().AsDuration(),
 		ContentMD5:            base64digestMD5,
@@ -192,7 +204,7 @@ func (s Service) CreateDownloadLink(ctx context.Context, req *service.CreateDown
 		return nil, errors.NewFlyteAdminErrorf(codes.NotFound, "object not found")
 	}
 
-	signedURLResp, err := s.dataStore.CreateSignedURL(ctx, ref, storage.SignedURLProperties{
+	signedURLResp, err := s.createSignedURL(ctx, ref, storage.SignedURLProperties{
 		Scope:     stow.ClientMethodGet,
 		ExpiresIn: req.GetExpiresIn().AsDuration(),
 	})
@@ -223,7 +235,7 @@ func (s Service) CreateDownloadLocation(ctx context.Context, req *service.Create
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "error while validating request: %v", err)
 	}
 
-	resp, err := s.dataStore.CreateSignedURL(ctx, storage.DataReference(req.GetNativeUrl()), storage.SignedURLProperties{
+	resp, err := s.createSignedURL(ctx, storage.DataReference(req.GetNativeUrl()), storage.SignedURLProperties{
 		Scope:     stow.ClientMethodGet,
 		ExpiresIn: req.GetExpiresIn().AsDuration(),
 	})
@@ -474,7 +486,8 @@ func (s Service) GetData(ctx context.Context, req *service.GetDataRequest) (
 func NewService(cfg config.DataProxyConfig,
 	nodeExec interfaces.NodeExecutionInterface,
 	dataStore *storage.DataStore,
-	taskExec interfaces.TaskExecutionInterface) (Service, error) {
+	taskExec interfaces.TaskExecutionInterface,
+	sm pluginsCore.SecretManager) (Service, error) {
 
 	// Context is not used in the constructor. Should ideally be removed.
 	selector, err := ioutils.NewBase36PrefixShardSelector(context.TODO())
@@ -482,11 +495,20 @@ func NewService(cfg config.DataProxyConfig,
 		return Service{}, err
 	}
 
+	var signer *streamSigner
+	if cfg.Proxy.Enabled {
+		signer, err = newStreamSigner(context.TODO(), cfg.Proxy, sm)
+		if err != nil {
+			return Service{}, err
+		}
+	}
+
 	return Service{
 		cfg:                  cfg,
 		dataStore:            dataStore,
 		shardSelector:        selector,
 		nodeExecutionManager: nodeExec,
 		taskExecutionManager: taskExec,
+		streamSigner:         signer,
 	}, nil
 }
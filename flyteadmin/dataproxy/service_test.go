@@ -38,7 +38,7 @@ func TestNewService(t *testing.T) {
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
 	s, err := NewService(config.DataProxyConfig{
 		Upload: config.DataProxyUploadConfig{},
-	}, nodeExecutionManager, dataStore, taskExecutionManager)
+	}, nodeExecutionManager, dataStore, taskExecutionManager, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, s)
 }
@@ -62,7 +62,7 @@ func TestCreateUploadLocation(t *testing.T) {
 	assert.NoError(t, err)
 	nodeExecutionManager := &mocks.NodeExecutionInterface{}
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
-	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, dataStore, taskExecutionManager)
+	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, dataStore, taskExecutionManager, nil)
 	assert.NoError(t, err)
 	t.Run("No project/domain", func(t *testing.T) {
 		_, err = s.CreateUploadLocation(context.Background(), &service.CreateUploadLocationRequest{})
@@ -116,7 +116,7 @@ func TestCreateUploadLocationMore(t *testing.T) {
 	assert.NoError(t, err)
 	nodeExecutionManager := &mocks.NodeExecutionInterface{}
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
-	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, &ds, taskExecutionManager)
+	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, &ds, taskExecutionManager, nil)
 	assert.NoError(t, err)
 
 	exists, err := createStorageLocation(context.TODO(), s.dataStore, s.cfg.Upload,
@@ -180,7 +180,7 @@ func setupCreateDownloadLink(t *testing.T) (Service, CreateDownloadLinkDependenc
 	dataStore := commonMocks.GetMockStorageClient()
 	nodeExecutionManager := &mocks.NodeExecutionInterface{}
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
-	s, err := NewService(config.DataProxyConfig{Download: config.DataProxyDownloadConfig{MaxExpiresIn: stdlibConfig.Duration{Duration: time.Hour}}}, nodeExecutionManager, dataStore, taskExecutionManager)
+	s, err := NewService(config.DataProxyConfig{Download: config.DataProxyDownloadConfig{MaxExpiresIn: stdlibConfig.Duration{Duration: time.Hour}}}, nodeExecutionManager, dataStore, taskExecutionManager, nil)
 	assert.NoError(t, err)
 	return s, CreateDownloadLinkDependencies{
 		dataStore:            dataStore,
@@ -311,7 +311,7 @@ func TestCreateDownloadLocation(t *testing.T) {
 	dataStore := commonMocks.GetMockStorageClient()
 	nodeExecutionManager := &mocks.NodeExecutionInterface{}
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
-	s, err := NewService(config.DataProxyConfig{Download: config.DataProxyDownloadConfig{MaxExpiresIn: stdlibConfig.Duration{Duration: time.Hour}}}, nodeExecutionManager, dataStore, taskExecutionManager)
+	s, err := NewService(config.DataProxyConfig{Download: config.DataProxyDownloadConfig{MaxExpiresIn: stdlibConfig.Duration{Duration: time.Hour}}}, nodeExecutionManager, dataStore, taskExecutionManager, nil)
 	assert.NoError(t, err)
 
 	t.Run("Invalid expiry", func(t *testing.T) {
@@ -349,7 +349,7 @@ func TestService_GetData(t *testing.T) {
 	dataStore := commonMocks.GetMockStorageClient()
 	nodeExecutionManager := &mocks.NodeExecutionInterface{}
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
-	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, dataStore, taskExecutionManager)
+	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, dataStore, taskExecutionManager, nil)
 	assert.NoError(t, err)
 
 	inputsLM := &core.LiteralMap{
@@ -490,7 +490,7 @@ func TestService_Error(t *testing.T) {
 	dataStore := commonMocks.GetMockStorageClient()
 	nodeExecutionManager := &mocks.NodeExecutionInterface{}
 	taskExecutionManager := &mocks.TaskExecutionInterface{}
-	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, dataStore, taskExecutionManager)
+	s, err := NewService(config.DataProxyConfig{}, nodeExecutionManager, dataStore, taskExecutionManager, nil)
 	assert.NoError(t, err)
 
 	t.Run("get a working set of urls without retry attempt", func(t *testing.T) {
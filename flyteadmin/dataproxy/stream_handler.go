@@ -0,0 +1,517 @@
+package dataproxy
+
+import (
+	"context"
+	"crypto/md5" // #nosec
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/flyteorg/stow"
+)
+
+const (
+	contentMD5Header   = "Content-MD5"
+	contentRangeHeader = "Content-Range"
+	rangeHeader        = "Range"
+	bytesUnit          = "bytes"
+	stagingSuffix      = ".parts"
+	partNameFormat     = "%020d"
+	// The name an upload sent whole is staged under until its digest is checked, which can't be mistaken for a chunk.
+	wholeUploadName = "whole"
+	// The status returned to chunks which don't complete an upload, as well as to queries of the progress of an
+	// upload, following the convention of resumable uploads to object stores.
+	statusResumeIncomplete = http.StatusPermanentRedirect
+)
+
+type streamHandlerMetrics struct {
+	Scope          promutils.Scope
+	Uploads        prometheus.Counter
+	UploadedChunks prometheus.Counter
+	Downloads      prometheus.Counter
+	Rejected       prometheus.Counter
+	Failures       prometheus.Counter
+}
+
+// StreamHandler serves the streaming upload and download endpoints of the data proxy, which the upload and download
+// locations point at when the data proxy is configured not to hand out the signed urls of the storage backend.
+//
+// Downloads honour single byte ranges, which are read from storage as such. Uploads are either sent whole, which are
+// written to the upload location once their digest, if any, is checked, or in chunks carrying a Content-Range header,
+// which are staged next to the upload location until the last chunk assembles them. An upload interrupted between
+// chunks resumes from the progress reported to a chunk-less request with a "bytes */<size>" Content-Range header.
+type StreamHandler struct {
+	cfg       config.DataProxyConfig
+	dataStore *storage.DataStore
+	signer    *streamSigner
+	metrics   streamHandlerMetrics
+}
+
+type statusError struct {
+	status int
+	msg    string
+}
+
+func (e statusError) Error() string {
+	return e.msg
+}
+
+func newStatusErrorf(status int, format string, args ...interface{}) error {
+	return statusError{status: status, msg: fmt.Sprintf(format, args...)}
+}
+
+func (h *StreamHandler) writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	var statusErr statusError
+	if errors.As(err, &statusErr) {
+		h.metrics.Rejected.Inc()
+		http.Error(w, statusErr.msg, statusErr.status)
+		return
+	}
+	h.metrics.Failures.Inc()
+	logger.Errorf(ctx, "Failed to stream data through the data proxy with err: %v", err)
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// Parses a single "bytes=<first>-<last>" range of an object of the given size, into its offset and length. Requests
+// for several ranges are served the whole object.
+func parseRange(header string, size int64) (start, length int64, partial bool, err error) {
+	if len(header) == 0 || strings.Contains(header, ",") {
+		return 0, size, false, nil
+	}
+	spec, found := strings.CutPrefix(header, bytesUnit+"=")
+	if !found {
+		return 0, 0, false, fmt.Errorf("unsupported range [%v]", header)
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false, fmt.Errorf("invalid range [%v]", header)
+	}
+	if len(first) == 0 {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix <= 0 || size == 0 {
+			return 0, 0, false, fmt.Errorf("invalid range [%v]", header)
+		}
+		suffix = min(suffix, size)
+		return size - suffix, suffix, true, nil
+	}
+	start, err = strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, false, fmt.Errorf("invalid range [%v]", header)
+	}
+	end := size - 1
+	if len(last) > 0 {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, false, fmt.Errorf("invalid range [%v]", header)
+		}
+		end = min(end, size-1)
+	}
+	return start, end - start + 1, true, nil
+}
+
+// Parses the "bytes <first>-<last>/<size>" Content-Range header of an upload chunk. Uploads without the header are
+// sent whole. A first byte of -1 denotes a "bytes */<size>" query of the progress of the upload.
+func parseContentRange(header string, contentLength int64) (start, end, total int64, err error) {
+	if len(header) == 0 {
+		return 0, contentLength - 1, contentLength, nil
+	}
+	spec, found := strings.CutPrefix(header, bytesUnit+" ")
+	if !found {
+		return 0, 0, 0, fmt.Errorf("unsupported content range [%v]", header)
+	}
+	byteRange, size, found := strings.Cut(spec, "/")
+	if !found {
+		return 0, 0, 0, fmt.Errorf("invalid content range [%v]", header)
+	}
+	total, err = strconv.ParseInt(size, 10, 64)
+	if err != nil || total < 0 {
+		return 0, 0, 0, fmt.Errorf("content range [%v] must specify the size of the upload", header)
+	}
+	if byteRange == "*" {
+		return -1, -1, total, nil
+	}
+	first, last, found := strings.Cut(byteRange, "-")
+	if !found {
+		return 0, 0, 0, fmt.Errorf("invalid content range [%v]", header)
+	}
+	start, err = strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, 0, fmt.Errorf("invalid content range [%v]", header)
+	}
+	end, err = strconv.ParseInt(last, 10, 64)
+	if err != nil || end < start || end >= total {
+		return 0, 0, 0, fmt.Errorf("invalid content range [%v]", header)
+	}
+	return start, end, total, nil
+}
+
+func getStagingLocation(reference storage.DataReference) storage.DataReference {
+	return storage.DataReference(reference.String() + stagingSuffix)
+}
+
+// Returns the staged chunks of an upload, in order, along with the number of bytes they hold.
+func (h *StreamHandler) getStagedParts(ctx context.Context, staging storage.DataReference) (
+	[]storage.DataReference, int64, error) {
+	var parts []storage.DataReference
+	var offset int64
+	for {
+		part, err := h.dataStore.ConstructReference(ctx, staging, fmt.Sprintf(partNameFormat, offset))
+		if err != nil {
+			return nil, 0, err
+		}
+		metadata, err := h.dataStore.Head(ctx, part)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !metadata.Exists() || metadata.Size() == 0 {
+			return parts, offset, nil
+		}
+		parts = append(parts, part)
+		offset += metadata.Size()
+	}
+}
+
+func (h *StreamHandler) deleteStagedParts(ctx context.Context, parts []storage.DataReference) {
+	for _, part := range parts {
+		if err := h.dataStore.Delete(ctx, part); err != nil {
+			logger.Warningf(ctx, "Failed to delete staged upload chunk [%v] with err: %v", part, err)
+		}
+	}
+}
+
+func writeUploadProgress(w http.ResponseWriter, offset int64) {
+	if offset > 0 {
+		w.Header().Set(rangeHeader, fmt.Sprintf("%s=0-%d", bytesUnit, offset-1))
+	}
+	w.WriteHeader(statusResumeIncomplete)
+}
+
+// partsReader reads the staged chunks of an upload one after the other.
+type partsReader struct {
+	ctx       context.Context
+	dataStore *storage.DataStore
+	parts     []storage.DataReference
+	current   io.ReadCloser
+}
+
+func (r *partsReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.parts) == 0 {
+				return 0, io.EOF
+			}
+			current, err := r.dataStore.ReadRaw(r.ctx, r.parts[0])
+			if err != nil {
+				return 0, err
+			}
+			r.current = current
+			r.parts = r.parts[1:]
+		}
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			err = r.current.Close()
+			r.current = nil
+			if n > 0 || err != nil {
+				return n, err
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *partsReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	return r.current.Close()
+}
+
+// Returns the options of the write of an upload, which records its digest in the metadata of the object when the upload
+// location was signed to do so.
+func getUploadOptions(query url.Values) storage.Options {
+	opts := storage.Options{}
+	if query.Get(md5MetadataParam) == strconv.FormatBool(true) {
+		opts.Metadata = map[string]interface{}{
+			storage.FlyteContentMD5: query.Get(contentMD5Param),
+		}
+	}
+	return opts
+}
+
+// Checks the digest of the content of an upload against the Content-MD5 header of its request, and against the one
+// the upload location was signed with.
+func checkUploadDigest(digest hash.Hash, header string, query url.Values) error {
+	if digest == nil {
+		return nil
+	}
+	contentMD5 := base64.StdEncoding.EncodeToString(digest.Sum(nil))
+	if len(header) > 0 && contentMD5 != header {
+		return newStatusErrorf(http.StatusBadRequest, "the uploaded content does not match its Content-MD5 header")
+	}
+	if signed := query.Get(contentMD5Param); len(signed) > 0 && contentMD5 != signed {
+		return newStatusErrorf(http.StatusBadRequest, "the uploaded content does not match its md5 digest")
+	}
+	return nil
+}
+
+// Writes an upload sent whole to the upload location. An upload with a digest is staged next to the upload location
+// until its digest, which can only be checked once all of it is read, matches, such that content which doesn't match
+// never replaces the object at the upload location.
+func (h *StreamHandler) writeUpload(w http.ResponseWriter, r *http.Request, reference storage.DataReference) error {
+	ctx := r.Context()
+	query := r.URL.Query()
+	body := io.Reader(http.MaxBytesReader(w, r.Body, r.ContentLength))
+	if len(r.Header.Get(contentMD5Header)) == 0 && len(query.Get(contentMD5Param)) == 0 {
+		if err := h.dataStore.WriteRaw(ctx, reference, r.ContentLength, getUploadOptions(query), body); err != nil {
+			return err
+		}
+		h.metrics.Uploads.Inc()
+		return nil
+	}
+
+	staged, err := h.dataStore.ConstructReference(ctx, getStagingLocation(reference), wholeUploadName)
+	if err != nil {
+		return err
+	}
+	digest := md5.New() // #nosec
+	if err := h.dataStore.WriteRaw(ctx, staged, r.ContentLength, storage.Options{}, io.TeeReader(body, digest)); err != nil {
+		return err
+	}
+	defer h.deleteStagedParts(ctx, []storage.DataReference{staged})
+	if err := checkUploadDigest(digest, r.Header.Get(contentMD5Header), query); err != nil {
+		return err
+	}
+
+	reader, err := h.dataStore.ReadRaw(ctx, staged)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if err := h.dataStore.WriteRaw(ctx, reference, r.ContentLength, getUploadOptions(query), reader); err != nil {
+		return err
+	}
+	h.metrics.Uploads.Inc()
+	return nil
+}
+
+// Assembles the staged chunks of an upload into the upload location, once their digest matches the one the upload
+// location was signed with.
+func (h *StreamHandler) completeUpload(ctx context.Context, reference storage.DataReference,
+	parts []storage.DataReference, total int64, query url.Values) error {
+	contentMD5 := query.Get(contentMD5Param)
+	if len(contentMD5) > 0 {
+		digest := md5.New() // #nosec
+		reader := &partsReader{ctx: ctx, dataStore: h.dataStore, parts: parts}
+		_, err := io.Copy(digest, reader)
+		_ = reader.Close()
+		if err != nil {
+			return err
+		}
+		if base64.StdEncoding.EncodeToString(digest.Sum(nil)) != contentMD5 {
+			h.deleteStagedParts(ctx, parts)
+			return newStatusErrorf(http.StatusBadRequest, "the uploaded content does not match its md5 digest")
+		}
+	}
+
+	reader := &partsReader{ctx: ctx, dataStore: h.dataStore, parts: parts}
+	defer reader.Close()
+	if err := h.dataStore.WriteRaw(ctx, reference, total, getUploadOptions(query), reader); err != nil {
+		return err
+	}
+	h.deleteStagedParts(ctx, parts)
+	h.metrics.Uploads.Inc()
+	return nil
+}
+
+func (h *StreamHandler) handleUpload(w http.ResponseWriter, r *http.Request, reference storage.DataReference) error {
+	ctx := r.Context()
+	if r.ContentLength < 0 {
+		return newStatusErrorf(http.StatusLengthRequired, "uploads require a Content-Length header")
+	}
+	start, end, total, err := parseContentRange(r.Header.Get(contentRangeHeader), r.ContentLength)
+	if err != nil {
+		return newStatusErrorf(http.StatusBadRequest, "%v", err)
+	}
+	if maxSize := h.cfg.Upload.MaxSize.Value(); maxSize > 0 && total > maxSize {
+		return newStatusErrorf(http.StatusRequestEntityTooLarge, "upload of [%d] bytes exceeds the limit of [%d] bytes",
+			total, maxSize)
+	}
+
+	staging := getStagingLocation(reference)
+	parts, offset, err := h.getStagedParts(ctx, staging)
+	if err != nil {
+		return err
+	}
+	if start < 0 {
+		writeUploadProgress(w, offset)
+		return nil
+	}
+	if len(r.Header.Get(contentRangeHeader)) == 0 {
+		// Uploads sent whole replace the chunks of earlier attempts, rather than being staged as a chunk.
+		if err := h.writeUpload(w, r, reference); err != nil {
+			return err
+		}
+		h.deleteStagedParts(ctx, parts)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+	if length := end - start + 1; r.ContentLength != length {
+		return newStatusErrorf(http.StatusBadRequest, "Content-Length [%d] does not match the [%d] bytes of the chunk",
+			r.ContentLength, length)
+	}
+	if maxChunkSize := h.cfg.Proxy.MaxChunkSize.Value(); maxChunkSize > 0 && r.ContentLength > maxChunkSize {
+		return newStatusErrorf(http.StatusRequestEntityTooLarge, "chunk of [%d] bytes exceeds the limit of [%d] bytes",
+			r.ContentLength, maxChunkSize)
+	}
+	// A chunk starting at the first byte restarts the upload, discarding the chunks of earlier attempts.
+	if start == 0 && offset > 0 {
+		h.deleteStagedParts(ctx, parts)
+		parts, offset = nil, 0
+	}
+	if start != offset {
+		w.Header().Set(contentRangeHeader, fmt.Sprintf("%s */%d", bytesUnit, total))
+		if offset > 0 {
+			w.Header().Set(rangeHeader, fmt.Sprintf("%s=0-%d", bytesUnit, offset-1))
+		}
+		return newStatusErrorf(http.StatusRequestedRangeNotSatisfiable,
+			"chunk starts at byte [%d] while [%d] bytes were uploaded", start, offset)
+	}
+
+	if r.ContentLength > 0 {
+		part, err := h.dataStore.ConstructReference(ctx, staging, fmt.Sprintf(partNameFormat, start))
+		if err != nil {
+			return err
+		}
+		var digest hash.Hash
+		body := io.Reader(http.MaxBytesReader(w, r.Body, r.ContentLength))
+		if len(r.Header.Get(contentMD5Header)) > 0 {
+			digest = md5.New() // #nosec
+			body = io.TeeReader(body, digest)
+		}
+		if err := h.dataStore.WriteRaw(ctx, part, r.ContentLength, storage.Options{}, body); err != nil {
+			return err
+		}
+		if digest != nil && base64.StdEncoding.EncodeToString(digest.Sum(nil)) != r.Header.Get(contentMD5Header) {
+			h.deleteStagedParts(ctx, []storage.DataReference{part})
+			return newStatusErrorf(http.StatusBadRequest, "the uploaded chunk does not match its Content-MD5 header")
+		}
+		parts = append(parts, part)
+		h.metrics.UploadedChunks.Inc()
+	}
+
+	if end+1 < total {
+		writeUploadProgress(w, end+1)
+		return nil
+	}
+	if err := h.completeUpload(ctx, reference, parts, total, r.URL.Query()); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (h *StreamHandler) handleDownload(w http.ResponseWriter, r *http.Request, reference storage.DataReference) error {
+	ctx := r.Context()
+	metadata, err := h.dataStore.Head(ctx, reference)
+	if err != nil {
+		return err
+	}
+	if !metadata.Exists() {
+		return newStatusErrorf(http.StatusNotFound, "object not found")
+	}
+	size := metadata.Size()
+	start, length, partial, err := parseRange(r.Header.Get(rangeHeader), size)
+	if err != nil {
+		w.Header().Set(contentRangeHeader, fmt.Sprintf("%s */%d", bytesUnit, size))
+		return newStatusErrorf(http.StatusRequestedRangeNotSatisfiable, "%v", err)
+	}
+
+	var body io.ReadCloser
+	if r.Method != http.MethodHead && length > 0 {
+		body, err = h.dataStore.ReadRawRange(ctx, reference, start, length)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+	}
+
+	w.Header().Set("Accept-Ranges", bytesUnit)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	if etag := metadata.Etag(); len(etag) > 0 {
+		w.Header().Set("ETag", strconv.Quote(etag))
+	}
+	status := http.StatusOK
+	if partial {
+		w.Header().Set(contentRangeHeader, fmt.Sprintf("%s %d-%d/%d", bytesUnit, start, start+length-1, size))
+		status = http.StatusPartialContent
+	}
+	w.WriteHeader(status)
+	h.metrics.Downloads.Inc()
+	if body != nil {
+		if _, err := io.CopyN(w, body, length); err != nil {
+			logger.Warningf(ctx, "Failed to stream [%v] to the client with err: %v", reference, err)
+		}
+	}
+	return nil
+}
+
+func (h *StreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	reference, err := getStreamReference(r.URL.Path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	var method stow.ClientMethod
+	var handle func(http.ResponseWriter, *http.Request, storage.DataReference) error
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		method, handle = stow.ClientMethodGet, h.handleDownload
+	case http.MethodPut:
+		method, handle = stow.ClientMethodPut, h.handleUpload
+	default:
+		w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodHead, http.MethodPut}, ", "))
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := h.signer.verify(method, reference, r.URL.Query()); err != nil {
+		h.writeError(ctx, w, newStatusErrorf(http.StatusForbidden, "%v", err))
+		return
+	}
+	if err := handle(w, r, reference); err != nil {
+		h.writeError(ctx, w, err)
+	}
+}
+
+// NewStreamHandler returns the handler of the streaming endpoints of the data proxy service, which must be configured
+// to stream data.
+func NewStreamHandler(s Service, scope promutils.Scope) *StreamHandler {
+	return &StreamHandler{
+		cfg:       s.cfg,
+		dataStore: s.dataStore,
+		signer:    s.streamSigner,
+		metrics: streamHandlerMetrics{
+			Scope:          scope,
+			Uploads:        scope.MustNewCounter("uploads", "count of uploads streamed to storage"),
+			UploadedChunks: scope.MustNewCounter("uploaded_chunks", "count of upload chunks staged in storage"),
+			Downloads:      scope.MustNewCounter("downloads", "count of downloads streamed from storage"),
+			Rejected:       scope.MustNewCounter("rejected", "count of streaming requests rejected as invalid"),
+			Failures:       scope.MustNewCounter("failures", "count of streaming requests which failed"),
+		},
+	}
+}
@@ -0,0 +1,279 @@
+package dataproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // #nosec
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	pluginsCoreMocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	stdlibConfig "github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/flyteorg/stow"
+)
+
+const streamBaseURL = "https://admin.example.com"
+
+func getStreamingConfig() config.DataProxyConfig {
+	baseURL, _ := url.Parse(streamBaseURL)
+	return config.DataProxyConfig{
+		Upload: config.DataProxyUploadConfig{
+			MaxSize:               resource.MustParse("1Ki"),
+			MaxExpiresIn:          stdlibConfig.Duration{Duration: time.Hour},
+			DefaultFileNameLength: 20,
+		},
+		Download: config.DataProxyDownloadConfig{
+			MaxExpiresIn: stdlibConfig.Duration{Duration: time.Hour},
+		},
+		Proxy: config.DataProxyProxyConfig{
+			Enabled:              true,
+			BaseURL:              stdlibConfig.URL{URL: *baseURL},
+			SigningKeySecretName: "key",
+			MaxChunkSize:         resource.MustParse("16"),
+		},
+	}
+}
+
+func getStreamingService(t *testing.T) (Service, *StreamHandler) {
+	dataStore, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	sm := &pluginsCoreMocks.SecretManager{}
+	sm.EXPECT().Get(mock.Anything, "key").Return("secret", nil)
+	s, err := NewService(getStreamingConfig(), &mocks.NodeExecutionInterface{}, dataStore,
+		&mocks.TaskExecutionInterface{}, sm)
+	assert.NoError(t, err)
+	return s, NewStreamHandler(s, promutils.NewTestScope())
+}
+
+func serveStreamRequest(handler http.Handler, method, signedURL string, body []byte,
+	headers map[string]string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, signedURL, bytes.NewReader(body))
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func getContentMD5(content []byte) []byte {
+	digest := md5.Sum(content) // #nosec
+	return digest[:]
+}
+
+func TestNewService_Streaming(t *testing.T) {
+	dataStore, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	sm := &pluginsCoreMocks.SecretManager{}
+	sm.EXPECT().Get(mock.Anything, "key").Return("", fmt.Errorf("not found"))
+	_, err = NewService(getStreamingConfig(), &mocks.NodeExecutionInterface{}, dataStore,
+		&mocks.TaskExecutionInterface{}, sm)
+	assert.Error(t, err)
+}
+
+func TestStreamSigner(t *testing.T) {
+	signer := streamSigner{key: []byte("secret")}
+	signed, err := signer.createURL("s3://bucket/file", storage.SignedURLProperties{
+		Scope:     stow.ClientMethodGet,
+		ExpiresIn: time.Hour,
+	})
+	assert.NoError(t, err)
+	reference, err := getStreamReference(signed.URL.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.DataReference("s3://bucket/file"), reference)
+	assert.NoError(t, signer.verify(stow.ClientMethodGet, reference, signed.URL.Query()))
+
+	t.Run("other method", func(t *testing.T) {
+		assert.Error(t, signer.verify(stow.ClientMethodPut, reference, signed.URL.Query()))
+	})
+	t.Run("other reference", func(t *testing.T) {
+		assert.Error(t, signer.verify(stow.ClientMethodGet, "s3://bucket/other", signed.URL.Query()))
+	})
+	t.Run("expired", func(t *testing.T) {
+		expired, err := signer.createURL(reference, storage.SignedURLProperties{
+			Scope:     stow.ClientMethodGet,
+			ExpiresIn: -time.Minute,
+		})
+		assert.NoError(t, err)
+		assert.Error(t, signer.verify(stow.ClientMethodGet, reference, expired.URL.Query()))
+	})
+}
+
+func TestCreateUploadLocation_Streaming(t *testing.T) {
+	s, handler := getStreamingService(t)
+	content := []byte("hello world")
+	resp, err := s.CreateUploadLocation(context.Background(), &service.CreateUploadLocationRequest{
+		Project:    "project",
+		Domain:     "domain",
+		Filename:   "file",
+		ContentMd5: getContentMD5(content),
+		ExpiresIn:  durationpb.New(time.Minute),
+	})
+	assert.NoError(t, err)
+	signedURL, err := url.Parse(resp.GetSignedUrl())
+	assert.NoError(t, err)
+	assert.Equal(t, "admin.example.com", signedURL.Host)
+
+	t.Run("rejects mismatching content", func(t *testing.T) {
+		recorder := serveStreamRequest(handler, http.MethodPut, resp.GetSignedUrl(), []byte("hello there"), nil)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		metadata, err := s.dataStore.Head(context.Background(), storage.DataReference(resp.GetNativeUrl()))
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+	t.Run("rejects unsigned requests", func(t *testing.T) {
+		signedURL.RawQuery = ""
+		recorder := serveStreamRequest(handler, http.MethodPut, signedURL.String(), content, nil)
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	recorder := serveStreamRequest(handler, http.MethodPut, resp.GetSignedUrl(), content, map[string]string{
+		contentMD5Header: base64.StdEncoding.EncodeToString(getContentMD5(content)),
+	})
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	reader, err := s.dataStore.ReadRaw(context.Background(), storage.DataReference(resp.GetNativeUrl()))
+	assert.NoError(t, err)
+	uploaded, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, content, uploaded)
+
+	t.Run("keeps the upload on mismatching content", func(t *testing.T) {
+		recorder := serveStreamRequest(handler, http.MethodPut, resp.GetSignedUrl(), []byte("hello there"), nil)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		reader, err := s.dataStore.ReadRaw(context.Background(), storage.DataReference(resp.GetNativeUrl()))
+		assert.NoError(t, err)
+		uploaded, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, content, uploaded)
+		staged, err := s.dataStore.ConstructReference(context.Background(),
+			getStagingLocation(storage.DataReference(resp.GetNativeUrl())), wholeUploadName)
+		assert.NoError(t, err)
+		metadata, err := s.dataStore.Head(context.Background(), staged)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+}
+
+func TestStreamHandler_ChunkedUpload(t *testing.T) {
+	s, handler := getStreamingService(t)
+	content := []byte("a resumable upload of three chunks")
+	reference := storage.DataReference("/uploads/file")
+	signed, err := s.streamSigner.createURL(reference, storage.SignedURLProperties{
+		Scope:      stow.ClientMethodPut,
+		ExpiresIn:  time.Minute,
+		ContentMD5: base64.StdEncoding.EncodeToString(getContentMD5(content)),
+	})
+	assert.NoError(t, err)
+	signedURL := signed.URL.String()
+	total := len(content)
+	putChunk := func(start, end int) *httptest.ResponseRecorder {
+		return serveStreamRequest(handler, http.MethodPut, signedURL, content[start:end+1], map[string]string{
+			contentRangeHeader: fmt.Sprintf("bytes %d-%d/%d", start, end, total),
+		})
+	}
+
+	recorder := putChunk(0, 15)
+	assert.Equal(t, statusResumeIncomplete, recorder.Code)
+	assert.Equal(t, "bytes=0-15", recorder.Header().Get(rangeHeader))
+
+	t.Run("rejects chunks out of order", func(t *testing.T) {
+		recorder := putChunk(20, 25)
+		assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, recorder.Code)
+		assert.Equal(t, "bytes=0-15", recorder.Header().Get(rangeHeader))
+	})
+	t.Run("rejects chunks over the size limit", func(t *testing.T) {
+		recorder := putChunk(16, total-1)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+	t.Run("reports the progress of the upload", func(t *testing.T) {
+		recorder := serveStreamRequest(handler, http.MethodPut, signedURL, nil, map[string]string{
+			contentRangeHeader: fmt.Sprintf("bytes */%d", total),
+		})
+		assert.Equal(t, statusResumeIncomplete, recorder.Code)
+		assert.Equal(t, "bytes=0-15", recorder.Header().Get(rangeHeader))
+	})
+
+	assert.Equal(t, statusResumeIncomplete, putChunk(16, 31).Code)
+	assert.Equal(t, http.StatusOK, putChunk(32, total-1).Code)
+	reader, err := s.dataStore.ReadRaw(context.Background(), reference)
+	assert.NoError(t, err)
+	uploaded, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, content, uploaded)
+	parts, _, err := handler.getStagedParts(context.Background(), getStagingLocation(reference))
+	assert.NoError(t, err)
+	assert.Empty(t, parts)
+}
+
+func TestStreamHandler_UploadSizeLimit(t *testing.T) {
+	s, handler := getStreamingService(t)
+	signed, err := s.streamSigner.createURL("/uploads/file", storage.SignedURLProperties{
+		Scope:     stow.ClientMethodPut,
+		ExpiresIn: time.Minute,
+	})
+	assert.NoError(t, err)
+	recorder := serveStreamRequest(handler, http.MethodPut, signed.URL.String(), make([]byte, 2048), nil)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+}
+
+func TestStreamHandler_Download(t *testing.T) {
+	s, handler := getStreamingService(t)
+	content := []byte("0123456789")
+	reference := storage.DataReference("/downloads/file")
+	assert.NoError(t, s.dataStore.WriteRaw(context.Background(), reference, int64(len(content)), storage.Options{},
+		bytes.NewReader(content)))
+	signed, err := s.streamSigner.createURL(reference, storage.SignedURLProperties{
+		Scope:     stow.ClientMethodGet,
+		ExpiresIn: time.Minute,
+	})
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name         string
+		rangeHeader  string
+		status       int
+		body         string
+		contentRange string
+	}{
+		{name: "whole", status: http.StatusOK, body: "0123456789"},
+		{name: "range", rangeHeader: "bytes=2-4", status: http.StatusPartialContent, body: "234",
+			contentRange: "bytes 2-4/10"},
+		{name: "open range", rangeHeader: "bytes=7-", status: http.StatusPartialContent, body: "789",
+			contentRange: "bytes 7-9/10"},
+		{name: "suffix range", rangeHeader: "bytes=-2", status: http.StatusPartialContent, body: "89",
+			contentRange: "bytes 8-9/10"},
+		{name: "unsatisfiable range", rangeHeader: "bytes=20-", status: http.StatusRequestedRangeNotSatisfiable,
+			contentRange: "bytes */10"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := serveStreamRequest(handler, http.MethodGet, signed.URL.String(), nil,
+				map[string]string{rangeHeader: tc.rangeHeader})
+			assert.Equal(t, tc.status, recorder.Code)
+			assert.Equal(t, tc.contentRange, recorder.Header().Get(contentRangeHeader))
+			if tc.status != http.StatusRequestedRangeNotSatisfiable {
+				assert.Equal(t, tc.body, recorder.Body.String())
+			}
+		})
+	}
+
+	t.Run("rejects upload urls", func(t *testing.T) {
+		recorder := serveStreamRequest(handler, http.MethodPut, signed.URL.String(), content, nil)
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
@@ -0,0 +1,118 @@
+package dataproxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/config"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/flyteorg/stow"
+)
+
+// StreamPathPrefix is the path under which the streaming upload and download endpoints are served.
+const StreamPathPrefix = "/dataproxy/v1/objects/"
+
+const (
+	expiresParam     = "expires"
+	contentMD5Param  = "md5"
+	md5MetadataParam = "md5Metadata"
+	signatureParam   = "signature"
+)
+
+// streamSigner signs the urls of the streaming endpoints. The endpoints authenticate requests by the signatures of
+// their urls, the same way storage backends authenticate requests to the signed urls they hand out, such that only
+// callers authenticated by the CreateUploadLocation and CreateDownloadLink calls can reach the data.
+type streamSigner struct {
+	baseURL url.URL
+	key     []byte
+}
+
+// The reference is signed last, as it's the only field which may contain the delimiter.
+func (s streamSigner) sign(method stow.ClientMethod, reference storage.DataReference, expires, contentMD5 string,
+	md5Metadata bool) string {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = fmt.Fprintf(mac, "%v\n%s\n%s\n%t\n%s", method, expires, contentMD5, md5Metadata, reference)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// createURL returns the streaming url of the reference, valid for the scope and duration of the properties.
+func (s streamSigner) createURL(reference storage.DataReference, properties storage.SignedURLProperties) (
+	storage.SignedURLResponse, error) {
+	expires := strconv.FormatInt(time.Now().Add(properties.ExpiresIn).Unix(), 10)
+	md5Metadata := properties.AddContentMD5Metadata && len(properties.ContentMD5) > 0
+	query := url.Values{}
+	query.Set(expiresParam, expires)
+	if len(properties.ContentMD5) > 0 {
+		query.Set(contentMD5Param, properties.ContentMD5)
+	}
+	if md5Metadata {
+		query.Set(md5MetadataParam, strconv.FormatBool(md5Metadata))
+	}
+	query.Set(signatureParam, s.sign(properties.Scope, reference, expires, properties.ContentMD5, md5Metadata))
+
+	u := s.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + StreamPathPrefix +
+		base64.RawURLEncoding.EncodeToString([]byte(reference))
+	u.RawQuery = query.Encode()
+	return storage.SignedURLResponse{URL: u}, nil
+}
+
+// verify checks that the query of a request to the streaming url of the reference carries an unexpired signature for
+// the method.
+func (s streamSigner) verify(method stow.ClientMethod, reference storage.DataReference, query url.Values) error {
+	expires, err := strconv.ParseInt(query.Get(expiresParam), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid expiration [%v]", query.Get(expiresParam))
+	}
+	if time.Now().Unix() > expires {
+		return fmt.Errorf("url expired at [%v]", time.Unix(expires, 0).UTC())
+	}
+	md5Metadata := query.Get(md5MetadataParam) == strconv.FormatBool(true)
+	expected := s.sign(method, reference, query.Get(expiresParam), query.Get(contentMD5Param), md5Metadata)
+	if !hmac.Equal([]byte(expected), []byte(query.Get(signatureParam))) {
+		return fmt.Errorf("signature does not match")
+	}
+	return nil
+}
+
+// getStreamReference decodes the reference of a streaming url from its path.
+func getStreamReference(path string) (storage.DataReference, error) {
+	encoded := strings.TrimPrefix(path, StreamPathPrefix)
+	if len(encoded) == 0 || strings.Contains(encoded, "/") {
+		return "", fmt.Errorf("invalid path [%v]", path)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid path [%v]. Error: %w", path, err)
+	}
+	return storage.DataReference(decoded), nil
+}
+
+func newStreamSigner(ctx context.Context, cfg config.DataProxyProxyConfig, sm core.SecretManager) (*streamSigner, error) {
+	if len(cfg.BaseURL.String()) == 0 {
+		return nil, fmt.Errorf("a base url is required to serve uploads and downloads through the data proxy")
+	}
+	if sm == nil {
+		return nil, fmt.Errorf("a secret manager is required to serve uploads and downloads through the data proxy")
+	}
+	key, err := sm.Get(ctx, cfg.SigningKeySecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the data proxy signing key [%v]. Error: %w", cfg.SigningKeySecretName, err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("the data proxy signing key [%v] is empty", cfg.SigningKeySecretName)
+	}
+	return &streamSigner{
+		baseURL: cfg.BaseURL.URL,
+		key:     []byte(key),
+	}, nil
+}
@@ -0,0 +1,165 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Layouts of the datetime values parsed from CSV files, as written by pandas and the standard formatters.
+var csvDatetimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+// limitedReader fails reads once more than the limit has been read, unlike io.LimitReader which ends the file.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		return 0, ErrMemoryLimit
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// ReadCSV reads the header and the first rows of a CSV file. Values are parsed by the simple types of the declared
+// columns of the dataset, matched by name, and empty values of non-string columns are read as nulls. Columns without a
+// declared simple type are read as strings.
+func ReadCSV(r io.Reader, declared []*core.StructuredDatasetType_DatasetColumn, opts Options) (*Table, error) {
+	limited := &limitedReader{r: r, remaining: opts.MemoryLimit}
+	reader := csv.NewReader(limited)
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return &Table{MemoryUsed: opts.MemoryLimit - limited.remaining}, nil
+	} else if errors.Is(err, ErrMemoryLimit) {
+		return nil, fmt.Errorf("%w: the header of the file exceeds [%v] bytes", ErrMemoryLimit, opts.MemoryLimit)
+	} else if err != nil {
+		return nil, err
+	}
+
+	declaredTypes := make(map[string]core.SimpleType, len(declared))
+	for _, column := range declared {
+		if simple, ok := column.GetLiteralType().GetType().(*core.LiteralType_Simple); ok && isCSVType(simple.Simple) {
+			declaredTypes[column.GetName()] = simple.Simple
+		}
+	}
+	columns := make([]Column, 0, len(header))
+	types := make([]core.SimpleType, 0, len(header))
+	for _, name := range header {
+		simple, ok := declaredTypes[name]
+		if !ok {
+			simple = core.SimpleType_STRING
+		}
+		columns = append(columns, Column{Name: name, Type: simpleType(simple)})
+		types = append(types, simple)
+	}
+	indexes, err := project(columns, opts.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &Table{Columns: make([]Column, 0, len(indexes))}
+	for _, i := range indexes {
+		table.Columns = append(table.Columns, columns[i])
+	}
+	for {
+		record, err := reader.Read()
+		table.MemoryUsed = opts.MemoryLimit - limited.remaining
+		if err == io.EOF {
+			return table, nil
+		} else if errors.Is(err, ErrMemoryLimit) {
+			if len(table.Rows) == 0 {
+				return nil, fmt.Errorf("%w: the first row of the file exceeds [%v] bytes", ErrMemoryLimit, opts.MemoryLimit)
+			}
+			table.Truncated = true
+			return table, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if len(table.Rows) >= opts.Limit {
+			table.Truncated = true
+			return table, nil
+		}
+		row := make([]*core.Scalar, 0, len(indexes))
+		for _, i := range indexes {
+			value, err := parseCSVValue(record[i], types[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to read column [%v] of row [%v]. Error: %w", header[i], len(table.Rows), err)
+			}
+			row = append(row, value)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+}
+
+func isCSVType(simple core.SimpleType) bool {
+	switch simple {
+	case core.SimpleType_INTEGER, core.SimpleType_FLOAT, core.SimpleType_STRING, core.SimpleType_BOOLEAN,
+		core.SimpleType_DATETIME, core.SimpleType_DURATION:
+		return true
+	}
+	return false
+}
+
+func parseCSVValue(value string, simple core.SimpleType) (*core.Scalar, error) {
+	if simple == core.SimpleType_STRING {
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_StringValue{StringValue: value}}), nil
+	}
+	if len(value) == 0 {
+		return nullScalar(), nil
+	}
+	switch simple {
+	case core.SimpleType_INTEGER:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_Integer{Integer: v}}), nil
+	case core.SimpleType_FLOAT:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_FloatValue{FloatValue: v}}), nil
+	case core.SimpleType_BOOLEAN:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_Boolean{Boolean: v}}), nil
+	case core.SimpleType_DATETIME:
+		for _, layout := range csvDatetimeLayouts {
+			if v, err := time.Parse(layout, value); err == nil {
+				return primitiveScalar(&core.Primitive{Value: &core.Primitive_Datetime{Datetime: timestamppb.New(v)}}), nil
+			}
+		}
+		return nil, fmt.Errorf("invalid datetime [%v]", value)
+	case core.SimpleType_DURATION:
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_Duration{Duration: durationpb.New(v)}}), nil
+	}
+	return nil, fmt.Errorf("%w: values of type [%v]", ErrUnsupported, simple)
+}
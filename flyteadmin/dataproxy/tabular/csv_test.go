@@ -0,0 +1,111 @@
+package tabular
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const testCSV = `id,name,score,created,elapsed,flag
+1,a,0.5,2024-01-02 03:04:05,1s,true
+2,,,2024-01-02T03:04:05Z,,False
+3,"c, d",1.5,2024-01-02,1m,
+`
+
+func getDeclaredColumns() []*core.StructuredDatasetType_DatasetColumn {
+	return []*core.StructuredDatasetType_DatasetColumn{
+		{Name: "id", LiteralType: simpleType(core.SimpleType_INTEGER)},
+		{Name: "score", LiteralType: simpleType(core.SimpleType_FLOAT)},
+		{Name: "created", LiteralType: simpleType(core.SimpleType_DATETIME)},
+		{Name: "elapsed", LiteralType: simpleType(core.SimpleType_DURATION)},
+		{Name: "flag", LiteralType: simpleType(core.SimpleType_BOOLEAN)},
+		{Name: "ignored", LiteralType: &core.LiteralType{Type: &core.LiteralType_CollectionType{
+			CollectionType: simpleType(core.SimpleType_INTEGER),
+		}}},
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	table, err := ReadCSV(strings.NewReader(testCSV), getDeclaredColumns(), Options{Limit: 10, MemoryLimit: 1 << 20})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(testCSV)), table.MemoryUsed)
+
+	assert.Equal(t, []Column{
+		{Name: "id", Type: simpleType(core.SimpleType_INTEGER)},
+		{Name: "name", Type: simpleType(core.SimpleType_STRING)},
+		{Name: "score", Type: simpleType(core.SimpleType_FLOAT)},
+		{Name: "created", Type: simpleType(core.SimpleType_DATETIME)},
+		{Name: "elapsed", Type: simpleType(core.SimpleType_DURATION)},
+		{Name: "flag", Type: simpleType(core.SimpleType_BOOLEAN)},
+	}, table.Columns)
+	assert.False(t, table.Truncated)
+	created := datetimeScalar(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	duration := func(d time.Duration) *core.Scalar {
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_Duration{Duration: durationpb.New(d)}})
+	}
+	boolean := func(v bool) *core.Scalar {
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_Boolean{Boolean: v}})
+	}
+	assert.Equal(t, [][]*core.Scalar{
+		{integer(1), stringScalar("a"), floatScalar(0.5), created, duration(time.Second), boolean(true)},
+		{integer(2), stringScalar(""), nullScalar(), created, nullScalar(), boolean(false)},
+		{integer(3), stringScalar("c, d"), floatScalar(1.5), datetimeScalar(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+			duration(time.Minute), nullScalar()},
+	}, table.Rows)
+}
+
+func TestReadCSV_Projection(t *testing.T) {
+	table, err := ReadCSV(strings.NewReader(testCSV), nil, Options{
+		Limit:       2,
+		Columns:     []string{"score", "id"},
+		MemoryLimit: 1 << 20,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Column{
+		{Name: "score", Type: simpleType(core.SimpleType_STRING)},
+		{Name: "id", Type: simpleType(core.SimpleType_STRING)},
+	}, table.Columns)
+	assert.True(t, table.Truncated)
+	assert.Equal(t, [][]*core.Scalar{
+		{stringScalar("0.5"), stringScalar("1")},
+		{stringScalar(""), stringScalar("2")},
+	}, table.Rows)
+
+	t.Run("unknown column", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader(testCSV), nil, Options{Limit: 1, Columns: []string{"missing"},
+			MemoryLimit: 1 << 20})
+		assert.ErrorIs(t, err, ErrUnknownColumn)
+	})
+}
+
+func TestReadCSV_MemoryLimit(t *testing.T) {
+	header := strings.Index(testCSV, "\n") + 1
+	secondRow := header + strings.Index(testCSV[header:], "2,")
+
+	table, err := ReadCSV(strings.NewReader(testCSV), nil, Options{Limit: 10, MemoryLimit: int64(secondRow + 2)})
+	require.NoError(t, err)
+	assert.True(t, table.Truncated)
+	assert.Len(t, table.Rows, 1)
+
+	_, err = ReadCSV(strings.NewReader(testCSV), nil, Options{Limit: 10, MemoryLimit: int64(header + 2)})
+	assert.ErrorIs(t, err, ErrMemoryLimit)
+}
+
+func TestReadCSV_InvalidValue(t *testing.T) {
+	_, err := ReadCSV(strings.NewReader("id\nnot a number\n"), getDeclaredColumns(), Options{Limit: 10,
+		MemoryLimit: 1 << 20})
+	assert.Error(t, err)
+}
+
+func TestReadCSV_Empty(t *testing.T) {
+	table, err := ReadCSV(strings.NewReader(""), nil, Options{Limit: 10, MemoryLimit: 1 << 20})
+	require.NoError(t, err)
+	assert.Empty(t, table.Columns)
+	assert.Empty(t, table.Rows)
+}
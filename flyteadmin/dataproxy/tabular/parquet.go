@@ -0,0 +1,427 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/deprecated"
+	"github.com/parquet-go/parquet-go/format"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const parquetMagic = "PAR1"
+
+// parquetReadBufferSize is the size of the ranges column chunks are read in.
+const parquetReadBufferSize = 1 << 20
+
+// decodedValueSize is the memory accounted for each decoded value, on top of the pages the values are decoded from.
+const decodedValueSize = 16
+
+const secondsPerDay = 24 * 60 * 60
+
+// julianDayOfUnixEpoch is the julian day of 1970-01-01, which int96 timestamps count days from.
+const julianDayOfUnixEpoch = 2440588
+
+// errPageBudget is returned when the pages of a column don't fit within the memory limit of a read.
+var errPageBudget = errors.New("page exceeds the memory limit")
+
+// parquetColumn is a top-level, non-repeated column of a parquet file.
+type parquetColumn struct {
+	Column
+	// leaf is the index of the column among the leaf columns of the schema, which orders the chunks of row groups.
+	leaf    int
+	convert func(value parquet.Value) *core.Scalar
+}
+
+// ReadParquet reads the column schema and the first rows of a parquet file. The metadata of the file is read from its
+// end, and then only the chunks of the read columns, such that the file is never read as a whole. Only top-level
+// columns which aren't repeated are read, nested and repeated columns are reported as omitted.
+func ReadParquet(r io.ReaderAt, size int64, opts Options) (*Table, error) {
+	if size < int64(2*len(parquetMagic)+4) {
+		return nil, fmt.Errorf("not a parquet file")
+	}
+	var tail [8]byte
+	if _, err := r.ReadAt(tail[:], size-8); err != nil {
+		return nil, err
+	}
+	if string(tail[4:]) != parquetMagic {
+		return nil, fmt.Errorf("not a parquet file")
+	}
+	budget := &memoryBudget{remaining: opts.MemoryLimit}
+	if footerLength := int64(binary.LittleEndian.Uint32(tail[:4])); !budget.reserve(footerLength) {
+		return nil, fmt.Errorf("%w: the metadata of the file holds [%v] bytes", ErrMemoryLimit, footerLength)
+	}
+	file, err := parquet.OpenFile(r, size,
+		parquet.SkipPageIndex(true),
+		parquet.SkipBloomFilters(true),
+		parquet.ReadBufferSize(parquetReadBufferSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the parquet file. Error: %w", err)
+	}
+
+	columns, omitted, err := getParquetColumns(file.Root())
+	if err != nil {
+		return nil, err
+	}
+	tableColumns := make([]Column, 0, len(columns))
+	for _, column := range columns {
+		tableColumns = append(tableColumns, column.Column)
+	}
+	for _, name := range opts.Columns {
+		for _, omittedName := range omitted {
+			if name == omittedName {
+				return nil, fmt.Errorf("%w: column [%v] is nested or repeated", ErrUnsupported, name)
+			}
+		}
+	}
+	indexes, err := project(tableColumns, opts.Columns)
+	if err != nil {
+		return nil, err
+	}
+	table := &Table{Columns: make([]Column, 0, len(indexes))}
+	if len(opts.Columns) == 0 {
+		table.Omitted = omitted
+	}
+	for _, i := range indexes {
+		table.Columns = append(table.Columns, tableColumns[i])
+	}
+	if len(indexes) == 0 {
+		table.Truncated = file.NumRows() > 0
+		table.MemoryUsed = opts.MemoryLimit - budget.remaining
+		return table, nil
+	}
+	// Column chunks are read one at a time, each through a single read buffer.
+	if !budget.reserve(parquetReadBufferSize) {
+		return nil, fmt.Errorf("%w: reading the file takes [%v] bytes", ErrMemoryLimit, parquetReadBufferSize)
+	}
+
+	for _, rowGroup := range file.RowGroups() {
+		remaining := int64(opts.Limit - len(table.Rows))
+		if remaining <= 0 {
+			break
+		}
+		rows := min(rowGroup.NumRows(), remaining)
+		chunks := rowGroup.ColumnChunks()
+		values := make([][]*core.Scalar, 0, len(indexes))
+		exhausted := false
+		for _, i := range indexes {
+			column := columns[i]
+			if column.leaf >= len(chunks) {
+				return nil, fmt.Errorf("row group is missing the chunk of column [%v]", column.Name)
+			}
+			columnValues, err := readColumnChunk(chunks[column.leaf], column, rows, budget)
+			if errors.Is(err, errPageBudget) {
+				exhausted = true
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to read column [%v]. Error: %w", column.Name, err)
+			}
+			rows = min(rows, int64(len(columnValues)))
+			values = append(values, columnValues)
+		}
+		for row := int64(0); row < rows; row++ {
+			tableRow := make([]*core.Scalar, 0, len(values))
+			for _, columnValues := range values {
+				tableRow = append(tableRow, columnValues[row])
+			}
+			table.Rows = append(table.Rows, tableRow)
+		}
+		if exhausted {
+			if len(table.Rows) == 0 {
+				return nil, fmt.Errorf("%w: the first row of the file exceeds [%v] bytes", ErrMemoryLimit,
+					opts.MemoryLimit)
+			}
+			break
+		}
+	}
+	table.Truncated = int64(len(table.Rows)) < file.NumRows()
+	table.MemoryUsed = opts.MemoryLimit - budget.remaining
+	return table, nil
+}
+
+// getParquetColumns returns the top-level, non-repeated columns of the schema of a parquet file, along with the names
+// of the top-level columns which are nested or repeated.
+func getParquetColumns(root *parquet.Column) (columns []*parquetColumn, omitted []string, err error) {
+	for _, child := range root.Columns() {
+		if !child.Leaf() || child.Repeated() {
+			omitted = append(omitted, child.Name())
+			continue
+		}
+		column, err := newParquetColumn(child)
+		if err != nil {
+			return nil, nil, err
+		}
+		columns = append(columns, column)
+	}
+	return columns, omitted, nil
+}
+
+func newParquetColumn(leaf *parquet.Column) (*parquetColumn, error) {
+	column := &parquetColumn{
+		Column: Column{Name: leaf.Name()},
+		leaf:   leaf.Index(),
+	}
+	columnType := leaf.Type()
+	logical := columnType.LogicalType()
+	if logical == nil {
+		logical = &format.LogicalType{}
+	}
+	isConverted := func(types ...deprecated.ConvertedType) bool {
+		if converted := columnType.ConvertedType(); converted != nil {
+			for _, t := range types {
+				if *converted == t {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if logical.Decimal != nil {
+		scale := int64(logical.Decimal.Scale)
+		column.Type = simpleType(core.SimpleType_FLOAT)
+		column.convert = func(value parquet.Value) *core.Scalar {
+			return floatScalar(decimalToFloat(value, scale))
+		}
+		return column, nil
+	}
+
+	switch kind := columnType.Kind(); kind {
+	case parquet.Boolean:
+		column.Type = simpleType(core.SimpleType_BOOLEAN)
+		column.convert = func(value parquet.Value) *core.Scalar {
+			return primitiveScalar(&core.Primitive{Value: &core.Primitive_Boolean{Boolean: value.Boolean()}})
+		}
+	case parquet.Int32, parquet.Int64:
+		switch {
+		case logical.Date != nil || isConverted(deprecated.Date):
+			column.Type = simpleType(core.SimpleType_DATETIME)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				return datetimeScalar(time.Unix(integerValue(value)*secondsPerDay, 0))
+			}
+		case logical.Timestamp != nil || isConverted(deprecated.TimestampMillis, deprecated.TimestampMicros):
+			var unit int64
+			if logical.Timestamp != nil {
+				unit = getTimeUnit(logical.Timestamp.Unit)
+			} else {
+				unit = getConvertedTimeUnit(isConverted(deprecated.TimestampMillis))
+			}
+			column.Type = simpleType(core.SimpleType_DATETIME)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				seconds, nanos := splitTime(integerValue(value), unit)
+				return datetimeScalar(time.Unix(seconds, nanos))
+			}
+		case logical.Time != nil || isConverted(deprecated.TimeMillis, deprecated.TimeMicros):
+			var unit int64
+			if logical.Time != nil {
+				unit = getTimeUnit(logical.Time.Unit)
+			} else {
+				unit = getConvertedTimeUnit(isConverted(deprecated.TimeMillis))
+			}
+			column.Type = simpleType(core.SimpleType_DURATION)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				seconds, nanos := splitTime(integerValue(value), unit)
+				return primitiveScalar(&core.Primitive{Value: &core.Primitive_Duration{
+					Duration: &durationpb.Duration{Seconds: seconds, Nanos: int32(nanos)},
+				}})
+			}
+		default:
+			unsigned := isConverted(deprecated.Uint8, deprecated.Uint16, deprecated.Uint32, deprecated.Uint64)
+			if logical.Integer != nil {
+				unsigned = !logical.Integer.IsSigned
+			}
+			column.Type = simpleType(core.SimpleType_INTEGER)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				v := integerValue(value)
+				if unsigned && value.Kind() == parquet.Int32 {
+					v = int64(uint32(v))
+				}
+				return primitiveScalar(&core.Primitive{Value: &core.Primitive_Integer{Integer: v}})
+			}
+		}
+	case parquet.Int96:
+		column.Type = simpleType(core.SimpleType_DATETIME)
+		column.convert = func(value parquet.Value) *core.Scalar {
+			int96 := value.Int96()
+			nanos := int64(uint64(int96[1])<<32 | uint64(int96[0]))
+			days := int64(int96[2]) - julianDayOfUnixEpoch
+			return datetimeScalar(time.Unix(days*secondsPerDay, nanos))
+		}
+	case parquet.Float:
+		column.Type = simpleType(core.SimpleType_FLOAT)
+		column.convert = func(value parquet.Value) *core.Scalar {
+			return floatScalar(float64(value.Float()))
+		}
+	case parquet.Double:
+		column.Type = simpleType(core.SimpleType_FLOAT)
+		column.convert = func(value parquet.Value) *core.Scalar {
+			return floatScalar(value.Double())
+		}
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		switch {
+		case logical.UTF8 != nil || logical.Enum != nil || logical.Json != nil ||
+			isConverted(deprecated.UTF8, deprecated.Enum, deprecated.Json):
+			column.Type = simpleType(core.SimpleType_STRING)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				return stringScalar(string(value.ByteArray()))
+			}
+		case logical.UUID != nil && columnType.Length() == 16:
+			column.Type = simpleType(core.SimpleType_STRING)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				b := hex.EncodeToString(value.ByteArray())
+				return stringScalar(b[:8] + "-" + b[8:12] + "-" + b[12:16] + "-" + b[16:20] + "-" + b[20:])
+			}
+		case logical.Float16 != nil && columnType.Length() == 2:
+			column.Type = simpleType(core.SimpleType_FLOAT)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				return floatScalar(float16ToFloat(binary.LittleEndian.Uint16(value.ByteArray())))
+			}
+		default:
+			column.Type = simpleType(core.SimpleType_BINARY)
+			column.convert = func(value parquet.Value) *core.Scalar {
+				return &core.Scalar{Value: &core.Scalar_Binary{Binary: &core.Binary{
+					Value: bytes.Clone(value.ByteArray()),
+				}}}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: physical type [%v] of column [%v]", ErrUnsupported, kind, column.Name)
+	}
+	return column, nil
+}
+
+// readColumnChunk reads the first values of the chunk of a column, up to count. It returns the values read so far
+// along with errPageBudget when the next page doesn't fit within the budget.
+func readColumnChunk(chunk parquet.ColumnChunk, column *parquetColumn, count int64, budget *memoryBudget) (
+	[]*core.Scalar, error) {
+	pages := chunk.Pages()
+	defer pages.Close()
+
+	values := make([]*core.Scalar, 0, min(count, 1024))
+	buffer := make([]parquet.Value, min(count, 1024))
+	for int64(len(values)) < count {
+		page, err := pages.ReadPage()
+		if err == io.EOF {
+			return values, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read page. Error: %w", err)
+		}
+		// Pages are accounted for once read, the writers of the file bounding their size.
+		if !budget.reserve(page.Size() + page.NumValues()*decodedValueSize) {
+			return values, errPageBudget
+		}
+		reader := page.Values()
+		for int64(len(values)) < count {
+			n, err := reader.ReadValues(buffer[:min(int64(len(buffer)), count-int64(len(values)))])
+			for _, value := range buffer[:n] {
+				if value.IsNull() {
+					values = append(values, nullScalar())
+				} else {
+					values = append(values, column.convert(value))
+				}
+			}
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to decode page. Error: %w", err)
+			}
+		}
+	}
+	return values, nil
+}
+
+// getTimeUnit returns the number of nanoseconds in the unit of a time or timestamp logical type.
+func getTimeUnit(unit format.TimeUnit) int64 {
+	switch {
+	case unit.Millis != nil:
+		return int64(time.Millisecond)
+	case unit.Nanos != nil:
+		return int64(time.Nanosecond)
+	}
+	return int64(time.Microsecond)
+}
+
+// getConvertedTimeUnit returns the number of nanoseconds in the unit of a time or timestamp converted type, which
+// preceded logical types.
+func getConvertedTimeUnit(millis bool) int64 {
+	if millis {
+		return int64(time.Millisecond)
+	}
+	return int64(time.Microsecond)
+}
+
+// integerValue returns the value of an int32 or int64 column.
+func integerValue(value parquet.Value) int64 {
+	if value.Kind() == parquet.Int32 {
+		return int64(value.Int32())
+	}
+	return value.Int64()
+}
+
+func splitTime(value, unit int64) (seconds, nanos int64) {
+	perSecond := int64(time.Second) / unit
+	seconds, remainder := value/perSecond, value%perSecond
+	if remainder < 0 {
+		seconds, remainder = seconds-1, remainder+perSecond
+	}
+	return seconds, remainder * unit
+}
+
+// decimalToFloat converts an unscaled decimal, stored as an integer or a big-endian two's complement byte array, to
+// the nearest floating point number.
+func decimalToFloat(value parquet.Value, scale int64) float64 {
+	unscaled := new(big.Int)
+	switch value.Kind() {
+	case parquet.Int32, parquet.Int64:
+		unscaled.SetInt64(integerValue(value))
+	default:
+		v := value.ByteArray()
+		unscaled.SetBytes(v)
+		if len(v) > 0 && v[0]&0x80 != 0 {
+			unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(v)*8)))
+		}
+	}
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(unscaled),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(scale), nil))).Float64()
+	return f
+}
+
+func float16ToFloat(bits uint16) float64 {
+	sign := 1.0
+	if bits&0x8000 != 0 {
+		sign = -1
+	}
+	exponent, fraction := int(bits>>10&0x1f), float64(bits&0x3ff)
+	switch exponent {
+	case 0:
+		return sign * math.Ldexp(fraction, -24)
+	case 0x1f:
+		if fraction != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(fraction+1024, exponent-25)
+}
+
+func floatScalar(value float64) *core.Scalar {
+	return primitiveScalar(&core.Primitive{Value: &core.Primitive_FloatValue{FloatValue: value}})
+}
+
+func stringScalar(value string) *core.Scalar {
+	return primitiveScalar(&core.Primitive{Value: &core.Primitive_StringValue{StringValue: value}})
+}
+
+func datetimeScalar(value time.Time) *core.Scalar {
+	return primitiveScalar(&core.Primitive{Value: &core.Primitive_Datetime{Datetime: timestamppb.New(value)}})
+}
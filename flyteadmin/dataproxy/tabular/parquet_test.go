@@ -0,0 +1,184 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+type testNested struct {
+	Value int32 `parquet:"value"`
+}
+
+type testRow struct {
+	ID        int64       `parquet:"id"`
+	Name      *string     `parquet:"name,optional,dict,snappy"`
+	Score     *float64    `parquet:"score,optional,zstd"`
+	Tags      []string    `parquet:"tags"`
+	Nested    *testNested `parquet:"nested,optional"`
+	Timestamp time.Time   `parquet:"timestamp,timestamp(microsecond),gzip"`
+	Flag      bool        `parquet:"flag"`
+	Amount    *int32      `parquet:"amount,optional,decimal(2:4)"`
+}
+
+// writeParquet writes a parquet file holding a row group for each of the slices of rows.
+func writeParquet[T any](t *testing.T, rowGroups ...[]T) []byte {
+	var buf bytes.Buffer
+	writer := parquet.NewGenericWriter[T](&buf)
+	for _, rows := range rowGroups {
+		_, err := writer.Write(rows)
+		require.NoError(t, err)
+		require.NoError(t, writer.Flush())
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
+
+func integer(v int64) *core.Scalar {
+	return primitiveScalar(&core.Primitive{Value: &core.Primitive_Integer{Integer: v}})
+}
+
+func getTestRows() []testRow {
+	return []testRow{
+		{ID: 1, Name: pointer("a"), Score: pointer(0.5), Tags: []string{"x"}, Nested: &testNested{Value: 1},
+			Timestamp: time.UnixMicro(1e15), Flag: true, Amount: pointer[int32](256)},
+		{ID: 2, Name: pointer("b"), Tags: []string{"y"}, Nested: &testNested{Value: 2},
+			Timestamp: time.UnixMicro(1e15 + 1), Amount: pointer[int32](-1)},
+		{ID: 3, Score: pointer(1.5), Tags: []string{"z"}, Timestamp: time.UnixMicro(1e15 + 2), Flag: true},
+		{ID: 4, Name: pointer("a"), Score: pointer(2.5), Tags: []string{"x", "y"}, Nested: &testNested{Value: 4},
+			Timestamp: time.UnixMicro(1e15 + 3), Flag: true, Amount: pointer[int32](1)},
+		{ID: 5, Name: pointer("c"), Timestamp: time.UnixMicro(-1), Amount: pointer[int32](-32768)},
+	}
+}
+
+func TestReadParquet(t *testing.T) {
+	rows := getTestRows()
+	file := writeParquet(t, rows[:3], rows[3:])
+	table, err := ReadParquet(bytes.NewReader(file), int64(len(file)), Options{Limit: 10, MemoryLimit: 4 << 20})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Column{
+		{Name: "id", Type: simpleType(core.SimpleType_INTEGER)},
+		{Name: "name", Type: simpleType(core.SimpleType_STRING)},
+		{Name: "score", Type: simpleType(core.SimpleType_FLOAT)},
+		{Name: "timestamp", Type: simpleType(core.SimpleType_DATETIME)},
+		{Name: "flag", Type: simpleType(core.SimpleType_BOOLEAN)},
+		{Name: "amount", Type: simpleType(core.SimpleType_FLOAT)},
+	}, table.Columns)
+	assert.Equal(t, []string{"tags", "nested"}, table.Omitted)
+	assert.False(t, table.Truncated)
+	boolean := func(v bool) *core.Scalar {
+		return primitiveScalar(&core.Primitive{Value: &core.Primitive_Boolean{Boolean: v}})
+	}
+	datetime := func(micros int64) *core.Scalar {
+		return datetimeScalar(time.UnixMicro(micros))
+	}
+	assert.Equal(t, [][]*core.Scalar{
+		{integer(1), stringScalar("a"), floatScalar(0.5), datetime(1e15), boolean(true), floatScalar(2.56)},
+		{integer(2), stringScalar("b"), nullScalar(), datetime(1e15 + 1), boolean(false), floatScalar(-0.01)},
+		{integer(3), nullScalar(), floatScalar(1.5), datetime(1e15 + 2), boolean(true), nullScalar()},
+		{integer(4), stringScalar("a"), floatScalar(2.5), datetime(1e15 + 3), boolean(true), floatScalar(0.01)},
+		{integer(5), stringScalar("c"), nullScalar(), datetime(-1), boolean(false), floatScalar(-327.68)},
+	}, table.Rows)
+}
+
+func TestReadParquet_Projection(t *testing.T) {
+	rows := getTestRows()
+	file := writeParquet(t, rows[:3], rows[3:])
+	table, err := ReadParquet(bytes.NewReader(file), int64(len(file)), Options{
+		Limit:       4,
+		Columns:     []string{"score", "id"},
+		MemoryLimit: 4 << 20,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Column{
+		{Name: "score", Type: simpleType(core.SimpleType_FLOAT)},
+		{Name: "id", Type: simpleType(core.SimpleType_INTEGER)},
+	}, table.Columns)
+	assert.Empty(t, table.Omitted)
+	assert.True(t, table.Truncated)
+	assert.Equal(t, [][]*core.Scalar{
+		{floatScalar(0.5), integer(1)},
+		{nullScalar(), integer(2)},
+		{floatScalar(1.5), integer(3)},
+		{floatScalar(2.5), integer(4)},
+	}, table.Rows)
+
+	t.Run("nested column", func(t *testing.T) {
+		_, err := ReadParquet(bytes.NewReader(file), int64(len(file)), Options{
+			Limit:       1,
+			Columns:     []string{"nested"},
+			MemoryLimit: 4 << 20,
+		})
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		_, err := ReadParquet(bytes.NewReader(file), int64(len(file)), Options{
+			Limit:       1,
+			Columns:     []string{"missing"},
+			MemoryLimit: 4 << 20,
+		})
+		assert.ErrorIs(t, err, ErrUnknownColumn)
+	})
+}
+
+func TestReadParquet_MemoryLimit(t *testing.T) {
+	type row struct {
+		Value string `parquet:"value"`
+	}
+	value := strings.Repeat("v", 1000)
+	file := writeParquet(t, []row{{value}}, []row{{value}}, []row{{value}})
+	footerLength := int64(binary.LittleEndian.Uint32(file[len(file)-8:]))
+
+	// Each row group holds a single page of a little over 1000 bytes, accounted for along with its decoded value.
+	table, err := ReadParquet(bytes.NewReader(file), int64(len(file)), Options{
+		Limit:       10,
+		MemoryLimit: footerLength + parquetReadBufferSize + 2500,
+	})
+	require.NoError(t, err)
+	assert.True(t, table.Truncated)
+	assert.Equal(t, [][]*core.Scalar{{stringScalar(value)}, {stringScalar(value)}}, table.Rows)
+	assert.Greater(t, table.MemoryUsed, footerLength+parquetReadBufferSize+2000)
+	assert.LessOrEqual(t, table.MemoryUsed, footerLength+parquetReadBufferSize+2500)
+
+	_, err = ReadParquet(bytes.NewReader(file), int64(len(file)), Options{
+		Limit:       10,
+		MemoryLimit: footerLength + parquetReadBufferSize + 1,
+	})
+	assert.ErrorIs(t, err, ErrMemoryLimit)
+
+	_, err = ReadParquet(bytes.NewReader(file), int64(len(file)), Options{
+		Limit:       10,
+		MemoryLimit: footerLength - 1,
+	})
+	assert.ErrorIs(t, err, ErrMemoryLimit)
+}
+
+func TestReadParquet_InvalidFile(t *testing.T) {
+	file := []byte("PAR1 not quite a parquet file")
+	_, err := ReadParquet(bytes.NewReader(file), int64(len(file)), Options{Limit: 1, MemoryLimit: 1 << 20})
+	assert.Error(t, err)
+}
+
+func TestFloat16ToFloat(t *testing.T) {
+	assert.Equal(t, 1.0, float16ToFloat(0x3c00))
+	assert.Equal(t, -2.0, float16ToFloat(0xc000))
+	assert.Equal(t, 65504.0, float16ToFloat(0x7bff))
+	assert.Equal(t, math.Ldexp(1, -24), float16ToFloat(0x0001))
+	assert.True(t, math.IsInf(float16ToFloat(0x7c00), 1))
+	assert.True(t, math.IsNaN(float16ToFloat(0x7e00)))
+}
@@ -0,0 +1,109 @@
+// Package tabular reads the first rows and the column schema of parquet and CSV files, to preview structured datasets
+// without downloading them.
+package tabular
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var (
+	// ErrUnknownColumn is returned when a projected column isn't a top-level column of the file.
+	ErrUnknownColumn = errors.New("unknown column")
+	// ErrUnsupported is returned for files using features the readers don't implement, and for projections of nested or
+	// repeated columns.
+	ErrUnsupported = errors.New("unsupported file")
+	// ErrMemoryLimit is returned when not even a single row fits within the memory limit.
+	ErrMemoryLimit = errors.New("memory limit exceeded")
+)
+
+// Column describes a column of a table.
+type Column struct {
+	Name string
+	Type *core.LiteralType
+}
+
+// Table holds the rows read from a file. Null values are represented by none_type scalars.
+type Table struct {
+	Columns []Column
+	Rows    [][]*core.Scalar
+	// Truncated is set when the file holds more rows than were read.
+	Truncated bool
+	// Omitted names the top-level columns of the file left out of the table, as they're nested or repeated.
+	Omitted []string
+	// MemoryUsed is the part of the memory limit of the options the read took, such that the reads of the files of a
+	// dataset can share a single limit.
+	MemoryUsed int64
+}
+
+// Options bound the rows read from a file.
+type Options struct {
+	// Limit is the maximum number of rows read.
+	Limit int
+	// Columns projects the columns read, in order. All top-level columns are read when empty.
+	Columns []string
+	// MemoryLimit bounds the bytes read into memory.
+	MemoryLimit int64
+}
+
+// DatasetColumns converts the columns of a table to the columns of a structured dataset type.
+func DatasetColumns(columns []Column) []*core.StructuredDatasetType_DatasetColumn {
+	datasetColumns := make([]*core.StructuredDatasetType_DatasetColumn, 0, len(columns))
+	for _, column := range columns {
+		datasetColumns = append(datasetColumns, &core.StructuredDatasetType_DatasetColumn{
+			Name:        column.Name,
+			LiteralType: column.Type,
+		})
+	}
+	return datasetColumns
+}
+
+// project returns the indexes of the projected columns within the columns of a file.
+func project(columns []Column, projection []string) ([]int, error) {
+	indexes := make([]int, 0, len(columns))
+	if len(projection) == 0 {
+		for i := range columns {
+			indexes = append(indexes, i)
+		}
+		return indexes, nil
+	}
+	byName := make(map[string]int, len(columns))
+	for i, column := range columns {
+		byName[column.Name] = i
+	}
+	for _, name := range projection {
+		i, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%w [%v]", ErrUnknownColumn, name)
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes, nil
+}
+
+// memoryBudget tracks the bytes read into memory against the limit of a read.
+type memoryBudget struct {
+	remaining int64
+}
+
+func (b *memoryBudget) reserve(n int64) bool {
+	if n > b.remaining {
+		return false
+	}
+	b.remaining -= n
+	return true
+}
+
+func simpleType(simple core.SimpleType) *core.LiteralType {
+	return &core.LiteralType{Type: &core.LiteralType_Simple{Simple: simple}}
+}
+
+func nullScalar() *core.Scalar {
+	return &core.Scalar{Value: &core.Scalar_NoneType{NoneType: &core.Void{}}}
+}
+
+func primitiveScalar(primitive *core.Primitive) *core.Scalar {
+	return &core.Scalar{Value: &core.Scalar_Primitive{Primitive: primitive}}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/implementations"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	managerInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
@@ -251,6 +252,101 @@ func NewNotificationsProcessor(config runtimeInterfaces.NotificationsConfig, sco
 
 }
 
+func NewStorageEventsProcessor(config runtimeInterfaces.TriggersConfig, triggerManager managerInterfaces.TriggerInterface, scope promutils.Scope) interfaces.Processor {
+
+	reconnectAttempts := config.ReconnectAttempts
+
+	reconnectDelay := time.Duration(config.ReconnectDelaySeconds) * time.Second
+
+	var sub pubsub.Subscriber
+
+	switch config.Type {
+
+	case common.AWS:
+
+		sqsConfig := gizmoAWS.SQSConfig{
+
+			QueueName: config.StorageEventsProcessorConfig.QueueName,
+
+			QueueOwnerAccountID: config.StorageEventsProcessorConfig.AccountID,
+
+			// S3 bucket notifications, whether delivered to SQS directly or through SNS, aren't Base64 encoded.
+
+			ConsumeBase64: &enable64decoding,
+		}
+
+		sqsConfig.Region = config.AWSConfig.Region
+
+		var err error
+
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+
+			sub, err = gizmoAWS.NewSubscriber(sqsConfig)
+
+			if err != nil {
+
+				logger.Warnf(context.TODO(), "Failed to initialize new gizmo aws subscriber with config [%+v] and err: %v", sqsConfig, err)
+
+			}
+
+			return err
+
+		})
+
+		if err != nil {
+
+			panic(err)
+
+		}
+
+		return implementations.NewAwsStorageEventProcessor(sub, triggerManager, scope)
+
+	case common.GCP:
+
+		projectID := config.GCPConfig.ProjectID
+
+		subscription := config.StorageEventsProcessorConfig.QueueName
+
+		var err error
+
+		err = async.Retry(reconnectAttempts, reconnectDelay, func() error {
+
+			sub, err = gizmoGCP.NewSubscriber(context.TODO(), projectID, subscription)
+
+			if err != nil {
+
+				logger.Warnf(context.TODO(), "Failed to initialize new gizmo gcp subscriber with config [ProjectID: %s, Subscription: %s] and err: %v", projectID, subscription, err)
+
+			}
+
+			return err
+
+		})
+
+		if err != nil {
+
+			panic(err)
+
+		}
+
+		return implementations.NewGcpStorageEventProcessor(sub, triggerManager, scope)
+
+	case common.Local:
+
+		fallthrough
+
+	default:
+
+		logger.Infof(context.Background(),
+
+			"Using default noop storage events processor implementation for config type [%s]", config.Type)
+
+		return implementations.NewNoopProcess()
+
+	}
+
+}
+
 func NewNotificationsPublisher(config runtimeInterfaces.NotificationsConfig, scope promutils.Scope) interfaces.Publisher {
 
 	reconnectAttempts := config.ReconnectAttempts
@@ -0,0 +1,218 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NYTimes/gizmo/pubsub"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	managerInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const s3ObjectCreatedEventPrefix = "ObjectCreated:"
+
+// Decodes the object creation events a message from the data store holds.
+type storageEventDecoder func(message []byte) ([]managerInterfaces.StorageEvent, error)
+
+// Fires the storage event triggers of launch plans on the object creation events a data store publishes.
+type StorageEventProcessor struct {
+	sub            pubsub.Subscriber
+	decode         storageEventDecoder
+	triggerManager managerInterfaces.TriggerInterface
+	systemMetrics  processorSystemMetrics
+}
+
+type s3EventNotification struct {
+	Records []struct {
+		EventName string `json:"eventName"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key       string `json:"key"`
+				Size      int64  `json:"size"`
+				ETag      string `json:"eTag"`
+				Sequencer string `json:"sequencer"`
+			} `json:"object"`
+		} `json:"s3"`
+	} `json:"Records"`
+}
+
+// Decodes the S3 event notifications delivered to SQS, either directly or through an SNS topic.
+// The message format is documented here: https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+func decodeS3Events(message []byte) ([]managerInterfaces.StorageEvent, error) {
+	var snsJSONFormat map[string]interface{}
+	if err := json.Unmarshal(message, &snsJSONFormat); err != nil {
+		return nil, err
+	}
+	if value, ok := snsJSONFormat["Message"]; ok {
+		valueString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("sns message holds no notification string")
+		}
+		message = []byte(valueString)
+	}
+
+	var notification s3EventNotification
+	if err := json.Unmarshal(message, &notification); err != nil {
+		return nil, err
+	}
+	// Test events sent upon configuring bucket notifications hold no records.
+	events := make([]managerInterfaces.StorageEvent, 0, len(notification.Records))
+	for _, record := range notification.Records {
+		if !strings.HasPrefix(record.EventName, s3ObjectCreatedEventPrefix) {
+			continue
+		}
+		// Object keys are url encoded in notifications.
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			return nil, err
+		}
+		bucket := record.S3.Bucket.Name
+		events = append(events, managerInterfaces.StorageEvent{
+			ID:     fmt.Sprintf("%s/%s/%s", bucket, key, record.S3.Object.Sequencer),
+			URI:    fmt.Sprintf("s3://%s/%s", bucket, key),
+			Bucket: bucket,
+			Key:    key,
+			Size:   record.S3.Object.Size,
+			ETag:   record.S3.Object.ETag,
+		})
+	}
+	return events, nil
+}
+
+type gcsObject struct {
+	// The bucket, name and generation of the object.
+	ID     string `json:"id"`
+	Bucket string `json:"bucket"`
+	Name   string `json:"name"`
+	Size   string `json:"size"`
+	ETag   string `json:"etag"`
+}
+
+// Decodes the GCS notifications in the JSON_API_V1 payload format delivered to Pub/Sub, which hold the created object.
+// The message format is documented here: https://cloud.google.com/storage/docs/pubsub-notifications
+func decodeGCSEvents(message []byte) ([]managerInterfaces.StorageEvent, error) {
+	var object gcsObject
+	if err := json.Unmarshal(message, &object); err != nil {
+		return nil, err
+	}
+	if object.Bucket == "" || object.Name == "" {
+		return nil, fmt.Errorf("gcs notification holds no object")
+	}
+	var size int64
+	if object.Size != "" {
+		var err error
+		if size, err = strconv.ParseInt(object.Size, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return []managerInterfaces.StorageEvent{
+		{
+			ID:     object.ID,
+			URI:    fmt.Sprintf("gs://%s/%s", object.Bucket, object.Name),
+			Bucket: object.Bucket,
+			Key:    object.Name,
+			Size:   size,
+			ETag:   object.ETag,
+		},
+	}, nil
+}
+
+func (p *StorageEventProcessor) StartProcessing() {
+	for {
+		logger.Warningf(context.Background(), "Starting storage events processor")
+		err := p.run()
+		logger.Errorf(context.Background(), "error with running storage events processor err: [%v] ", err)
+		time.Sleep(async.RetryDelay)
+	}
+}
+
+func (p *StorageEventProcessor) run() error {
+	for msg := range p.sub.Start() {
+		p.systemMetrics.MessageTotal.Inc()
+
+		events, err := p.decode(msg.Message())
+		if err != nil {
+			p.systemMetrics.MessageDecodingError.Inc()
+			logger.Errorf(context.Background(), "failed to decode storage events from message [%s] with err: %v", string(msg.Message()), err)
+			p.markMessageDone(msg)
+			continue
+		}
+
+		failed := false
+		for _, event := range events {
+			if _, err := p.triggerManager.HandleStorageEvent(context.Background(), event); err != nil {
+				failed = true
+				logger.Errorf(context.Background(), "Error firing the triggers of storage event [%+v] with err: %v", event, err)
+			}
+		}
+		if failed {
+			// Leave the message to be delivered again, the triggers already fired dedupe the event.
+			p.systemMetrics.MessageProcessorError.Inc()
+			continue
+		}
+
+		p.systemMetrics.MessageSuccess.Inc()
+		p.markMessageDone(msg)
+	}
+
+	// According to https://github.com/NYTimes/gizmo/blob/f2b3deec03175b11cdfb6642245a49722751357f/pubsub/pubsub.go#L36-L39,
+	// the channel backing the subscriber will just close if there is an error. The call to Err() is needed to identify
+	// there was an error in the channel or there are no more messages left (resulting in no errors when calling Err()).
+	if err := p.sub.Err(); err != nil {
+		p.systemMetrics.ChannelClosedError.Inc()
+		logger.Warningf(context.Background(), "The stream for the subscriber channel closed with err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *StorageEventProcessor) markMessageDone(message pubsub.SubscriberMessage) {
+	if err := message.Done(); err != nil {
+		p.systemMetrics.MessageDoneError.Inc()
+		logger.Errorf(context.Background(), "failed to mark message as Done() in storage events processor with err: %v", err)
+	}
+}
+
+func (p *StorageEventProcessor) StopProcessing() error {
+	// Note: If the underlying channel is already closed, then Stop() will return an error.
+	if err := p.sub.Stop(); err != nil {
+		p.systemMetrics.StopError.Inc()
+		logger.Errorf(context.Background(), "Failed to stop the subscriber channel gracefully with err: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func NewAwsStorageEventProcessor(sub pubsub.Subscriber, triggerManager managerInterfaces.TriggerInterface,
+	scope promutils.Scope) interfaces.Processor {
+	return &StorageEventProcessor{
+		sub:            sub,
+		decode:         decodeS3Events,
+		triggerManager: triggerManager,
+		systemMetrics:  newProcessorSystemMetrics(scope.NewSubScope("storage_event_processor")),
+	}
+}
+
+func NewGcpStorageEventProcessor(sub pubsub.Subscriber, triggerManager managerInterfaces.TriggerInterface,
+	scope promutils.Scope) interfaces.Processor {
+	return &StorageEventProcessor{
+		sub:            sub,
+		decode:         decodeGCSEvents,
+		triggerManager: triggerManager,
+		systemMetrics:  newProcessorSystemMetrics(scope.NewSubScope("gcp_storage_event_processor")),
+	}
+}
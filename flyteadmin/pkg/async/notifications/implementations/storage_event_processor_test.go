@@ -0,0 +1,141 @@
+package implementations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/NYTimes/gizmo/pubsub/pubsubtest"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	managerInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+var testS3Notification = map[string]interface{}{
+	"Records": []interface{}{
+		map[string]interface{}{
+			"eventName": "ObjectCreated:Put",
+			"s3": map[string]interface{}{
+				"bucket": map[string]interface{}{"name": "bucket"},
+				"object": map[string]interface{}{
+					"key":       "prefix/my+data.csv",
+					"size":      42,
+					"eTag":      "etag",
+					"sequencer": "0055AED6DCD90281E5",
+				},
+			},
+		},
+		map[string]interface{}{
+			"eventName": "ObjectRemoved:Delete",
+			"s3": map[string]interface{}{
+				"bucket": map[string]interface{}{"name": "bucket"},
+				"object": map[string]interface{}{"key": "prefix/other.csv"},
+			},
+		},
+	},
+}
+
+func TestDecodeS3Events(t *testing.T) {
+	expected := []managerInterfaces.StorageEvent{
+		{
+			ID:     "bucket/prefix/my data.csv/0055AED6DCD90281E5",
+			URI:    "s3://bucket/prefix/my data.csv",
+			Bucket: "bucket",
+			Key:    "prefix/my data.csv",
+			Size:   42,
+			ETag:   "etag",
+		},
+	}
+	notification, err := json.Marshal(testS3Notification)
+	assert.NoError(t, err)
+
+	events, err := decodeS3Events(notification)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+
+	snsMessage, err := json.Marshal(map[string]interface{}{
+		"Type":    "Notification",
+		"Message": string(notification),
+	})
+	assert.NoError(t, err)
+	events, err = decodeS3Events(snsMessage)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, events)
+
+	events, err = decodeS3Events([]byte(`{"Service":"Amazon S3","Event":"s3:TestEvent"}`))
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestDecodeGCSEvents(t *testing.T) {
+	events, err := decodeGCSEvents([]byte(
+		`{"kind":"storage#object","id":"bucket/prefix/data.csv/1","bucket":"bucket","name":"prefix/data.csv","size":"42","etag":"etag"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []managerInterfaces.StorageEvent{
+		{
+			ID:     "bucket/prefix/data.csv/1",
+			URI:    "gs://bucket/prefix/data.csv",
+			Bucket: "bucket",
+			Key:    "prefix/data.csv",
+			Size:   42,
+			ETag:   "etag",
+		},
+	}, events)
+
+	_, err = decodeGCSEvents([]byte(`{"kind":"storage#object"}`))
+	assert.Error(t, err)
+}
+
+func TestStorageEventProcessor_StartProcessing(t *testing.T) {
+	subscriber := pubsubtest.TestSubscriber{
+		JSONMessages: []interface{}{testS3Notification},
+	}
+	triggerManager := &managerMocks.TriggerInterface{}
+	triggerManager.EXPECT().HandleStorageEvent(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, event managerInterfaces.StorageEvent) ([]*admin.TriggerFiring, error) {
+			assert.Equal(t, "s3://bucket/prefix/my data.csv", event.URI)
+			return nil, nil
+		})
+	processor := NewAwsStorageEventProcessor(&subscriber, triggerManager, promutils.NewTestScope())
+	assert.Nil(t, processor.(*StorageEventProcessor).run())
+
+	m := &dto.Metric{}
+	assert.Nil(t, processor.(*StorageEventProcessor).systemMetrics.MessageSuccess.Write(m))
+	assert.Equal(t, float64(1), m.GetCounter().GetValue())
+	triggerManager.AssertNumberOfCalls(t, "HandleStorageEvent", 1)
+}
+
+func TestStorageEventProcessor_StartProcessingTriggerError(t *testing.T) {
+	subscriber := pubsubtest.TestSubscriber{
+		JSONMessages: []interface{}{testS3Notification},
+	}
+	triggerManager := &managerMocks.TriggerInterface{}
+	triggerManager.EXPECT().HandleStorageEvent(mock.Anything, mock.Anything).Return(nil, errors.New("db unavailable"))
+	processor := NewAwsStorageEventProcessor(&subscriber, triggerManager, promutils.NewTestScope())
+	assert.Nil(t, processor.(*StorageEventProcessor).run())
+
+	m := &dto.Metric{}
+	assert.Nil(t, processor.(*StorageEventProcessor).systemMetrics.MessageProcessorError.Write(m))
+	assert.Equal(t, float64(1), m.GetCounter().GetValue())
+	// The message is left to be delivered again.
+	assert.Nil(t, processor.(*StorageEventProcessor).systemMetrics.MessageSuccess.Write(m))
+	assert.Equal(t, float64(0), m.GetCounter().GetValue())
+}
+
+func TestStorageEventProcessor_StartProcessingDecodingError(t *testing.T) {
+	subscriber := pubsubtest.TestSubscriber{
+		JSONMessages: []interface{}{"not a notification"},
+	}
+	processor := NewGcpStorageEventProcessor(&subscriber, &managerMocks.TriggerInterface{}, promutils.NewTestScope())
+	assert.Nil(t, processor.(*StorageEventProcessor).run())
+
+	m := &dto.Metric{}
+	assert.Nil(t, processor.(*StorageEventProcessor).systemMetrics.MessageDecodingError.Write(m))
+	assert.Equal(t, float64(1), m.GetCounter().GetValue())
+}
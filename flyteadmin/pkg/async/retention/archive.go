@@ -0,0 +1,72 @@
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+const archivesKey = "archives"
+const archiveExtension = ".json.gz"
+
+// GetArchiveLocation returns where the archive of an execution is written to in the metadata bucket.
+func GetArchiveLocation(ctx context.Context, store *storage.DataStore, storagePrefix []string,
+	execution models.ExecutionKey) (storage.DataReference, error) {
+	nestedKeys := append(append([]string{}, storagePrefix...),
+		archivesKey, execution.Project, execution.Domain, execution.Name+archiveExtension)
+	location, err := store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx), nestedKeys...)
+	if err != nil {
+		return "", errors.NewFlyteAdminErrorf(codes.Internal,
+			"failed to construct the archive location of execution [%+v] with err: %v", execution, err)
+	}
+	return location, nil
+}
+
+// WriteArchive writes the rows of an execution to a gzip compressed json archive.
+func WriteArchive(ctx context.Context, store *storage.DataStore, location storage.DataReference,
+	archived models.ArchivedExecution) error {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if err := json.NewEncoder(writer).Encode(archived); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to encode the archive of execution [%+v] with err: %v",
+			archived.Execution.ExecutionKey, err)
+	}
+	if err := writer.Close(); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to compress the archive of execution [%+v] with err: %v",
+			archived.Execution.ExecutionKey, err)
+	}
+	if err := store.WriteRaw(ctx, location, int64(buffer.Len()), storage.Options{}, &buffer); err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to write the archive [%s] with err: %v", location, err)
+	}
+	return nil
+}
+
+// ReadArchive reads the rows of an execution back from its archive.
+func ReadArchive(ctx context.Context, store *storage.DataStore, location storage.DataReference) (
+	models.ArchivedExecution, error) {
+	var archived models.ArchivedExecution
+	reader, err := store.ReadRaw(ctx, location)
+	if err != nil {
+		return archived, errors.NewFlyteAdminErrorf(codes.Internal, "failed to read the archive [%s] with err: %v",
+			location, err)
+	}
+	defer reader.Close()
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return archived, errors.NewFlyteAdminErrorf(codes.Internal, "failed to decompress the archive [%s] with err: %v",
+			location, err)
+	}
+	defer gzipReader.Close()
+	if err := json.NewDecoder(gzipReader).Decode(&archived); err != nil {
+		return archived, errors.NewFlyteAdminErrorf(codes.Internal, "failed to decode the archive [%s] with err: %v",
+			location, err)
+	}
+	return archived, nil
+}
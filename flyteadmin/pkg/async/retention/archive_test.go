@@ -0,0 +1,51 @@
+package retention
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	key := models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"}
+	archived := models.ArchivedExecution{
+		Execution: models.Execution{
+			ExecutionKey: key,
+			Phase:        "SUCCEEDED",
+			Spec:         []byte{1, 2, 3},
+		},
+		NodeExecutions: []models.NodeExecution{
+			{NodeExecutionKey: models.NodeExecutionKey{ExecutionKey: key, NodeID: "n0"}},
+		},
+		ExecutionEvents: []models.ExecutionEvent{
+			{ExecutionKey: key, Phase: "SUCCEEDED"},
+		},
+	}
+
+	location, err := GetArchiveLocation(ctx, store, []string{"metadata", "admin"}, key)
+	assert.NoError(t, err)
+	assert.Contains(t, location.String(), "metadata/admin/archives/project/domain/name.json.gz")
+	assert.NoError(t, WriteArchive(ctx, store, location, archived))
+
+	read, err := ReadArchive(ctx, store, location)
+	assert.NoError(t, err)
+	assert.Equal(t, archived.Execution.Spec, read.Execution.Spec)
+	assert.Equal(t, "n0", read.NodeExecutions[0].NodeID)
+	assert.Len(t, read.ExecutionEvents, 1)
+	assert.Empty(t, read.TaskExecutions)
+}
+
+func TestReadArchive_Missing(t *testing.T) {
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	_, err = ReadArchive(context.Background(), store, "mem://bucket/missing.json.gz")
+	assert.Error(t, err)
+}
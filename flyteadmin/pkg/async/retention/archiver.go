@@ -0,0 +1,198 @@
+package retention
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+type archiverMetrics struct {
+	Scope        promutils.Scope
+	Archived     prometheus.Counter
+	Failed       prometheus.Counter
+	PassDuration promutils.StopWatch
+}
+
+// Archiver moves terminal executions past the retention period of their project and domain out of the database, into
+// compressed archives in the metadata bucket from which GetExecution keeps serving them.
+//
+// Archiving an execution is idempotent, such that replicas archiving executions concurrently, or a pass resuming the
+// archival of an execution an earlier pass was interrupted in, leave a single archive behind.
+type Archiver struct {
+	db            repoInterfaces.Repository
+	store         *storage.DataStore
+	storagePrefix []string
+	config        runtimeInterfaces.ExecutionRetentionConfig
+	domains       runtimeInterfaces.DomainsConfig
+	metrics       archiverMetrics
+}
+
+// Returns the retention period of the executions of a project and domain, zero if they are kept indefinitely.
+func (a *Archiver) getRetentionPeriod(project, domain string) time.Duration {
+	retentionPeriod := a.config.DefaultRetentionPeriod.Duration
+	bestSpecificity := -1
+	for _, policy := range a.config.Policies {
+		if (len(policy.Project) > 0 && policy.Project != project) || (len(policy.Domain) > 0 && policy.Domain != domain) {
+			continue
+		}
+		specificity := 0
+		if len(policy.Project) > 0 {
+			specificity += 2
+		}
+		if len(policy.Domain) > 0 {
+			specificity++
+		}
+		if specificity > bestSpecificity {
+			bestSpecificity = specificity
+			retentionPeriod = policy.RetentionPeriod.Duration
+		}
+	}
+	return retentionPeriod
+}
+
+func (a *Archiver) archiveExecution(ctx context.Context, execution models.Execution) error {
+	_, err := a.db.ExecutionArchiveRepo().Get(ctx, execution.ExecutionKey)
+	if err != nil && !errors.IsDoesNotExistError(err) {
+		return err
+	}
+	// An execution already archived by an interrupted pass is only left to delete.
+	if err != nil {
+		archived, err := a.db.ExecutionArchiveRepo().GetArchivedExecution(ctx, execution)
+		if err != nil {
+			return err
+		}
+		location, err := GetArchiveLocation(ctx, a.store, a.storagePrefix, execution.ExecutionKey)
+		if err != nil {
+			return err
+		}
+		if err := WriteArchive(ctx, a.store, location, archived); err != nil {
+			return err
+		}
+		if err := a.db.ExecutionArchiveRepo().Create(ctx, &models.ExecutionArchive{
+			ExecutionKey:       execution.ExecutionKey,
+			Phase:              execution.Phase,
+			ExecutionUpdatedAt: execution.ExecutionUpdatedAt,
+			Location:           location,
+		}); err != nil {
+			return err
+		}
+	}
+	return a.db.ExecutionArchiveRepo().DeleteArchived(ctx, execution, a.config.BatchSize)
+}
+
+// Archives the executions of a project and domain last updated before the given time, a batch at a time. A failure
+// to archive an execution stops the archival of the project and domain until the next pass.
+func (a *Archiver) archiveProjectDomain(ctx context.Context, project, domain string, updatedBefore time.Time) error {
+	ctx = contextutils.WithProjectDomain(ctx, project, domain)
+	for {
+		executions, err := a.db.ExecutionArchiveRepo().ListArchivable(ctx, repoInterfaces.ListArchivableExecutionsInput{
+			Project:       project,
+			Domain:        domain,
+			Phases:        common.GetTerminalExecutionPhases(),
+			UpdatedBefore: updatedBefore,
+			Limit:         a.config.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, execution := range executions {
+			if err := a.archiveExecution(ctx, execution); err != nil {
+				a.metrics.Failed.Inc()
+				logger.Errorf(ctx, "failed to archive execution [%+v] with err: %v", execution.ExecutionKey, err)
+				return err
+			}
+			a.metrics.Archived.Inc()
+		}
+		if len(executions) < a.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+// Lists the projects in every state: the executions of archived projects are past their retention period all the
+// same, whereas projects are listed without the archived ones by default.
+func (a *Archiver) listProjects(ctx context.Context) ([]models.Project, error) {
+	states := make([]int32, 0, len(admin.Project_ProjectState_value))
+	for _, state := range admin.Project_ProjectState_value {
+		states = append(states, state)
+	}
+	slices.Sort(states)
+	stateFilter, err := common.NewRepeatedValueFilter(common.Project, common.ValueIn, "state", states)
+	if err != nil {
+		return nil, err
+	}
+	return a.db.ProjectRepo().List(ctx, repoInterfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{stateFilter},
+	})
+}
+
+// ArchiveExecutions archives the terminal executions of every project and domain past their retention period.
+func (a *Archiver) ArchiveExecutions(ctx context.Context) error {
+	defer a.metrics.PassDuration.Start().Stop()
+	projects, err := a.listProjects(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var lastErr error
+	for _, project := range projects {
+		for _, domain := range a.domains {
+			retentionPeriod := a.getRetentionPeriod(project.Identifier, domain.ID)
+			if retentionPeriod <= 0 {
+				continue
+			}
+			if err := a.archiveProjectDomain(ctx, project.Identifier, domain.ID, now.Add(-retentionPeriod)); err != nil {
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+// Run archives executions at the configured interval until the context is cancelled.
+func (a *Archiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		if err := a.ArchiveExecutions(ctx); err != nil {
+			logger.Warningf(ctx, "Failed to archive some executions past their retention period with err: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func NewArchiver(db repoInterfaces.Repository, store *storage.DataStore, storagePrefix []string,
+	config runtimeInterfaces.ExecutionRetentionConfig, domains runtimeInterfaces.DomainsConfig,
+	scope promutils.Scope) *Archiver {
+	return &Archiver{
+		db:            db,
+		store:         store,
+		storagePrefix: storagePrefix,
+		config:        config,
+		domains:       domains,
+		metrics: archiverMetrics{
+			Scope:    scope,
+			Archived: scope.MustNewCounter("archived", "count of executions archived out of the database"),
+			Failed:   scope.MustNewCounter("failed", "count of executions which failed to be archived"),
+			PassDuration: scope.MustNewStopWatch("pass_duration",
+				"time taken to archive the executions past their retention period", time.Millisecond),
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	adminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+var retentionConfig = runtimeInterfaces.ExecutionRetentionConfig{
+	BatchSize:              2,
+	DefaultRetentionPeriod: config.Duration{Duration: 30 * 24 * time.Hour},
+	Policies: []runtimeInterfaces.ExecutionRetentionPolicy{
+		{Domain: "development", RetentionPeriod: config.Duration{Duration: 24 * time.Hour}},
+		{Project: "flytesnacks", RetentionPeriod: config.Duration{Duration: 7 * 24 * time.Hour}},
+		{Project: "flytesnacks", Domain: "production"},
+	},
+}
+
+var retentionDomains = runtimeInterfaces.DomainsConfig{
+	{ID: "development", Name: "development"},
+	{ID: "production", Name: "production"},
+}
+
+func TestGetRetentionPeriod(t *testing.T) {
+	archiver := NewArchiver(repositoryMocks.NewMockRepository(), nil, nil, retentionConfig, retentionDomains,
+		promutils.NewTestScope())
+	assert.Equal(t, 30*24*time.Hour, archiver.getRetentionPeriod("other", "production"))
+	assert.Equal(t, 24*time.Hour, archiver.getRetentionPeriod("other", "development"))
+	assert.Equal(t, 7*24*time.Hour, archiver.getRetentionPeriod("flytesnacks", "development"))
+	assert.Equal(t, time.Duration(0), archiver.getRetentionPeriod("flytesnacks", "production"))
+}
+
+func TestArchiveExecutions(t *testing.T) {
+	ctx := context.Background()
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	repository := repositoryMocks.NewMockRepository()
+	repository.ProjectRepo().(*repositoryMocks.MockProjectRepo).ListProjectsFunction = func(
+		ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.Project, error) {
+		// Archived projects are listed too.
+		assert.Len(t, input.InlineFilters, 1)
+		expr, err := input.InlineFilters[0].GetGormQueryExpr()
+		assert.NoError(t, err)
+		assert.Equal(t, "state in (?)", expr.Query)
+		assert.Equal(t, []int32{0, 1, 2, 3}, expr.Args)
+		return []models.Project{{Identifier: "flytesnacks"}}, nil
+	}
+	archived := models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "archived"}
+	interrupted := models.ExecutionKey{Project: "flytesnacks", Domain: "development", Name: "interrupted"}
+	archiveRepo := repository.ExecutionArchiveRepo().(*repositoryMocks.ExecutionArchiveRepoInterface)
+	archiveRepo.EXPECT().ListArchivable(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, input repoInterfaces.ListArchivableExecutionsInput) ([]models.Execution, error) {
+			// flytesnacks/production executions are kept indefinitely.
+			assert.Equal(t, "development", input.Domain)
+			assert.True(t, input.UpdatedBefore.Before(time.Now().Add(-6*24*time.Hour)))
+			assert.Contains(t, input.Phases, "SUCCEEDED")
+			return []models.Execution{{ExecutionKey: archived}, {ExecutionKey: interrupted}}, nil
+		}).Once()
+	archiveRepo.EXPECT().ListArchivable(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	archiveRepo.EXPECT().Get(mock.Anything, archived).Return(models.ExecutionArchive{},
+		adminErrors.NewFlyteAdminError(codes.NotFound, "not archived"))
+	archiveRepo.EXPECT().Get(mock.Anything, interrupted).Return(models.ExecutionArchive{ExecutionKey: interrupted}, nil)
+	archiveRepo.EXPECT().GetArchivedExecution(mock.Anything, models.Execution{ExecutionKey: archived}).Return(
+		models.ArchivedExecution{Execution: models.Execution{ExecutionKey: archived}}, nil)
+	var location storage.DataReference
+	archiveRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, input *models.ExecutionArchive) error {
+			assert.Equal(t, archived, input.ExecutionKey)
+			location = input.Location
+			return nil
+		})
+	archiveRepo.EXPECT().DeleteArchived(mock.Anything, mock.Anything, 2).Return(nil)
+
+	archiver := NewArchiver(repository, store, []string{"metadata"}, retentionConfig, retentionDomains,
+		promutils.NewTestScope())
+	assert.NoError(t, archiver.ArchiveExecutions(ctx))
+	archiveRepo.AssertNumberOfCalls(t, "DeleteArchived", 2)
+	readArchive, err := ReadArchive(ctx, store, location)
+	assert.NoError(t, err)
+	assert.Equal(t, archived, readArchive.Execution.ExecutionKey)
+}
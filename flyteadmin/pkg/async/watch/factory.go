@@ -0,0 +1,33 @@
+package watch
+
+import (
+	"context"
+
+	notificationInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/implementations"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// NewExecutionWatcher returns a watcher notified of the phase changes recorded by the other replicas with postgres
+// LISTEN/NOTIFY, or only polling the database with the other databases.
+func NewExecutionWatcher(ctx context.Context, repo repositoryInterfaces.Repository,
+	config runtimeInterfaces.ExecutionWatchConfig, scope promutils.Scope) interfaces.ExecutionWatcher {
+	var notifier interfaces.Notifier = implementations.PollingNotifier{}
+	if db := repo.GetGormDB(); db != nil && db.Dialector.Name() == "postgres" {
+		notifier = implementations.NewPostgresNotifier(db)
+	} else {
+		logger.Infof(ctx, "Polling the phase changes of executions every [%v]", config.PollInterval.Duration)
+	}
+	return implementations.NewExecutionWatcher(repo, notifier, config, scope)
+}
+
+// NewEventsPublisher returns a publisher recording the phase changes reported by the events for the watcher, before
+// publishing them with the publisher.
+func NewEventsPublisher(publisher notificationInterfaces.Publisher,
+	watcher interfaces.ExecutionWatcher) notificationInterfaces.Publisher {
+	return implementations.NewPublisher(publisher, watcher)
+}
@@ -0,0 +1,462 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const (
+	// Number of phase changes read from the database at once.
+	pageSize = 500
+	// Duration after which an id missing from the phase changes fetched is assumed to belong to an insert that was
+	// rolled back, rather than to one that is yet to be committed.
+	missingIDTimeout = 30 * time.Second
+	// Interval at which the phase changes past their retention are deleted.
+	pruneInterval = time.Hour
+)
+
+// errLagged is returned when a watcher fell too far behind to be sent the phase changes fetched, and has to catch up
+// from the database.
+var errLagged = errors.New("watcher lagged behind")
+
+type watcherMetrics struct {
+	Scope          promutils.Scope
+	Watchers       prometheus.Gauge
+	Recorded       prometheus.Counter
+	Unwatched      prometheus.Counter
+	RecordFailures prometheus.Counter
+	FetchFailures  prometheus.Counter
+	Lagged         prometheus.Counter
+}
+
+func newWatcherMetrics(scope promutils.Scope) watcherMetrics {
+	return watcherMetrics{
+		Scope:          scope,
+		Watchers:       scope.MustNewGauge("watchers", "number of watchers connected"),
+		Recorded:       scope.MustNewCounter("recorded", "number of phase changes recorded"),
+		Unwatched:      scope.MustNewCounter("unwatched", "number of phase changes not recorded as their execution isn't watched"),
+		RecordFailures: scope.MustNewCounter("record_failures", "number of phase changes that failed to be recorded"),
+		FetchFailures:  scope.MustNewCounter("fetch_failures", "number of failures to fetch the phase changes recorded"),
+		Lagged:         scope.MustNewCounter("lagged", "number of times watchers fell behind and caught up from the database"),
+	}
+}
+
+// subscription receives the phase changes of an execution fetched from the database.
+type subscription struct {
+	execution models.ExecutionKey
+	changes   chan models.ExecutionPhaseChange
+}
+
+// ExecutionWatcher records the phase changes of the watched executions in the database, which orders them across the
+// replicas. Each replica fetches the new phase changes once for all its watchers, whenever it is notified of them or,
+// while it can't listen for the notifications, polls the database, and fans them out in-process. Watchers first catch
+// up from the database, so they can resume from a cursor.
+//
+// Whether an execution is watched is looked up in memory, so that recording the phase changes of the executions nobody
+// watches doesn't query the database. The watches are reloaded from the database whenever Run wakes up and at every
+// poll interval, and watching an execution notifies the other replicas, so that they record its phase changes too.
+type ExecutionWatcher struct {
+	repo     repositoryInterfaces.Repository
+	notifier interfaces.Notifier
+	config   runtimeInterfaces.ExecutionWatchConfig
+	metrics  watcherMetrics
+	wake     chan struct{}
+	// Whether the notifier is listening for the phase changes recorded by the other replicas, which are then only
+	// polled for as a fallback.
+	listening atomic.Bool
+
+	mu            sync.Mutex
+	subscriptions map[*subscription]struct{}
+
+	// The executions watched, by the time their watch expires.
+	watchesMu sync.RWMutex
+	watches   map[models.ExecutionKey]time.Time
+
+	// The id of the last phase change fetched, and the ids below it which were not committed yet when it was, by the
+	// time they were found missing. Only accessed by Run.
+	lastID  uint
+	missing map[uint]time.Time
+}
+
+func (w *ExecutionWatcher) Record(ctx context.Context, msg proto.Message) error {
+	execution, change, ok := toPhaseChange(msg)
+	if !ok {
+		return nil
+	}
+	// The phase changes of the executions nobody watches are not recorded.
+	if !w.isWatched(models.ExecutionKey{
+		Project: execution.GetProject(),
+		Domain:  execution.GetDomain(),
+		Name:    execution.GetName(),
+	}, time.Now()) {
+		w.metrics.Unwatched.Inc()
+		return nil
+	}
+	serializedChange, err := proto.Marshal(change)
+	if err != nil {
+		return err
+	}
+	if err := w.repo.ExecutionPhaseChangeRepo().Create(ctx, &models.ExecutionPhaseChange{
+		Project: execution.GetProject(),
+		Domain:  execution.GetDomain(),
+		Name:    execution.GetName(),
+		Change:  serializedChange,
+	}); err != nil {
+		w.metrics.RecordFailures.Inc()
+		return err
+	}
+	w.metrics.Recorded.Inc()
+	w.notified()
+	return w.notifier.Notify(ctx)
+}
+
+// notified wakes up Run to fetch the new phase changes.
+func (w *ExecutionWatcher) notified() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (w *ExecutionWatcher) Run(ctx context.Context) error {
+	lastID, err := w.repo.ExecutionPhaseChangeRepo().GetLatestID(ctx)
+	if err != nil {
+		return err
+	}
+	w.lastID = lastID
+	w.refreshWatches(ctx)
+	go w.listen(ctx)
+
+	poll := time.NewTicker(w.config.PollInterval.Duration)
+	defer poll.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-prune.C:
+			w.prune(ctx)
+			continue
+		case <-w.wake:
+		case <-poll.C:
+			if w.listening.Load() {
+				w.refreshWatches(ctx)
+				continue
+			}
+		}
+		w.refreshWatches(ctx)
+		if err := w.fetch(ctx); err != nil {
+			w.metrics.FetchFailures.Inc()
+			logger.Warnf(ctx, "Failed to fetch the phase changes of executions with err [%v]", err)
+		}
+	}
+}
+
+// listen wakes up Run whenever another replica records phase changes, listening again after failures. The notifier is
+// listening from the first time it notifies, which it does as soon as it starts listening.
+func (w *ExecutionWatcher) listen(ctx context.Context) {
+	for {
+		err := w.notifier.Listen(ctx, func() {
+			w.listening.Store(true)
+			w.notified()
+		})
+		w.listening.Store(false)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warnf(ctx, "Stopped listening for the phase changes of executions with err [%v]", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.config.PollInterval.Duration):
+		}
+	}
+}
+
+// fetch sends the phase changes recorded since the last fetch to the watchers. As the ids are assigned before the
+// inserts are committed, the ids missing below the last one fetched are looked for again until they time out.
+func (w *ExecutionWatcher) fetch(ctx context.Context) error {
+	now := time.Now()
+	afterID := w.lastID
+	for id, missingSince := range w.missing {
+		if now.Sub(missingSince) > missingIDTimeout {
+			delete(w.missing, id)
+		} else if id-1 < afterID {
+			afterID = id - 1
+		}
+	}
+
+	for {
+		changes, err := w.repo.ExecutionPhaseChangeRepo().List(ctx, repositoryInterfaces.ListExecutionPhaseChangesInput{
+			AfterID: afterID,
+			Limit:   pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			afterID = change.ID
+			if change.ID <= w.lastID {
+				if _, ok := w.missing[change.ID]; !ok {
+					continue
+				}
+				delete(w.missing, change.ID)
+			} else {
+				// Larger gaps are sequence jumps rather than concurrent inserts.
+				if change.ID-w.lastID <= pageSize {
+					for id := w.lastID + 1; id < change.ID; id++ {
+						w.missing[id] = now
+					}
+				}
+				w.lastID = change.ID
+			}
+			w.dispatch(change)
+		}
+		if len(changes) < pageSize {
+			return nil
+		}
+	}
+}
+
+func (w *ExecutionWatcher) dispatch(change models.ExecutionPhaseChange) {
+	execution := models.ExecutionKey{Project: change.Project, Domain: change.Domain, Name: change.Name}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for s := range w.subscriptions {
+		if s.execution != execution {
+			continue
+		}
+		select {
+		case s.changes <- change:
+		default:
+			w.metrics.Lagged.Inc()
+			delete(w.subscriptions, s)
+			close(s.changes)
+		}
+	}
+}
+
+func (w *ExecutionWatcher) prune(ctx context.Context) {
+	now := time.Now()
+	if err := w.repo.ExecutionPhaseChangeRepo().DeleteCreatedBefore(ctx, now.Add(-w.config.Retention.Duration)); err != nil {
+		logger.Warnf(ctx, "Failed to delete the phase changes of executions past their retention with err [%v]", err)
+	}
+	if err := w.repo.ExecutionPhaseChangeRepo().DeleteWatchesExpiredBefore(ctx, now); err != nil {
+		logger.Warnf(ctx, "Failed to delete the expired watches of executions with err [%v]", err)
+	}
+}
+
+// watch marks the execution as watched for the retention of the phase changes, so that its watchers can resume
+// within it after they disconnect.
+func (w *ExecutionWatcher) watch(ctx context.Context, execution models.ExecutionKey) error {
+	until := time.Now().Add(w.config.Retention.Duration)
+	if err := w.repo.ExecutionPhaseChangeRepo().Watch(ctx, execution, until); err != nil {
+		return err
+	}
+	w.addWatches(time.Now(), models.ExecutionWatch{
+		Project:   execution.Project,
+		Domain:    execution.Domain,
+		Name:      execution.Name,
+		ExpiresAt: until,
+	})
+	// Wakes up the other replicas, which reload the watches.
+	if err := w.notifier.Notify(ctx); err != nil {
+		logger.Warnf(ctx, "Failed to notify the watch of execution [%v] with err [%v]", execution, err)
+	}
+	return nil
+}
+
+// addWatches extends the watches of the executions to the time they expire, and forgets the watches that expired.
+// Watches are never shortened, so the watches added meanwhile are kept when the watches are reloaded.
+func (w *ExecutionWatcher) addWatches(now time.Time, watches ...models.ExecutionWatch) {
+	w.watchesMu.Lock()
+	defer w.watchesMu.Unlock()
+	for execution, expiresAt := range w.watches {
+		if !expiresAt.After(now) {
+			delete(w.watches, execution)
+		}
+	}
+	for _, watch := range watches {
+		execution := models.ExecutionKey{Project: watch.Project, Domain: watch.Domain, Name: watch.Name}
+		if watch.ExpiresAt.After(w.watches[execution]) {
+			w.watches[execution] = watch.ExpiresAt
+		}
+	}
+}
+
+// refreshWatches reloads the watches from the database, to learn of the executions watched through other replicas.
+func (w *ExecutionWatcher) refreshWatches(ctx context.Context) {
+	now := time.Now()
+	watches, err := w.repo.ExecutionPhaseChangeRepo().ListWatches(ctx, now)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to load the watches of executions with err [%v]", err)
+		return
+	}
+	w.addWatches(now, watches...)
+}
+
+func (w *ExecutionWatcher) isWatched(execution models.ExecutionKey, at time.Time) bool {
+	w.watchesMu.RLock()
+	defer w.watchesMu.RUnlock()
+	return w.watches[execution].After(at)
+}
+
+func (w *ExecutionWatcher) subscribe(execution models.ExecutionKey) *subscription {
+	s := &subscription{
+		execution: execution,
+		changes:   make(chan models.ExecutionPhaseChange, w.config.BufferSize),
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscriptions[s] = struct{}{}
+	return s
+}
+
+func (w *ExecutionWatcher) unsubscribe(s *subscription) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.subscriptions, s)
+}
+
+// watchStream sends the phase changes of an execution once each, in the order they are presented.
+type watchStream struct {
+	cursor uint
+	filter *subtreeFilter
+	seen   map[uint]struct{}
+	send   func(*admin.WatchExecutionResponse) error
+}
+
+func (s *watchStream) process(ctx context.Context, change models.ExecutionPhaseChange) error {
+	if _, ok := s.seen[change.ID]; ok {
+		return nil
+	}
+	s.seen[change.ID] = struct{}{}
+
+	response := &admin.WatchExecutionResponse{}
+	if err := proto.Unmarshal(change.Change, response); err != nil {
+		logger.Warnf(ctx, "Failed to unmarshal phase change [%d] with err [%v]", change.ID, err)
+		return nil
+	}
+	// The phase changes up to the cursor are still presented to the filter, to discover the nodes of the subtree.
+	if !s.filter.accept(response) || change.ID <= s.cursor {
+		return nil
+	}
+	response.Cursor = formatCursor(change.ID)
+	return s.send(response)
+}
+
+func (w *ExecutionWatcher) Watch(ctx context.Context, request *admin.WatchExecutionRequest,
+	send func(*admin.WatchExecutionResponse) error) error {
+	cursor, err := parseCursor(request.GetCursor())
+	if err != nil {
+		return err
+	}
+	execution := models.ExecutionKey{
+		Project: request.GetId().GetProject(),
+		Domain:  request.GetId().GetDomain(),
+		Name:    request.GetId().GetName(),
+	}
+	stream := &watchStream{
+		cursor: cursor,
+		filter: newSubtreeFilter(request.GetNodeId()),
+		seen:   map[uint]struct{}{},
+		send:   send,
+	}
+
+	if err := w.watch(ctx, execution); err != nil {
+		return err
+	}
+	w.metrics.Watchers.Inc()
+	defer w.metrics.Watchers.Dec()
+	for {
+		// Subscribing before catching up guarantees that no phase change falls in between.
+		s := w.subscribe(execution)
+		err := w.catchUp(ctx, execution, stream)
+		if err == nil {
+			err = w.follow(ctx, s, stream)
+		}
+		w.unsubscribe(s)
+		if !errors.Is(err, errLagged) {
+			return err
+		}
+	}
+}
+
+// catchUp sends the phase changes of the execution already persisted.
+func (w *ExecutionWatcher) catchUp(ctx context.Context, execution models.ExecutionKey, stream *watchStream) error {
+	var afterID uint
+	if stream.filter == nil {
+		afterID = stream.cursor
+	}
+	for {
+		changes, err := w.repo.ExecutionPhaseChangeRepo().List(ctx, repositoryInterfaces.ListExecutionPhaseChangesInput{
+			Execution: &execution,
+			AfterID:   afterID,
+			Limit:     pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			afterID = change.ID
+			if err := stream.process(ctx, change); err != nil {
+				return err
+			}
+		}
+		if len(changes) < pageSize {
+			return nil
+		}
+	}
+}
+
+// follow sends the phase changes of the execution fetched by Run, renewing its watch meanwhile.
+func (w *ExecutionWatcher) follow(ctx context.Context, s *subscription, stream *watchStream) error {
+	renew := time.NewTicker(w.config.Retention.Duration / 2)
+	defer renew.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-renew.C:
+			if err := w.watch(ctx, s.execution); err != nil {
+				logger.Warnf(ctx, "Failed to renew the watch of execution [%v] with err [%v]", s.execution, err)
+			}
+		case change, ok := <-s.changes:
+			if !ok {
+				return errLagged
+			}
+			if err := stream.process(ctx, change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func NewExecutionWatcher(repo repositoryInterfaces.Repository, notifier interfaces.Notifier,
+	config runtimeInterfaces.ExecutionWatchConfig, scope promutils.Scope) *ExecutionWatcher {
+	return &ExecutionWatcher{
+		repo:          repo,
+		notifier:      notifier,
+		config:        config,
+		metrics:       newWatcherMetrics(scope),
+		wake:          make(chan struct{}, 1),
+		subscriptions: map[*subscription]struct{}{},
+		watches:       map[models.ExecutionKey]time.Time{},
+		missing:       map[uint]time.Time{},
+	}
+}
@@ -0,0 +1,368 @@
+package implementations
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories"
+	repoErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+var executionID = &core.WorkflowExecutionIdentifier{Project: "project", Domain: "development", Name: "name"}
+
+func newTestRepo(t *testing.T) repositoryInterfaces.Repository {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "admin.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	// The sqlite driver declares auto incremented primary keys twice when auto migrating.
+	require.NoError(t, db.Exec("CREATE TABLE execution_phase_changes (id integer PRIMARY KEY AUTOINCREMENT, "+
+		"created_at datetime, execution_project text, execution_domain text, execution_name text, change blob)").Error)
+	require.NoError(t, db.AutoMigrate(&models.ExecutionWatch{}))
+	return repositories.NewGormRepo(db, repoErrors.NewTestErrorTransformer(), promutils.NewTestScope())
+}
+
+// watched marks the execution as watched, so that its phase changes are recorded.
+func watched(t *testing.T, w *ExecutionWatcher, execution *core.WorkflowExecutionIdentifier) {
+	require.NoError(t, w.watch(context.Background(), models.ExecutionKey{
+		Project: execution.GetProject(),
+		Domain:  execution.GetDomain(),
+		Name:    execution.GetName(),
+	}))
+}
+
+func newTestWatcher(repo repositoryInterfaces.Repository, bufferSize int) *ExecutionWatcher {
+	return NewExecutionWatcher(repo, PollingNotifier{}, runtimeInterfaces.ExecutionWatchConfig{
+		PollInterval: config.Duration{Duration: 10 * time.Millisecond},
+		Retention:    config.Duration{Duration: time.Hour},
+		BufferSize:   bufferSize,
+	}, promutils.NewTestScope())
+}
+
+func workflowEvent(execution *core.WorkflowExecutionIdentifier, phase core.WorkflowExecution_Phase) *admin.WorkflowExecutionEventRequest {
+	return &admin.WorkflowExecutionEventRequest{Event: &event.WorkflowExecutionEvent{
+		ExecutionId: execution,
+		Phase:       phase,
+	}}
+}
+
+func nodeEvent(nodeID, parentNodeID string, phase core.NodeExecution_Phase) *admin.NodeExecutionEventRequest {
+	request := &admin.NodeExecutionEventRequest{Event: &event.NodeExecutionEvent{
+		Id:    &core.NodeExecutionIdentifier{NodeId: nodeID, ExecutionId: executionID},
+		Phase: phase,
+	}}
+	if len(parentNodeID) > 0 {
+		request.Event.ParentNodeMetadata = &event.ParentNodeExecutionMetadata{NodeId: parentNodeID}
+	}
+	return request
+}
+
+func taskEvent(nodeID string, phase core.TaskExecution_Phase) *admin.TaskExecutionEventRequest {
+	return &admin.TaskExecutionEventRequest{Event: &event.TaskExecutionEvent{
+		TaskId:                &core.Identifier{ResourceType: core.ResourceType_TASK, Name: "task"},
+		ParentNodeExecutionId: &core.NodeExecutionIdentifier{NodeId: nodeID, ExecutionId: executionID},
+		Phase:                 phase,
+		PhaseVersion:          1,
+	}}
+}
+
+// watch watches the execution in the background, returning the responses sent and a func stopping the watch and
+// returning its error.
+func watch(t *testing.T, w *ExecutionWatcher, request *admin.WatchExecutionRequest) (<-chan *admin.WatchExecutionResponse, func() error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	responses := make(chan *admin.WatchExecutionResponse, 100)
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Watch(ctx, request, func(response *admin.WatchExecutionResponse) error {
+			responses <- response
+			return nil
+		})
+	}()
+	return responses, func() error {
+		cancel()
+		select {
+		case err := <-done:
+			return err
+		case <-time.After(5 * time.Second):
+			t.Fatal("watch did not stop")
+			return nil
+		}
+	}
+}
+
+func receive(t *testing.T, responses <-chan *admin.WatchExecutionResponse, n int) []*admin.WatchExecutionResponse {
+	var received []*admin.WatchExecutionResponse
+	for len(received) < n {
+		select {
+		case response := <-responses:
+			received = append(received, response)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d phase changes, expected %d", len(received), n)
+		}
+	}
+	select {
+	case response := <-responses:
+		t.Fatalf("unexpected phase change [%v]", response)
+	case <-time.After(50 * time.Millisecond):
+	}
+	return received
+}
+
+func TestExecutionWatcher_Watch(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 10)
+	runCtx, stopRun := context.WithCancel(ctx)
+	defer stopRun()
+	go func() {
+		assert.NoError(t, w.Run(runCtx))
+	}()
+
+	watched(t, w, executionID)
+	require.NoError(t, w.Record(ctx, workflowEvent(executionID, core.WorkflowExecution_RUNNING)))
+	require.NoError(t, w.Record(ctx, nodeEvent("n0", "", core.NodeExecution_RUNNING)))
+	// Other executions, which aren't watched, and messages are not recorded.
+	require.NoError(t, w.Record(ctx, workflowEvent(&core.WorkflowExecutionIdentifier{Project: "project", Domain: "development", Name: "other"}, core.WorkflowExecution_RUNNING)))
+	require.NoError(t, w.Record(ctx, &admin.WorkflowExecutionEventResponse{}))
+
+	responses, stop := watch(t, w, &admin.WatchExecutionRequest{Id: executionID})
+	caughtUp := receive(t, responses, 2)
+	assert.Equal(t, core.WorkflowExecution_RUNNING, caughtUp[0].GetWorkflowExecution().GetPhase())
+	assert.True(t, proto.Equal(executionID, caughtUp[0].GetWorkflowExecution().GetId()))
+	assert.Equal(t, "n0", caughtUp[1].GetNodeExecution().GetId().GetNodeId())
+
+	require.NoError(t, w.Record(ctx, taskEvent("n0", core.TaskExecution_RUNNING)))
+	require.NoError(t, w.Record(ctx, workflowEvent(executionID, core.WorkflowExecution_SUCCEEDED)))
+	live := receive(t, responses, 2)
+	assert.Equal(t, "n0", live[0].GetTaskExecution().GetId().GetNodeExecutionId().GetNodeId())
+	assert.Equal(t, uint32(1), live[0].GetTaskExecution().GetPhaseVersion())
+	assert.Equal(t, core.WorkflowExecution_SUCCEEDED, live[1].GetWorkflowExecution().GetPhase())
+	assert.NoError(t, stop())
+
+	t.Run("resume from cursor", func(t *testing.T) {
+		responses, stop := watch(t, w, &admin.WatchExecutionRequest{Id: executionID, Cursor: caughtUp[1].GetCursor()})
+		resumed := receive(t, responses, 2)
+		assert.Equal(t, live[0].GetCursor(), resumed[0].GetCursor())
+		assert.Equal(t, live[1].GetCursor(), resumed[1].GetCursor())
+		assert.NoError(t, stop())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		err := w.Watch(ctx, &admin.WatchExecutionRequest{Id: executionID, Cursor: "abc"}, nil)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+}
+
+func TestExecutionWatcher_Watch_Subtree(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 10)
+	watched(t, w, executionID)
+	for _, request := range []proto.Message{
+		workflowEvent(executionID, core.WorkflowExecution_RUNNING),
+		nodeEvent("n0", "", core.NodeExecution_RUNNING),
+		nodeEvent("n1", "", core.NodeExecution_RUNNING),
+		nodeEvent("n1-0-n0", "n1", core.NodeExecution_RUNNING),
+		nodeEvent("n1-0-n0-0-n0", "n1-0-n0", core.NodeExecution_RUNNING),
+		taskEvent("n1-0-n0-0-n0", core.TaskExecution_RUNNING),
+		taskEvent("n0", core.TaskExecution_RUNNING),
+		nodeEvent("n1", "", core.NodeExecution_SUCCEEDED),
+	} {
+		require.NoError(t, w.Record(ctx, request))
+	}
+
+	responses, stop := watch(t, w, &admin.WatchExecutionRequest{Id: executionID, NodeId: "n1"})
+	received := receive(t, responses, 5)
+	assert.NoError(t, stop())
+	assert.Equal(t, "n1", received[0].GetNodeExecution().GetId().GetNodeId())
+	assert.Equal(t, "n1-0-n0", received[1].GetNodeExecution().GetId().GetNodeId())
+	assert.Equal(t, "n1-0-n0-0-n0", received[2].GetNodeExecution().GetId().GetNodeId())
+	assert.Equal(t, "n1-0-n0-0-n0", received[3].GetTaskExecution().GetId().GetNodeExecutionId().GetNodeId())
+	assert.Equal(t, core.NodeExecution_SUCCEEDED, received[4].GetNodeExecution().GetPhase())
+
+	// Resuming discovers the nested nodes from the phase changes before the cursor.
+	responses, stop = watch(t, w, &admin.WatchExecutionRequest{Id: executionID, NodeId: "n1", Cursor: received[1].GetCursor()})
+	resumed := receive(t, responses, 3)
+	assert.NoError(t, stop())
+	assert.Equal(t, received[2].GetCursor(), resumed[0].GetCursor())
+}
+
+func TestExecutionWatcher_Lagged(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 1)
+	execution := models.ExecutionKey{Project: executionID.GetProject(), Domain: executionID.GetDomain(), Name: executionID.GetName()}
+	s := w.subscribe(execution)
+
+	w.dispatch(models.ExecutionPhaseChange{ID: 1, Project: execution.Project, Domain: execution.Domain, Name: execution.Name})
+	w.dispatch(models.ExecutionPhaseChange{ID: 2, Project: execution.Project, Domain: execution.Domain, Name: execution.Name})
+	change, ok := <-s.changes
+	assert.True(t, ok)
+	assert.Equal(t, uint(1), change.ID)
+	_, ok = <-s.changes
+	assert.False(t, ok, "lagging subscriptions are closed")
+	assert.Empty(t, w.subscriptions)
+
+	// The watchers catch up from the database.
+	watched(t, w, executionID)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.Record(ctx, nodeEvent("n0", "", core.NodeExecution_RUNNING)))
+	}
+	responses, stop := watch(t, w, &admin.WatchExecutionRequest{Id: executionID})
+	receive(t, responses, 3)
+	assert.NoError(t, stop())
+}
+
+func TestExecutionWatcher_Fetch_MissingIDs(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 10)
+	s := w.subscribe(models.ExecutionKey{Project: "p", Domain: "d", Name: "n"})
+
+	// The insert of the phase change 2 commits after the one of 3.
+	for _, id := range []uint{1, 3} {
+		require.NoError(t, repo.ExecutionPhaseChangeRepo().Create(ctx, &models.ExecutionPhaseChange{ID: id, Project: "p", Domain: "d", Name: "n"}))
+	}
+	require.NoError(t, w.fetch(ctx))
+	assert.Equal(t, uint(1), (<-s.changes).ID)
+	assert.Equal(t, uint(3), (<-s.changes).ID)
+	assert.Contains(t, w.missing, uint(2))
+
+	require.NoError(t, repo.ExecutionPhaseChangeRepo().Create(ctx, &models.ExecutionPhaseChange{ID: 2, Project: "p", Domain: "d", Name: "n"}))
+	require.NoError(t, w.fetch(ctx))
+	assert.Equal(t, uint(2), (<-s.changes).ID)
+	assert.Empty(t, w.missing)
+	assert.Empty(t, s.changes)
+
+	// Missing ids are given up on after a while.
+	w.missing[4] = time.Now().Add(-2 * missingIDTimeout)
+	require.NoError(t, w.fetch(ctx))
+	assert.Empty(t, w.missing)
+}
+
+func TestExecutionWatcher_Prune(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 10)
+	require.NoError(t, repo.ExecutionPhaseChangeRepo().Create(ctx, &models.ExecutionPhaseChange{CreatedAt: time.Now().Add(-2 * time.Hour)}))
+	require.NoError(t, repo.ExecutionPhaseChangeRepo().Create(ctx, &models.ExecutionPhaseChange{}))
+
+	w.prune(ctx)
+	changes, err := repo.ExecutionPhaseChangeRepo().List(ctx, repositoryInterfaces.ListExecutionPhaseChangesInput{Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, uint(2), changes[0].ID)
+	latestID, err := repo.ExecutionPhaseChangeRepo().GetLatestID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), latestID)
+}
+
+func TestExecutionWatcher_Record_Unwatched(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 10)
+	require.NoError(t, w.Record(ctx, workflowEvent(executionID, core.WorkflowExecution_RUNNING)))
+	latestID, err := repo.ExecutionPhaseChangeRepo().GetLatestID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), latestID)
+
+	// Watching the execution records its next phase changes.
+	responses, stop := watch(t, w, &admin.WatchExecutionRequest{Id: executionID})
+	assert.Eventually(t, func() bool {
+		return w.isWatched(models.ExecutionKey{
+			Project: executionID.GetProject(),
+			Domain:  executionID.GetDomain(),
+			Name:    executionID.GetName(),
+		}, time.Now())
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, w.Record(ctx, workflowEvent(executionID, core.WorkflowExecution_SUCCEEDED)))
+	require.NoError(t, w.fetch(ctx))
+	received := receive(t, responses, 1)
+	assert.NoError(t, stop())
+	assert.Equal(t, core.WorkflowExecution_SUCCEEDED, received[0].GetWorkflowExecution().GetPhase())
+}
+
+func TestExecutionWatcher_RefreshWatches(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	w := newTestWatcher(repo, 10)
+	execution := models.ExecutionKey{Project: executionID.GetProject(), Domain: executionID.GetDomain(), Name: executionID.GetName()}
+	other := models.ExecutionKey{Project: "project", Domain: "development", Name: "other"}
+
+	// Watched through another replica.
+	require.NoError(t, repo.ExecutionPhaseChangeRepo().Watch(ctx, execution, time.Now().Add(time.Hour)))
+	require.NoError(t, repo.ExecutionPhaseChangeRepo().Watch(ctx, other, time.Now().Add(-time.Minute)))
+	assert.False(t, w.isWatched(execution, time.Now()))
+	require.NoError(t, w.Record(ctx, workflowEvent(executionID, core.WorkflowExecution_RUNNING)))
+	latestID, err := repo.ExecutionPhaseChangeRepo().GetLatestID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), latestID)
+
+	w.refreshWatches(ctx)
+	assert.True(t, w.isWatched(execution, time.Now()))
+	assert.False(t, w.isWatched(other, time.Now()))
+	assert.False(t, w.isWatched(execution, time.Now().Add(2*time.Hour)))
+	require.NoError(t, w.Record(ctx, workflowEvent(executionID, core.WorkflowExecution_RUNNING)))
+	latestID, err = repo.ExecutionPhaseChangeRepo().GetLatestID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), latestID)
+
+	// Reloading doesn't shorten the watches added meanwhile.
+	w.addWatches(time.Now(), models.ExecutionWatch{Project: other.Project, Domain: other.Domain, Name: other.Name,
+		ExpiresAt: time.Now().Add(time.Hour)})
+	w.refreshWatches(ctx)
+	assert.True(t, w.isWatched(other, time.Now()))
+}
+
+func TestExecutionWatcher_Listen(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := newTestRepo(t)
+	notifier := &listeningNotifier{listening: make(chan func(), 1)}
+	w := NewExecutionWatcher(repo, notifier, runtimeInterfaces.ExecutionWatchConfig{
+		PollInterval: config.Duration{Duration: 10 * time.Millisecond},
+		Retention:    config.Duration{Duration: time.Hour},
+		BufferSize:   10,
+	}, promutils.NewTestScope())
+	go func() {
+		assert.NoError(t, w.Run(ctx))
+	}()
+
+	notified := <-notifier.listening
+	notified()
+	assert.Eventually(t, w.listening.Load, 5*time.Second, 10*time.Millisecond)
+}
+
+// listeningNotifier listens until the context is canceled, handing out the func notifying its listener.
+type listeningNotifier struct {
+	listening chan func()
+}
+
+func (n *listeningNotifier) Notify(context.Context) error {
+	return nil
+}
+
+func (n *listeningNotifier) Listen(ctx context.Context, notified func()) error {
+	n.listening <- notified
+	<-ctx.Done()
+	return nil
+}
@@ -0,0 +1,109 @@
+package implementations
+
+import (
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// toPhaseChange returns the execution and the phase change reported by a workflow, node or task execution event
+// request, or false for any other message.
+func toPhaseChange(msg proto.Message) (*core.WorkflowExecutionIdentifier, *admin.WatchExecutionResponse, bool) {
+	switch request := msg.(type) {
+	case *admin.WorkflowExecutionEventRequest:
+		event := request.GetEvent()
+		return event.GetExecutionId(), &admin.WatchExecutionResponse{
+			OccurredAt: event.GetOccurredAt(),
+			Change: &admin.WatchExecutionResponse_WorkflowExecution{
+				WorkflowExecution: &admin.WorkflowExecutionPhaseChange{
+					Id:    event.GetExecutionId(),
+					Phase: event.GetPhase(),
+				},
+			},
+		}, true
+	case *admin.NodeExecutionEventRequest:
+		event := request.GetEvent()
+		return event.GetId().GetExecutionId(), &admin.WatchExecutionResponse{
+			OccurredAt: event.GetOccurredAt(),
+			Change: &admin.WatchExecutionResponse_NodeExecution{
+				NodeExecution: &admin.NodeExecutionPhaseChange{
+					Id:           event.GetId(),
+					Phase:        event.GetPhase(),
+					ParentNodeId: event.GetParentNodeMetadata().GetNodeId(),
+				},
+			},
+		}, true
+	case *admin.TaskExecutionEventRequest:
+		event := request.GetEvent()
+		return event.GetParentNodeExecutionId().GetExecutionId(), &admin.WatchExecutionResponse{
+			OccurredAt: event.GetOccurredAt(),
+			Change: &admin.WatchExecutionResponse_TaskExecution{
+				TaskExecution: &admin.TaskExecutionPhaseChange{
+					Id: &core.TaskExecutionIdentifier{
+						TaskId:          event.GetTaskId(),
+						NodeExecutionId: event.GetParentNodeExecutionId(),
+						RetryAttempt:    event.GetRetryAttempt(),
+					},
+					Phase:        event.GetPhase(),
+					PhaseVersion: event.GetPhaseVersion(),
+				},
+			},
+		}, true
+	}
+	return nil, nil, false
+}
+
+// subtreeFilter accepts the phase changes of a node, of the nodes nested in it and of their tasks. The nodes nested in
+// it are discovered from the phase changes it accepts, which must be presented in the order they were recorded. A nil
+// filter accepts all the phase changes.
+type subtreeFilter struct {
+	nodes map[string]struct{}
+}
+
+func newSubtreeFilter(nodeID string) *subtreeFilter {
+	if len(nodeID) == 0 {
+		return nil
+	}
+	return &subtreeFilter{nodes: map[string]struct{}{nodeID: {}}}
+}
+
+func (f *subtreeFilter) accept(response *admin.WatchExecutionResponse) bool {
+	if f == nil {
+		return true
+	}
+	switch change := response.GetChange().(type) {
+	case *admin.WatchExecutionResponse_NodeExecution:
+		nodeID := change.NodeExecution.GetId().GetNodeId()
+		if _, ok := f.nodes[nodeID]; ok {
+			return true
+		}
+		if _, ok := f.nodes[change.NodeExecution.GetParentNodeId()]; ok {
+			f.nodes[nodeID] = struct{}{}
+			return true
+		}
+	case *admin.WatchExecutionResponse_TaskExecution:
+		_, ok := f.nodes[change.TaskExecution.GetId().GetNodeExecutionId().GetNodeId()]
+		return ok
+	}
+	return false
+}
+
+func formatCursor(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+func parseCursor(cursor string) (uint, error) {
+	if len(cursor) == 0 {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return 0, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid cursor [%s]", cursor)
+	}
+	return uint(id), nil
+}
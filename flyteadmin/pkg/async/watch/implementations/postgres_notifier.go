@@ -0,0 +1,55 @@
+package implementations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+const notificationChannel = "flyte_execution_phase_changes"
+
+// PostgresNotifier notifies the replicas of the phase changes recorded with postgres LISTEN/NOTIFY.
+type PostgresNotifier struct {
+	db *gorm.DB
+}
+
+func (n *PostgresNotifier) Notify(ctx context.Context) error {
+	return n.db.WithContext(ctx).Exec("NOTIFY " + notificationChannel).Error
+}
+
+func (n *PostgresNotifier) Listen(ctx context.Context, notified func()) error {
+	sqlDB, err := n.db.DB()
+	if err != nil {
+		return err
+	}
+	// Listening holds a connection of the pool for as long as it lasts.
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		pgConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected postgres driver connection [%T]", driverConn)
+		}
+		if _, err := pgConn.Conn().Exec(ctx, "LISTEN "+notificationChannel); err != nil {
+			return err
+		}
+		// Phase changes may have been recorded while not listening.
+		notified()
+		for {
+			if _, err := pgConn.Conn().WaitForNotification(ctx); err != nil {
+				return err
+			}
+			notified()
+		}
+	})
+}
+
+func NewPostgresNotifier(db *gorm.DB) *PostgresNotifier {
+	return &PostgresNotifier{db: db}
+}
@@ -0,0 +1,34 @@
+package implementations
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	notificationInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// Publisher records the phase changes reported by the events published, once persisted, for the watchers of their
+// executions.
+type Publisher struct {
+	notificationInterfaces.Publisher
+	watcher interfaces.ExecutionWatcher
+}
+
+func (p *Publisher) Publish(ctx context.Context, notificationType string, msg proto.Message) error {
+	// Failing to record the phase change for the watchers must not fail publishing the event.
+	if err := p.watcher.Record(ctx, msg); err != nil {
+		logger.Warnf(ctx, "Failed to record the phase change of [%s] for the watchers of its execution with err [%v]",
+			notificationType, err)
+	}
+	return p.Publisher.Publish(ctx, notificationType, msg)
+}
+
+func NewPublisher(publisher notificationInterfaces.Publisher, watcher interfaces.ExecutionWatcher) *Publisher {
+	return &Publisher{
+		Publisher: publisher,
+		watcher:   watcher,
+	}
+}
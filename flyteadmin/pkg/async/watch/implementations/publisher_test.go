@@ -0,0 +1,36 @@
+package implementations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	notificationMocks "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	request := workflowEvent(executionID, core.WorkflowExecution_RUNNING)
+
+	t.Run("records and publishes", func(t *testing.T) {
+		watcher := mocks.NewExecutionWatcher(t)
+		watcher.EXPECT().Record(ctx, request).Return(nil)
+		publisher := notificationMocks.NewPublisher(t)
+		publisher.EXPECT().Publish(ctx, "type", request).Return(nil)
+
+		assert.NoError(t, NewPublisher(publisher, watcher).Publish(ctx, "type", request))
+	})
+
+	t.Run("publishes when failing to record", func(t *testing.T) {
+		watcher := mocks.NewExecutionWatcher(t)
+		watcher.EXPECT().Record(ctx, request).Return(errors.New("foo"))
+		publisher := notificationMocks.NewPublisher(t)
+		publisher.EXPECT().Publish(ctx, "type", request).Return(nil)
+
+		assert.NoError(t, NewPublisher(publisher, watcher).Publish(ctx, "type", request))
+	})
+}
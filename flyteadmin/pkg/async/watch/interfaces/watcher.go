@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+//go:generate mockery --name=ExecutionWatcher --output=../mocks --case=underscore --with-expecter
+
+// ExecutionWatcher streams the phase changes of the executions, of their nodes and of their tasks to their watchers,
+// across all the replicas of flyteadmin.
+type ExecutionWatcher interface {
+	// Record persists the phase change reported by a workflow, node or task execution event request, and wakes up the
+	// watchers of its execution. Other messages, and the phase changes of the executions which aren't watched, are
+	// ignored.
+	Record(ctx context.Context, msg proto.Message) error
+	// Watch marks the execution requested as watched, and sends its phase changes, starting with the ones already
+	// persisted, until the context is canceled or sending fails.
+	Watch(ctx context.Context, request *admin.WatchExecutionRequest, send func(*admin.WatchExecutionResponse) error) error
+	// Run fans out the phase changes recorded by all the replicas to the watchers connected to this one, until the
+	// context is canceled.
+	Run(ctx context.Context) error
+}
+
+//go:generate mockery --name=Notifier --output=../mocks --case=underscore --with-expecter
+
+// Notifier wakes up the watchers of all the replicas as soon as phase changes are recorded, rather than at the next
+// poll of the database.
+type Notifier interface {
+	// Notify notifies all the replicas that phase changes were recorded.
+	Notify(ctx context.Context) error
+	// Listen calls notified whenever a replica records phase changes, until the context is canceled or listening fails.
+	Listen(ctx context.Context, notified func()) error
+}
@@ -21,6 +21,7 @@ const (
 	AdminTag            = "at"
 	ExecutionAdminTag   = "eat"
 	ExecutionTag        = "et"
+	TriggerFiring       = "tf"
 )
 
 // ResourceTypeToEntity maps a resource type to an entity suitable for use with Database filters
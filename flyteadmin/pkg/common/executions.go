@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"sort"
 
 	"k8s.io/apimachinery/pkg/util/rand"
 
@@ -43,6 +44,16 @@ func IsExecutionTerminal(phase core.WorkflowExecution_Phase) bool {
 	return terminalExecutionPhases[phase]
 }
 
+// GetTerminalExecutionPhases returns the names of the terminal phases of workflow executions, sorted.
+func GetTerminalExecutionPhases() []string {
+	phases := make([]string, 0, len(terminalExecutionPhases))
+	for phase := range terminalExecutionPhases {
+		phases = append(phases, phase.String())
+	}
+	sort.Strings(phases)
+	return phases
+}
+
 func IsNodeExecutionTerminal(phase core.NodeExecution_Phase) bool {
 	return terminalNodeExecutionPhases[phase]
 }
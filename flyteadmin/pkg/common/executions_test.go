@@ -21,3 +21,7 @@ func TestGetExecutionName(t *testing.T) {
 		assert.Contains(t, AllowedExecutionIDChars, rune(randString[i]))
 	}
 }
+
+func TestGetTerminalExecutionPhases(t *testing.T) {
+	assert.Equal(t, []string{"ABORTED", "FAILED", "SUCCEEDED", "TIMED_OUT"}, GetTerminalExecutionPhases())
+}
@@ -0,0 +1,201 @@
+package common
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"gorm.io/gorm/schema"
+)
+
+// PageToken is the position a list query resumes from.
+type PageToken struct {
+	// Offset is the number of rows to skip. Tokens of unsorted lists, and the ones issued before cursors were
+	// introduced, are offsets.
+	Offset int
+	// Cursor is set instead of Offset when resuming after the last row of a sorted page.
+	Cursor *Cursor
+}
+
+// Cursor identifies the last row of a page of a sorted list query, by the value of its sort key followed by the values
+// of the columns uniquely identifying it among the rows sharing the same sort key.
+type Cursor struct {
+	SortKey    string
+	Descending bool
+	// Nullable is set when the sort key may be null, in which case null values sort together either before or after
+	// all the others depending on the database.
+	Nullable bool
+	Values   []interface{}
+}
+
+// cursorValue is the json representation of a value of a cursor, which keeps its type.
+type cursorValue struct {
+	Int    *int64     `json:"i,omitempty"`
+	Float  *float64   `json:"f,omitempty"`
+	String *string    `json:"s,omitempty"`
+	Bool   *bool      `json:"b,omitempty"`
+	Time   *time.Time `json:"t,omitempty"`
+}
+
+type cursorToken struct {
+	SortKey    string        `json:"k"`
+	Descending bool          `json:"d,omitempty"`
+	Nullable   bool          `json:"n,omitempty"`
+	Values     []cursorValue `json:"v"`
+}
+
+var schemaCache = &sync.Map{}
+
+// NewPageToken returns the token of the page following the one of a list query ending with lastRow, a model whose
+// uniqueColumns identify it. Queries sorted by any column are resumed with a cursor, which the unique columns break the
+// ties of, whether or not an index serves the sort: seeking to a cursor scans no more rows than skipping an offset.
+// Pages of unsorted queries, of queries sorted by columns of types cursors don't support, and of queries that were
+// resumed from a legacy offset, so that the clients counting offsets themselves keep working, are identified by their
+// offset.
+func NewPageToken(sortParameter SortParameter, current PageToken, pageSize int, lastRow interface{},
+	uniqueColumns []string) string {
+	if sortParameter != nil && current.Offset == 0 {
+		if cursor, err := newCursor(sortParameter, lastRow, uniqueColumns); err == nil {
+			if token, err := encodeCursor(cursor); err == nil {
+				return token
+			}
+		}
+	}
+	return strconv.Itoa(current.Offset + pageSize)
+}
+
+func newCursor(sortParameter SortParameter, row interface{}, uniqueColumns []string) (*Cursor, error) {
+	rowSchema, err := schema.Parse(row, schemaCache, schema.NamingStrategy{})
+	if err != nil {
+		return nil, err
+	}
+	rowValue := reflect.Indirect(reflect.ValueOf(row))
+	cursor := &Cursor{
+		SortKey:    sortParameter.GetKey(),
+		Descending: sortParameter.IsDescending(),
+	}
+	columns := append([]string{sortParameter.GetKey()}, UniqueColumnsAfterSortKey(sortParameter, uniqueColumns)...)
+	for _, column := range columns {
+		field := rowSchema.LookUpField(column)
+		if field == nil {
+			return nil, fmt.Errorf("unknown column %s", column)
+		}
+		if column == sortParameter.GetKey() {
+			cursor.Nullable = field.FieldType.Kind() == reflect.Ptr
+		}
+		value, _ := field.ValueOf(context.Background(), rowValue)
+		cursor.Values = append(cursor.Values, value)
+	}
+	return cursor, nil
+}
+
+// UniqueColumnsAfterSortKey returns the unique columns a list query sorted by sortParameter is sorted by after its sort
+// key, to break ties between the rows sharing the same sort key.
+func UniqueColumnsAfterSortKey(sortParameter SortParameter, uniqueColumns []string) []string {
+	columns := make([]string, 0, len(uniqueColumns))
+	for _, column := range uniqueColumns {
+		if column != sortParameter.GetKey() {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+func encodeCursor(cursor *Cursor) (string, error) {
+	token := cursorToken{
+		SortKey:    cursor.SortKey,
+		Descending: cursor.Descending,
+		Nullable:   cursor.Nullable,
+	}
+	for _, value := range cursor.Values {
+		encoded, err := encodeCursorValue(value)
+		if err != nil {
+			return "", err
+		}
+		token.Values = append(token.Values, encoded)
+	}
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func encodeCursorValue(value interface{}) (cursorValue, error) {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return cursorValue{}, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return cursorValue{}, nil
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return cursorValue{Time: &t}, nil
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := v.Int()
+		return cursorValue{Int: &i}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i := int64(v.Uint()) // #nosec G115
+		return cursorValue{Int: &i}, nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return cursorValue{Float: &f}, nil
+	case reflect.String:
+		s := v.String()
+		return cursorValue{String: &s}, nil
+	case reflect.Bool:
+		b := v.Bool()
+		return cursorValue{Bool: &b}, nil
+	}
+	return cursorValue{}, fmt.Errorf("unsupported cursor value type %s", v.Type())
+}
+
+// DecodeCursor decodes a cursor encoded in a token returned by NewPageToken.
+func DecodeCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	var decoded cursorToken
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, err
+	}
+	if len(decoded.SortKey) == 0 || len(decoded.Values) == 0 {
+		return nil, fmt.Errorf("incomplete cursor")
+	}
+	cursor := &Cursor{
+		SortKey:    decoded.SortKey,
+		Descending: decoded.Descending,
+		Nullable:   decoded.Nullable,
+	}
+	for _, value := range decoded.Values {
+		cursor.Values = append(cursor.Values, value.get())
+	}
+	return cursor, nil
+}
+
+func (v cursorValue) get() interface{} {
+	switch {
+	case v.Int != nil:
+		return *v.Int
+	case v.Float != nil:
+		return *v.Float
+	case v.String != nil:
+		return *v.String
+	case v.Bool != nil:
+		return *v.Bool
+	case v.Time != nil:
+		return *v.Time
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type paginatedModel struct {
+	ID        uint
+	CreatedAt time.Time
+	StartedAt *time.Time
+	Name      string
+	Spec      []byte
+}
+
+var paginatedModelColumns = sets.NewString("id", "created_at", "started_at", "name", "spec")
+
+func newTestSortParameter(t *testing.T, key string, direction admin.Sort_Direction) SortParameter {
+	sortParameter, err := NewSortParameter(&admin.Sort{Key: key, Direction: direction}, paginatedModelColumns)
+	assert.NoError(t, err)
+	return sortParameter
+}
+
+func TestNewPageToken(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 1, 2, 3, 456789000, time.FixedZone("", 3600))
+	row := &paginatedModel{
+		ID:        12,
+		CreatedAt: createdAt,
+		Name:      "name",
+	}
+
+	t.Run("cursor", func(t *testing.T) {
+		sortParameter := newTestSortParameter(t, "created_at", admin.Sort_DESCENDING)
+		token := NewPageToken(sortParameter, PageToken{}, 10, row, []string{"id"})
+
+		cursor, err := DecodeCursor(token)
+		assert.NoError(t, err)
+		assert.Equal(t, "created_at", cursor.SortKey)
+		assert.True(t, cursor.Descending)
+		assert.False(t, cursor.Nullable)
+		assert.Len(t, cursor.Values, 2)
+		assert.True(t, createdAt.Equal(cursor.Values[0].(time.Time)))
+		assert.Equal(t, int64(12), cursor.Values[1])
+	})
+
+	t.Run("null sort key", func(t *testing.T) {
+		sortParameter := newTestSortParameter(t, "started_at", admin.Sort_ASCENDING)
+		cursor, err := DecodeCursor(NewPageToken(sortParameter, PageToken{}, 10, *row, []string{"id"}))
+		assert.NoError(t, err)
+		assert.True(t, cursor.Nullable)
+		assert.Equal(t, []interface{}{nil, int64(12)}, cursor.Values)
+	})
+
+	t.Run("sort key among unique columns", func(t *testing.T) {
+		sortParameter := newTestSortParameter(t, "name", admin.Sort_ASCENDING)
+		cursor, err := DecodeCursor(NewPageToken(sortParameter, PageToken{}, 10, row, []string{"id", "name"}))
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"name", int64(12)}, cursor.Values)
+	})
+
+	t.Run("unsorted", func(t *testing.T) {
+		assert.Equal(t, "15", NewPageToken(nil, PageToken{Offset: 5}, 10, row, []string{"id"}))
+	})
+
+	t.Run("legacy offset", func(t *testing.T) {
+		sortParameter := newTestSortParameter(t, "created_at", admin.Sort_DESCENDING)
+		assert.Equal(t, "15", NewPageToken(sortParameter, PageToken{Offset: 5}, 10, row, []string{"id"}))
+	})
+
+	t.Run("unsupported sort key type", func(t *testing.T) {
+		sortParameter := newTestSortParameter(t, "spec", admin.Sort_ASCENDING)
+		assert.Equal(t, "15", NewPageToken(sortParameter, PageToken{Offset: 5}, 10, row, []string{"id"}))
+	})
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, token := range []string{"!", "Zm9v", "e30"} {
+		_, err := DecodeCursor(token)
+		assert.Error(t, err, token)
+	}
+}
@@ -15,16 +15,29 @@ const gormAscending = "%s asc"
 
 type SortParameter interface {
 	GetGormOrderExpr() string
+	// GetKey returns the column sorted by.
+	GetKey() string
+	IsDescending() bool
 }
 
 type sortParamImpl struct {
 	gormOrderExpression string
+	key                 string
+	descending          bool
 }
 
 func (s *sortParamImpl) GetGormOrderExpr() string {
 	return s.gormOrderExpression
 }
 
+func (s *sortParamImpl) GetKey() string {
+	return s.key
+}
+
+func (s *sortParamImpl) IsDescending() bool {
+	return s.descending
+}
+
 func NewSortParameter(sort *admin.Sort, allowed sets.String) (SortParameter, error) {
 	if sort == nil {
 		return nil, nil
@@ -46,5 +59,7 @@ func NewSortParameter(sort *admin.Sort, allowed sets.String) (SortParameter, err
 	}
 	return &sortParamImpl{
 		gormOrderExpression: gormOrderExpression,
+		key:                 key,
+		descending:          sort.GetDirection() == admin.Sort_DESCENDING,
 	}, nil
 }
@@ -45,6 +45,8 @@ func TestSortParameter_Ascending(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, "name asc", sortParameter.GetGormOrderExpr())
+	assert.Equal(t, "name", sortParameter.GetKey())
+	assert.False(t, sortParameter.IsDescending())
 }
 
 func TestSortParameter_Descending(t *testing.T) {
@@ -55,4 +57,6 @@ func TestSortParameter_Descending(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, "project desc", sortParameter.GetGormOrderExpr())
+	assert.Equal(t, "project", sortParameter.GetKey())
+	assert.True(t, sortParameter.IsDescending())
 }
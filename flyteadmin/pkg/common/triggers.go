@@ -0,0 +1,56 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const payloadPathSeparator = "."
+
+// CompileStoragePattern compiles the pattern a storage event trigger matches the uris of created objects against. A *
+// matches any sequence of characters but /, and a ** any sequence of characters.
+func CompileStoragePattern(pattern string) (*regexp.Regexp, error) {
+	if !strings.Contains(pattern, "://") {
+		return nil, fmt.Errorf("pattern [%s] has no scheme", pattern)
+	}
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '*' {
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			continue
+		}
+		if i+1 < len(pattern) && pattern[i+1] == '*' {
+			expr.WriteString(".*")
+			i++
+			continue
+		}
+		expr.WriteString("[^/]*")
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// GetPayloadField returns the value of the field of an event payload at a dot separated path such as "data.date".
+func GetPayloadField(payload map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = payload
+	for _, field := range strings.Split(path, payloadPathSeparator) {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[field]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// HashTriggerToken returns the hex encoded SHA-256 digest of the token of a webhook trigger.
+func HashTriggerToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileStoragePattern(t *testing.T) {
+	pattern, err := CompileStoragePattern("s3://bucket/prefix/*.csv")
+	assert.NoError(t, err)
+	assert.True(t, pattern.MatchString("s3://bucket/prefix/data.csv"))
+	assert.False(t, pattern.MatchString("s3://bucket/prefix/nested/data.csv"))
+	assert.False(t, pattern.MatchString("s3://bucket/prefix/data.csv.gz"))
+	assert.False(t, pattern.MatchString("s3://bucketXprefix/data.csv"))
+
+	pattern, err = CompileStoragePattern("gs://bucket/prefix/**")
+	assert.NoError(t, err)
+	assert.True(t, pattern.MatchString("gs://bucket/prefix/nested/data.csv"))
+	assert.False(t, pattern.MatchString("gs://other/prefix/data.csv"))
+
+	_, err = CompileStoragePattern("bucket/prefix/*")
+	assert.Error(t, err)
+}
+
+func TestGetPayloadField(t *testing.T) {
+	payload := map[string]interface{}{
+		"key": "prefix/data.csv",
+		"data": map[string]interface{}{
+			"date": "2026-10-19",
+		},
+	}
+	value, ok := GetPayloadField(payload, "key")
+	assert.True(t, ok)
+	assert.Equal(t, "prefix/data.csv", value)
+
+	value, ok = GetPayloadField(payload, "data.date")
+	assert.True(t, ok)
+	assert.Equal(t, "2026-10-19", value)
+
+	_, ok = GetPayloadField(payload, "data.missing")
+	assert.False(t, ok)
+	_, ok = GetPayloadField(payload, "key.nested")
+	assert.False(t, ok)
+}
+
+func TestHashTriggerToken(t *testing.T) {
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", HashTriggerToken("hello"))
+}
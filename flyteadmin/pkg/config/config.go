@@ -34,6 +34,25 @@ type ServerConfig struct {
 type DataProxyConfig struct {
 	Upload   DataProxyUploadConfig   `json:"upload" pflag:",Defines data proxy upload configuration."`
 	Download DataProxyDownloadConfig `json:"download" pflag:",Defines data proxy download configuration."`
+	Proxy    DataProxyProxyConfig    `json:"proxy" pflag:",Defines data proxy streaming configuration."`
+	Preview  DataProxyPreviewConfig  `json:"preview" pflag:",Defines data proxy structured dataset preview configuration."`
+}
+
+// DataProxyProxyConfig configures the streaming mode of the data proxy, for storage backends which can't sign urls
+// (e.g. local or NFS-backed stores, or S3-compatible stores on private networks). Upload and download locations then
+// point at endpoints of admin itself, which stream the data to and from the storage backend.
+type DataProxyProxyConfig struct {
+	Enabled              bool              `json:"enabled" pflag:",Serves uploads and downloads through admin instead of signed urls of the storage backend."`
+	BaseURL              config.URL        `json:"baseUrl" pflag:",Public url of admin the streaming upload and download urls point at."`
+	SigningKeySecretName string            `json:"signingKeySecretName" pflag:",Name of the secret holding the key streaming urls are signed with."`
+	MaxChunkSize         resource.Quantity `json:"maxChunkSize" pflag:",Maximum allowed size of a chunk of a resumable upload."`
+}
+
+// DataProxyPreviewConfig bounds the previews of parquet and CSV structured datasets the data proxy reads from storage.
+// Parquet files are read in ranges, their metadata from their end and then the chunks of the previewed columns only.
+type DataProxyPreviewConfig struct {
+	MaxRows     int               `json:"maxRows" pflag:",Maximum number of rows returned by a structured dataset preview."`
+	MemoryLimit resource.Quantity `json:"memoryLimit" pflag:",Maximum amount of data read into memory to preview a structured dataset."`
 }
 
 type DataProxyDownloadConfig struct {
@@ -114,6 +133,14 @@ var defaultServerConfig = &ServerConfig{
 		Download: DataProxyDownloadConfig{
 			MaxExpiresIn: config.Duration{Duration: time.Hour},
 		},
+		Proxy: DataProxyProxyConfig{
+			SigningKeySecretName: "dataproxy_signing_key",
+			MaxChunkSize:         resource.MustParse("8Mi"),
+		},
+		Preview: DataProxyPreviewConfig{
+			MaxRows:     100,
+			MemoryLimit: resource.MustParse("64Mi"),
+		},
 	},
 	ReadHeaderTimeoutSeconds: 32, // just shy of requestTimeoutUpperBound
 	KubeClientConfig: KubeClientConfig{
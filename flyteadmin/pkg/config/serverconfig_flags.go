@@ -78,6 +78,12 @@ func (cfg ServerConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "dataProxy.upload.defaultFileNameLength"), defaultServerConfig.DataProxy.Upload.DefaultFileNameLength, "Default length for the generated file name if not provided in the request.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dataProxy.upload.storagePrefix"), defaultServerConfig.DataProxy.Upload.StoragePrefix, "Storage prefix to use for all upload requests.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dataProxy.download.maxExpiresIn"), defaultServerConfig.DataProxy.Download.MaxExpiresIn.String(), "Maximum allowed expiration duration.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "dataProxy.proxy.enabled"), defaultServerConfig.DataProxy.Proxy.Enabled, "Serves uploads and downloads through admin instead of signed urls of the storage backend.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dataProxy.proxy.baseUrl"), defaultServerConfig.DataProxy.Proxy.BaseURL.String(), "Public url of admin the streaming upload and download urls point at.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dataProxy.proxy.signingKeySecretName"), defaultServerConfig.DataProxy.Proxy.SigningKeySecretName, "Name of the secret holding the key streaming urls are signed with.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dataProxy.proxy.maxChunkSize"), defaultServerConfig.DataProxy.Proxy.MaxChunkSize.String(), "Maximum allowed size of a chunk of a resumable upload.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "dataProxy.preview.maxRows"), defaultServerConfig.DataProxy.Preview.MaxRows, "Maximum number of rows returned by a structured dataset preview.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "dataProxy.preview.memoryLimit"), defaultServerConfig.DataProxy.Preview.MemoryLimit.String(), "Maximum amount of data read into memory to preview a structured dataset.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "readHeaderTimeoutSeconds"), defaultServerConfig.ReadHeaderTimeoutSeconds, "The amount of time allowed to read request headers.")
 	cmdFlags.Int32(fmt.Sprintf("%v%v", prefix, "kubeClientConfig.qps"), defaultServerConfig.KubeClientConfig.QPS, "Max QPS to the master for requests to KubeAPI. 0 defaults to 5.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "kubeClientConfig.burst"), defaultServerConfig.KubeClientConfig.Burst, "Max burst rate for throttle. 0 defaults to 10")
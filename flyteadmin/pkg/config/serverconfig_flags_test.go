@@ -491,6 +491,90 @@ func TestServerConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_dataProxy.proxy.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("dataProxy.proxy.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("dataProxy.proxy.enabled"); err == nil {
+				testDecodeJson_ServerConfig(t, fmt.Sprintf("%v", vBool), &actual.DataProxy.Proxy.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dataProxy.proxy.baseUrl", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultServerConfig.DataProxy.Proxy.BaseURL.String()
+
+			cmdFlags.Set("dataProxy.proxy.baseUrl", testValue)
+			if vString, err := cmdFlags.GetString("dataProxy.proxy.baseUrl"); err == nil {
+				testDecodeJson_ServerConfig(t, fmt.Sprintf("%v", vString), &actual.DataProxy.Proxy.BaseURL)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dataProxy.proxy.signingKeySecretName", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("dataProxy.proxy.signingKeySecretName", testValue)
+			if vString, err := cmdFlags.GetString("dataProxy.proxy.signingKeySecretName"); err == nil {
+				testDecodeJson_ServerConfig(t, fmt.Sprintf("%v", vString), &actual.DataProxy.Proxy.SigningKeySecretName)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dataProxy.proxy.maxChunkSize", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultServerConfig.DataProxy.Proxy.MaxChunkSize.String()
+
+			cmdFlags.Set("dataProxy.proxy.maxChunkSize", testValue)
+			if vString, err := cmdFlags.GetString("dataProxy.proxy.maxChunkSize"); err == nil {
+				testDecodeJson_ServerConfig(t, fmt.Sprintf("%v", vString), &actual.DataProxy.Proxy.MaxChunkSize)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dataProxy.preview.maxRows", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("dataProxy.preview.maxRows", testValue)
+			if vInt, err := cmdFlags.GetInt("dataProxy.preview.maxRows"); err == nil {
+				testDecodeJson_ServerConfig(t, fmt.Sprintf("%v", vInt), &actual.DataProxy.Preview.MaxRows)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_dataProxy.preview.memoryLimit", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultServerConfig.DataProxy.Preview.MemoryLimit.String()
+
+			cmdFlags.Set("dataProxy.preview.memoryLimit", testValue)
+			if vString, err := cmdFlags.GetString("dataProxy.preview.memoryLimit"); err == nil {
+				testDecodeJson_ServerConfig(t, fmt.Sprintf("%v", vString), &actual.DataProxy.Preview.MemoryLimit)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_readHeaderTimeoutSeconds", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
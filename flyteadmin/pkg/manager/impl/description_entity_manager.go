@@ -2,7 +2,6 @@ package impl
 
 import (
 	"context"
-	"strconv"
 
 	"google.golang.org/grpc/codes"
 
@@ -71,14 +70,15 @@ func (d *DescriptionEntityManager) ListDescriptionEntity(ctx context.Context, re
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListWorkflows", request.GetToken())
 	}
 	listDescriptionEntitiesInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -95,7 +95,8 @@ func (d *DescriptionEntityManager) ListDescriptionEntity(ctx context.Context, re
 	}
 	var token string
 	if len(output.Entities) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.Entities))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.Entities),
+			output.Entities[len(output.Entities)-1], models.IDColumns)
 	}
 	return &admin.DescriptionEntityList{
 		DescriptionEntities: descriptionEntityList,
@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"sync"
 	"time"
 
@@ -21,6 +20,7 @@ import (
 	eventWriter "github.com/flyteorg/flyte/flyteadmin/pkg/async/events/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications"
 	notificationInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/retention"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	dataInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/data/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
@@ -68,6 +68,8 @@ type executionSystemMetrics struct {
 	AcceptanceDelay            prometheus.Summary
 	PublishEventError          prometheus.Counter
 	TerminateExecutionFailures prometheus.Counter
+	PauseExecutionFailures     prometheus.Counter
+	ResumeExecutionFailures    prometheus.Counter
 	ConcurrencyCheckDuration   labeled.StopWatch
 	ConcurrencyLimitHits       *prometheus.CounterVec
 }
@@ -631,6 +633,11 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 	if request.GetSpec().GetMetadata() != nil && request.GetSpec().GetMetadata().GetReferenceExecution() != nil &&
 		request.GetSpec().GetMetadata().GetMode() == admin.ExecutionMetadata_RECOVERED {
 		executionParameters.RecoveryExecution = request.GetSpec().GetMetadata().GetReferenceExecution()
+		rerunFrom := request.GetSpec().GetMetadata().GetRerunFrom()
+		if err := validation.ValidateRerunFrom(rerunFrom, workflow.GetClosure().GetCompiledWorkflow()); err != nil {
+			return nil, nil, err
+		}
+		executionParameters.RerunFrom = rerunFrom
 	}
 
 	err = offloadInputsGroup.Wait()
@@ -1071,6 +1078,11 @@ func (m *ExecutionManager) launchExecution(
 	if request.GetSpec().GetMetadata() != nil && request.GetSpec().GetMetadata().GetReferenceExecution() != nil &&
 		request.GetSpec().GetMetadata().GetMode() == admin.ExecutionMetadata_RECOVERED {
 		executionParameters.RecoveryExecution = request.GetSpec().GetMetadata().GetReferenceExecution()
+		rerunFrom := request.GetSpec().GetMetadata().GetRerunFrom()
+		if err := validation.ValidateRerunFrom(rerunFrom, workflow.GetClosure().GetCompiledWorkflow()); err != nil {
+			return nil, nil, nil, err
+		}
+		executionParameters.RerunFrom = rerunFrom
 	}
 
 	executionCreatedAt := time.Now()
@@ -1378,6 +1390,14 @@ func (m *ExecutionManager) RecoverExecution(
 	}
 	executionSpec.Metadata.Mode = admin.ExecutionMetadata_RECOVERED
 	executionSpec.Metadata.ReferenceExecution = existingExecution.GetId()
+	executionSpec.Metadata.RerunFrom = request.GetRerunFrom()
+	if request.GetLaunchPlan() != nil && !proto.Equal(request.GetLaunchPlan(), executionSpec.GetLaunchPlan()) {
+		if err := m.validateRecoveredNodes(ctx, executionSpec.GetLaunchPlan(), request.GetLaunchPlan(),
+			request.GetRerunFrom()); err != nil {
+			return nil, err
+		}
+		executionSpec.LaunchPlan = request.GetLaunchPlan()
+	}
 	var executionModel *models.Execution
 	var executionTagModel []*models.ExecutionTag
 	ctx, executionModel, executionTagModel, err = m.launchExecutionAndPrepareModel(ctx, &admin.ExecutionCreateRequest{
@@ -1401,6 +1421,39 @@ func (m *ExecutionManager) RecoverExecution(
 	}, nil
 }
 
+// validateRecoveredNodes validates that the nodes recovered by an execution of launchPlanID recovering an execution of
+// referenceLaunchPlanID exist in the workflow of the latter. Single task executions can only recover single task
+// executions.
+func (m *ExecutionManager) validateRecoveredNodes(ctx context.Context, referenceLaunchPlanID, launchPlanID *core.Identifier,
+	rerunFrom *admin.RerunFromNode) error {
+	if referenceLaunchPlanID.GetResourceType() == core.ResourceType_TASK ||
+		launchPlanID.GetResourceType() == core.ResourceType_TASK {
+		if referenceLaunchPlanID.GetResourceType() != launchPlanID.GetResourceType() {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"cannot recover an execution of [%v] with an execution of [%v]", referenceLaunchPlanID, launchPlanID)
+		}
+		return nil
+	}
+	referenceWorkflow, err := m.getLaunchPlanWorkflow(ctx, referenceLaunchPlanID)
+	if err != nil {
+		return err
+	}
+	workflow, err := m.getLaunchPlanWorkflow(ctx, launchPlanID)
+	if err != nil {
+		return err
+	}
+	return validation.ValidateRecoveredNodes(workflow.GetClosure().GetCompiledWorkflow(),
+		referenceWorkflow.GetClosure().GetCompiledWorkflow(), rerunFrom)
+}
+
+func (m *ExecutionManager) getLaunchPlanWorkflow(ctx context.Context, launchPlanID *core.Identifier) (*admin.Workflow, error) {
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, launchPlanID)
+	if err != nil {
+		return nil, err
+	}
+	return util.GetWorkflow(ctx, m.db, m.storageClient, launchPlan.GetSpec().GetWorkflowId())
+}
+
 func (m *ExecutionManager) emitScheduledWorkflowMetrics(
 	ctx context.Context, executionModel *models.Execution, runningEventTimeProto *timestamp.Timestamp) {
 	if executionModel == nil || runningEventTimeProto == nil {
@@ -1601,9 +1654,9 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request *adm
 	}
 	m.dbEventWriter.Write(request)
 
-	if request.GetEvent().GetPhase() == core.WorkflowExecution_RUNNING {
+	if request.GetEvent().GetPhase() == core.WorkflowExecution_RUNNING && wfExecPhase != core.WorkflowExecution_PAUSED {
 		// Workflow executions are created in state "UNDEFINED". All the time up until a RUNNING event is received is
-		// considered system-induced delay.
+		// considered system-induced delay. Executions resuming from a pause don't start anew.
 		if executionModel.Mode == int32(admin.ExecutionMetadata_SCHEDULED) {
 			go m.emitScheduledWorkflowMetrics(ctx, executionModel, request.GetEvent().GetOccurredAt()) //nolint:gosec
 		}
@@ -1651,6 +1704,27 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request *adm
 	return &admin.WorkflowExecutionEventResponse{}, nil
 }
 
+// Returns the execution model read back from the archive of an execution pruned from the database, or the error the
+// execution wasn't found with if it wasn't archived.
+func (m *ExecutionManager) getArchivedExecutionModel(ctx context.Context, id *core.WorkflowExecutionIdentifier,
+	notFoundErr error) (*models.Execution, error) {
+	archive, err := m.db.ExecutionArchiveRepo().Get(ctx, models.ExecutionKey{
+		Project: id.GetProject(),
+		Domain:  id.GetDomain(),
+		Name:    id.GetName(),
+	})
+	if errors.IsDoesNotExistError(err) {
+		return nil, notFoundErr
+	} else if err != nil {
+		return nil, err
+	}
+	archived, err := retention.ReadArchive(ctx, m.storageClient, archive.Location)
+	if err != nil {
+		return nil, err
+	}
+	return &archived.Execution, nil
+}
+
 func (m *ExecutionManager) GetExecution(
 	ctx context.Context, request *admin.WorkflowExecutionGetRequest) (*admin.Execution, error) {
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.GetId()); err != nil {
@@ -1659,6 +1733,10 @@ func (m *ExecutionManager) GetExecution(
 	}
 	ctx = getExecutionContext(ctx, request.GetId())
 	executionModel, err := util.GetExecutionModel(ctx, m.db, request.GetId())
+	if errors.IsDoesNotExistError(err) {
+		// Executions pruned from the database are served read-only from their archive.
+		executionModel, err = m.getArchivedExecutionModel(ctx, request.GetId(), err)
+	}
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err: %v", request, err)
 		return nil, err
@@ -1796,7 +1874,7 @@ func (m *ExecutionManager) ListExecutions(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token %s for ListExecutions",
 			request.GetToken())
@@ -1813,7 +1891,8 @@ func (m *ExecutionManager) ListExecutions(
 
 	listExecutionsInput := repositoryInterfaces.ListResourceInput{
 		Limit:             int(request.GetLimit()),
-		Offset:            offset,
+		Offset:            pageToken.Offset,
+		Cursor:            pageToken.Cursor,
 		InlineFilters:     filters,
 		SortParameter:     sortParameter,
 		JoinTableEntities: joinTableEntities,
@@ -1840,7 +1919,8 @@ func (m *ExecutionManager) ListExecutions(
 	// END TO BE DELETED
 	var token string
 	if len(executionList) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(executionList))
+		token = common.NewPageToken(sortParameter, pageToken, len(executionList),
+			output.Executions[len(output.Executions)-1], models.IDColumns)
 	}
 	return &admin.ExecutionList{
 		Executions: executionList,
@@ -1958,6 +2038,94 @@ func (m *ExecutionManager) TerminateExecution(
 	return &admin.ExecutionTerminateResponse{}, nil
 }
 
+// PauseExecution stops a running execution from starting new nodes until it is resumed. The execution records the
+// PAUSED phase once flytepropeller has picked the request up.
+func (m *ExecutionManager) PauseExecution(
+	ctx context.Context, request *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.GetId()); err != nil {
+		logger.Debugf(ctx, "received pause execution request: %v with invalid identifier: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.GetId())
+	executionModel, err := m.getActiveExecutionModel(ctx, request.GetId(), "pause")
+	if err != nil {
+		return nil, err
+	}
+
+	workflowExecutor := plugins.Get[workflowengineInterfaces.WorkflowExecutor](m.pluginRegistry, plugins.PluginIDWorkflowExecutor)
+	err = workflowExecutor.Pause(ctx, workflowengineInterfaces.PauseData{
+		Namespace: common.GetNamespaceName(
+			m.config.NamespaceMappingConfiguration().GetNamespaceTemplate(), request.GetId().GetProject(), request.GetId().GetDomain()),
+		ExecutionID:       request.GetId(),
+		Cluster:           executionModel.Cluster,
+		AbortRunningNodes: request.GetAbortRunningNodes(),
+	})
+	if err != nil {
+		m.systemMetrics.PauseExecutionFailures.Inc()
+		return nil, err
+	}
+	logger.Infof(ctx, "execution [%+v] paused by [%s]", request.GetId(), getUser(ctx))
+	return &admin.ExecutionPauseResponse{}, nil
+}
+
+// ResumeExecution lets a paused execution start new nodes again. Only executions in the PAUSED phase can be resumed;
+// the execution records the RUNNING phase once flytepropeller has picked the request up.
+func (m *ExecutionManager) ResumeExecution(
+	ctx context.Context, request *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error) {
+	if err := validation.ValidateWorkflowExecutionIdentifier(request.GetId()); err != nil {
+		logger.Debugf(ctx, "received resume execution request: %v with invalid identifier: %v", request, err)
+		return nil, err
+	}
+	ctx = getExecutionContext(ctx, request.GetId())
+	executionModel, err := m.getActiveExecutionModel(ctx, request.GetId(), "resume")
+	if err != nil {
+		return nil, err
+	}
+	if executionModel.Phase != core.WorkflowExecution_PAUSED.String() {
+		return nil, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"Cannot resume workflow execution %v in phase %s, it is not paused", request.GetId(), executionModel.Phase)
+	}
+
+	workflowExecutor := plugins.Get[workflowengineInterfaces.WorkflowExecutor](m.pluginRegistry, plugins.PluginIDWorkflowExecutor)
+	err = workflowExecutor.Resume(ctx, workflowengineInterfaces.ResumeData{
+		Namespace: common.GetNamespaceName(
+			m.config.NamespaceMappingConfiguration().GetNamespaceTemplate(), request.GetId().GetProject(), request.GetId().GetDomain()),
+		ExecutionID: request.GetId(),
+		Cluster:     executionModel.Cluster,
+	})
+	if err != nil {
+		m.systemMetrics.ResumeExecutionFailures.Inc()
+		return nil, err
+	}
+	logger.Infof(ctx, "execution [%+v] resumed by [%s]", request.GetId(), getUser(ctx))
+	return &admin.ExecutionResumeResponse{}, nil
+}
+
+// getActiveExecutionModel fetches an execution which is neither terminated nor being aborted, for the action to be
+// applied to it.
+func (m *ExecutionManager) getActiveExecutionModel(
+	ctx context.Context, id *core.WorkflowExecutionIdentifier, action string) (models.Execution, error) {
+	executionModel, err := m.db.ExecutionRepo().Get(ctx, repositoryInterfaces.Identifier{
+		Project: id.GetProject(),
+		Domain:  id.GetDomain(),
+		Name:    id.GetName(),
+	})
+	if err != nil {
+		logger.Debugf(ctx, "couldn't find execution [%+v] to %s", id, action)
+		return models.Execution{}, err
+	}
+	phase := core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])
+	if common.IsExecutionTerminal(phase) {
+		return models.Execution{}, errors.NewAlreadyInTerminalStateError(ctx,
+			fmt.Sprintf("Cannot %s an already terminated workflow execution", action), executionModel.Phase)
+	}
+	if phase == core.WorkflowExecution_ABORTING {
+		return models.Execution{}, errors.NewFlyteAdminErrorf(codes.FailedPrecondition,
+			"Cannot %s workflow execution %v which is being aborted", action, id)
+	}
+	return executionModel, nil
+}
+
 func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 	return executionSystemMetrics{
 		Scope: scope,
@@ -1985,6 +2153,10 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of publish event errors when invoking publish()"),
 		TerminateExecutionFailures: scope.MustNewCounter("execution_termination_failure",
 			"count of failed workflow executions terminations"),
+		PauseExecutionFailures: scope.MustNewCounter("execution_pause_failure",
+			"count of failed workflow executions pauses"),
+		ResumeExecutionFailures: scope.MustNewCounter("execution_resume_failure",
+			"count of failed workflow executions resumptions"),
 		ConcurrencyCheckDuration: labeled.NewStopWatch("concurrency_check_duration",
 			"time spent checking concurrency limits for launch plans", time.Millisecond, scope),
 		ConcurrencyLimitHits: scope.MustNewCounterVec("concurrency_limit_hits",
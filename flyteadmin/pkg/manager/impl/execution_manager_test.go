@@ -25,6 +25,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/auth"
 	eventWriterMocks "github.com/flyteorg/flyte/flyteadmin/pkg/async/events/mocks"
 	notificationMocks "github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/retention"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	commonMocks "github.com/flyteorg/flyte/flyteadmin/pkg/common/mocks"
 	commonTestUtils "github.com/flyteorg/flyte/flyteadmin/pkg/common/testutils"
@@ -1857,6 +1858,72 @@ func TestRecoverExecution(t *testing.T) {
 	assert.True(t, proto.Equal(expectedResponse, response))
 }
 
+func TestRecoverExecution_RerunFrom(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	var executionData workflowengineInterfaces.ExecutionData
+	mockExecutor.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, data workflowengineInterfaces.ExecutionData) (workflowengineInterfaces.ExecutionResponse, error) {
+			executionData = data
+			return workflowengineInterfaces.ExecutionResponse{}, nil
+		})
+	mockExecutor.EXPECT().ID().Return("testMockExecutor")
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &mockExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	startTime := time.Now()
+	startTimeProto, _ := ptypes.TimestampProto(startTime)
+	existingClosure := admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_FAILED,
+		StartedAt: startTimeProto,
+	}
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	executionGetFunc := makeExecutionGetFunc(t, existingClosureBytes, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(executionGetFunc)
+
+	rerunFrom := &admin.RerunFromNode{NodeId: "node 2"}
+	var createCalled bool
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+		func(ctx context.Context, input models.Execution) error {
+			createCalled = true
+			var spec admin.ExecutionSpec
+			assert.NoError(t, proto.Unmarshal(input.Spec, &spec))
+			assert.Equal(t, admin.ExecutionMetadata_RECOVERED, spec.GetMetadata().GetMode())
+			assert.True(t, proto.Equal(rerunFrom, spec.GetMetadata().GetRerunFrom()))
+			return nil
+		})
+
+	_, err := execManager.RecoverExecution(context.Background(), &admin.ExecutionRecoverRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		Name:      "recovered",
+		RerunFrom: rerunFrom,
+	}, requestedAt)
+	assert.NoError(t, err)
+	assert.True(t, createCalled)
+	assert.Equal(t, "name", executionData.ExecutionParameters.RecoveryExecution.GetName())
+	assert.True(t, proto.Equal(rerunFrom, executionData.ExecutionParameters.RerunFrom))
+
+	t.Run("unknown node", func(t *testing.T) {
+		createCalled = false
+		_, err := execManager.RecoverExecution(context.Background(), &admin.ExecutionRecoverRequest{
+			Id: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			Name:      "recovered",
+			RerunFrom: &admin.RerunFromNode{NodeId: "node 3"},
+		}, requestedAt)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+		assert.False(t, createCalled)
+	})
+}
+
 func TestRecoverExecution_RecoveredChildNode(t *testing.T) {
 	repository := getMockRepositoryForExecTest()
 	setDefaultLpCallbackForExecTest(repository)
@@ -2888,6 +2955,58 @@ func TestGetExecution_DatabaseError(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestGetExecution_Archived(t *testing.T) {
+	ctx := context.Background()
+	repository := repositoryMocks.NewMockRepository()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "missing execution")
+		})
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, mockScope.NewTestScope())
+	assert.NoError(t, err)
+	key := models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"}
+	location, err := retention.GetArchiveLocation(ctx, store, []string{"metadata"}, key)
+	assert.NoError(t, err)
+	assert.NoError(t, retention.WriteArchive(ctx, store, location, models.ArchivedExecution{
+		Execution: models.Execution{
+			ExecutionKey: key,
+			Spec:         getExpectedSpecBytes(),
+			Phase:        phase,
+			Closure:      closureBytes,
+		},
+	}))
+	repository.ExecutionArchiveRepo().(*repositoryMocks.ExecutionArchiveRepoInterface).EXPECT().Get(mock.Anything, key).Return(
+		models.ExecutionArchive{ExecutionKey: key, Location: location}, nil)
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), store, mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execution, err := execManager.GetExecution(ctx, &admin.WorkflowExecutionGetRequest{
+		Id: &executionIdentifier,
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&executionIdentifier, execution.GetId()))
+	assert.Equal(t, core.WorkflowExecution_RUNNING, execution.GetClosure().GetPhase())
+}
+
+func TestGetExecution_NotArchived(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	expectedErr := flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "missing execution")
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			return models.Execution{}, expectedErr
+		})
+	repository.ExecutionArchiveRepo().(*repositoryMocks.ExecutionArchiveRepoInterface).EXPECT().Get(mock.Anything, mock.Anything).Return(
+		models.ExecutionArchive{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not archived"))
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	execution, err := execManager.GetExecution(context.Background(), &admin.WorkflowExecutionGetRequest{
+		Id: &executionIdentifier,
+	})
+	assert.Nil(t, execution)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestGetExecution_TransformerError(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
@@ -3594,6 +3713,142 @@ func TestTerminateExecution_AlreadyTerminated(t *testing.T) {
 	assert.Equal(t, codes.FailedPrecondition, s.Code())
 }
 
+func TestPauseExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		makeExecutionGetFunc(t, []byte{}, &startTime))
+
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.EXPECT().Pause(mock.Anything, mock.MatchedBy(func(data workflowengineInterfaces.PauseData) bool {
+		return proto.Equal(&core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		}, data.ExecutionID) && data.Cluster == testCluster && data.AbortRunningNodes
+	})).Return(nil)
+	mockExecutor.EXPECT().ID().Return("customMockExecutor")
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &mockExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+
+	resp, err := execManager.PauseExecution(context.Background(), &admin.ExecutionPauseRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+		AbortRunningNodes: true,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockExecutor.AssertNumberOfCalls(t, "Pause", 1)
+}
+
+func TestPauseExecution_NotActive(t *testing.T) {
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.EXPECT().ID().Return("customMockExecutor")
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &mockExecutor)
+
+	for _, phase := range []core.WorkflowExecution_Phase{core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_ABORTING} {
+		t.Run(phase.String(), func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository()
+			repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+				func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+					return models.Execution{
+						Phase: phase.String(),
+					}, nil
+				})
+			execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+			resp, err := execManager.PauseExecution(context.Background(), &admin.ExecutionPauseRequest{
+				Id: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			})
+
+			assert.Nil(t, resp)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.FailedPrecondition, s.Code())
+		})
+	}
+}
+
+func TestResumeExecution(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	startTime := time.Now()
+	getExecution := makeExecutionGetFunc(t, []byte{}, &startTime)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+		func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+			execution, err := getExecution(ctx, input)
+			execution.Phase = core.WorkflowExecution_PAUSED.String()
+			return execution, err
+		})
+
+	var expectedError = errors.New("expected error")
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.EXPECT().Resume(mock.Anything, mock.MatchedBy(func(data workflowengineInterfaces.ResumeData) bool {
+		return data.ExecutionID.GetName() == "name" && data.Cluster == testCluster
+	})).Return(nil).Once()
+	mockExecutor.EXPECT().Resume(mock.Anything, mock.Anything).Return(expectedError).Once()
+	mockExecutor.EXPECT().ID().Return("customMockExecutor")
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &mockExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+
+	request := &admin.ExecutionResumeRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "name",
+		},
+	}
+	resp, err := execManager.ResumeExecution(context.Background(), request)
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	resp, err = execManager.ResumeExecution(context.Background(), request)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, expectedError.Error())
+}
+
+func TestResumeExecution_NotPaused(t *testing.T) {
+	mockExecutor := workflowengineMocks.WorkflowExecutor{}
+	mockExecutor.EXPECT().ID().Return("customMockExecutor")
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &mockExecutor)
+
+	for _, phase := range []core.WorkflowExecution_Phase{
+		core.WorkflowExecution_RUNNING, core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_ABORTING} {
+		t.Run(phase.String(), func(t *testing.T) {
+			repository := repositoryMocks.NewMockRepository()
+			repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+				func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+					return models.Execution{
+						Phase: phase.String(),
+					}, nil
+				})
+			execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(), getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(), &mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+			resp, err := execManager.ResumeExecution(context.Background(), &admin.ExecutionResumeRequest{
+				Id: &core.WorkflowExecutionIdentifier{
+					Project: "project",
+					Domain:  "domain",
+					Name:    "name",
+				},
+			})
+
+			assert.Nil(t, resp)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.FailedPrecondition, s.Code())
+			mockExecutor.AssertNotCalled(t, "Resume", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestGetExecutionData(t *testing.T) {
 	repository := repositoryMocks.NewMockRepository()
 	startedAt := time.Date(2018, 8, 30, 0, 0, 0, 0, time.UTC)
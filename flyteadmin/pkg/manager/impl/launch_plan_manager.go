@@ -3,7 +3,6 @@ package impl
 import (
 	"bytes"
 	"context"
-	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -218,6 +217,34 @@ func (m *LaunchPlanManager) updateSchedules(
 		}
 		logger.Infof(ctx, "Enabled schedules for activated launch plan [%+v]", launchPlanIdentifier)
 	}
+	if len(formerlyActiveLaunchPlanSpec.GetEntityMetadata().GetTriggers()) > 0 ||
+		len(newlyActiveLaunchPlanSpec.GetEntityMetadata().GetTriggers()) > 0 {
+		if err = m.updateTriggers(ctx, launchPlanIdentifier, newlyActiveLaunchPlanSpec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replaces the triggers of a launch plan with the ones of its newly active version. The triggers are deleted when the
+// launch plan is disabled, in which case no spec is given.
+func (m *LaunchPlanManager) updateTriggers(ctx context.Context, launchPlanIdentifier *core.Identifier,
+	newlyActiveLaunchPlanSpec *admin.LaunchPlanSpec) error {
+	triggerModels, err := transformers.CreateLaunchPlanTriggerModels(
+		launchPlanIdentifier, newlyActiveLaunchPlanSpec.GetEntityMetadata().GetTriggers())
+	if err != nil {
+		return err
+	}
+	err = m.db.LaunchPlanTriggerRepo().Replace(ctx, repoInterfaces.Identifier{
+		Project: launchPlanIdentifier.GetProject(),
+		Domain:  launchPlanIdentifier.GetDomain(),
+		Name:    launchPlanIdentifier.GetName(),
+	}, triggerModels)
+	if err != nil {
+		return err
+	}
+	logger.Infof(ctx, "Updated triggers of launch plan [%+v] to [%d] active triggers", launchPlanIdentifier,
+		len(triggerModels))
 	return nil
 }
 
@@ -257,6 +284,17 @@ func (m *LaunchPlanManager) disableLaunchPlan(ctx context.Context, request *admi
 			return nil, err
 		}
 	}
+	if len(launchPlanSpec.GetEntityMetadata().GetTriggers()) > 0 {
+		err = m.updateTriggers(ctx, &core.Identifier{
+			Project: launchPlanModel.Project,
+			Domain:  launchPlanModel.Domain,
+			Name:    launchPlanModel.Name,
+			Version: launchPlanModel.Version,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = m.db.LaunchPlanRepo().Update(ctx, launchPlanModel)
 	if err != nil {
 		logger.Debugf(ctx, "Failed to update launchPlanModel with ID [%+v] with err %v", request.GetId(), err)
@@ -413,14 +451,15 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request *admin.
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListLaunchPlans", request.GetToken())
 	}
 	listLaunchPlansInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -438,7 +477,8 @@ func (m *LaunchPlanManager) ListLaunchPlans(ctx context.Context, request *admin.
 	}
 	var token string
 	if len(output.LaunchPlans) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.LaunchPlans))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.LaunchPlans),
+			output.LaunchPlans[len(output.LaunchPlans)-1], models.IDColumns)
 	}
 	return &admin.LaunchPlanList{
 		LaunchPlans: launchPlanList,
@@ -466,14 +506,15 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request *
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListActiveLaunchPlans", request.GetToken())
 	}
 	listLaunchPlansInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -491,7 +532,8 @@ func (m *LaunchPlanManager) ListActiveLaunchPlans(ctx context.Context, request *
 	}
 	var token string
 	if len(output.LaunchPlans) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.LaunchPlans))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.LaunchPlans),
+			output.LaunchPlans[len(output.LaunchPlans)-1], models.IDColumns)
 	}
 	return &admin.LaunchPlanList{
 		LaunchPlans: launchPlanList,
@@ -516,13 +558,14 @@ func (m *LaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request *admi
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token %s", request.GetToken())
 	}
 	listLaunchPlansInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -535,7 +578,8 @@ func (m *LaunchPlanManager) ListLaunchPlanIds(ctx context.Context, request *admi
 	}
 	var token string
 	if len(output.LaunchPlans) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.LaunchPlans))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.LaunchPlans),
+			output.LaunchPlans[len(output.LaunchPlans)-1], models.IdentifierColumns)
 	}
 	return &admin.NamedEntityIdentifierList{
 		Entities: transformers.FromLaunchPlanModelsToIdentifiers(output.LaunchPlans),
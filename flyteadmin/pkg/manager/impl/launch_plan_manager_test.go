@@ -927,6 +927,113 @@ func TestDisableLaunchPlan(t *testing.T) {
 	assert.True(t, removeScheduleFuncCalled)
 }
 
+func TestUpdateSchedules_Triggers(t *testing.T) {
+	trigger := &admin.Trigger{
+		Name: "on-upload",
+		Event: &admin.Trigger_Storage{
+			Storage: &admin.StorageEventTrigger{UriPattern: "s3://bucket/prefix/*"},
+		},
+	}
+	launchPlanSpecBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Triggers: []*admin.Trigger{trigger},
+		},
+	})
+	noTriggersSpecBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{})
+
+	t.Run("replaces triggers", func(t *testing.T) {
+		repository := getMockRepositoryForLpTest()
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().Replace(
+			mock.Anything, interfaces.Identifier{Project: project, Domain: domain, Name: name}, mock.Anything).RunAndReturn(
+			func(ctx context.Context, launchPlan interfaces.Identifier, triggers []models.LaunchPlanTrigger) error {
+				assert.Len(t, triggers, 1)
+				assert.Equal(t, "on-upload", triggers[0].TriggerName)
+				assert.Equal(t, version, triggers[0].Version)
+				assert.Equal(t, models.TriggerEventTypeStorage, triggers[0].EventType)
+				return nil
+			})
+		lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+		err := lpManager.(*LaunchPlanManager).updateSchedules(context.Background(), models.LaunchPlan{
+			LaunchPlanKey: models.LaunchPlanKey{
+				Project: project,
+				Domain:  domain,
+				Name:    name,
+				Version: version,
+			},
+			Spec: launchPlanSpecBytes,
+		}, nil)
+		assert.NoError(t, err)
+	})
+	t.Run("deletes triggers of formerly active version", func(t *testing.T) {
+		repository := getMockRepositoryForLpTest()
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().Replace(
+			mock.Anything, mock.Anything, []models.LaunchPlanTrigger{}).Return(nil)
+		lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+		err := lpManager.(*LaunchPlanManager).updateSchedules(context.Background(), models.LaunchPlan{
+			LaunchPlanKey: models.LaunchPlanKey{
+				Project: project,
+				Domain:  domain,
+				Name:    name,
+				Version: version,
+			},
+			Spec: noTriggersSpecBytes,
+		}, &models.LaunchPlan{
+			LaunchPlanKey: models.LaunchPlanKey{
+				Project: project,
+				Domain:  domain,
+				Name:    name,
+				Version: "former",
+			},
+			Spec: launchPlanSpecBytes,
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestDisableLaunchPlan_Triggers(t *testing.T) {
+	repository := getMockRepositoryForLpTest()
+	specWithTriggersBytes, _ := proto.Marshal(&admin.LaunchPlanSpec{
+		EntityMetadata: &admin.LaunchPlanMetadata{
+			Triggers: []*admin.Trigger{
+				{
+					Name: "webhook",
+					Event: &admin.Trigger_Webhook{
+						Webhook: &admin.WebhookTrigger{TokenSha256: common.HashTriggerToken("token")},
+					},
+				},
+			},
+		},
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				State: &active,
+				Spec:  specWithTriggersBytes,
+			}, nil
+		})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetUpdateCallback(
+		func(toDisable models.LaunchPlan) error {
+			return nil
+		})
+	mockTriggerRepo := repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface)
+	mockTriggerRepo.EXPECT().Replace(mock.Anything,
+		interfaces.Identifier{Project: project, Domain: domain, Name: name}, []models.LaunchPlanTrigger{}).Return(nil)
+
+	lpManager := NewLaunchPlanManager(repository, getMockConfigForLpTest(), mockScheduler, mockScope.NewTestScope())
+	_, err := lpManager.UpdateLaunchPlan(context.Background(), &admin.LaunchPlanUpdateRequest{
+		Id:    launchPlanIdentifier,
+		State: admin.LaunchPlanState_INACTIVE,
+	})
+	assert.NoError(t, err)
+	mockTriggerRepo.AssertExpectations(t)
+}
+
 func TestDisableLaunchPlan_DatabaseError(t *testing.T) {
 	repository := getMockRepositoryForLpTest()
 	expectedError := errors.New("expected error")
@@ -117,7 +117,7 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request *adm
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListNamedEntities", request.GetToken())
@@ -125,7 +125,8 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request *adm
 	listInput := repoInterfaces.ListNamedEntityInput{
 		ListResourceInput: repoInterfaces.ListResourceInput{
 			Limit:         int(request.GetLimit()),
-			Offset:        offset,
+			Offset:        pageToken.Offset,
+			Cursor:        pageToken.Cursor,
 			InlineFilters: filters,
 			SortParameter: sortParameter,
 		},
@@ -143,7 +144,8 @@ func (m *NamedEntityManager) ListNamedEntities(ctx context.Context, request *adm
 
 	var token string
 	if len(output.Entities) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.Entities))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.Entities),
+			output.Entities[len(output.Entities)-1], models.IdentifierColumns)
 	}
 	entities := transformers.FromNamedEntityModels(output.Entities)
 	return &admin.NamedEntityList{
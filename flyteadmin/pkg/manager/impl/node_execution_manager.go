@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -400,7 +399,7 @@ func (m *NodeExecutionManager) listNodeExecutions(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(requestToken)
+	pageToken, err := validation.ValidatePageToken(requestToken, sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListNodeExecutions", requestToken)
@@ -411,7 +410,8 @@ func (m *NodeExecutionManager) listNodeExecutions(
 	}
 	listInput := repoInterfaces.ListResourceInput{
 		Limit:             int(limit),
-		Offset:            offset,
+		Offset:            pageToken.Offset,
+		Cursor:            pageToken.Cursor,
 		InlineFilters:     filters,
 		SortParameter:     sortParameter,
 		JoinTableEntities: joinTableEntities,
@@ -426,7 +426,8 @@ func (m *NodeExecutionManager) listNodeExecutions(
 
 	var token string
 	if len(output.NodeExecutions) == int(limit) {
-		token = strconv.Itoa(offset + len(output.NodeExecutions))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.NodeExecutions),
+			output.NodeExecutions[len(output.NodeExecutions)-1], models.IDColumns)
 	}
 	nodeExecutionList, err := m.transformNodeExecutionModelList(ctx, output.NodeExecutions)
 	if err != nil {
@@ -2,7 +2,6 @@ package impl
 
 import (
 	"context"
-	"strconv"
 
 	"google.golang.org/grpc/codes"
 
@@ -59,7 +58,7 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request *admin.Projec
 		sortParameter = alphabeticalSortParam
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListProjects", request.GetToken())
@@ -67,7 +66,8 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request *admin.Projec
 	// And finally, query the database
 	listProjectsInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -79,7 +79,8 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request *admin.Projec
 
 	var token string
 	if len(projects) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(projects))
+		token = common.NewPageToken(sortParameter, pageToken, len(projects),
+			projectModels[len(projectModels)-1], models.ProjectIDColumns)
 	}
 
 	return &admin.Projects{
@@ -0,0 +1,433 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/resources"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/transformers"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// Launch plans of a promoted workflow version are listed in pages of this size.
+const promotedLaunchPlansPageSize = 100
+
+type promotionMetrics struct {
+	Scope    promutils.Scope
+	Promoted prometheus.Counter
+	Existed  prometheus.Counter
+}
+
+// PromotionManager copies registered entities from one project and domain to another.
+//
+// Promoted entities are registered in the target project and domain through the managers which register them, such
+// that they are validated exactly as they would be when registered there directly. Matchable attributes are not copied:
+// tasks are validated against the task resource attributes of the target project and domain, and the settings of
+// promoted launch plans which were resolved from the workflow execution config of the source project and domain are
+// re-resolved for the target project and domain.
+type PromotionManager struct {
+	db                repoInterfaces.Repository
+	config            runtimeInterfaces.Configuration
+	resourceManager   interfaces.ResourceInterface
+	taskManager       interfaces.TaskInterface
+	workflowManager   interfaces.WorkflowInterface
+	launchPlanManager interfaces.LaunchPlanInterface
+	metrics           promotionMetrics
+}
+
+// The project and domain entities are promoted from and to.
+type promotionTarget struct {
+	sourceProject string
+	sourceDomain  string
+	targetProject string
+	targetDomain  string
+}
+
+func (t promotionTarget) isPromoted(id *core.Identifier) bool {
+	return id.GetProject() == t.sourceProject && id.GetDomain() == t.sourceDomain
+}
+
+// Returns the identifier an entity of the source project and domain is promoted to.
+func (t promotionTarget) targetIdentifier(id *core.Identifier) *core.Identifier {
+	return &core.Identifier{
+		ResourceType: id.GetResourceType(),
+		Project:      t.targetProject,
+		Domain:       t.targetDomain,
+		Name:         id.GetName(),
+		Version:      id.GetVersion(),
+		Org:          id.GetOrg(),
+	}
+}
+
+// Points the task and workflow references held by a promoted entity at the entities promoted with it. References to
+// launch plans are left pointing at the source project and domain, as launch nodes launch independently registered
+// launch plans which are not promoted along.
+func (t promotionTarget) rewriteIdentifiers(message protoreflect.Message) {
+	if id, ok := message.Interface().(*core.Identifier); ok {
+		if t.isPromoted(id) && id.GetResourceType() != core.ResourceType_LAUNCH_PLAN {
+			id.Project = t.targetProject
+			id.Domain = t.targetDomain
+		}
+		return
+	}
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			if field.Message() != nil {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					t.rewriteIdentifiers(list.Get(i).Message())
+				}
+			}
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+					t.rewriteIdentifiers(mapValue.Message())
+					return true
+				})
+			}
+		case field.Message() != nil:
+			t.rewriteIdentifiers(value.Message())
+		}
+		return true
+	})
+}
+
+// Returns a copy of the template of a compiled workflow, without the nodes the compiler injected into it.
+func getRegisteredWorkflowTemplate(compiled *core.CompiledWorkflow) *core.WorkflowTemplate {
+	template := proto.Clone(compiled.GetTemplate()).(*core.WorkflowTemplate)
+	nodes := make([]*core.Node, 0, len(template.GetNodes()))
+	for _, node := range template.GetNodes() {
+		if node.GetId() == v1alpha1.StartNodeID || node.GetId() == v1alpha1.EndNodeID {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	template.Nodes = nodes
+	return template
+}
+
+// Registering an entity which is already registered with an identical structure is reported as AlreadyExists, in
+// which case promoting it again is a no-op.
+func isAlreadyRegistered(err error) bool {
+	adminError, ok := err.(errors.FlyteAdminError)
+	return ok && adminError.Code() == codes.AlreadyExists
+}
+
+// Records the promotion of an entity as soon as it is registered. Entities which were already registered are recorded
+// too, as they may have been registered by an earlier attempt of the same promotion which failed before recording
+// them; the record of an earlier promotion of the entity is kept.
+func (m *PromotionManager) recordPromotion(ctx context.Context, source, target *core.Identifier, existed bool) (
+	*admin.Promotion, error) {
+	promotion := &admin.Promotion{
+		Source:     source,
+		Target:     target,
+		PromotedBy: getUser(ctx),
+		PromotedAt: timestamppb.Now(),
+		Existed:    existed,
+	}
+	promotionModel := transformers.CreatePromotionModel(promotion)
+	if err := m.db.PromotionRepo().Create(ctx, &promotionModel); err != nil {
+		logger.Errorf(ctx, "failed to record the promotion of [%+v] to [%+v] with err: %v", source, target, err)
+		return nil, err
+	}
+	if existed {
+		m.metrics.Existed.Inc()
+	} else {
+		m.metrics.Promoted.Inc()
+	}
+	return promotion, nil
+}
+
+// Resolves the workflow execution config of a project and domain the way executions of the workflow resolve it: the
+// most specific attributes take precedence over the attributes of the project.
+func (m *PromotionManager) resolveExecutionConfig(ctx context.Context, project, domain, workflowName string) (
+	*admin.WorkflowExecutionConfig, error) {
+	executionConfig := &admin.WorkflowExecutionConfig{}
+	for _, scope := range []struct{ domain, workflowName string }{{domain, workflowName}, {"", ""}} {
+		matchableResource, err := util.GetMatchableResource(ctx, m.resourceManager,
+			admin.MatchableResource_WORKFLOW_EXECUTION_CONFIG, project, scope.domain, scope.workflowName)
+		if err != nil {
+			return nil, err
+		}
+		if matchableResource != nil && matchableResource.Attributes.GetWorkflowExecutionConfig() != nil {
+			executionConfig = util.MergeIntoExecConfig(executionConfig,
+				matchableResource.Attributes.GetWorkflowExecutionConfig())
+		}
+	}
+	return executionConfig, nil
+}
+
+// Returns the value a promoted launch plan setting takes: settings which are set to what the workflow execution config
+// of the source project and domain resolves to are replaced with what the config of the target resolves to.
+func reresolve[T interface {
+	comparable
+	proto.Message
+}](value, source, target T) T {
+	var unset T
+	if value == unset || !proto.Equal(value, source) {
+		return value
+	}
+	return target
+}
+
+// Re-resolves the settings of a promoted launch plan which were resolved from the workflow execution config of the
+// source project and domain, typically by registering it with settings specific to the source domain.
+func reresolveExecutionConfig(spec *admin.LaunchPlanSpec, source, target *admin.WorkflowExecutionConfig) {
+	if spec.GetMaxParallelism() > 0 && spec.GetMaxParallelism() == source.GetMaxParallelism() {
+		spec.MaxParallelism = target.GetMaxParallelism()
+	}
+	if spec.GetOverwriteCache() && source.GetOverwriteCache() {
+		spec.OverwriteCache = target.GetOverwriteCache()
+	}
+	spec.SecurityContext = reresolve(spec.GetSecurityContext(), source.GetSecurityContext(), target.GetSecurityContext())
+	spec.RawOutputDataConfig = reresolve(spec.GetRawOutputDataConfig(), source.GetRawOutputDataConfig(),
+		target.GetRawOutputDataConfig())
+	spec.Labels = reresolve(spec.GetLabels(), source.GetLabels(), target.GetLabels())
+	spec.Annotations = reresolve(spec.GetAnnotations(), source.GetAnnotations(), target.GetAnnotations())
+	spec.Interruptible = reresolve(spec.GetInterruptible(), source.GetInterruptible(), target.GetInterruptible())
+	spec.Envs = reresolve(spec.GetEnvs(), source.GetEnvs(), target.GetEnvs())
+}
+
+func (m *PromotionManager) promoteTasks(ctx context.Context, target promotionTarget,
+	tasks []*core.CompiledTask) ([]*admin.Promotion, error) {
+	promotions := make([]*admin.Promotion, 0, len(tasks))
+	for _, task := range tasks {
+		source := task.GetTemplate().GetId()
+		if !target.isPromoted(source) {
+			// Tasks registered in other projects and domains are referenced as they are.
+			continue
+		}
+		template := proto.Clone(task.GetTemplate()).(*core.TaskTemplate)
+		target.rewriteIdentifiers(template.ProtoReflect())
+		_, err := m.taskManager.CreateTask(ctx, &admin.TaskCreateRequest{
+			Id: template.GetId(),
+			Spec: &admin.TaskSpec{
+				Template: template,
+			},
+		})
+		if err != nil && !isAlreadyRegistered(err) {
+			logger.Debugf(ctx, "failed to promote task [%+v] with err: %v", source, err)
+			return nil, err
+		}
+		promotion, err := m.recordPromotion(ctx, source, template.GetId(), err != nil)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+	}
+	return promotions, nil
+}
+
+func (m *PromotionManager) promoteWorkflow(ctx context.Context, target promotionTarget,
+	workflow *admin.Workflow) (*admin.Promotion, error) {
+	compiledWorkflow := workflow.GetClosure().GetCompiledWorkflow()
+	if compiledWorkflow.GetPrimary().GetTemplate() == nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+			"workflow [%+v] has no compiled template to promote", workflow.GetId())
+	}
+	spec := &admin.WorkflowSpec{
+		Template:     getRegisteredWorkflowTemplate(compiledWorkflow.GetPrimary()),
+		SubWorkflows: make([]*core.WorkflowTemplate, 0, len(compiledWorkflow.GetSubWorkflows())),
+	}
+	for _, subWorkflow := range compiledWorkflow.GetSubWorkflows() {
+		spec.SubWorkflows = append(spec.SubWorkflows, getRegisteredWorkflowTemplate(subWorkflow))
+	}
+	target.rewriteIdentifiers(spec.ProtoReflect())
+	targetID := target.targetIdentifier(workflow.GetId())
+	_, err := m.workflowManager.CreateWorkflow(ctx, &admin.WorkflowCreateRequest{
+		Id:   targetID,
+		Spec: spec,
+	})
+	if err != nil && !isAlreadyRegistered(err) {
+		logger.Debugf(ctx, "failed to promote workflow [%+v] with err: %v", workflow.GetId(), err)
+		return nil, err
+	}
+	return m.recordPromotion(ctx, workflow.GetId(), targetID, err != nil)
+}
+
+// Lists the launch plans registered against a workflow version.
+func (m *PromotionManager) getWorkflowLaunchPlans(ctx context.Context, workflowID *core.Identifier) (
+	[]*admin.LaunchPlan, error) {
+	workflowModel, err := util.GetWorkflowModel(ctx, m.db, workflowID)
+	if err != nil {
+		return nil, err
+	}
+	projectFilter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, shared.Project, workflowID.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	domainFilter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, shared.Domain, workflowID.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	workflowFilter, err := common.NewSingleValueFilter(common.LaunchPlan, common.Equal, "workflow_id", workflowModel.ID)
+	if err != nil {
+		return nil, err
+	}
+	var launchPlans []*admin.LaunchPlan
+	for offset := 0; ; offset += promotedLaunchPlansPageSize {
+		output, err := m.db.LaunchPlanRepo().List(ctx, repoInterfaces.ListResourceInput{
+			Limit:         promotedLaunchPlansPageSize,
+			Offset:        offset,
+			InlineFilters: []common.InlineFilter{projectFilter, domainFilter, workflowFilter},
+		})
+		if err != nil {
+			return nil, err
+		}
+		page, err := transformers.FromLaunchPlanModels(output.LaunchPlans)
+		if err != nil {
+			return nil, err
+		}
+		launchPlans = append(launchPlans, page...)
+		if len(output.LaunchPlans) < promotedLaunchPlansPageSize {
+			return launchPlans, nil
+		}
+	}
+}
+
+func (m *PromotionManager) promoteLaunchPlans(ctx context.Context, target promotionTarget,
+	workflowID *core.Identifier, activate bool) ([]*admin.Promotion, error) {
+	launchPlans, err := m.getWorkflowLaunchPlans(ctx, workflowID)
+	if err != nil {
+		return nil, err
+	}
+	sourceConfig, err := m.resolveExecutionConfig(ctx, target.sourceProject, target.sourceDomain, workflowID.GetName())
+	if err != nil {
+		return nil, err
+	}
+	targetConfig, err := m.resolveExecutionConfig(ctx, target.targetProject, target.targetDomain, workflowID.GetName())
+	if err != nil {
+		return nil, err
+	}
+	promotions := make([]*admin.Promotion, 0, len(launchPlans))
+	for _, launchPlan := range launchPlans {
+		spec := proto.Clone(launchPlan.GetSpec()).(*admin.LaunchPlanSpec)
+		target.rewriteIdentifiers(spec.ProtoReflect())
+		reresolveExecutionConfig(spec, sourceConfig, targetConfig)
+		targetID := target.targetIdentifier(launchPlan.GetId())
+		_, err := m.launchPlanManager.CreateLaunchPlan(ctx, &admin.LaunchPlanCreateRequest{
+			Id:   targetID,
+			Spec: spec,
+		})
+		if err != nil && !isAlreadyRegistered(err) {
+			logger.Debugf(ctx, "failed to promote launch plan [%+v] with err: %v", launchPlan.GetId(), err)
+			return nil, err
+		}
+		promotion, err := m.recordPromotion(ctx, launchPlan.GetId(), targetID, err != nil)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+		if activate {
+			if _, err := m.launchPlanManager.UpdateLaunchPlan(ctx, &admin.LaunchPlanUpdateRequest{
+				Id:    targetID,
+				State: admin.LaunchPlanState_ACTIVE,
+			}); err != nil {
+				logger.Debugf(ctx, "failed to activate promoted launch plan [%+v] with err: %v", targetID, err)
+				return nil, err
+			}
+		}
+	}
+	return promotions, nil
+}
+
+// PromoteWorkflow registers a workflow version in another project and domain, along with the tasks of its project
+// and domain it runs and the launch plans registered against it. Entities are registered in dependency order, and
+// entities already registered with an identical structure are left as they are, such that a promotion which failed
+// part way through can be retried. The promotion of each entity is recorded as soon as it is registered.
+func (m *PromotionManager) PromoteWorkflow(ctx context.Context, request *admin.WorkflowPromoteRequest) (
+	*admin.WorkflowPromoteResponse, error) {
+	if err := validation.ValidateWorkflowPromoteRequest(ctx, request, m.db, m.config.ApplicationConfiguration()); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.GetId().GetProject(), request.GetId().GetDomain())
+	ctx = contextutils.WithWorkflowID(ctx, request.GetId().GetName())
+	target := promotionTarget{
+		sourceProject: request.GetId().GetProject(),
+		sourceDomain:  request.GetId().GetDomain(),
+		targetProject: request.GetTargetProject(),
+		targetDomain:  request.GetTargetDomain(),
+	}
+	if len(target.targetProject) == 0 {
+		target.targetProject = target.sourceProject
+	}
+
+	workflow, err := m.workflowManager.GetWorkflow(ctx, &admin.ObjectGetRequest{
+		Id: request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	promotions, err := m.promoteTasks(ctx, target, workflow.GetClosure().GetCompiledWorkflow().GetTasks())
+	if err != nil {
+		return nil, err
+	}
+	workflowPromotion, err := m.promoteWorkflow(ctx, target, workflow)
+	if err != nil {
+		return nil, err
+	}
+	promotions = append(promotions, workflowPromotion)
+	launchPlanPromotions, err := m.promoteLaunchPlans(ctx, target, request.GetId(), request.GetActivateLaunchPlans())
+	if err != nil {
+		return nil, err
+	}
+	promotions = append(promotions, launchPlanPromotions...)
+	return &admin.WorkflowPromoteResponse{
+		Promotions: promotions,
+	}, nil
+}
+
+// GetPromotion returns where an entity registered by a promotion was promoted from.
+func (m *PromotionManager) GetPromotion(ctx context.Context, request *admin.ObjectGetRequest) (*admin.Promotion, error) {
+	if err := validation.ValidateDescriptionEntityGetRequest(request); err != nil {
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.GetId().GetProject(), request.GetId().GetDomain())
+	promotionModel, err := m.db.PromotionRepo().Get(ctx, transformers.CreatePromotionKey(request.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return transformers.FromPromotionModel(promotionModel), nil
+}
+
+func NewPromotionManager(
+	db repoInterfaces.Repository,
+	config runtimeInterfaces.Configuration,
+	taskManager interfaces.TaskInterface,
+	workflowManager interfaces.WorkflowInterface,
+	launchPlanManager interfaces.LaunchPlanInterface,
+	scope promutils.Scope) interfaces.PromotionInterface {
+	metrics := promotionMetrics{
+		Scope:    scope,
+		Promoted: scope.MustNewCounter("promoted", "count of entities registered by promotions"),
+		Existed:  scope.MustNewCounter("existed", "count of promoted entities which were already registered"),
+	}
+	return &PromotionManager{
+		db:                db,
+		config:            config,
+		resourceManager:   resources.NewResourceManager(db, config.ApplicationConfiguration()),
+		taskManager:       taskManager,
+		workflowManager:   workflowManager,
+		launchPlanManager: launchPlanManager,
+		metrics:           metrics,
+	}
+}
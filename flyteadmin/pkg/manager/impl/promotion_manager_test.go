@@ -0,0 +1,284 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	flyteAdminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/testutils"
+	managerMocks "github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const promotionTargetDomain = "production"
+
+var promotedWorkflowID = &core.Identifier{
+	ResourceType: core.ResourceType_WORKFLOW,
+	Project:      project,
+	Domain:       domain,
+	Name:         name,
+	Version:      version,
+}
+
+var promotedTaskID = &core.Identifier{
+	ResourceType: core.ResourceType_TASK,
+	Project:      project,
+	Domain:       domain,
+	Name:         "task",
+	Version:      version,
+}
+
+// A task registered in another project, which workflows of the promoted project reference as it is.
+var sharedTaskID = &core.Identifier{
+	ResourceType: core.ResourceType_TASK,
+	Project:      "shared",
+	Domain:       domain,
+	Name:         "task",
+	Version:      version,
+}
+
+func getTaskNode(id string, taskID *core.Identifier) *core.Node {
+	return &core.Node{
+		Id: id,
+		Target: &core.Node_TaskNode{
+			TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{ReferenceId: taskID},
+			},
+		},
+	}
+}
+
+func getPromotedWorkflow() *admin.Workflow {
+	return &admin.Workflow{
+		Id: promotedWorkflowID,
+		Closure: &admin.WorkflowClosure{
+			CompiledWorkflow: &core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{
+						Id: promotedWorkflowID,
+						Nodes: []*core.Node{
+							{Id: "start-node"},
+							getTaskNode("n0", promotedTaskID),
+							getTaskNode("n1", sharedTaskID),
+							{Id: "end-node"},
+						},
+					},
+				},
+				Tasks: []*core.CompiledTask{
+					{Template: &core.TaskTemplate{Id: promotedTaskID, Type: "python-task"}},
+					{Template: &core.TaskTemplate{Id: sharedTaskID, Type: "python-task"}},
+				},
+			},
+		},
+	}
+}
+
+func getMockRepositoryForPromotionTest(t *testing.T) repoInterfaces.Repository {
+	repository := testutils.GetRepoWithDefaultProject()
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetGetCallback(
+		func(input repoInterfaces.Identifier) (models.Workflow, error) {
+			return models.Workflow{BaseModel: models.BaseModel{ID: 7}}, nil
+		})
+	launchPlanSpec, err := proto.Marshal(&admin.LaunchPlanSpec{
+		WorkflowId: promotedWorkflowID,
+		// Registered with the output location of the source domain.
+		RawOutputDataConfig: &admin.RawOutputDataConfig{OutputLocationPrefix: "s3://development"},
+		Labels:              &admin.Labels{Values: map[string]string{"team": "flyte"}},
+	})
+	assert.NoError(t, err)
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(
+		ctx context.Context, id repoInterfaces.ResourceID) (models.Resource, error) {
+		assert.Equal(t, admin.MatchableResource_WORKFLOW_EXECUTION_CONFIG.String(), id.ResourceType)
+		outputLocationPrefixes := map[string]string{
+			domain:                "s3://development",
+			promotionTargetDomain: "s3://production",
+		}
+		outputLocationPrefix, ok := outputLocationPrefixes[id.Domain]
+		if !ok {
+			return models.Resource{}, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found")
+		}
+		attributes, err := proto.Marshal(&admin.MatchingAttributes{
+			Target: &admin.MatchingAttributes_WorkflowExecutionConfig{
+				WorkflowExecutionConfig: &admin.WorkflowExecutionConfig{
+					RawOutputDataConfig: &admin.RawOutputDataConfig{OutputLocationPrefix: outputLocationPrefix},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		return models.Resource{Project: id.Project, Domain: id.Domain, Attributes: attributes}, nil
+	}
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetListCallback(
+		func(input repoInterfaces.ListResourceInput) (repoInterfaces.LaunchPlanCollectionOutput, error) {
+			assert.Len(t, input.InlineFilters, 3)
+			return repoInterfaces.LaunchPlanCollectionOutput{
+				LaunchPlans: []models.LaunchPlan{
+					{
+						LaunchPlanKey: models.LaunchPlanKey{
+							Project: project,
+							Domain:  domain,
+							Name:    name,
+							Version: version,
+						},
+						Spec: launchPlanSpec,
+					},
+				},
+			}, nil
+		})
+	return repository
+}
+
+func getMockConfigForPromotionTest() runtimeInterfaces.Configuration {
+	return runtimeMocks.NewMockConfigurationProvider(
+		testutils.GetApplicationConfigWithDefaultDomains(), nil, nil, nil, nil, nil)
+}
+
+func TestPromotionManager_PromoteWorkflow(t *testing.T) {
+	request := &admin.WorkflowPromoteRequest{
+		Id:                  promotedWorkflowID,
+		TargetDomain:        promotionTargetDomain,
+		ActivateLaunchPlans: true,
+	}
+
+	t.Run("promotes the workflow, its tasks and launch plans", func(t *testing.T) {
+		repository := getMockRepositoryForPromotionTest(t)
+		var recorded []models.Promotion
+		repository.PromotionRepo().(*repositoryMocks.PromotionRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, input *models.Promotion) error {
+			recorded = append(recorded, *input)
+			return nil
+		})
+		taskManager := &managerMocks.TaskInterface{}
+		taskManager.EXPECT().CreateTask(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, request *admin.TaskCreateRequest) (*admin.TaskCreateResponse, error) {
+				assert.Equal(t, promotionTargetDomain, request.GetId().GetDomain())
+				assert.True(t, proto.Equal(request.GetId(), request.GetSpec().GetTemplate().GetId()))
+				return &admin.TaskCreateResponse{}, nil
+			})
+		workflowManager := &managerMocks.WorkflowInterface{}
+		workflowManager.EXPECT().GetWorkflow(mock.Anything, mock.Anything).Return(getPromotedWorkflow(), nil)
+		workflowManager.EXPECT().CreateWorkflow(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, request *admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error) {
+				assert.Equal(t, promotionTargetDomain, request.GetId().GetDomain())
+				nodes := request.GetSpec().GetTemplate().GetNodes()
+				assert.Len(t, nodes, 2)
+				assert.Equal(t, promotionTargetDomain, nodes[0].GetTaskNode().GetReferenceId().GetDomain())
+				assert.True(t, proto.Equal(sharedTaskID, nodes[1].GetTaskNode().GetReferenceId()))
+				return nil, flyteAdminErrors.NewWorkflowExistsIdenticalStructureError(ctx, request)
+			})
+		launchPlanManager := &managerMocks.LaunchPlanInterface{}
+		launchPlanManager.EXPECT().CreateLaunchPlan(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, request *admin.LaunchPlanCreateRequest) (*admin.LaunchPlanCreateResponse, error) {
+				assert.Equal(t, promotionTargetDomain, request.GetId().GetDomain())
+				assert.Equal(t, promotionTargetDomain, request.GetSpec().GetWorkflowId().GetDomain())
+				assert.Equal(t, "s3://production", request.GetSpec().GetRawOutputDataConfig().GetOutputLocationPrefix())
+				assert.Equal(t, "flyte", request.GetSpec().GetLabels().GetValues()["team"])
+				return &admin.LaunchPlanCreateResponse{}, nil
+			})
+		launchPlanManager.EXPECT().UpdateLaunchPlan(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, request *admin.LaunchPlanUpdateRequest) (*admin.LaunchPlanUpdateResponse, error) {
+				assert.Equal(t, admin.LaunchPlanState_ACTIVE, request.GetState())
+				return &admin.LaunchPlanUpdateResponse{}, nil
+			})
+
+		promotionManager := NewPromotionManager(repository, getMockConfigForPromotionTest(), taskManager,
+			workflowManager, launchPlanManager, mockScope.NewTestScope())
+		response, err := promotionManager.PromoteWorkflow(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Len(t, response.GetPromotions(), 3)
+		taskManager.AssertNumberOfCalls(t, "CreateTask", 1)
+		assert.True(t, response.GetPromotions()[1].GetExisted())
+		assert.Len(t, recorded, 3)
+		for _, promotion := range recorded {
+			assert.Equal(t, promotionTargetDomain, promotion.Domain)
+			assert.Equal(t, domain, promotion.SourceDomain)
+		}
+	})
+	t.Run("fails on a conflicting registration", func(t *testing.T) {
+		repository := getMockRepositoryForPromotionTest(t)
+		taskManager := &managerMocks.TaskInterface{}
+		taskManager.EXPECT().CreateTask(mock.Anything, mock.Anything).Return(nil,
+			flyteAdminErrors.NewFlyteAdminError(codes.InvalidArgument, "task with different structure already exists"))
+		workflowManager := &managerMocks.WorkflowInterface{}
+		workflowManager.EXPECT().GetWorkflow(mock.Anything, mock.Anything).Return(getPromotedWorkflow(), nil)
+
+		promotionManager := NewPromotionManager(repository, getMockConfigForPromotionTest(), taskManager,
+			workflowManager, &managerMocks.LaunchPlanInterface{}, mockScope.NewTestScope())
+		_, err := promotionManager.PromoteWorkflow(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+		workflowManager.AssertNotCalled(t, "CreateWorkflow", mock.Anything, mock.Anything)
+	})
+	t.Run("records the entities registered before a failure", func(t *testing.T) {
+		repository := getMockRepositoryForPromotionTest(t)
+		var recorded []models.Promotion
+		repository.PromotionRepo().(*repositoryMocks.PromotionRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, input *models.Promotion) error {
+			recorded = append(recorded, *input)
+			return nil
+		})
+		taskManager := &managerMocks.TaskInterface{}
+		taskManager.EXPECT().CreateTask(mock.Anything, mock.Anything).Return(&admin.TaskCreateResponse{}, nil)
+		workflowManager := &managerMocks.WorkflowInterface{}
+		workflowManager.EXPECT().GetWorkflow(mock.Anything, mock.Anything).Return(getPromotedWorkflow(), nil)
+		workflowManager.EXPECT().CreateWorkflow(mock.Anything, mock.Anything).Return(&admin.WorkflowCreateResponse{}, nil)
+		launchPlanManager := &managerMocks.LaunchPlanInterface{}
+		launchPlanManager.EXPECT().CreateLaunchPlan(mock.Anything, mock.Anything).Return(nil,
+			flyteAdminErrors.NewFlyteAdminError(codes.Internal, "failed to create launch plan"))
+
+		promotionManager := NewPromotionManager(repository, getMockConfigForPromotionTest(), taskManager,
+			workflowManager, launchPlanManager, mockScope.NewTestScope())
+		_, err := promotionManager.PromoteWorkflow(context.Background(), request)
+		assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+		assert.Len(t, recorded, 2)
+		assert.Equal(t, core.ResourceType_TASK.String(), recorded[0].ResourceType)
+		assert.Equal(t, core.ResourceType_WORKFLOW.String(), recorded[1].ResourceType)
+	})
+}
+
+func TestPromotionManager_GetPromotion(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	targetID := &core.Identifier{
+		ResourceType: core.ResourceType_WORKFLOW,
+		Project:      project,
+		Domain:       promotionTargetDomain,
+		Name:         name,
+		Version:      version,
+	}
+	repository.PromotionRepo().(*repositoryMocks.PromotionRepoInterface).EXPECT().Get(
+		mock.Anything, models.PromotionKey{
+			ResourceType: core.ResourceType_WORKFLOW.String(),
+			Project:      project,
+			Domain:       promotionTargetDomain,
+			Name:         name,
+			Version:      version,
+		}).Return(models.Promotion{
+		PromotionKey: models.PromotionKey{
+			ResourceType: core.ResourceType_WORKFLOW.String(),
+			Project:      project,
+			Domain:       promotionTargetDomain,
+			Name:         name,
+			Version:      version,
+		},
+		SourceProject: project,
+		SourceDomain:  domain,
+		PromotedBy:    "user",
+	}, nil)
+
+	promotionManager := NewPromotionManager(repository, getMockConfigForPromotionTest(), &managerMocks.TaskInterface{},
+		&managerMocks.WorkflowInterface{}, &managerMocks.LaunchPlanInterface{}, mockScope.NewTestScope())
+	promotion, err := promotionManager.GetPromotion(context.Background(), &admin.ObjectGetRequest{Id: targetID})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(promotedWorkflowID, promotion.GetSource()))
+	assert.Equal(t, "user", promotion.GetPromotedBy())
+}
@@ -2,7 +2,6 @@ package impl
 
 import (
 	"context"
-	"strconv"
 
 	"google.golang.org/grpc/codes"
 
@@ -87,7 +86,7 @@ func (s *SignalManager) ListSignals(ctx context.Context, request *admin.SignalLi
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListSignals", request.GetToken())
@@ -95,7 +94,8 @@ func (s *SignalManager) ListSignals(ctx context.Context, request *admin.SignalLi
 
 	signalModelList, err := s.db.SignalRepo().List(ctx, repoInterfaces.ListResourceInput{
 		InlineFilters: filters,
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		Limit:         int(request.GetLimit()),
 		SortParameter: sortParameter,
 	})
@@ -112,7 +112,8 @@ func (s *SignalManager) ListSignals(ctx context.Context, request *admin.SignalLi
 	}
 	var token string
 	if len(signalList) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(signalList))
+		token = common.NewPageToken(sortParameter, pageToken, len(signalList),
+			signalModelList[len(signalModelList)-1], models.IDColumns)
 	}
 	return &admin.SignalList{
 		Signals: signalList,
@@ -3,7 +3,6 @@ package impl
 import (
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -262,7 +261,7 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListTaskExecutions", request.GetToken())
@@ -274,7 +273,8 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 
 	output, err := m.db.TaskExecutionRepo().List(ctx, repoInterfaces.ListResourceInput{
 		InlineFilters:     filters,
-		Offset:            offset,
+		Offset:            pageToken.Offset,
+		Cursor:            pageToken.Cursor,
 		Limit:             int(request.GetLimit()),
 		SortParameter:     sortParameter,
 		JoinTableEntities: joinTableEntities,
@@ -293,7 +293,8 @@ func (m *TaskExecutionManager) ListTaskExecutions(
 	}
 	var token string
 	if len(taskExecutionList) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(taskExecutionList))
+		token = common.NewPageToken(sortParameter, pageToken, len(taskExecutionList),
+			output.TaskExecutions[len(output.TaskExecutions)-1], models.IDColumns)
 	}
 	return &admin.TaskExecutionList{
 		TaskExecutions: taskExecutionList,
@@ -3,7 +3,6 @@ package impl
 import (
 	"bytes"
 	"context"
-	"strconv"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -181,7 +180,7 @@ func (t *TaskManager) ListTasks(ctx context.Context, request *admin.ResourceList
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListTasks", request.GetToken())
@@ -189,7 +188,8 @@ func (t *TaskManager) ListTasks(ctx context.Context, request *admin.ResourceList
 	// And finally, query the database
 	listTasksInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -207,7 +207,8 @@ func (t *TaskManager) ListTasks(ctx context.Context, request *admin.ResourceList
 
 	var token string
 	if len(taskList) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(taskList))
+		token = common.NewPageToken(sortParameter, pageToken, len(taskList),
+			output.Tasks[len(output.Tasks)-1], models.IDColumns)
 	}
 	return &admin.TaskList{
 		Tasks: taskList,
@@ -237,14 +238,15 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request *ad
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListUniqueTaskIdentifiers", request.GetToken())
 	}
 	listTasksInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -259,7 +261,8 @@ func (t *TaskManager) ListUniqueTaskIdentifiers(ctx context.Context, request *ad
 	idList := transformers.FromTaskModelsToIdentifiers(output.Tasks)
 	var token string
 	if len(idList) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(idList))
+		token = common.NewPageToken(sortParameter, pageToken, len(idList),
+			output.Tasks[len(output.Tasks)-1], models.IdentifierColumns)
 	}
 	return &admin.NamedEntityIdentifierList{
 		Entities: idList,
@@ -362,7 +362,9 @@ func TestListTasks(t *testing.T) {
 			CreatedAt: testutils.MockCreatedAtProto,
 		}, task.GetClosure()))
 	}
-	assert.Equal(t, "2", taskList.GetToken())
+	cursor, err := common.DecodeCursor(taskList.GetToken())
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{domainValue, int64(0)}, cursor.Values)
 }
 
 func TestListTasks_MissingParameters(t *testing.T) {
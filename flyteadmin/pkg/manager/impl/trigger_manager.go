@@ -0,0 +1,382 @@
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/transformers"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// Triggers launch at most the maximum number of executions they are configured with within this window.
+const triggerRateLimitWindow = time.Hour
+
+const triggeredExecutionNameInputsFormat = "%s:%s:%s:%s:%s"
+
+type triggerMetrics struct {
+	Scope                 promutils.Scope
+	Fired                 prometheus.Counter
+	Duplicates            prometheus.Counter
+	RateLimited           prometheus.Counter
+	Failed                prometheus.Counter
+	Unauthorized          prometheus.Counter
+	FailedFiringRecords   prometheus.Counter
+	InvalidStoredTriggers prometheus.Counter
+}
+
+type TriggerManager struct {
+	db               repoInterfaces.Repository
+	executionManager interfaces.ExecutionInterface
+	metrics          triggerMetrics
+}
+
+func getTriggerContext(ctx context.Context, trigger models.LaunchPlanTriggerKey) context.Context {
+	ctx = contextutils.WithProjectDomain(ctx, trigger.Project, trigger.Domain)
+	return contextutils.WithLaunchPlanID(ctx, trigger.Name)
+}
+
+// Deterministically names the execution launched by an event, such that the deliveries of the same event launch a
+// single execution. The name is derived from the digest alone, without going through the shared random source.
+func getTriggeredExecutionName(trigger models.LaunchPlanTriggerKey, eventID string) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(fmt.Sprintf(triggeredExecutionNameInputsFormat,
+		trigger.Project, trigger.Domain, trigger.Name, trigger.TriggerName, eventID)))
+	encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
+	return "f" + strings.ToLower(encoded[:common.ExecutionIDLength-1])
+}
+
+// Maps the fields of the payload of an event onto the inputs of the launch plan, as configured by the trigger.
+func getTriggeredExecutionInputs(trigger *admin.Trigger, expectedInputs *core.ParameterMap,
+	payload map[string]interface{}) (*core.LiteralMap, error) {
+	literals := make(map[string]*core.Literal, len(trigger.GetInputs()))
+	for input, path := range trigger.GetInputs() {
+		parameter, ok := expectedInputs.GetParameters()[input]
+		if !ok {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"trigger maps [%s] which is not a free input of the launch plan", input)
+		}
+		value, ok := common.GetPayloadField(payload, path)
+		if !ok {
+			if parameter.GetRequired() {
+				return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"event has no field [%s] holding the value of required input [%s]", path, input)
+			}
+			continue
+		}
+		literal, err := coreutils.MakeLiteralForType(parameter.GetVar().GetType(), value)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"failed to convert field [%s] of the event to input [%s]: %v", path, input, err)
+		}
+		literals[input] = literal
+	}
+	return &core.LiteralMap{Literals: literals}, nil
+}
+
+// Whether a trigger reached its rate limit, counting the firing being reserved. Firings are inserted as pending
+// before they are counted, such that concurrent firings of a trigger can't all pass the limit: the last one to insert
+// its pending firing counts all the others. Concurrent firings may however all count each other and be dropped below
+// the limit, and the pending firings of a crashed admin count until they leave the window.
+func (m *TriggerManager) isRateLimited(
+	ctx context.Context, triggerModel models.LaunchPlanTriggerKey, trigger *admin.Trigger) (bool, error) {
+	if trigger.GetMaxFiringsPerHour() == 0 {
+		return false, nil
+	}
+	count, err := m.db.TriggerFiringRepo().Count(ctx, triggerModel, []string{
+		admin.TriggerFiringOutcome_FIRED.String(),
+		admin.TriggerFiringOutcome_TRIGGER_FIRING_OUTCOME_UNSPECIFIED.String(),
+	}, time.Now().Add(-triggerRateLimitWindow))
+	if err != nil {
+		return false, err
+	}
+	return count > int64(trigger.GetMaxFiringsPerHour()), nil
+}
+
+func (m *TriggerManager) launchExecution(ctx context.Context, firing *admin.TriggerFiring, trigger *admin.Trigger,
+	payload map[string]interface{}) error {
+	launchPlan, err := util.GetLaunchPlan(ctx, m.db, firing.GetLaunchPlan())
+	if err != nil {
+		return err
+	}
+	inputs, err := getTriggeredExecutionInputs(trigger, launchPlan.GetClosure().GetExpectedInputs(), payload)
+	if err != nil {
+		return err
+	}
+	_, err = m.executionManager.CreateExecution(ctx, &admin.ExecutionCreateRequest{
+		Project: firing.GetExecutionId().GetProject(),
+		Domain:  firing.GetExecutionId().GetDomain(),
+		Name:    firing.GetExecutionId().GetName(),
+		Spec: &admin.ExecutionSpec{
+			LaunchPlan: firing.GetLaunchPlan(),
+			Metadata: &admin.ExecutionMetadata{
+				Mode: admin.ExecutionMetadata_TRIGGER,
+			},
+		},
+		Inputs: inputs,
+	}, time.Now())
+	return err
+}
+
+// Launches an execution of the active version of the launch plan of a trigger on an event matching it, unless the
+// event was already received or the trigger reached its rate limit. The firing is recorded as pending before it is
+// counted against the rate limit, and updated with its outcome.
+func (m *TriggerManager) fire(ctx context.Context, triggerModel models.LaunchPlanTrigger, trigger *admin.Trigger,
+	eventID string, payload map[string]interface{}) *admin.TriggerFiring {
+	ctx = getTriggerContext(ctx, triggerModel.LaunchPlanTriggerKey)
+	firing := &admin.TriggerFiring{
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      triggerModel.Project,
+			Domain:       triggerModel.Domain,
+			Name:         triggerModel.Name,
+			Version:      triggerModel.Version,
+		},
+		TriggerName: triggerModel.TriggerName,
+		EventId:     eventID,
+		ReceivedAt:  timestamppb.Now(),
+	}
+
+	firingModel := transformers.CreateTriggerFiringModel(firing)
+	err := m.db.TriggerFiringRepo().Create(ctx, &firingModel)
+	reserved := err == nil
+	rateLimited := false
+	if reserved {
+		rateLimited, err = m.isRateLimited(ctx, triggerModel.LaunchPlanTriggerKey, trigger)
+	} else {
+		m.metrics.FailedFiringRecords.Inc()
+	}
+	switch {
+	case err != nil:
+		firing.Outcome = admin.TriggerFiringOutcome_FAILED
+		firing.Message = err.Error()
+	case rateLimited:
+		firing.Outcome = admin.TriggerFiringOutcome_RATE_LIMITED
+	default:
+		firing.ExecutionId = &core.WorkflowExecutionIdentifier{
+			Project: triggerModel.Project,
+			Domain:  triggerModel.Domain,
+			Name:    getTriggeredExecutionName(triggerModel.LaunchPlanTriggerKey, eventID),
+		}
+		err = m.launchExecution(ctx, firing, trigger, payload)
+		if adminErr, ok := err.(errors.FlyteAdminError); ok && adminErr.Code() == codes.AlreadyExists {
+			firing.Outcome = admin.TriggerFiringOutcome_DUPLICATE
+		} else if err != nil {
+			firing.Outcome = admin.TriggerFiringOutcome_FAILED
+			firing.Message = err.Error()
+			firing.ExecutionId = nil
+		} else {
+			firing.Outcome = admin.TriggerFiringOutcome_FIRED
+		}
+	}
+
+	switch firing.GetOutcome() {
+	case admin.TriggerFiringOutcome_FIRED:
+		m.metrics.Fired.Inc()
+		logger.Infof(ctx, "Trigger [%s] launched execution [%+v] on event [%s]", triggerModel.TriggerName,
+			firing.GetExecutionId(), eventID)
+	case admin.TriggerFiringOutcome_DUPLICATE:
+		m.metrics.Duplicates.Inc()
+		logger.Debugf(ctx, "Trigger [%s] already launched execution [%+v] on event [%s]", triggerModel.TriggerName,
+			firing.GetExecutionId(), eventID)
+	case admin.TriggerFiringOutcome_RATE_LIMITED:
+		m.metrics.RateLimited.Inc()
+		logger.Warningf(ctx, "Trigger [%s] dropped event [%s] past its limit of [%d] executions an hour",
+			triggerModel.TriggerName, eventID, trigger.GetMaxFiringsPerHour())
+	case admin.TriggerFiringOutcome_FAILED:
+		m.metrics.Failed.Inc()
+		logger.Errorf(ctx, "Trigger [%s] failed to launch an execution on event [%s] with err: %v",
+			triggerModel.TriggerName, eventID, firing.GetMessage())
+	}
+
+	if !reserved {
+		return firing
+	}
+	outcomeModel := transformers.CreateTriggerFiringModel(firing)
+	outcomeModel.ID = firingModel.ID
+	if err := m.db.TriggerFiringRepo().Update(ctx, &outcomeModel); err != nil {
+		m.metrics.FailedFiringRecords.Inc()
+		logger.Errorf(ctx, "Failed to record firing of trigger [%s] on event [%s] with err: %v",
+			triggerModel.TriggerName, eventID, err)
+	}
+	return firing
+}
+
+// Fires the triggers of the given kind of events that the matches function selects.
+func (m *TriggerManager) fireMatching(ctx context.Context, eventType, eventID string, payload map[string]interface{},
+	matches func(trigger *admin.Trigger) bool) ([]*admin.TriggerFiring, error) {
+	triggerModels, err := m.db.LaunchPlanTriggerRepo().ListByEventType(ctx, eventType)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to list [%s] triggers with err: %v", eventType, err)
+		return nil, err
+	}
+	firings := make([]*admin.TriggerFiring, 0)
+	for _, triggerModel := range triggerModels {
+		trigger, err := transformers.FromLaunchPlanTriggerModel(triggerModel)
+		if err != nil {
+			m.metrics.InvalidStoredTriggers.Inc()
+			logger.Errorf(ctx, "Skipping trigger [%+v] with err: %v", triggerModel.LaunchPlanTriggerKey, err)
+			continue
+		}
+		if matches(trigger) {
+			firings = append(firings, m.fire(ctx, triggerModel, trigger, eventID, payload))
+		}
+	}
+	return firings, nil
+}
+
+func (m *TriggerManager) HandleStorageEvent(ctx context.Context, event interfaces.StorageEvent) (
+	[]*admin.TriggerFiring, error) {
+	payload := map[string]interface{}{
+		"uri":    event.URI,
+		"bucket": event.Bucket,
+		"key":    event.Key,
+		"size":   float64(event.Size),
+		"etag":   event.ETag,
+	}
+	return m.fireMatching(ctx, models.TriggerEventTypeStorage, event.ID, payload, func(trigger *admin.Trigger) bool {
+		pattern, err := common.CompileStoragePattern(trigger.GetStorage().GetUriPattern())
+		if err != nil {
+			logger.Warningf(ctx, "Skipping trigger [%s] with invalid uri pattern: %v", trigger.GetName(), err)
+			return false
+		}
+		return pattern.MatchString(event.URI)
+	})
+}
+
+func (m *TriggerManager) HandleWebhookEvent(ctx context.Context, event interfaces.WebhookEvent) (
+	*admin.TriggerFiring, error) {
+	triggerModel, err := m.db.LaunchPlanTriggerRepo().Get(ctx, models.LaunchPlanTriggerKey{
+		Project:     event.LaunchPlan.GetProject(),
+		Domain:      event.LaunchPlan.GetDomain(),
+		Name:        event.LaunchPlan.GetName(),
+		TriggerName: event.TriggerName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	trigger, err := transformers.FromLaunchPlanTriggerModel(triggerModel)
+	if err != nil {
+		return nil, err
+	}
+	// Unknown triggers and wrong tokens are reported alike, not to reveal which triggers exist.
+	tokenDigest := common.HashTriggerToken(event.Token)
+	if trigger.GetWebhook() == nil ||
+		subtle.ConstantTimeCompare([]byte(tokenDigest), []byte(trigger.GetWebhook().GetTokenSha256())) != 1 {
+		m.metrics.Unauthorized.Inc()
+		return nil, errors.NewFlyteAdminErrorf(codes.NotFound,
+			"launch plan [%s/%s/%s] has no active trigger [%s]", triggerModel.Project, triggerModel.Domain,
+			triggerModel.Name, event.TriggerName)
+	}
+	return m.fire(ctx, triggerModel, trigger, event.ID, event.Payload), nil
+}
+
+func (m *TriggerManager) HandleCloudEvent(ctx context.Context, event interfaces.CloudEvent) (
+	[]*admin.TriggerFiring, error) {
+	if event.ID == "" || event.Type == "" || event.Source == "" {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"cloud events must have an id, a type and a source")
+	}
+	return m.fireMatching(ctx, models.TriggerEventTypeCloudEvent, event.Source+"/"+event.ID, event.Payload,
+		func(trigger *admin.Trigger) bool {
+			cloudEvent := trigger.GetCloudEvent()
+			return cloudEvent.GetType() == event.Type &&
+				(cloudEvent.GetSource() == "" || cloudEvent.GetSource() == event.Source)
+		})
+}
+
+func (m *TriggerManager) ListTriggerFirings(ctx context.Context, request *admin.ResourceListRequest) (
+	*admin.TriggerFiringList, error) {
+	if err := validation.ValidateResourceListRequest(request); err != nil {
+		logger.Debugf(ctx, "ListTriggerFirings request [%+v] is invalid: %v", request, err)
+		return nil, err
+	}
+	ctx = contextutils.WithProjectDomain(ctx, request.GetId().GetProject(), request.GetId().GetDomain())
+	ctx = contextutils.WithLaunchPlanID(ctx, request.GetId().GetName())
+
+	filters, err := util.GetDbFilters(util.FilterSpec{
+		Project:        request.GetId().GetProject(),
+		Domain:         request.GetId().GetDomain(),
+		Name:           request.GetId().GetName(),
+		RequestFilters: request.GetFilters(),
+	}, common.TriggerFiring)
+	if err != nil {
+		return nil, err
+	}
+
+	sortParameter, err := common.NewSortParameter(request.GetSortBy(), models.TriggerFiringColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
+	if err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"invalid pagination token %s for ListTriggerFirings", request.GetToken())
+	}
+
+	firingModels, err := m.db.TriggerFiringRepo().List(ctx, repoInterfaces.ListResourceInput{
+		InlineFilters: filters,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
+		Limit:         int(request.GetLimit()),
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "Failed to list trigger firings with request [%+v] with err %v", request, err)
+		return nil, err
+	}
+
+	var token string
+	if len(firingModels) == int(request.GetLimit()) {
+		token = common.NewPageToken(sortParameter, pageToken, len(firingModels),
+			firingModels[len(firingModels)-1], models.IDColumns)
+	}
+	return &admin.TriggerFiringList{
+		Firings: transformers.FromTriggerFiringModels(firingModels),
+		Token:   token,
+	}, nil
+}
+
+func NewTriggerManager(
+	db repoInterfaces.Repository,
+	executionManager interfaces.ExecutionInterface,
+	scope promutils.Scope) interfaces.TriggerInterface {
+	metrics := triggerMetrics{
+		Scope:        scope,
+		Fired:        scope.MustNewCounter("fired", "count of executions launched by triggers"),
+		Duplicates:   scope.MustNewCounter("duplicates", "count of events received again by triggers"),
+		RateLimited:  scope.MustNewCounter("rate_limited", "count of events dropped past the rate limit of triggers"),
+		Failed:       scope.MustNewCounter("failed", "count of executions triggers failed to launch"),
+		Unauthorized: scope.MustNewCounter("unauthorized", "count of webhook events with an unknown token"),
+		FailedFiringRecords: scope.MustNewCounter("failed_firing_records",
+			"count of trigger firings which failed to be recorded"),
+		InvalidStoredTriggers: scope.MustNewCounter("invalid_stored_triggers",
+			"count of stored triggers which failed to be deserialized"),
+	}
+	return &TriggerManager{
+		db:               db,
+		executionManager: executionManager,
+		metrics:          metrics,
+	}
+}
@@ -0,0 +1,377 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	flyteAdminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const triggerName = "on-upload"
+
+func getTriggerModel(t *testing.T, trigger *admin.Trigger) models.LaunchPlanTrigger {
+	triggerBytes, err := proto.Marshal(trigger)
+	assert.NoError(t, err)
+	return models.LaunchPlanTrigger{
+		LaunchPlanTriggerKey: models.LaunchPlanTriggerKey{
+			Project:     project,
+			Domain:      domain,
+			Name:        name,
+			TriggerName: trigger.GetName(),
+		},
+		Version: version,
+		Trigger: triggerBytes,
+	}
+}
+
+func getMockRepositoryForTriggerTest(t *testing.T) repoInterfaces.Repository {
+	repository := repositoryMocks.NewMockRepository()
+	closureBytes, err := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: &core.ParameterMap{
+			Parameters: map[string]*core.Parameter{
+				"path": {
+					Var: &core.Variable{
+						Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+					},
+					Behavior: &core.Parameter_Required{Required: true},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input repoInterfaces.Identifier) (models.LaunchPlan, error) {
+			assert.Equal(t, version, input.Version)
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				Closure: closureBytes,
+			}, nil
+		})
+	return repository
+}
+
+func TestGetTriggeredExecutionName(t *testing.T) {
+	trigger := models.LaunchPlanTriggerKey{
+		Project:     "project",
+		Domain:      "domain",
+		Name:        "name",
+		TriggerName: "on-upload",
+	}
+	name := getTriggeredExecutionName(trigger, "event")
+	assert.Len(t, name, common.ExecutionIDLength)
+	assert.Regexp(t, "^f[a-z2-7]+$", name)
+	assert.Equal(t, name, getTriggeredExecutionName(trigger, "event"))
+	assert.NotEqual(t, name, getTriggeredExecutionName(trigger, "other-event"))
+}
+
+func TestTriggerManager_HandleStorageEvent(t *testing.T) {
+	storageTrigger := &admin.Trigger{
+		Name: triggerName,
+		Event: &admin.Trigger_Storage{
+			Storage: &admin.StorageEventTrigger{UriPattern: "s3://bucket/prefix/*.csv"},
+		},
+		Inputs: map[string]string{"path": "key"},
+	}
+	otherTrigger := &admin.Trigger{
+		Name: "other",
+		Event: &admin.Trigger_Storage{
+			Storage: &admin.StorageEventTrigger{UriPattern: "s3://other/**"},
+		},
+	}
+	event := interfaces.StorageEvent{
+		ID:     "event",
+		URI:    "s3://bucket/prefix/data.csv",
+		Bucket: "bucket",
+		Key:    "prefix/data.csv",
+	}
+
+	t.Run("fires matching triggers", func(t *testing.T) {
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+			mock.Anything, models.TriggerEventTypeStorage).Return(
+			[]models.LaunchPlanTrigger{getTriggerModel(t, storageTrigger), getTriggerModel(t, otherTrigger)}, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, input *models.TriggerFiring) error {
+			assert.Equal(t, "TRIGGER_FIRING_OUTCOME_UNSPECIFIED", input.Outcome)
+			input.ID = 1
+			return nil
+		})
+		var recorded models.TriggerFiring
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+			mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, input *models.TriggerFiring) error {
+			recorded = *input
+			return nil
+		})
+		executionManager := &managerMocks.ExecutionInterface{}
+		executionManager.EXPECT().CreateExecution(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, request *admin.ExecutionCreateRequest, requestedAt time.Time) (
+				*admin.ExecutionCreateResponse, error) {
+				assert.Equal(t, getTriggeredExecutionName(models.LaunchPlanTriggerKey{
+					Project: project, Domain: domain, Name: name, TriggerName: triggerName,
+				}, "event"), request.GetName())
+				assert.Equal(t, version, request.GetSpec().GetLaunchPlan().GetVersion())
+				assert.Equal(t, admin.ExecutionMetadata_TRIGGER, request.GetSpec().GetMetadata().GetMode())
+				assert.Equal(t, "prefix/data.csv",
+					request.GetInputs().GetLiterals()["path"].GetScalar().GetPrimitive().GetStringValue())
+				return &admin.ExecutionCreateResponse{}, nil
+			})
+
+		triggerManager := NewTriggerManager(repository, executionManager, mockScope.NewTestScope())
+		firings, err := triggerManager.HandleStorageEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Len(t, firings, 1)
+		assert.Equal(t, admin.TriggerFiringOutcome_FIRED, firings[0].GetOutcome())
+		assert.Equal(t, uint(1), recorded.ID)
+		assert.Equal(t, triggerName, recorded.TriggerName)
+		assert.Equal(t, "FIRED", recorded.Outcome)
+		assert.Equal(t, firings[0].GetExecutionId().GetName(), recorded.ExecutionName)
+	})
+	t.Run("duplicate event", func(t *testing.T) {
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+			mock.Anything, models.TriggerEventTypeStorage).Return(
+			[]models.LaunchPlanTrigger{getTriggerModel(t, storageTrigger)}, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).Return(nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+			mock.Anything, mock.Anything).Return(nil)
+		executionManager := &managerMocks.ExecutionInterface{}
+		executionManager.EXPECT().CreateExecution(mock.Anything, mock.Anything, mock.Anything).Return(
+			nil, flyteAdminErrors.NewFlyteAdminError(codes.AlreadyExists, "exists"))
+
+		triggerManager := NewTriggerManager(repository, executionManager, mockScope.NewTestScope())
+		firings, err := triggerManager.HandleStorageEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Len(t, firings, 1)
+		assert.Equal(t, admin.TriggerFiringOutcome_DUPLICATE, firings[0].GetOutcome())
+		assert.NotNil(t, firings[0].GetExecutionId())
+	})
+	t.Run("missing payload field", func(t *testing.T) {
+		trigger := proto.Clone(storageTrigger).(*admin.Trigger)
+		trigger.Inputs = map[string]string{"path": "missing"}
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+			mock.Anything, models.TriggerEventTypeStorage).Return(
+			[]models.LaunchPlanTrigger{getTriggerModel(t, trigger)}, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).Return(nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+			mock.Anything, mock.Anything).Return(nil)
+
+		triggerManager := NewTriggerManager(repository, &managerMocks.ExecutionInterface{}, mockScope.NewTestScope())
+		firings, err := triggerManager.HandleStorageEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Len(t, firings, 1)
+		assert.Equal(t, admin.TriggerFiringOutcome_FAILED, firings[0].GetOutcome())
+		assert.Nil(t, firings[0].GetExecutionId())
+	})
+	t.Run("rate limited", func(t *testing.T) {
+		trigger := proto.Clone(storageTrigger).(*admin.Trigger)
+		trigger.MaxFiringsPerHour = 2
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+			mock.Anything, models.TriggerEventTypeStorage).Return(
+			[]models.LaunchPlanTrigger{getTriggerModel(t, trigger)}, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Count(
+			mock.Anything, getTriggerModel(t, trigger).LaunchPlanTriggerKey,
+			[]string{"FIRED", "TRIGGER_FIRING_OUTCOME_UNSPECIFIED"}, mock.Anything).Return(3, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).Return(nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+			mock.Anything, mock.Anything).Return(nil)
+
+		triggerManager := NewTriggerManager(repository, &managerMocks.ExecutionInterface{}, mockScope.NewTestScope())
+		firings, err := triggerManager.HandleStorageEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Len(t, firings, 1)
+		assert.Equal(t, admin.TriggerFiringOutcome_RATE_LIMITED, firings[0].GetOutcome())
+	})
+	t.Run("within rate limit", func(t *testing.T) {
+		trigger := proto.Clone(storageTrigger).(*admin.Trigger)
+		trigger.MaxFiringsPerHour = 2
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+			mock.Anything, models.TriggerEventTypeStorage).Return(
+			[]models.LaunchPlanTrigger{getTriggerModel(t, trigger)}, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Count(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(2, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).Return(nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+			mock.Anything, mock.Anything).Return(nil)
+		executionManager := &managerMocks.ExecutionInterface{}
+		executionManager.EXPECT().CreateExecution(mock.Anything, mock.Anything, mock.Anything).Return(
+			&admin.ExecutionCreateResponse{}, nil)
+
+		triggerManager := NewTriggerManager(repository, executionManager, mockScope.NewTestScope())
+		firings, err := triggerManager.HandleStorageEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Len(t, firings, 1)
+		assert.Equal(t, admin.TriggerFiringOutcome_FIRED, firings[0].GetOutcome())
+	})
+	t.Run("failed reservation", func(t *testing.T) {
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+			mock.Anything, models.TriggerEventTypeStorage).Return(
+			[]models.LaunchPlanTrigger{getTriggerModel(t, storageTrigger)}, nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).Return(flyteAdminErrors.NewFlyteAdminError(codes.Internal, "unavailable"))
+
+		triggerManager := NewTriggerManager(repository, &managerMocks.ExecutionInterface{}, mockScope.NewTestScope())
+		firings, err := triggerManager.HandleStorageEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Len(t, firings, 1)
+		assert.Equal(t, admin.TriggerFiringOutcome_FAILED, firings[0].GetOutcome())
+		assert.Nil(t, firings[0].GetExecutionId())
+	})
+}
+
+func TestTriggerManager_HandleWebhookEvent(t *testing.T) {
+	webhookTrigger := &admin.Trigger{
+		Name: "webhook",
+		Event: &admin.Trigger_Webhook{
+			Webhook: &admin.WebhookTrigger{TokenSha256: common.HashTriggerToken("token")},
+		},
+		Inputs: map[string]string{"path": "data.path"},
+	}
+	event := interfaces.WebhookEvent{
+		LaunchPlan:  &admin.NamedEntityIdentifier{Project: project, Domain: domain, Name: name},
+		TriggerName: "webhook",
+		Token:       "token",
+		ID:          "event",
+		Payload: map[string]interface{}{
+			"data": map[string]interface{}{"path": "s3://bucket/key"},
+		},
+	}
+
+	t.Run("fires trigger", func(t *testing.T) {
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().Get(
+			mock.Anything, getTriggerModel(t, webhookTrigger).LaunchPlanTriggerKey).Return(
+			getTriggerModel(t, webhookTrigger), nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+			mock.Anything, mock.Anything).Return(nil)
+		repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+			mock.Anything, mock.Anything).Return(nil)
+		executionManager := &managerMocks.ExecutionInterface{}
+		executionManager.EXPECT().CreateExecution(mock.Anything, mock.Anything, mock.Anything).Return(
+			&admin.ExecutionCreateResponse{}, nil)
+
+		triggerManager := NewTriggerManager(repository, executionManager, mockScope.NewTestScope())
+		firing, err := triggerManager.HandleWebhookEvent(context.Background(), event)
+		assert.NoError(t, err)
+		assert.Equal(t, admin.TriggerFiringOutcome_FIRED, firing.GetOutcome())
+	})
+	t.Run("wrong token", func(t *testing.T) {
+		repository := getMockRepositoryForTriggerTest(t)
+		repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().Get(
+			mock.Anything, mock.Anything).Return(getTriggerModel(t, webhookTrigger), nil)
+
+		triggerManager := NewTriggerManager(repository, &managerMocks.ExecutionInterface{}, mockScope.NewTestScope())
+		wrongTokenEvent := event
+		wrongTokenEvent.Token = "wrong"
+		_, err := triggerManager.HandleWebhookEvent(context.Background(), wrongTokenEvent)
+		assert.Equal(t, codes.NotFound, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+}
+
+func TestTriggerManager_HandleCloudEvent(t *testing.T) {
+	cloudEventTrigger := &admin.Trigger{
+		Name: "cloud-event",
+		Event: &admin.Trigger_CloudEvent{
+			CloudEvent: &admin.CloudEventTrigger{Type: "com.example.object.created", Source: "example"},
+		},
+		Inputs: map[string]string{"path": "data.path"},
+	}
+	repository := getMockRepositoryForTriggerTest(t)
+	repository.LaunchPlanTriggerRepo().(*repositoryMocks.LaunchPlanTriggerRepoInterface).EXPECT().ListByEventType(
+		mock.Anything, models.TriggerEventTypeCloudEvent).Return(
+		[]models.LaunchPlanTrigger{getTriggerModel(t, cloudEventTrigger)}, nil)
+	repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Create(
+		mock.Anything, mock.Anything).Return(nil)
+	repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().Update(
+		mock.Anything, mock.Anything).Return(nil)
+	executionManager := &managerMocks.ExecutionInterface{}
+	executionManager.EXPECT().CreateExecution(mock.Anything, mock.Anything, mock.Anything).Return(
+		&admin.ExecutionCreateResponse{}, nil)
+	triggerManager := NewTriggerManager(repository, executionManager, mockScope.NewTestScope())
+
+	firings, err := triggerManager.HandleCloudEvent(context.Background(), interfaces.CloudEvent{
+		ID:      "event",
+		Source:  "other",
+		Type:    "com.example.object.created",
+		Payload: map[string]interface{}{"data": map[string]interface{}{"path": "key"}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, firings)
+
+	firings, err = triggerManager.HandleCloudEvent(context.Background(), interfaces.CloudEvent{
+		ID:      "event",
+		Source:  "example",
+		Type:    "com.example.object.created",
+		Payload: map[string]interface{}{"data": map[string]interface{}{"path": "key"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, firings, 1)
+	assert.Equal(t, admin.TriggerFiringOutcome_FIRED, firings[0].GetOutcome())
+	assert.Equal(t, "example/event", firings[0].GetEventId())
+
+	_, err = triggerManager.HandleCloudEvent(context.Background(), interfaces.CloudEvent{Type: "type"})
+	assert.Error(t, err)
+}
+
+func TestTriggerManager_ListTriggerFirings(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	repository.TriggerFiringRepo().(*repositoryMocks.TriggerFiringRepoInterface).EXPECT().List(
+		mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, input repoInterfaces.ListResourceInput) ([]models.TriggerFiring, error) {
+			assert.Len(t, input.InlineFilters, 3)
+			for _, filter := range input.InlineFilters {
+				assert.Equal(t, common.TriggerFiring, filter.GetEntity())
+			}
+			return []models.TriggerFiring{
+				{
+					ID:                1,
+					Project:           project,
+					Domain:            domain,
+					Name:              name,
+					TriggerName:       triggerName,
+					LaunchPlanVersion: version,
+					EventID:           "event",
+					Outcome:           "DUPLICATE",
+					ExecutionName:     "execution",
+				},
+			}, nil
+		})
+	triggerManager := NewTriggerManager(repository, &managerMocks.ExecutionInterface{}, mockScope.NewTestScope())
+
+	firings, err := triggerManager.ListTriggerFirings(context.Background(), &admin.ResourceListRequest{
+		Id:    &admin.NamedEntityIdentifier{Project: project, Domain: domain, Name: name},
+		Limit: 1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, firings.GetFirings(), 1)
+	assert.Equal(t, admin.TriggerFiringOutcome_DUPLICATE, firings.GetFirings()[0].GetOutcome())
+	assert.Equal(t, "execution", firings.GetFirings()[0].GetExecutionId().GetName())
+	assert.NotEmpty(t, firings.GetToken())
+}
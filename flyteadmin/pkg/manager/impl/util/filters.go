@@ -65,6 +65,7 @@ var filterFieldEntityPrefix = map[string]common.Entity{
 	"admin_tag":             common.AdminTag,
 	"execution_admin_tag":   common.ExecutionAdminTag,
 	"execution_tag":         common.ExecutionTag,
+	"trigger_firing":        common.TriggerFiring,
 }
 
 func parseField(field string, primaryEntity common.Entity) (common.Entity, string) {
@@ -135,6 +136,7 @@ var allowedJoinEntities = map[common.Entity]sets.String{
 	common.Signal:              sets.NewString(common.Signal),
 	common.AdminTag:            sets.NewString(common.AdminTag),
 	common.ExecutionTag:        sets.NewString(common.ExecutionTag),
+	common.TriggerFiring:       sets.NewString(common.TriggerFiring),
 }
 
 var entityColumns = map[common.Entity]sets.String{
@@ -151,6 +153,7 @@ var entityColumns = map[common.Entity]sets.String{
 	common.Signal:              models.SignalColumns,
 	common.AdminTag:            models.AdminTagColumns,
 	common.ExecutionTag:        models.ExecutionTagColumns,
+	common.TriggerFiring:       models.TriggerFiringColumns,
 }
 
 func ParseFilters(filterParams string, primaryEntity common.Entity) ([]common.InlineFilter, error) {
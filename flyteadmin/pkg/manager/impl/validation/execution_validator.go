@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/shared"
@@ -168,3 +170,100 @@ func ValidateWorkflowExecutionIdentifier(identifier *core.WorkflowExecutionIdent
 	}
 	return nil
 }
+
+// ValidateRerunFrom validates that the node an execution re-runs from is a node of the top-level workflow, and that the
+// inputs overriding its ones are inputs it is bound to.
+func ValidateRerunFrom(rerunFrom *admin.RerunFromNode, workflow *core.CompiledWorkflowClosure) error {
+	if rerunFrom == nil {
+		return nil
+	}
+	if err := ValidateEmptyStringField(rerunFrom.GetNodeId(), "rerun_from.node_id"); err != nil {
+		return err
+	}
+	for _, node := range workflow.GetPrimary().GetTemplate().GetNodes() {
+		if node.GetId() != rerunFrom.GetNodeId() {
+			continue
+		}
+		boundInputs := make(map[string]bool, len(node.GetInputs()))
+		for _, binding := range node.GetInputs() {
+			boundInputs[binding.GetVar()] = true
+		}
+		for name := range rerunFrom.GetInputs().GetLiterals() {
+			if !boundInputs[name] {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"node [%s] has no input named [%s]", rerunFrom.GetNodeId(), name)
+			}
+		}
+		return nil
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+		"workflow [%s] has no node [%s]", workflow.GetPrimary().GetTemplate().GetId().GetName(), rerunFrom.GetNodeId())
+}
+
+// ValidateRecoveredNodes validates that the nodes a recovered execution of a workflow other than the one of its
+// reference execution recovers exist in the workflow of the reference execution, with the same ids. These are all the
+// nodes of the workflow, including the ones nested in its sub-workflows, branches and array nodes, except the node it
+// re-runs from and the ones downstream of it.
+func ValidateRecoveredNodes(
+	workflow, referenceWorkflow *core.CompiledWorkflowClosure, rerunFrom *admin.RerunFromNode) error {
+	rerunNodes := sets.NewString()
+	if rerunFrom != nil {
+		pending := []string{rerunFrom.GetNodeId()}
+		for len(pending) > 0 {
+			nodeID := pending[0]
+			pending = pending[1:]
+			if rerunNodes.Has(nodeID) {
+				continue
+			}
+			rerunNodes.Insert(nodeID)
+			pending = append(pending, workflow.GetPrimary().GetConnections().GetDownstream()[nodeID].GetIds()...)
+		}
+	}
+
+	referenceNodes := sets.NewString()
+	collectNodePaths(referenceWorkflow, referenceWorkflow.GetPrimary().GetTemplate().GetNodes(), "", referenceNodes)
+	recoveredNodes := sets.NewString()
+	for _, node := range workflow.GetPrimary().GetTemplate().GetNodes() {
+		if !rerunNodes.Has(node.GetId()) {
+			collectNodePaths(workflow, []*core.Node{node}, "", recoveredNodes)
+		}
+	}
+	if missing := recoveredNodes.Difference(referenceNodes); missing.Len() > 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"nodes [%s] to recover are missing from the workflow of the reference execution",
+			strings.Join(missing.List(), ", "))
+	}
+	return nil
+}
+
+// collectNodePaths inserts the paths of the nodes and of all the nodes nested in them into paths. The path of a nested
+// node is the one of its parent followed by a slash and its id.
+func collectNodePaths(workflow *core.CompiledWorkflowClosure, nodes []*core.Node, prefix string, paths sets.String) {
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		path := prefix + node.GetId()
+		paths.Insert(path)
+		var nested []*core.Node
+		switch {
+		case node.GetWorkflowNode().GetSubWorkflowRef() != nil:
+			for _, subWorkflow := range workflow.GetSubWorkflows() {
+				if proto.Equal(subWorkflow.GetTemplate().GetId(), node.GetWorkflowNode().GetSubWorkflowRef()) {
+					nested = subWorkflow.GetTemplate().GetNodes()
+					break
+				}
+			}
+		case node.GetBranchNode() != nil:
+			ifElse := node.GetBranchNode().GetIfElse()
+			nested = append(nested, ifElse.GetCase().GetThenNode())
+			for _, other := range ifElse.GetOther() {
+				nested = append(nested, other.GetThenNode())
+			}
+			nested = append(nested, ifElse.GetElseNode())
+		case node.GetArrayNode() != nil:
+			nested = append(nested, node.GetArrayNode().GetNode())
+		}
+		collectNodePaths(workflow, nested, path+"/", paths)
+	}
+}
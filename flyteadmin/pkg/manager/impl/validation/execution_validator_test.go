@@ -314,3 +314,88 @@ func TestValidateWorkflowExecutionIdentifier_Error(t *testing.T) {
 		Name:   "name",
 	}))
 }
+
+func TestValidateRerunFrom(t *testing.T) {
+	workflow := &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Id: &core.Identifier{Name: "wf"},
+				Nodes: []*core.Node{
+					{
+						Id: "n0",
+						Inputs: []*core.Binding{
+							{Var: "x"},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, ValidateRerunFrom(nil, workflow))
+	assert.NoError(t, ValidateRerunFrom(&admin.RerunFromNode{NodeId: "n0"}, workflow))
+	assert.NoError(t, ValidateRerunFrom(&admin.RerunFromNode{
+		NodeId: "n0",
+		Inputs: &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(1)}},
+	}, workflow))
+
+	assert.EqualError(t, ValidateRerunFrom(&admin.RerunFromNode{}, workflow), "missing rerun_from.node_id")
+	assert.EqualError(t, ValidateRerunFrom(&admin.RerunFromNode{NodeId: "n1"}, workflow),
+		"workflow [wf] has no node [n1]")
+	assert.EqualError(t, ValidateRerunFrom(&admin.RerunFromNode{
+		NodeId: "n0",
+		Inputs: &core.LiteralMap{Literals: map[string]*core.Literal{"y": coreutils.MustMakeLiteral(1)}},
+	}, workflow), "node [n0] has no input named [y]")
+}
+
+func TestValidateRecoveredNodes(t *testing.T) {
+	subWorkflowID := &core.Identifier{Name: "sub"}
+	// n0 -> n1 -> n2, with n1 running a sub-workflow and n2 a branch.
+	newWorkflow := func(subNodeID, elseNodeID string) *core.CompiledWorkflowClosure {
+		return &core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template: &core.WorkflowTemplate{
+					Nodes: []*core.Node{
+						{Id: "n0"},
+						{
+							Id: "n1",
+							Target: &core.Node_WorkflowNode{WorkflowNode: &core.WorkflowNode{
+								Reference: &core.WorkflowNode_SubWorkflowRef{SubWorkflowRef: subWorkflowID},
+							}},
+						},
+						{
+							Id: "n2",
+							Target: &core.Node_BranchNode{BranchNode: &core.BranchNode{IfElse: &core.IfElseBlock{
+								Case:    &core.IfBlock{ThenNode: &core.Node{Id: "then"}},
+								Default: &core.IfElseBlock_ElseNode{ElseNode: &core.Node{Id: elseNodeID}},
+							}}},
+						},
+					},
+				},
+				Connections: &core.ConnectionSet{
+					Downstream: map[string]*core.ConnectionSet_IdList{
+						"n0": {Ids: []string{"n1"}},
+						"n1": {Ids: []string{"n2"}},
+					},
+				},
+			},
+			SubWorkflows: []*core.CompiledWorkflow{
+				{
+					Template: &core.WorkflowTemplate{
+						Id:    subWorkflowID,
+						Nodes: []*core.Node{{Id: subNodeID}},
+					},
+				},
+			},
+		}
+	}
+	referenceWorkflow := newWorkflow("s0", "else")
+
+	assert.NoError(t, ValidateRecoveredNodes(newWorkflow("s0", "else"), referenceWorkflow, nil))
+	assert.EqualError(t, ValidateRecoveredNodes(newWorkflow("s1", "other"), referenceWorkflow, nil),
+		"nodes [n1/s1, n2/other] to recover are missing from the workflow of the reference execution")
+	assert.EqualError(t, ValidateRecoveredNodes(newWorkflow("s1", "other"), referenceWorkflow,
+		&admin.RerunFromNode{NodeId: "n2"}),
+		"nodes [n1/s1] to recover are missing from the workflow of the reference execution")
+	assert.NoError(t, ValidateRecoveredNodes(newWorkflow("s1", "other"), referenceWorkflow,
+		&admin.RerunFromNode{NodeId: "n1"}))
+}
@@ -2,6 +2,8 @@ package validation
 
 import (
 	"context"
+	"encoding/hex"
+	"regexp"
 
 	"github.com/robfig/cron/v3"
 	"google.golang.org/grpc/codes"
@@ -55,6 +57,9 @@ func ValidateLaunchPlan(ctx context.Context,
 	if err := validateSchedule(request, expectedInputs); err != nil {
 		return err
 	}
+	if err := validateTriggers(request.GetSpec().GetEntityMetadata().GetTriggers(), expectedInputs); err != nil {
+		return err
+	}
 
 	// Augment default inputs with the unbound workflow inputs.
 	request.Spec.DefaultInputs = expectedInputs
@@ -109,6 +114,59 @@ func validateSchedule(request *admin.LaunchPlanCreateRequest, expectedInputs *co
 	return nil
 }
 
+var triggerNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func validateTriggers(triggers []*admin.Trigger, expectedInputs *core.ParameterMap) error {
+	triggerNames := make(map[string]bool, len(triggers))
+	for _, trigger := range triggers {
+		if !triggerNameRegex.MatchString(trigger.GetName()) {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"invalid trigger name [%s], must consist of alphanumeric characters, '-' and '_'", trigger.GetName())
+		}
+		if triggerNames[trigger.GetName()] {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "duplicate trigger name [%s]", trigger.GetName())
+		}
+		triggerNames[trigger.GetName()] = true
+
+		switch event := trigger.GetEvent().(type) {
+		case *admin.Trigger_Storage:
+			if _, err := common.CompileStoragePattern(event.Storage.GetUriPattern()); err != nil {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"invalid uri pattern of trigger [%s]: %v", trigger.GetName(), err)
+			}
+		case *admin.Trigger_Webhook:
+			if digest, err := hex.DecodeString(event.Webhook.GetTokenSha256()); err != nil || len(digest) != 32 {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"token of trigger [%s] must be a hex encoded SHA-256 digest", trigger.GetName())
+			}
+		case *admin.Trigger_CloudEvent:
+			if event.CloudEvent.GetType() == "" {
+				return shared.GetMissingArgumentError("cloud_event.type")
+			}
+		default:
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "trigger [%s] has no event", trigger.GetName())
+		}
+
+		for input, path := range trigger.GetInputs() {
+			if _, ok := expectedInputs.GetParameters()[input]; !ok {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"trigger [%s] maps [%s] which is not a free input", trigger.GetName(), input)
+			}
+			if path == "" {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"trigger [%s] maps [%s] onto no payload field", trigger.GetName(), input)
+			}
+		}
+		for key, value := range expectedInputs.GetParameters() {
+			if _, ok := trigger.GetInputs()[key]; value.GetRequired() && !ok {
+				return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+					"Cannot create a launch plan with a trigger if there is an unbound required input. [%v] is required", key)
+			}
+		}
+	}
+	return nil
+}
+
 func checkAndFetchExpectedInputForLaunchPlan(
 	workflowVariableMap *core.VariableMap, fixedInputs *core.LiteralMap, defaultInputs *core.ParameterMap) (*core.ParameterMap, error) {
 	expectedInputMap := map[string]*core.Parameter{}
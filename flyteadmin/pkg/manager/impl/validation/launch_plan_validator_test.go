@@ -477,3 +477,80 @@ func TestValidateSchedule_InvalidCronExpression(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestValidateTriggers(t *testing.T) {
+	inputMap := &core.ParameterMap{
+		Parameters: map[string]*core.Parameter{
+			foo: {
+				Var: &core.Variable{
+					Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}},
+				},
+				Behavior: &core.Parameter_Required{
+					Required: true,
+				},
+			},
+		},
+	}
+	storageTrigger := func() *admin.Trigger {
+		return &admin.Trigger{
+			Name: "on-upload",
+			Event: &admin.Trigger_Storage{
+				Storage: &admin.StorageEventTrigger{UriPattern: "s3://bucket/prefix/*"},
+			},
+			Inputs: map[string]string{foo: "key"},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		webhookTrigger := &admin.Trigger{
+			Name: "webhook",
+			Event: &admin.Trigger_Webhook{
+				Webhook: &admin.WebhookTrigger{
+					TokenSha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+				},
+			},
+			Inputs: map[string]string{foo: "data.date"},
+		}
+		assert.NoError(t, validateTriggers([]*admin.Trigger{storageTrigger(), webhookTrigger}, inputMap))
+	})
+	t.Run("duplicate name", func(t *testing.T) {
+		err := validateTriggers([]*admin.Trigger{storageTrigger(), storageTrigger()}, inputMap)
+		assert.EqualError(t, err, "duplicate trigger name [on-upload]")
+	})
+	t.Run("invalid name", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.Name = "on/upload"
+		assert.Error(t, validateTriggers([]*admin.Trigger{trigger}, inputMap))
+	})
+	t.Run("no event", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.Event = nil
+		assert.EqualError(t, validateTriggers([]*admin.Trigger{trigger}, inputMap), "trigger [on-upload] has no event")
+	})
+	t.Run("invalid uri pattern", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.GetStorage().UriPattern = "bucket/prefix/*"
+		assert.Error(t, validateTriggers([]*admin.Trigger{trigger}, inputMap))
+	})
+	t.Run("invalid token digest", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.Event = &admin.Trigger_Webhook{Webhook: &admin.WebhookTrigger{TokenSha256: "token"}}
+		assert.Error(t, validateTriggers([]*admin.Trigger{trigger}, inputMap))
+	})
+	t.Run("missing cloud event type", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.Event = &admin.Trigger_CloudEvent{CloudEvent: &admin.CloudEventTrigger{}}
+		assert.EqualError(t, validateTriggers([]*admin.Trigger{trigger}, inputMap), "missing cloud_event.type")
+	})
+	t.Run("unknown input", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.Inputs["bar"] = "key"
+		assert.EqualError(t, validateTriggers([]*admin.Trigger{trigger}, inputMap),
+			"trigger [on-upload] maps [bar] which is not a free input")
+	})
+	t.Run("unbound required input", func(t *testing.T) {
+		trigger := storageTrigger()
+		trigger.Inputs = nil
+		assert.Error(t, validateTriggers([]*admin.Trigger{trigger}, inputMap))
+	})
+}
@@ -319,6 +319,25 @@ func ValidateToken(token string) (int, error) {
 	return offset, nil
 }
 
+// ValidatePageToken validates the token of a list request sorted by sortParameter. Numeric tokens are offsets, validated
+// by ValidateToken, and any other token is a cursor issued for a request with the same sort parameter.
+func ValidatePageToken(token string, sortParameter common.SortParameter) (common.PageToken, error) {
+	if _, err := strconv.Atoi(token); token == "" || err == nil {
+		offset, err := ValidateToken(token)
+		return common.PageToken{Offset: offset}, err
+	}
+	cursor, err := common.DecodeCursor(token)
+	if err != nil {
+		return common.PageToken{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "Invalid token value: %s", token)
+	}
+	if sortParameter == nil || cursor.SortKey != sortParameter.GetKey() ||
+		cursor.Descending != sortParameter.IsDescending() {
+		return common.PageToken{}, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"Token %s was issued for a different sort order", token)
+	}
+	return common.PageToken{Cursor: cursor}, nil
+}
+
 func ValidateLimit(limit uint32) error {
 	if limit == 0 {
 		return shared.GetInvalidArgumentError(shared.Limit)
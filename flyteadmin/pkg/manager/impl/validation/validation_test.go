@@ -12,6 +12,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/shared"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
@@ -367,6 +368,55 @@ func TestValidateToken(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestValidatePageToken(t *testing.T) {
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	}, models.ExecutionColumns)
+	assert.NoError(t, err)
+	cursorToken := common.NewPageToken(sortParameter, common.PageToken{}, 1, &models.Execution{
+		BaseModel: models.BaseModel{ID: 3},
+	}, models.IDColumns)
+
+	t.Run("offset", func(t *testing.T) {
+		pageToken, err := ValidatePageToken("2", sortParameter)
+		assert.NoError(t, err)
+		assert.Equal(t, common.PageToken{Offset: 2}, pageToken)
+
+		pageToken, err = ValidatePageToken("", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, common.PageToken{}, pageToken)
+
+		_, err = ValidatePageToken("-1", sortParameter)
+		assert.Error(t, err)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		pageToken, err := ValidatePageToken(cursorToken, sortParameter)
+		assert.NoError(t, err)
+		assert.Zero(t, pageToken.Offset)
+		assert.Equal(t, int64(3), pageToken.Cursor.Values[1])
+	})
+
+	t.Run("cursor of a different sort order", func(t *testing.T) {
+		ascending, err := common.NewSortParameter(&admin.Sort{
+			Key:       "created_at",
+			Direction: admin.Sort_ASCENDING,
+		}, models.ExecutionColumns)
+		assert.NoError(t, err)
+		_, err = ValidatePageToken(cursorToken, ascending)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+
+		_, err = ValidatePageToken(cursorToken, nil)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ValidatePageToken("foo", sortParameter)
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	})
+}
+
 func TestValidateActiveLaunchPlanRequest(t *testing.T) {
 	err := ValidateActiveLaunchPlanRequest(
 		&admin.ActiveLaunchPlanRequest{
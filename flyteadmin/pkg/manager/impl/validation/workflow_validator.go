@@ -60,3 +60,23 @@ func ValidateCompiledWorkflow(identifier *core.Identifier, workflow *admin.Workf
 	}
 	return nil
 }
+
+func ValidateWorkflowPromoteRequest(
+	ctx context.Context, request *admin.WorkflowPromoteRequest, db repositoryInterfaces.Repository,
+	config runtime.ApplicationConfiguration) error {
+	if err := ValidateIdentifier(request.GetId(), common.Workflow); err != nil {
+		return err
+	}
+	if err := ValidateEmptyStringField(request.GetTargetDomain(), shared.Domain); err != nil {
+		return err
+	}
+	targetProject := request.GetTargetProject()
+	if len(targetProject) == 0 {
+		targetProject = request.GetId().GetProject()
+	}
+	if targetProject == request.GetId().GetProject() && request.GetTargetDomain() == request.GetId().GetDomain() {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"cannot promote workflow [%+v] to the project and domain it is registered in", request.GetId())
+	}
+	return ValidateProjectAndDomain(ctx, db, config, targetProject, request.GetTargetDomain())
+}
@@ -79,3 +79,38 @@ func TestValidateCompiledWorkflow(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.EqualError(t, err, "Workflow closure size exceeds max limit [1]")
 }
+
+func TestValidateWorkflowPromoteRequest(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		request := &admin.WorkflowPromoteRequest{
+			Id:           testutils.GetWorkflowRequest().GetId(),
+			TargetDomain: "production",
+		}
+		err := ValidateWorkflowPromoteRequest(context.Background(), request, testutils.GetRepoWithDefaultProject(), workflowConfig)
+		assert.NoError(t, err)
+	})
+	t.Run("missing target domain", func(t *testing.T) {
+		request := &admin.WorkflowPromoteRequest{
+			Id: testutils.GetWorkflowRequest().GetId(),
+		}
+		err := ValidateWorkflowPromoteRequest(context.Background(), request, testutils.GetRepoWithDefaultProject(), workflowConfig)
+		assert.EqualError(t, err, "missing domain")
+	})
+	t.Run("same project and domain", func(t *testing.T) {
+		request := &admin.WorkflowPromoteRequest{
+			Id:            testutils.GetWorkflowRequest().GetId(),
+			TargetProject: "project",
+			TargetDomain:  "domain",
+		}
+		err := ValidateWorkflowPromoteRequest(context.Background(), request, testutils.GetRepoWithDefaultProject(), workflowConfig)
+		assert.Error(t, err)
+	})
+	t.Run("unrecognized target domain", func(t *testing.T) {
+		request := &admin.WorkflowPromoteRequest{
+			Id:           testutils.GetWorkflowRequest().GetId(),
+			TargetDomain: "foo",
+		}
+		err := ValidateWorkflowPromoteRequest(context.Background(), request, testutils.GetRepoWithDefaultProject(), workflowConfig)
+		assert.EqualError(t, err, "domain [foo] is unrecognized by system")
+	})
+}
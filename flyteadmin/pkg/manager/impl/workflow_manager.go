@@ -3,7 +3,6 @@ package impl
 import (
 	"bytes"
 	"context"
-	"strconv"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -263,14 +262,15 @@ func (w *WorkflowManager) ListWorkflows(
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListWorkflows", request.GetToken())
 	}
 	listWorkflowsInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -287,7 +287,8 @@ func (w *WorkflowManager) ListWorkflows(
 	}
 	var token string
 	if len(output.Workflows) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.Workflows))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.Workflows),
+			output.Workflows[len(output.Workflows)-1], models.IDColumns)
 	}
 	return &admin.WorkflowList{
 		Workflows: workflowList,
@@ -316,14 +317,15 @@ func (w *WorkflowManager) ListWorkflowIdentifiers(ctx context.Context, request *
 		return nil, err
 	}
 
-	offset, err := validation.ValidateToken(request.GetToken())
+	pageToken, err := validation.ValidatePageToken(request.GetToken(), sortParameter)
 	if err != nil {
 		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 			"invalid pagination token %s for ListWorkflowIdentifiers", request.GetToken())
 	}
 	listWorkflowsInput := repoInterfaces.ListResourceInput{
 		Limit:         int(request.GetLimit()),
-		Offset:        offset,
+		Offset:        pageToken.Offset,
+		Cursor:        pageToken.Cursor,
 		InlineFilters: filters,
 		SortParameter: sortParameter,
 	}
@@ -337,7 +339,8 @@ func (w *WorkflowManager) ListWorkflowIdentifiers(ctx context.Context, request *
 
 	var token string
 	if len(output.Workflows) == int(request.GetLimit()) {
-		token = strconv.Itoa(offset + len(output.Workflows))
+		token = common.NewPageToken(sortParameter, pageToken, len(output.Workflows),
+			output.Workflows[len(output.Workflows)-1], models.IdentifierColumns)
 	}
 	entities := transformers.FromWorkflowModelsToIdentifiers(output.Workflows)
 	return &admin.NamedEntityIdentifierList{
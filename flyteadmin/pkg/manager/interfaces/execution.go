@@ -30,4 +30,7 @@ type ExecutionInterface interface {
 	ListExecutions(ctx context.Context, request *admin.ResourceListRequest) (*admin.ExecutionList, error)
 	TerminateExecution(
 		ctx context.Context, request *admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+	// Stops a running workflow execution from starting new nodes until it is resumed.
+	PauseExecution(ctx context.Context, request *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error)
+	ResumeExecution(ctx context.Context, request *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error)
 }
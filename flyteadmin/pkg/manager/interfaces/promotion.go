@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+//go:generate mockery --name=PromotionInterface --output=../mocks --case=underscore --with-expecter
+
+// Interface for promoting Flyte entities across projects and domains
+type PromotionInterface interface {
+	PromoteWorkflow(ctx context.Context, request *admin.WorkflowPromoteRequest) (*admin.WorkflowPromoteResponse, error)
+	GetPromotion(ctx context.Context, request *admin.ObjectGetRequest) (*admin.Promotion, error)
+}
@@ -0,0 +1,51 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// StorageEvent is the creation of an object in a data store, as notified by the store.
+type StorageEvent struct {
+	// Identifier of the event, shared by the deliveries of the same event.
+	ID     string
+	URI    string
+	Bucket string
+	Key    string
+	Size   int64
+	ETag   string
+}
+
+// WebhookEvent is a request posted to the webhook of a trigger.
+type WebhookEvent struct {
+	LaunchPlan  *admin.NamedEntityIdentifier
+	TriggerName string
+	// Token the request carried as a bearer token.
+	Token string
+	// Identifier of the event, shared by the deliveries of the same event.
+	ID      string
+	Payload map[string]interface{}
+}
+
+// CloudEvent is a CloudEvent in its structured json representation.
+type CloudEvent struct {
+	ID     string
+	Source string
+	Type   string
+	// The whole json representation of the event, holding its data in the data field.
+	Payload map[string]interface{}
+}
+
+//go:generate mockery --name=TriggerInterface --output=../mocks --case=underscore --with-expecter
+
+// Interface for launching executions of launch plans on the events matching their triggers.
+type TriggerInterface interface {
+	// Fires the storage event triggers whose uri pattern matches the created object.
+	HandleStorageEvent(ctx context.Context, event StorageEvent) ([]*admin.TriggerFiring, error)
+	// Fires the webhook trigger an event was posted to, provided the event carries its token.
+	HandleWebhookEvent(ctx context.Context, event WebhookEvent) (*admin.TriggerFiring, error)
+	// Fires the CloudEvent triggers matching the type and source of an event.
+	HandleCloudEvent(ctx context.Context, event CloudEvent) ([]*admin.TriggerFiring, error)
+	ListTriggerFirings(ctx context.Context, request *admin.ResourceListRequest) (*admin.TriggerFiringList, error)
+}
@@ -321,6 +321,65 @@ func (_c *ExecutionInterface_ListExecutions_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// PauseExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionInterface) PauseExecution(ctx context.Context, request *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseExecution")
+	}
+
+	var r0 *admin.ExecutionPauseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionPauseRequest) *admin.ExecutionPauseResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ExecutionPauseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ExecutionPauseRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecutionInterface_PauseExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseExecution'
+type ExecutionInterface_PauseExecution_Call struct {
+	*mock.Call
+}
+
+// PauseExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *admin.ExecutionPauseRequest
+func (_e *ExecutionInterface_Expecter) PauseExecution(ctx interface{}, request interface{}) *ExecutionInterface_PauseExecution_Call {
+	return &ExecutionInterface_PauseExecution_Call{Call: _e.mock.On("PauseExecution", ctx, request)}
+}
+
+func (_c *ExecutionInterface_PauseExecution_Call) Run(run func(ctx context.Context, request *admin.ExecutionPauseRequest)) *ExecutionInterface_PauseExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ExecutionPauseRequest))
+	})
+	return _c
+}
+
+func (_c *ExecutionInterface_PauseExecution_Call) Return(_a0 *admin.ExecutionPauseResponse, _a1 error) *ExecutionInterface_PauseExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExecutionInterface_PauseExecution_Call) RunAndReturn(run func(context.Context, *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error)) *ExecutionInterface_PauseExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecoverExecution provides a mock function with given fields: ctx, request, requestedAt
 func (_m *ExecutionInterface) RecoverExecution(ctx context.Context, request *admin.ExecutionRecoverRequest, requestedAt time.Time) (*admin.ExecutionCreateResponse, error) {
 	ret := _m.Called(ctx, request, requestedAt)
@@ -441,6 +500,65 @@ func (_c *ExecutionInterface_RelaunchExecution_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ResumeExecution provides a mock function with given fields: ctx, request
+func (_m *ExecutionInterface) ResumeExecution(ctx context.Context, request *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeExecution")
+	}
+
+	var r0 *admin.ExecutionResumeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionResumeRequest) *admin.ExecutionResumeResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ExecutionResumeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ExecutionResumeRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecutionInterface_ResumeExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeExecution'
+type ExecutionInterface_ResumeExecution_Call struct {
+	*mock.Call
+}
+
+// ResumeExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *admin.ExecutionResumeRequest
+func (_e *ExecutionInterface_Expecter) ResumeExecution(ctx interface{}, request interface{}) *ExecutionInterface_ResumeExecution_Call {
+	return &ExecutionInterface_ResumeExecution_Call{Call: _e.mock.On("ResumeExecution", ctx, request)}
+}
+
+func (_c *ExecutionInterface_ResumeExecution_Call) Run(run func(ctx context.Context, request *admin.ExecutionResumeRequest)) *ExecutionInterface_ResumeExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ExecutionResumeRequest))
+	})
+	return _c
+}
+
+func (_c *ExecutionInterface_ResumeExecution_Call) Return(_a0 *admin.ExecutionResumeResponse, _a1 error) *ExecutionInterface_ResumeExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExecutionInterface_ResumeExecution_Call) RunAndReturn(run func(context.Context, *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error)) *ExecutionInterface_ResumeExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateExecution provides a mock function with given fields: ctx, request
 func (_m *ExecutionInterface) TerminateExecution(ctx context.Context, request *admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	ret := _m.Called(ctx, request)
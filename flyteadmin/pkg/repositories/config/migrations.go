@@ -3,6 +3,7 @@ package config
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-gormigrate/gormigrate/v2"
@@ -1298,6 +1299,211 @@ var ContinuedMigrations = []*gormigrate.Migration{
 			return tx.Migrator().DropIndex(&models.Execution{}, "idx_executions_phase")
 		},
 	},
+
+	// Create the table of the phase changes streamed to the watchers of executions
+	{
+		ID: "2026-10-18-execution-phase-changes",
+		Migrate: func(tx *gorm.DB) error {
+			type ExecutionPhaseChange struct {
+				ID        uint      `gorm:"primary_key"`
+				CreatedAt time.Time `gorm:"index"`
+				Project   string    `gorm:"column:execution_project;size:255;index:idx_execution_phase_changes_execution"`
+				Domain    string    `gorm:"column:execution_domain;size:255;index:idx_execution_phase_changes_execution"`
+				Name      string    `gorm:"column:execution_name;size:255;index:idx_execution_phase_changes_execution"`
+				Change    []byte
+			}
+			return tx.AutoMigrate(&ExecutionPhaseChange{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("execution_phase_changes")
+		},
+	},
+
+	// Create the indexes serving the pages following cursors of the executions, node executions and task executions
+	// sorted by creation time, the sort these lists are usually paginated with.
+	{
+		ID: "2026-10-18-keyset-pagination-indexes",
+		Migrate: func(tx *gorm.DB) error {
+			for _, index := range keysetPaginationIndexes {
+				if err := createIndexIfNotExists(tx, index.table, index.name, index.columns); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, index := range keysetPaginationIndexes {
+				if err := tx.Migrator().DropIndex(index.table, index.name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+
+	// Create the tables of the triggers of active launch plans and of the events which matched them
+	{
+		ID: "2026-10-19-launch-plan-triggers",
+		Migrate: func(tx *gorm.DB) error {
+			type LaunchPlanTrigger struct {
+				ID          uint `gorm:"index;autoIncrement;not null"`
+				CreatedAt   time.Time
+				UpdatedAt   time.Time
+				DeletedAt   *time.Time `gorm:"index"`
+				Project     string     `gorm:"primary_key;size:255"`
+				Domain      string     `gorm:"primary_key;size:255"`
+				Name        string     `gorm:"primary_key;size:255"`
+				TriggerName string     `gorm:"primary_key;size:255"`
+				Version     string     `gorm:"size:255"`
+				EventType   string     `gorm:"size:255;index"`
+				Trigger     []byte     `gorm:"not null"`
+			}
+			type TriggerFiring struct {
+				ID                uint      `gorm:"primary_key"`
+				CreatedAt         time.Time `gorm:"index:idx_trigger_firings_trigger,priority:5"`
+				Project           string    `gorm:"size:255;index:idx_trigger_firings_trigger,priority:1"`
+				Domain            string    `gorm:"size:255;index:idx_trigger_firings_trigger,priority:2"`
+				Name              string    `gorm:"size:255;index:idx_trigger_firings_trigger,priority:3"`
+				TriggerName       string    `gorm:"size:255;index:idx_trigger_firings_trigger,priority:4"`
+				LaunchPlanVersion string    `gorm:"size:255"`
+				EventID           string
+				Outcome           string `gorm:"size:255"`
+				ExecutionName     string `gorm:"size:255"`
+				Message           string
+			}
+			return tx.AutoMigrate(&LaunchPlanTrigger{}, &TriggerFiring{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable("trigger_firings"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable("launch_plan_triggers")
+		},
+	},
+
+	// Create the table recording where promoted workflows, tasks and launch plans were promoted from
+	{
+		ID: "2026-10-19-promotions",
+		Migrate: func(tx *gorm.DB) error {
+			type Promotion struct {
+				ID            uint `gorm:"index;autoIncrement;not null"`
+				CreatedAt     time.Time
+				UpdatedAt     time.Time
+				DeletedAt     *time.Time `gorm:"index"`
+				ResourceType  string     `gorm:"primary_key;size:255"`
+				Project       string     `gorm:"primary_key;size:255"`
+				Domain        string     `gorm:"primary_key;size:255"`
+				Name          string     `gorm:"primary_key;size:255"`
+				Version       string     `gorm:"primary_key;size:255"`
+				SourceProject string     `gorm:"size:255"`
+				SourceDomain  string     `gorm:"size:255"`
+				PromotedBy    string     `gorm:"size:255"`
+			}
+			return tx.AutoMigrate(&Promotion{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("promotions")
+		},
+	},
+
+	// Create the table recording where pruned executions were archived, and the index serving the lookup of the
+	// executions past their retention period
+	{
+		ID: "2026-10-19-execution-archives",
+		Migrate: func(tx *gorm.DB) error {
+			type ExecutionArchive struct {
+				ID                 uint `gorm:"index;autoIncrement;not null"`
+				CreatedAt          time.Time
+				UpdatedAt          time.Time
+				DeletedAt          *time.Time `gorm:"index"`
+				Project            string     `gorm:"primary_key;column:execution_project;size:255"`
+				Domain             string     `gorm:"primary_key;column:execution_domain;size:255"`
+				Name               string     `gorm:"primary_key;column:execution_name;size:255"`
+				Phase              string     `gorm:"size:255"`
+				ExecutionUpdatedAt *time.Time
+				Location           string
+			}
+			if err := tx.AutoMigrate(&ExecutionArchive{}); err != nil {
+				return err
+			}
+			return createIndexIfNotExists(tx, "executions", "idx_executions_retention",
+				[]string{"execution_project", "execution_domain", "execution_updated_at"})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex("executions", "idx_executions_retention"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable("execution_archives")
+		},
+	},
+
+	// Create the table of the leases of the watchers of executions, which the phase changes are only recorded for
+	{
+		ID: "2026-10-19-execution-watches",
+		Migrate: func(tx *gorm.DB) error {
+			type ExecutionWatch struct {
+				Project   string    `gorm:"primary_key;column:execution_project;size:255"`
+				Domain    string    `gorm:"primary_key;column:execution_domain;size:255"`
+				Name      string    `gorm:"primary_key;column:execution_name;size:255"`
+				ExpiresAt time.Time `gorm:"index"`
+			}
+			return tx.AutoMigrate(&ExecutionWatch{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("execution_watches")
+		},
+	},
+}
+
+var keysetPaginationIndexes = []struct {
+	table   string
+	name    string
+	columns []string
+}{
+	{
+		table:   "executions",
+		name:    "idx_executions_keyset_created_at",
+		columns: []string{"execution_project", "execution_domain", "created_at", "id"},
+	},
+	{
+		table:   "node_executions",
+		name:    "idx_node_executions_keyset_created_at",
+		columns: []string{"execution_project", "execution_domain", "execution_name", "created_at", "id"},
+	},
+	{
+		table:   "task_executions",
+		name:    "idx_task_executions_keyset_created_at",
+		columns: []string{"execution_project", "execution_domain", "execution_name", "node_id", "created_at", "id"},
+	},
+}
+
+// createIndexIfNotExists creates an index unless it already exists. On Postgres the index is built concurrently, so
+// that building it on large tables doesn't block the writes to them. This is possible because migrations don't run in a
+// transaction. A concurrent build which failed leaves an invalid index behind, which is dropped and built again.
+func createIndexIfNotExists(db *gorm.DB, table, name string, columns []string) error {
+	switch db.Dialector.Name() {
+	case "postgres":
+		var invalid bool
+		if err := db.Raw("SELECT NOT indisvalid FROM pg_index WHERE indexrelid = to_regclass(?)", name).
+			Scan(&invalid).Error; err != nil {
+			return err
+		}
+		if invalid {
+			if err := db.Exec(fmt.Sprintf("DROP INDEX CONCURRENTLY IF EXISTS %s", name)).Error; err != nil {
+				return err
+			}
+		}
+		return db.Exec(fmt.Sprintf("CREATE INDEX CONCURRENTLY IF NOT EXISTS %s ON %s (%s)", name, table,
+			strings.Join(columns, ", "))).Error
+	case "mysql":
+		// MySQL doesn't support CREATE INDEX IF NOT EXISTS.
+		if db.Migrator().HasIndex(table, name) {
+			return nil
+		}
+		return db.Exec(fmt.Sprintf("CREATE INDEX %s ON %s (%s)", name, table, strings.Join(columns, ", "))).Error
+	}
+	return db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)", name, table,
+		strings.Join(columns, ", "))).Error
 }
 
 var m = append(LegacyMigrations, NoopMigrations...)
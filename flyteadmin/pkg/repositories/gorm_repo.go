@@ -15,6 +15,7 @@ type GormRepo struct {
 	db                           *gorm.DB
 	executionRepo                interfaces.ExecutionRepoInterface
 	executionEventRepo           interfaces.ExecutionEventRepoInterface
+	executionPhaseChangeRepo     interfaces.ExecutionPhaseChangeRepoInterface
 	namedEntityRepo              interfaces.NamedEntityRepoInterface
 	launchPlanRepo               interfaces.LaunchPlanRepoInterface
 	projectRepo                  interfaces.ProjectRepoInterface
@@ -28,6 +29,10 @@ type GormRepo struct {
 	schedulableEntityRepo        schedulerInterfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
 	signalRepo                   interfaces.SignalRepoInterface
+	launchPlanTriggerRepo        interfaces.LaunchPlanTriggerRepoInterface
+	triggerFiringRepo            interfaces.TriggerFiringRepoInterface
+	promotionRepo                interfaces.PromotionRepoInterface
+	executionArchiveRepo         interfaces.ExecutionArchiveRepoInterface
 }
 
 func (r *GormRepo) ExecutionRepo() interfaces.ExecutionRepoInterface {
@@ -38,6 +43,10 @@ func (r *GormRepo) ExecutionEventRepo() interfaces.ExecutionEventRepoInterface {
 	return r.executionEventRepo
 }
 
+func (r *GormRepo) ExecutionPhaseChangeRepo() interfaces.ExecutionPhaseChangeRepoInterface {
+	return r.executionPhaseChangeRepo
+}
+
 func (r *GormRepo) LaunchPlanRepo() interfaces.LaunchPlanRepoInterface {
 	return r.launchPlanRepo
 }
@@ -90,6 +99,22 @@ func (r *GormRepo) SignalRepo() interfaces.SignalRepoInterface {
 	return r.signalRepo
 }
 
+func (r *GormRepo) LaunchPlanTriggerRepo() interfaces.LaunchPlanTriggerRepoInterface {
+	return r.launchPlanTriggerRepo
+}
+
+func (r *GormRepo) TriggerFiringRepo() interfaces.TriggerFiringRepoInterface {
+	return r.triggerFiringRepo
+}
+
+func (r *GormRepo) PromotionRepo() interfaces.PromotionRepoInterface {
+	return r.promotionRepo
+}
+
+func (r *GormRepo) ExecutionArchiveRepo() interfaces.ExecutionArchiveRepoInterface {
+	return r.executionArchiveRepo
+}
+
 func (r *GormRepo) GetGormDB() *gorm.DB {
 	return r.db
 }
@@ -99,6 +124,7 @@ func NewGormRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope pr
 		db:                           db,
 		executionRepo:                gormimpl.NewExecutionRepo(db, errorTransformer, scope.NewSubScope("executions")),
 		executionEventRepo:           gormimpl.NewExecutionEventRepo(db, errorTransformer, scope.NewSubScope("execution_events")),
+		executionPhaseChangeRepo:     gormimpl.NewExecutionPhaseChangeRepo(db, errorTransformer, scope.NewSubScope("execution_phase_changes")),
 		launchPlanRepo:               gormimpl.NewLaunchPlanRepo(db, errorTransformer, scope.NewSubScope("launch_plans")),
 		projectRepo:                  gormimpl.NewProjectRepo(db, errorTransformer, scope.NewSubScope("project")),
 		namedEntityRepo:              gormimpl.NewNamedEntityRepo(db, errorTransformer, scope.NewSubScope("named_entity")),
@@ -112,5 +138,9 @@ func NewGormRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope pr
 		schedulableEntityRepo:        schedulerGormImpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: schedulerGormImpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
 		signalRepo:                   gormimpl.NewSignalRepo(db, errorTransformer, scope.NewSubScope("signals")),
+		launchPlanTriggerRepo:        gormimpl.NewLaunchPlanTriggerRepo(db, errorTransformer, scope.NewSubScope("launch_plan_triggers")),
+		triggerFiringRepo:            gormimpl.NewTriggerFiringRepo(db, errorTransformer, scope.NewSubScope("trigger_firings")),
+		promotionRepo:                gormimpl.NewPromotionRepo(db, errorTransformer, scope.NewSubScope("promotions")),
+		executionArchiveRepo:         gormimpl.NewExecutionArchiveRepo(db, errorTransformer, scope.NewSubScope("execution_archives")),
 	}
 }
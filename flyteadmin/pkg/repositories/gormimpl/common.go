@@ -2,6 +2,7 @@ package gormimpl
 
 import (
 	"fmt"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"gorm.io/gorm"
@@ -29,6 +30,9 @@ const taskTableName = "tasks"
 const workflowTableName = "workflows"
 const descriptionEntityTableName = "description_entities"
 const executionTagsTableName = "execution_tags"
+const signalTableName = "signals"
+const projectTableName = "projects"
+const triggerFiringTableName = "trigger_firings"
 
 const limit = "limit"
 const filters = "filters"
@@ -49,6 +53,7 @@ var entityToTableName = map[common.Entity]string{
 	common.AdminTag:            "admin_tags",
 	common.ExecutionAdminTag:   "execution_admin_tags",
 	common.ExecutionTag:        "execution_tags",
+	common.TriggerFiring:       "trigger_firings",
 }
 
 var innerJoinExecToNodeExec = fmt.Sprintf(
@@ -116,3 +121,82 @@ func applyScopedFilters(tx *gorm.DB, inlineFilters []common.InlineFilter, mapFil
 func getIDFilter(id uint) (query string, args interface{}) {
 	return fmt.Sprintf("%s = ?", ID), id
 }
+
+// paginate limits a list query to the page following input.Offset rows or input.Cursor, in the order of
+// input.SortParameter followed by the uniqueColumns of the table. Pages following a cursor are looked up with a row
+// value comparison on the sort key and the unique columns, which an index on these columns serves without scanning the
+// preceding rows.
+func paginate(tx *gorm.DB, input interfaces.ListResourceInput, tableName string, uniqueColumns []string) (
+	*gorm.DB, error) {
+	if input.Limit != 0 {
+		tx = tx.Limit(input.Limit)
+	}
+	if input.SortParameter == nil {
+		return tx.Offset(input.Offset), nil
+	}
+	tx = orderBy(tx, input.SortParameter, tableName, uniqueColumns)
+	columns := []string{fmt.Sprintf("%s.%s", tableName, input.SortParameter.GetKey())}
+	for _, column := range common.UniqueColumnsAfterSortKey(input.SortParameter, uniqueColumns) {
+		columns = append(columns, fmt.Sprintf("%s.%s", tableName, column))
+	}
+	if input.Cursor == nil {
+		return tx.Offset(input.Offset), nil
+	}
+	if len(input.Cursor.Values) != len(columns) {
+		return nil, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid pagination token")
+	}
+	query, args := getCursorQuery(columns, input.Cursor, tx.Dialector.Name() == "postgres")
+	return tx.Where(query, args...), nil
+}
+
+// orderBy orders a list query by sortParameter followed by the uniqueColumns of the table, which makes the order of the
+// rows sharing the same sort key deterministic.
+func orderBy(tx *gorm.DB, sortParameter common.SortParameter, tableName string, uniqueColumns []string) *gorm.DB {
+	tx = tx.Order(sortParameter.GetGormOrderExpr())
+	direction := "asc"
+	if sortParameter.IsDescending() {
+		direction = "desc"
+	}
+	for _, column := range common.UniqueColumnsAfterSortKey(sortParameter, uniqueColumns) {
+		tx = tx.Order(fmt.Sprintf("%s.%s %s", tableName, column, direction))
+	}
+	return tx
+}
+
+// getCursorQuery returns the condition selecting the rows following the cursor, in the order of columns. Null sort
+// keys are the largest values in postgres and the smallest ones elsewhere.
+func getCursorQuery(columns []string, cursor *common.Cursor, nullsLargest bool) (string, []interface{}) {
+	comparison := ">"
+	if cursor.Descending {
+		comparison = "<"
+	}
+	nullsFirst := cursor.Descending == nullsLargest
+	sortKey := columns[0]
+	if cursor.Values[0] != nil {
+		query := rowValueComparison(columns, comparison)
+		if cursor.Nullable && !nullsFirst {
+			query = fmt.Sprintf("(%s OR %s IS NULL)", query, sortKey)
+		}
+		return query, cursor.Values
+	}
+	if len(columns) == 1 {
+		// The sort key is unique, and the cursor is the last null.
+		if nullsFirst {
+			return fmt.Sprintf("%s IS NOT NULL", sortKey), nil
+		}
+		return "1 = 0", nil
+	}
+	query := fmt.Sprintf("%s IS NULL AND %s", sortKey, rowValueComparison(columns[1:], comparison))
+	if nullsFirst {
+		query = fmt.Sprintf("(%s IS NOT NULL OR (%s))", sortKey, query)
+	}
+	return query, cursor.Values[1:]
+}
+
+func rowValueComparison(columns []string, comparison string) string {
+	if len(columns) == 1 {
+		return fmt.Sprintf("%s %s ?", columns[0], comparison)
+	}
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), comparison,
+		strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", "))
+}
@@ -0,0 +1,158 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+type paginatedRow struct {
+	ID       uint
+	Priority *int
+	Name     string
+}
+
+func TestGetCursorQuery(t *testing.T) {
+	columns := []string{"t.priority", "t.id"}
+	for _, test := range []struct {
+		descending   bool
+		nullsLargest bool
+		value        interface{}
+		query        string
+	}{
+		{false, true, 1, "((t.priority, t.id) > (?, ?) OR t.priority IS NULL)"},
+		{false, true, nil, "t.priority IS NULL AND t.id > ?"},
+		{true, true, 1, "(t.priority, t.id) < (?, ?)"},
+		{true, true, nil, "(t.priority IS NOT NULL OR (t.priority IS NULL AND t.id < ?))"},
+		{false, false, 1, "(t.priority, t.id) > (?, ?)"},
+		{false, false, nil, "(t.priority IS NOT NULL OR (t.priority IS NULL AND t.id > ?))"},
+		{true, false, 1, "((t.priority, t.id) < (?, ?) OR t.priority IS NULL)"},
+		{true, false, nil, "t.priority IS NULL AND t.id < ?"},
+	} {
+		t.Run(fmt.Sprintf("descending %v nulls largest %v value %v", test.descending, test.nullsLargest, test.value),
+			func(t *testing.T) {
+				query, args := getCursorQuery(columns, &common.Cursor{
+					Descending: test.descending,
+					Nullable:   true,
+					Values:     []interface{}{test.value, 7},
+				}, test.nullsLargest)
+				assert.Equal(t, test.query, query)
+				if test.value == nil {
+					assert.Equal(t, []interface{}{7}, args)
+				} else {
+					assert.Equal(t, []interface{}{test.value, 7}, args)
+				}
+			})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "admin.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec("CREATE TABLE paginated_rows (id integer PRIMARY KEY, priority integer, name text)").Error)
+	priorities := []interface{}{2, nil, 1, 2, nil, 3, 1, 2}
+	for i, priority := range priorities {
+		require.NoError(t, db.Exec("INSERT INTO paginated_rows (id, priority, name) VALUES (?, ?, ?)",
+			i+1, priority, fmt.Sprintf("row-%d", len(priorities)-i)).Error)
+	}
+	columns := sets.NewString("id", "priority", "name")
+
+	for _, test := range []struct {
+		key       string
+		direction admin.Sort_Direction
+		expected  []uint
+	}{
+		{"priority", admin.Sort_ASCENDING, []uint{2, 5, 3, 7, 1, 4, 8, 6}},
+		{"priority", admin.Sort_DESCENDING, []uint{6, 8, 4, 1, 7, 3, 5, 2}},
+		{"name", admin.Sort_ASCENDING, []uint{8, 7, 6, 5, 4, 3, 2, 1}},
+		{"id", admin.Sort_DESCENDING, []uint{8, 7, 6, 5, 4, 3, 2, 1}},
+	} {
+		t.Run(fmt.Sprintf("%s %s", test.key, test.direction), func(t *testing.T) {
+			sortParameter, err := common.NewSortParameter(&admin.Sort{Key: test.key, Direction: test.direction}, columns)
+			require.NoError(t, err)
+			var pageToken common.PageToken
+			var listed []uint
+			for page := 0; page < len(priorities); page++ {
+				tx, err := paginate(db.Model(&paginatedRow{}), interfaces.ListResourceInput{
+					Limit:         3,
+					Offset:        pageToken.Offset,
+					Cursor:        pageToken.Cursor,
+					SortParameter: sortParameter,
+				}, "paginated_rows", models.IDColumns)
+				require.NoError(t, err)
+				var rows []paginatedRow
+				require.NoError(t, tx.Find(&rows).Error)
+				for _, row := range rows {
+					listed = append(listed, row.ID)
+				}
+				if len(rows) < 3 {
+					break
+				}
+				pageToken.Cursor, err = common.DecodeCursor(common.NewPageToken(sortParameter, pageToken, len(rows),
+					rows[len(rows)-1], models.IDColumns))
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, listed)
+		})
+	}
+}
+
+func TestListExecutions_Cursor(t *testing.T) {
+	executionRepo := NewExecutionRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "created_at",
+		Direction: admin.Sort_DESCENDING,
+	}, models.ExecutionColumns)
+	require.NoError(t, err)
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(`SELECT * FROM "executions" WHERE executions.execution_project = $1 AND (executions.created_at, executions.id) < ($2, $3) ORDER BY created_at desc,executions.id desc LIMIT 20`)
+
+	_, err = executionRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+		},
+		Limit:         20,
+		SortParameter: sortParameter,
+		Cursor: &common.Cursor{
+			SortKey:    "created_at",
+			Descending: true,
+			Values:     []interface{}{createdAt, int64(10)},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+
+	_, err = executionRepo.List(context.Background(), interfaces.ListResourceInput{
+		InlineFilters: []common.InlineFilter{
+			getEqualityFilter(common.Execution, "project", project),
+		},
+		Limit:         20,
+		SortParameter: sortParameter,
+		Cursor: &common.Cursor{
+			SortKey:    "created_at",
+			Descending: true,
+			Values:     []interface{}{createdAt},
+		},
+	})
+	assert.Error(t, err)
+}
@@ -53,16 +53,17 @@ func (r *DescriptionEntityRepo) List(
 		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
 	var descriptionEntities []models.DescriptionEntity
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, descriptionEntityTableName, models.IDColumns)
+	if err != nil {
+		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
 	timer := r.metrics.ListDuration.Start()
 	tx.Find(&descriptionEntities)
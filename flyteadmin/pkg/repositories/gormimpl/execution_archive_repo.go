@@ -0,0 +1,163 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	adminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	flyteAdminDbErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// The models of the rows of the nodes and tasks of an execution, in the order they are deleted in. Node executions are
+// deleted before the task executions which launched them, should foreign key constraints be enforced.
+var archivedExecutionRowModels = []interface{}{
+	&models.ExecutionEvent{},
+	&models.NodeExecutionEvent{},
+	&models.ExecutionPhaseChange{},
+	&models.NodeExecution{},
+	&models.TaskExecution{},
+}
+
+// ExecutionArchiveRepo is an implementation of ExecutionArchiveRepoInterface.
+type ExecutionArchiveRepo struct {
+	db               *gorm.DB
+	errorTransformer flyteAdminDbErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func getExecutionKeyFilter(key models.ExecutionKey) map[string]interface{} {
+	return map[string]interface{}{
+		"execution_project": key.Project,
+		"execution_domain":  key.Domain,
+		"execution_name":    key.Name,
+	}
+}
+
+func (r *ExecutionArchiveRepo) ListArchivable(
+	ctx context.Context, input interfaces.ListArchivableExecutionsInput) ([]models.Execution, error) {
+	var executions []models.Execution
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.Execution{
+		ExecutionKey: models.ExecutionKey{
+			Project: input.Project,
+			Domain:  input.Domain,
+		},
+	}).Where("phase IN (?)", input.Phases).Where("execution_updated_at < ?", input.UpdatedBefore).
+		Order("execution_updated_at").Limit(input.Limit).Find(&executions)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return executions, nil
+}
+
+func (r *ExecutionArchiveRepo) GetArchivedExecution(
+	ctx context.Context, execution models.Execution) (models.ArchivedExecution, error) {
+	archived := models.ArchivedExecution{
+		Execution: execution,
+	}
+	filter := getExecutionKeyFilter(execution.ExecutionKey)
+	timer := r.metrics.GetDuration.Start()
+	defer timer.Stop()
+	tx := r.db.WithContext(ctx)
+	for _, rows := range []interface{}{
+		&archived.NodeExecutions,
+		&archived.TaskExecutions,
+		&archived.ExecutionEvents,
+		&archived.NodeExecutionEvents,
+	} {
+		if err := tx.Where(filter).Order("id").Find(rows).Error; err != nil {
+			return models.ArchivedExecution{}, r.errorTransformer.ToFlyteAdminError(err)
+		}
+	}
+	if err := tx.Model(&execution).Association("Tags").Find(&archived.Execution.Tags); err != nil {
+		return models.ArchivedExecution{}, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return archived, nil
+}
+
+func (r *ExecutionArchiveRepo) Create(ctx context.Context, input *models.ExecutionArchive) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionArchiveRepo) Get(ctx context.Context, input models.ExecutionKey) (models.ExecutionArchive, error) {
+	var archive models.ExecutionArchive
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.ExecutionArchive{
+		ExecutionKey: input,
+	}).Take(&archive)
+	timer.Stop()
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return models.ExecutionArchive{}, adminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"execution [%s/%s/%s] was not archived", input.Project, input.Domain, input.Name)
+	}
+	if tx.Error != nil {
+		return models.ExecutionArchive{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return archive, nil
+}
+
+// Deletes the rows of the execution in statements deleting at most batchSize rows each, such that no statement holds
+// its locks for long. The ids of each batch are selected first, as not every database supports deleting from a table
+// the rows a limited subquery of the same table selects.
+func (r *ExecutionArchiveRepo) deleteInBatches(
+	ctx context.Context, model interface{}, key models.ExecutionKey, batchSize int) error {
+	for {
+		var ids []uint
+		tx := r.db.WithContext(ctx).Model(model).Where(getExecutionKeyFilter(key)).Order("id DESC").Limit(batchSize).
+			Pluck("id", &ids)
+		if tx.Error != nil {
+			return r.errorTransformer.ToFlyteAdminError(tx.Error)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := r.db.WithContext(ctx).Where("id IN (?)", ids).Delete(model).Error; err != nil {
+			return r.errorTransformer.ToFlyteAdminError(err)
+		}
+		if len(ids) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (r *ExecutionArchiveRepo) DeleteArchived(ctx context.Context, execution models.Execution, batchSize int) error {
+	timer := r.metrics.DeleteDuration.Start()
+	defer timer.Stop()
+	for _, model := range archivedExecutionRowModels {
+		if err := r.deleteInBatches(ctx, model, execution.ExecutionKey, batchSize); err != nil {
+			return err
+		}
+	}
+	if err := r.db.WithContext(ctx).Model(&execution).Association("Tags").Clear(); err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	if err := r.db.WithContext(ctx).Where(getIDFilter(execution.ID)).Delete(&models.Execution{}).Error; err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+// Returns an instance of ExecutionArchiveRepoInterface
+func NewExecutionArchiveRepo(
+	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionArchiveRepoInterface {
+	metrics := newMetrics(scope)
+	return &ExecutionArchiveRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
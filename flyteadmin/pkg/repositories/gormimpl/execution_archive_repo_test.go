@@ -0,0 +1,71 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	adminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func TestListArchivableExecutions(t *testing.T) {
+	repo := NewExecutionArchiveRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`phase IN ($3,$4) AND execution_updated_at < $5 ORDER BY execution_updated_at LIMIT 10`).
+		WithReply([]map[string]interface{}{
+			{"id": 3, "execution_project": project, "execution_domain": domain, "execution_name": name},
+		})
+
+	executions, err := repo.ListArchivable(context.Background(), interfaces.ListArchivableExecutionsInput{
+		Project:       project,
+		Domain:        domain,
+		Phases:        []string{"FAILED", "SUCCEEDED"},
+		UpdatedBefore: time.Now(),
+		Limit:         10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, executions, 1)
+	assert.Equal(t, uint(3), executions[0].ID)
+}
+
+func TestGetExecutionArchive_NotFound(t *testing.T) {
+	repo := NewExecutionArchiveRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	_, err := repo.Get(context.Background(), models.ExecutionKey{Project: project, Domain: domain, Name: name})
+	assert.Equal(t, codes.NotFound, err.(adminErrors.FlyteAdminError).Code())
+}
+
+func TestDeleteArchivedExecution(t *testing.T) {
+	repo := NewExecutionArchiveRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`SELECT "id" FROM "node_executions" WHERE`).WithReply(
+		[]map[string]interface{}{{"id": 5}, {"id": 4}})
+	nodeExecutionsQuery := GlobalMock.NewMock()
+	nodeExecutionsQuery.WithQuery(`DELETE FROM "node_executions" WHERE id IN ($1,$2)`)
+	taskExecutionsQuery := GlobalMock.NewMock()
+	taskExecutionsQuery.WithQuery(`DELETE FROM "task_executions"`)
+	executionQuery := GlobalMock.NewMock()
+	executionQuery.WithQuery(`DELETE FROM "executions" WHERE`)
+
+	err := repo.DeleteArchived(context.Background(), models.Execution{
+		BaseModel:    models.BaseModel{ID: 3},
+		ExecutionKey: models.ExecutionKey{Project: project, Domain: domain, Name: name},
+	}, 100)
+	assert.NoError(t, err)
+	assert.True(t, nodeExecutionsQuery.Triggered)
+	// No task execution of the execution is left to delete.
+	assert.False(t, taskExecutionsQuery.Triggered)
+	assert.True(t, executionQuery.Triggered)
+}
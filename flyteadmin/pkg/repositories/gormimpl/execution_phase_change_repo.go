@@ -0,0 +1,124 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+type ExecutionPhaseChangeRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *ExecutionPhaseChangeRepo) Create(ctx context.Context, input *models.ExecutionPhaseChange) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionPhaseChangeRepo) List(
+	ctx context.Context, input interfaces.ListExecutionPhaseChangesInput) ([]models.ExecutionPhaseChange, error) {
+	var changes []models.ExecutionPhaseChange
+	tx := r.db.WithContext(ctx).Where("id > ?", input.AfterID)
+	if input.Execution != nil {
+		tx = tx.Where(&models.ExecutionPhaseChange{
+			Project: input.Execution.Project,
+			Domain:  input.Execution.Domain,
+			Name:    input.Execution.Name,
+		})
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx = tx.Order("id").Limit(input.Limit).Find(&changes)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return changes, nil
+}
+
+func (r *ExecutionPhaseChangeRepo) GetLatestID(ctx context.Context) (uint, error) {
+	var id uint
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.ExecutionPhaseChange{}).Select("COALESCE(MAX(id), 0)").Scan(&id)
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return id, nil
+}
+
+func (r *ExecutionPhaseChangeRepo) DeleteCreatedBefore(ctx context.Context, before time.Time) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.WithContext(ctx).Where("created_at < ?", before).Delete(&models.ExecutionPhaseChange{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionPhaseChangeRepo) Watch(ctx context.Context, execution models.ExecutionKey, until time.Time) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "execution_project"}, {Name: "execution_domain"}, {Name: "execution_name"}},
+		DoUpdates: clause.Set{{
+			Column: clause.Column{Name: "expires_at"},
+			Value:  gorm.Expr("CASE WHEN execution_watches.expires_at > excluded.expires_at THEN execution_watches.expires_at ELSE excluded.expires_at END"),
+		}},
+	}).Create(&models.ExecutionWatch{
+		Project:   execution.Project,
+		Domain:    execution.Domain,
+		Name:      execution.Name,
+		ExpiresAt: until,
+	})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *ExecutionPhaseChangeRepo) ListWatches(ctx context.Context, at time.Time) ([]models.ExecutionWatch, error) {
+	var watches []models.ExecutionWatch
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.WithContext(ctx).Where("expires_at > ?", at).Find(&watches)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return watches, nil
+}
+
+func (r *ExecutionPhaseChangeRepo) DeleteWatchesExpiredBefore(ctx context.Context, before time.Time) error {
+	timer := r.metrics.DeleteDuration.Start()
+	tx := r.db.WithContext(ctx).Where("expires_at < ?", before).Delete(&models.ExecutionWatch{})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+// Returns an instance of ExecutionPhaseChangeRepoInterface
+func NewExecutionPhaseChangeRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionPhaseChangeRepoInterface {
+	metrics := newMetrics(scope)
+	return &ExecutionPhaseChangeRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
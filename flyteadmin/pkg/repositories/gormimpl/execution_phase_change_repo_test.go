@@ -0,0 +1,120 @@
+package gormimpl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func TestCreateExecutionPhaseChange(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(
+		`INSERT INTO "execution_phase_changes" ("created_at","execution_project","execution_domain","execution_name","change") VALUES ($1,$2,$3,$4,$5)`)
+
+	err := repo.Create(context.Background(), &models.ExecutionPhaseChange{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+		Change:  []byte{1, 2},
+	})
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListExecutionPhaseChanges(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "execution_phase_changes" WHERE id > $1 AND "execution_phase_changes"."execution_project" = $2 AND "execution_phase_changes"."execution_domain" = $3 AND "execution_phase_changes"."execution_name" = $4 ORDER BY id LIMIT 10`).
+		WithReply([]map[string]interface{}{
+			{"id": 3, "execution_project": "project", "execution_domain": "domain", "execution_name": "name"},
+		})
+
+	changes, err := repo.List(context.Background(), interfaces.ListExecutionPhaseChangesInput{
+		Execution: &models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+		AfterID:   2,
+		Limit:     10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, uint(3), changes[0].ID)
+}
+
+func TestGetLatestExecutionPhaseChangeID(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	GlobalMock.NewMock().WithQuery(`SELECT COALESCE(MAX(id), 0) FROM "execution_phase_changes"`).
+		WithReply([]map[string]interface{}{{"coalesce": 7}})
+
+	id, err := repo.GetLatestID(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), id)
+}
+
+func TestDeleteExecutionPhaseChangesCreatedBefore(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "execution_phase_changes" WHERE created_at < $1`)
+
+	assert.NoError(t, repo.DeleteCreatedBefore(context.Background(), time.Now()))
+	assert.True(t, query.Triggered)
+}
+
+func TestWatchExecution(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(
+		`INSERT INTO "execution_watches" ("execution_project","execution_domain","execution_name","expires_at") VALUES ($1,$2,$3,$4) ON CONFLICT ("execution_project","execution_domain","execution_name") DO UPDATE SET "expires_at"=`)
+
+	err := repo.Watch(context.Background(), models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"}, time.Now())
+	assert.NoError(t, err)
+	assert.True(t, query.Triggered)
+}
+
+func TestListExecutionWatches(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	expiresAt := time.Now().Add(time.Hour)
+	GlobalMock.NewMock().WithQuery(`SELECT * FROM "execution_watches" WHERE expires_at > $1`).
+		WithReply([]map[string]interface{}{{
+			"execution_project": "project",
+			"execution_domain":  "domain",
+			"execution_name":    "name",
+			"expires_at":        expiresAt,
+		}})
+
+	watches, err := repo.ListWatches(context.Background(), time.Now())
+	assert.NoError(t, err)
+	assert.Len(t, watches, 1)
+	assert.Equal(t, "name", watches[0].Name)
+	assert.Equal(t, expiresAt, watches[0].ExpiresAt)
+}
+
+func TestDeleteExecutionWatchesExpiredBefore(t *testing.T) {
+	repo := NewExecutionPhaseChangeRepo(GetDbForTest(t), errors.NewTestErrorTransformer(), mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+	query := GlobalMock.NewMock()
+	query.WithQuery(`DELETE FROM "execution_watches" WHERE expires_at < $1`)
+
+	assert.NoError(t, repo.DeleteWatchesExpiredBefore(context.Background(), time.Now()))
+	assert.True(t, query.Triggered)
+}
@@ -84,7 +84,7 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 		return interfaces.ExecutionCollectionOutput{}, err
 	}
 	var executions []models.Execution
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx)
 	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
 	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
@@ -109,9 +109,10 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 	if err != nil {
 		return interfaces.ExecutionCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, executionTableName, models.IDColumns)
+	if err != nil {
+		return interfaces.ExecutionCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
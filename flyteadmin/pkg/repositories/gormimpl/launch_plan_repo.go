@@ -114,7 +114,7 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 	var launchPlans []models.LaunchPlan
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx)
 
 	// Add join conditions
 	tx = tx.Joins("inner join workflows on launch_plans.workflow_id = workflows.id")
@@ -124,9 +124,10 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 	if err != nil {
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, launchPlanTableName, models.IDColumns)
+	if err != nil {
+		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -151,16 +152,17 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
-	tx := r.db.WithContext(ctx).Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.db.WithContext(ctx).Model(models.LaunchPlan{})
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, launchPlanTableName, models.IdentifierColumns)
+	if err != nil {
+		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
 	// Scan the results into a list of launch plans
@@ -0,0 +1,86 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+
+	adminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	flyteAdminDbErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// LaunchPlanTriggerRepo is an implementation of LaunchPlanTriggerRepoInterface.
+type LaunchPlanTriggerRepo struct {
+	db               *gorm.DB
+	errorTransformer flyteAdminDbErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *LaunchPlanTriggerRepo) Replace(
+	ctx context.Context, launchPlan interfaces.Identifier, triggers []models.LaunchPlanTrigger) error {
+	timer := r.metrics.CreateDuration.Start()
+	defer timer.Stop()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where(&models.LaunchPlanTrigger{
+			LaunchPlanTriggerKey: models.LaunchPlanTriggerKey{
+				Project: launchPlan.Project,
+				Domain:  launchPlan.Domain,
+				Name:    launchPlan.Name,
+			},
+		}).Delete(&models.LaunchPlanTrigger{}).Error
+		if err != nil || len(triggers) == 0 {
+			return err
+		}
+		return tx.Create(&triggers).Error
+	})
+	if err != nil {
+		return r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return nil
+}
+
+func (r *LaunchPlanTriggerRepo) Get(
+	ctx context.Context, input models.LaunchPlanTriggerKey) (models.LaunchPlanTrigger, error) {
+	var trigger models.LaunchPlanTrigger
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.LaunchPlanTrigger{
+		LaunchPlanTriggerKey: input,
+	}).Take(&trigger)
+	timer.Stop()
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return models.LaunchPlanTrigger{}, adminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"launch plan [%s/%s/%s] has no active trigger [%s]", input.Project, input.Domain, input.Name, input.TriggerName)
+	}
+	if tx.Error != nil {
+		return models.LaunchPlanTrigger{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return trigger, nil
+}
+
+func (r *LaunchPlanTriggerRepo) ListByEventType(
+	ctx context.Context, eventType string) ([]models.LaunchPlanTrigger, error) {
+	var triggers []models.LaunchPlanTrigger
+	timer := r.metrics.ListDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.LaunchPlanTrigger{EventType: eventType}).Find(&triggers)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return triggers, nil
+}
+
+// Returns an instance of LaunchPlanTriggerRepoInterface
+func NewLaunchPlanTriggerRepo(
+	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanTriggerRepoInterface {
+	metrics := newMetrics(scope)
+	return &LaunchPlanTriggerRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
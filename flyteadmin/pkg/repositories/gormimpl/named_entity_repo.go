@@ -28,20 +28,19 @@ var joinString = "RIGHT JOIN (?) AS entities ON named_entity_metadata.resource_t
 	"named_entity_metadata.project = entities.project AND named_entity_metadata.domain = entities.domain AND " +
 	"named_entity_metadata.name = entities.name"
 
-func getSubQueryJoin(db *gorm.DB, tableName string, input interfaces.ListNamedEntityInput) *gorm.DB {
+func getSubQueryJoin(db *gorm.DB, tableName string, input interfaces.ListNamedEntityInput) (*gorm.DB, error) {
 	tx := db.Select([]string{Project, Domain, Name}).
 		Table(tableName).
 		Where(map[string]interface{}{Project: input.Project, Domain: input.Domain}).
-		Limit(input.Limit).
-		Offset(input.Offset).
 		Group(identifierGroupBy)
 
-	// Apply consistent sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply consistent sort ordering and pagination.
+	tx, err := paginate(tx, input.ListResourceInput, tableName, models.IdentifierColumns)
+	if err != nil {
+		return nil, err
 	}
 
-	return db.Joins(fmt.Sprintf(joinString, input.ResourceType), tx)
+	return db.Joins(fmt.Sprintf(joinString, input.ResourceType), tx), nil
 }
 
 var leftJoinWorkflowNameToMetadata = fmt.Sprintf(
@@ -182,16 +181,19 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 			"Cannot list entity names for resource type: %v", input.ResourceType)
 	}
 
-	tx := getSubQueryJoin(r.db.WithContext(ctx), tableName, input)
+	tx, err := getSubQueryJoin(r.db.WithContext(ctx), tableName, input)
+	if err != nil {
+		return interfaces.NamedEntityCollectionOutput{}, err
+	}
 
 	// Apply filters
-	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
+	tx, err = applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return interfaces.NamedEntityCollectionOutput{}, err
 	}
 	// Apply sort ordering.
 	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+		tx = orderBy(tx, input.SortParameter, innerJoinTableAlias, models.IdentifierColumns)
 	}
 
 	// Scan the results into a list of named entities
@@ -112,7 +112,7 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 	var nodeExecutions []models.NodeExecution
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecutions")
+	tx := r.db.WithContext(ctx).Preload("ChildNodeExecutions")
 	// And add join condition, if any
 	if input.JoinTableEntities[common.Execution] {
 		tx = tx.Joins(innerJoinExecToNodeExec)
@@ -123,9 +123,10 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	if err != nil {
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, nodeExecutionTableName, models.IDColumns)
+	if err != nil {
+		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
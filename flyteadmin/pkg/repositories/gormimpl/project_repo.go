@@ -52,26 +52,24 @@ func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project
 func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
 	var projects []models.Project
 
-	tx := r.db.WithContext(ctx).Offset(input.Offset)
-	if input.Limit != 0 {
-		tx = tx.Limit(input.Limit)
-	}
+	tx := r.db.WithContext(ctx)
+	var err error
 
 	// Apply filters
 	// If no filter provided, default to filtering out archived projects
 	if len(input.InlineFilters) == 0 && len(input.MapFilters) == 0 {
 		tx = tx.Where("state != ?", int32(admin.Project_ARCHIVED))
 	} else {
-		var err error
 		tx, err = applyFilters(tx, input.InlineFilters, input.MapFilters)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// Apply sort ordering
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination
+	tx, err = paginate(tx, input, projectTableName, models.ProjectIDColumns)
+	if err != nil {
+		return nil, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -0,0 +1,62 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	adminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	flyteAdminDbErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// PromotionRepo is an implementation of PromotionRepoInterface.
+type PromotionRepo struct {
+	db               *gorm.DB
+	errorTransformer flyteAdminDbErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *PromotionRepo) Create(ctx context.Context, input *models.Promotion) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *PromotionRepo) Get(ctx context.Context, input models.PromotionKey) (models.Promotion, error) {
+	var promotion models.Promotion
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.WithContext(ctx).Where(&models.Promotion{
+		PromotionKey: input,
+	}).Take(&promotion)
+	timer.Stop()
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return models.Promotion{}, adminErrors.NewFlyteAdminErrorf(codes.NotFound,
+			"%s [%s/%s/%s/%s] was not registered by a promotion",
+			input.ResourceType, input.Project, input.Domain, input.Name, input.Version)
+	}
+	if tx.Error != nil {
+		return models.Promotion{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return promotion, nil
+}
+
+// Returns an instance of PromotionRepoInterface
+func NewPromotionRepo(
+	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.PromotionRepoInterface {
+	metrics := newMetrics(scope)
+	return &PromotionRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
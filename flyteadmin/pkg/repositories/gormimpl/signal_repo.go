@@ -56,16 +56,17 @@ func (s *SignalRepo) List(ctx context.Context, input interfaces.ListResourceInpu
 		return nil, err
 	}
 	var signals []models.Signal
-	tx := s.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := s.db.WithContext(ctx)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
 		return nil, err
 	}
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, signalTableName, models.IDColumns)
+	if err != nil {
+		return nil, err
 	}
 	timer := s.metrics.ListDuration.Start()
 	tx.Find(&signals)
@@ -97,7 +97,7 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	}
 
 	var taskExecutions []models.TaskExecution
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
+	tx := r.db.WithContext(ctx).Preload("ChildNodeExecution")
 
 	// And add three join conditions
 	// We enable joining on
@@ -120,9 +120,10 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.TaskExecutionCollectionOutput{}, err
 	}
 
-	// Apply sort ordering.
-	if input.SortParameter != nil {
-		tx = tx.Order(input.SortParameter.GetGormOrderExpr())
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, taskExecutionTableName, models.IDColumns)
+	if err != nil {
+		return interfaces.TaskExecutionCollectionOutput{}, err
 	}
 
 	timer := r.metrics.ListDuration.Start()
@@ -0,0 +1,95 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// TriggerFiringRepo is an implementation of TriggerFiringRepoInterface.
+type TriggerFiringRepo struct {
+	db               *gorm.DB
+	errorTransformer errors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *TriggerFiringRepo) Create(ctx context.Context, input *models.TriggerFiring) error {
+	timer := r.metrics.CreateDuration.Start()
+	tx := r.db.WithContext(ctx).Create(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *TriggerFiringRepo) Update(ctx context.Context, input *models.TriggerFiring) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.TriggerFiring{}).Where(getIDFilter(input.ID)).Updates(input)
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *TriggerFiringRepo) Count(ctx context.Context, trigger models.LaunchPlanTriggerKey, outcomes []string,
+	since time.Time) (int64, error) {
+	var count int64
+	timer := r.metrics.CountDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.TriggerFiring{}).Where(&models.TriggerFiring{
+		Project:     trigger.Project,
+		Domain:      trigger.Domain,
+		Name:        trigger.Name,
+		TriggerName: trigger.TriggerName,
+	}).Where("outcome IN ? AND created_at >= ?", outcomes, since).Count(&count)
+	timer.Stop()
+	if tx.Error != nil {
+		return 0, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return count, nil
+}
+
+func (r *TriggerFiringRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.TriggerFiring, error) {
+	// First validate input.
+	if err := ValidateListInput(input); err != nil {
+		return nil, err
+	}
+	var firings []models.TriggerFiring
+	tx := r.db.WithContext(ctx)
+
+	// Apply filters
+	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
+	if err != nil {
+		return nil, err
+	}
+	// Apply sort ordering and pagination.
+	tx, err = paginate(tx, input, triggerFiringTableName, models.IDColumns)
+	if err != nil {
+		return nil, err
+	}
+	timer := r.metrics.ListDuration.Start()
+	tx.Find(&firings)
+	timer.Stop()
+	if tx.Error != nil {
+		return nil, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return firings, nil
+}
+
+// Returns an instance of TriggerFiringRepoInterface
+func NewTriggerFiringRepo(
+	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.TriggerFiringRepoInterface {
+	metrics := newMetrics(scope)
+	return &TriggerFiringRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
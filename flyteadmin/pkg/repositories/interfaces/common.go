@@ -14,8 +14,10 @@ type Identifier struct {
 
 // Parameters for querying multiple resources.
 type ListResourceInput struct {
-	Limit         int
-	Offset        int
+	Limit  int
+	Offset int
+	// Cursor is the last row of the previous page of a sorted list, set instead of Offset.
+	Cursor        *common.Cursor
 	InlineFilters []common.InlineFilter
 	// MapFilters refers to primary entity filters defined as map values rather than inline sql queries.
 	// These exist to permit filtering on "IS NULL" which isn't permitted with inline filter queries and
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery --name=ExecutionArchiveRepoInterface --output=../mocks --case=underscore --with-expecter
+
+// Defines the interface for archiving executions out of the database.
+type ExecutionArchiveRepoInterface interface {
+	// Returns the executions of a project and domain in one of the given phases, last updated before the given time,
+	// least recently updated first.
+	ListArchivable(ctx context.Context, input ListArchivableExecutionsInput) ([]models.Execution, error)
+	// Returns the rows of an execution, and of its nodes and tasks, to archive.
+	GetArchivedExecution(ctx context.Context, execution models.Execution) (models.ArchivedExecution, error)
+	// Records where an execution was archived, keeping the earlier record if there is one.
+	Create(ctx context.Context, input *models.ExecutionArchive) error
+	// Returns where an execution was archived.
+	Get(ctx context.Context, input models.ExecutionKey) (models.ExecutionArchive, error)
+	// Deletes the rows of an archived execution, and of its nodes and tasks, deleting at most batchSize rows at once.
+	// The execution itself is deleted last, such that an interrupted deletion is resumed by deleting it again.
+	DeleteArchived(ctx context.Context, execution models.Execution, batchSize int) error
+}
+
+type ListArchivableExecutionsInput struct {
+	Project       string
+	Domain        string
+	Phases        []string
+	UpdatedBefore time.Time
+	Limit         int
+}
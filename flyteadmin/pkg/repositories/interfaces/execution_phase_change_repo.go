@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery --name=ExecutionPhaseChangeRepoInterface --output=../mocks --case=underscore --with-expecter
+
+type ExecutionPhaseChangeRepoInterface interface {
+	// Inserts a phase change into the database store, setting its ID.
+	Create(ctx context.Context, input *models.ExecutionPhaseChange) error
+	// Returns the phase changes recorded after the one with the given ID, in the order they were recorded.
+	List(ctx context.Context, input ListExecutionPhaseChangesInput) ([]models.ExecutionPhaseChange, error)
+	// Returns the ID of the last phase change recorded, or 0 if there is none.
+	GetLatestID(ctx context.Context) (uint, error)
+	// Deletes the phase changes recorded before the given time.
+	DeleteCreatedBefore(ctx context.Context, before time.Time) error
+	// Marks the execution as watched until the given time, unless it already is for longer.
+	Watch(ctx context.Context, execution models.ExecutionKey, until time.Time) error
+	// Returns the watches of the executions that haven't expired at the given time.
+	ListWatches(ctx context.Context, at time.Time) ([]models.ExecutionWatch, error)
+	// Deletes the watches of the executions expired before the given time.
+	DeleteWatchesExpiredBefore(ctx context.Context, before time.Time) error
+}
+
+type ListExecutionPhaseChangesInput struct {
+	// If set, only the phase changes of this execution are returned.
+	Execution *models.ExecutionKey
+	// Only the phase changes recorded after the one with this ID are returned.
+	AfterID uint
+	Limit   int
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery --name=LaunchPlanTriggerRepoInterface --output=../mocks --case=underscore --with-expecter
+
+// Defines the interface for interacting with the triggers of active launch plans.
+type LaunchPlanTriggerRepoInterface interface {
+	// Replaces the triggers of the launch plan identified by the project, domain and name of the input with the given
+	// ones, which belong to its active version. The triggers of the launch plan are deleted when none are given.
+	Replace(ctx context.Context, launchPlan Identifier, triggers []models.LaunchPlanTrigger) error
+	// Returns a trigger of the active version of a launch plan.
+	Get(ctx context.Context, input models.LaunchPlanTriggerKey) (models.LaunchPlanTrigger, error)
+	// Returns the triggers firing on the given kind of events.
+	ListByEventType(ctx context.Context, eventType string) ([]models.LaunchPlanTrigger, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery --name=PromotionRepoInterface --output=../mocks --case=underscore --with-expecter
+
+// Defines the interface for interacting with the provenance records of promoted entities.
+type PromotionRepoInterface interface {
+	// Inserts the promotion of an entity into the database store, keeping the record of an earlier promotion of the
+	// same entity if there is one.
+	Create(ctx context.Context, input *models.Promotion) error
+	// Returns the promotion which registered an entity.
+	Get(ctx context.Context, input models.PromotionKey) (models.Promotion, error)
+}
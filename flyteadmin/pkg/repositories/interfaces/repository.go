@@ -15,6 +15,7 @@ type Repository interface {
 	LaunchPlanRepo() LaunchPlanRepoInterface
 	ExecutionRepo() ExecutionRepoInterface
 	ExecutionEventRepo() ExecutionEventRepoInterface
+	ExecutionPhaseChangeRepo() ExecutionPhaseChangeRepoInterface
 	ProjectRepo() ProjectRepoInterface
 	ResourceRepo() ResourceRepoInterface
 	NodeExecutionRepo() NodeExecutionRepoInterface
@@ -25,6 +26,10 @@ type Repository interface {
 	SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
 	SignalRepo() SignalRepoInterface
+	LaunchPlanTriggerRepo() LaunchPlanTriggerRepoInterface
+	TriggerFiringRepo() TriggerFiringRepoInterface
+	PromotionRepo() PromotionRepoInterface
+	ExecutionArchiveRepo() ExecutionArchiveRepoInterface
 
 	GetGormDB() *gorm.DB
 }
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+)
+
+//go:generate mockery --name=TriggerFiringRepoInterface --output=../mocks --case=underscore --with-expecter
+
+// Defines the interface for interacting with the records of trigger firings.
+type TriggerFiringRepoInterface interface {
+	// Inserts a trigger firing into the database store.
+	Create(ctx context.Context, input *models.TriggerFiring) error
+	// Updates the outcome of a trigger firing inserted before.
+	Update(ctx context.Context, input *models.TriggerFiring) error
+	// Returns the number of firings of a trigger recorded since the given time with any of the given outcomes.
+	Count(ctx context.Context, trigger models.LaunchPlanTriggerKey, outcomes []string, since time.Time) (int64, error)
+	// Returns the trigger firings that match the input values.
+	List(ctx context.Context, input ListResourceInput) ([]models.TriggerFiring, error)
+}
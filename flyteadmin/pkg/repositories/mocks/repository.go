@@ -14,6 +14,7 @@ type MockRepository struct {
 	launchPlanRepo                interfaces.LaunchPlanRepoInterface
 	executionRepo                 interfaces.ExecutionRepoInterface
 	ExecutionEventRepoIface       interfaces.ExecutionEventRepoInterface
+	ExecutionPhaseChangeRepoIface interfaces.ExecutionPhaseChangeRepoInterface
 	nodeExecutionRepo             interfaces.NodeExecutionRepoInterface
 	NodeExecutionEventRepoIface   interfaces.NodeExecutionEventRepoInterface
 	ProjectRepoIface              interfaces.ProjectRepoInterface
@@ -24,6 +25,10 @@ type MockRepository struct {
 	schedulableEntityRepo         sIface.SchedulableEntityRepoInterface
 	schedulableEntitySnapshotRepo sIface.ScheduleEntitiesSnapShotRepoInterface
 	signalRepo                    interfaces.SignalRepoInterface
+	launchPlanTriggerRepo         interfaces.LaunchPlanTriggerRepoInterface
+	triggerFiringRepo             interfaces.TriggerFiringRepoInterface
+	promotionRepo                 interfaces.PromotionRepoInterface
+	executionArchiveRepo          interfaces.ExecutionArchiveRepoInterface
 }
 
 func (r *MockRepository) GetGormDB() *gorm.DB {
@@ -58,6 +63,10 @@ func (r *MockRepository) ExecutionEventRepo() interfaces.ExecutionEventRepoInter
 	return r.ExecutionEventRepoIface
 }
 
+func (r *MockRepository) ExecutionPhaseChangeRepo() interfaces.ExecutionPhaseChangeRepoInterface {
+	return r.ExecutionPhaseChangeRepoIface
+}
+
 func (r *MockRepository) NodeExecutionRepo() interfaces.NodeExecutionRepoInterface {
 	return r.nodeExecutionRepo
 }
@@ -90,6 +99,22 @@ func (r *MockRepository) SignalRepo() interfaces.SignalRepoInterface {
 	return r.signalRepo
 }
 
+func (r *MockRepository) LaunchPlanTriggerRepo() interfaces.LaunchPlanTriggerRepoInterface {
+	return r.launchPlanTriggerRepo
+}
+
+func (r *MockRepository) TriggerFiringRepo() interfaces.TriggerFiringRepoInterface {
+	return r.triggerFiringRepo
+}
+
+func (r *MockRepository) PromotionRepo() interfaces.PromotionRepoInterface {
+	return r.promotionRepo
+}
+
+func (r *MockRepository) ExecutionArchiveRepo() interfaces.ExecutionArchiveRepoInterface {
+	return r.executionArchiveRepo
+}
+
 func NewMockRepository() interfaces.Repository {
 	return &MockRepository{
 		taskRepo:                      NewMockTaskRepo(),
@@ -103,9 +128,14 @@ func NewMockRepository() interfaces.Repository {
 		namedEntityRepo:               &NamedEntityRepoInterface{},
 		descriptionEntityRepo:         &DescriptionEntityRepoInterface{},
 		ExecutionEventRepoIface:       &ExecutionEventRepoInterface{},
+		ExecutionPhaseChangeRepoIface: &ExecutionPhaseChangeRepoInterface{},
 		NodeExecutionEventRepoIface:   &NodeExecutionEventRepoInterface{},
 		schedulableEntityRepo:         &sMocks.SchedulableEntityRepoInterface{},
 		schedulableEntitySnapshotRepo: &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
 		signalRepo:                    &SignalRepoInterface{},
+		launchPlanTriggerRepo:         &LaunchPlanTriggerRepoInterface{},
+		triggerFiringRepo:             &TriggerFiringRepoInterface{},
+		promotionRepo:                 &PromotionRepoInterface{},
+		executionArchiveRepo:          &ExecutionArchiveRepoInterface{},
 	}
 }
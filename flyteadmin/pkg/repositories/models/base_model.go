@@ -18,6 +18,13 @@ type BaseModel struct {
 	DeletedAt *time.Time `gorm:"index"`
 }
 
+// The columns uniquely identifying the rows listed, which order the rows sharing the same sort key when paginating.
+var (
+	IDColumns         = []string{"id"}
+	IdentifierColumns = []string{"project", "domain", "name"}
+	ProjectIDColumns  = []string{"identifier"}
+)
+
 func modelColumns(v any) sets.String {
 	s, err := schema.Parse(v, &sync.Map{}, schema.NamingStrategy{})
 	if err != nil {
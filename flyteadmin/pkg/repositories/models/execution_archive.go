@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+// ExecutionArchive records where a terminal execution was archived to when it was pruned from the database.
+type ExecutionArchive struct {
+	BaseModel
+	ExecutionKey
+	// Phase and last update of the execution when it was archived.
+	Phase              string `valid:"length(0|255)"`
+	ExecutionUpdatedAt *time.Time
+	// Location of the compressed archive of the rows of the execution in the metadata bucket.
+	Location storage.DataReference
+}
+
+// ArchivedExecution holds the rows of an execution, and of its nodes and tasks, written to its archive.
+type ArchivedExecution struct {
+	Execution           Execution
+	NodeExecutions      []NodeExecution
+	TaskExecutions      []TaskExecution
+	ExecutionEvents     []ExecutionEvent
+	NodeExecutionEvents []NodeExecutionEvent
+}
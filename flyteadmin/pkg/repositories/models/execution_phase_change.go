@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// ExecutionPhaseChange is a phase change of a workflow execution, of one of its nodes or of one of their tasks, kept
+// for the watchers of the execution. Its ID orders the phase changes of all the executions and serves as the cursor
+// watchers resume from.
+type ExecutionPhaseChange struct {
+	ID        uint      `gorm:"primary_key"`
+	CreatedAt time.Time `gorm:"index"`
+	Project   string    `gorm:"column:execution_project;index:idx_execution_phase_changes_execution" valid:"length(0|255)"`
+	Domain    string    `gorm:"column:execution_domain;index:idx_execution_phase_changes_execution" valid:"length(0|255)"`
+	Name      string    `gorm:"column:execution_name;index:idx_execution_phase_changes_execution" valid:"length(0|255)"`
+	// Serialized admin.WatchExecutionResponse, without its cursor.
+	Change []byte
+}
+
+// ExecutionWatch is a lease of the watchers of an execution. The phase changes of an execution are only recorded while
+// it is watched, and for a while after its watchers disconnected so that they can resume.
+type ExecutionWatch struct {
+	Project   string    `gorm:"primary_key;column:execution_project" valid:"length(0|255)"`
+	Domain    string    `gorm:"primary_key;column:execution_domain" valid:"length(0|255)"`
+	Name      string    `gorm:"primary_key;column:execution_name" valid:"length(0|255)"`
+	ExpiresAt time.Time `gorm:"index"`
+}
@@ -0,0 +1,29 @@
+package models
+
+// LaunchPlanTrigger primary key
+type LaunchPlanTriggerKey struct {
+	Project string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain  string `gorm:"primary_key" valid:"length(0|255)"`
+	// Name of the launch plan.
+	Name        string `gorm:"primary_key" valid:"length(0|255)"`
+	TriggerName string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// LaunchPlanTrigger is a trigger of the active version of a launch plan, kept apart from the launch plan to look up the
+// triggers matching an event.
+type LaunchPlanTrigger struct {
+	BaseModel
+	LaunchPlanTriggerKey
+	Version string `valid:"length(0|255)"`
+	// Kind of the events the trigger fires on.
+	EventType string `gorm:"index" valid:"length(0|255)"`
+	// Serialized admin.Trigger.
+	Trigger []byte `gorm:"not null"`
+}
+
+// Kinds of the events triggers fire on.
+const (
+	TriggerEventTypeStorage    = "storage"
+	TriggerEventTypeWebhook    = "webhook"
+	TriggerEventTypeCloudEvent = "cloud_event"
+)
@@ -0,0 +1,21 @@
+package models
+
+// Promotion primary key, identifying the entity a promotion registered.
+type PromotionKey struct {
+	// Name of the core.ResourceType of the entity.
+	ResourceType string `gorm:"primary_key" valid:"length(0|255)"`
+	Project      string `gorm:"primary_key" valid:"length(0|255)"`
+	Domain       string `gorm:"primary_key" valid:"length(0|255)"`
+	Name         string `gorm:"primary_key" valid:"length(0|255)"`
+	Version      string `gorm:"primary_key" valid:"length(0|255)"`
+}
+
+// Promotion records the project and domain an entity was promoted from. The promoted entity shares the name and
+// version of the entity it was copied from.
+type Promotion struct {
+	BaseModel
+	PromotionKey
+	SourceProject string `valid:"length(0|255)"`
+	SourceDomain  string `valid:"length(0|255)"`
+	PromotedBy    string `valid:"length(0|255)"`
+}
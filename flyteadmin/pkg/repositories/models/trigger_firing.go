@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// TriggerFiring records an event which matched a trigger of a launch plan, and what came out of it.
+type TriggerFiring struct {
+	ID        uint      `gorm:"primary_key"`
+	CreatedAt time.Time `gorm:"index:idx_trigger_firings_trigger,priority:5"`
+	Project   string    `gorm:"index:idx_trigger_firings_trigger,priority:1" valid:"length(0|255)"`
+	Domain    string    `gorm:"index:idx_trigger_firings_trigger,priority:2" valid:"length(0|255)"`
+	// Name of the launch plan.
+	Name              string `gorm:"index:idx_trigger_firings_trigger,priority:3" valid:"length(0|255)"`
+	TriggerName       string `gorm:"index:idx_trigger_firings_trigger,priority:4" valid:"length(0|255)"`
+	LaunchPlanVersion string `valid:"length(0|255)"`
+	EventID           string
+	// Name of the admin.TriggerFiringOutcome, which is unspecified while the firing is pending.
+	Outcome       string `valid:"length(0|255)"`
+	ExecutionName string `valid:"length(0|255)"`
+	Message       string
+}
+
+var TriggerFiringColumns = modelColumns(TriggerFiring{})
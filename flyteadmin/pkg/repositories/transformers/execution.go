@@ -214,6 +214,7 @@ func UpdateExecutionModelState(
 	if err != nil {
 		return flyteErrs.NewFlyteAdminErrorf(codes.Internal, "Failed to unmarshal execution closure: %v", err)
 	}
+	resumed := execution.Phase == core.WorkflowExecution_PAUSED.String()
 	executionClosure.Phase = request.GetEvent().GetPhase()
 	executionClosure.UpdatedAt = request.GetEvent().GetOccurredAt()
 	execution.Phase = request.GetEvent().GetPhase().String()
@@ -224,8 +225,8 @@ func UpdateExecutionModelState(
 	}
 	execution.ExecutionUpdatedAt = &occurredAtTimestamp
 
-	// only mark the execution started when we get the initial running event
-	if request.GetEvent().GetPhase() == core.WorkflowExecution_RUNNING {
+	// only mark the execution started when we get the initial running event, rather than the one of its resumption
+	if request.GetEvent().GetPhase() == core.WorkflowExecution_RUNNING && !resumed {
 		execution.StartedAt = &occurredAtTimestamp
 		executionClosure.StartedAt = request.GetEvent().GetOccurredAt()
 	} else if common.IsExecutionTerminal(request.GetEvent().GetPhase()) {
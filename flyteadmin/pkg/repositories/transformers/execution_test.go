@@ -387,6 +387,39 @@ func TestUpdateModelState_UnknownToRunning(t *testing.T) {
 	assert.EqualValues(t, expectedModel, executionModel)
 }
 
+func TestUpdateModelState_PausedToRunning(t *testing.T) {
+	startedAt := time.Date(2018, 10, 29, 16, 0, 0, 0, time.UTC)
+	startedAtProto, _ := ptypes.TimestampProto(startedAt)
+	existingClosure := admin.ExecutionClosure{
+		Phase:     core.WorkflowExecution_PAUSED,
+		StartedAt: startedAtProto,
+	}
+	spec := testutils.GetExecutionRequest().GetSpec()
+	specBytes, _ := proto.Marshal(spec)
+	existingClosureBytes, _ := proto.Marshal(&existingClosure)
+	executionModel := getRunningExecutionModel(specBytes, existingClosureBytes, startedAt)
+	executionModel.Phase = core.WorkflowExecution_PAUSED.String()
+
+	occurredAt := time.Date(2018, 10, 29, 16, 10, 0, 0, time.UTC)
+	occurredAtProto, _ := ptypes.TimestampProto(occurredAt)
+	err := UpdateExecutionModelState(context.TODO(), &executionModel, &admin.WorkflowExecutionEventRequest{
+		Event: &event.WorkflowExecutionEvent{
+			Phase:      core.WorkflowExecution_RUNNING,
+			OccurredAt: occurredAtProto,
+		},
+	}, interfaces.InlineEventDataPolicyStoreInline, commonMocks.GetMockStorageClient())
+	assert.Nil(t, err)
+
+	// Resuming doesn't restart the execution.
+	assert.Equal(t, core.WorkflowExecution_RUNNING.String(), executionModel.Phase)
+	assert.Equal(t, startedAt, *executionModel.StartedAt)
+	var closure admin.ExecutionClosure
+	assert.NoError(t, proto.Unmarshal(executionModel.Closure, &closure))
+	assert.Equal(t, core.WorkflowExecution_RUNNING, closure.GetPhase())
+	assert.True(t, proto.Equal(startedAtProto, closure.GetStartedAt()))
+	assert.True(t, proto.Equal(occurredAtProto, closure.GetUpdatedAt()))
+}
+
 func TestUpdateModelState_RunningToFailed(t *testing.T) {
 	startedAt := time.Now()
 	startedAtProto, _ := ptypes.TimestampProto(startedAt)
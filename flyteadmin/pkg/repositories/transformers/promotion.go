@@ -0,0 +1,52 @@
+package transformers
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func CreatePromotionKey(id *core.Identifier) models.PromotionKey {
+	return models.PromotionKey{
+		ResourceType: id.GetResourceType().String(),
+		Project:      id.GetProject(),
+		Domain:       id.GetDomain(),
+		Name:         id.GetName(),
+		Version:      id.GetVersion(),
+	}
+}
+
+// Transforms the promotion of an entity to its model. The target of the promotion shares the name and version of its
+// source.
+func CreatePromotionModel(promotion *admin.Promotion) models.Promotion {
+	return models.Promotion{
+		PromotionKey:  CreatePromotionKey(promotion.GetTarget()),
+		SourceProject: promotion.GetSource().GetProject(),
+		SourceDomain:  promotion.GetSource().GetDomain(),
+		PromotedBy:    promotion.GetPromotedBy(),
+	}
+}
+
+func FromPromotionModel(promotionModel models.Promotion) *admin.Promotion {
+	resourceType := core.ResourceType(core.ResourceType_value[promotionModel.ResourceType])
+	return &admin.Promotion{
+		Source: &core.Identifier{
+			ResourceType: resourceType,
+			Project:      promotionModel.SourceProject,
+			Domain:       promotionModel.SourceDomain,
+			Name:         promotionModel.Name,
+			Version:      promotionModel.Version,
+		},
+		Target: &core.Identifier{
+			ResourceType: resourceType,
+			Project:      promotionModel.Project,
+			Domain:       promotionModel.Domain,
+			Name:         promotionModel.Name,
+			Version:      promotionModel.Version,
+		},
+		PromotedBy: promotionModel.PromotedBy,
+		PromotedAt: timestamppb.New(promotionModel.CreatedAt),
+	}
+}
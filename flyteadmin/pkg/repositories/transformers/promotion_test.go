@@ -0,0 +1,53 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func TestPromotionModel(t *testing.T) {
+	promotion := &admin.Promotion{
+		Source: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "development",
+			Name:         "name",
+			Version:      "version",
+		},
+		Target: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      "project",
+			Domain:       "production",
+			Name:         "name",
+			Version:      "version",
+		},
+		PromotedBy: "user",
+	}
+
+	promotionModel := CreatePromotionModel(promotion)
+	assert.Equal(t, models.Promotion{
+		PromotionKey: models.PromotionKey{
+			ResourceType: "WORKFLOW",
+			Project:      "project",
+			Domain:       "production",
+			Name:         "name",
+			Version:      "version",
+		},
+		SourceProject: "project",
+		SourceDomain:  "development",
+		PromotedBy:    "user",
+	}, promotionModel)
+
+	promotedAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	promotionModel.CreatedAt = promotedAt
+	promotion.PromotedAt = timestamppb.New(promotedAt)
+	assert.True(t, proto.Equal(promotion, FromPromotionModel(promotionModel)))
+}
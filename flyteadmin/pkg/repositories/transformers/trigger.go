@@ -0,0 +1,103 @@
+package transformers
+
+import (
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func getTriggerEventType(trigger *admin.Trigger) string {
+	switch trigger.GetEvent().(type) {
+	case *admin.Trigger_Storage:
+		return models.TriggerEventTypeStorage
+	case *admin.Trigger_Webhook:
+		return models.TriggerEventTypeWebhook
+	case *admin.Trigger_CloudEvent:
+		return models.TriggerEventTypeCloudEvent
+	}
+	return ""
+}
+
+// Transforms the triggers of a launch plan version to the models of the triggers of its launch plan.
+func CreateLaunchPlanTriggerModels(id *core.Identifier, triggers []*admin.Trigger) ([]models.LaunchPlanTrigger, error) {
+	triggerModels := make([]models.LaunchPlanTrigger, len(triggers))
+	for idx, trigger := range triggers {
+		triggerBytes, err := proto.Marshal(trigger)
+		if err != nil {
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal, "Failed to serialize trigger [%s]", trigger.GetName())
+		}
+		triggerModels[idx] = models.LaunchPlanTrigger{
+			LaunchPlanTriggerKey: models.LaunchPlanTriggerKey{
+				Project:     id.GetProject(),
+				Domain:      id.GetDomain(),
+				Name:        id.GetName(),
+				TriggerName: trigger.GetName(),
+			},
+			Version:   id.GetVersion(),
+			EventType: getTriggerEventType(trigger),
+			Trigger:   triggerBytes,
+		}
+	}
+	return triggerModels, nil
+}
+
+func FromLaunchPlanTriggerModel(triggerModel models.LaunchPlanTrigger) (*admin.Trigger, error) {
+	trigger := &admin.Trigger{}
+	if err := proto.Unmarshal(triggerModel.Trigger, trigger); err != nil {
+		return nil, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal trigger [%s]", triggerModel.TriggerName)
+	}
+	return trigger, nil
+}
+
+// Transforms a record of an event matching a trigger to its model. The launch plan of the firing is expected to be set.
+func CreateTriggerFiringModel(firing *admin.TriggerFiring) models.TriggerFiring {
+	return models.TriggerFiring{
+		Project:           firing.GetLaunchPlan().GetProject(),
+		Domain:            firing.GetLaunchPlan().GetDomain(),
+		Name:              firing.GetLaunchPlan().GetName(),
+		TriggerName:       firing.GetTriggerName(),
+		LaunchPlanVersion: firing.GetLaunchPlan().GetVersion(),
+		EventID:           firing.GetEventId(),
+		Outcome:           firing.GetOutcome().String(),
+		ExecutionName:     firing.GetExecutionId().GetName(),
+		Message:           firing.GetMessage(),
+	}
+}
+
+func FromTriggerFiringModel(firingModel models.TriggerFiring) *admin.TriggerFiring {
+	firing := &admin.TriggerFiring{
+		LaunchPlan: &core.Identifier{
+			ResourceType: core.ResourceType_LAUNCH_PLAN,
+			Project:      firingModel.Project,
+			Domain:       firingModel.Domain,
+			Name:         firingModel.Name,
+			Version:      firingModel.LaunchPlanVersion,
+		},
+		TriggerName: firingModel.TriggerName,
+		EventId:     firingModel.EventID,
+		Outcome:     admin.TriggerFiringOutcome(admin.TriggerFiringOutcome_value[firingModel.Outcome]),
+		Message:     firingModel.Message,
+		ReceivedAt:  timestamppb.New(firingModel.CreatedAt),
+	}
+	if len(firingModel.ExecutionName) > 0 {
+		firing.ExecutionId = &core.WorkflowExecutionIdentifier{
+			Project: firingModel.Project,
+			Domain:  firingModel.Domain,
+			Name:    firingModel.ExecutionName,
+		}
+	}
+	return firing
+}
+
+func FromTriggerFiringModels(firingModels []models.TriggerFiring) []*admin.TriggerFiring {
+	firings := make([]*admin.TriggerFiring, len(firingModels))
+	for idx, firingModel := range firingModels {
+		firings[idx] = FromTriggerFiringModel(firingModel)
+	}
+	return firings
+}
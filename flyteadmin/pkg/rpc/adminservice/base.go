@@ -8,7 +8,10 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/cloudevent"
 	eventWriter "github.com/flyteorg/flyte/flyteadmin/pkg/async/events/implementations"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/notifications"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/retention"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/async/schedule"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/async/watch"
+	watchInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/data"
 	executionCluster "github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster/impl"
 	manager "github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl"
@@ -16,6 +19,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc/triggers"
 	runtimeIfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	workflowengineImpl "github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/impl"
 	"github.com/flyteorg/flyte/flyteadmin/plugins"
@@ -40,6 +44,9 @@ type AdminService struct {
 	VersionManager           interfaces.VersionInterface
 	DescriptionEntityManager interfaces.DescriptionEntityInterface
 	MetricsManager           interfaces.MetricsInterface
+	TriggerManager           interfaces.TriggerInterface
+	PromotionManager         interfaces.PromotionInterface
+	ExecutionWatcher         watchInterfaces.ExecutionWatcher
 	Metrics                  AdminMetrics
 }
 
@@ -84,6 +91,18 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 	publisher := notifications.NewNotificationsPublisher(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope)
 	processor := notifications.NewNotificationsProcessor(*configuration.ApplicationConfiguration().GetNotificationsConfig(), adminScope, sm)
 	eventPublisher := notifications.NewEventsPublisher(*configuration.ApplicationConfiguration().GetExternalEventsConfig(), adminScope)
+	var executionWatcher watchInterfaces.ExecutionWatcher
+	if applicationConfiguration.ExecutionWatch.Enabled {
+		executionWatcher = watch.NewExecutionWatcher(ctx, repo, applicationConfiguration.ExecutionWatch,
+			adminScope.NewSubScope("execution_watcher"))
+		go func() {
+			logger.Info(ctx, "Started watching the phase changes of executions.")
+			if err := executionWatcher.Run(ctx); err != nil {
+				logger.Errorf(ctx, "Failed to watch the phase changes of executions with err [%v]", err)
+			}
+		}()
+		eventPublisher = watch.NewEventsPublisher(eventPublisher, executionWatcher)
+	}
 	go func() {
 		logger.Info(ctx, "Started processing notifications.")
 		processor.StartProcessing()
@@ -118,6 +137,10 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 	workflowManager := manager.NewWorkflowManager(
 		repo, configuration, workflowengineImpl.NewCompiler(), dataStorageClient, applicationConfiguration.GetMetadataStoragePrefix(),
 		adminScope.NewSubScope("workflow_manager"))
+	taskManager := manager.NewTaskManager(repo, configuration, workflowengineImpl.NewCompiler(),
+		adminScope.NewSubScope("task_manager"))
+	promotionManager := manager.NewPromotionManager(repo, configuration, taskManager, workflowManager, launchPlanManager,
+		adminScope.NewSubScope("promotion_manager"))
 	namedEntityManager := manager.NewNamedEntityManager(repo, configuration, adminScope.NewSubScope("named_entity_manager"))
 	descriptionEntityManager := manager.NewDescriptionEntityManager(repo, configuration, adminScope.NewSubScope("description_entity_manager"))
 
@@ -131,6 +154,19 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		publisher, urlData, workflowManager, namedEntityManager, eventPublisher, cloudEventPublisher, executionEventWriter)
 	versionManager := manager.NewVersionManager()
 
+	triggersConfig := configuration.ApplicationConfiguration().GetTriggersConfig()
+	triggerManager := manager.NewTriggerManager(repo, executionManager, adminScope.NewSubScope("trigger_manager"))
+	storageEventsProcessor := notifications.NewStorageEventsProcessor(*triggersConfig, triggerManager,
+		adminScope.NewSubScope("triggers"))
+	go func() {
+		logger.Info(ctx, "Started processing storage events.")
+		storageEventsProcessor.StartProcessing()
+	}()
+	if triggersConfig.EnableHTTPEndpoints {
+		pluginRegistry.RegisterDefault(plugins.PluginIDTriggerHandler,
+			triggers.NewHandler(*triggersConfig, triggerManager, adminScope.NewSubScope("trigger_handler")))
+	}
+
 	scheduledWorkflowExecutor := workflowScheduler.GetWorkflowExecutor(executionManager, launchPlanManager)
 	logger.Info(ctx, "Successfully initialized a new scheduled workflow executor")
 	go func() {
@@ -138,6 +174,16 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		scheduledWorkflowExecutor.Run()
 	}()
 
+	if retentionConfig := applicationConfiguration.ExecutionRetention; retentionConfig.Enabled {
+		archiver := retention.NewArchiver(repo, dataStorageClient, applicationConfiguration.GetMetadataStoragePrefix(),
+			retentionConfig, *configuration.ApplicationConfiguration().GetDomainsConfig(),
+			adminScope.NewSubScope("execution_archiver"))
+		go func() {
+			logger.Info(ctx, "Started archiving the executions past their retention period.")
+			archiver.Run(ctx)
+		}()
+	}
+
 	nodeExecutionEventWriter := eventWriter.NewNodeExecutionEventWriter(repo, applicationConfiguration.GetAsyncEventsBufferSize())
 	go func() {
 		nodeExecutionEventWriter.Run()
@@ -150,8 +196,7 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 
 	logger.Info(ctx, "Initializing a new AdminService")
 	return &AdminService{
-		TaskManager: manager.NewTaskManager(repo, configuration, workflowengineImpl.NewCompiler(),
-			adminScope.NewSubScope("task_manager")),
+		TaskManager:              taskManager,
 		WorkflowManager:          workflowManager,
 		LaunchPlanManager:        launchPlanManager,
 		ExecutionManager:         executionManager,
@@ -164,6 +209,9 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		ResourceManager:          resources.NewResourceManager(repo, configuration.ApplicationConfiguration()),
 		MetricsManager: manager.NewMetricsManager(workflowManager, executionManager, nodeExecutionManager,
 			taskExecutionManager, adminScope.NewSubScope("metrics_manager")),
-		Metrics: InitMetrics(adminScope),
+		TriggerManager:   triggerManager,
+		PromotionManager: promotionManager,
+		ExecutionWatcher: executionWatcher,
+		Metrics:          InitMetrics(adminScope),
 	}
 }
@@ -4,8 +4,12 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc/adminservice/util"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
 )
 
 func (m *AdminService) CreateExecution(
@@ -152,3 +156,49 @@ func (m *AdminService) TerminateExecution(
 	m.Metrics.executionEndpointMetrics.terminate.Success()
 	return response, nil
 }
+
+func (m *AdminService) PauseExecution(
+	ctx context.Context, request *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error) {
+	var response *admin.ExecutionPauseResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.pause.Time(func() {
+		response, err = m.ExecutionManager.PauseExecution(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.pause)
+	}
+	m.Metrics.executionEndpointMetrics.pause.Success()
+	return response, nil
+}
+
+func (m *AdminService) ResumeExecution(
+	ctx context.Context, request *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error) {
+	var response *admin.ExecutionResumeResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.resume.Time(func() {
+		response, err = m.ExecutionManager.ResumeExecution(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.resume)
+	}
+	m.Metrics.executionEndpointMetrics.resume.Success()
+	return response, nil
+}
+
+func (m *AdminService) WatchExecution(
+	request *admin.WatchExecutionRequest, stream service.AdminService_WatchExecutionServer) error {
+	ctx := stream.Context()
+	if m.ExecutionWatcher == nil {
+		return util.TransformAndRecordError(errors.NewFlyteAdminError(codes.Unimplemented,
+			"watching executions is not enabled"), &m.Metrics.executionEndpointMetrics.watch)
+	}
+	// Fails for executions which don't exist, rather than waiting for phase changes that will never come.
+	if _, err := m.ExecutionManager.GetExecution(ctx, &admin.WorkflowExecutionGetRequest{Id: request.GetId()}); err != nil {
+		return util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.watch)
+	}
+	if err := m.ExecutionWatcher.Watch(ctx, request, stream.Send); err != nil {
+		return util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.watch)
+	}
+	m.Metrics.executionEndpointMetrics.watch.Success()
+	return nil
+}
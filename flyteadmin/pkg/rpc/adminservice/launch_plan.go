@@ -120,3 +120,18 @@ func (m *AdminService) ListLaunchPlanIds(ctx context.Context, request *admin.Nam
 	m.Metrics.launchPlanEndpointMetrics.listIds.Success()
 	return response, nil
 }
+
+func (m *AdminService) ListTriggerFirings(ctx context.Context, request *admin.ResourceListRequest) (
+	*admin.TriggerFiringList, error) {
+	var response *admin.TriggerFiringList
+	var err error
+	m.Metrics.launchPlanEndpointMetrics.listTriggerFirings.Time(func() {
+		response, err = m.TriggerManager.ListTriggerFirings(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.launchPlanEndpointMetrics.listTriggerFirings)
+	}
+
+	m.Metrics.launchPlanEndpointMetrics.listTriggerFirings.Success()
+	return response, nil
+}
@@ -19,6 +19,9 @@ type executionEndpointMetrics struct {
 	getMetrics  util.RequestMetrics
 	list        util.RequestMetrics
 	terminate   util.RequestMetrics
+	pause       util.RequestMetrics
+	resume      util.RequestMetrics
+	watch       util.RequestMetrics
 }
 
 type launchPlanEndpointMetrics struct {
@@ -31,6 +34,8 @@ type launchPlanEndpointMetrics struct {
 	list       util.RequestMetrics
 	listActive util.RequestMetrics
 	listIds    util.RequestMetrics
+
+	listTriggerFirings util.RequestMetrics
 }
 
 type namedEntityEndpointMetrics struct {
@@ -98,10 +103,12 @@ type taskExecutionEndpointMetrics struct {
 type workflowEndpointMetrics struct {
 	scope promutils.Scope
 
-	create  util.RequestMetrics
-	get     util.RequestMetrics
-	list    util.RequestMetrics
-	listIds util.RequestMetrics
+	create       util.RequestMetrics
+	get          util.RequestMetrics
+	list         util.RequestMetrics
+	listIds      util.RequestMetrics
+	promote      util.RequestMetrics
+	getPromotion util.RequestMetrics
 }
 
 type descriptionEntityEndpointMetrics struct {
@@ -147,6 +154,9 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			getMetrics:  util.NewRequestMetrics(adminScope, "get_execution_metrics"),
 			list:        util.NewRequestMetrics(adminScope, "list_execution"),
 			terminate:   util.NewRequestMetrics(adminScope, "terminate_execution"),
+			pause:       util.NewRequestMetrics(adminScope, "pause_execution"),
+			resume:      util.NewRequestMetrics(adminScope, "resume_execution"),
+			watch:       util.NewRequestMetrics(adminScope, "watch_execution"),
 		},
 		launchPlanEndpointMetrics: launchPlanEndpointMetrics{
 			scope:      adminScope,
@@ -157,6 +167,8 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			list:       util.NewRequestMetrics(adminScope, "list_launch_plan"),
 			listActive: util.NewRequestMetrics(adminScope, "list_active_launch_plans"),
 			listIds:    util.NewRequestMetrics(adminScope, "list_launch_plan_ids"),
+
+			listTriggerFirings: util.NewRequestMetrics(adminScope, "list_trigger_firings"),
 		},
 		namedEntityEndpointMetrics: namedEntityEndpointMetrics{
 			scope:  adminScope,
@@ -222,11 +234,13 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			list:        util.NewRequestMetrics(adminScope, "list_task_execution"),
 		},
 		workflowEndpointMetrics: workflowEndpointMetrics{
-			scope:   adminScope,
-			create:  util.NewRequestMetrics(adminScope, "create_workflow"),
-			get:     util.NewRequestMetrics(adminScope, "get_workflow"),
-			list:    util.NewRequestMetrics(adminScope, "list_workflow"),
-			listIds: util.NewRequestMetrics(adminScope, "list_workflow_ids"),
+			scope:        adminScope,
+			create:       util.NewRequestMetrics(adminScope, "create_workflow"),
+			get:          util.NewRequestMetrics(adminScope, "get_workflow"),
+			list:         util.NewRequestMetrics(adminScope, "list_workflow"),
+			listIds:      util.NewRequestMetrics(adminScope, "list_workflow_ids"),
+			promote:      util.NewRequestMetrics(adminScope, "promote_workflow"),
+			getPromotion: util.NewRequestMetrics(adminScope, "get_promotion"),
 		},
 
 		descriptionEntityMetrics: descriptionEntityEndpointMetrics{
@@ -10,10 +10,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	watchMocks "github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/mocks"
 	flyteAdminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
 	repoErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	serviceMocks "github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
@@ -283,6 +286,66 @@ func TestGetExecutionError(t *testing.T) {
 	assert.Nil(t, actualResponse)
 }
 
+func TestWatchExecution(t *testing.T) {
+	ctx := context.Background()
+	request := &admin.WatchExecutionRequest{
+		Id:     &workflowExecutionIdentifier,
+		NodeId: "n0",
+	}
+	response := &admin.WatchExecutionResponse{
+		Cursor: "1",
+	}
+	mockExecutionManager := mocks.ExecutionInterface{}
+	mockExecutionManager.EXPECT().GetExecution(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context,
+			request *admin.WorkflowExecutionGetRequest) (*admin.Execution, error) {
+			assert.True(t, proto.Equal(&workflowExecutionIdentifier, request.GetId()))
+			return &admin.Execution{Id: &workflowExecutionIdentifier}, nil
+		},
+	)
+	mockExecutionWatcher := watchMocks.NewExecutionWatcher(t)
+	mockExecutionWatcher.EXPECT().Watch(ctx, request, mock.Anything).RunAndReturn(
+		func(ctx context.Context, request *admin.WatchExecutionRequest,
+			send func(*admin.WatchExecutionResponse) error) error {
+			return send(response)
+		},
+	)
+	mockStream := serviceMocks.NewAdminService_WatchExecutionServer(t)
+	mockStream.EXPECT().Context().Return(ctx)
+	mockStream.EXPECT().Send(response).Return(nil)
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+		executionWatcher: mockExecutionWatcher,
+	})
+
+	assert.NoError(t, mockServer.WatchExecution(request, mockStream))
+}
+
+func TestWatchExecutionError(t *testing.T) {
+	mockExecutionManager := mocks.ExecutionInterface{}
+	mockExecutionManager.EXPECT().GetExecution(mock.Anything, mock.Anything).Return(
+		nil, flyteAdminErrors.NewFlyteAdminError(codes.NotFound, "not found"))
+	mockStream := serviceMocks.NewAdminService_WatchExecutionServer(t)
+	mockStream.EXPECT().Context().Return(context.Background())
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+		executionWatcher: watchMocks.NewExecutionWatcher(t),
+	})
+
+	err := mockServer.WatchExecution(&admin.WatchExecutionRequest{Id: &workflowExecutionIdentifier}, mockStream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWatchExecutionDisabled(t *testing.T) {
+	mockStream := serviceMocks.NewAdminService_WatchExecutionServer(t)
+	mockStream.EXPECT().Context().Return(context.Background())
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{})
+	mockServer.ExecutionWatcher = nil
+
+	err := mockServer.WatchExecution(&admin.WatchExecutionRequest{Id: &workflowExecutionIdentifier}, mockStream)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
 func TestUpdateExecution(t *testing.T) {
 	response := &admin.ExecutionUpdateResponse{}
 	mockExecutionManager := mocks.ExecutionInterface{}
@@ -424,3 +487,48 @@ func TestTerminateExecution_Error(t *testing.T) {
 	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
 	assert.Nil(t, response)
 }
+
+func TestPauseExecution(t *testing.T) {
+	mockExecutionManager := mocks.ExecutionInterface{}
+	identifier := core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	mockExecutionManager.EXPECT().PauseExecution(mock.Anything, mock.Anything).RunAndReturn(func(
+		ctx context.Context, request *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error) {
+		assert.True(t, proto.Equal(&identifier, request.GetId()))
+		assert.True(t, request.GetAbortRunningNodes())
+		return &admin.ExecutionPauseResponse{}, nil
+	})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+	_, err := mockServer.PauseExecution(context.Background(), &admin.ExecutionPauseRequest{
+		Id:                &identifier,
+		AbortRunningNodes: true,
+	})
+	assert.Nil(t, err)
+}
+
+func TestResumeExecution_Error(t *testing.T) {
+	mockExecutionManager := mocks.ExecutionInterface{}
+	identifier := core.WorkflowExecutionIdentifier{
+		Project: "project",
+		Domain:  "domain",
+		Name:    "name",
+	}
+	mockExecutionManager.EXPECT().ResumeExecution(mock.Anything, mock.Anything).RunAndReturn(func(
+		ctx context.Context, request *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error) {
+		return nil, errors.New("expected error")
+	})
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		executionManager: &mockExecutionManager,
+	})
+	response, err := mockServer.ResumeExecution(context.Background(), &admin.ExecutionResumeRequest{
+		Id: &identifier,
+	})
+	assert.EqualError(t, err, "expected error")
+	assert.Equal(t, codes.Internal, err.(flyteAdminErrors.FlyteAdminError).Code())
+	assert.Nil(t, response)
+}
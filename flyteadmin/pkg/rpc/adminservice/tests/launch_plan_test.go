@@ -162,3 +162,26 @@ func TestListActiveLaunchPlans_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 }
+
+func TestListTriggerFirings(t *testing.T) {
+	ctx := context.Background()
+
+	mockTriggerManager := mocks.TriggerInterface{}
+	mockTriggerManager.EXPECT().ListTriggerFirings(mock.Anything, mock.Anything).Return(&admin.TriggerFiringList{
+		Firings: []*admin.TriggerFiring{{TriggerName: "trigger"}},
+	}, nil)
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		triggerManager: &mockTriggerManager,
+	})
+
+	resp, err := mockServer.ListTriggerFirings(ctx, &admin.ResourceListRequest{
+		Id: &admin.NamedEntityIdentifier{
+			Project: "Project",
+			Domain:  "Domain",
+			Name:    "Name",
+		},
+		Limit: 10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetFirings(), 1)
+}
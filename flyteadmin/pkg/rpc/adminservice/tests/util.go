@@ -1,6 +1,7 @@
 package tests
 
 import (
+	watchMocks "github.com/flyteorg/flyte/flyteadmin/pkg/async/watch/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc/adminservice"
 	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
@@ -15,6 +16,9 @@ type NewMockAdminServerInput struct {
 	taskManager          *mocks.TaskInterface
 	workflowManager      *mocks.WorkflowInterface
 	taskExecutionManager *mocks.TaskExecutionInterface
+	triggerManager       *mocks.TriggerInterface
+	promotionManager     *mocks.PromotionInterface
+	executionWatcher     *watchMocks.ExecutionWatcher
 }
 
 func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminService {
@@ -28,6 +32,9 @@ func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminServic
 		ResourceManager:      input.resourceManager,
 		WorkflowManager:      input.workflowManager,
 		TaskExecutionManager: input.taskExecutionManager,
+		TriggerManager:       input.triggerManager,
+		PromotionManager:     input.promotionManager,
+		ExecutionWatcher:     input.executionWatcher,
 		Metrics:              adminservice.InitMetrics(testScope),
 	}
 }
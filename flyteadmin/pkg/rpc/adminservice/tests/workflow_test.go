@@ -64,3 +64,47 @@ func TestCreateWorkflowError(t *testing.T) {
 	utils.AssertEqualWithSanitizedRegex(t, "missing entity of type WORKFLOW with "+
 		"identifier resource_type:WORKFLOW project:\"Project\" domain:\"Domain\" name:\"Name\" version:\"Version\"", err.Error())
 }
+
+func TestPromoteWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	mockPromotionManager := mocks.PromotionInterface{}
+	mockPromotionManager.EXPECT().PromoteWorkflow(mock.Anything, mock.Anything).Return(&admin.WorkflowPromoteResponse{
+		Promotions: []*admin.Promotion{
+			{
+				Source: &workflowIdentifier,
+			},
+		},
+	}, nil)
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		promotionManager: &mockPromotionManager,
+	})
+
+	resp, err := mockServer.PromoteWorkflow(ctx, &admin.WorkflowPromoteRequest{
+		Id:           &workflowIdentifier,
+		TargetDomain: "production",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetPromotions(), 1)
+}
+
+func TestGetPromotionError(t *testing.T) {
+	ctx := context.Background()
+
+	mockPromotionManager := mocks.PromotionInterface{}
+	mockPromotionManager.EXPECT().GetPromotion(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, request *admin.ObjectGetRequest) (*admin.Promotion, error) {
+			return nil, errors.GetMissingEntityError(core.ResourceType_WORKFLOW.String(), request.GetId())
+		},
+	)
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		promotionManager: &mockPromotionManager,
+	})
+
+	resp, err := mockServer.GetPromotion(ctx, &admin.ObjectGetRequest{
+		Id: &workflowIdentifier,
+	})
+	assert.Nil(t, resp)
+	utils.AssertEqualWithSanitizedRegex(t, "missing entity of type WORKFLOW with "+
+		"identifier resource_type:WORKFLOW project:\"Project\" domain:\"Domain\" name:\"Name\" version:\"Version\"", err.Error())
+}
@@ -70,3 +70,30 @@ func (m *AdminService) ListWorkflows(ctx context.Context, request *admin.Resourc
 	m.Metrics.workflowEndpointMetrics.list.Success()
 	return response, nil
 }
+
+func (m *AdminService) PromoteWorkflow(ctx context.Context, request *admin.WorkflowPromoteRequest) (
+	*admin.WorkflowPromoteResponse, error) {
+	var response *admin.WorkflowPromoteResponse
+	var err error
+	m.Metrics.workflowEndpointMetrics.promote.Time(func() {
+		response, err = m.PromotionManager.PromoteWorkflow(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.workflowEndpointMetrics.promote)
+	}
+	m.Metrics.workflowEndpointMetrics.promote.Success()
+	return response, nil
+}
+
+func (m *AdminService) GetPromotion(ctx context.Context, request *admin.ObjectGetRequest) (*admin.Promotion, error) {
+	var response *admin.Promotion
+	var err error
+	m.Metrics.workflowEndpointMetrics.getPromotion.Time(func() {
+		response, err = m.PromotionManager.GetPromotion(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.workflowEndpointMetrics.getPromotion)
+	}
+	m.Metrics.workflowEndpointMetrics.getPromotion.Success()
+	return response, nil
+}
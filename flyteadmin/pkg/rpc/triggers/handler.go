@@ -0,0 +1,224 @@
+package triggers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// PathPrefix is the path under which the webhook and CloudEvents endpoints are served.
+const PathPrefix = "/triggers/v1/"
+
+const cloudEventsPath = PathPrefix + "cloudevents"
+
+// EventIDHeader identifies the event a webhook request delivers. Requests without it are identified by the digest of
+// their body, such that the retries of a request launch a single execution.
+const EventIDHeader = "X-Flyte-Event-Id"
+
+const bearerPrefix = "Bearer "
+
+const maxBodyBytes = 1 << 20
+
+const cloudEventsContentType = "application/cloudevents+json"
+
+type handlerMetrics struct {
+	Scope          promutils.Scope
+	WebhookEvents  prometheus.Counter
+	CloudEvents    prometheus.Counter
+	InvalidEvents  prometheus.Counter
+	Unauthorized   prometheus.Counter
+	HandlingErrors prometheus.Counter
+}
+
+// Handler serves the endpoints which fire the webhook and CloudEvent triggers of launch plans.
+type Handler struct {
+	triggerManager         interfaces.TriggerInterface
+	cloudEventsTokenSHA256 string
+	metrics                handlerMetrics
+}
+
+func getBearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(authorization, bearerPrefix)
+}
+
+func readPayload(w http.ResponseWriter, r *http.Request) ([]byte, map[string]interface{}, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		return nil, nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument, "failed to read request body: %v", err)
+	}
+	payload := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"request body must be a json object: %v", err)
+		}
+	}
+	return body, payload, nil
+}
+
+func (h *Handler) writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	code := codes.Internal
+	if adminErr, ok := err.(errors.FlyteAdminError); ok {
+		code = adminErr.Code()
+	}
+	switch code {
+	case codes.InvalidArgument:
+		h.metrics.InvalidEvents.Inc()
+	case codes.NotFound, codes.Unauthenticated:
+		h.metrics.Unauthorized.Inc()
+	default:
+		h.metrics.HandlingErrors.Inc()
+		logger.Errorf(ctx, "Failed to handle trigger event with err: %v", err)
+	}
+	http.Error(w, err.Error(), runtime.HTTPStatusFromCode(code))
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, response proto.Message) {
+	body, err := protojson.Marshal(response)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to marshal trigger firings with err: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		logger.Warningf(ctx, "Failed to write trigger firings with err: %v", err)
+	}
+}
+
+// Handles the events posted to /triggers/v1/{project}/{domain}/{launch_plan}/{trigger}.
+func (h *Handler) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h.metrics.WebhookEvents.Inc()
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, PathPrefix), "/")
+	if len(parts) != 4 {
+		http.NotFound(w, r)
+		return
+	}
+	token := getBearerToken(r)
+	if token == "" {
+		h.writeError(ctx, w, errors.NewFlyteAdminError(codes.Unauthenticated, "missing bearer token"))
+		return
+	}
+	body, payload, err := readPayload(w, r)
+	if err != nil {
+		h.writeError(ctx, w, err)
+		return
+	}
+	eventID := r.Header.Get(EventIDHeader)
+	if eventID == "" {
+		digest := sha256.Sum256(body)
+		eventID = hex.EncodeToString(digest[:])
+	}
+
+	firing, err := h.triggerManager.HandleWebhookEvent(ctx, interfaces.WebhookEvent{
+		LaunchPlan: &admin.NamedEntityIdentifier{
+			Project: parts[0],
+			Domain:  parts[1],
+			Name:    parts[2],
+		},
+		TriggerName: parts[3],
+		Token:       token,
+		ID:          eventID,
+		Payload:     payload,
+	})
+	if err != nil {
+		h.writeError(ctx, w, err)
+		return
+	}
+	writeResponse(ctx, w, firing)
+}
+
+// Handles the CloudEvents posted to /triggers/v1/cloudevents in their structured json representation.
+func (h *Handler) handleCloudEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	h.metrics.CloudEvents.Inc()
+	if h.cloudEventsTokenSHA256 == "" {
+		h.writeError(ctx, w, errors.NewFlyteAdminError(codes.Unauthenticated,
+			"cloud events are not accepted, since no token is configured for them"))
+		return
+	}
+	tokenDigest := common.HashTriggerToken(getBearerToken(r))
+	if subtle.ConstantTimeCompare([]byte(tokenDigest), []byte(h.cloudEventsTokenSHA256)) != 1 {
+		h.writeError(ctx, w, errors.NewFlyteAdminError(codes.Unauthenticated, "invalid bearer token"))
+		return
+	}
+	if contentType := r.Header.Get("Content-Type"); contentType != "" &&
+		!strings.HasPrefix(contentType, cloudEventsContentType) && !strings.HasPrefix(contentType, "application/json") {
+		h.writeError(ctx, w, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"cloud events must be posted in the structured content mode as %s", cloudEventsContentType))
+		return
+	}
+	_, payload, err := readPayload(w, r)
+	if err != nil {
+		h.writeError(ctx, w, err)
+		return
+	}
+	id, _ := payload["id"].(string)
+	source, _ := payload["source"].(string)
+	eventType, _ := payload["type"].(string)
+
+	firings, err := h.triggerManager.HandleCloudEvent(ctx, interfaces.CloudEvent{
+		ID:      id,
+		Source:  source,
+		Type:    eventType,
+		Payload: payload,
+	})
+	if err != nil {
+		h.writeError(ctx, w, err)
+		return
+	}
+	writeResponse(ctx, w, &admin.TriggerFiringList{Firings: firings})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path == cloudEventsPath {
+		h.handleCloudEvent(w, r)
+		return
+	}
+	h.handleWebhook(w, r)
+}
+
+func NewHandler(config runtimeInterfaces.TriggersConfig, triggerManager interfaces.TriggerInterface,
+	scope promutils.Scope) http.Handler {
+	return &Handler{
+		triggerManager:         triggerManager,
+		cloudEventsTokenSHA256: config.CloudEventsTokenSHA256,
+		metrics: handlerMetrics{
+			Scope:          scope,
+			WebhookEvents:  scope.MustNewCounter("webhook_events", "count of events posted to trigger webhooks"),
+			CloudEvents:    scope.MustNewCounter("cloud_events", "count of cloud events posted to triggers"),
+			InvalidEvents:  scope.MustNewCounter("invalid_events", "count of malformed events posted to triggers"),
+			Unauthorized:   scope.MustNewCounter("unauthorized", "count of events posted without a valid token"),
+			HandlingErrors: scope.MustNewCounter("handling_errors", "count of events which failed to be handled"),
+		},
+	}
+}
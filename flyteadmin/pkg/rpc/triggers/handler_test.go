@@ -0,0 +1,118 @@
+package triggers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func TestHandler_Webhook(t *testing.T) {
+	triggerManager := &mocks.TriggerInterface{}
+	triggerManager.EXPECT().HandleWebhookEvent(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, event interfaces.WebhookEvent) (*admin.TriggerFiring, error) {
+			assert.Equal(t, "project", event.LaunchPlan.GetProject())
+			assert.Equal(t, "domain", event.LaunchPlan.GetDomain())
+			assert.Equal(t, "name", event.LaunchPlan.GetName())
+			assert.Equal(t, "webhook", event.TriggerName)
+			assert.Equal(t, "event", event.ID)
+			if event.Token != "token" {
+				return nil, errors.NewFlyteAdminError(codes.NotFound, "no such trigger")
+			}
+			assert.Equal(t, map[string]interface{}{"key": "value"}, event.Payload)
+			return &admin.TriggerFiring{TriggerName: event.TriggerName, EventId: event.ID}, nil
+		})
+	handler := NewHandler(runtimeInterfaces.TriggersConfig{}, triggerManager, promutils.NewTestScope())
+
+	request := httptest.NewRequest(http.MethodPost, "/triggers/v1/project/domain/name/webhook",
+		strings.NewReader(`{"key":"value"}`))
+	request.Header.Set("Authorization", "Bearer token")
+	request.Header.Set(EventIDHeader, "event")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"eventId":"event"`)
+
+	request = httptest.NewRequest(http.MethodPost, "/triggers/v1/project/domain/name/webhook",
+		strings.NewReader(`{"key":"value"}`))
+	request.Header.Set("Authorization", "Bearer wrong")
+	request.Header.Set(EventIDHeader, "event")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	request = httptest.NewRequest(http.MethodPost, "/triggers/v1/project/domain/name/webhook", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	request = httptest.NewRequest(http.MethodPost, "/triggers/v1/project/domain/name/webhook",
+		strings.NewReader(`[1, 2]`))
+	request.Header.Set("Authorization", "Bearer token")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	request = httptest.NewRequest(http.MethodGet, "/triggers/v1/project/domain/name/webhook", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestHandler_CloudEvent(t *testing.T) {
+	triggerManager := &mocks.TriggerInterface{}
+	triggerManager.EXPECT().HandleCloudEvent(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, event interfaces.CloudEvent) ([]*admin.TriggerFiring, error) {
+			assert.Equal(t, "event", event.ID)
+			assert.Equal(t, "example", event.Source)
+			assert.Equal(t, "com.example.object.created", event.Type)
+			assert.Equal(t, map[string]interface{}{"path": "key"}, event.Payload["data"])
+			return []*admin.TriggerFiring{{EventId: "example/event"}}, nil
+		})
+	handler := NewHandler(runtimeInterfaces.TriggersConfig{
+		CloudEventsTokenSHA256: common.HashTriggerToken("token"),
+	}, triggerManager, promutils.NewTestScope())
+	body := `{"specversion":"1.0","id":"event","source":"example","type":"com.example.object.created","data":{"path":"key"}}`
+
+	request := httptest.NewRequest(http.MethodPost, "/triggers/v1/cloudevents", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/cloudevents+json; charset=UTF-8")
+	request.Header.Set("Authorization", "Bearer token")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"eventId":"example/event"`)
+
+	request = httptest.NewRequest(http.MethodPost, "/triggers/v1/cloudevents", strings.NewReader(body))
+	request.Header.Set("Authorization", "Bearer wrong")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	request = httptest.NewRequest(http.MethodPost, "/triggers/v1/cloudevents", strings.NewReader(body))
+	request.Header.Set("Content-Type", "text/plain")
+	request.Header.Set("Authorization", "Bearer token")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	t.Run("no token configured", func(t *testing.T) {
+		handler := NewHandler(runtimeInterfaces.TriggersConfig{}, triggerManager, promutils.NewTestScope())
+		request := httptest.NewRequest(http.MethodPost, "/triggers/v1/cloudevents", strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"time"
+
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/config"
@@ -15,6 +17,7 @@ const notifications = "notifications"
 const domains = "domains"
 const externalEvents = "externalEvents"
 const cloudEvents = "cloudEvents"
+const triggers = "triggers"
 const metricPort = 10254
 
 const KB = 1024
@@ -34,6 +37,15 @@ var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.Applic
 	K8SServiceAccount:           "",
 	UseOffloadedWorkflowClosure: false,
 	ConsoleURL:                  "",
+	ExecutionWatch: interfaces.ExecutionWatchConfig{
+		PollInterval: config.Duration{Duration: 2 * time.Second},
+		Retention:    config.Duration{Duration: 24 * time.Hour},
+		BufferSize:   100,
+	},
+	ExecutionRetention: interfaces.ExecutionRetentionConfig{
+		Interval:  config.Duration{Duration: time.Hour},
+		BatchSize: 100,
+	},
 })
 
 var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.SchedulerConfig{
@@ -85,6 +97,10 @@ var cloudEventsConfig = config.MustRegisterSection(cloudEvents, &interfaces.Clou
 	Type: common.Local,
 })
 
+var triggersConfig = config.MustRegisterSection(triggers, &interfaces.TriggersConfig{
+	Type: common.Local,
+})
+
 // Implementation of an interfaces.ApplicationConfiguration
 type ApplicationConfigurationProvider struct{}
 
@@ -120,6 +136,10 @@ func (p *ApplicationConfigurationProvider) GetCloudEventsConfig() *interfaces.Cl
 	return cloudEventsConfig.GetConfig().(*interfaces.CloudEventsConfig)
 }
 
+func (p *ApplicationConfigurationProvider) GetTriggersConfig() *interfaces.TriggersConfig {
+	return triggersConfig.GetConfig().(*interfaces.TriggersConfig)
+}
+
 func NewApplicationConfigurationProvider() interfaces.ApplicationConfiguration {
 	return &ApplicationConfigurationProvider{}
 }
@@ -5,9 +5,12 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/config/viper"
 )
@@ -91,3 +94,51 @@ func TestSqliteConfig(t *testing.T) {
 	dbConfig := configProvider.ApplicationConfiguration().GetDbConfig()
 	assert.Equal(t, "admin.db", dbConfig.SQLite.File)
 }
+
+func TestTaskResourceSpec_Validate(t *testing.T) {
+	assert.NoError(t, taskResourceConfig.GetConfig().(*TaskResourceSpec).Validate())
+
+	assert.Error(t, (&TaskResourceSpec{
+		Defaults: interfaces.TaskResourceSet{CPU: resource.MustParse("4")},
+		Limits:   interfaces.TaskResourceSet{CPU: resource.MustParse("2")},
+	}).Validate())
+	assert.Error(t, (&TaskResourceSpec{
+		Defaults: interfaces.TaskResourceSet{Memory: resource.MustParse("-1Mi")},
+	}).Validate())
+	// Defaults are unbounded when no limit is set.
+	assert.NoError(t, (&TaskResourceSpec{
+		Defaults: interfaces.TaskResourceSet{GPU: resource.MustParse("1")},
+	}).Validate())
+}
+
+func TestQualityOfServiceConfig_Validate(t *testing.T) {
+	cfg := &interfaces.QualityOfServiceConfig{
+		TierExecutionValues: map[interfaces.TierName]interfaces.QualityOfServiceSpec{
+			"low": {QueueingBudget: config.Duration{Duration: time.Minute}},
+		},
+		DefaultTiers: map[interfaces.DomainName]interfaces.TierName{"development": "LOW"},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.DefaultTiers["staging"] = "fast"
+	assert.Error(t, cfg.Validate())
+}
+
+func TestExecutionRetentionConfig_Validate(t *testing.T) {
+	assert.NoError(t, flyteAdminConfig.GetConfig().(*interfaces.ApplicationConfig).Validate())
+
+	cfg := &interfaces.ExecutionRetentionConfig{
+		Interval: config.Duration{Duration: time.Hour},
+	}
+	// Settings of disabled archival are left unchecked.
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Enabled = true
+	assert.ErrorContains(t, cfg.Validate(), "batch size [0] must be positive")
+
+	cfg.BatchSize = 100
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Interval.Duration = 0
+	assert.ErrorContains(t, cfg.Validate(), "interval [0s] must be positive")
+}
@@ -55,6 +55,59 @@ type PostgresConfig struct {
 	Debug        bool   `json:"debug" pflag:" Whether or not to start the database connection with debug mode enabled."`
 }
 
+// ExecutionWatchConfig configures the streaming of the phase changes of executions to their watchers.
+type ExecutionWatchConfig struct {
+	// Whether the phase changes of the watched executions are recorded and streamed to their watchers.
+	Enabled bool `json:"enabled"`
+	// Interval at which the phase changes recorded by the other replicas are fetched. With postgres, they are instead
+	// fetched as soon as they are notified, and only polled for while listening for the notifications fails.
+	PollInterval config.Duration `json:"pollInterval"`
+	// Duration for which the phase changes are kept, and for which an execution is still watched after its watchers
+	// disconnected, and so for which watchers can resume after a reconnect.
+	Retention config.Duration `json:"retention"`
+	// Number of phase changes buffered for each watcher. Watchers falling further behind catch up from the database.
+	BufferSize int `json:"bufferSize"`
+}
+
+// ExecutionRetentionPolicy is the duration for which the terminal executions of a project and domain are kept in the
+// database.
+type ExecutionRetentionPolicy struct {
+	// Project and domain the policy applies to. Either can be left empty for the policy to apply to every project or
+	// domain. Policies naming a project take precedence over policies only naming a domain.
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	// Duration since their last update after which terminal executions are archived. Zero keeps them indefinitely.
+	RetentionPeriod config.Duration `json:"retentionPeriod"`
+}
+
+// ExecutionRetentionConfig configures the archival of old terminal executions out of the database.
+type ExecutionRetentionConfig struct {
+	// Whether to archive terminal executions past their retention period.
+	Enabled bool `json:"enabled"`
+	// Interval at which executions past their retention period are archived.
+	Interval config.Duration `json:"interval"`
+	// Number of executions archived, and of rows deleted, at once. Smaller batches hold locks for shorter.
+	BatchSize int `json:"batchSize"`
+	// Retention period of the executions of the projects and domains no policy applies to. Zero keeps them
+	// indefinitely.
+	DefaultRetentionPeriod config.Duration            `json:"defaultRetentionPeriod"`
+	Policies               []ExecutionRetentionPolicy `json:"policies"`
+}
+
+// Validate checks that enabled archival runs at a positive interval, in batches of at least one execution.
+func (c *ExecutionRetentionConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval.Duration <= 0 {
+		return fmt.Errorf("execution retention interval [%v] must be positive", c.Interval.Duration)
+	}
+	if c.BatchSize <= 0 {
+		return fmt.Errorf("execution retention batch size [%v] must be positive", c.BatchSize)
+	}
+	return nil
+}
+
 type FeatureGates struct {
 	EnableArtifacts bool `json:"enableArtifacts" pflag:",Enable artifacts feature."`
 }
@@ -120,6 +173,15 @@ type ApplicationConfig struct {
 	InjectIdentityAnnotations bool     `json:"injectIdentityAnnotations"`
 	IdentityAnnotationPrefix  string   `json:"identityAnnotationPrefix"`
 	IdentityAnnotationKeys    []string `json:"identityAnnotationKeys"`
+
+	ExecutionWatch ExecutionWatchConfig `json:"executionWatch"`
+
+	ExecutionRetention ExecutionRetentionConfig `json:"executionRetention"`
+}
+
+// Validate rejects application configs whose execution retention settings would stall the archival of executions.
+func (a *ApplicationConfig) Validate() error {
+	return a.ExecutionRetention.Validate()
 }
 
 func (a *ApplicationConfig) GetRoleNameKey() string {
@@ -714,6 +776,37 @@ type NotificationsConfig struct {
 	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
 }
 
+// This section handles the consumption of the storage events which fire the storage event triggers of launch plans.
+type StorageEventsProcessorConfig struct {
+	// The name of the queue onto which the data store publishes its object creation events, e.g. the SQS queue
+	// S3 bucket notifications are sent to, or the Pub/Sub subscription of GCS bucket notifications. GCS notifications
+	// must use the JSON_API_V1 payload format and be restricted to the OBJECT_FINALIZE event type.
+	QueueName string `json:"queueName"`
+	// The account id (according to whichever cloud provider scheme is used) that has permission to read from the above
+	// queue.
+	AccountID string `json:"accountId"`
+}
+
+// Configuration specific to the triggers of launch plans.
+type TriggersConfig struct {
+	// Defines the cloud provider that publishes storage events. In the absence of a specification the no-op, 'local'
+	// scheme is used and storage event triggers never fire.
+	Type                         string                       `json:"type"`
+	AWSConfig                    AWSConfig                    `json:"aws"`
+	GCPConfig                    GCPConfig                    `json:"gcp"`
+	StorageEventsProcessorConfig StorageEventsProcessorConfig `json:"storageEvents"`
+	// Whether to serve the webhook and CloudEvents endpoints which fire webhook and CloudEvent triggers. Disabled by
+	// default.
+	EnableHTTPEndpoints bool `json:"enableHttpEndpoints"`
+	// Hex encoded SHA-256 digest of the bearer token CloudEvents must be posted with. When unset, CloudEvents are
+	// rejected.
+	CloudEventsTokenSHA256 string `json:"cloudEventsTokenSha256"`
+	// Number of times to attempt recreating the storage events processor client should there be any disruptions.
+	ReconnectAttempts int `json:"reconnectAttempts"`
+	// Specifies the time interval to wait before attempting to reconnect the storage events processor client.
+	ReconnectDelaySeconds int `json:"reconnectDelaySeconds"`
+}
+
 // Domains are always globally set in the application config, whereas individual projects can be individually registered.
 type Domain struct {
 	// Unique identifier for a domain.
@@ -734,4 +827,5 @@ type ApplicationConfiguration interface {
 	GetDomainsConfig() *DomainsConfig
 	GetExternalEventsConfig() *ExternalEventsConfig
 	GetCloudEventsConfig() *CloudEventsConfig
+	GetTriggersConfig() *TriggersConfig
 }
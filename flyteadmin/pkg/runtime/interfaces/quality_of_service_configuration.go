@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 )
@@ -19,6 +22,30 @@ type QualityOfServiceConfig struct {
 	DefaultTiers        map[DomainName]TierName           `json:"defaultTiers"`
 }
 
+// Validate checks that tiers are referenced by their names, regardless of case since config keys may be lowercased, and
+// that queueing budgets aren't negative. Reloads with
+// invalid values are rejected, and the quality of service currently applied is kept.
+func (c *QualityOfServiceConfig) Validate() error {
+	for tierName, spec := range c.TierExecutionValues {
+		if _, ok := core.QualityOfService_Tier_value[strings.ToUpper(tierName)]; !ok {
+			return fmt.Errorf("unknown quality of service tier [%v]", tierName)
+		}
+
+		if spec.QueueingBudget.Duration < 0 {
+			return fmt.Errorf("queueing budget [%v] of tier [%v] must not be negative", spec.QueueingBudget.Duration,
+				tierName)
+		}
+	}
+
+	for domain, tierName := range c.DefaultTiers {
+		if _, ok := core.QualityOfService_Tier_value[strings.ToUpper(tierName)]; !ok {
+			return fmt.Errorf("unknown quality of service tier [%v] for domain [%v]", tierName, domain)
+		}
+	}
+
+	return nil
+}
+
 type QualityOfServiceConfiguration interface {
 	GetTierExecutionValues() map[core.QualityOfService_Tier]*core.QualityOfServiceSpec
 	GetDefaultTiers() map[DomainName]core.QualityOfService_Tier
@@ -14,6 +14,7 @@ type MockApplicationProvider struct {
 	domainsConfig        interfaces.DomainsConfig
 	externalEventsConfig interfaces.ExternalEventsConfig
 	cloudEventConfig     interfaces.CloudEventsConfig
+	triggersConfig       interfaces.TriggersConfig
 }
 
 func (p *MockApplicationProvider) GetDbConfig() *database.DbConfig {
@@ -79,3 +80,11 @@ func (p *MockApplicationProvider) SetCloudEventsConfig(cloudEventConfig interfac
 func (p *MockApplicationProvider) GetCloudEventsConfig() *interfaces.CloudEventsConfig {
 	return &p.cloudEventConfig
 }
+
+func (p *MockApplicationProvider) SetTriggersConfig(triggersConfig interfaces.TriggersConfig) {
+	p.triggersConfig = triggersConfig
+}
+
+func (p *MockApplicationProvider) GetTriggersConfig() *interfaces.TriggersConfig {
+	return &p.triggersConfig
+}
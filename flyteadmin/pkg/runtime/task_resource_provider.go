@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
@@ -26,6 +28,29 @@ type TaskResourceSpec struct {
 	Limits   interfaces.TaskResourceSet `json:"limits"`
 }
 
+// Validate checks that no resource is negative and that defaults don't exceed the limits set. Reloads with invalid
+// values are rejected, and the task resources currently applied are kept.
+func (s *TaskResourceSpec) Validate() error {
+	for name, values := range map[string][2]resource.Quantity{
+		"cpu":              {s.Defaults.CPU, s.Limits.CPU},
+		"gpu":              {s.Defaults.GPU, s.Limits.GPU},
+		"memory":           {s.Defaults.Memory, s.Limits.Memory},
+		"ephemeralStorage": {s.Defaults.EphemeralStorage, s.Limits.EphemeralStorage},
+	} {
+		defaultValue, limit := values[0], values[1]
+		if defaultValue.Sign() < 0 || limit.Sign() < 0 {
+			return fmt.Errorf("%v default [%v] and limit [%v] must not be negative", name, defaultValue.String(),
+				limit.String())
+		}
+
+		if !limit.IsZero() && defaultValue.Cmp(limit) > 0 {
+			return fmt.Errorf("%v default [%v] exceeds the limit [%v]", name, defaultValue.String(), limit.String())
+		}
+	}
+
+	return nil
+}
+
 // Implementation of an interfaces.TaskResourceConfiguration
 type TaskResourceProvider struct{}
 
@@ -22,11 +22,13 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"k8s.io/apimachinery/pkg/util/rand"
 
@@ -40,6 +42,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc/adminservice"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc/adminservice/middleware"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/rpc/triggers"
 	runtime2 "github.com/flyteorg/flyte/flyteadmin/pkg/runtime"
 	runtimeIfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/plugins"
@@ -91,6 +94,8 @@ func newGRPCServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 	logger.Infof(ctx, "Registering default middleware with blanket auth validation")
 	pluginRegistry.RegisterDefault(plugins.PluginIDUnaryServiceMiddleware, grpcmiddleware.ChainUnaryServer(
 		RequestIDInterceptor, auth.BlanketAuthorization, auth.ExecutionUserIdentifierInterceptor))
+	pluginRegistry.RegisterDefault(plugins.PluginIDStreamServiceMiddleware, grpcmiddleware.ChainStreamServer(
+		RequestIDStreamInterceptor, auth.BlanketAuthorizationStream, auth.ExecutionUserIdentifierStreamInterceptor))
 
 	if cfg.GrpcConfig.EnableGrpcLatencyMetrics {
 		logger.Debugf(ctx, "enabling grpc histogram metrics")
@@ -131,11 +136,24 @@ func newGRPCServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 		)
 	}
 
-	chainedStreamInterceptors := grpcmiddleware.ChainStreamServer(
-		// recovery interceptor should always be first in order to handle any panics in the middleware or server
-		recoveryInterceptor.StreamServerInterceptor(),
-		grpcprometheus.StreamServerInterceptor,
-	)
+	var chainedStreamInterceptors grpc.StreamServerInterceptor
+	if cfg.Security.UseAuth {
+		chainedStreamInterceptors = grpcmiddleware.ChainStreamServer(
+			// recovery interceptor should always be first in order to handle any panics in the middleware or server
+			recoveryInterceptor.StreamServerInterceptor(),
+			grpcprometheus.StreamServerInterceptor,
+			auth.GetAuthenticationCustomMetadataStreamInterceptor(authCtx),
+			grpcauth.StreamServerInterceptor(auth.GetAuthenticationInterceptor(authCtx)),
+			auth.AuthenticationLoggingStreamInterceptor,
+			getStreamServiceMiddleware(ctx, pluginRegistry),
+		)
+	} else {
+		chainedStreamInterceptors = grpcmiddleware.ChainStreamServer(
+			// recovery interceptor should always be first in order to handle any panics in the middleware or server
+			recoveryInterceptor.StreamServerInterceptor(),
+			grpcprometheus.StreamServerInterceptor,
+		)
+	}
 
 	serverOpts := []grpc.ServerOption{
 		grpc.StreamInterceptor(chainedStreamInterceptors),
@@ -164,10 +182,14 @@ func newGRPCServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 		grpcService.RegisterIdentityServiceServer(grpcServer, authCtx.IdentityService())
 	}
 
-	dataProxySvc, err := dataproxy.NewService(cfg.DataProxy, adminServer.NodeExecutionManager, dataStorageClient, adminServer.TaskExecutionManager)
+	dataProxySvc, err := dataproxy.NewService(cfg.DataProxy, adminServer.NodeExecutionManager, dataStorageClient, adminServer.TaskExecutionManager, sm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize dataProxy service. Error: %w", err)
 	}
+	if cfg.DataProxy.Proxy.Enabled {
+		pluginRegistry.RegisterDefault(plugins.PluginIDDataProxyStreamHandler,
+			dataproxy.NewStreamHandler(dataProxySvc, scope.NewSubScope("dataproxy_stream")))
+	}
 
 	pluginRegistry.RegisterDefault(plugins.PluginIDDataProxy, dataProxySvc)
 	grpcService.RegisterDataProxyServiceServer(grpcServer, plugins.Get[grpcService.DataProxyServiceServer](pluginRegistry, plugins.PluginIDDataProxy))
@@ -221,6 +243,18 @@ func newHTTPServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 	// Register healthcheck
 	mux.HandleFunc("/healthcheck", healthCheckFunc)
 
+	// Serves the endpoints which fire the webhook and CloudEvent triggers of launch plans. Requests to these endpoints
+	// authenticate with the tokens of the triggers instead of the credentials of users.
+	if triggerHandler := plugins.Get[http.Handler](pluginRegistry, plugins.PluginIDTriggerHandler); triggerHandler != nil {
+		mux.Handle(triggers.PathPrefix, triggerHandler)
+	}
+
+	// Serves the endpoints the data proxy streams uploads and downloads through. Requests to these endpoints
+	// authenticate with the signatures of their urls instead of the credentials of users.
+	if streamHandler := plugins.Get[http.Handler](pluginRegistry, plugins.PluginIDDataProxyStreamHandler); streamHandler != nil {
+		mux.Handle(dataproxy.StreamPathPrefix, streamHandler)
+	}
+
 	// Register OpenAPI endpoint
 	// This endpoint will serve the OpenAPI2 spec generated by the swagger protoc plugin, and bundled by go-bindata
 	mux.HandleFunc("/api/v1/openapi", GetHandleOpenapiSpec(ctx))
@@ -304,6 +338,28 @@ func RequestIDInterceptor(ctx context.Context, req interface{}, _ *grpc.UnarySer
 	return handler(GetOrGenerateRequestIDForGRPC(ctx), req)
 }
 
+// RequestIDStreamInterceptor is the streaming counterpart of RequestIDInterceptor.
+func RequestIDStreamInterceptor(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	wrapped := grpcmiddleware.WrapServerStream(stream)
+	wrapped.WrappedContext = GetOrGenerateRequestIDForGRPC(stream.Context())
+	return handler(srv, wrapped)
+}
+
+// getStreamServiceMiddleware returns the stream middleware plugin. A custom unary middleware, e.g. authorizing the
+// requests, doesn't apply to the streaming methods, so they are denied unless a stream middleware is registered too.
+func getStreamServiceMiddleware(ctx context.Context, pluginRegistry *plugins.Registry) grpc.StreamServerInterceptor {
+	if pluginRegistry.IsRegistered(plugins.PluginIDUnaryServiceMiddleware) &&
+		!pluginRegistry.IsRegistered(plugins.PluginIDStreamServiceMiddleware) {
+		logger.Warnf(ctx, "No stream middleware is registered along with the unary middleware, denying streaming methods")
+		return denyStreamInterceptor
+	}
+	return plugins.Get[grpc.StreamServerInterceptor](pluginRegistry, plugins.PluginIDStreamServiceMiddleware)
+}
+
+func denyStreamInterceptor(_ interface{}, _ grpc.ServerStream, info *grpc.StreamServerInfo, _ grpc.StreamHandler) error {
+	return status.Errorf(codes.PermissionDenied, "streaming method [%s] is not supported by the service middleware", info.FullMethod)
+}
+
 // GetOrGenerateRequestIDForGRPC returns a context with request id set from the context or from grpc metadata if it exists,
 // otherwise it generates a new one.
 func GetOrGenerateRequestIDForGRPC(ctx context.Context) context.Context {
@@ -2,16 +2,20 @@ package impl
 
 import (
 	"context"
+	"encoding/json"
 
 	"google.golang.org/grpc/codes"
 	k8_api_err "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster"
 	execClusterInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
@@ -104,6 +108,44 @@ func (e K8sWorkflowExecutor) Abort(ctx context.Context, data interfaces.AbortDat
 	return nil
 }
 
+func (e K8sWorkflowExecutor) Pause(ctx context.Context, data interfaces.PauseData) error {
+	return e.patchPause(ctx, data.Namespace, data.ExecutionID, data.Cluster,
+		&v1alpha1.PauseConfig{AbortRunningNodes: data.AbortRunningNodes})
+}
+
+func (e K8sWorkflowExecutor) Resume(ctx context.Context, data interfaces.ResumeData) error {
+	return e.patchPause(ctx, data.Namespace, data.ExecutionID, data.Cluster, nil)
+}
+
+// patchPause sets the pause config of a Flyte workflow execution CRD object, which flytepropeller picks up on its next
+// round. A nil pause config resumes the execution.
+func (e K8sWorkflowExecutor) patchPause(ctx context.Context, namespace string, executionID *core.WorkflowExecutionIdentifier,
+	cluster string, pause *v1alpha1.PauseConfig) error {
+	target, err := e.executionCluster.GetTarget(ctx, &executioncluster.ExecutionTargetSpec{
+		TargetID: cluster,
+	})
+	if err != nil {
+		return errors.NewFlyteAdminError(codes.Internal, err.Error())
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"executionConfig": map[string]interface{}{
+			"Pause": pause,
+		},
+	})
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to marshal pause patch: %v", err)
+	}
+	_, err = target.FlyteClient.FlyteworkflowV1alpha1().FlyteWorkflows(namespace).Patch(ctx, executionID.GetName(),
+		types.MergePatchType, patch, v1.PatchOptions{})
+	if k8_api_err.IsNotFound(err) {
+		return errors.NewFlyteAdminErrorf(codes.FailedPrecondition, "execution %v is no longer running", executionID)
+	}
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.Internal, "failed to update pause of execution: %v with err %v", executionID, err)
+	}
+	return nil
+}
+
 func NewK8sWorkflowExecutor(config runtimeInterfaces.Configuration, executionCluster execClusterInterfaces.ClusterInterface, workflowBuilder interfaces.FlyteWorkflowBuilder, client *storage.DataStore) *K8sWorkflowExecutor {
 
 	return &K8sWorkflowExecutor{
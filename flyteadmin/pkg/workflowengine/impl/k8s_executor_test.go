@@ -9,10 +9,13 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 	k8_api_err "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
+	flyteAdminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster"
 	execClusterIfaces "github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster/interfaces"
 	clusterMock "github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster/mocks"
@@ -32,10 +35,12 @@ var fakeFlyteWF = FakeFlyteWorkflowV1alpha1{}
 
 type createCallback func(*v1alpha1.FlyteWorkflow, v1.CreateOptions) (*v1alpha1.FlyteWorkflow, error)
 type deleteCallback func(name string, options *v1.DeleteOptions) error
+type patchCallback func(name string, pt types.PatchType, data []byte) (*v1alpha1.FlyteWorkflow, error)
 type FakeFlyteWorkflow struct {
 	v1alpha12.FlyteWorkflowInterface
 	createCallback createCallback
 	deleteCallback deleteCallback
+	patchCallback  patchCallback
 }
 
 func (b *FakeFlyteWorkflow) Create(ctx context.Context, wf *v1alpha1.FlyteWorkflow, opts v1.CreateOptions) (*v1alpha1.FlyteWorkflow, error) {
@@ -52,6 +57,14 @@ func (b *FakeFlyteWorkflow) Delete(ctx context.Context, name string, options v1.
 	return nil
 }
 
+func (b *FakeFlyteWorkflow) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions,
+	subresources ...string) (*v1alpha1.FlyteWorkflow, error) {
+	if b.patchCallback != nil {
+		return b.patchCallback(name, pt, data)
+	}
+	return nil, nil
+}
+
 type flyteWorkflowsCallback func(string) v1alpha12.FlyteWorkflowInterface
 
 type FakeFlyteWorkflowV1alpha1 struct {
@@ -345,6 +358,75 @@ func TestAbort_MiscError(t *testing.T) {
 	assert.Equal(t, regex.ReplaceAllString(err.Error(), ""), regex.ReplaceAllString(expected, ""))
 }
 
+func TestPause(t *testing.T) {
+	fakeFlyteWorkflow := FakeFlyteWorkflow{}
+	fakeFlyteWorkflow.patchCallback = func(name string, pt types.PatchType, data []byte) (*v1alpha1.FlyteWorkflow, error) {
+		assert.Equal(t, execID.GetName(), name)
+		assert.Equal(t, types.MergePatchType, pt)
+		assert.JSONEq(t, `{"executionConfig": {"Pause": {"AbortRunningNodes": true}}}`, string(data))
+		return &v1alpha1.FlyteWorkflow{}, nil
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(ns string) v1alpha12.FlyteWorkflowInterface {
+		assert.Equal(t, namespace, ns)
+		return &fakeFlyteWorkflow
+	}
+	executor := K8sWorkflowExecutor{
+		executionCluster: getFakeExecutionCluster(),
+	}
+	err := executor.Pause(context.TODO(), interfaces.PauseData{
+		Namespace:         namespace,
+		ExecutionID:       execID,
+		Cluster:           clusterID,
+		AbortRunningNodes: true,
+	})
+	assert.NoError(t, err)
+}
+
+func TestResume(t *testing.T) {
+	fakeFlyteWorkflow := FakeFlyteWorkflow{}
+	fakeFlyteWorkflow.patchCallback = func(name string, pt types.PatchType, data []byte) (*v1alpha1.FlyteWorkflow, error) {
+		assert.Equal(t, execID.GetName(), name)
+		assert.Equal(t, types.MergePatchType, pt)
+		assert.JSONEq(t, `{"executionConfig": {"Pause": null}}`, string(data))
+		return &v1alpha1.FlyteWorkflow{}, nil
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(ns string) v1alpha12.FlyteWorkflowInterface {
+		assert.Equal(t, namespace, ns)
+		return &fakeFlyteWorkflow
+	}
+	executor := K8sWorkflowExecutor{
+		executionCluster: getFakeExecutionCluster(),
+	}
+	err := executor.Resume(context.TODO(), interfaces.ResumeData{
+		Namespace:   namespace,
+		ExecutionID: execID,
+		Cluster:     clusterID,
+	})
+	assert.NoError(t, err)
+}
+
+func TestPause_NotFound(t *testing.T) {
+	fakeFlyteWorkflow := FakeFlyteWorkflow{}
+	fakeFlyteWorkflow.patchCallback = func(name string, pt types.PatchType, data []byte) (*v1alpha1.FlyteWorkflow, error) {
+		return nil, k8_api_err.NewNotFound(schema.GroupResource{
+			Group:    "foo",
+			Resource: "bar",
+		}, execID.GetName())
+	}
+	fakeFlyteWF.flyteWorkflowsCallback = func(ns string) v1alpha12.FlyteWorkflowInterface {
+		return &fakeFlyteWorkflow
+	}
+	executor := K8sWorkflowExecutor{
+		executionCluster: getFakeExecutionCluster(),
+	}
+	err := executor.Pause(context.TODO(), interfaces.PauseData{
+		Namespace:   namespace,
+		ExecutionID: execID,
+		Cluster:     clusterID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
 func TestExecute_OffloadWorkflowClosure(t *testing.T) {
 	offloadedFlyteWf := &v1alpha1.FlyteWorkflow{
 		ExecutionID: v1alpha1.ExecutionID{
@@ -149,6 +149,16 @@ func PrepareFlyteWorkflow(data interfaces.ExecutionData, flyteWorkflow *v1alpha1
 	flyteWorkflow.WorkflowMeta.EventVersion = v1alpha1.EventVersion(data.ExecutionParameters.EventVersion)
 	addExecutionOverrides(data.ExecutionParameters.TaskPluginOverrides, data.ExecutionParameters.ExecutionConfig,
 		data.ExecutionParameters.RecoveryExecution, data.ExecutionParameters.TaskResources, flyteWorkflow)
+	if data.ExecutionParameters.RecoveryExecution != nil && data.ExecutionParameters.RerunFrom != nil {
+		flyteWorkflow.ExecutionConfig.RerunFrom = &v1alpha1.RerunConfig{
+			NodeID: data.ExecutionParameters.RerunFrom.GetNodeId(),
+		}
+		if data.ExecutionParameters.RerunFrom.GetInputs() != nil {
+			flyteWorkflow.ExecutionConfig.RerunFrom.Inputs = &v1alpha1.Inputs{
+				LiteralMap: data.ExecutionParameters.RerunFrom.GetInputs(),
+			}
+		}
+	}
 
 	if data.ExecutionParameters.RawOutputDataConfig != nil {
 		flyteWorkflow.RawOutputDataConfig = v1alpha1.RawOutputDataConfig{
@@ -11,6 +11,7 @@ import (
 
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
@@ -257,3 +258,43 @@ func TestPrepareFlyteWorkflow(t *testing.T) {
 	})
 	assert.Equal(t, flyteWorkflow.Finalizers, []string{controller.Finalizer})
 }
+
+func TestPrepareFlyteWorkflow_RerunFrom(t *testing.T) {
+	execID := core.WorkflowExecutionIdentifier{
+		Project: "p",
+		Domain:  "d",
+		Name:    "n",
+	}
+	rerunInputs := &core.LiteralMap{Literals: map[string]*core.Literal{
+		"x": coreutils.MustMakeLiteral(1),
+	}}
+	rerunFrom := &admin.RerunFromNode{NodeId: "n1", Inputs: rerunInputs}
+
+	var flyteWorkflow v1alpha1.FlyteWorkflow
+	err := PrepareFlyteWorkflow(interfaces.ExecutionData{
+		ExecutionID: &execID,
+		ExecutionParameters: interfaces.ExecutionParameters{
+			RecoveryExecution: &core.WorkflowExecutionIdentifier{
+				Project: "p",
+				Domain:  "d",
+				Name:    "original",
+			},
+			RerunFrom: rerunFrom,
+		},
+	}, &flyteWorkflow)
+	assert.NoError(t, err)
+	assert.Equal(t, "n1", flyteWorkflow.ExecutionConfig.RerunFrom.NodeID)
+	assert.True(t, proto.Equal(rerunInputs, flyteWorkflow.ExecutionConfig.RerunFrom.Inputs.LiteralMap))
+
+	t.Run("not recovering", func(t *testing.T) {
+		var flyteWorkflow v1alpha1.FlyteWorkflow
+		err := PrepareFlyteWorkflow(interfaces.ExecutionData{
+			ExecutionID: &execID,
+			ExecutionParameters: interfaces.ExecutionParameters{
+				RerunFrom: rerunFrom,
+			},
+		}, &flyteWorkflow)
+		assert.NoError(t, err)
+		assert.Nil(t, flyteWorkflow.ExecutionConfig.RerunFrom)
+	})
+}
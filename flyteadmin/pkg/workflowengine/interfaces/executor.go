@@ -25,6 +25,7 @@ type ExecutionParameters struct {
 	TaskPluginOverrides   []*admin.PluginOverride
 	ExecutionConfig       *admin.WorkflowExecutionConfig
 	RecoveryExecution     *core.WorkflowExecutionIdentifier
+	RerunFrom             *admin.RerunFromNode
 	TaskResources         *TaskResources
 	EventVersion          int
 	RoleNameKey           string
@@ -69,7 +70,29 @@ type AbortData struct {
 	Cluster string
 }
 
-// WorkflowExecutor is a client interface used to create and delete Flyte workflow CRD objects.
+// PauseData includes all parameters required to pause an execution CRD object.
+type PauseData struct {
+	// Execution namespace.
+	Namespace string
+	// Execution identifier.
+	ExecutionID *core.WorkflowExecutionIdentifier
+	// Cluster identifier where the execution was created
+	Cluster string
+	// Whether the running nodes are aborted, to be re-queued once the execution is resumed, rather than left to finish.
+	AbortRunningNodes bool
+}
+
+// ResumeData includes all parameters required to resume a paused execution CRD object.
+type ResumeData struct {
+	// Execution namespace.
+	Namespace string
+	// Execution identifier.
+	ExecutionID *core.WorkflowExecutionIdentifier
+	// Cluster identifier where the execution was created
+	Cluster string
+}
+
+// WorkflowExecutor is a client interface used to create, delete and pause Flyte workflow CRD objects.
 type WorkflowExecutor interface {
 	// ID returns the unique name of this executor implementation.
 	ID() string
@@ -77,4 +100,8 @@ type WorkflowExecutor interface {
 	Execute(ctx context.Context, data ExecutionData) (ExecutionResponse, error)
 	// Abort aborts a running Flyte workflow execution CRD object.
 	Abort(ctx context.Context, data AbortData) error
+	// Pause stops a running Flyte workflow execution CRD object from starting new nodes until it is resumed.
+	Pause(ctx context.Context, data PauseData) error
+	// Resume resumes a paused Flyte workflow execution CRD object.
+	Resume(ctx context.Context, data ResumeData) error
 }
@@ -171,6 +171,100 @@ func (_c *WorkflowExecutor_ID_Call) RunAndReturn(run func() string) *WorkflowExe
 	return _c
 }
 
+// Pause provides a mock function with given fields: ctx, data
+func (_m *WorkflowExecutor) Pause(ctx context.Context, data interfaces.PauseData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pause")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.PauseData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkflowExecutor_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type WorkflowExecutor_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data interfaces.PauseData
+func (_e *WorkflowExecutor_Expecter) Pause(ctx interface{}, data interface{}) *WorkflowExecutor_Pause_Call {
+	return &WorkflowExecutor_Pause_Call{Call: _e.mock.On("Pause", ctx, data)}
+}
+
+func (_c *WorkflowExecutor_Pause_Call) Run(run func(ctx context.Context, data interfaces.PauseData)) *WorkflowExecutor_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.PauseData))
+	})
+	return _c
+}
+
+func (_c *WorkflowExecutor_Pause_Call) Return(_a0 error) *WorkflowExecutor_Pause_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkflowExecutor_Pause_Call) RunAndReturn(run func(context.Context, interfaces.PauseData) error) *WorkflowExecutor_Pause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: ctx, data
+func (_m *WorkflowExecutor) Resume(ctx context.Context, data interfaces.ResumeData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.ResumeData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkflowExecutor_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type WorkflowExecutor_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data interfaces.ResumeData
+func (_e *WorkflowExecutor_Expecter) Resume(ctx interface{}, data interface{}) *WorkflowExecutor_Resume_Call {
+	return &WorkflowExecutor_Resume_Call{Call: _e.mock.On("Resume", ctx, data)}
+}
+
+func (_c *WorkflowExecutor_Resume_Call) Run(run func(ctx context.Context, data interfaces.ResumeData)) *WorkflowExecutor_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.ResumeData))
+	})
+	return _c
+}
+
+func (_c *WorkflowExecutor_Resume_Call) Return(_a0 error) *WorkflowExecutor_Resume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WorkflowExecutor_Resume_Call) RunAndReturn(run func(context.Context, interfaces.ResumeData) error) *WorkflowExecutor_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWorkflowExecutor creates a new instance of WorkflowExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWorkflowExecutor(t interface {
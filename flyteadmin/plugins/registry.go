@@ -9,13 +9,16 @@ import (
 type PluginID = string
 
 const (
-	PluginIDAdditionalGRPCService  PluginID = "AdditionalGRPCService"
-	PluginIDCustomerHeaderMatcher  PluginID = "CustomerHeaderMatcher"
-	PluginIDDataProxy              PluginID = "DataProxy"
-	PluginIDLogoutHook             PluginID = "LogoutHook"
-	PluginIDPreRedirectHook        PluginID = "PreRedirectHook"
-	PluginIDUnaryServiceMiddleware PluginID = "UnaryServiceMiddleware"
-	PluginIDWorkflowExecutor       PluginID = "WorkflowExecutor"
+	PluginIDAdditionalGRPCService   PluginID = "AdditionalGRPCService"
+	PluginIDCustomerHeaderMatcher   PluginID = "CustomerHeaderMatcher"
+	PluginIDDataProxy               PluginID = "DataProxy"
+	PluginIDDataProxyStreamHandler  PluginID = "DataProxyStreamHandler"
+	PluginIDLogoutHook              PluginID = "LogoutHook"
+	PluginIDPreRedirectHook         PluginID = "PreRedirectHook"
+	PluginIDStreamServiceMiddleware PluginID = "StreamServiceMiddleware"
+	PluginIDTriggerHandler          PluginID = "TriggerHandler"
+	PluginIDUnaryServiceMiddleware  PluginID = "UnaryServiceMiddleware"
+	PluginIDWorkflowExecutor        PluginID = "WorkflowExecutor"
 )
 
 type AtomicRegistry struct {
@@ -64,6 +67,12 @@ func (r *Registry) RegisterDefault(id PluginID, impl interface{}) {
 	r.mDefault.Store(id, impl)
 }
 
+// IsRegistered returns whether an implementation, other than the default one, is registered for the ID.
+func (r *Registry) IsRegistered(id PluginID) bool {
+	_, exists := r.m.Load(id)
+	return exists
+}
+
 // Get retrieves a registered implementation for the ID. If one doesn't exist, it returns the default implementation.
 // If the id isn't found, it returns nil.
 func Get[T any](r *Registry, id PluginID) T {
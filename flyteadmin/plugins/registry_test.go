@@ -23,6 +23,14 @@ func TestNewAtomicRegistry(t *testing.T) {
 	assert.Equal(t, 5, r.Get(PluginIDDataProxy))
 }
 
+func TestIsRegistered(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterDefault(PluginIDUnaryServiceMiddleware, 5)
+	assert.False(t, r.IsRegistered(PluginIDUnaryServiceMiddleware))
+	assert.NoError(t, r.Register(PluginIDUnaryServiceMiddleware, 6))
+	assert.True(t, r.IsRegistered(PluginIDUnaryServiceMiddleware))
+}
+
 type PreRedirectHookFunc func(ctx context.Context) error
 
 func TestRedirectHook(t *testing.T) {
@@ -0,0 +1,115 @@
+package containerwatcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+const (
+	// StartMarkerFile is created by the entrypoint wrapper of the main container before it runs the actual command.
+	StartMarkerFile = "_START"
+	// ExitMarkerFile is created by the entrypoint wrapper once the command exits. The first line holds the exit code,
+	// the optional second line the termination reason and the rest of the file a message, e.g.
+	//
+	//	"$@"; echo $? > /var/flyte/outputs/_EXIT.tmp && mv /var/flyte/outputs/_EXIT.tmp /var/flyte/outputs/_EXIT
+	ExitMarkerFile = "_EXIT"
+
+	defaultPollInterval = time.Second
+)
+
+// FileWatcher detects the start and exit of the main container through marker files in a shared volume. Unlike the
+// signal watcher, it does not rely on the kubelet or on a shared process namespace.
+type FileWatcher struct {
+	dir          string
+	pollInterval time.Duration
+
+	lock       sync.Mutex
+	exitStatus *ExitStatus
+}
+
+func (f *FileWatcher) markerExists(name string) (bool, error) {
+	if _, err := os.Stat(filepath.Join(f.dir, name)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to check for marker file [%s]", name)
+	}
+	return true, nil
+}
+
+func (f *FileWatcher) WaitToStart(ctx context.Context) error {
+	logger.Infof(ctx, "File Watcher waiting for start marker in [%s]", f.dir)
+	return pollUntil(ctx, f.pollInterval, func() (bool, error) {
+		started, err := f.markerExists(StartMarkerFile)
+		if err != nil || started {
+			return started, err
+		}
+		// A container that exited very quickly has started as well.
+		return f.markerExists(ExitMarkerFile)
+	})
+}
+
+func (f *FileWatcher) WaitToExit(ctx context.Context) error {
+	logger.Infof(ctx, "File Watcher waiting for exit marker in [%s]", f.dir)
+	if err := pollUntil(ctx, f.pollInterval, func() (bool, error) {
+		return f.markerExists(ExitMarkerFile)
+	}); err != nil {
+		return err
+	}
+
+	b, err := os.ReadFile(filepath.Join(f.dir, ExitMarkerFile))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read exit marker file")
+	}
+	status, err := parseExitMarker(string(b))
+	if err != nil {
+		return err
+	}
+	logger.Infof(ctx, "File Watcher found exit marker, exit code [%d]", status.ExitCode)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.exitStatus = &status
+	return nil
+}
+
+func (f *FileWatcher) ExitStatus() (ExitStatus, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.exitStatus == nil {
+		return ExitStatus{}, false
+	}
+	return *f.exitStatus, true
+}
+
+func parseExitMarker(content string) (ExitStatus, error) {
+	lines := strings.SplitN(strings.TrimSpace(content), "\n", 3)
+	code, err := strconv.ParseInt(strings.TrimSpace(lines[0]), 10, 32)
+	if err != nil {
+		return ExitStatus{}, errors.Wrapf(err, "exit marker file should start with the exit code, found [%s]", lines[0])
+	}
+	status := ExitStatus{ExitCode: int32(code)}
+	if len(lines) > 1 {
+		status.Reason = strings.TrimSpace(lines[1])
+	}
+	if len(lines) > 2 {
+		status.Message = strings.TrimSpace(lines[2])
+	}
+	return status, nil
+}
+
+func NewFileWatcher(dir string) *FileWatcher {
+	return &FileWatcher{
+		dir:          dir,
+		pollInterval: defaultPollInterval,
+	}
+}
@@ -0,0 +1,64 @@
+package containerwatcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWatcher(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("start-and-exit", func(t *testing.T) {
+		dir := t.TempDir()
+		w := NewFileWatcher(dir)
+		w.pollInterval = time.Millisecond
+
+		_, ok := w.ExitStatus()
+		assert.False(t, ok)
+
+		go func() {
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, StartMarkerFile), nil, os.ModePerm)) // #nosec G306
+		}()
+		assert.NoError(t, w.WaitToStart(ctx))
+
+		go func() {
+			assert.NoError(t, os.WriteFile(filepath.Join(dir, ExitMarkerFile), []byte("137\nOOMKilled\nout of memory\n"), os.ModePerm)) // #nosec G306
+		}()
+		assert.NoError(t, w.WaitToExit(ctx))
+		status, ok := w.ExitStatus()
+		assert.True(t, ok)
+		assert.Equal(t, ExitStatus{ExitCode: 137, Reason: "OOMKilled", Message: "out of memory"}, status)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		w := NewFileWatcher(t.TempDir())
+		w.pollInterval = time.Millisecond
+		childCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assert.Equal(t, ErrTimeout, w.WaitToExit(childCtx))
+	})
+
+	t.Run("bad-exit-marker", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, ExitMarkerFile), []byte("not a code"), os.ModePerm)) // #nosec G306
+		assert.Error(t, NewFileWatcher(dir).WaitToExit(ctx))
+	})
+}
+
+func TestParseExitMarker(t *testing.T) {
+	status, err := parseExitMarker("0")
+	assert.NoError(t, err)
+	assert.Equal(t, ExitStatus{}, status)
+
+	status, err = parseExitMarker(" 1 \nError\n")
+	assert.NoError(t, err)
+	assert.Equal(t, ExitStatus{ExitCode: 1, Reason: "Error"}, status)
+
+	_, err = parseExitMarker("")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package containerwatcher
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 var ErrTimeout = fmt.Errorf("timeout while waiting")
@@ -12,6 +13,37 @@ type Watcher interface {
 	WaitToExit(ctx context.Context) error
 }
 
+// ExitStatus describes how the watched container terminated.
+type ExitStatus struct {
+	ExitCode int32
+	// Short, machine readable reason for the termination, e.g. OOMKilled
+	Reason  string
+	Message string
+}
+
+// ExitStatusProvider is implemented by watchers that are able to tell how the container exited. The status is only
+// available once WaitToExit returned successfully.
+type ExitStatusProvider interface {
+	ExitStatus() (ExitStatus, bool)
+}
+
+// ExitError is returned when the watched container exited with a non-zero exit code.
+type ExitError struct {
+	Status ExitStatus
+}
+
+// Code returns a simplified code for the error, to be used in the error document.
+func (e ExitError) Code() string {
+	if e.Status.Reason != "" {
+		return e.Status.Reason
+	}
+	return "NonZeroExitCode"
+}
+
+func (e ExitError) Error() string {
+	return fmt.Sprintf("container exited with code [%d]. Reason [%s]. Message: %s", e.Status.ExitCode, e.Status.Reason, e.Status.Message)
+}
+
 type WatcherType = string
 
 const (
@@ -20,9 +52,36 @@ const (
 	WatcherTypeSignal WatcherType = "signal"
 	// Dummy watcher. Exits immediately, assuming success
 	WatcherTypeNoop WatcherType = "noop"
+	// Waits for marker files written to a shared volume by the entrypoint wrapper of the main container
+	WatcherTypeFile WatcherType = "file"
+	// Polls the pod status through the kubernetes API, the pod is identified using the downward API
+	WatcherTypeKubeAPI WatcherType = "kube-api"
 )
 
 var AllWatcherTypes = []WatcherType{
 	WatcherTypeSignal,
 	WatcherTypeNoop,
+	WatcherTypeFile,
+	WatcherTypeKubeAPI,
+}
+
+// pollUntil calls condition every interval until it returns true or an error. It returns ErrTimeout if the context is
+// done first.
+func pollUntil(ctx context.Context, interval time.Duration, condition func() (bool, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := condition()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ErrTimeout
+		case <-ticker.C:
+		}
+	}
 }
@@ -0,0 +1,100 @@
+package containerwatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// KubeAPIWatcher detects the start and exit of the main container by polling the status of its own pod. The pod name
+// and namespace are usually provided through the downward API. The service account of the pod needs permission to get
+// pods in its namespace.
+type KubeAPIWatcher struct {
+	client        kubernetes.Interface
+	namespace     string
+	podName       string
+	containerName string
+	pollInterval  time.Duration
+
+	lock       sync.Mutex
+	exitStatus *ExitStatus
+}
+
+func (k *KubeAPIWatcher) containerState(ctx context.Context) (*v1.ContainerState, error) {
+	pod, err := k.client.CoreV1().Pods(k.namespace).Get(ctx, k.podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get pod [%s/%s]", k.namespace, k.podName)
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == k.containerName {
+			return &status.State, nil
+		}
+	}
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return nil, fmt.Errorf("pod [%s/%s] completed without a status for container [%s]", k.namespace, k.podName, k.containerName)
+	}
+	return nil, nil
+}
+
+func (k *KubeAPIWatcher) WaitToStart(ctx context.Context) error {
+	logger.Infof(ctx, "Kube API Watcher waiting for container [%s] to start", k.containerName)
+	return pollUntil(ctx, k.pollInterval, func() (bool, error) {
+		state, err := k.containerState(ctx)
+		if err != nil || state == nil {
+			return false, err
+		}
+		return state.Running != nil || state.Terminated != nil, nil
+	})
+}
+
+func (k *KubeAPIWatcher) WaitToExit(ctx context.Context) error {
+	logger.Infof(ctx, "Kube API Watcher waiting for container [%s] to exit", k.containerName)
+	var terminated *v1.ContainerStateTerminated
+	if err := pollUntil(ctx, k.pollInterval, func() (bool, error) {
+		state, err := k.containerState(ctx)
+		if err != nil || state == nil {
+			return false, err
+		}
+		terminated = state.Terminated
+		return terminated != nil, nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed waiting for container [%s] to exit", k.containerName)
+	}
+	logger.Infof(ctx, "Kube API Watcher found container [%s] terminated, exit code [%d]", k.containerName, terminated.ExitCode)
+
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.exitStatus = &ExitStatus{
+		ExitCode: terminated.ExitCode,
+		Reason:   terminated.Reason,
+		Message:  terminated.Message,
+	}
+	return nil
+}
+
+func (k *KubeAPIWatcher) ExitStatus() (ExitStatus, bool) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.exitStatus == nil {
+		return ExitStatus{}, false
+	}
+	return *k.exitStatus, true
+}
+
+func NewKubeAPIWatcher(client kubernetes.Interface, namespace, podName, containerName string) *KubeAPIWatcher {
+	return &KubeAPIWatcher{
+		client:        client,
+		namespace:     namespace,
+		podName:       podName,
+		containerName: containerName,
+		pollInterval:  defaultPollInterval,
+	}
+}
@@ -0,0 +1,82 @@
+package containerwatcher
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newTestPod(phase v1.PodPhase, statuses ...v1.ContainerStatus) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+		Status: v1.PodStatus{
+			Phase:             phase,
+			ContainerStatuses: statuses,
+		},
+	}
+}
+
+func TestKubeAPIWatcher(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("running", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newTestPod(v1.PodRunning, v1.ContainerStatus{
+			Name:  "main",
+			State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+		}))
+		w := NewKubeAPIWatcher(client, "ns", "pod", "main")
+		w.pollInterval = time.Millisecond
+		assert.NoError(t, w.WaitToStart(ctx))
+
+		childCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assert.Error(t, w.WaitToExit(childCtx))
+		_, ok := w.ExitStatus()
+		assert.False(t, ok)
+	})
+
+	t.Run("terminated", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newTestPod(v1.PodRunning, v1.ContainerStatus{
+			Name: "main",
+			State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+				ExitCode: 137,
+				Reason:   "OOMKilled",
+				Message:  "out of memory",
+			}},
+		}))
+		w := NewKubeAPIWatcher(client, "ns", "pod", "main")
+		w.pollInterval = time.Millisecond
+		assert.NoError(t, w.WaitToStart(ctx))
+		assert.NoError(t, w.WaitToExit(ctx))
+		status, ok := w.ExitStatus()
+		assert.True(t, ok)
+		assert.Equal(t, ExitStatus{ExitCode: 137, Reason: "OOMKilled", Message: "out of memory"}, status)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newTestPod(v1.PodRunning))
+		client.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewForbidden(v1.Resource("pods"), "pod", fmt.Errorf("rbac"))
+		})
+		w := NewKubeAPIWatcher(client, "ns", "pod", "main")
+		w.pollInterval = time.Millisecond
+		assert.Error(t, w.WaitToStart(ctx))
+		assert.Error(t, w.WaitToExit(ctx))
+	})
+
+	t.Run("missing-container", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newTestPod(v1.PodFailed))
+		w := NewKubeAPIWatcher(client, "ns", "pod", "main")
+		w.pollInterval = time.Millisecond
+		assert.Error(t, w.WaitToExit(ctx))
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
 
+	"github.com/flyteorg/flyte/flytecopilot/cmd/containerwatcher"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/config/viper"
@@ -53,13 +54,20 @@ func (r RootOptions) UploadError(ctx context.Context, code string, recvErr error
 		logger.Errorf(ctx, "failed to create error file path err: %s", err)
 		return err
 	}
+	containerErr := &core.ContainerError{
+		Code:    code,
+		Message: recvErr.Error(),
+		Kind:    core.ContainerError_RECOVERABLE,
+	}
+	// The main container failed on its own, surface its exit code and termination reason instead.
+	var exitErr containerwatcher.ExitError
+	if errors.As(recvErr, &exitErr) {
+		containerErr.Code = exitErr.Code()
+		containerErr.Origin = core.ExecutionError_USER
+	}
 	logger.Infof(ctx, "Uploading Error file to path [%s], errFile: %s", errorPath, r.errorOutputName)
 	return r.Store.WriteProtobuf(ctx, errorPath, storage.Options{}, &core.ErrorDocument{
-		Error: &core.ContainerError{
-			Code:    code,
-			Message: recvErr.Error(),
-			Kind:    core.ContainerError_RECOVERABLE,
-		},
+		Error: containerErr,
 	})
 }
 
@@ -71,10 +79,10 @@ func NewDataCommand() *cobra.Command {
 		Short: "flytedata is a simple go binary that can be used to retrieve and upload data from/to remote stow store to local disk.",
 		Long:  `flytedata when used with conjunction with flytepropeller eliminates the need to have any flyte library installed inside the container`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			rootOpts.Scope = promutils.NewScope("flyte:data")
 			if err := rootOpts.initConfig(cmd, args); err != nil {
 				return err
 			}
-			rootOpts.Scope = promutils.NewScope("flyte:data")
 			cfg := storage.GetConfig()
 			store, err := storage.NewDataStore(cfg, rootOpts.Scope)
 			if err != nil {
@@ -115,8 +123,9 @@ func NewDataCommand() *cobra.Command {
 
 func (r *RootOptions) initConfig(cmd *cobra.Command, _ []string) error {
 	r.configAccessor = viper.NewAccessor(config.Options{
-		StrictMode:  true,
-		SearchPaths: []string{r.cfgFile},
+		StrictMode:   true,
+		SearchPaths:  []string{r.cfgFile},
+		MetricsScope: r.Scope,
 	})
 
 	rootCmd := cmd
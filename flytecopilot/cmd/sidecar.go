@@ -3,15 +3,19 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"path"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/flyteorg/flyte/flytecopilot/cmd/containerwatcher"
 	"github.com/flyteorg/flyte/flytecopilot/data"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/futures"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
@@ -20,6 +24,10 @@ const (
 	StartFile   = "_START"
 	SuccessFile = "_SUCCESS"
 	ErrorFile   = "_ERROR"
+
+	podNameEnvVar      = "POD_NAME"
+	podNamespaceEnvVar = "POD_NAMESPACE"
+	maxErrorFileSize   = 1024 * 1024
 )
 
 type UploadOptions struct {
@@ -39,6 +47,15 @@ type UploadOptions struct {
 	typedInterface   []byte
 	startWatcherType containerwatcher.WatcherType
 	exitWatcherType  containerwatcher.WatcherType
+	// Local directory path where the downloader created named pipes for inputs, when using DOWNLOAD_STREAM mode.
+	streamInputsDir string
+	// Directory where the file watcher looks for marker files, defaults to the local directory path.
+	watcherDir string
+	// Name of the main container, used by the kube-api watcher.
+	watchContainerName string
+	// Pod of the main container, used by the kube-api watcher. Defaults to the POD_NAME and POD_NAMESPACE env vars.
+	podName      string
+	podNamespace string
 }
 
 func (u *UploadOptions) createWatcher(_ context.Context, w containerwatcher.WatcherType) (containerwatcher.Watcher, error) {
@@ -47,10 +64,57 @@ func (u *UploadOptions) createWatcher(_ context.Context, w containerwatcher.Watc
 		return containerwatcher.SignalWatcher{}, nil
 	case containerwatcher.WatcherTypeNoop:
 		return containerwatcher.NoopWatcher{}, nil
+	case containerwatcher.WatcherTypeFile:
+		dir := u.watcherDir
+		if dir == "" {
+			dir = u.localDirectoryPath
+		}
+		return containerwatcher.NewFileWatcher(dir), nil
+	case containerwatcher.WatcherTypeKubeAPI:
+		podName := u.podName
+		if podName == "" {
+			podName = os.Getenv(podNameEnvVar)
+		}
+		podNamespace := u.podNamespace
+		if podNamespace == "" {
+			podNamespace = os.Getenv(podNamespaceEnvVar)
+		}
+		if podName == "" || podNamespace == "" || u.watchContainerName == "" {
+			return nil, fmt.Errorf("pod name, pod namespace and container name are required for the %s watcher", w)
+		}
+		restConfig, err := u.clientConfig.ClientConfig()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load kubernetes client config")
+		}
+		client, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create kubernetes client")
+		}
+		return containerwatcher.NewKubeAPIWatcher(client, podNamespace, podName, u.watchContainerName), nil
 	}
 	return nil, fmt.Errorf("unsupported watcher type")
 }
 
+// Returns an ExitError if the watcher knows that the container exited with a non-zero exit code.
+func (u *UploadOptions) checkExitStatus(ctx context.Context, w containerwatcher.Watcher) error {
+	p, ok := w.(containerwatcher.ExitStatusProvider)
+	if !ok {
+		return nil
+	}
+	status, ok := p.ExitStatus()
+	if !ok || status.ExitCode == 0 {
+		return nil
+	}
+	if status.Message == "" {
+		// Use the error written by the container, if any, as it is more helpful than the exit code alone.
+		if b, err := os.ReadFile(path.Join(u.localDirectoryPath, ErrorFile)); err == nil && len(b) <= maxErrorFileSize {
+			status.Message = string(b)
+		}
+	}
+	logger.Errorf(ctx, "Container exited with code [%d], reason [%s]", status.ExitCode, status.Reason)
+	return containerwatcher.ExitError{Status: status}
+}
+
 func (u *UploadOptions) uploader(ctx context.Context) error {
 	if u.typedInterface == nil {
 		logger.Infof(ctx, "No output interface provided. Assuming Void outputs.")
@@ -85,11 +149,23 @@ func (u *UploadOptions) uploader(ctx context.Context) error {
 		return err
 	}
 
+	dl := data.NewUploader(ctx, u.Store, core.DataLoadingConfig_LiteralMapFormat(f), core.IOStrategy_UploadMode(m), ErrorFile)
+	stopEagerUpload, err := dl.StartEagerUpload(ctx, outputInterface, u.localDirectoryPath, storage.DataReference(u.remoteOutputsRawPrefix))
+	if err != nil {
+		return err
+	}
+
 	logger.Infof(ctx, "Waiting for Container to exit.")
 	if err := w.WaitToExit(ctx); err != nil {
+		stopEagerUpload()
 		logger.Errorf(ctx, "Failed waiting for container to exit. Err: %s", err)
 		return err
 	}
+	stopEagerUpload()
+
+	if err := u.checkExitStatus(ctx, w); err != nil {
+		return err
+	}
 
 	logger.Infof(ctx, "Container Exited! uploading data.")
 
@@ -99,8 +175,6 @@ func (u *UploadOptions) uploader(ctx context.Context) error {
 		return err
 	}
 
-	dl := data.NewUploader(ctx, u.Store, core.DataLoadingConfig_LiteralMapFormat(f), core.IOStrategy_UploadMode(m), ErrorFile)
-
 	childCtx, cancelFn := context.WithTimeout(ctx, u.timeout)
 	defer cancelFn()
 	if err := dl.RecursiveUpload(childCtx, outputInterface, u.localDirectoryPath, toOutputPath, storage.DataReference(u.remoteOutputsRawPrefix)); err != nil {
@@ -113,6 +187,14 @@ func (u *UploadOptions) uploader(ctx context.Context) error {
 }
 
 func (u *UploadOptions) Sidecar(ctx context.Context) error {
+	var streamer futures.Future
+	streamCtx, stopStreaming := context.WithCancel(ctx)
+	defer stopStreaming()
+	if u.streamInputsDir != "" {
+		streamer = futures.NewAsyncFuture(streamCtx, func(ctx2 context.Context) (interface{}, error) {
+			return nil, data.NewInputStreamer(ctx2, u.Store, u.streamInputsDir).Serve(ctx2)
+		})
+	}
 
 	if err := u.uploader(ctx); err != nil {
 		logger.Errorf(ctx, "Uploading failed, err %s", err)
@@ -120,6 +202,17 @@ func (u *UploadOptions) Sidecar(ctx context.Context) error {
 			logger.Errorf(ctx, "Failed to write error document, err :%s", err)
 			return err
 		}
+	} else if streamer != nil {
+		// The container has exited, so stop feeding the pipes it never opened. Those aren't an error, but a failed stream
+		// means truncated inputs, whether or not the streamer had finished by now.
+		stopStreaming()
+		if _, err := streamer.Get(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Errorf(ctx, "Streaming inputs failed, err %s", err)
+			if err := u.UploadError(ctx, "InputStreamFailed", err, storage.DataReference(u.remoteOutputsPrefix)); err != nil {
+				logger.Errorf(ctx, "Failed to write error document, err :%s", err)
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -150,6 +243,11 @@ func NewUploadCommand(opts *RootOptions) *cobra.Command {
 	uploadCmd.Flags().BytesBase64VarP(&uploadOptions.typedInterface, "interface", "i", nil, "Typed Interface - core.TypedInterface, base64 encoded string of the serialized protobuf")
 	uploadCmd.Flags().DurationVarP(&uploadOptions.timeout, "start-timeout", "", 0, "Deprecated: Use --timeout instead. Specifies the maximum duration to allow uploads to complete. Retained for backward compatibility.")
 	uploadCmd.Flags().StringVarP(&uploadOptions.startWatcherType, "start-watcher-type", "", containerwatcher.WatcherTypeSignal, fmt.Sprintf("Sidecar will wait for container before starting upload process. Watcher type makes the type configurable. Available Type %+v", containerwatcher.AllWatcherTypes))
+	uploadCmd.Flags().StringVarP(&uploadOptions.streamInputsDir, "stream-inputs-dir", "", "", fmt.Sprintf("The local directory on disk where the downloader created named pipes for inputs in %s mode. Sidecar will stream the inputs into them.", core.IOStrategy_DOWNLOAD_STREAM.String()))
 	uploadCmd.Flags().StringVarP(&uploadOptions.exitWatcherType, "exit-watcher-type", "", containerwatcher.WatcherTypeSignal, fmt.Sprintf("Sidecar will wait for completion of the container before starting upload process. Watcher type makes the type configurable. Available Type %+v", containerwatcher.AllWatcherTypes))
+	uploadCmd.Flags().StringVarP(&uploadOptions.watcherDir, "watcher-dir", "", "", fmt.Sprintf("Directory where the %s watcher looks for the %s and %s marker files. Defaults to --from-local-dir.", containerwatcher.WatcherTypeFile, containerwatcher.StartMarkerFile, containerwatcher.ExitMarkerFile))
+	uploadCmd.Flags().StringVarP(&uploadOptions.watchContainerName, "watch-container", "", "", fmt.Sprintf("Name of the main container, required by the %s watcher.", containerwatcher.WatcherTypeKubeAPI))
+	uploadCmd.Flags().StringVarP(&uploadOptions.podName, "pod-name", "", "", fmt.Sprintf("Name of the pod, used by the %s watcher. Defaults to the %s env var.", containerwatcher.WatcherTypeKubeAPI, podNameEnvVar))
+	uploadCmd.Flags().StringVarP(&uploadOptions.podNamespace, "pod-namespace", "", "", fmt.Sprintf("Namespace of the pod, used by the %s watcher. Defaults to the %s env var.", containerwatcher.WatcherTypeKubeAPI, podNamespaceEnvVar))
 	return uploadCmd
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -90,4 +91,50 @@ func TestUploadOptions_Upload(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, v.Exists())
 	})
+
+	t.Run("uploadContainerFailed", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir(tmpFolderLocation, tmpPrefix)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, os.RemoveAll(tmpDir))
+		}()
+		s := promutils.NewTestScope()
+		store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, s.NewSubScope("storage"))
+		assert.NoError(t, err)
+
+		iface := &core.TypedInterface{
+			Outputs: &core.VariableMap{
+				Variables: map[string]*core.Variable{
+					"x": {
+						Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}},
+					},
+				},
+			},
+		}
+		d, err := proto.Marshal(iface)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(path.Join(tmpDir, "x"), []byte("5"), os.ModePerm))                                          // #nosec G306
+		assert.NoError(t, ioutil.WriteFile(path.Join(tmpDir, containerwatcher.ExitMarkerFile), []byte("137\nOOMKilled"), os.ModePerm)) // #nosec G306
+
+		uopts := UploadOptions{
+			RootOptions: &RootOptions{
+				Scope:           s,
+				Store:           store,
+				errorOutputName: "errors.pb",
+			},
+			remoteOutputsPrefix: outputPath,
+			metadataFormat:      core.DataLoadingConfig_JSON.String(),
+			uploadMode:          core.IOStrategy_UPLOAD_ON_EXIT.String(),
+			startWatcherType:    containerwatcher.WatcherTypeFile,
+			typedInterface:      d,
+			localDirectoryPath:  tmpDir,
+		}
+
+		assert.NoError(t, uopts.Sidecar(ctx))
+		errDoc := &core.ErrorDocument{}
+		assert.NoError(t, store.ReadProtobuf(ctx, "/output/errors.pb", errDoc))
+		assert.Equal(t, "OOMKilled", errDoc.GetError().GetCode())
+		assert.Equal(t, core.ExecutionError_USER, errDoc.GetError().GetOrigin())
+		assert.Contains(t, errDoc.GetError().GetMessage(), "[137]")
+	})
 }
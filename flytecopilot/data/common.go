@@ -10,6 +10,12 @@
 //	uploader := NewUploader(...)
 //	uploader.RecursiveUpload(...) // Will recursively upload all the data from the given path depending on the output interface
 //
+// Modes:
+//   - In DOWNLOAD_STREAM mode single blob inputs are created as named pipes, which the sidecar feeds using an
+//     InputStreamer once the user container opens them for reading.
+//   - In UPLOAD_EAGER mode, uploader.StartEagerUpload(...) uploads single blob outputs as soon as they are closed, so that
+//     RecursiveUpload only uploads what is left.
+//
 // All errors are bubbled up.
 //
 // Both the uploader and downloader accept context.Context variables. These should be used to control timeouts etc.
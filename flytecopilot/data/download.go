@@ -29,8 +29,9 @@ import (
 type Downloader struct {
 	format core.DataLoadingConfig_LiteralMapFormat
 	store  *storage.DataStore
-	// TODO support download mode
-	mode core.IOStrategy_DownloadMode
+	mode   core.IOStrategy_DownloadMode
+	// Blobs exposed as named pipes instead of being downloaded, only used in DOWNLOAD_STREAM mode
+	streams *streamedBlobs
 }
 
 // TODO add timeout and rate limit
@@ -227,6 +228,15 @@ func (d Downloader) handleBlob(ctx context.Context, blob *core.Blob, toPath stri
 		logger.Infof(ctx, "successfully copied %d remote files from [%s] to local [%s]", downloadSuccess, blobRef, toPath)
 		return toPath, nil
 	} else if blob.GetMetadata().GetType().GetDimensionality() == core.BlobType_SINGLE {
+		if d.mode == core.IOStrategy_DOWNLOAD_STREAM && d.streams != nil {
+			// The blob is streamed into the named pipe by the sidecar, once the user container opens it for reading.
+			if err := makeFifo(toPath); err != nil {
+				return nil, errors.Wrapf(err, "failed to create named pipe at path %s", toPath)
+			}
+			d.streams.add(toPath, blob.GetUri())
+			logger.Infof(ctx, "Created named pipe [%s] to stream remote data from [%s]", toPath, blobRef)
+			return toPath, nil
+		}
 		// reader should be declared here (avoid being shared across all goroutines)
 		var reader io.ReadCloser
 		if scheme == "http" || scheme == "https" {
@@ -548,6 +558,12 @@ func (d Downloader) DownloadInputs(ctx context.Context, inputRef storage.DataRef
 		return err
 	}
 
+	if d.mode == core.IOStrategy_DOWNLOAD_STREAM && d.streams != nil {
+		if err := d.streams.writeManifest(outputDir); err != nil {
+			return errors.Wrapf(err, "failed to write stream manifest")
+		}
+	}
+
 	if d.format == core.DataLoadingConfig_JSON {
 		m, err := json.Marshal(varMap)
 		if err != nil {
@@ -567,8 +583,9 @@ func (d Downloader) DownloadInputs(ctx context.Context, inputRef storage.DataRef
 
 func NewDownloader(_ context.Context, store *storage.DataStore, format core.DataLoadingConfig_LiteralMapFormat, mode core.IOStrategy_DownloadMode) Downloader {
 	return Downloader{
-		format: format,
-		store:  store,
-		mode:   mode,
+		format:  format,
+		store:   store,
+		mode:    mode,
+		streams: newStreamedBlobs(),
 	}
 }
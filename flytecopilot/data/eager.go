@@ -0,0 +1,170 @@
+package data
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+type eagerUpload struct {
+	path    string
+	size    int64
+	modTime time.Time
+	lit     *core.Literal
+}
+
+// eagerUploads keeps track of the blobs that were uploaded while the user container was still running. It is keyed by
+// the variable path (without extension) as used by RecursiveUpload.
+type eagerUploads struct {
+	sync.Mutex
+	uploads  map[string]eagerUpload
+	varLocks map[string]*sync.Mutex
+}
+
+func newEagerUploads() *eagerUploads {
+	return &eagerUploads{
+		uploads:  map[string]eagerUpload{},
+		varLocks: map[string]*sync.Mutex{},
+	}
+}
+
+// Returns the literal of an eager upload for the given variable path, only if the file on disk has not changed since.
+func (e *eagerUploads) get(varPath string) (*core.Literal, bool) {
+	if e == nil {
+		return nil, false
+	}
+	e.Lock()
+	up, ok := e.uploads[varPath]
+	e.Unlock()
+	if !ok {
+		return nil, false
+	}
+	fpath, info, err := IsFileReadable(varPath, true)
+	if err != nil || fpath != up.path || info.IsDir() || info.Size() != up.size || !info.ModTime().Equal(up.modTime) {
+		return nil, false
+	}
+	return up.lit, true
+}
+
+func (e *eagerUploads) put(varPath string, up eagerUpload) {
+	e.Lock()
+	defer e.Unlock()
+	e.uploads[varPath] = up
+}
+
+func (e *eagerUploads) forget(varPath string) {
+	e.Lock()
+	defer e.Unlock()
+	delete(e.uploads, varPath)
+}
+
+// Uploads of the same variable are serialized, so that the recorded upload always matches the content in the store.
+func (e *eagerUploads) lockVar(varPath string) func() {
+	e.Lock()
+	l, ok := e.varLocks[varPath]
+	if !ok {
+		l = &sync.Mutex{}
+		e.varLocks[varPath] = l
+	}
+	e.Unlock()
+	l.Lock()
+	return l.Unlock
+}
+
+func (u Uploader) eagerUpload(ctx context.Context, varPath, filePath string, toPath storage.DataReference) {
+	unlock := u.eager.lockVar(varPath)
+	defer unlock()
+
+	before, err := os.Stat(filePath)
+	if err != nil || before.IsDir() {
+		return
+	}
+	logger.Infof(ctx, "Eagerly uploading [%s] to [%s]", filePath, toPath)
+	lit, err := u.handleBlobType(ctx, filePath, toPath)
+	if err != nil {
+		logger.Warnf(ctx, "Eager upload of [%s] failed, it will be retried once the container exits. Err: %s", filePath, err)
+		u.eager.forget(varPath)
+		return
+	}
+	after, err := os.Stat(filePath)
+	if err != nil || after.Size() != before.Size() || !after.ModTime().Equal(before.ModTime()) {
+		logger.Infof(ctx, "[%s] changed while it was being uploaded, it will be uploaded again once the container exits", filePath)
+		u.eager.forget(varPath)
+		return
+	}
+	u.eager.put(varPath, eagerUpload{
+		path:    filePath,
+		size:    before.Size(),
+		modTime: before.ModTime(),
+		lit:     lit,
+	})
+}
+
+// StartEagerUpload watches fromPath for single blob outputs and uploads each of them as soon as the user container
+// closes the file. RecursiveUpload later skips the blobs that have not changed since they were uploaded.
+// It is a noop unless the uploader is in UPLOAD_EAGER mode. The returned function stops watching and waits for
+// in-flight uploads to complete, it should be called once the container has exited and before RecursiveUpload.
+func (u Uploader) StartEagerUpload(ctx context.Context, vars *core.VariableMap, fromPath string, dataRawPath storage.DataReference) (stop func(), err error) {
+	blobVars := make(map[string]storage.DataReference)
+	for varName, variable := range vars.GetVariables() {
+		blobType := variable.GetType().GetBlob()
+		if blobType == nil || blobType.GetDimensionality() != core.BlobType_SINGLE {
+			continue
+		}
+		ref, err := u.blobOutputReference(ctx, dataRawPath, varName)
+		if err != nil {
+			return nil, err
+		}
+		blobVars[varName] = ref
+	}
+
+	if u.mode != core.IOStrategy_UPLOAD_EAGER || len(blobVars) == 0 || u.eager == nil {
+		return func() {}, nil
+	}
+
+	if err := os.MkdirAll(fromPath, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	watcher, err := newClosedFileWatcher(fromPath)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to watch [%s], outputs will be uploaded once the container exits. Err: %s", fromPath, err)
+		return func() {}, nil
+	}
+
+	stopCh := make(chan struct{})
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		logger.Infof(ctx, "Watching [%s] for outputs to upload eagerly", fromPath)
+		err := watcher.run(ctx, stopCh, func(name string) {
+			varName := strings.TrimSuffix(name, filepath.Ext(name))
+			toPath, ok := blobVars[varName]
+			if !ok {
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				u.eagerUpload(ctx, path.Join(fromPath, varName), path.Join(fromPath, name), toPath)
+			}()
+		})
+		if err != nil {
+			logger.Warnf(ctx, "Stopped watching [%s], remaining outputs will be uploaded once the container exits. Err: %s", fromPath, err)
+		}
+	}()
+
+	return func() {
+		close(stopCh)
+		wg.Wait()
+	}, nil
+}
@@ -0,0 +1,87 @@
+//go:build linux
+
+package data
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+func TestUploader_StartEagerUpload(t *testing.T) {
+	ctx := context.TODO()
+	vmap := &core.VariableMap{
+		Variables: map[string]*core.Variable{
+			"x": {
+				Type: &core.LiteralType{Type: &core.LiteralType_Blob{Blob: &core.BlobType{Dimensionality: core.BlobType_SINGLE}}},
+			},
+		},
+	}
+
+	t.Run("upload-on-exit-noop", func(t *testing.T) {
+		store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+		assert.NoError(t, err)
+		u := NewUploader(ctx, store, core.DataLoadingConfig_JSON, core.IOStrategy_UPLOAD_ON_EXIT, "error")
+		stop, err := u.StartEagerUpload(ctx, vmap, "/does/not/exist", "raw")
+		assert.NoError(t, err)
+		stop()
+	})
+
+	t.Run("upload-eager", func(t *testing.T) {
+		tmpDir, err := os.MkdirTemp("", "upload_test")
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, os.RemoveAll(tmpDir))
+		}()
+
+		store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+		assert.NoError(t, err)
+		u := NewUploader(ctx, store, core.DataLoadingConfig_JSON, core.IOStrategy_UPLOAD_EAGER, "error")
+		stop, err := u.StartEagerUpload(ctx, vmap, tmpDir, "raw")
+		assert.NoError(t, err)
+
+		data := []byte("data")
+		assert.NoError(t, os.WriteFile(path.Join(tmpDir, "x.txt"), data, os.ModePerm)) // #nosec G306
+		var lit *core.Literal
+		assert.Eventually(t, func() bool {
+			l, ok := u.eager.get(path.Join(tmpDir, "x"))
+			lit = l
+			return ok
+		}, 5*time.Second, 10*time.Millisecond)
+		stop()
+
+		ref := storage.DataReference(lit.GetScalar().GetBlob().GetUri())
+		r, err := store.ReadRaw(ctx, ref)
+		assert.NoError(t, err)
+		b, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.NoError(t, r.Close())
+		assert.Equal(t, data, b)
+
+		// A file rewritten after its eager upload is uploaded again.
+		assert.NoError(t, os.WriteFile(path.Join(tmpDir, "x.txt"), []byte("changed data"), os.ModePerm)) // #nosec G306
+		_, ok := u.eager.get(path.Join(tmpDir, "x"))
+		assert.False(t, ok)
+
+		outputRef := storage.DataReference("output")
+		assert.NoError(t, u.RecursiveUpload(ctx, vmap, tmpDir, outputRef, "raw"))
+		outputs := &core.LiteralMap{}
+		assert.NoError(t, store.ReadProtobuf(ctx, outputRef, outputs))
+		assert.Equal(t, ref.String(), outputs.GetLiterals()["x"].GetScalar().GetBlob().GetUri())
+		r, err = store.ReadRaw(ctx, ref)
+		assert.NoError(t, err)
+		b, err = io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.NoError(t, r.Close())
+		assert.Equal(t, "changed data", string(b))
+	})
+}
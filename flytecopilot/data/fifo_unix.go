@@ -0,0 +1,35 @@
+//go:build !windows
+
+package data
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func makeFifo(path string) error {
+	return unix.Mkfifo(path, 0666)
+}
+
+// openFifoForWrite waits for a reader to open the named pipe at the given path and returns the write end of the pipe.
+// Unlike a blocking open, it returns as soon as the context is done.
+func openFifoForWrite(ctx context.Context, path string, pollInterval time.Duration) (*os.File, error) {
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|unix.O_NONBLOCK, 0)
+		if err == nil {
+			return f, nil
+		}
+		// ENXIO is returned while no process has the pipe open for reading.
+		if pathErr, ok := err.(*os.PathError); !ok || pathErr.Err != unix.ENXIO {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
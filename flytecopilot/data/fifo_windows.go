@@ -0,0 +1,18 @@
+//go:build windows
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+func makeFifo(path string) error {
+	return fmt.Errorf("cannot create named pipe at [%s], streaming downloads are not supported on windows", path)
+}
+
+func openFifoForWrite(_ context.Context, path string, _ time.Duration) (*os.File, error) {
+	return nil, fmt.Errorf("cannot open named pipe at [%s], streaming downloads are not supported on windows", path)
+}
@@ -0,0 +1,92 @@
+//go:build linux
+
+package data
+
+import (
+	"context"
+	"encoding/binary"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	inotifyPollTimeoutMillis = 200
+	inotifyBufferSize        = 64 * (unix.SizeofInotifyEvent + unix.NAME_MAX + 1)
+)
+
+// closedFileWatcher reports files in a directory that are closed after being written to, or moved into it. Nested
+// directories are not watched.
+type closedFileWatcher struct {
+	fd int
+}
+
+// newClosedFileWatcher starts watching dir right away, so that no event is missed between this call and run.
+func newClosedFileWatcher(dir string) (*closedFileWatcher, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize inotify")
+	}
+
+	if _, err := unix.InotifyAddWatch(fd, dir, unix.IN_CLOSE_WRITE|unix.IN_MOVED_TO); err != nil {
+		_ = unix.Close(fd)
+		return nil, errors.Wrapf(err, "failed to watch directory [%s]", dir)
+	}
+	return &closedFileWatcher{fd: fd}, nil
+}
+
+// run calls onClose with the name of every closed file until stop is closed or the context is done. The watcher cannot
+// be reused afterwards.
+func (w *closedFileWatcher) run(ctx context.Context, stop <-chan struct{}, onClose func(name string)) error {
+	fd := w.fd
+	defer func() {
+		_ = unix.Close(fd)
+	}()
+
+	buf := make([]byte, inotifyBufferSize)
+	fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}} // #nosec G115
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		ready, err := unix.Poll(fds, inotifyPollTimeoutMillis)
+		if err != nil {
+			if err == unix.EINTR {
+				continue
+			}
+			return errors.Wrap(err, "failed to poll inotify events")
+		}
+		if ready == 0 {
+			continue
+		}
+
+		n, err := unix.Read(fd, buf)
+		if err != nil {
+			if err == unix.EAGAIN || err == unix.EINTR {
+				continue
+			}
+			return errors.Wrap(err, "failed to read inotify events")
+		}
+
+		// Each event is a fixed size unix.InotifyEvent followed by a nul padded name of Len bytes.
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			mask := binary.NativeEndian.Uint32(buf[offset+4:])
+			nameLen := int(binary.NativeEndian.Uint32(buf[offset+12:]))
+			nameStart := offset + unix.SizeofInotifyEvent
+			offset = nameStart + nameLen
+			if offset > n {
+				break
+			}
+			name := strings.TrimRight(string(buf[nameStart:offset]), "\x00")
+			if mask&unix.IN_ISDIR == 0 && name != "" {
+				onClose(name)
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !linux
+
+package data
+
+import (
+	"context"
+	"fmt"
+)
+
+// closedFileWatcher is only supported on linux, where it is backed by inotify.
+type closedFileWatcher struct{}
+
+func newClosedFileWatcher(dir string) (*closedFileWatcher, error) {
+	return nil, fmt.Errorf("cannot watch [%s], eager upload is only supported on linux", dir)
+}
+
+func (w *closedFileWatcher) run(_ context.Context, _ <-chan struct{}, _ func(name string)) error {
+	return nil
+}
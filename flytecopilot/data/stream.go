@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+// StreamManifestFile is written by the Downloader in DOWNLOAD_STREAM mode next to the inputs. It lists the named pipes
+// that still have to be fed by an InputStreamer.
+const StreamManifestFile = "_STREAMS.json"
+
+const defaultStreamPollInterval = 500 * time.Millisecond
+
+type streamManifest struct {
+	// Maps the path of each named pipe, relative to the inputs directory, to the remote blob it streams.
+	Streams map[string]string `json:"streams"`
+}
+
+// streamedBlobs collects the blobs that the Downloader exposed as named pipes instead of downloading them.
+type streamedBlobs struct {
+	sync.Mutex
+	streams map[string]string
+}
+
+func newStreamedBlobs() *streamedBlobs {
+	return &streamedBlobs{
+		streams: map[string]string{},
+	}
+}
+
+func (s *streamedBlobs) add(localPath, uri string) {
+	s.Lock()
+	defer s.Unlock()
+	s.streams[localPath] = uri
+}
+
+func (s *streamedBlobs) writeManifest(dir string) error {
+	s.Lock()
+	defer s.Unlock()
+	m := streamManifest{Streams: make(map[string]string, len(s.streams))}
+	for localPath, uri := range s.streams {
+		rel, err := filepath.Rel(dir, localPath)
+		if err != nil {
+			return errors.Wrapf(err, "streamed blob [%s] is not under the inputs directory [%s]", localPath, dir)
+		}
+		m.Streams[rel] = uri
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal stream manifest")
+	}
+	return os.WriteFile(filepath.Join(dir, StreamManifestFile), b, os.ModePerm) // #nosec G306
+}
+
+// InputStreamer feeds the named pipes that a Downloader created in DOWNLOAD_STREAM mode. It runs alongside the user
+// container (as part of the sidecar), so that the container can start before large blobs are fully downloaded.
+// Each pipe can only be read once and sequentially.
+type InputStreamer struct {
+	store        *storage.DataStore
+	inputDir     string
+	pollInterval time.Duration
+}
+
+func (s InputStreamer) waitForManifest(ctx context.Context) (*streamManifest, error) {
+	manifestPath := filepath.Join(s.inputDir, StreamManifestFile)
+	for {
+		b, err := os.ReadFile(manifestPath)
+		if err == nil {
+			m := &streamManifest{}
+			if err := json.Unmarshal(b, m); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal stream manifest [%s]", manifestPath)
+			}
+			return m, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "failed to read stream manifest [%s]", manifestPath)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+func (s InputStreamer) stream(ctx context.Context, pipePath string, ref storage.DataReference) error {
+	scheme, _, _, err := ref.Split()
+	if err != nil {
+		return errors.Wrapf(err, "Blob uri incorrectly formatted")
+	}
+
+	w, err := openFifoForWrite(ctx, pipePath, s.pollInterval)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open named pipe [%s]", pipePath)
+	}
+	defer func() {
+		if err := w.Close(); err != nil {
+			logger.Errorf(ctx, "failed to close named pipe [%s]. Error: %s", pipePath, err)
+		}
+	}()
+
+	var reader io.ReadCloser
+	if scheme == "http" || scheme == "https" {
+		reader, err = DownloadFileFromHTTP(ctx, ref)
+	} else {
+		reader, err = DownloadFileFromStorage(ctx, ref, s.store)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to download from ref [%s]", ref)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			logger.Errorf(ctx, "failed to close Blob read stream @ref [%s]. Error: %s", ref, err)
+		}
+	}()
+
+	v, err := io.Copy(w, reader)
+	if err != nil {
+		if errors.Is(err, syscall.EPIPE) {
+			logger.Warnf(ctx, "Reader of [%s] closed the named pipe after [%d] bytes", pipePath, v)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to stream [%s] into [%s]", ref, pipePath)
+	}
+	logger.Infof(ctx, "Successfully streamed [%d] bytes from [%s] to [%s]", v, ref, pipePath)
+	return nil
+}
+
+// Serve waits for the Downloader to write the stream manifest and then streams every listed blob into its named pipe.
+// Writing to a pipe blocks until the user container reads it, so Serve returns once all pipes have been consumed, as
+// soon as any of them fails, or when the context is done.
+func (s InputStreamer) Serve(ctx context.Context) error {
+	m, err := s.waitForManifest(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Infof(ctx, "Streaming [%d] inputs into [%s]", len(m.Streams), s.inputDir)
+
+	childCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Buffered so that the remaining streams don't block once Serve has returned.
+	errs := make(chan error, len(m.Streams))
+	for rel, uri := range m.Streams {
+		pipePath := filepath.Join(s.inputDir, rel)
+		ref := storage.DataReference(uri)
+		go func() {
+			if err := s.stream(childCtx, pipePath, ref); err != nil {
+				errs <- errors.Wrapf(err, "input [%s] streaming failed", rel)
+				return
+			}
+			errs <- nil
+		}()
+	}
+
+	for range m.Streams {
+		if err := <-errs; err != nil {
+			logger.Errorf(ctx, "Failed to stream inputs, err %s", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func NewInputStreamer(_ context.Context, store *storage.DataStore, inputDir string) InputStreamer {
+	return InputStreamer{
+		store:        store,
+		inputDir:     inputDir,
+		pollInterval: defaultStreamPollInterval,
+	}
+}
@@ -0,0 +1,125 @@
+//go:build !windows
+
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+func TestDownloader_DownloadInputsStream(t *testing.T) {
+	ctx := context.TODO()
+	s, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	content := []byte("streamed content")
+	blobRef := storage.DataReference("s3://container/blob")
+	assert.NoError(t, s.WriteRaw(ctx, blobRef, int64(len(content)), storage.Options{}, bytes.NewReader(content)))
+
+	inputsRef := storage.DataReference("s3://container/inputs.pb")
+	assert.NoError(t, s.WriteProtobuf(ctx, inputsRef, storage.Options{}, &core.LiteralMap{
+		Literals: map[string]*core.Literal{
+			"x": {Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Blob{Blob: &core.Blob{
+				Uri:      blobRef.String(),
+				Metadata: &core.BlobMetadata{Type: &core.BlobType{Dimensionality: core.BlobType_SINGLE}},
+			}}}}},
+			"y": {Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Primitive{Primitive: &core.Primitive{
+				Value: &core.Primitive_Integer{Integer: 5},
+			}}}}},
+		},
+	}))
+
+	tmpDir, err := os.MkdirTemp("", "stream_test")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	d := NewDownloader(ctx, s, core.DataLoadingConfig_JSON, core.IOStrategy_DOWNLOAD_STREAM)
+	assert.NoError(t, d.DownloadInputs(ctx, inputsRef, tmpDir))
+
+	info, err := os.Stat(filepath.Join(tmpDir, "x"))
+	assert.NoError(t, err)
+	assert.True(t, info.Mode()&os.ModeNamedPipe != 0)
+
+	y, err := os.ReadFile(filepath.Join(tmpDir, "y"))
+	assert.NoError(t, err)
+	assert.Equal(t, "5", string(y))
+
+	b, err := os.ReadFile(filepath.Join(tmpDir, StreamManifestFile))
+	assert.NoError(t, err)
+	m := streamManifest{}
+	assert.NoError(t, json.Unmarshal(b, &m))
+	assert.Equal(t, map[string]string{"x": blobRef.String()}, m.Streams)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- NewInputStreamer(ctx, s, tmpDir).Serve(ctx)
+	}()
+
+	f, err := os.Open(filepath.Join(tmpDir, "x"))
+	assert.NoError(t, err)
+	read, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	assert.Equal(t, content, read)
+	assert.NoError(t, <-served)
+}
+
+func TestInputStreamer_ServeCanceled(t *testing.T) {
+	s, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	tmpDir, err := os.MkdirTemp("", "stream_test")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	assert.Error(t, NewInputStreamer(ctx, s, tmpDir).Serve(ctx))
+}
+
+func TestInputStreamer_ServeFailed(t *testing.T) {
+	ctx := context.TODO()
+	s, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	tmpDir, err := os.MkdirTemp("", "stream_test")
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(tmpDir))
+	}()
+
+	// The container reads "x", whose blob doesn't exist, and never opens "z".
+	assert.NoError(t, makeFifo(filepath.Join(tmpDir, "x")))
+	assert.NoError(t, makeFifo(filepath.Join(tmpDir, "z")))
+	blobs := newStreamedBlobs()
+	blobs.add(filepath.Join(tmpDir, "x"), "s3://container/missing")
+	blobs.add(filepath.Join(tmpDir, "z"), "s3://container/other")
+	assert.NoError(t, blobs.writeManifest(tmpDir))
+
+	served := make(chan error, 1)
+	go func() {
+		served <- NewInputStreamer(ctx, s, tmpDir).Serve(ctx)
+	}()
+
+	f, err := os.Open(filepath.Join(tmpDir, "x"))
+	assert.NoError(t, err)
+	_, err = io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	assert.ErrorContains(t, <-served, "input [x] streaming failed")
+}
@@ -30,6 +30,8 @@ type Uploader struct {
 	store                   *storage.DataStore
 	aggregateOutputFileName string
 	errorFileName           string
+	// Blobs that were already uploaded while the container was running, only used in UPLOAD_EAGER mode
+	eager *eagerUploads
 }
 
 type dirFile struct {
@@ -114,6 +116,13 @@ func (u Uploader) handleBlobType(ctx context.Context, localPath string, toPath s
 	return coreutils.MakeLiteralForBlob(toPath, false, ""), UploadFileToStorage(ctx, fpath, toPath, size, u.store)
 }
 
+func (u Uploader) blobOutputReference(ctx context.Context, dataRawPath storage.DataReference, varName string) (storage.DataReference, error) {
+	if varName == u.aggregateOutputFileName {
+		return u.store.ConstructReference(ctx, dataRawPath, "_"+varName)
+	}
+	return u.store.ConstructReference(ctx, dataRawPath, varName)
+}
+
 func (u Uploader) RecursiveUpload(ctx context.Context, vars *core.VariableMap, fromPath string, metaOutputPath, dataRawPath storage.DataReference) error {
 	childCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -141,16 +150,15 @@ func (u Uploader) RecursiveUpload(ctx context.Context, vars *core.VariableMap, f
 		varType := variable.GetType()
 		switch varType.GetType().(type) {
 		case *core.LiteralType_Blob:
-			var varOutputPath storage.DataReference
-			var err error
-			if varName == u.aggregateOutputFileName {
-				varOutputPath, err = u.store.ConstructReference(ctx, dataRawPath, "_"+varName)
-			} else {
-				varOutputPath, err = u.store.ConstructReference(ctx, dataRawPath, varName)
-			}
+			varOutputPath, err := u.blobOutputReference(ctx, dataRawPath, varName)
 			if err != nil {
 				return err
 			}
+			if l, ok := u.eager.get(varPath); ok {
+				logger.Infof(ctx, "Var [%s] was already uploaded eagerly, skipping", varName)
+				varFutures[varName] = futures.NewSyncFuture(l, nil)
+				continue
+			}
 			varFutures[varName] = futures.NewAsyncFuture(childCtx, func(ctx2 context.Context) (interface{}, error) {
 				return u.handleBlobType(ctx2, varPath, varOutputPath)
 			})
@@ -196,5 +204,6 @@ func NewUploader(_ context.Context, store *storage.DataStore, format core.DataLo
 		store:         store,
 		errorFileName: errorFileName,
 		mode:          mode,
+		eager:         newEagerUploads(),
 	}
 }
@@ -0,0 +1,205 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	applyconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/apply"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	cmdUtil "github.com/flyteorg/flyte/flytectl/pkg/commandutils"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	applyShort = "Applies the Flyte configuration declared in manifests."
+	applyLong  = `
+Declaratively manage projects, matchable attributes and active launch plan versions, e.g. from a git repository.
+The manifests are YAML files with one or more documents, each declaring a resource with its *kind* and its *spec*.
+The current state of each declared resource is compared with its declared state and the resulting changes are shown
+before being applied. Applying the same manifests again is a no-op.
+
+A project is declared with the same fields as the project file of the update project command, including its *state*
+(*0* for active and *1* for archived):
+
+.. code-block:: yaml
+
+    kind: project
+    spec:
+      id: flytesnacks
+      name: flytesnacks
+      description: Flyte examples
+      labels:
+        values:
+          team: ml
+
+Matchable attributes are declared with the same format as the attribute files of the update commands, for the
+project, the project and domain or the workflow they apply to. The supported kinds are *task-resource-attribute*,
+*cluster-resource-attribute*, *execution-queue-attribute*, *execution-cluster-label*, *plugin-override*,
+*workflow-execution-config*, *quality-of-service* and *cluster-assignment*:
+
+.. code-block:: yaml
+
+    kind: task-resource-attribute
+    spec:
+      project: flytesnacks
+      domain: development
+      defaults:
+        cpu: "1"
+        memory: 150Mi
+      limits:
+        cpu: "2"
+        memory: 450Mi
+    ---
+    kind: quality-of-service
+    spec:
+      project: flytesnacks
+      domain: production
+      tier: HIGH
+    ---
+    kind: cluster-assignment
+    spec:
+      project: flytesnacks
+      cluster_pool_name: gpu
+
+The active version of a launch plan is declared with the *launchplan* kind. All the versions of the launch plan are
+deactivated if no active version is declared:
+
+.. code-block:: yaml
+
+    kind: launchplan
+    spec:
+      project: flytesnacks
+      domain: production
+      name: core.control_flow.merge_sort.merge_sort
+      activeVersion: v2
+
+Apply all the manifests of a directory, recursively:
+::
+
+ flytectl apply -f flyte-config/
+
+Show the changes without applying them:
+::
+
+ flytectl apply -f flyte-config/ --dryRun
+
+Also delete the matchable attributes of the projects declared in the manifests that are not declared themselves.
+Projects that don't appear in the manifests are left untouched:
+::
+
+ flytectl apply -f flyte-config/ --prune --force
+
+Usage
+`
+)
+
+// CreateApplyCommand will return apply command
+func CreateApplyCommand() map[string]cmdCore.CommandEntry {
+	applyResourcesFuncs := map[string]cmdCore.CommandEntry{
+		"apply": {
+			Short:                    applyShort,
+			Long:                     applyLong,
+			CmdFunc:                  applyFunc,
+			PFlagProvider:            applyconfig.DefaultConfig,
+			ProjectDomainNotRequired: true,
+		},
+	}
+	return applyResourcesFuncs
+}
+
+// applyOrder returns the rank of the resource in the plan, so that projects exist before their attributes are updated.
+func applyOrder(r resource) int {
+	switch r.(type) {
+	case *projectResource:
+		return 0
+	case *attributeResource:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func applyFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	cfg := applyconfig.DefaultConfig
+	if len(cfg.Files) == 0 {
+		return fmt.Errorf("at least one manifest file or directory must be passed with -f")
+	}
+
+	resources, err := readManifests(cfg.Files)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return applyOrder(resources[i]) < applyOrder(resources[j])
+	})
+
+	changes, err := plan(ctx, cmdCtx, resources, cfg.Prune)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("No changes detected. All %d resources are up to date.\n", len(resources))
+		return nil
+	}
+
+	for _, c := range changes {
+		fmt.Printf("%s:\n%s\n", c.description, c.patch)
+	}
+	fmt.Printf("%d changes to apply.\n", len(changes))
+
+	if cfg.DryRun {
+		fmt.Printf("Skipping apply (dryRun)\n")
+		return nil
+	}
+
+	if !cfg.Force && !cmdUtil.AskForConfirmation("Continue?", os.Stdin) {
+		return fmt.Errorf("apply aborted by user")
+	}
+
+	for i, c := range changes {
+		if err := c.apply(ctx, cmdCtx); err != nil {
+			return fmt.Errorf("%s failed after %d of %d changes were applied: %w", c.description, i, len(changes), err)
+		}
+		fmt.Printf("Applied: %s\n", c.description)
+	}
+
+	return nil
+}
+
+// plan returns the changes to apply to bring the resources to their declared state.
+func plan(ctx context.Context, cmdCtx cmdCore.CommandContext, resources []resource, prune bool) ([]*change, error) {
+	var changes []*change
+	projects := map[string]bool{}
+	newProjects := map[string]bool{}
+	declared := map[string]bool{}
+	for _, r := range resources {
+		projects[r.project()] = true
+		declared[r.key()] = true
+
+		c, err := r.plan(ctx, cmdCtx, newProjects)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			continue
+		}
+		if c.createsProject {
+			newProjects[r.project()] = true
+		}
+		changes = append(changes, c)
+	}
+
+	if !prune {
+		return changes, nil
+	}
+
+	pruneChanges, err := planPrune(ctx, cmdCtx, projects, declared)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(changes, pruneChanges...), nil
+}
@@ -0,0 +1,268 @@
+package apply
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	applyconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/apply"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	testProject  = "flytesnacks"
+	testDomain   = "development"
+	testWorkflow = "core.control_flow.merge_sort.merge_sort"
+)
+
+func setupApplyConfig(t *testing.T, cfg applyconfig.Config) {
+	previous := *applyconfig.DefaultConfig
+	*applyconfig.DefaultConfig = cfg
+	t.Cleanup(func() {
+		*applyconfig.DefaultConfig = previous
+	})
+}
+
+func currentProject(description string) *admin.Project {
+	return &admin.Project{
+		Id:          testProject,
+		Name:        testProject,
+		Description: description,
+		Labels:      &admin.Labels{Values: map[string]string{"team": "ml"}},
+		Domains:     []*admin.Domain{{Id: testDomain, Name: testDomain}},
+	}
+}
+
+func currentTaskResourceAttributes() *admin.ProjectDomainAttributesGetResponse {
+	return &admin.ProjectDomainAttributesGetResponse{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: testProject,
+			Domain:  testDomain,
+			MatchingAttributes: &admin.MatchingAttributes{
+				Target: &admin.MatchingAttributes_TaskResourceAttributes{
+					TaskResourceAttributes: &admin.TaskResourceAttributes{
+						Defaults: &admin.TaskResourceSpec{Cpu: "1", Memory: "150Mi"},
+						Limits:   &admin.TaskResourceSpec{Cpu: "2", Memory: "450Mi"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expectCurrentState mocks the current state of the resources declared in testdata/manifests. The project description
+// and the active launch plan version differ from the manifests.
+func expectCurrentState(s *testutils.TestStruct, taskResourceAttributes *admin.ProjectDomainAttributesGetResponse) {
+	s.FetcherExt.EXPECT().GetProjectByID(s.Ctx, testProject).Return(currentProject("old description"), nil)
+	if taskResourceAttributes != nil {
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, testProject, testDomain, admin.MatchableResource_TASK_RESOURCE).
+			Return(taskResourceAttributes, nil)
+	} else {
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, testProject, testDomain, admin.MatchableResource_TASK_RESOURCE).
+			Return(nil, ext.NewNotFoundError("attribute"))
+	}
+	s.FetcherExt.EXPECT().FetchWorkflowAttributes(s.Ctx, testProject, testDomain, testWorkflow, admin.MatchableResource_QUALITY_OF_SERVICE_SPECIFICATION).
+		Return(&admin.WorkflowAttributesGetResponse{
+			Attributes: &admin.WorkflowAttributes{
+				MatchingAttributes: &admin.MatchingAttributes{
+					Target: &admin.MatchingAttributes_QualityOfService{
+						QualityOfService: &core.QualityOfService{
+							Designation: &core.QualityOfService_Spec{
+								Spec: &core.QualityOfServiceSpec{QueueingBudget: durationpb.New(time.Hour)},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+	s.FetcherExt.EXPECT().FetchProjectAttributes(s.Ctx, testProject, admin.MatchableResource_CLUSTER_ASSIGNMENT).
+		Return(&admin.ProjectAttributesGetResponse{
+			Attributes: &admin.ProjectAttributes{
+				MatchingAttributes: &admin.MatchingAttributes{
+					Target: &admin.MatchingAttributes_ClusterAssignment{
+						ClusterAssignment: &admin.ClusterAssignment{ClusterPoolName: "gpu"},
+					},
+				},
+			},
+		}, nil)
+	s.MockAdminClient.EXPECT().GetActiveLaunchPlan(s.Ctx, &admin.ActiveLaunchPlanRequest{
+		Id: &admin.NamedEntityIdentifier{Project: testProject, Domain: "production", Name: testWorkflow},
+	}).Return(&admin.LaunchPlan{Id: &core.Identifier{Version: "v1"}}, nil)
+}
+
+func TestReadManifests(t *testing.T) {
+	t.Run("directory", func(t *testing.T) {
+		resources, err := readManifests([]string{"testdata/manifests"})
+		assert.Nil(t, err)
+		var keys []string
+		for _, r := range resources {
+			keys = append(keys, r.key())
+		}
+		assert.Equal(t, []string{
+			"task_resource attributes of flytesnacks/development",
+			"quality_of_service_specification attributes of flytesnacks/development/core.control_flow.merge_sort.merge_sort",
+			"cluster_assignment attributes of flytesnacks",
+			"launch plan flytesnacks/production/core.control_flow.merge_sort.merge_sort",
+			"project flytesnacks",
+		}, keys)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		resources, err := readManifests([]string{"testdata/manifests/project.yaml"})
+		assert.Nil(t, err)
+		assert.Len(t, resources, 1)
+		assert.Equal(t, "Flyte examples", resources[0].(*projectResource).config.GetDescription())
+	})
+
+	t.Run("declared twice", func(t *testing.T) {
+		_, err := readManifests([]string{"testdata/manifests/project.yaml", "testdata/manifests"})
+		assert.EqualError(t, err, "project flytesnacks is declared in both testdata/manifests/project.yaml and testdata/manifests/project.yaml")
+	})
+
+	t.Run("non existent", func(t *testing.T) {
+		_, err := readManifests([]string{"testdata/non-existent"})
+		assert.NotNil(t, err)
+	})
+
+	for file, expectedErr := range map[string]string{
+		"unknown_kind.yaml":   "unknown kind [workflow]",
+		"unknown_field.yaml":  `invalid task-resource-attribute spec: json: unknown field "default"`,
+		"invalid_tier.yaml":   "invalid quality-of-service spec: invalid tier [URGENT]",
+		"duplicate.yaml":      "cluster_assignment attributes of flytesnacks is declared in both testdata/invalid/duplicate.yaml and testdata/invalid/duplicate.yaml",
+		"missing_domain.yaml": "invalid plugin-override spec: domain is required",
+		"missing_spec.yaml":   "project document has no spec",
+	} {
+		t.Run(file, func(t *testing.T) {
+			_, err := readManifests([]string{"testdata/invalid/" + file})
+			assert.ErrorContains(t, err, expectedErr)
+		})
+	}
+}
+
+func TestApplyFunc(t *testing.T) {
+	t.Run("no files", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{})
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.EqualError(t, err, "at least one manifest file or directory must be passed with -f")
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests/attributes"}})
+		expectCurrentState(&s, currentTaskResourceAttributes())
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.TearDownAndVerify(t, "No changes detected. All 3 resources are up to date.")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests"}, DryRun: true})
+		expectCurrentState(&s, nil)
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.UpdaterExt.AssertNotCalled(t, "UpdateProjectDomainAttributes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.MockAdminClient.AssertNotCalled(t, "UpdateProject", mock.Anything, mock.Anything)
+		s.MockAdminClient.AssertNotCalled(t, "UpdateLaunchPlan", mock.Anything, mock.Anything)
+		s.TearDownAndVerifyContains(t, "3 changes to apply.Skipping apply (dryRun)")
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests"}, Force: true})
+		expectCurrentState(&s, nil)
+
+		updatedProject := currentProject("Flyte examples")
+		s.MockAdminClient.EXPECT().UpdateProject(s.Ctx, updatedProject).Return(&admin.ProjectUpdateResponse{}, nil)
+		s.UpdaterExt.EXPECT().UpdateProjectDomainAttributes(s.Ctx, testProject, testDomain,
+			currentTaskResourceAttributes().GetAttributes().GetMatchingAttributes()).Return(nil)
+		s.MockAdminClient.EXPECT().UpdateLaunchPlan(s.Ctx, &admin.LaunchPlanUpdateRequest{
+			Id: &core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      testProject,
+				Domain:       "production",
+				Name:         testWorkflow,
+				Version:      "v2",
+			},
+			State: admin.LaunchPlanState_ACTIVE,
+		}).Return(&admin.LaunchPlanUpdateResponse{}, nil)
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.TearDownAndVerifyContains(t, "Applied: update project flytesnacks"+
+			"Applied: update task_resource attributes of flytesnacks/development"+
+			"Applied: update launch plan flytesnacks/production/core.control_flow.merge_sort.merge_sort")
+	})
+
+	t.Run("apply failure", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests"}, Force: true})
+		expectCurrentState(&s, nil)
+		s.MockAdminClient.EXPECT().UpdateProject(s.Ctx, mock.Anything).Return(nil, fmt.Errorf("unavailable"))
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.EqualError(t, err, "update project flytesnacks failed after 0 of 3 changes were applied: unavailable")
+	})
+
+	t.Run("new project", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests/project.yaml", "testdata/manifests/launchplans.yaml"}, Force: true})
+		s.FetcherExt.EXPECT().GetProjectByID(s.Ctx, testProject).Return(nil, ext.NewNotFoundError("project %s", testProject))
+		s.MockAdminClient.EXPECT().RegisterProject(s.Ctx, mock.Anything).Return(&admin.ProjectRegisterResponse{}, nil)
+		s.MockAdminClient.EXPECT().UpdateLaunchPlan(s.Ctx, mock.Anything).Return(&admin.LaunchPlanUpdateResponse{}, nil)
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.MockAdminClient.AssertNotCalled(t, "GetActiveLaunchPlan", mock.Anything, mock.Anything)
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests/attributes"}, Prune: true, Force: true})
+		expectCurrentState(&s, currentTaskResourceAttributes())
+
+		s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, &admin.ListMatchableAttributesRequest{ResourceType: admin.MatchableResource_EXECUTION_QUEUE}).
+			Return(&admin.ListMatchableAttributesResponse{Configurations: []*admin.MatchableAttributesConfiguration{
+				{Project: testProject, Domain: testDomain, Attributes: &admin.MatchingAttributes{
+					Target: &admin.MatchingAttributes_ExecutionQueueAttributes{
+						ExecutionQueueAttributes: &admin.ExecutionQueueAttributes{Tags: []string{"gpu"}},
+					},
+				}},
+				{Project: "other", Domain: testDomain},
+				{Project: testProject, Domain: testDomain, Workflow: testWorkflow, LaunchPlan: "lp"},
+			}}, nil)
+		s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, &admin.ListMatchableAttributesRequest{ResourceType: admin.MatchableResource_CLUSTER_ASSIGNMENT}).
+			Return(&admin.ListMatchableAttributesResponse{Configurations: []*admin.MatchableAttributesConfiguration{
+				{Project: testProject},
+			}}, nil)
+		s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, mock.Anything).Return(&admin.ListMatchableAttributesResponse{}, nil)
+		s.DeleterExt.EXPECT().DeleteProjectDomainAttributes(s.Ctx, testProject, testDomain, admin.MatchableResource_EXECUTION_QUEUE).Return(nil)
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.DeleterExt.AssertNumberOfCalls(t, "DeleteProjectDomainAttributes", 1)
+		s.TearDownAndVerifyContains(t, "Applied: delete execution_queue attributes of flytesnacks/development")
+	})
+
+	t.Run("prune list failure", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupApplyConfig(t, applyconfig.Config{Files: []string{"testdata/manifests/attributes"}, Prune: true, Force: true})
+		expectCurrentState(&s, currentTaskResourceAttributes())
+		s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, mock.Anything).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+		err := applyFunc(s.Ctx, nil, s.CmdCtx)
+		assert.ErrorContains(t, err, "could not list task_resource attributes")
+	})
+}
@@ -0,0 +1,212 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	sconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand"
+	applyconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/apply"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/clusterresourceattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionclusterlabel"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionqueueattribute"
+	pluginoverride "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/plugin_override"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/workflowexecutionconfig"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	projectKind    = "project"
+	launchPlanKind = "launchplan"
+)
+
+// matchableAttributeConfig is the file config of a matchable attribute, as used by the update commands.
+type matchableAttributeConfig interface {
+	sconfig.MatchableAttributeDecorator
+	sconfig.ProjectDomainWorkflowGetter
+}
+
+type matchableAttributeKind struct {
+	resourceType admin.MatchableResource
+	newConfig    func() matchableAttributeConfig
+}
+
+// matchableAttributeKinds maps the kinds of the matchable attribute documents to their resource type. The kinds are
+// named after the nouns of the update commands and their specs use the same format as the attribute files.
+var matchableAttributeKinds = map[string]matchableAttributeKind{
+	"task-resource-attribute": {admin.MatchableResource_TASK_RESOURCE,
+		func() matchableAttributeConfig { return &taskresourceattribute.TaskResourceAttrFileConfig{} }},
+	"cluster-resource-attribute": {admin.MatchableResource_CLUSTER_RESOURCE,
+		func() matchableAttributeConfig { return &clusterresourceattribute.AttrFileConfig{} }},
+	"execution-queue-attribute": {admin.MatchableResource_EXECUTION_QUEUE,
+		func() matchableAttributeConfig { return &executionqueueattribute.AttrFileConfig{} }},
+	"execution-cluster-label": {admin.MatchableResource_EXECUTION_CLUSTER_LABEL,
+		func() matchableAttributeConfig { return &executionclusterlabel.FileConfig{} }},
+	"quality-of-service": {admin.MatchableResource_QUALITY_OF_SERVICE_SPECIFICATION,
+		func() matchableAttributeConfig { return &applyconfig.QualityOfServiceFileConfig{} }},
+	"plugin-override": {admin.MatchableResource_PLUGIN_OVERRIDE,
+		func() matchableAttributeConfig { return &pluginoverride.FileConfig{} }},
+	"workflow-execution-config": {admin.MatchableResource_WORKFLOW_EXECUTION_CONFIG,
+		func() matchableAttributeConfig { return &workflowexecutionconfig.FileConfig{} }},
+	"cluster-assignment": {admin.MatchableResource_CLUSTER_ASSIGNMENT,
+		func() matchableAttributeConfig { return &applyconfig.ClusterAssignmentFileConfig{} }},
+}
+
+// document is a single YAML document of a manifest.
+type document struct {
+	Kind string          `json:"kind"`
+	Spec json.RawMessage `json:"spec"`
+}
+
+// readManifests reads the resources declared in the given manifest files. Directories are walked recursively for
+// .yaml and .yml files.
+func readManifests(paths []string) ([]resource, error) {
+	var resources []resource
+	declared := map[string]string{}
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			fileResources, err := readManifest(file)
+			if err != nil {
+				return nil, fmt.Errorf("reading manifest %s: %w", file, err)
+			}
+
+			for _, r := range fileResources {
+				if previousFile, found := declared[r.key()]; found {
+					return nil, fmt.Errorf("%s is declared in both %s and %s", r.key(), previousFile, file)
+				}
+				declared[r.key()] = file
+			}
+			resources = append(resources, fileResources...)
+		}
+	}
+
+	return resources, nil
+}
+
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ext := filepath.Ext(file); !d.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, file)
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+func readManifest(file string) ([]resource, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []resource
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var content map[string]interface{}
+		if err := decoder.Decode(&content); errors.Is(err, io.EOF) {
+			return resources, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if content == nil {
+			// Empty document
+			continue
+		}
+
+		r, err := parseDocument(content)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+}
+
+func parseDocument(content map[string]interface{}) (resource, error) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document{}
+	if err := unmarshalStrict(raw, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Spec) == 0 {
+		return nil, fmt.Errorf("%s document has no spec", doc.Kind)
+	}
+
+	switch doc.Kind {
+	case projectKind:
+		project := &admin.Project{}
+		if err := unmarshalStrict(doc.Spec, project); err != nil {
+			return nil, fmt.Errorf("invalid %s spec: %w", doc.Kind, err)
+		}
+		if len(project.GetId()) == 0 {
+			return nil, fmt.Errorf("invalid %s spec: id is required", doc.Kind)
+		}
+		return &projectResource{config: project}, nil
+	case launchPlanKind:
+		launchPlan := &applyconfig.LaunchPlanFileConfig{}
+		if err := unmarshalStrict(doc.Spec, launchPlan); err != nil {
+			return nil, fmt.Errorf("invalid %s spec: %w", doc.Kind, err)
+		}
+		if len(launchPlan.Project) == 0 || len(launchPlan.Domain) == 0 || len(launchPlan.Name) == 0 {
+			return nil, fmt.Errorf("invalid %s spec: project, domain and name are required", doc.Kind)
+		}
+		return &launchPlanResource{config: launchPlan}, nil
+	}
+
+	kind, found := matchableAttributeKinds[doc.Kind]
+	if !found {
+		return nil, fmt.Errorf("unknown kind [%s]", doc.Kind)
+	}
+
+	config := kind.newConfig()
+	if err := unmarshalStrict(doc.Spec, config); err != nil {
+		return nil, fmt.Errorf("invalid %s spec: %w", doc.Kind, err)
+	}
+	if validator, ok := config.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s spec: %w", doc.Kind, err)
+		}
+	}
+	if len(config.GetProject()) == 0 {
+		return nil, fmt.Errorf("invalid %s spec: project is required", doc.Kind)
+	}
+	if len(config.GetDomain()) == 0 && len(config.GetWorkflow()) > 0 {
+		return nil, fmt.Errorf("invalid %s spec: domain is required", doc.Kind)
+	}
+
+	return &attributeResource{resourceType: kind.resourceType, config: config}, nil
+}
+
+func unmarshalStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,266 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	applyconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/apply"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/update"
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	diffPathBefore = "before"
+	diffPathAfter  = "after"
+)
+
+// resource is a Flyte resource declared in a manifest.
+type resource interface {
+	// key uniquely identifies the resource, e.g. to detect resources declared twice.
+	key() string
+	// project returns the project the resource belongs to.
+	project() string
+	// plan compares the declared state of the resource with its current state and returns the change that brings it
+	// to the declared state, or nil if it is up to date. newProjects are the projects that the plan creates, their
+	// resources don't exist yet.
+	plan(ctx context.Context, cmdCtx cmdCore.CommandContext, newProjects map[string]bool) (*change, error)
+}
+
+// change is a planned change to a resource.
+type change struct {
+	description string
+	patch       string
+	apply       func(ctx context.Context, cmdCtx cmdCore.CommandContext) error
+	// Whether the change creates a project.
+	createsProject bool
+}
+
+func newChange(description string, before, after any, apply func(ctx context.Context, cmdCtx cmdCore.CommandContext) error) (*change, error) {
+	patch, err := update.DiffAsYaml(diffPathBefore, diffPathAfter, before, after)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", description, err)
+	}
+	if patch == "" {
+		return nil, nil
+	}
+
+	return &change{description: description, patch: patch, apply: apply}, nil
+}
+
+type projectResource struct {
+	config *admin.Project
+}
+
+func (r *projectResource) key() string {
+	return fmt.Sprintf("project %s", r.config.GetId())
+}
+
+func (r *projectResource) project() string {
+	return r.config.GetId()
+}
+
+func (r *projectResource) plan(ctx context.Context, cmdCtx cmdCore.CommandContext, _ map[string]bool) (*change, error) {
+	current, err := cmdCtx.AdminFetcherExt().GetProjectByID(ctx, r.config.GetId())
+	if ext.IsNotFoundError(err) {
+		desired := proto.Clone(r.config).(*admin.Project)
+		if len(desired.GetName()) == 0 {
+			desired.Name = desired.GetId()
+		}
+
+		c, err := newChange("create "+r.key(), nil, desired, func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+			_, err := cmdCtx.AdminClient().RegisterProject(ctx, &admin.ProjectRegisterRequest{Project: desired})
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.createsProject = true
+		return c, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %w", r.key(), err)
+	}
+
+	// Only the fields that can be declared are compared, e.g. the domains of the project are left as is.
+	desired := proto.Clone(current).(*admin.Project)
+	if len(r.config.GetName()) > 0 {
+		desired.Name = r.config.GetName()
+	}
+	desired.Description = r.config.GetDescription()
+	desired.Labels = r.config.GetLabels()
+	desired.State = r.config.GetState()
+
+	return newChange("update "+r.key(), current, desired, func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+		_, err := cmdCtx.AdminClient().UpdateProject(ctx, desired)
+		return err
+	})
+}
+
+type attributeResource struct {
+	resourceType admin.MatchableResource
+	config       matchableAttributeConfig
+}
+
+func (r *attributeResource) key() string {
+	return attributeKey(r.resourceType, r.config.GetProject(), r.config.GetDomain(), r.config.GetWorkflow())
+}
+
+func (r *attributeResource) project() string {
+	return r.config.GetProject()
+}
+
+func (r *attributeResource) plan(ctx context.Context, cmdCtx cmdCore.CommandContext, newProjects map[string]bool) (*change, error) {
+	var current *admin.MatchingAttributes
+	if !newProjects[r.project()] {
+		var err error
+		current, err = fetchMatchingAttributes(ctx, cmdCtx, r.resourceType, r.config.GetProject(), r.config.GetDomain(), r.config.GetWorkflow())
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch %s: %w", r.key(), err)
+		}
+	}
+
+	desired := r.config.Decorate()
+	project, domain, workflow := r.config.GetProject(), r.config.GetDomain(), r.config.GetWorkflow()
+	return newChange("update "+r.key(), current.GetTarget(), desired.GetTarget(), func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+		switch {
+		case workflow != "":
+			return cmdCtx.AdminUpdaterExt().UpdateWorkflowAttributes(ctx, project, domain, workflow, desired)
+		case domain != "":
+			return cmdCtx.AdminUpdaterExt().UpdateProjectDomainAttributes(ctx, project, domain, desired)
+		default:
+			return cmdCtx.AdminUpdaterExt().UpdateProjectAttributes(ctx, project, desired)
+		}
+	})
+}
+
+// fetchMatchingAttributes returns the matching attributes of the given scope, nil if there are none.
+func fetchMatchingAttributes(ctx context.Context, cmdCtx cmdCore.CommandContext, resourceType admin.MatchableResource,
+	project, domain, workflow string) (*admin.MatchingAttributes, error) {
+
+	var attributes *admin.MatchingAttributes
+	var err error
+	switch {
+	case workflow != "":
+		var response *admin.WorkflowAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchWorkflowAttributes(ctx, project, domain, workflow, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	case domain != "":
+		var response *admin.ProjectDomainAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchProjectDomainAttributes(ctx, project, domain, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	default:
+		var response *admin.ProjectAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchProjectAttributes(ctx, project, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	}
+	if err != nil && !ext.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+func attributeKey(resourceType admin.MatchableResource, project, domain, workflow string) string {
+	scope := []string{project}
+	if domain != "" {
+		scope = append(scope, domain)
+	}
+	if workflow != "" {
+		scope = append(scope, workflow)
+	}
+
+	return fmt.Sprintf("%s attributes of %s", strings.ToLower(resourceType.String()), strings.Join(scope, "/"))
+}
+
+type launchPlanResource struct {
+	config *applyconfig.LaunchPlanFileConfig
+}
+
+func (r *launchPlanResource) key() string {
+	return fmt.Sprintf("launch plan %s/%s/%s", r.config.Project, r.config.Domain, r.config.Name)
+}
+
+func (r *launchPlanResource) project() string {
+	return r.config.Project
+}
+
+func (r *launchPlanResource) plan(ctx context.Context, cmdCtx cmdCore.CommandContext, newProjects map[string]bool) (*change, error) {
+	type launchPlanState struct {
+		ActiveVersion string `json:"activeVersion"`
+	}
+
+	var currentVersion string
+	if !newProjects[r.project()] {
+		active, err := cmdCtx.AdminClient().GetActiveLaunchPlan(ctx, &admin.ActiveLaunchPlanRequest{
+			Id: &admin.NamedEntityIdentifier{Project: r.config.Project, Domain: r.config.Domain, Name: r.config.Name},
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, fmt.Errorf("could not fetch %s: %w", r.key(), err)
+		}
+		currentVersion = active.GetId().GetVersion()
+	}
+
+	// Activating a version deactivates the previously active one.
+	state, version := admin.LaunchPlanState_ACTIVE, r.config.ActiveVersion
+	if len(version) == 0 {
+		state, version = admin.LaunchPlanState_INACTIVE, currentVersion
+	}
+
+	return newChange("update "+r.key(), launchPlanState{currentVersion}, launchPlanState{r.config.ActiveVersion}, func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+		_, err := cmdCtx.AdminClient().UpdateLaunchPlan(ctx, &admin.LaunchPlanUpdateRequest{
+			Id: &core.Identifier{
+				ResourceType: core.ResourceType_LAUNCH_PLAN,
+				Project:      r.config.Project,
+				Domain:       r.config.Domain,
+				Name:         r.config.Name,
+				Version:      version,
+			},
+			State: state,
+		})
+		return err
+	})
+}
+
+// planPrune returns the changes that delete the matchable attributes of the given projects that are not declared.
+func planPrune(ctx context.Context, cmdCtx cmdCore.CommandContext, projects map[string]bool, declared map[string]bool) ([]*change, error) {
+	var changes []*change
+	for value := 0; value < len(admin.MatchableResource_name); value++ {
+		resourceType := admin.MatchableResource(value)
+		response, err := cmdCtx.AdminClient().ListMatchableAttributes(ctx, &admin.ListMatchableAttributesRequest{ResourceType: resourceType})
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s attributes: %w", strings.ToLower(resourceType.String()), err)
+		}
+
+		for _, configuration := range response.GetConfigurations() {
+			project, domain, workflow := configuration.GetProject(), configuration.GetDomain(), configuration.GetWorkflow()
+			key := attributeKey(resourceType, project, domain, workflow)
+			// Launch plan level attributes can't be declared in manifests.
+			if !projects[project] || declared[key] || len(configuration.GetLaunchPlan()) > 0 {
+				continue
+			}
+
+			c, err := newChange("delete "+key, configuration.GetAttributes().GetTarget(), nil, func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+				switch {
+				case workflow != "":
+					return cmdCtx.AdminDeleterExt().DeleteWorkflowAttributes(ctx, project, domain, workflow, resourceType)
+				case domain != "":
+					return cmdCtx.AdminDeleterExt().DeleteProjectDomainAttributes(ctx, project, domain, resourceType)
+				default:
+					return cmdCtx.AdminDeleterExt().DeleteProjectAttributes(ctx, project, resourceType)
+				}
+			})
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, c)
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,146 @@
+package approve
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/get"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	approveShort = "Approves the gate nodes of executions waiting on an approval."
+	approveLong  = `
+Approve a gate node of an execution, using the id of its signal shown by get signal:
+::
+
+ flytectl approve -p flytesnacks -d development --execution oeh94k9r2r approval
+
+Approve all the pending approvals of an execution:
+::
+
+ flytectl approve -p flytesnacks -d development --execution oeh94k9r2r
+
+Approve the pending approvals of all the running executions matching the filter, e.g. in a release pipeline. The
+signal ids restrict the approvals to the given gates:
+::
+
+ flytectl approve -p flytesnacks -d production --filter.fieldSelector="execution.launch_plan_name=release" deploy-approval
+
+Print the approvals that would be given without giving them:
+::
+
+ flytectl approve -p flytesnacks -d production --filter.fieldSelector="execution.launch_plan_name=release" --dryRun
+
+Usage
+`
+)
+
+// CreateApproveCommand will return approve command
+func CreateApproveCommand() map[string]cmdCore.CommandEntry {
+	approveResourcesFuncs := map[string]cmdCore.CommandEntry{
+		"approve": {
+			Short:         approveShort,
+			Long:          approveLong,
+			CmdFunc:       approveFunc,
+			PFlagProvider: signal.DefaultApproveConfig,
+		},
+	}
+	return approveResourcesFuncs
+}
+
+func approveFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	executionIDs, err := getExecutionIDs(ctx, cmdCtx)
+	if err != nil {
+		return err
+	}
+
+	signalIDs := make(map[string]bool, len(args))
+	for _, signalID := range args {
+		signalIDs[signalID] = true
+	}
+	approved := map[string]bool{}
+
+	approvals := 0
+	for _, executionID := range executionIDs {
+		signals, err := get.FetchSignals(ctx, cmdCtx, executionID, true)
+		if err != nil {
+			return err
+		}
+		for _, s := range signals {
+			signalID := s.GetId().GetSignalId()
+			if len(signalIDs) > 0 && !signalIDs[signalID] {
+				continue
+			}
+			if s.GetType().GetSimple() != core.SimpleType_BOOLEAN {
+				if len(signalIDs) > 0 && len(signal.DefaultApproveConfig.Execution) > 0 {
+					return fmt.Errorf("signal %s of execution %s is not an approval, use set signal to give its value", signalID, executionID.GetName())
+				}
+				logger.Debugf(ctx, "Skipping signal %s of execution %s which is not an approval", signalID, executionID.GetName())
+				continue
+			}
+			approved[signalID] = true
+
+			if signal.DefaultApproveConfig.DryRun {
+				fmt.Printf("Would approve signal %s of execution %s\n", signalID, executionID.GetName())
+			} else {
+				if _, err := cmdCtx.ClientSet().SignalServiceClient().SetSignal(ctx, &admin.SignalSetRequest{
+					Id:    s.GetId(),
+					Value: coreutils.MustMakeLiteral(true),
+				}); err != nil {
+					return fmt.Errorf("could not approve signal %s of execution %s: %w", signalID, executionID.GetName(), err)
+				}
+				fmt.Printf("Approved signal %s of execution %s\n", signalID, executionID.GetName())
+			}
+			approvals++
+		}
+	}
+
+	// A missing approval is an error when approving a given execution, whereas other executions matching the filter
+	// may simply not have reached their gates yet.
+	if len(signal.DefaultApproveConfig.Execution) > 0 {
+		for _, signalID := range args {
+			if !approved[signalID] {
+				return fmt.Errorf("no pending approval %s in execution %s", signalID, signal.DefaultApproveConfig.Execution)
+			}
+		}
+	}
+
+	if signal.DefaultApproveConfig.DryRun {
+		fmt.Println("Skipping approval (dryRun)")
+		return nil
+	}
+	fmt.Printf("Approved %d signals of %d executions\n", approvals, len(executionIDs))
+	return nil
+}
+
+// getExecutionIDs returns the given execution, or the running executions matching the filter.
+func getExecutionIDs(ctx context.Context, cmdCtx cmdCore.CommandContext) ([]*core.WorkflowExecutionIdentifier, error) {
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	if len(signal.DefaultApproveConfig.Execution) > 0 {
+		return []*core.WorkflowExecutionIdentifier{{Project: project, Domain: domain, Name: signal.DefaultApproveConfig.Execution}}, nil
+	}
+	// Approving the gates of all the executions of a project is too broad to be done by accident.
+	if len(signal.DefaultApproveConfig.Filter.FieldSelector) == 0 {
+		return nil, fmt.Errorf("either execution or filter.fieldSelector is required to select the executions to approve")
+	}
+
+	executionList, err := cmdCtx.AdminFetcherExt().ListExecution(ctx, project, domain, signal.DefaultApproveConfig.Filter)
+	if err != nil {
+		return nil, err
+	}
+	var executionIDs []*core.WorkflowExecutionIdentifier
+	for _, execution := range executionList.GetExecutions() {
+		if execution.GetClosure().GetPhase() == core.WorkflowExecution_RUNNING {
+			executionIDs = append(executionIDs, execution.GetId())
+		}
+	}
+	return executionIDs, nil
+}
@@ -0,0 +1,119 @@
+package approve
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func executionID(name string) *core.WorkflowExecutionIdentifier {
+	return &core.WorkflowExecutionIdentifier{Project: "dummyProject", Domain: "dummyDomain", Name: name}
+}
+
+func signalID(execution, signal string) *core.SignalIdentifier {
+	return &core.SignalIdentifier{ExecutionId: executionID(execution), SignalId: signal}
+}
+
+func setupSignals(s *testutils.TestStruct, execution string) *mocks.SignalServiceClient {
+	signalClient := s.MockClient.SignalServiceClient().(*mocks.SignalServiceClient)
+	boolean := &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}}
+	signalClient.EXPECT().ListSignals(s.Ctx, &admin.SignalListRequest{WorkflowExecutionId: executionID(execution), Limit: 100}).Return(
+		&admin.SignalList{Signals: []*admin.Signal{
+			{Id: signalID(execution, "qa"), Type: boolean},
+			{Id: signalID(execution, "deploy"), Type: boolean},
+			{Id: signalID(execution, "done"), Type: boolean, Value: coreutils.MustMakeLiteral(true)},
+			{Id: signalID(execution, "title"), Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}}},
+		}}, nil)
+	return signalClient
+}
+
+func approval(execution, signal string) *admin.SignalSetRequest {
+	return &admin.SignalSetRequest{Id: signalID(execution, signal), Value: coreutils.MustMakeLiteral(true)}
+}
+
+func TestApproveCommand(t *testing.T) {
+	approveCommand := CreateApproveCommand()
+	assert.Equal(t, approveCommand["approve"].Short, approveShort)
+	assert.Equal(t, approveCommand["approve"].Long, approveLong)
+}
+
+func TestApproveFunc(t *testing.T) {
+	defer func() { signal.DefaultApproveConfig = &signal.ApproveConfig{Filter: filters.DefaultFilter} }()
+
+	t.Run("execution", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signalClient := setupSignals(&s, "exec")
+		signalClient.EXPECT().SetSignal(s.Ctx, approval("exec", "qa")).Return(&admin.SignalSetResponse{}, nil)
+		signalClient.EXPECT().SetSignal(s.Ctx, approval("exec", "deploy")).Return(&admin.SignalSetResponse{}, nil)
+		signal.DefaultApproveConfig = &signal.ApproveConfig{Execution: "exec"}
+
+		err := approveFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, "Approved signal qa of execution exec\nApproved signal deploy of execution exec\nApproved 2 signals of 1 executions")
+	})
+	t.Run("signal", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signalClient := setupSignals(&s, "exec")
+		signalClient.EXPECT().SetSignal(s.Ctx, approval("exec", "deploy")).Return(&admin.SignalSetResponse{}, nil)
+		signal.DefaultApproveConfig = &signal.ApproveConfig{Execution: "exec"}
+
+		err := approveFunc(s.Ctx, []string{"deploy"}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		signalClient.AssertNumberOfCalls(t, "SetSignal", 1)
+		s.TearDownAndVerify(t, "Approved signal deploy of execution exec\nApproved 1 signals of 1 executions")
+	})
+	t.Run("not pending", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s, "exec")
+		signal.DefaultApproveConfig = &signal.ApproveConfig{Execution: "exec"}
+
+		err := approveFunc(s.Ctx, []string{"done"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "no pending approval done in execution exec")
+	})
+	t.Run("not an approval", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s, "exec")
+		signal.DefaultApproveConfig = &signal.ApproveConfig{Execution: "exec"}
+
+		err := approveFunc(s.Ctx, []string{"title"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "signal title of execution exec is not an approval, use set signal to give its value")
+	})
+	t.Run("filter", func(t *testing.T) {
+		s := testutils.Setup(t)
+		filter := filters.Filters{FieldSelector: "execution.launch_plan_name=release", Limit: 10}
+		s.FetcherExt.EXPECT().ListExecution(s.Ctx, "dummyProject", "dummyDomain", filter).Return(&admin.ExecutionList{
+			Executions: []*admin.Execution{
+				{Id: executionID("running"), Closure: &admin.ExecutionClosure{Phase: core.WorkflowExecution_RUNNING}},
+				{Id: executionID("succeeded"), Closure: &admin.ExecutionClosure{Phase: core.WorkflowExecution_SUCCEEDED}},
+			},
+		}, nil)
+		setupSignals(&s, "running")
+		signal.DefaultApproveConfig = &signal.ApproveConfig{Filter: filter, DryRun: true}
+
+		err := approveFunc(s.Ctx, []string{"deploy", "missing"}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.MockClient.SignalServiceClient().(*mocks.SignalServiceClient).AssertNotCalled(t, "SetSignal", mock.Anything, mock.Anything)
+		s.TearDownAndVerify(t, "Would approve signal deploy of execution running\nSkipping approval (dryRun)")
+	})
+	t.Run("missing selection", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signal.DefaultApproveConfig = &signal.ApproveConfig{Filter: filters.DefaultFilter}
+
+		err := approveFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "either execution or filter.fieldSelector is required to select the executions to approve")
+	})
+}
@@ -3,6 +3,7 @@ package compile
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 
@@ -42,9 +43,9 @@ func compileFromPackage(packagePath string) error {
 	}
 	fmt.Println("Successfully extracted package...")
 	fmt.Println("Processing Protobuf files...")
-	workflows := make(map[string]*admin.WorkflowSpec)
-	plans := make(map[string]*admin.LaunchPlan)
-	tasks := []*admin.TaskSpec{}
+	var tasks []*admin.TaskSpec
+	var workflows []*admin.WorkflowSpec
+	var plans []*admin.LaunchPlan
 
 	for _, pbFilePath := range fileList {
 		rawTsk, err := ioutil.ReadFile(pbFilePath)
@@ -61,22 +62,36 @@ func compileFromPackage(packagePath string) error {
 		case *admin.TaskSpec:
 			tasks = append(tasks, v)
 		case *admin.WorkflowSpec:
-			workflows[v.GetTemplate().GetId().GetName()] = v
+			workflows = append(workflows, v)
 		case *admin.LaunchPlan:
-			plans[v.GetId().GetName()] = v
+			plans = append(plans, v)
 		}
 	}
 
+	return CompileEntities(tasks, workflows, plans, os.Stdout)
+}
+
+// CompileEntities compiles the tasks, workflows and launch plans of a package, writing the progress to out.
+func CompileEntities(tasks []*admin.TaskSpec, workflowSpecs []*admin.WorkflowSpec, planSpecs []*admin.LaunchPlan, out io.Writer) error {
+	workflows := make(map[string]*admin.WorkflowSpec)
+	for _, workflow := range workflowSpecs {
+		workflows[workflow.GetTemplate().GetId().GetName()] = workflow
+	}
+	plans := make(map[string]*admin.LaunchPlan)
+	for _, plan := range planSpecs {
+		plans[plan.GetId().GetName()] = plan
+	}
+
 	// compile tasks
 	taskTemplates := []*core.TaskTemplate{}
 	for _, task := range tasks {
 		taskTemplates = append(taskTemplates, task.GetTemplate())
 	}
 
-	fmt.Println("\nCompiling tasks...")
+	fmt.Fprintln(out, "\nCompiling tasks...")
 	compiledTasks, err := compileTasks(taskTemplates)
 	if err != nil {
-		fmt.Println("Error while compiling tasks...")
+		fmt.Fprintln(out, "Error while compiling tasks...")
 		return err
 	}
 
@@ -85,18 +100,18 @@ func compileFromPackage(packagePath string) error {
 
 	// compile workflows
 	for _, workflow := range workflows {
-		providers, err = handleWorkflow(workflow, compiledTasks, compiledWorkflows, providers, plans, workflows)
+		providers, err = handleWorkflow(workflow, compiledTasks, compiledWorkflows, providers, plans, workflows, out)
 
 		if err != nil {
 			return err
 		}
 	}
 
-	fmt.Println("All Workflows compiled successfully!")
-	fmt.Println("\nSummary:")
-	fmt.Println(len(workflows), " workflows found in package")
-	fmt.Println(len(tasks), " Tasks found in package")
-	fmt.Println(len(plans), " Launch plans found in package")
+	fmt.Fprintln(out, "All Workflows compiled successfully!")
+	fmt.Fprintln(out, "\nSummary:")
+	fmt.Fprintln(out, len(workflows), " workflows found in package")
+	fmt.Fprintln(out, len(tasks), " Tasks found in package")
+	fmt.Fprintln(out, len(plans), " Launch plans found in package")
 	return nil
 }
 
@@ -106,7 +121,8 @@ func handleWorkflow(
 	compiledWorkflows map[string]*core.CompiledWorkflowClosure,
 	compiledLaunchPlanProviders []common.InterfaceProvider,
 	plans map[string]*admin.LaunchPlan,
-	workflows map[string]*admin.WorkflowSpec) ([]common.InterfaceProvider, error) {
+	workflows map[string]*admin.WorkflowSpec,
+	out io.Writer) ([]common.InterfaceProvider, error) {
 	reqs, _ := compiler.GetRequirements(workflow.GetTemplate(), workflow.GetSubWorkflows())
 	wfName := workflow.GetTemplate().GetId().GetName()
 
@@ -118,14 +134,14 @@ func handleWorkflow(
 		if compiledWorkflows[lpWfName] == nil {
 			// Recursively compile the missing workflow first
 			err := error(nil)
-			compiledLaunchPlanProviders, err = handleWorkflow(missingWorkflow, compiledTasks, compiledWorkflows, compiledLaunchPlanProviders, plans, workflows)
+			compiledLaunchPlanProviders, err = handleWorkflow(missingWorkflow, compiledTasks, compiledWorkflows, compiledLaunchPlanProviders, plans, workflows, out)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
-	fmt.Println("\nCompiling workflow:", wfName)
+	fmt.Fprintln(out, "\nCompiling workflow:", wfName)
 
 	wf, err := compiler.CompileWorkflow(workflow.GetTemplate(),
 		workflow.GetSubWorkflows(),
@@ -133,7 +149,7 @@ func handleWorkflow(
 		compiledLaunchPlanProviders)
 
 	if err != nil {
-		fmt.Println(":( Error Compiling workflow:", wfName)
+		fmt.Fprintln(out, ":( Error Compiling workflow:", wfName)
 		return nil, err
 	}
 	compiledWorkflows[wfName] = wf
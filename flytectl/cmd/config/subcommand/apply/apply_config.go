@@ -0,0 +1,28 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// Config stores the flags required by apply command
+type Config struct {
+	Files  []string
+	DryRun bool
+	Force  bool
+	Prune  bool
+}
+
+var (
+	DefaultConfig = &Config{}
+)
+
+func (c *Config) GetPFlagSet(prefix string) *pflag.FlagSet {
+	cmdFlags := pflag.NewFlagSet("Config", pflag.ExitOnError)
+	cmdFlags.StringSliceVarP(&c.Files, fmt.Sprintf("%v%v", prefix, "files"), "f", c.Files, "manifest files or directories to apply. Directories are read recursively.")
+	cmdFlags.BoolVar(&c.DryRun, fmt.Sprintf("%v%v", prefix, "dryRun"), c.DryRun, "show the changes without applying them.")
+	cmdFlags.BoolVar(&c.Force, fmt.Sprintf("%v%v", prefix, "force"), c.Force, "do not ask for an acknowledgement before applying the changes.")
+	cmdFlags.BoolVar(&c.Prune, fmt.Sprintf("%v%v", prefix, "prune"), c.Prune, "delete the matchable attributes of the projects in the manifests that are not declared in the manifests.")
+	return cmdFlags
+}
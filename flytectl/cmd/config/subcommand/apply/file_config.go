@@ -0,0 +1,115 @@
+package apply
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// QualityOfServiceFileConfig shadow Config for QualityOfService.
+// The quality of service is either a tier or a queueing budget, e.g. 1h.
+type QualityOfServiceFileConfig struct {
+	Project        string `json:"project"`
+	Domain         string `json:"domain"`
+	Workflow       string `json:"workflow,omitempty"`
+	Tier           string `json:"tier,omitempty"`
+	QueueingBudget string `json:"queueingBudget,omitempty"`
+}
+
+// Validate checks that exactly one of the tier and the queueing budget is set and that it is valid.
+func (t QualityOfServiceFileConfig) Validate() error {
+	if (len(t.Tier) == 0) == (len(t.QueueingBudget) == 0) {
+		return fmt.Errorf("exactly one of tier and queueingBudget must be set")
+	}
+	if len(t.Tier) > 0 {
+		if _, ok := core.QualityOfService_Tier_value[strings.ToUpper(t.Tier)]; !ok {
+			return fmt.Errorf("invalid tier [%s]", t.Tier)
+		}
+		return nil
+	}
+	if _, err := time.ParseDuration(t.QueueingBudget); err != nil {
+		return fmt.Errorf("invalid queueingBudget [%s]: %w", t.QueueingBudget, err)
+	}
+	return nil
+}
+
+// Decorate decorator over QualityOfService.
+func (t QualityOfServiceFileConfig) Decorate() *admin.MatchingAttributes {
+	qualityOfService := &core.QualityOfService{}
+	if len(t.Tier) > 0 {
+		qualityOfService.Designation = &core.QualityOfService_Tier_{
+			Tier: core.QualityOfService_Tier(core.QualityOfService_Tier_value[strings.ToUpper(t.Tier)]),
+		}
+	} else {
+		queueingBudget, _ := time.ParseDuration(t.QueueingBudget)
+		qualityOfService.Designation = &core.QualityOfService_Spec{
+			Spec: &core.QualityOfServiceSpec{QueueingBudget: durationpb.New(queueingBudget)},
+		}
+	}
+
+	return &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_QualityOfService{
+			QualityOfService: qualityOfService,
+		},
+	}
+}
+
+// GetProject from the QualityOfServiceFileConfig
+func (t QualityOfServiceFileConfig) GetProject() string {
+	return t.Project
+}
+
+// GetDomain from the QualityOfServiceFileConfig
+func (t QualityOfServiceFileConfig) GetDomain() string {
+	return t.Domain
+}
+
+// GetWorkflow from the QualityOfServiceFileConfig
+func (t QualityOfServiceFileConfig) GetWorkflow() string {
+	return t.Workflow
+}
+
+// ClusterAssignmentFileConfig shadow Config for ClusterAssignment.
+type ClusterAssignmentFileConfig struct {
+	Project  string `json:"project"`
+	Domain   string `json:"domain"`
+	Workflow string `json:"workflow,omitempty"`
+	*admin.ClusterAssignment
+}
+
+// Decorate decorator over ClusterAssignment.
+func (t ClusterAssignmentFileConfig) Decorate() *admin.MatchingAttributes {
+	return &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_ClusterAssignment{
+			ClusterAssignment: t.ClusterAssignment,
+		},
+	}
+}
+
+// GetProject from the ClusterAssignmentFileConfig
+func (t ClusterAssignmentFileConfig) GetProject() string {
+	return t.Project
+}
+
+// GetDomain from the ClusterAssignmentFileConfig
+func (t ClusterAssignmentFileConfig) GetDomain() string {
+	return t.Domain
+}
+
+// GetWorkflow from the ClusterAssignmentFileConfig
+func (t ClusterAssignmentFileConfig) GetWorkflow() string {
+	return t.Workflow
+}
+
+// LaunchPlanFileConfig declares the active version of a launch plan. All the versions of the launch plan are
+// deactivated if the active version is empty.
+type LaunchPlanFileConfig struct {
+	Project       string `json:"project"`
+	Domain        string `json:"domain"`
+	Name          string `json:"name"`
+	ActiveVersion string `json:"activeVersion,omitempty"`
+}
@@ -0,0 +1,32 @@
+package diff
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+var (
+	DefaultConfig = &Config{
+		Format: diff.FormatText,
+	}
+)
+
+// Config stores the flags required by the diff commands
+type Config struct {
+	From   string `json:"from" pflag:",Version to compare from."`
+	To     string `json:"to" pflag:",Version to compare to."`
+	Format string `json:"format" pflag:",Output format of the changes, text, json or dot. The dot format is only supported for workflows."`
+}
+
+// Validate returns an error if a version is missing or the output format is unknown.
+func (c Config) Validate() error {
+	if len(c.From) == 0 || len(c.To) == 0 {
+		return fmt.Errorf("from and to versions are required")
+	}
+	if c.Format != diff.FormatText && c.Format != diff.FormatJSON && c.Format != diff.FormatDOT {
+		return fmt.Errorf("invalid format [%s], must be one of %s, %s or %s", c.Format, diff.FormatText, diff.FormatJSON, diff.FormatDOT)
+	}
+	return nil
+}
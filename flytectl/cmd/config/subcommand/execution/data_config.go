@@ -0,0 +1,11 @@
+package execution
+
+//go:generate pflags ExecDataConfig --default-var DefaultExecDataConfig --bind-default-var
+
+var DefaultExecDataConfig = &ExecDataConfig{}
+
+// ExecDataConfig stores the flags required by get execution-data
+type ExecDataConfig struct {
+	NodeID   string `json:"nodeID" pflag:",get the inputs and outputs of the given node instead of the ones of the execution."`
+	Download string `json:"download" pflag:",directory to download the inputs and outputs to. They are printed if not specified."`
+}
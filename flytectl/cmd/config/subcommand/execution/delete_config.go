@@ -1,10 +1,21 @@
 package execution
 
+import "github.com/flyteorg/flyte/flytectl/pkg/filters"
+
 //go:generate pflags ExecDeleteConfig --default-var DefaultExecDeleteConfig --bind-default-var
 
-var DefaultExecDeleteConfig = &ExecDeleteConfig{}
+var DefaultExecDeleteConfig = &ExecDeleteConfig{
+	Filter:      filters.DefaultFilter,
+	Concurrency: 4,
+	Rate:        10,
+}
 
 // ExecutionDeleteConfig stores the flags required by delete execution
 type ExecDeleteConfig struct {
-	DryRun bool `json:"dryRun" pflag:",execute command without making any modifications."`
+	DryRun      bool            `json:"dryRun" pflag:",execute command without making any modifications."`
+	Filter      filters.Filters `json:"filter" pflag:","`
+	Labels      string          `json:"labels" pflag:",comma separated key=value labels of the executions to terminate, along with the filter."`
+	Concurrency int             `json:"concurrency" pflag:",number of executions terminated concurrently when terminating the executions matching the filter."`
+	Rate        int             `json:"rate" pflag:",maximum number of executions terminated per second, 0 for no limit."`
+	Journal     string          `json:"journal" pflag:",path to a progress journal. The executions it records as done are skipped, so that interrupted runs can be resumed."`
 }
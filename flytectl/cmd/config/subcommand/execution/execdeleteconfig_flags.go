@@ -51,5 +51,14 @@ func (ExecDeleteConfig) mustMarshalJSON(v json.Marshaler) string {
 func (cfg ExecDeleteConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags := pflag.NewFlagSet("ExecDeleteConfig", pflag.ExitOnError)
 	cmdFlags.BoolVar(&DefaultExecDeleteConfig.DryRun, fmt.Sprintf("%v%v", prefix, "dryRun"), DefaultExecDeleteConfig.DryRun, "execute command without making any modifications.")
+	cmdFlags.StringVar(&DefaultExecDeleteConfig.Filter.FieldSelector, fmt.Sprintf("%v%v", prefix, "filter.fieldSelector"), DefaultExecDeleteConfig.Filter.FieldSelector, "Specifies the Field selector")
+	cmdFlags.StringVar(&DefaultExecDeleteConfig.Filter.SortBy, fmt.Sprintf("%v%v", prefix, "filter.sortBy"), DefaultExecDeleteConfig.Filter.SortBy, "Specifies which field to sort results ")
+	cmdFlags.Int32Var(&DefaultExecDeleteConfig.Filter.Limit, fmt.Sprintf("%v%v", prefix, "filter.limit"), DefaultExecDeleteConfig.Filter.Limit, "Specifies the limit")
+	cmdFlags.BoolVar(&DefaultExecDeleteConfig.Filter.Asc, fmt.Sprintf("%v%v", prefix, "filter.asc"), DefaultExecDeleteConfig.Filter.Asc, "Specifies the sorting order. By default flytectl sort result in descending order")
+	cmdFlags.Int32Var(&DefaultExecDeleteConfig.Filter.Page, fmt.Sprintf("%v%v", prefix, "filter.page"), DefaultExecDeleteConfig.Filter.Page, "Specifies the page number,  in case there are multiple pages of results")
+	cmdFlags.StringVar(&DefaultExecDeleteConfig.Labels, fmt.Sprintf("%v%v", prefix, "labels"), DefaultExecDeleteConfig.Labels, "comma separated key=value labels of the executions to terminate, along with the filter.")
+	cmdFlags.IntVar(&DefaultExecDeleteConfig.Concurrency, fmt.Sprintf("%v%v", prefix, "concurrency"), DefaultExecDeleteConfig.Concurrency, "number of executions terminated concurrently when terminating the executions matching the filter.")
+	cmdFlags.IntVar(&DefaultExecDeleteConfig.Rate, fmt.Sprintf("%v%v", prefix, "rate"), DefaultExecDeleteConfig.Rate, "maximum number of executions terminated per second, 0 for no limit.")
+	cmdFlags.StringVar(&DefaultExecDeleteConfig.Journal, fmt.Sprintf("%v%v", prefix, "journal"), DefaultExecDeleteConfig.Journal, "path to a progress journal. The executions it records as done are skipped, so that interrupted runs can be resumed.")
 	return cmdFlags
 }
@@ -113,4 +113,130 @@ func TestExecDeleteConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_filter.fieldSelector", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("filter.fieldSelector", testValue)
+			if vString, err := cmdFlags.GetString("filter.fieldSelector"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vString), &actual.Filter.FieldSelector)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_filter.sortBy", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("filter.sortBy", testValue)
+			if vString, err := cmdFlags.GetString("filter.sortBy"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vString), &actual.Filter.SortBy)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_filter.limit", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("filter.limit", testValue)
+			if vInt32, err := cmdFlags.GetInt32("filter.limit"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vInt32), &actual.Filter.Limit)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_filter.asc", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("filter.asc", testValue)
+			if vBool, err := cmdFlags.GetBool("filter.asc"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vBool), &actual.Filter.Asc)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_filter.page", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("filter.page", testValue)
+			if vInt32, err := cmdFlags.GetInt32("filter.page"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vInt32), &actual.Filter.Page)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_labels", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("labels", testValue)
+			if vString, err := cmdFlags.GetString("labels"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vString), &actual.Labels)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_concurrency", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("concurrency", testValue)
+			if vInt, err := cmdFlags.GetInt("concurrency"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vInt), &actual.Concurrency)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_rate", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("rate", testValue)
+			if vInt, err := cmdFlags.GetInt("rate"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vInt), &actual.Rate)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_journal", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("journal", testValue)
+			if vString, err := cmdFlags.GetString("journal"); err == nil {
+				testDecodeJson_ExecDeleteConfig(t, fmt.Sprintf("%v", vString), &actual.Journal)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
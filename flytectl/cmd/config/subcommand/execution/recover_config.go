@@ -0,0 +1,21 @@
+package execution
+
+import "github.com/flyteorg/flyte/flytectl/pkg/filters"
+
+//go:generate pflags ExecRecoverConfig --default-var DefaultExecRecoverConfig --bind-default-var
+
+var DefaultExecRecoverConfig = &ExecRecoverConfig{
+	Filter:      filters.DefaultFilter,
+	Concurrency: 4,
+	Rate:        10,
+}
+
+// ExecRecoverConfig stores the flags required by recover execution
+type ExecRecoverConfig struct {
+	DryRun      bool            `json:"dryRun" pflag:",execute command without making any modifications."`
+	Filter      filters.Filters `json:"filter" pflag:","`
+	Labels      string          `json:"labels" pflag:",comma separated key=value labels of the executions to operate on, along with the filter."`
+	Concurrency int             `json:"concurrency" pflag:",number of executions operated on concurrently."`
+	Rate        int             `json:"rate" pflag:",maximum number of executions operated on per second, 0 for no limit."`
+	Journal     string          `json:"journal" pflag:",path to a progress journal. The executions it records as done are skipped, so that interrupted runs can be resumed."`
+}
@@ -0,0 +1,22 @@
+package execution
+
+import "github.com/flyteorg/flyte/flytectl/pkg/filters"
+
+//go:generate pflags ExecRelaunchConfig --default-var DefaultExecRelaunchConfig --bind-default-var
+
+var DefaultExecRelaunchConfig = &ExecRelaunchConfig{
+	Filter:      filters.DefaultFilter,
+	Concurrency: 4,
+	Rate:        10,
+}
+
+// ExecRelaunchConfig stores the flags required by relaunch execution
+type ExecRelaunchConfig struct {
+	DryRun         bool            `json:"dryRun" pflag:",execute command without making any modifications."`
+	Filter         filters.Filters `json:"filter" pflag:","`
+	Labels         string          `json:"labels" pflag:",comma separated key=value labels of the executions to operate on, along with the filter."`
+	Concurrency    int             `json:"concurrency" pflag:",number of executions operated on concurrently."`
+	Rate           int             `json:"rate" pflag:",maximum number of executions operated on per second, 0 for no limit."`
+	Journal        string          `json:"journal" pflag:",path to a progress journal. The executions it records as done are skipped, so that interrupted runs can be resumed."`
+	OverwriteCache bool            `json:"overwriteCache" pflag:",skip cached results when relaunching and recompute all the outputs."`
+}
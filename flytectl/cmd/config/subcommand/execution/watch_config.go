@@ -0,0 +1,16 @@
+package execution
+
+//go:generate pflags ExecWatchConfig --default-var DefaultExecWatchConfig --bind-default-var
+
+var DefaultExecWatchConfig = &ExecWatchConfig{
+	Interval: "5s",
+	LogLines: 10,
+}
+
+// ExecWatchConfig stores the flags required by watch execution
+type ExecWatchConfig struct {
+	Interval string `json:"interval" pflag:",interval between polls for execution updates e.g. 5s."`
+	Logs     bool   `json:"logs" pflag:",tail the logs of running tasks whose log links are reachable."`
+	LogLines int    `json:"logLines" pflag:",number of log lines to show for each tailed log."`
+	Plain    bool   `json:"plain" pflag:",print updates line by line instead of redrawing the execution tree e.g. in CI pipelines."`
+}
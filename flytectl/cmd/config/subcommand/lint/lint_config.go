@@ -0,0 +1,29 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flyte/flytectl/pkg/lint"
+)
+
+//go:generate pflags Config --default-var DefaultLintConfig --bind-default-var
+var (
+	DefaultLintConfig = &Config{
+		Format: lint.FormatHuman,
+	}
+)
+
+// Config stores the flags required by lint command
+type Config struct {
+	File   string `json:"file" pflag:",Path to a flyte package file. Flyte packages are tgz files generated by pyflyte or jflyte."`
+	Rules  string `json:"rules" pflag:",Path to the YAML configuration of the lint rules. The rules have their default configuration if not set."`
+	Format string `json:"format" pflag:",Output format of the violations, human or sarif."`
+}
+
+// Validate returns an error if the output format is unknown.
+func (c Config) Validate() error {
+	if c.Format != lint.FormatHuman && c.Format != lint.FormatSARIF {
+		return fmt.Errorf("invalid format [%s], must be one of %s or %s", c.Format, lint.FormatHuman, lint.FormatSARIF)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package project
+
+//go:generate pflags ExportConfig --default-var DefaultExportConfig --bind-default-var
+
+var DefaultExportConfig = &ExportConfig{}
+
+// ExportConfig holds the flags of export project.
+type ExportConfig struct {
+	Archive string `json:"archive" pflag:",path of the archive to write. Defaults to <project>-<domain>.tgz."`
+}
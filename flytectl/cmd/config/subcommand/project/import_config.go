@@ -0,0 +1,31 @@
+package project
+
+import "fmt"
+
+//go:generate pflags ImportConfig --default-var DefaultImportConfig --bind-default-var
+
+const (
+	// ConflictSkip skips the entities and attributes that already exist in the target project and domain.
+	ConflictSkip = "skip"
+	// ConflictFail fails the import on the first entity or attribute that already exists in the target project and domain.
+	ConflictFail = "fail"
+)
+
+var DefaultImportConfig = &ImportConfig{
+	OnConflict: ConflictSkip,
+}
+
+// ImportConfig holds the flags of import.
+type ImportConfig struct {
+	Version    string `json:"version" pflag:",version to register all the imported entities with. The exported versions are kept if empty."`
+	OnConflict string `json:"onConflict" pflag:",what to do with the entities and attributes that already exist in the target project and domain: skip or fail."`
+	DryRun     bool   `json:"dryRun" pflag:",execute command without making any modifications."`
+}
+
+// Validate checks the import flags.
+func (c *ImportConfig) Validate() error {
+	if c.OnConflict != ConflictSkip && c.OnConflict != ConflictFail {
+		return fmt.Errorf("invalid onConflict [%s], must be one of %s or %s", c.OnConflict, ConflictSkip, ConflictFail)
+	}
+	return nil
+}
@@ -27,6 +27,8 @@ type FilesConfig struct {
 	DestinationDirectory       string `json:"destinationDirectory" pflag:",Location of source code in container."`
 	DryRun                     bool   `json:"dryRun" pflag:",Execute command without making any modifications."`
 	EnableSchedule             bool   `json:"enableSchedule" pflag:",Enable the schedule if the files contain schedulable launchplan."`
+	Lint                       bool   `json:"lint" pflag:",Lint the files before registering them and fail on lint errors."`
+	LintRules                  string `json:"lintRules" pflag:",Path to the YAML configuration of the lint rules used with --lint."`
 }
 
 func GetConfig() *FilesConfig {
@@ -62,5 +62,7 @@ func (cfg FilesConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.StringVar(&DefaultFilesConfig.DestinationDirectory, fmt.Sprintf("%v%v", prefix, "destinationDirectory"), DefaultFilesConfig.DestinationDirectory, "Location of source code in container.")
 	cmdFlags.BoolVar(&DefaultFilesConfig.DryRun, fmt.Sprintf("%v%v", prefix, "dryRun"), DefaultFilesConfig.DryRun, "Execute command without making any modifications.")
 	cmdFlags.BoolVar(&DefaultFilesConfig.EnableSchedule, fmt.Sprintf("%v%v", prefix, "enableSchedule"), DefaultFilesConfig.EnableSchedule, "Enable the schedule if the files contain schedulable launchplan.")
+	cmdFlags.BoolVar(&DefaultFilesConfig.Lint, fmt.Sprintf("%v%v", prefix, "lint"), DefaultFilesConfig.Lint, "Lint the files before registering them and fail on lint errors.")
+	cmdFlags.StringVar(&DefaultFilesConfig.LintRules, fmt.Sprintf("%v%v", prefix, "lintRules"), DefaultFilesConfig.LintRules, "Path to the YAML configuration of the lint rules used with --lint.")
 	return cmdFlags
 }
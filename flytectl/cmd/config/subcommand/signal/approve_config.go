@@ -0,0 +1,16 @@
+package signal
+
+import "github.com/flyteorg/flyte/flytectl/pkg/filters"
+
+//go:generate pflags ApproveConfig --default-var DefaultApproveConfig --bind-default-var
+
+var DefaultApproveConfig = &ApproveConfig{
+	Filter: filters.DefaultFilter,
+}
+
+// ApproveConfig stores the flags required by approve
+type ApproveConfig struct {
+	Execution string          `json:"execution" pflag:",name of the execution waiting on the approvals."`
+	Filter    filters.Filters `json:"filter" pflag:","`
+	DryRun    bool            `json:"dryRun" pflag:",print the approvals that would be given without setting the signals."`
+}
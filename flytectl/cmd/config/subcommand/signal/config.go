@@ -0,0 +1,11 @@
+package signal
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+
+var DefaultConfig = &Config{}
+
+// Config stores the flags required by get signal
+type Config struct {
+	Execution string `json:"execution" pflag:",name of the execution whose signals are fetched."`
+	All       bool   `json:"all" pflag:",fetch the signals that are already set as well, instead of the pending ones only."`
+}
@@ -0,0 +1,11 @@
+package signal
+
+//go:generate pflags SetConfig --default-var DefaultSetConfig --bind-default-var
+
+var DefaultSetConfig = &SetConfig{}
+
+// SetConfig stores the flags required by set signal
+type SetConfig struct {
+	Execution string `json:"execution" pflag:",name of the execution waiting on the signal."`
+	Value     string `json:"value" pflag:",value of the signal, parsed as YAML against the type of the signal e.g. true, 3 or [1, 2]."`
+}
@@ -3,3 +3,17 @@ package cmdcore
 import "context"
 
 type CommandFunc func(ctx context.Context, args []string, cmdCtx CommandContext) error
+
+// ExitError is returned by commands that make flytectl exit with a specific status code instead of 1.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e ExitError) Unwrap() error {
+	return e.Err
+}
@@ -117,6 +117,13 @@ It is worth noting that the source's and target's project and domain can be diff
 
  flytectl create execution --recover ffb31066a0f8b4d52b77 -p flytesnacks -d development
 
+To re-run a node of the recovered execution and the nodes downstream of it instead of recovering them, e.g. after
+fixing a task it runs, run:
+
+::
+
+ flytectl create execution --recover ffb31066a0f8b4d52b77 --rerunFrom n2 -p flytesnacks -d development
+
 See :ref:` + "`ref_flyteidl.admin.ExecutionRecoverRequest`" + ` for more details.
 
 8. You can create executions idempotently by naming them. This is also a way to *name* an execution for discovery. Note,
@@ -193,6 +200,7 @@ type ExecutionConfig struct {
 	IamRoleARN             string `json:"iamRoleARN" pflag:",iam role ARN AuthRole for launching execution."`
 	Relaunch               string `json:"relaunch" pflag:",execution id to be relaunched."`
 	Recover                string `json:"recover" pflag:",execution id to be recreated from the last known failure point."`
+	RerunFrom              string `json:"rerunFrom" pflag:",id of a node of the recovered execution to re-run with the nodes downstream of it instead of recovering them."`
 	DryRun                 bool   `json:"dryRun" pflag:",execute command without making any modifications."`
 	Version                string `json:"version" pflag:",specify version of execution workflow/task."`
 	ClusterPool            string `json:"clusterPool" pflag:",specify which cluster pool to assign execution to."`
@@ -130,14 +130,18 @@ func recoverExecution(ctx context.Context, executionName string, project string,
 		logger.Debugf(ctx, "skipping RecoverExecution request (DryRun)")
 		return nil
 	}
-	recoveredExec, err := cmdCtx.AdminClient().RecoverExecution(ctx, &admin.ExecutionRecoverRequest{
+	request := &admin.ExecutionRecoverRequest{
 		Id: &core.WorkflowExecutionIdentifier{
 			Name:    executionName,
 			Project: project,
 			Domain:  domain,
 		},
 		Name: targetExecName,
-	})
+	}
+	if len(executionConfig.RerunFrom) > 0 {
+		request.RerunFrom = &admin.RerunFromNode{NodeId: executionConfig.RerunFrom}
+	}
+	recoveredExec, err := cmdCtx.AdminClient().RecoverExecution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -74,6 +74,17 @@ func TestCreateExecutionForRecovery(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCreateExecutionForRecoveryRerunFrom(t *testing.T) {
+	s := testutils.Setup(t)
+
+	createExecutionUtilSetup()
+	executionConfig.RerunFrom = "n2"
+	recoverRequest.RerunFrom = &admin.RerunFromNode{NodeId: "n2"}
+	s.MockAdminClient.EXPECT().RecoverExecution(s.Ctx, recoverRequest).Return(executionCreateResponse, nil)
+	err := recoverExecution(s.Ctx, "execName", config.GetConfig().Project, config.GetConfig().Domain, s.CmdCtx, executionConfig, "")
+	assert.Nil(t, err)
+}
+
 func TestCreateExecutionForRecoveryNotFound(t *testing.T) {
 	s := testutils.Setup(t)
 
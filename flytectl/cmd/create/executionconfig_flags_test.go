@@ -211,6 +211,20 @@ func TestExecutionConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_rerunFrom", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("rerunFrom", testValue)
+			if vString, err := cmdFlags.GetString("rerunFrom"); err == nil {
+				testDecodeJson_ExecutionConfig(t, fmt.Sprintf("%v", vString), &actual.RerunFrom)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_dryRun", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
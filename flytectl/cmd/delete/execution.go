@@ -6,6 +6,7 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
 	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/bulk"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
@@ -56,11 +57,35 @@ Get an execution to find the state of previously terminated executions:
  | p4wv4hwgc4 | recipes.core.basic.lp.go_greet                                          | WORKFLOW | ABORTED   | 2021-02-17T08:14:27.476307400Z | 19.727504400s |
   ------------ ------------------------------------------------------------------------- ---------- ----------- -------------------------------- --------------- 
 
+Terminate all the executions matching a filter, e.g. the running executions of a launch plan started before a date:
+::
+
+ flytectl delete execution -d development -p flytesnacks --filter.fieldSelector="execution.phase=RUNNING,launch_plan.name=core.basic.lp.go_greet,execution.created_at<2021-02-17T00:00:00Z"
+
+The executions can also be selected by their labels, along with the filter:
+::
+
+ flytectl delete execution -d development -p flytesnacks --filter.fieldSelector="execution.phase=RUNNING" --labels team=ml,env=test
+
+Preview the executions matching the filter without terminating them:
+::
+
+ flytectl delete execution -d development -p flytesnacks --filter.fieldSelector="execution.phase=RUNNING" --dryRun
+
+Executions matching a filter are terminated concurrently, 4 at a time and up to 10 per second by default.
+Record the progress in a journal to resume an interrupted run, skipping the executions already terminated:
+::
+
+ flytectl delete execution -d development -p flytesnacks --filter.fieldSelector="execution.phase=RUNNING" --concurrency 8 --rate 20 --journal terminate.jsonl
+
 Usage
 `
 )
 
 func terminateExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) == 0 {
+		return terminateExecutionsByFilter(ctx, cmdCtx)
+	}
 	for i := 0; i < len(args); i++ {
 		name := args[i]
 		logger.Infof(ctx, "Terminating execution of %v execution ", name)
@@ -83,3 +108,27 @@ func terminateExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.C
 	}
 	return nil
 }
+
+// terminateExecutionsByFilter terminates the executions matching the filter and labels.
+func terminateExecutionsByFilter(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+	deleteConfig := execution.DefaultExecDeleteConfig
+	ids, err := bulk.SelectExecutions(ctx, cmdCtx.AdminFetcherExt(), config.GetConfig().Project, config.GetConfig().Domain,
+		deleteConfig.Filter, deleteConfig.Labels)
+	if err != nil {
+		return err
+	}
+	_, err = bulk.Run(ctx, bulk.Operation{
+		Name: "terminate",
+		Done: "Terminated",
+		Run: func(ctx context.Context, id *core.WorkflowExecutionIdentifier) (string, error) {
+			_, err := cmdCtx.AdminClient().TerminateExecution(ctx, &admin.ExecutionTerminateRequest{Id: id})
+			return "", err
+		},
+	}, ids, bulk.Options{
+		Concurrency: deleteConfig.Concurrency,
+		Rate:        deleteConfig.Rate,
+		Journal:     deleteConfig.Journal,
+		DryRun:      deleteConfig.DryRun,
+	})
+	return err
+}
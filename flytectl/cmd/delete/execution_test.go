@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
 	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
@@ -73,3 +75,22 @@ func TestTerminateExecutionFuncWithPartialSuccess(t *testing.T) {
 	s.MockAdminClient.AssertCalled(t, "TerminateExecution", s.Ctx, terminateExecRequests[1])
 	s.TearDownAndVerify(t, "")
 }
+
+func TestTerminateExecutionFuncByFilter(t *testing.T) {
+	s := testutils.Setup(t)
+	t.Cleanup(func() { *execution.DefaultExecDeleteConfig = execution.ExecDeleteConfig{Filter: filters.DefaultFilter, Concurrency: 4, Rate: 10} })
+	*execution.DefaultExecDeleteConfig = execution.ExecDeleteConfig{Filter: filters.DefaultFilter, Concurrency: 1}
+	execution.DefaultExecDeleteConfig.Filter.FieldSelector = "execution.phase=RUNNING"
+
+	id := &core.WorkflowExecutionIdentifier{Project: config.GetConfig().Project, Domain: config.GetConfig().Domain, Name: "exec1"}
+	filter := filters.DefaultFilter
+	filter.FieldSelector = "execution.phase=RUNNING"
+	s.FetcherExt.EXPECT().ListExecution(s.Ctx, config.GetConfig().Project, config.GetConfig().Domain, filter).
+		Return(&admin.ExecutionList{Executions: []*admin.Execution{{Id: id}}}, nil)
+	s.MockAdminClient.EXPECT().TerminateExecution(s.Ctx, &admin.ExecutionTerminateRequest{Id: id}).Return(&admin.ExecutionTerminateResponse{}, nil)
+
+	err := terminateExecutionFunc(s.Ctx, nil, s.CmdCtx)
+	assert.Nil(t, err)
+	s.TearDownAndVerify(t, `Terminated execution exec1
+Terminated 1 executions, 0 failed and 0 skipped`)
+}
@@ -0,0 +1,49 @@
+package diff
+
+import (
+	"fmt"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	diffCmdShort = `Compares two versions of Flyte resources such as workflows, tasks and launch plans.`
+	diffCmdLong  = `
+Report the structural changes between two versions of a workflow:
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.control_flow.merge_sort.merge_sort --from v1 --to v2
+`
+)
+
+// CreateDiffCommand will return diff command
+func CreateDiffCommand() *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: diffCmdShort,
+		Long:  diffCmdLong,
+	}
+
+	diffResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"workflow": {CmdFunc: diffWorkflowFunc, Aliases: []string{"workflows"}, Short: workflowShort,
+			Long: workflowLong, PFlagProvider: config.DefaultConfig},
+		"task": {CmdFunc: diffTaskFunc, Aliases: []string{"tasks"}, Short: taskShort,
+			Long: taskLong, PFlagProvider: config.DefaultConfig},
+		"launchplan": {CmdFunc: diffLaunchPlanFunc, Aliases: []string{"launchplans"}, Short: launchPlanShort,
+			Long: launchPlanLong, PFlagProvider: config.DefaultConfig},
+	}
+
+	cmdcore.AddCommands(diffCmd, diffResourcesFuncs)
+	return diffCmd
+}
+
+// entityName returns the name of the entity whose versions are compared, after validating the flags.
+func entityName(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("the name of the entity to compare is required")
+	}
+	return args[0], config.DefaultConfig.Validate()
+}
@@ -0,0 +1,52 @@
+package diff
+
+import (
+	"sort"
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffCommand(t *testing.T) {
+	diffCommand := CreateDiffCommand()
+	assert.Equal(t, diffCommand.Use, "diff")
+	assert.Equal(t, diffCommand.Short, diffCmdShort)
+	assert.Equal(t, diffCommand.Long, diffCmdLong)
+	assert.Equal(t, len(diffCommand.Commands()), 3)
+	cmdNouns := diffCommand.Commands()
+	// Sort by Use value.
+	sort.Slice(cmdNouns, func(i, j int) bool {
+		return cmdNouns[i].Use < cmdNouns[j].Use
+	})
+	useArray := []string{"launchplan", "task", "workflow"}
+	aliases := [][]string{{"launchplans"}, {"tasks"}, {"workflows"}}
+	shortArray := []string{launchPlanShort, taskShort, workflowShort}
+	longArray := []string{launchPlanLong, taskLong, workflowLong}
+	for i := range cmdNouns {
+		assert.Equal(t, cmdNouns[i].Use, useArray[i])
+		assert.Equal(t, cmdNouns[i].Aliases, aliases[i])
+		assert.Equal(t, cmdNouns[i].Short, shortArray[i])
+		assert.Equal(t, cmdNouns[i].Long, longArray[i])
+	}
+}
+
+func TestEntityName(t *testing.T) {
+	t.Cleanup(func() { *config.DefaultConfig = config.Config{Format: "text"} })
+	*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "text"}
+
+	name, err := entityName([]string{"core.basic.wf"})
+	assert.NoError(t, err)
+	assert.Equal(t, "core.basic.wf", name)
+
+	_, err = entityName(nil)
+	assert.EqualError(t, err, "the name of the entity to compare is required")
+
+	config.DefaultConfig.To = ""
+	_, err = entityName([]string{"core.basic.wf"})
+	assert.EqualError(t, err, "from and to versions are required")
+
+	*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "yaml"}
+	_, err = entityName([]string{"core.basic.wf"})
+	assert.EqualError(t, err, "invalid format [yaml], must be one of text, json or dot")
+}
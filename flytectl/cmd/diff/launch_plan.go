@@ -0,0 +1,47 @@
+package diff
+
+import (
+	"context"
+	"os"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	diffconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+const (
+	launchPlanShort = "Compares two versions of a launch plan."
+	launchPlanLong  = `
+Report the changes between two versions of a launch plan: the workflow it launches, its inputs and their default
+values, its fixed inputs, outputs, schedule and max parallelism.
+::
+
+ flytectl diff launchplan -p flytesnacks -d development core.control_flow.merge_sort.merge_sort --from v1 --to v2
+
+Report the changes in JSON:
+::
+
+ flytectl diff launchplan -p flytesnacks -d development core.control_flow.merge_sort.merge_sort --from v1 --to v2 --format json
+
+Usage
+`
+)
+
+func diffLaunchPlanFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	name, err := entityName(args)
+	if err != nil {
+		return err
+	}
+	from, err := cmdCtx.AdminFetcherExt().FetchLPVersion(ctx, name, diffconfig.DefaultConfig.From,
+		config.GetConfig().Project, config.GetConfig().Domain)
+	if err != nil {
+		return err
+	}
+	to, err := cmdCtx.AdminFetcherExt().FetchLPVersion(ctx, name, diffconfig.DefaultConfig.To,
+		config.GetConfig().Project, config.GetConfig().Domain)
+	if err != nil {
+		return err
+	}
+	return diff.LaunchPlans(from, to).Write(os.Stdout, diffconfig.DefaultConfig.Format)
+}
@@ -0,0 +1,38 @@
+package diff
+
+import (
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func launchPlanVersion(version string, maxParallelism int32) *admin.LaunchPlan {
+	return &admin.LaunchPlan{
+		Id: &core.Identifier{ResourceType: core.ResourceType_LAUNCH_PLAN, Name: "core.basic.lp", Version: version},
+		Spec: &admin.LaunchPlanSpec{
+			WorkflowId:     &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "core.basic.wf", Version: "v1"},
+			MaxParallelism: maxParallelism,
+		},
+	}
+}
+
+func TestDiffLaunchPlanFunc(t *testing.T) {
+	t.Cleanup(func() { *config.DefaultConfig = config.Config{Format: "text"} })
+
+	s := testutils.Setup(t)
+	*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "text"}
+	s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, "core.basic.lp", "v1", "dummyProject", "dummyDomain").
+		Return(launchPlanVersion("v1", 10), nil)
+	s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, "core.basic.lp", "v2", "dummyProject", "dummyDomain").
+		Return(launchPlanVersion("v2", 20), nil)
+
+	err := diffLaunchPlanFunc(s.Ctx, []string{"core.basic.lp"}, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerify(t, `launch plan core.basic.lp v1 -> v2
+~ max parallelism: 10 -> 20
+1 changes, 0 breaking`)
+}
@@ -0,0 +1,47 @@
+package diff
+
+import (
+	"context"
+	"os"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	diffconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+const (
+	taskShort = "Compares two versions of a task."
+	taskLong  = `
+Report the changes between two versions of a task: its type, image, resources, retries, timeout, interruptibility,
+caching, and interface changes and whether they are backward compatible.
+::
+
+ flytectl diff task -p flytesnacks -d development core.control_flow.merge_sort.merge --from v1 --to v2
+
+Report the changes in JSON:
+::
+
+ flytectl diff task -p flytesnacks -d development core.control_flow.merge_sort.merge --from v1 --to v2 --format json
+
+Usage
+`
+)
+
+func diffTaskFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	name, err := entityName(args)
+	if err != nil {
+		return err
+	}
+	from, err := cmdCtx.AdminFetcherExt().FetchTaskVersion(ctx, name, diffconfig.DefaultConfig.From,
+		config.GetConfig().Project, config.GetConfig().Domain)
+	if err != nil {
+		return err
+	}
+	to, err := cmdCtx.AdminFetcherExt().FetchTaskVersion(ctx, name, diffconfig.DefaultConfig.To,
+		config.GetConfig().Project, config.GetConfig().Domain)
+	if err != nil {
+		return err
+	}
+	return diff.Tasks(from, to).Write(os.Stdout, diffconfig.DefaultConfig.Format)
+}
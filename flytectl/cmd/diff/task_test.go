@@ -0,0 +1,63 @@
+package diff
+
+import (
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func taskVersion(version, image string) *admin.Task {
+	id := &core.Identifier{ResourceType: core.ResourceType_TASK, Name: "core.basic.t1", Version: version}
+	return &admin.Task{
+		Id: id,
+		Closure: &admin.TaskClosure{CompiledTask: &core.CompiledTask{Template: &core.TaskTemplate{
+			Id:     id,
+			Target: &core.TaskTemplate_Container{Container: &core.Container{Image: image}},
+		}}},
+	}
+}
+
+func TestDiffTaskFunc(t *testing.T) {
+	t.Cleanup(func() { *config.DefaultConfig = config.Config{Format: "text"} })
+
+	t.Run("Changes", func(t *testing.T) {
+		s := testutils.Setup(t)
+		*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "json"}
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "core.basic.t1", "v1", "dummyProject", "dummyDomain").
+			Return(taskVersion("v1", "image:v1"), nil)
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "core.basic.t1", "v2", "dummyProject", "dummyDomain").
+			Return(taskVersion("v2", "image:v2"), nil)
+
+		err := diffTaskFunc(s.Ctx, []string{"core.basic.t1"}, s.CmdCtx)
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `{
+  "entity": "task core.basic.t1",
+  "from": "v1",
+  "to": "v2",
+  "changes": [
+    {
+      "kind": "changed",
+      "path": "image",
+      "from": "image:v1",
+      "to": "image:v2"
+    }
+  ]
+}`)
+	})
+
+	t.Run("DOT format", func(t *testing.T) {
+		s := testutils.Setup(t)
+		*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "dot"}
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "core.basic.t1", "v1", "dummyProject", "dummyDomain").
+			Return(taskVersion("v1", "image:v1"), nil)
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "core.basic.t1", "v2", "dummyProject", "dummyDomain").
+			Return(taskVersion("v2", "image:v2"), nil)
+
+		err := diffTaskFunc(s.Ctx, []string{"core.basic.t1"}, s.CmdCtx)
+		assert.EqualError(t, err, "dot format is only supported for workflows")
+	})
+}
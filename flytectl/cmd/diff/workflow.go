@@ -0,0 +1,69 @@
+package diff
+
+import (
+	"context"
+	"os"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	diffconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+const (
+	workflowShort = "Compares two versions of a workflow."
+	workflowLong  = `
+Report the structural changes between two versions of a workflow: added or removed nodes, changed edges, interface
+changes and whether they are backward compatible, as well as the changes of the tasks the workflow runs such as their
+images and resources.
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.control_flow.merge_sort.merge_sort --from v1 --to v2
+
+For example:
+
+::
+
+ workflow core.control_flow.merge_sort.merge_sort v1 -> v2
+ ~ input numbers: list[integer] -> list[float]
+ + node n3: task core.control_flow.merge_sort.report
+ + edge n2 -> n3
+ ~ task core.control_flow.merge_sort.sort image: ghcr.io/flyteorg/flytecookbook:v1 -> ghcr.io/flyteorg/flytecookbook:v2
+ ~ task core.control_flow.merge_sort.sort memory limit: 500Mi -> 1Gi
+ 5 changes, 0 breaking
+
+Changes that are not backward compatible, e.g. removed inputs or types the values of the previous version can't be cast
+to, are marked as breaking.
+
+Report the changes in JSON:
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.control_flow.merge_sort.merge_sort --from v1 --to v2 --format json
+
+Render the graph of the newer version in DOT format, with added nodes and edges in green, changed ones in orange and
+removed ones dashed in red:
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.control_flow.merge_sort.merge_sort --from v1 --to v2 --format dot | dot -Tsvg > diff.svg
+
+Usage
+`
+)
+
+func diffWorkflowFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	name, err := entityName(args)
+	if err != nil {
+		return err
+	}
+	from, err := cmdCtx.AdminFetcherExt().FetchWorkflowVersion(ctx, name, diffconfig.DefaultConfig.From,
+		config.GetConfig().Project, config.GetConfig().Domain)
+	if err != nil {
+		return err
+	}
+	to, err := cmdCtx.AdminFetcherExt().FetchWorkflowVersion(ctx, name, diffconfig.DefaultConfig.To,
+		config.GetConfig().Project, config.GetConfig().Domain)
+	if err != nil {
+		return err
+	}
+	return diff.Workflows(from, to).Write(os.Stdout, diffconfig.DefaultConfig.Format)
+}
@@ -0,0 +1,53 @@
+package diff
+
+import (
+	"fmt"
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/diff"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func workflowVersion(version string, nodes ...string) *admin.Workflow {
+	id := &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "core.basic.wf", Version: version}
+	template := &core.WorkflowTemplate{Id: id}
+	for _, node := range nodes {
+		template.Nodes = append(template.Nodes, &core.Node{Id: node, Target: &core.Node_GateNode{GateNode: &core.GateNode{}}})
+	}
+	return &admin.Workflow{
+		Id:      id,
+		Closure: &admin.WorkflowClosure{CompiledWorkflow: &core.CompiledWorkflowClosure{Primary: &core.CompiledWorkflow{Template: template}}},
+	}
+}
+
+func TestDiffWorkflowFunc(t *testing.T) {
+	t.Cleanup(func() { *config.DefaultConfig = config.Config{Format: "text"} })
+
+	t.Run("Changes", func(t *testing.T) {
+		s := testutils.Setup(t)
+		*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "text"}
+		s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, "core.basic.wf", "v1", "dummyProject", "dummyDomain").
+			Return(workflowVersion("v1", "n0"), nil)
+		s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, "core.basic.wf", "v2", "dummyProject", "dummyDomain").
+			Return(workflowVersion("v2", "n0", "n1"), nil)
+
+		err := diffWorkflowFunc(s.Ctx, []string{"core.basic.wf"}, s.CmdCtx)
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `workflow core.basic.wf v1 -> v2
++ node n1: gate
+1 changes, 0 breaking`)
+	})
+
+	t.Run("Fetch error", func(t *testing.T) {
+		s := testutils.Setup(t)
+		*config.DefaultConfig = config.Config{From: "v1", To: "v2", Format: "text"}
+		s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, "core.basic.wf", "v1", "dummyProject", "dummyDomain").
+			Return(nil, fmt.Errorf("not found"))
+
+		err := diffWorkflowFunc(s.Ctx, []string{"core.basic.wf"}, s.CmdCtx)
+		assert.EqualError(t, err, "not found")
+	})
+}
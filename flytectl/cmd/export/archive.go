@@ -0,0 +1,81 @@
+package export
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path"
+	"time"
+)
+
+// Layout of the archives written by export project and read by import.
+const (
+	// MetadataFile describes the exported project and domain.
+	MetadataFile = "metadata.json"
+	// EntitiesDir holds the task, workflow and launch plan specs in registration order. They are named like the files
+	// serialized by pyflyte, i.e. xxx_1.pb for tasks, xxx_2.pb for workflows and xxx_3.pb for launch plans.
+	EntitiesDir = "entities"
+	// BundlesDir holds the code bundles of the fast registered tasks, which reference them by their path in the archive.
+	BundlesDir = "bundles"
+	// AttributesFile holds the matchable attributes as an admin.ListMatchableAttributesResponse.
+	AttributesFile = "attributes.pb"
+	// FastRegisterDistributionArg precedes the location of the code bundle in the arguments of fast registered tasks.
+	FastRegisterDistributionArg = "--additional-distribution"
+)
+
+// Metadata describes an exported project and domain.
+type Metadata struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+}
+
+// archiveWriter writes files to a gzipped tarball, along with the headers of their directories so that the archive can
+// be extracted like the archives of register files.
+type archiveWriter struct {
+	file      *os.File
+	gzip      *gzip.Writer
+	tar       *tar.Writer
+	dirs      map[string]bool
+	createdAt time.Time
+}
+
+func newArchiveWriter(name string) (*archiveWriter, error) {
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	gzipWriter := gzip.NewWriter(file)
+	return &archiveWriter{
+		file:      file,
+		gzip:      gzipWriter,
+		tar:       tar.NewWriter(gzipWriter),
+		dirs:      map[string]bool{},
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (w *archiveWriter) writeFile(name string, data []byte) error {
+	if dir := path.Dir(name); dir != "." && !w.dirs[dir] {
+		if err := w.tar.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: dir + "/", Mode: 0755, ModTime: w.createdAt}); err != nil {
+			return err
+		}
+		w.dirs[dir] = true
+	}
+
+	if err := w.tar.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0644, Size: int64(len(data)), ModTime: w.createdAt}); err != nil {
+		return err
+	}
+	_, err := w.tar.Write(data)
+	return err
+}
+
+func (w *archiveWriter) Close() error {
+	if err := w.tar.Close(); err != nil {
+		return err
+	}
+	if err := w.gzip.Close(); err != nil {
+		return err
+	}
+	return w.file.Close()
+}
@@ -0,0 +1,36 @@
+package export
+
+import (
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	exportCmdShort = `Exports Flyte resources to a portable archive.`
+	exportCmdLong  = `
+Export the tasks, workflows, launch plans and matchable attributes of a project and domain, e.g. to import them into
+another Flyte deployment:
+::
+
+ flytectl export project -p flytesnacks -d development --archive flytesnacks.tgz
+`
+)
+
+// CreateExportCommand will return export command
+func CreateExportCommand() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: exportCmdShort,
+		Long:  exportCmdLong,
+	}
+
+	exportResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"project": {CmdFunc: exportProjectFunc, Aliases: []string{"projects"}, Short: projectShort,
+			Long: projectLong, PFlagProvider: project.DefaultExportConfig},
+	}
+
+	cmdcore.AddCommands(exportCmd, exportResourcesFuncs)
+	return exportCmd
+}
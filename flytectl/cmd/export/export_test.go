@@ -0,0 +1,20 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportCommand(t *testing.T) {
+	exportCommand := CreateExportCommand()
+	assert.Equal(t, exportCommand.Use, "export")
+	assert.Equal(t, exportCommand.Short, exportCmdShort)
+	assert.Equal(t, exportCommand.Long, exportCmdLong)
+	assert.Equal(t, len(exportCommand.Commands()), 1)
+	cmdNouns := exportCommand.Commands()
+	assert.Equal(t, cmdNouns[0].Use, "project")
+	assert.Equal(t, cmdNouns[0].Aliases, []string{"projects"})
+	assert.Equal(t, cmdNouns[0].Short, projectShort)
+	assert.Equal(t, cmdNouns[0].Long, projectLong)
+}
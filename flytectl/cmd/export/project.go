@@ -0,0 +1,413 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	projectconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	projectShort = "Exports the entities and matchable attributes of a project and domain."
+	projectLong  = `
+Export all the versions of the tasks, workflows and launch plans of a project and domain, along with their description
+entities, the active state of the launch plans and the matchable attributes of the project and of the domain, to a
+portable archive. The code bundles of the tasks that were fast registered are downloaded through the data proxy and
+added to the archive.
+The archive can be imported into another project, domain or Flyte deployment with the import command.
+
+::
+
+ flytectl export project -p flytesnacks -d development
+
+Choose the path of the archive, which defaults to <project>-<domain>.tgz:
+
+::
+
+ flytectl export project -p flytesnacks -d development --archive flytesnacks.tgz
+
+Usage
+`
+)
+
+const pageSize = 100
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// entity is an exported task, workflow or launch plan version.
+type entity struct {
+	id        *core.Identifier
+	createdAt *timestamppb.Timestamp
+	spec      proto.Message
+}
+
+type projectExporter struct {
+	cmdCtx  cmdCore.CommandContext
+	project string
+	domain  string
+	// bundles maps the remote locations of the code bundles to their path in the archive.
+	bundles     map[string]string
+	bundleFiles map[string][]byte
+}
+
+func exportProjectFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	archive := projectconfig.DefaultExportConfig.Archive
+	if len(archive) == 0 {
+		archive = fmt.Sprintf("%s-%s.tgz", project, domain)
+	}
+
+	e := &projectExporter{
+		cmdCtx:      cmdCtx,
+		project:     project,
+		domain:      domain,
+		bundles:     map[string]string{},
+		bundleFiles: map[string][]byte{},
+	}
+
+	entities, err := e.fetchEntities(ctx)
+	if err != nil {
+		return err
+	}
+	attributes, err := e.fetchAttributes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := e.writeArchive(archive, entities, attributes); err != nil {
+		if removeErr := os.Remove(archive); removeErr != nil && !os.IsNotExist(removeErr) {
+			logger.Errorf(ctx, "unable to delete incomplete archive %v due to %v", archive, removeErr)
+		}
+		return fmt.Errorf("failed to write archive %s: %w", archive, err)
+	}
+
+	fmt.Printf("Exported %d entities, %d code bundles and %d matchable attributes of %s/%s to %s\n",
+		len(entities), len(e.bundleFiles), len(attributes.GetConfigurations()), project, domain, archive)
+	return nil
+}
+
+func (e *projectExporter) writeArchive(name string, entities []*entity, attributes *admin.ListMatchableAttributesResponse) error {
+	w, err := newArchiveWriter(name)
+	if err != nil {
+		return err
+	}
+	if err := e.writeFiles(w, entities, attributes); err != nil {
+		_ = w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+func (e *projectExporter) writeFiles(w *archiveWriter, entities []*entity, attributes *admin.ListMatchableAttributesResponse) error {
+	metadata, err := json.Marshal(Metadata{Project: e.project, Domain: e.domain})
+	if err != nil {
+		return err
+	}
+	if err := w.writeFile(MetadataFile, metadata); err != nil {
+		return err
+	}
+
+	for i, exported := range entities {
+		data, err := proto.Marshal(exported.spec)
+		if err != nil {
+			return err
+		}
+		fileName := fmt.Sprintf("%s/%06d_%s_%s_%d.pb", EntitiesDir, i, unsafeFileNameChars.ReplaceAllString(exported.id.GetName(), "_"),
+			unsafeFileNameChars.ReplaceAllString(exported.id.GetVersion(), "_"), exported.id.GetResourceType())
+		if err := w.writeFile(fileName, data); err != nil {
+			return err
+		}
+	}
+
+	bundles := make([]string, 0, len(e.bundleFiles))
+	for bundle := range e.bundleFiles {
+		bundles = append(bundles, bundle)
+	}
+	sort.Strings(bundles)
+	for _, bundle := range bundles {
+		if err := w.writeFile(bundle, e.bundleFiles[bundle]); err != nil {
+			return err
+		}
+	}
+
+	data, err := proto.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	return w.writeFile(AttributesFile, data)
+}
+
+// fetchEntities returns all the versions of the tasks, workflows and launch plans in the order they were created, so
+// that the entities they reference are registered before them on import.
+func (e *projectExporter) fetchEntities(ctx context.Context) ([]*entity, error) {
+	var entities []*entity
+	for _, resourceType := range []core.ResourceType{core.ResourceType_TASK, core.ResourceType_WORKFLOW, core.ResourceType_LAUNCH_PLAN} {
+		ids, err := e.listIDs(ctx, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			var versions []*entity
+			switch resourceType {
+			case core.ResourceType_TASK:
+				versions, err = e.fetchTasks(ctx, id)
+			case core.ResourceType_WORKFLOW:
+				versions, err = e.fetchWorkflows(ctx, id)
+			default:
+				versions, err = e.fetchLaunchPlans(ctx, id)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("could not export %s %s: %w", resourceName(resourceType), id.GetName(), err)
+			}
+			entities = append(entities, versions...)
+		}
+	}
+
+	sort.SliceStable(entities, func(i, j int) bool {
+		if c := entities[i].createdAt.AsTime().Compare(entities[j].createdAt.AsTime()); c != 0 {
+			return c < 0
+		}
+		return entities[i].id.GetResourceType() < entities[j].id.GetResourceType()
+	})
+	return entities, nil
+}
+
+func (e *projectExporter) listIDs(ctx context.Context, resourceType core.ResourceType) ([]*admin.NamedEntityIdentifier, error) {
+	var ids []*admin.NamedEntityIdentifier
+	request := &admin.NamedEntityIdentifierListRequest{Project: e.project, Domain: e.domain, Limit: pageSize}
+	for {
+		var list *admin.NamedEntityIdentifierList
+		var err error
+		switch resourceType {
+		case core.ResourceType_TASK:
+			list, err = e.cmdCtx.AdminClient().ListTaskIds(ctx, request)
+		case core.ResourceType_WORKFLOW:
+			list, err = e.cmdCtx.AdminClient().ListWorkflowIds(ctx, request)
+		default:
+			list, err = e.cmdCtx.AdminClient().ListLaunchPlanIds(ctx, request)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s names of %s/%s: %w", resourceName(resourceType), e.project, e.domain, err)
+		}
+
+		ids = append(ids, list.GetEntities()...)
+		if len(list.GetToken()) == 0 {
+			return ids, nil
+		}
+		request.Token = list.GetToken()
+	}
+}
+
+func resourceName(resourceType core.ResourceType) string {
+	switch resourceType {
+	case core.ResourceType_TASK:
+		return "task"
+	case core.ResourceType_WORKFLOW:
+		return "workflow"
+	default:
+		return "launch plan"
+	}
+}
+
+func (e *projectExporter) fetchTasks(ctx context.Context, id *admin.NamedEntityIdentifier) ([]*entity, error) {
+	var entities []*entity
+	request := &admin.ResourceListRequest{Id: id, Limit: pageSize}
+	for {
+		list, err := e.cmdCtx.AdminClient().ListTasks(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range list.GetTasks() {
+			description, err := e.fetchDescription(ctx, task.GetId())
+			if err != nil {
+				return nil, err
+			}
+			spec := &admin.TaskSpec{
+				Template:    proto.Clone(task.GetClosure().GetCompiledTask().GetTemplate()).(*core.TaskTemplate),
+				Description: description,
+			}
+			if err := e.exportBundle(ctx, spec.GetTemplate()); err != nil {
+				return nil, err
+			}
+			entities = append(entities, &entity{id: task.GetId(), createdAt: task.GetClosure().GetCreatedAt(), spec: spec})
+		}
+
+		if len(list.GetToken()) == 0 {
+			return entities, nil
+		}
+		request.Token = list.GetToken()
+	}
+}
+
+func (e *projectExporter) fetchWorkflows(ctx context.Context, id *admin.NamedEntityIdentifier) ([]*entity, error) {
+	var entities []*entity
+	request := &admin.ResourceListRequest{Id: id, Limit: pageSize}
+	for {
+		list, err := e.cmdCtx.AdminClient().ListWorkflows(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, listed := range list.GetWorkflows() {
+			// The compiled workflows are only returned when fetching a single workflow.
+			workflow, err := e.cmdCtx.AdminClient().GetWorkflow(ctx, &admin.ObjectGetRequest{Id: listed.GetId()})
+			if err != nil {
+				return nil, err
+			}
+			description, err := e.fetchDescription(ctx, workflow.GetId())
+			if err != nil {
+				return nil, err
+			}
+
+			compiled := workflow.GetClosure().GetCompiledWorkflow()
+			spec := &admin.WorkflowSpec{
+				Template:    compiled.GetPrimary().GetTemplate(),
+				Description: description,
+			}
+			for _, subWorkflow := range compiled.GetSubWorkflows() {
+				spec.SubWorkflows = append(spec.SubWorkflows, subWorkflow.GetTemplate())
+			}
+			entities = append(entities, &entity{id: workflow.GetId(), createdAt: workflow.GetClosure().GetCreatedAt(), spec: spec})
+		}
+
+		if len(list.GetToken()) == 0 {
+			return entities, nil
+		}
+		request.Token = list.GetToken()
+	}
+}
+
+func (e *projectExporter) fetchLaunchPlans(ctx context.Context, id *admin.NamedEntityIdentifier) ([]*entity, error) {
+	var entities []*entity
+	request := &admin.ResourceListRequest{Id: id, Limit: pageSize}
+	for {
+		list, err := e.cmdCtx.AdminClient().ListLaunchPlans(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, launchPlan := range list.GetLaunchPlans() {
+			// Only the state of the closure is exported, to activate the launch plan on import.
+			spec := &admin.LaunchPlan{
+				Id:      launchPlan.GetId(),
+				Spec:    launchPlan.GetSpec(),
+				Closure: &admin.LaunchPlanClosure{State: launchPlan.GetClosure().GetState()},
+			}
+			entities = append(entities, &entity{id: launchPlan.GetId(), createdAt: launchPlan.GetClosure().GetCreatedAt(), spec: spec})
+		}
+
+		if len(list.GetToken()) == 0 {
+			return entities, nil
+		}
+		request.Token = list.GetToken()
+	}
+}
+
+// fetchDescription returns the description entity of the task or workflow, nil if it has none.
+func (e *projectExporter) fetchDescription(ctx context.Context, id *core.Identifier) (*admin.DescriptionEntity, error) {
+	description, err := e.cmdCtx.AdminClient().GetDescriptionEntity(ctx, &admin.ObjectGetRequest{Id: id})
+	if code := status.Code(err); code == codes.NotFound || code == codes.Unimplemented {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not fetch the description of %s version %s: %w", id.GetName(), id.GetVersion(), err)
+	}
+
+	return description, nil
+}
+
+// exportBundle adds the code bundle of a fast registered task to the archive and references it by its path in the
+// archive in the task arguments.
+func (e *projectExporter) exportBundle(ctx context.Context, template *core.TaskTemplate) error {
+	args := template.GetContainer().GetArgs()
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] != FastRegisterDistributionArg {
+			continue
+		}
+
+		location := args[i+1]
+		bundle, found := e.bundles[location]
+		if !found {
+			data, err := e.downloadBundle(ctx, location)
+			if err != nil {
+				return fmt.Errorf("could not download the code bundle %s of task %s: %w", location, template.GetId().GetName(), err)
+			}
+
+			bundle = path.Join(BundlesDir, path.Base(location))
+			if _, taken := e.bundleFiles[bundle]; taken {
+				bundle = path.Join(BundlesDir, fmt.Sprintf("%d-%s", len(e.bundleFiles), path.Base(location)))
+			}
+			e.bundles[location] = bundle
+			e.bundleFiles[bundle] = data
+		}
+		args[i+1] = bundle
+	}
+
+	return nil
+}
+
+func (e *projectExporter) downloadBundle(ctx context.Context, location string) ([]byte, error) {
+	resp, err := e.cmdCtx.ClientSet().DataProxyClient().CreateDownloadLocation(ctx, &service.CreateDownloadLocationRequest{NativeUrl: location})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.GetSignedUrl(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %s", res.Status)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+// fetchAttributes returns the matchable attributes of the project and of the project and domain. Launch plan level
+// attributes are left out since they can't be updated with the admin client extensions.
+func (e *projectExporter) fetchAttributes(ctx context.Context) (*admin.ListMatchableAttributesResponse, error) {
+	attributes := &admin.ListMatchableAttributesResponse{}
+	for value := 0; value < len(admin.MatchableResource_name); value++ {
+		resourceType := admin.MatchableResource(value)
+		response, err := e.cmdCtx.AdminClient().ListMatchableAttributes(ctx, &admin.ListMatchableAttributesRequest{ResourceType: resourceType})
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s attributes: %w", strings.ToLower(resourceType.String()), err)
+		}
+
+		for _, configuration := range response.GetConfigurations() {
+			if configuration.GetProject() != e.project || len(configuration.GetLaunchPlan()) > 0 ||
+				(len(configuration.GetDomain()) > 0 && configuration.GetDomain() != e.domain) {
+				continue
+			}
+			attributes.Configurations = append(attributes.Configurations, configuration)
+		}
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,199 @@
+package export
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	projectconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testProject = "dummyProject"
+	testDomain  = "dummyDomain"
+)
+
+func testIdentifier(resourceType core.ResourceType, name, version string) *core.Identifier {
+	return &core.Identifier{ResourceType: resourceType, Project: testProject, Domain: testDomain, Name: name, Version: version}
+}
+
+func readArchive(t *testing.T, name string) map[string][]byte {
+	file, err := os.Open(name)
+	assert.NoError(t, err)
+	defer file.Close()
+	gzipReader, err := gzip.NewReader(file)
+	assert.NoError(t, err)
+
+	files := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		assert.NoError(t, err)
+		data, err := io.ReadAll(tarReader)
+		assert.NoError(t, err)
+		files[header.Name] = data
+	}
+}
+
+func setupExportMocks(s *testutils.TestStruct, bundleURL string) {
+	taskID := testIdentifier(core.ResourceType_TASK, "task1", "v1")
+	workflowID := testIdentifier(core.ResourceType_WORKFLOW, "wf1", "v1")
+	launchPlanID := testIdentifier(core.ResourceType_LAUNCH_PLAN, "wf1", "v1")
+	namedID := func(name string) *admin.NamedEntityIdentifier {
+		return &admin.NamedEntityIdentifier{Project: testProject, Domain: testDomain, Name: name}
+	}
+	idsRequest := &admin.NamedEntityIdentifierListRequest{Project: testProject, Domain: testDomain, Limit: pageSize}
+
+	s.MockAdminClient.EXPECT().ListTaskIds(s.Ctx, idsRequest).Return(
+		&admin.NamedEntityIdentifierList{Entities: []*admin.NamedEntityIdentifier{namedID("task1")}}, nil)
+	s.MockAdminClient.EXPECT().ListTasks(s.Ctx, &admin.ResourceListRequest{Id: namedID("task1"), Limit: pageSize}).Return(
+		&admin.TaskList{Tasks: []*admin.Task{{
+			Id: taskID,
+			Closure: &admin.TaskClosure{
+				CreatedAt: timestamppb.New(timestamppb.Now().AsTime().Add(-2)),
+				CompiledTask: &core.CompiledTask{Template: &core.TaskTemplate{
+					Id: taskID,
+					Target: &core.TaskTemplate_Container{Container: &core.Container{
+						Args: []string{"pyflyte-fast-execute", FastRegisterDistributionArg, "s3://bucket/fast/fastabc.tar.gz", "--", "pyflyte-execute"},
+					}},
+				}},
+			},
+		}}}, nil)
+	s.MockAdminClient.EXPECT().GetDescriptionEntity(s.Ctx, &admin.ObjectGetRequest{Id: taskID}).Return(
+		&admin.DescriptionEntity{ShortDescription: "first task"}, nil)
+
+	s.MockAdminClient.EXPECT().ListWorkflowIds(s.Ctx, idsRequest).Return(
+		&admin.NamedEntityIdentifierList{Entities: []*admin.NamedEntityIdentifier{namedID("wf1")}}, nil)
+	s.MockAdminClient.EXPECT().ListWorkflows(s.Ctx, &admin.ResourceListRequest{Id: namedID("wf1"), Limit: pageSize}).Return(
+		&admin.WorkflowList{Workflows: []*admin.Workflow{{Id: workflowID}}}, nil)
+	s.MockAdminClient.EXPECT().GetWorkflow(s.Ctx, &admin.ObjectGetRequest{Id: workflowID}).Return(
+		&admin.Workflow{
+			Id: workflowID,
+			Closure: &admin.WorkflowClosure{
+				CreatedAt: timestamppb.New(timestamppb.Now().AsTime().Add(-1)),
+				CompiledWorkflow: &core.CompiledWorkflowClosure{
+					Primary:      &core.CompiledWorkflow{Template: &core.WorkflowTemplate{Id: workflowID}},
+					SubWorkflows: []*core.CompiledWorkflow{{Template: &core.WorkflowTemplate{Id: testIdentifier(core.ResourceType_WORKFLOW, "sub", "v1")}}},
+				},
+			},
+		}, nil)
+	s.MockAdminClient.EXPECT().GetDescriptionEntity(s.Ctx, &admin.ObjectGetRequest{Id: workflowID}).Return(
+		nil, status.Error(codes.NotFound, "not found"))
+
+	s.MockAdminClient.EXPECT().ListLaunchPlanIds(s.Ctx, idsRequest).Return(
+		&admin.NamedEntityIdentifierList{Entities: []*admin.NamedEntityIdentifier{namedID("wf1")}}, nil)
+	s.MockAdminClient.EXPECT().ListLaunchPlans(s.Ctx, &admin.ResourceListRequest{Id: namedID("wf1"), Limit: pageSize}).Return(
+		&admin.LaunchPlanList{LaunchPlans: []*admin.LaunchPlan{{
+			Id:      launchPlanID,
+			Spec:    &admin.LaunchPlanSpec{WorkflowId: workflowID},
+			Closure: &admin.LaunchPlanClosure{State: admin.LaunchPlanState_ACTIVE, CreatedAt: timestamppb.Now()},
+		}}}, nil)
+
+	s.MockClient.DataProxyClient().(*mocks.DataProxyServiceClient).EXPECT().CreateDownloadLocation(s.Ctx,
+		&service.CreateDownloadLocationRequest{NativeUrl: "s3://bucket/fast/fastabc.tar.gz"}).Return(
+		&service.CreateDownloadLocationResponse{SignedUrl: bundleURL}, nil)
+
+	s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, &admin.ListMatchableAttributesRequest{ResourceType: admin.MatchableResource_EXECUTION_QUEUE}).Return(
+		&admin.ListMatchableAttributesResponse{Configurations: []*admin.MatchableAttributesConfiguration{
+			{Project: testProject, Domain: testDomain, Attributes: &admin.MatchingAttributes{Target: &admin.MatchingAttributes_ExecutionQueueAttributes{
+				ExecutionQueueAttributes: &admin.ExecutionQueueAttributes{Tags: []string{"gpu"}}}}},
+			{Project: testProject, Domain: "otherDomain"},
+			{Project: "otherProject", Domain: testDomain},
+			{Project: testProject, Domain: testDomain, LaunchPlan: "wf1"},
+		}}, nil)
+	s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, mock.Anything).Return(&admin.ListMatchableAttributesResponse{}, nil)
+}
+
+func TestExportProjectFunc(t *testing.T) {
+	t.Run("export", func(t *testing.T) {
+		s := testutils.Setup(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("code"))
+		}))
+		defer server.Close()
+		setupExportMocks(&s, server.URL)
+		archive := filepath.Join(t.TempDir(), "export.tgz")
+		projectconfig.DefaultExportConfig.Archive = archive
+		defer func() { projectconfig.DefaultExportConfig.Archive = "" }()
+
+		err := exportProjectFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.NoError(t, err)
+		files := readArchive(t, archive)
+		assert.Len(t, files, 8)
+		assert.Contains(t, files, EntitiesDir+"/")
+		assert.Contains(t, files, BundlesDir+"/")
+		assert.Equal(t, []byte("code"), files[BundlesDir+"/fastabc.tar.gz"])
+
+		metadata := Metadata{}
+		assert.NoError(t, json.Unmarshal(files[MetadataFile], &metadata))
+		assert.Equal(t, Metadata{Project: testProject, Domain: testDomain}, metadata)
+
+		task := &admin.TaskSpec{}
+		assert.NoError(t, proto.Unmarshal(files[EntitiesDir+"/000000_task1_v1_1.pb"], task))
+		assert.Equal(t, "first task", task.GetDescription().GetShortDescription())
+		assert.Equal(t, BundlesDir+"/fastabc.tar.gz", task.GetTemplate().GetContainer().GetArgs()[2])
+
+		workflow := &admin.WorkflowSpec{}
+		assert.NoError(t, proto.Unmarshal(files[EntitiesDir+"/000001_wf1_v1_2.pb"], workflow))
+		assert.Equal(t, "wf1", workflow.GetTemplate().GetId().GetName())
+		assert.Len(t, workflow.GetSubWorkflows(), 1)
+		assert.Nil(t, workflow.GetDescription())
+
+		launchPlan := &admin.LaunchPlan{}
+		assert.NoError(t, proto.Unmarshal(files[EntitiesDir+"/000002_wf1_v1_3.pb"], launchPlan))
+		assert.Equal(t, admin.LaunchPlanState_ACTIVE, launchPlan.GetClosure().GetState())
+		assert.Nil(t, launchPlan.GetClosure().GetCreatedAt())
+
+		attributes := &admin.ListMatchableAttributesResponse{}
+		assert.NoError(t, proto.Unmarshal(files[AttributesFile], attributes))
+		assert.Len(t, attributes.GetConfigurations(), 1)
+		assert.Equal(t, []string{"gpu"}, attributes.GetConfigurations()[0].GetAttributes().GetExecutionQueueAttributes().GetTags())
+		s.TearDownAndVerify(t, "Exported 3 entities, 1 code bundles and 1 matchable attributes of dummyProject/dummyDomain to "+archive)
+	})
+	t.Run("bundle download failure", func(t *testing.T) {
+		s := testutils.Setup(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+		setupExportMocks(&s, server.URL)
+		archive := filepath.Join(t.TempDir(), "export.tgz")
+		projectconfig.DefaultExportConfig.Archive = archive
+		defer func() { projectconfig.DefaultExportConfig.Archive = "" }()
+
+		err := exportProjectFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "could not export task task1: could not download the code bundle s3://bucket/fast/fastabc.tar.gz of task task1: bad status: 403 Forbidden")
+		assert.NoFileExists(t, archive)
+	})
+	t.Run("list error", func(t *testing.T) {
+		s := testutils.Setup(t)
+		s.MockAdminClient.EXPECT().ListTaskIds(s.Ctx, mock.Anything).Return(nil, errors.New("unavailable"))
+
+		err := exportProjectFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "could not list task names of dummyProject/dummyDomain: unavailable")
+	})
+}
@@ -3,6 +3,7 @@ package get
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
@@ -10,7 +11,10 @@ import (
 	"github.com/flyteorg/flyte/flytectl/pkg/bubbletea"
 	"github.com/flyteorg/flyte/flytectl/pkg/filters"
 	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flytectl/pkg/visualize"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/errors"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/golang/protobuf/proto"
 )
@@ -85,12 +89,21 @@ Task execution view is available in YAML/JSON format too. The following example
 
  flytectl get execution -p flytesnacks -d development oeh94k9r2r --nodeID n0 -o yaml
 
+Visualize the execution as a self-contained HTML page, which shows the graph of its workflow with the phase, duration and retries of each node. It doesn't need graphviz or any other tool to be installed.
+
+::
+
+ flytectl get execution -p flytesnacks -d development oeh94k9r2r -o html > oeh94k9r2r.html
+
 Usage
 `
 )
 
 var hundredChars = 100
 
+// nodeRetriesConcurrency is the number of nodes whose task executions are fetched concurrently to count their retries.
+const nodeRetriesConcurrency = 8
+
 var executionColumns = []printer.Column{
 	{Header: "Name", JSONPath: "$.id.name"},
 	{Header: "Launch Plan Name", JSONPath: "$.spec.launchPlan.name"},
@@ -134,6 +147,10 @@ func getExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.Command
 		executions = append(executions, exec)
 		logger.Infof(ctx, "Retrieved %v executions", len(executions))
 
+		if config.GetConfig().MustOutputFormat() == printer.OutputFormatHTML {
+			return printExecutionHTML(ctx, exec, cmdCtx)
+		}
+
 		if execution.DefaultConfig.Details || len(execution.DefaultConfig.NodeID) > 0 {
 			// Fetching Node execution details
 			nExecDetailsForView, err := getExecutionDetails(ctx, config.GetConfig().Project, config.GetConfig().Domain, name, execution.DefaultConfig.NodeID, cmdCtx)
@@ -164,3 +181,113 @@ func getExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.Command
 	return adminPrinter.Print(config.GetConfig().MustOutputFormat(), executionColumns,
 		ExecutionToProtoMessages(executionList.GetExecutions())...)
 }
+
+// printExecutionHTML prints the graph of the workflow of the execution as a self-contained HTML page, overlaid with the
+// phase, duration and retries of its node executions.
+func printExecutionHTML(ctx context.Context, exec *admin.Execution, cmdCtx cmdCore.CommandContext) error {
+	workflowID := exec.GetClosure().GetWorkflowId()
+	workflow, err := cmdCtx.AdminFetcherExt().FetchWorkflowVersion(ctx, workflowID.GetName(), workflowID.GetVersion(),
+		workflowID.GetProject(), workflowID.GetDomain())
+	if err != nil {
+		return err
+	}
+	statuses, err := getNodeStatuses(ctx, exec.GetId(), cmdCtx)
+	if err != nil {
+		return err
+	}
+	page, err := visualize.RenderWorkflowHTML(exec.GetId().GetName(), workflow.GetClosure().GetCompiledWorkflow(), statuses)
+	if err != nil {
+		return errors.Wrapf("VisualizationError", err, "failed to visualize execution")
+	}
+	fmt.Println(page)
+	return nil
+}
+
+// getNodeStatuses returns the statuses of the node executions, and of their child nodes, by node path. Child nodes are
+// keyed by the path of their parent nodes, e.g. n0/n1 for the node n1 of the subworkflow n0, so that nodes with the
+// same id in different subworkflows or branches don't overwrite each other.
+func getNodeStatuses(ctx context.Context, id *core.WorkflowExecutionIdentifier, cmdCtx cmdCore.CommandContext) (map[string]visualize.NodeStatus, error) {
+	statuses := map[string]visualize.NodeStatus{}
+	leaves := map[string]string{}
+	if err := collectNodeStatuses(ctx, id, "", "", statuses, leaves, cmdCtx); err != nil {
+		return nil, err
+	}
+	if err := countNodeRetries(ctx, id, statuses, leaves, cmdCtx); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// collectNodeStatuses adds the statuses of the node executions with the parent, and of their child nodes, by node path.
+// The leaf nodes, whose retries are counted from their task executions, are added to leaves by node path.
+func collectNodeStatuses(ctx context.Context, id *core.WorkflowExecutionIdentifier, uniqueParentID, parentPath string,
+	statuses map[string]visualize.NodeStatus, leaves map[string]string, cmdCtx cmdCore.CommandContext) error {
+
+	nodeExecs, err := cmdCtx.AdminFetcherExt().FetchNodeExecutionDetails(ctx, id.GetName(), id.GetProject(), id.GetDomain(), uniqueParentID)
+	if err != nil {
+		return err
+	}
+	for _, nodeExec := range nodeExecs.GetNodeExecutions() {
+		nodeID := nodeExec.GetId().GetNodeId()
+		path := nodeID
+		if len(parentPath) > 0 {
+			specNodeID := nodeExec.GetMetadata().GetSpecNodeId()
+			if len(specNodeID) == 0 {
+				specNodeID = nodeID
+			}
+			path = parentPath + "/" + specNodeID
+		}
+		statuses[path] = visualize.NodeStatus{
+			Phase:    nodeExec.GetClosure().GetPhase().String(),
+			Duration: nodeExec.GetClosure().GetDuration().AsDuration(),
+		}
+		if nodeExec.GetMetadata().GetIsParentNode() {
+			if err := collectNodeStatuses(ctx, id, nodeID, path, statuses, leaves, cmdCtx); err != nil {
+				return err
+			}
+		} else {
+			leaves[path] = nodeID
+		}
+	}
+	return nil
+}
+
+// countNodeRetries sets the retries of the leaf nodes from the attempts of their task executions, fetched concurrently.
+func countNodeRetries(ctx context.Context, id *core.WorkflowExecutionIdentifier, statuses map[string]visualize.NodeStatus,
+	leaves map[string]string, cmdCtx cmdCore.CommandContext) error {
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	pending := make(chan string)
+	for i := 0; i < nodeRetriesConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range pending {
+				taskExecs, err := cmdCtx.AdminFetcherExt().FetchTaskExecutionsOnNode(ctx, leaves[path], id.GetName(), id.GetProject(), id.GetDomain())
+				mutex.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					status := statuses[path]
+					for _, taskExec := range taskExecs.GetTaskExecutions() {
+						if attempt := int(taskExec.GetId().GetRetryAttempt()); attempt > status.Retries {
+							status.Retries = attempt
+						}
+					}
+					statuses[path] = status
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	for path := range leaves {
+		pending <- path
+	}
+	close(pending)
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,522 @@
+package get
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	executionDataShort = "Gets the inputs and outputs of an execution or of one of its nodes."
+	executionDataLong  = `
+Print the inputs and outputs of an execution:
+::
+
+ flytectl get execution-data -p flytesnacks -d development oeh94k9r2r
+
+Print the inputs and outputs of a node of the execution, using the nodeID attribute given by the node details view of
+get execution:
+::
+
+ flytectl get execution-data -p flytesnacks -d development oeh94k9r2r --nodeID n0
+
+Download the inputs and outputs to a directory:
+::
+
+ flytectl get execution-data -p flytesnacks -d development oeh94k9r2r --download oeh94k9r2r/
+
+The inputs and outputs are written to the inputs and outputs subdirectories, one file per value:
+
+- Primitives, structs and collections or maps of them are written as JSON, e.g. *inputs/count.json*.
+- Single blobs are downloaded to a file with the extension of the blob, e.g. *inputs/data.csv*.
+- Multipart blobs and structured datasets are downloaded to a directory, e.g. *outputs/df/00000.parquet*.
+- Collections and maps with blobs or structured datasets are written to a directory with one entry per item.
+
+Offloaded values are resolved. The data is downloaded through the data proxy, with a fallback to the configured
+storage for older versions of FlyteAdmin. Listing the files of multipart blobs and structured datasets requires the
+storage to be configured.
+
+The inputs are also written to *execution_spec.yaml*, in the format of the execution spec file of create execution, with
+blobs and structured datasets referencing their remote location. This allows launching the workflow, or the task of the
+node, again with the same data:
+::
+
+ flytectl create execution -p flytesnacks -d development --execFile oeh94k9r2r/execution_spec.yaml
+
+Usage
+`
+)
+
+const (
+	inputsDir         = "inputs"
+	outputsDir        = "outputs"
+	executionSpecFile = "execution_spec.yaml"
+	parquetFormat     = "parquet"
+	listPageSize      = 1000
+)
+
+func getExecutionDataFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 1 {
+		return fmt.Errorf("execution name is required")
+	}
+	project, domain, name := config.GetConfig().Project, config.GetConfig().Domain, args[0]
+	nodeID := execution.DefaultExecDataConfig.NodeID
+
+	var inputs, outputs *core.LiteralMap
+	var inputsURL, outputsURL string
+	if len(nodeID) > 0 {
+		data, err := cmdCtx.AdminFetcherExt().FetchNodeExecutionData(ctx, nodeID, name, project, domain)
+		if err != nil {
+			return err
+		}
+		inputs, outputs = data.GetFullInputs(), data.GetFullOutputs()
+		inputsURL, outputsURL = data.GetInputs().GetUrl(), data.GetOutputs().GetUrl()
+	} else {
+		data, err := cmdCtx.AdminClient().GetExecutionData(ctx, &admin.WorkflowExecutionGetDataRequest{
+			Id: &core.WorkflowExecutionIdentifier{Project: project, Domain: domain, Name: name},
+		})
+		if err != nil {
+			return err
+		}
+		inputs, outputs = data.GetFullInputs(), data.GetFullOutputs()
+		inputsURL, outputsURL = data.GetInputs().GetUrl(), data.GetOutputs().GetUrl()
+	}
+
+	d := &dataDownloader{cmdCtx: cmdCtx}
+	inputs, err := d.resolveLiteralMap(ctx, inputs, inputsURL)
+	if err != nil {
+		return fmt.Errorf("could not fetch inputs: %w", err)
+	}
+	outputs, err = d.resolveLiteralMap(ctx, outputs, outputsURL)
+	if err != nil {
+		return fmt.Errorf("could not fetch outputs: %w", err)
+	}
+
+	dir := execution.DefaultExecDataConfig.Download
+	if len(dir) == 0 {
+		return printExecutionData(inputs, outputs)
+	}
+
+	for subDir, literalMap := range map[string]*core.LiteralMap{inputsDir: inputs, outputsDir: outputs} {
+		if err := d.writeLiteralMap(ctx, literalMap, filepath.Join(dir, subDir)); err != nil {
+			return err
+		}
+	}
+
+	specWritten, err := writeExecutionSpec(ctx, cmdCtx, project, domain, name, nodeID, inputs, filepath.Join(dir, executionSpecFile))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Downloaded %d inputs and %d outputs to %s\n", len(inputs.GetLiterals()), len(outputs.GetLiterals()), dir)
+	if specWritten {
+		fmt.Printf("Relaunch with: flytectl create execution -p %s -d %s --execFile %s\n", project, domain, filepath.Join(dir, executionSpecFile))
+	}
+	return nil
+}
+
+func printExecutionData(inputs, outputs *core.LiteralMap) error {
+	data := map[string]map[string]interface{}{inputsDir: {}, outputsDir: {}}
+	for key, literalMap := range map[string]*core.LiteralMap{inputsDir: inputs, outputsDir: outputs} {
+		for name, literal := range literalMap.GetLiterals() {
+			value, err := literalValue(literal)
+			if err != nil {
+				return fmt.Errorf("could not extract %s: %w", name, err)
+			}
+			data[key][name] = value
+		}
+	}
+
+	raw, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(raw))
+	return nil
+}
+
+// writeExecutionSpec writes the inputs to an execution spec file for the launch plan of the execution, or the task of
+// the node. It returns false if the node isn't a task node.
+func writeExecutionSpec(ctx context.Context, cmdCtx cmdCore.CommandContext, project, domain, name, nodeID string,
+	inputs *core.LiteralMap, fileName string) (bool, error) {
+
+	var executionConfig ExecutionConfig
+	if len(nodeID) > 0 {
+		taskExecutions, err := cmdCtx.AdminFetcherExt().FetchTaskExecutionsOnNode(ctx, nodeID, name, project, domain)
+		if err != nil {
+			return false, err
+		}
+		if len(taskExecutions.GetTaskExecutions()) == 0 {
+			return false, nil
+		}
+		taskID := taskExecutions.GetTaskExecutions()[0].GetId().GetTaskId()
+		executionConfig = ExecutionConfig{Task: taskID.GetName(), Version: taskID.GetVersion()}
+	} else {
+		exec, err := cmdCtx.AdminFetcherExt().FetchExecution(ctx, name, project, domain)
+		if err != nil {
+			return false, err
+		}
+		launchPlanID := exec.GetSpec().GetLaunchPlan()
+		executionConfig = ExecutionConfig{Workflow: launchPlanID.GetName(), Version: launchPlanID.GetVersion()}
+		if launchPlanID.GetResourceType() == core.ResourceType_TASK {
+			executionConfig = ExecutionConfig{Task: launchPlanID.GetName(), Version: launchPlanID.GetVersion()}
+		}
+	}
+
+	executionConfig.Inputs = map[string]yaml.Node{}
+	for inputName, literal := range inputs.GetLiterals() {
+		value, err := literalValue(literal)
+		if err != nil {
+			return false, fmt.Errorf("could not extract input %s: %w", inputName, err)
+		}
+		if executionConfig.Inputs[inputName], err = getCommentedYamlNode(value, ""); err != nil {
+			return false, err
+		}
+	}
+
+	return true, WriteExecConfigToFile(executionConfig, fileName)
+}
+
+// literalValue returns the value of the literal in the format of the inputs of execution spec files, i.e. blobs and
+// structured datasets are referenced by their uri and structs are maps.
+func literalValue(literal *core.Literal) (interface{}, error) {
+	switch v := literal.GetValue().(type) {
+	case *core.Literal_Collection:
+		values := make([]interface{}, 0, len(v.Collection.GetLiterals()))
+		for _, item := range v.Collection.GetLiterals() {
+			value, err := literalValue(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case *core.Literal_Map:
+		values := make(map[string]interface{}, len(v.Map.GetLiterals()))
+		for key, item := range v.Map.GetLiterals() {
+			value, err := literalValue(item)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+		return values, nil
+	}
+
+	if union := literal.GetScalar().GetUnion(); union != nil {
+		return literalValue(union.GetValue())
+	}
+
+	value, err := coreutils.ExtractFromLiteral(literal)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case *structpb.Struct:
+		return v.AsMap(), nil
+	case time.Duration:
+		return v.String(), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	}
+	return value, nil
+}
+
+// hasRemoteData returns whether the literal is or contains a blob or a structured dataset.
+func hasRemoteData(literal *core.Literal) bool {
+	switch v := literal.GetValue().(type) {
+	case *core.Literal_Collection:
+		for _, item := range v.Collection.GetLiterals() {
+			if hasRemoteData(item) {
+				return true
+			}
+		}
+	case *core.Literal_Map:
+		for _, item := range v.Map.GetLiterals() {
+			if hasRemoteData(item) {
+				return true
+			}
+		}
+	case *core.Literal_Scalar:
+		if union := v.Scalar.GetUnion(); union != nil {
+			return hasRemoteData(union.GetValue())
+		}
+		return v.Scalar.GetBlob() != nil || v.Scalar.GetStructuredDataset() != nil
+	}
+	return false
+}
+
+// dataDownloader reads remote data through the data proxy, or through the configured storage with older versions of
+// FlyteAdmin.
+type dataDownloader struct {
+	cmdCtx cmdCore.CommandContext
+}
+
+// resolveLiteralMap returns the literal map, downloading it from url if it was too large to be returned by FlyteAdmin,
+// with its offloaded literals resolved.
+func (d *dataDownloader) resolveLiteralMap(ctx context.Context, literalMap *core.LiteralMap, url string) (*core.LiteralMap, error) {
+	if literalMap == nil && len(url) > 0 {
+		literalMap = &core.LiteralMap{}
+		if err := d.readProto(ctx, url, literalMap); err != nil {
+			return nil, err
+		}
+	}
+
+	for name, literal := range literalMap.GetLiterals() {
+		resolved, err := d.resolveLiteral(ctx, literal)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve %s: %w", name, err)
+		}
+		literalMap.Literals[name] = resolved
+	}
+	return literalMap, nil
+}
+
+func (d *dataDownloader) resolveLiteral(ctx context.Context, literal *core.Literal) (*core.Literal, error) {
+	switch v := literal.GetValue().(type) {
+	case *core.Literal_OffloadedMetadata:
+		offloaded := &core.Literal{}
+		if err := d.readProto(ctx, v.OffloadedMetadata.GetUri(), offloaded); err != nil {
+			return nil, err
+		}
+		return d.resolveLiteral(ctx, offloaded)
+	case *core.Literal_Collection:
+		for i, item := range v.Collection.GetLiterals() {
+			resolved, err := d.resolveLiteral(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			v.Collection.Literals[i] = resolved
+		}
+	case *core.Literal_Map:
+		for key, item := range v.Map.GetLiterals() {
+			resolved, err := d.resolveLiteral(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			v.Map.Literals[key] = resolved
+		}
+	}
+	return literal, nil
+}
+
+func (d *dataDownloader) readProto(ctx context.Context, uri string, message proto.Message) error {
+	reader, err := d.open(ctx, uri)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, message)
+}
+
+// open returns a reader of the remote file.
+func (d *dataDownloader) open(ctx context.Context, uri string) (io.ReadCloser, error) {
+	signedURL := uri
+	if !strings.HasPrefix(uri, "http://") && !strings.HasPrefix(uri, "https://") {
+		resp, err := d.cmdCtx.ClientSet().DataProxyClient().CreateDownloadLocation(ctx, &service.CreateDownloadLocationRequest{NativeUrl: uri})
+		if status.Code(err) == codes.Unimplemented {
+			store, err := register.GetStorageClient(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return store.ReadRaw(ctx, storage.DataReference(uri))
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to create a download location for %s: %w", uri, err)
+		}
+		signedURL = resp.GetSignedUrl()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, signedURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("failed to download %s: bad status: %s", uri, res.Status)
+	}
+	return res.Body, nil
+}
+
+func (d *dataDownloader) download(ctx context.Context, uri, fileName string) error {
+	reader, err := d.open(ctx, uri)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// downloadDir downloads the files under the remote prefix to the directory and returns the number of files.
+func (d *dataDownloader) downloadDir(ctx context.Context, prefix, dir, extension string) (int, error) {
+	store, err := register.GetStorageClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	count := 0
+	cursor := storage.NewCursorAtStart()
+	for !storage.IsCursorEnd(cursor) {
+		var references []storage.DataReference
+		references, cursor, err = store.List(ctx, storage.DataReference(prefix), listPageSize, cursor)
+		if os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return count, fmt.Errorf("failed to list %s: %w", prefix, err)
+		}
+
+		for _, reference := range references {
+			relative := strings.TrimPrefix(strings.TrimPrefix(reference.String(), prefix), "/")
+			if len(relative) == 0 || strings.HasSuffix(relative, "/") {
+				continue
+			}
+			if len(path.Ext(relative)) == 0 {
+				relative += extension
+			}
+			if err := d.download(ctx, reference.String(), filepath.Join(dir, filepath.FromSlash(relative))); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (d *dataDownloader) writeLiteralMap(ctx context.Context, literalMap *core.LiteralMap, dir string) error {
+	names := make([]string, 0, len(literalMap.GetLiterals()))
+	for name := range literalMap.GetLiterals() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := validateFileName(name); err != nil {
+			return err
+		}
+		if err := d.writeLiteral(ctx, literalMap.GetLiterals()[name], filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("could not write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// validateFileName returns an error if the name of a literal can't be used as a file name in the download directory,
+// e.g. because it would write outside of it.
+func validateFileName(name string) error {
+	if len(name) == 0 || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("cannot download [%s], names must not be empty, relative references or contain path separators", name)
+	}
+	return nil
+}
+
+// writeLiteral writes the literal to the given path, adding the extension of the file type.
+func (d *dataDownloader) writeLiteral(ctx context.Context, literal *core.Literal, fileName string) error {
+	if !hasRemoteData(literal) {
+		value, err := literalValue(literal)
+		if err != nil {
+			return err
+		}
+		raw, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return err
+		}
+		// Items of collections and maps are written into a directory named after the collection or map
+		if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(fileName+".json", append(raw, '\n'), 0600)
+	}
+
+	switch v := literal.GetValue().(type) {
+	case *core.Literal_Collection:
+		for i, item := range v.Collection.GetLiterals() {
+			if err := d.writeLiteral(ctx, item, filepath.Join(fileName, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *core.Literal_Map:
+		for key, item := range v.Map.GetLiterals() {
+			if err := validateFileName(key); err != nil {
+				return err
+			}
+			if err := d.writeLiteral(ctx, item, filepath.Join(fileName, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	scalar := literal.GetScalar()
+	if union := scalar.GetUnion(); union != nil {
+		return d.writeLiteral(ctx, union.GetValue(), fileName)
+	}
+
+	if blob := scalar.GetBlob(); blob != nil {
+		if blob.GetMetadata().GetType().GetDimensionality() == core.BlobType_MULTIPART {
+			_, err := d.downloadDir(ctx, blob.GetUri(), fileName, "")
+			return err
+		}
+		return d.download(ctx, blob.GetUri(), fileName+path.Ext(blob.GetUri()))
+	}
+
+	// Structured datasets are usually directories of files but can be a single file as well.
+	dataset := scalar.GetStructuredDataset()
+	extension := ""
+	if format := dataset.GetMetadata().GetStructuredDatasetType().GetFormat(); len(format) == 0 || format == parquetFormat {
+		extension = "." + parquetFormat
+	}
+	count, err := d.downloadDir(ctx, dataset.GetUri(), fileName, extension)
+	if err != nil || count > 0 {
+		return err
+	}
+	return d.download(ctx, dataset.GetUri(), fileName+extension)
+}
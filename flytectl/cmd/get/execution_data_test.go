@@ -0,0 +1,216 @@
+package get
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupExecutionDataStore(t *testing.T) *storage.DataStore {
+	labeled.SetMetricKeys(contextutils.AppNameKey, contextutils.ProjectKey, contextutils.DomainKey)
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	assert.NoError(t, err)
+	register.Client = store
+	t.Cleanup(func() { register.Client = nil })
+	return store
+}
+
+func writeRaw(t *testing.T, store *storage.DataStore, reference string, data []byte) {
+	assert.NoError(t, store.WriteRaw(context.Background(), storage.DataReference(reference), int64(len(data)), storage.Options{}, bytes.NewReader(data)))
+}
+
+func TestGetExecutionDataFunc(t *testing.T) {
+	executionID := &core.WorkflowExecutionIdentifier{Project: dummyProject, Domain: dummyDomain, Name: dummyExec}
+	defer func() { execution.DefaultExecDataConfig = &execution.ExecDataConfig{} }()
+
+	t.Run("print", func(t *testing.T) {
+		s := testutils.Setup(t)
+		execution.DefaultExecDataConfig = &execution.ExecDataConfig{}
+		store := setupExecutionDataStore(t)
+		offloaded, err := proto.Marshal(coreutils.MustMakeLiteral("offloaded"))
+		assert.NoError(t, err)
+		writeRaw(t, store, "mem://bucket/offloaded", offloaded)
+		s.MockAdminClient.EXPECT().GetExecutionData(s.Ctx, &admin.WorkflowExecutionGetDataRequest{Id: executionID}).Return(
+			&admin.WorkflowExecutionGetDataResponse{
+				FullInputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+					"count": coreutils.MustMakeLiteral(3),
+					"big":   {Value: &core.Literal_OffloadedMetadata{OffloadedMetadata: &core.LiteralOffloadedMetadata{Uri: "mem://bucket/offloaded"}}},
+				}},
+				FullOutputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+					"names": coreutils.MustMakeLiteral([]interface{}{"a", "b"}),
+				}},
+			}, nil)
+		s.MockClient.DataProxyClient().(*mocks.DataProxyServiceClient).EXPECT().CreateDownloadLocation(s.Ctx, mock.Anything).Return(
+			nil, status.Error(codes.Unimplemented, "unimplemented"))
+
+		err = getExecutionDataFunc(s.Ctx, []string{dummyExec}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `{"inputs": {"big": "offloaded","count": 3},"outputs": {"names": ["a","b"]}}`)
+	})
+	t.Run("download", func(t *testing.T) {
+		s := testutils.Setup(t)
+		dir := t.TempDir()
+		execution.DefaultExecDataConfig = &execution.ExecDataConfig{Download: dir}
+		store := setupExecutionDataStore(t)
+		writeRaw(t, store, "mem://bucket/df/00000", []byte("part0"))
+		writeRaw(t, store, "mem://bucket/df/00001", []byte("part1"))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("a,b"))
+		}))
+		defer server.Close()
+
+		s.MockAdminClient.EXPECT().GetExecutionData(s.Ctx, &admin.WorkflowExecutionGetDataRequest{Id: executionID}).Return(
+			&admin.WorkflowExecutionGetDataResponse{
+				FullInputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+					"count": coreutils.MustMakeLiteral(3),
+					"data": {Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Blob{Blob: &core.Blob{
+						Uri:      "s3://bucket/data.csv",
+						Metadata: &core.BlobMetadata{Type: &core.BlobType{Dimensionality: core.BlobType_SINGLE}},
+					}}}}},
+				}},
+				FullOutputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+					"df": {Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_StructuredDataset{StructuredDataset: &core.StructuredDataset{
+						Uri:      "mem://bucket/df",
+						Metadata: &core.StructuredDatasetMetadata{StructuredDatasetType: &core.StructuredDatasetType{Format: "parquet"}},
+					}}}}},
+				}},
+			}, nil)
+		s.MockClient.DataProxyClient().(*mocks.DataProxyServiceClient).EXPECT().CreateDownloadLocation(s.Ctx,
+			&service.CreateDownloadLocationRequest{NativeUrl: "s3://bucket/data.csv"}).Return(
+			&service.CreateDownloadLocationResponse{SignedUrl: server.URL}, nil)
+		s.MockClient.DataProxyClient().(*mocks.DataProxyServiceClient).EXPECT().CreateDownloadLocation(s.Ctx, mock.Anything).Return(
+			nil, status.Error(codes.Unimplemented, "unimplemented"))
+		s.FetcherExt.EXPECT().FetchExecution(s.Ctx, dummyExec, dummyProject, dummyDomain).Return(&admin.Execution{
+			Spec: &admin.ExecutionSpec{LaunchPlan: &core.Identifier{ResourceType: core.ResourceType_LAUNCH_PLAN, Name: "wf", Version: "v1"}},
+		}, nil)
+
+		err := getExecutionDataFunc(s.Ctx, []string{dummyExec}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		assertFileContent(t, filepath.Join(dir, inputsDir, "count.json"), "3\n")
+		assertFileContent(t, filepath.Join(dir, inputsDir, "data.csv"), "a,b")
+		assertFileContent(t, filepath.Join(dir, outputsDir, "df", "00000.parquet"), "part0")
+		assertFileContent(t, filepath.Join(dir, outputsDir, "df", "00001.parquet"), "part1")
+
+		raw, err := os.ReadFile(filepath.Join(dir, executionSpecFile))
+		assert.NoError(t, err)
+		executionConfig := ExecutionConfig{}
+		assert.NoError(t, yaml.Unmarshal(raw, &executionConfig))
+		assert.Equal(t, "wf", executionConfig.Workflow)
+		assert.Equal(t, "v1", executionConfig.Version)
+		assert.Equal(t, "3", executionConfig.Inputs["count"].Value)
+		assert.Equal(t, "s3://bucket/data.csv", executionConfig.Inputs["data"].Value)
+		s.TearDownAndVerifyContains(t, "Downloaded 2 inputs and 1 outputs to "+dir)
+	})
+	t.Run("node", func(t *testing.T) {
+		s := testutils.Setup(t)
+		dir := t.TempDir()
+		execution.DefaultExecDataConfig = &execution.ExecDataConfig{NodeID: "n0", Download: dir}
+		s.FetcherExt.EXPECT().FetchNodeExecutionData(s.Ctx, "n0", dummyExec, dummyProject, dummyDomain).Return(
+			&admin.NodeExecutionGetDataResponse{
+				FullInputs: &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral("y")}},
+			}, nil)
+		s.FetcherExt.EXPECT().FetchTaskExecutionsOnNode(s.Ctx, "n0", dummyExec, dummyProject, dummyDomain).Return(
+			&admin.TaskExecutionList{TaskExecutions: []*admin.TaskExecution{{
+				Id: &core.TaskExecutionIdentifier{TaskId: &core.Identifier{Name: "task1", Version: "v2"}},
+			}}}, nil)
+
+		err := getExecutionDataFunc(s.Ctx, []string{dummyExec}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		assertFileContent(t, filepath.Join(dir, inputsDir, "x.json"), "\"y\"\n")
+		assert.DirExists(t, filepath.Join(dir, outputsDir))
+		raw, err := os.ReadFile(filepath.Join(dir, executionSpecFile))
+		assert.NoError(t, err)
+		executionConfig := ExecutionConfig{}
+		assert.NoError(t, yaml.Unmarshal(raw, &executionConfig))
+		assert.Equal(t, "task1", executionConfig.Task)
+		assert.Equal(t, "v2", executionConfig.Version)
+	})
+	t.Run("missing name", func(t *testing.T) {
+		s := testutils.Setup(t)
+
+		err := getExecutionDataFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "execution name is required")
+	})
+	t.Run("download failure", func(t *testing.T) {
+		s := testutils.Setup(t)
+		execution.DefaultExecDataConfig = &execution.ExecDataConfig{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+		s.MockAdminClient.EXPECT().GetExecutionData(s.Ctx, mock.Anything).Return(
+			&admin.WorkflowExecutionGetDataResponse{Inputs: &admin.UrlBlob{Url: server.URL}}, nil)
+
+		err := getExecutionDataFunc(s.Ctx, []string{dummyExec}, s.CmdCtx)
+
+		assert.EqualError(t, err, "could not fetch inputs: failed to download "+server.URL+": bad status: 403 Forbidden")
+	})
+}
+
+func assertFileContent(t *testing.T, fileName, content string) {
+	raw, err := os.ReadFile(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, content, string(raw))
+}
+
+func TestDataDownloaderWriteLiteral(t *testing.T) {
+	s := testutils.Setup(t)
+	ctx := s.Ctx
+	s.MockClient.DataProxyClient().(*mocks.DataProxyServiceClient).EXPECT().CreateDownloadLocation(ctx, mock.Anything).Return(
+		nil, status.Error(codes.Unimplemented, "unimplemented"))
+	store := setupExecutionDataStore(t)
+	writeRaw(t, store, "mem://bucket/a.csv", []byte("a,b"))
+	blob := &core.Literal{Value: &core.Literal_Scalar{Scalar: &core.Scalar{Value: &core.Scalar_Blob{Blob: &core.Blob{
+		Uri:      "mem://bucket/a.csv",
+		Metadata: &core.BlobMetadata{Type: &core.BlobType{Dimensionality: core.BlobType_SINGLE}},
+	}}}}}
+	d := &dataDownloader{cmdCtx: s.CmdCtx}
+
+	t.Run("map with remote data", func(t *testing.T) {
+		dir := t.TempDir()
+		literal := &core.Literal{Value: &core.Literal_Map{Map: &core.LiteralMap{Literals: map[string]*core.Literal{
+			"file":  blob,
+			"count": coreutils.MustMakeLiteral(3),
+		}}}}
+
+		assert.NoError(t, d.writeLiteral(ctx, literal, filepath.Join(dir, "m")))
+		assertFileContent(t, filepath.Join(dir, "m", "file.csv"), "a,b")
+		assertFileContent(t, filepath.Join(dir, "m", "count.json"), "3\n")
+	})
+	t.Run("unsafe key", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, key := range []string{"..", "../escape", "a/b", ""} {
+			literal := &core.Literal{Value: &core.Literal_Map{Map: &core.LiteralMap{Literals: map[string]*core.Literal{key: blob}}}}
+			assert.Error(t, d.writeLiteral(ctx, literal, filepath.Join(dir, "m")), key)
+			assert.Error(t, d.writeLiteralMap(ctx, &core.LiteralMap{Literals: map[string]*core.Literal{key: coreutils.MustMakeLiteral(1)}}, dir), key)
+		}
+		assert.NoFileExists(t, filepath.Join(dir, "escape.csv"))
+	})
+}
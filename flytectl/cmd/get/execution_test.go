@@ -5,14 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
 	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
@@ -329,6 +332,94 @@ func TestGetExecutionFuncWithIOData(t *testing.T) {
 	})
 }
 
+func TestGetExecutionFuncHTML(t *testing.T) {
+	getExecutionSetup()
+	s := testutils.Setup(t)
+	config.GetConfig().Output = printer.OutputFormatHTML.String()
+	defer func() { config.GetConfig().Output = output }()
+
+	workflowID := &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Project: projectValue, Domain: domainValue,
+		Name: workflowNameValue, Version: workflowVersionValue}
+	taskID := &core.Identifier{ResourceType: core.ResourceType_TASK, Project: projectValue, Domain: domainValue,
+		Name: "core.basic.t1", Version: workflowVersionValue}
+	workflow := &admin.Workflow{
+		Id: workflowID,
+		Closure: &admin.WorkflowClosure{CompiledWorkflow: &core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template: &core.WorkflowTemplate{Id: workflowID, Nodes: []*core.Node{
+					{Id: "start-node"},
+					{Id: "n0", Metadata: &core.NodeMetadata{Name: "core.basic.t1"},
+						Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{Reference: &core.TaskNode_ReferenceId{ReferenceId: taskID}}}},
+				}},
+				Connections: &core.ConnectionSet{Downstream: map[string]*core.ConnectionSet_IdList{"start-node": {Ids: []string{"n0"}}}},
+			},
+			Tasks: []*core.CompiledTask{{Template: &core.TaskTemplate{Id: taskID, Type: "python-task"}}},
+		}},
+	}
+	executionID := &core.WorkflowExecutionIdentifier{Project: projectValue, Domain: domainValue, Name: executionNameValue}
+	s.FetcherExt.EXPECT().FetchExecution(s.Ctx, executionNameValue, projectValue, domainValue).Return(&admin.Execution{
+		Id:      executionID,
+		Closure: &admin.ExecutionClosure{WorkflowId: workflowID},
+	}, nil)
+	s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, workflowNameValue, workflowVersionValue, projectValue, domainValue).Return(workflow, nil)
+	s.FetcherExt.EXPECT().FetchNodeExecutionDetails(s.Ctx, executionNameValue, projectValue, domainValue, "").Return(&admin.NodeExecutionList{
+		NodeExecutions: []*admin.NodeExecution{{
+			Id: &core.NodeExecutionIdentifier{NodeId: "n0", ExecutionId: executionID},
+			Closure: &admin.NodeExecutionClosure{
+				Phase:    core.NodeExecution_SUCCEEDED,
+				Duration: durationpb.New(90 * time.Second),
+			},
+		}},
+	}, nil)
+	s.FetcherExt.EXPECT().FetchTaskExecutionsOnNode(s.Ctx, "n0", executionNameValue, projectValue, domainValue).Return(&admin.TaskExecutionList{
+		TaskExecutions: []*admin.TaskExecution{
+			{Id: &core.TaskExecutionIdentifier{RetryAttempt: 0}},
+			{Id: &core.TaskExecutionIdentifier{RetryAttempt: 1}},
+		},
+	}, nil)
+
+	err := getExecutionFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx)
+	assert.Nil(t, err)
+	s.TearDownAndVerifyContains(t, "<title>n0: SUCCEEDED in 1m30s after 1 retries</title>")
+}
+
+func TestGetNodeStatuses(t *testing.T) {
+	s := testutils.Setup(t)
+	executionID := &core.WorkflowExecutionIdentifier{Project: projectValue, Domain: domainValue, Name: executionNameValue}
+	s.FetcherExt.EXPECT().FetchNodeExecutionDetails(s.Ctx, executionNameValue, projectValue, domainValue, "").Return(&admin.NodeExecutionList{
+		NodeExecutions: []*admin.NodeExecution{
+			{
+				Id:       &core.NodeExecutionIdentifier{NodeId: "n0", ExecutionId: executionID},
+				Metadata: &admin.NodeExecutionMetaData{IsParentNode: true},
+				Closure:  &admin.NodeExecutionClosure{Phase: core.NodeExecution_FAILED},
+			},
+			{
+				Id:      &core.NodeExecutionIdentifier{NodeId: "n1", ExecutionId: executionID},
+				Closure: &admin.NodeExecutionClosure{Phase: core.NodeExecution_SUCCEEDED},
+			},
+		},
+	}, nil)
+	s.FetcherExt.EXPECT().FetchNodeExecutionDetails(s.Ctx, executionNameValue, projectValue, domainValue, "n0").Return(&admin.NodeExecutionList{
+		NodeExecutions: []*admin.NodeExecution{{
+			Id:       &core.NodeExecutionIdentifier{NodeId: "n0-0-n1", ExecutionId: executionID},
+			Metadata: &admin.NodeExecutionMetaData{SpecNodeId: "n1"},
+			Closure:  &admin.NodeExecutionClosure{Phase: core.NodeExecution_FAILED},
+		}},
+	}, nil)
+	s.FetcherExt.EXPECT().FetchTaskExecutionsOnNode(s.Ctx, "n1", executionNameValue, projectValue, domainValue).Return(&admin.TaskExecutionList{}, nil)
+	s.FetcherExt.EXPECT().FetchTaskExecutionsOnNode(s.Ctx, "n0-0-n1", executionNameValue, projectValue, domainValue).Return(&admin.TaskExecutionList{
+		TaskExecutions: []*admin.TaskExecution{{Id: &core.TaskExecutionIdentifier{RetryAttempt: 2}}},
+	}, nil)
+
+	statuses, err := getNodeStatuses(s.Ctx, executionID, s.CmdCtx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 3)
+	assert.Equal(t, "FAILED", statuses["n0"].Phase)
+	assert.Equal(t, 0, statuses["n1"].Retries)
+	assert.Equal(t, "FAILED", statuses["n0/n1"].Phase)
+	assert.Equal(t, 2, statuses["n0/n1"].Retries)
+}
+
 func TestGetExecutionFuncWithError(t *testing.T) {
 	ctx := context.Background()
 	getExecutionSetup()
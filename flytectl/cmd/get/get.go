@@ -8,6 +8,7 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/launchplan"
 	pluginoverride "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/plugin_override"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/task"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/workflow"
@@ -47,6 +48,10 @@ func CreateGetCommand() *cobra.Command {
 			Long: launchPlanLong, PFlagProvider: launchplan.DefaultConfig},
 		"execution": {CmdFunc: getExecutionFunc, Aliases: []string{"executions"}, Short: executionShort,
 			Long: executionLong, PFlagProvider: execution.DefaultConfig},
+		"execution-data": {CmdFunc: getExecutionDataFunc, Short: executionDataShort,
+			Long: executionDataLong, PFlagProvider: execution.DefaultExecDataConfig},
+		"signal": {CmdFunc: getSignalFunc, Aliases: []string{"signals"}, Short: signalShort,
+			Long: signalLong, PFlagProvider: signal.DefaultConfig},
 		"task-resource-attribute": {CmdFunc: getTaskResourceAttributes, Aliases: []string{"task-resource-attributes"},
 			Short: taskResourceAttributesShort,
 			Long:  taskResourceAttributesLong, PFlagProvider: taskresourceattribute.DefaultFetchConfig},
@@ -28,20 +28,20 @@ func TestCreateGetCommand(t *testing.T) {
 	assert.Equal(t, getCommand.Use, "get")
 	assert.Equal(t, getCommand.Short, "Fetches various Flyte resources such as tasks, workflows, launch plans, executions, and projects.")
 	fmt.Println(getCommand.Commands())
-	assert.Equal(t, len(getCommand.Commands()), 11)
+	assert.Equal(t, len(getCommand.Commands()), 13)
 	cmdNouns := getCommand.Commands()
 	// Sort by Use value.
 	sort.Slice(cmdNouns, func(i, j int) bool {
 		return cmdNouns[i].Use < cmdNouns[j].Use
 	})
-	useArray := []string{"cluster-resource-attribute", "execution", "execution-cluster-label",
-		"execution-queue-attribute", "launchplan", "plugin-override", "project", "task", "task-resource-attribute", "workflow", "workflow-execution-config"}
-	aliases := [][]string{{"cluster-resource-attributes"}, {"executions"}, {"execution-cluster-labels"},
-		{"execution-queue-attributes"}, {"launchplans"}, {"plugin-overrides"}, {"projects"}, {"tasks"}, {"task-resource-attributes"}, {"workflows"}, {"workflow-execution-config"}}
-	shortArray := []string{clusterResourceAttributesShort, executionShort, executionClusterLabelShort, executionQueueAttributesShort, launchPlanShort,
-		pluginOverrideShort, projectShort, taskShort, taskResourceAttributesShort, workflowShort, workflowExecutionConfigShort}
-	longArray := []string{clusterResourceAttributesLong, executionLong, executionClusterLabelLong, executionQueueAttributesLong, launchPlanLong,
-		pluginOverrideLong, projectLong, taskLong, taskResourceAttributesLong, workflowLong, workflowExecutionConfigLong}
+	useArray := []string{"cluster-resource-attribute", "execution", "execution-cluster-label", "execution-data",
+		"execution-queue-attribute", "launchplan", "plugin-override", "project", "signal", "task", "task-resource-attribute", "workflow", "workflow-execution-config"}
+	aliases := [][]string{{"cluster-resource-attributes"}, {"executions"}, {"execution-cluster-labels"}, nil,
+		{"execution-queue-attributes"}, {"launchplans"}, {"plugin-overrides"}, {"projects"}, {"signals"}, {"tasks"}, {"task-resource-attributes"}, {"workflows"}, {"workflow-execution-config"}}
+	shortArray := []string{clusterResourceAttributesShort, executionShort, executionClusterLabelShort, executionDataShort, executionQueueAttributesShort, launchPlanShort,
+		pluginOverrideShort, projectShort, signalShort, taskShort, taskResourceAttributesShort, workflowShort, workflowExecutionConfigShort}
+	longArray := []string{clusterResourceAttributesLong, executionLong, executionClusterLabelLong, executionDataLong, executionQueueAttributesLong, launchPlanLong,
+		pluginOverrideLong, projectLong, signalLong, taskLong, taskResourceAttributesLong, workflowLong, workflowExecutionConfigLong}
 	for i := range cmdNouns {
 		assert.Equal(t, cmdNouns[i].Use, useArray[i])
 		assert.Equal(t, cmdNouns[i].Aliases, aliases[i])
@@ -0,0 +1,116 @@
+package get
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	signalShort = "Gets the signals gate nodes of an execution are waiting on."
+	signalLong  = `
+Retrieve the pending signals of an execution, i.e. the ones of the gate nodes waiting on an approval or an input:
+::
+
+ flytectl get signal -p flytesnacks -d development --execution oeh94k9r2r
+
+.. note::
+    The terms signal/signals are interchangeable in these commands.
+
+Retrieve the signals of an execution by id:
+::
+
+ flytectl get signal -p flytesnacks -d development --execution oeh94k9r2r approval
+
+Retrieve all the signals of an execution, including the ones that are already set, in YAML format to see their values:
+::
+
+ flytectl get signal -p flytesnacks -d development --execution oeh94k9r2r --all -o yaml
+
+Usage
+`
+)
+
+const signalPageSize = 100
+
+var signalColumns = []printer.Column{
+	{Header: "Execution", JSONPath: "$.id.executionId.name"},
+	{Header: "Signal ID", JSONPath: "$.id.signalId"},
+	{Header: "Type", JSONPath: "$.type.simple"},
+}
+
+func SignalToProtoMessages(l []*admin.Signal) []proto.Message {
+	messages := make([]proto.Message, 0, len(l))
+	for _, m := range l {
+		messages = append(messages, m)
+	}
+	return messages
+}
+
+// FetchSignals returns all the signals of the execution, or the pending ones only, i.e. the ones without value.
+func FetchSignals(ctx context.Context, cmdCtx cmdCore.CommandContext, executionID *core.WorkflowExecutionIdentifier,
+	pendingOnly bool) ([]*admin.Signal, error) {
+
+	var signals []*admin.Signal
+	token := ""
+	for {
+		signalList, err := cmdCtx.ClientSet().SignalServiceClient().ListSignals(ctx, &admin.SignalListRequest{
+			WorkflowExecutionId: executionID,
+			Limit:               signalPageSize,
+			Token:               token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not list the signals of execution %s: %w", executionID.GetName(), err)
+		}
+		for _, s := range signalList.GetSignals() {
+			if !pendingOnly || s.GetValue() == nil {
+				signals = append(signals, s)
+			}
+		}
+		if token = signalList.GetToken(); len(token) == 0 {
+			return signals, nil
+		}
+	}
+}
+
+func getSignalFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(signal.DefaultConfig.Execution) == 0 {
+		return fmt.Errorf("execution is required to get its signals")
+	}
+	executionID := &core.WorkflowExecutionIdentifier{
+		Project: config.GetConfig().Project,
+		Domain:  config.GetConfig().Domain,
+		Name:    signal.DefaultConfig.Execution,
+	}
+	signals, err := FetchSignals(ctx, cmdCtx, executionID, !signal.DefaultConfig.All && len(args) == 0)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		signalsByID := make(map[string]*admin.Signal, len(signals))
+		for _, s := range signals {
+			signalsByID[s.GetId().GetSignalId()] = s
+		}
+		signals = make([]*admin.Signal, 0, len(args))
+		for _, signalID := range args {
+			s, found := signalsByID[signalID]
+			if !found {
+				return fmt.Errorf("signal %s not found in execution %s", signalID, executionID.GetName())
+			}
+			signals = append(signals, s)
+		}
+	}
+
+	logger.Infof(ctx, "Retrieved %v signals", len(signals))
+	adminPrinter := printer.Printer{}
+	return adminPrinter.Print(config.GetConfig().MustOutputFormat(), signalColumns, SignalToProtoMessages(signals)...)
+}
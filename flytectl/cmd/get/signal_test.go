@@ -0,0 +1,92 @@
+package get
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testSignal(executionID *core.WorkflowExecutionIdentifier, signalID string, value *core.Literal) *admin.Signal {
+	return &admin.Signal{
+		Id:    &core.SignalIdentifier{ExecutionId: executionID, SignalId: signalID},
+		Type:  &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}},
+		Value: value,
+	}
+}
+
+func TestGetSignalFunc(t *testing.T) {
+	executionID := &core.WorkflowExecutionIdentifier{Project: projectValue, Domain: domainValue, Name: executionNameValue}
+	setupSignals := func(s *testutils.TestStruct) {
+		signalClient := s.MockClient.SignalServiceClient().(*mocks.SignalServiceClient)
+		signalClient.EXPECT().ListSignals(s.Ctx, &admin.SignalListRequest{WorkflowExecutionId: executionID, Limit: signalPageSize}).Return(
+			&admin.SignalList{Signals: []*admin.Signal{testSignal(executionID, "approved", coreutils.MustMakeLiteral(true))}, Token: "1"}, nil)
+		signalClient.EXPECT().ListSignals(s.Ctx, &admin.SignalListRequest{WorkflowExecutionId: executionID, Limit: signalPageSize, Token: "1"}).Return(
+			&admin.SignalList{Signals: []*admin.Signal{testSignal(executionID, "pending", nil)}}, nil)
+	}
+	defer func() { signal.DefaultConfig = &signal.Config{} }()
+
+	t.Run("pending", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultConfig = &signal.Config{Execution: executionNameValue}
+
+		err := getSignalFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `{"id": {"signalId": "pending","executionId": {"project": "dummyProject","domain": "dummyDomain","name": "e124"}},"type": {"simple": "BOOLEAN"}}`)
+	})
+	t.Run("all", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultConfig = &signal.Config{Execution: executionNameValue, All: true}
+
+		err := getSignalFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerifyContains(t, `"signalId": "approved"`)
+	})
+	t.Run("by id", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultConfig = &signal.Config{Execution: executionNameValue}
+
+		err := getSignalFunc(s.Ctx, []string{"approved"}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerifyContains(t, `"signalId": "approved"`)
+	})
+	t.Run("unknown id", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultConfig = &signal.Config{Execution: executionNameValue}
+
+		err := getSignalFunc(s.Ctx, []string{"unknown"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "signal unknown not found in execution e124")
+	})
+	t.Run("missing execution", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signal.DefaultConfig = &signal.Config{}
+
+		err := getSignalFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "execution is required to get its signals")
+	})
+	t.Run("list error", func(t *testing.T) {
+		s := testutils.Setup(t)
+		s.MockClient.SignalServiceClient().(*mocks.SignalServiceClient).EXPECT().ListSignals(s.Ctx, mock.Anything).Return(nil, errors.New("unavailable"))
+		signal.DefaultConfig = &signal.Config{Execution: executionNameValue}
+
+		err := getSignalFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "could not list the signals of execution e124: unavailable")
+	})
+}
@@ -83,6 +83,18 @@ Visualize the graph for a workflow within project and domain in a dot content re
 
  flytectl get workflow -p flytesnacks -d development  core.flyte_basics.basic_workflow.my_wf --latest -o doturl
 
+Visualize the graph for a workflow within project and domain as a Mermaid flowchart, which renders in GitHub and most documentation tools without graphviz:
+
+::
+
+ flytectl get workflow -p flytesnacks -d development  core.flyte_basics.basic_workflow.my_wf --latest -o mermaid
+
+Visualize the graph for a workflow within project and domain as a self-contained HTML page:
+
+::
+
+ flytectl get workflow -p flytesnacks -d development  core.flyte_basics.basic_workflow.my_wf --latest -o html > my_wf.html
+
 Usage
 `
 )
@@ -0,0 +1,424 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	projectconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	rconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/register"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/export"
+	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	importShort = "Imports an archive written by export project into a project and domain."
+	importLong  = `
+Register the tasks, workflows and launch plans of an archive written by export project into a project and domain,
+possibly of another Flyte deployment, then update its matchable attributes. The references between the exported
+entities are updated to the target project and domain, the launch plans that were active are activated and the code
+bundles of the fast registered tasks are uploaded through the data proxy.
+
+::
+
+ flytectl import flytesnacks.tgz -p flytesnacks -d staging
+
+The archive can also be an http link:
+
+::
+
+ flytectl import https://example.com/flytesnacks.tgz -p flytesnacks -d staging
+
+The exported versions are kept by default. Register all the entities with another version instead:
+
+::
+
+ flytectl import flytesnacks.tgz -p flytesnacks -d staging --version v2
+
+Entities and matchable attributes that already exist in the target project and domain are skipped by default. Fail
+the import instead:
+
+::
+
+ flytectl import flytesnacks.tgz -p flytesnacks -d staging --onConflict fail
+
+Show what would be imported without making any modifications:
+
+::
+
+ flytectl import flytesnacks.tgz -p flytesnacks -d staging --dryRun
+
+Usage
+`
+)
+
+// CreateImportCommand will return import command
+func CreateImportCommand() map[string]cmdCore.CommandEntry {
+	importResourcesFuncs := map[string]cmdCore.CommandEntry{
+		"import": {
+			Short:         importShort,
+			Long:          importLong,
+			CmdFunc:       importFunc,
+			PFlagProvider: projectconfig.DefaultImportConfig,
+		},
+	}
+	return importResourcesFuncs
+}
+
+type projectImporter struct {
+	cmdCtx  cmdCore.CommandContext
+	config  *projectconfig.ImportConfig
+	dir     string
+	source  export.Metadata
+	project string
+	domain  string
+	// uploads maps the code bundles of the archive to their uploaded location.
+	uploads  map[string]storage.DataReference
+	imported int
+	skipped  int
+}
+
+func importFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 1 {
+		return fmt.Errorf("exactly one archive must be passed")
+	}
+	cfg := projectconfig.DefaultImportConfig
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	files, dir, err := register.GetSerializeOutputFiles(ctx, args, true)
+	if len(dir) > 0 {
+		defer func() {
+			if err := os.RemoveAll(dir); err != nil {
+				logger.Errorf(ctx, "unable to delete temp dir %v due to %v", dir, err)
+			}
+		}()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to extract archive %s: %w", args[0], err)
+	}
+
+	i := &projectImporter{
+		cmdCtx:  cmdCtx,
+		config:  cfg,
+		dir:     dir,
+		project: config.GetConfig().Project,
+		domain:  config.GetConfig().Domain,
+		uploads: map[string]storage.DataReference{},
+	}
+
+	metadata, err := os.ReadFile(filepath.Join(dir, export.MetadataFile))
+	if err != nil {
+		return fmt.Errorf("%s is not an archive written by export project: %w", args[0], err)
+	}
+	if err := json.Unmarshal(metadata, &i.source); err != nil {
+		return fmt.Errorf("invalid %s: %w", export.MetadataFile, err)
+	}
+
+	entitiesDir := filepath.Join(dir, export.EntitiesDir) + string(filepath.Separator)
+	for _, file := range files {
+		if !strings.HasPrefix(file, entitiesDir) {
+			continue
+		}
+		if err := i.importEntity(ctx, file); err != nil {
+			return fmt.Errorf("failed importing %s after %d entities were imported: %w", filepath.Base(file), i.imported, err)
+		}
+	}
+
+	if err := i.importAttributes(ctx, filepath.Join(dir, export.AttributesFile)); err != nil {
+		return err
+	}
+
+	if cfg.DryRun {
+		fmt.Printf("Skipping import (dryRun)\n")
+		return nil
+	}
+	fmt.Printf("Imported %d entities and matchable attributes of %s/%s into %s/%s, skipped %d existing ones\n",
+		i.imported, i.source.Project, i.source.Domain, i.project, i.domain, i.skipped)
+	return nil
+}
+
+func (i *projectImporter) importEntity(ctx context.Context, file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	spec, err := register.UnMarshalContents(ctx, data, file)
+	if err != nil {
+		return err
+	}
+
+	i.remapIdentifiers(spec)
+
+	var id *core.Identifier
+	var kind string
+	activate := false
+	switch v := spec.(type) {
+	case *admin.TaskSpec:
+		id, kind = v.GetTemplate().GetId(), "task"
+	case *admin.WorkflowSpec:
+		id, kind = v.GetTemplate().GetId(), "workflow"
+	case *admin.LaunchPlan:
+		id, kind = v.GetId(), "launch plan"
+		activate = v.GetClosure().GetState() == admin.LaunchPlanState_ACTIVE
+		v.Closure = nil
+	default:
+		return fmt.Errorf("unknown type %T", v)
+	}
+	description := fmt.Sprintf("%s %s version %s", kind, id.GetName(), id.GetVersion())
+
+	exists, err := i.exists(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not fetch %s: %w", description, err)
+	}
+	if exists {
+		if i.config.OnConflict == projectconfig.ConflictFail {
+			return fmt.Errorf("%s already exists in %s/%s", description, i.project, i.domain)
+		}
+		fmt.Printf("Skipped %s: already exists\n", description)
+		i.skipped++
+		return nil
+	}
+
+	if i.config.DryRun {
+		fmt.Printf("Would register %s\n", description)
+		return nil
+	}
+
+	if task, ok := spec.(*admin.TaskSpec); ok {
+		if err := i.uploadBundle(ctx, task.GetTemplate()); err != nil {
+			return err
+		}
+	}
+	if err := register.RegisterSpec(ctx, spec, i.cmdCtx, "", rconfig.FilesConfig{EnableSchedule: activate}); err != nil {
+		return err
+	}
+
+	if activate {
+		description += " (active)"
+	}
+	fmt.Printf("Registered %s\n", description)
+	i.imported++
+	return nil
+}
+
+// remapIdentifiers moves the identifiers of the exported project and domain to the target ones, along with their
+// version if a version is forced. References to entities of other projects and domains are left as is.
+func (i *projectImporter) remapIdentifiers(spec proto.Message) {
+	rangeIdentifiers(proto.MessageReflect(spec), func(id *core.Identifier) {
+		if id.GetProject() != i.source.Project || id.GetDomain() != i.source.Domain {
+			return
+		}
+		id.Project, id.Domain = i.project, i.domain
+		if len(i.config.Version) > 0 {
+			id.Version = i.config.Version
+		}
+	})
+}
+
+// rangeIdentifiers calls f with each identifier of the message and of its nested messages.
+func rangeIdentifiers(m protoreflect.Message, f func(id *core.Identifier)) {
+	if id, ok := m.Interface().(*core.Identifier); ok {
+		f(id)
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			for j := 0; j < v.List().Len(); j++ {
+				rangeIdentifiers(v.List().Get(j).Message(), f)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				rangeIdentifiers(value.Message(), f)
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			rangeIdentifiers(v.Message(), f)
+		}
+		return true
+	})
+}
+
+func (i *projectImporter) exists(ctx context.Context, id *core.Identifier) (bool, error) {
+	var err error
+	switch id.GetResourceType() {
+	case core.ResourceType_TASK:
+		_, err = i.cmdCtx.AdminFetcherExt().FetchTaskVersion(ctx, id.GetName(), id.GetVersion(), i.project, i.domain)
+	case core.ResourceType_WORKFLOW:
+		_, err = i.cmdCtx.AdminFetcherExt().FetchWorkflowVersion(ctx, id.GetName(), id.GetVersion(), i.project, i.domain)
+	default:
+		_, err = i.cmdCtx.AdminFetcherExt().FetchLPVersion(ctx, id.GetName(), id.GetVersion(), i.project, i.domain)
+	}
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// uploadBundle uploads the code bundle of a fast registered task, once, and references its uploaded location in the
+// task arguments.
+func (i *projectImporter) uploadBundle(ctx context.Context, template *core.TaskTemplate) error {
+	args := template.GetContainer().GetArgs()
+	for j := 0; j < len(args)-1; j++ {
+		if args[j] != export.FastRegisterDistributionArg || !strings.HasPrefix(args[j+1], export.BundlesDir+"/") {
+			continue
+		}
+
+		bundle := args[j+1]
+		location, found := i.uploads[bundle]
+		if !found {
+			var err error
+			location, err = register.UploadFastRegisterArtifact(ctx, i.project, i.domain, filepath.Join(i.dir, filepath.FromSlash(bundle)),
+				template.GetId().GetVersion(), i.cmdCtx.ClientSet().DataProxyClient(), "")
+			if err != nil {
+				return fmt.Errorf("failed to upload code bundle %s: %w", bundle, err)
+			}
+			i.uploads[bundle] = location
+		}
+		args[j+1] = location.String()
+	}
+
+	return nil
+}
+
+func (i *projectImporter) importAttributes(ctx context.Context, file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	attributes := &admin.ListMatchableAttributesResponse{}
+	if err := proto.Unmarshal(data, attributes); err != nil {
+		return fmt.Errorf("invalid %s: %w", export.AttributesFile, err)
+	}
+
+	for _, configuration := range attributes.GetConfigurations() {
+		project, domain, workflow := i.project, configuration.GetDomain(), configuration.GetWorkflow()
+		if domain == i.source.Domain {
+			domain = i.domain
+		}
+		resourceType := matchableResource(configuration.GetAttributes())
+		description := fmt.Sprintf("%s attributes of %s", strings.ToLower(resourceType.String()),
+			strings.Join(nonEmpty(project, domain, workflow), "/"))
+
+		current, err := fetchMatchingAttributes(ctx, i.cmdCtx, resourceType, project, domain, workflow)
+		if err != nil {
+			return fmt.Errorf("could not fetch %s: %w", description, err)
+		}
+		if current != nil {
+			if proto.Equal(current, configuration.GetAttributes()) {
+				continue
+			}
+			if i.config.OnConflict == projectconfig.ConflictFail {
+				return fmt.Errorf("%s already exist in %s/%s", description, i.project, i.domain)
+			}
+			fmt.Printf("Skipped %s: already exist\n", description)
+			i.skipped++
+			continue
+		}
+
+		if i.config.DryRun {
+			fmt.Printf("Would update %s\n", description)
+			continue
+		}
+		if err := updateMatchingAttributes(ctx, i.cmdCtx, project, domain, workflow, configuration.GetAttributes()); err != nil {
+			return fmt.Errorf("failed to update %s: %w", description, err)
+		}
+		fmt.Printf("Updated %s\n", description)
+		i.imported++
+	}
+
+	return nil
+}
+
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, v := range values {
+		if len(v) > 0 {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// matchableResource returns the resource type of the matching attributes.
+func matchableResource(attributes *admin.MatchingAttributes) admin.MatchableResource {
+	switch attributes.GetTarget().(type) {
+	case *admin.MatchingAttributes_ClusterResourceAttributes:
+		return admin.MatchableResource_CLUSTER_RESOURCE
+	case *admin.MatchingAttributes_ExecutionQueueAttributes:
+		return admin.MatchableResource_EXECUTION_QUEUE
+	case *admin.MatchingAttributes_ExecutionClusterLabel:
+		return admin.MatchableResource_EXECUTION_CLUSTER_LABEL
+	case *admin.MatchingAttributes_QualityOfService:
+		return admin.MatchableResource_QUALITY_OF_SERVICE_SPECIFICATION
+	case *admin.MatchingAttributes_PluginOverrides:
+		return admin.MatchableResource_PLUGIN_OVERRIDE
+	case *admin.MatchingAttributes_WorkflowExecutionConfig:
+		return admin.MatchableResource_WORKFLOW_EXECUTION_CONFIG
+	case *admin.MatchingAttributes_ClusterAssignment:
+		return admin.MatchableResource_CLUSTER_ASSIGNMENT
+	default:
+		return admin.MatchableResource_TASK_RESOURCE
+	}
+}
+
+// fetchMatchingAttributes returns the matching attributes of the given scope, nil if there are none.
+func fetchMatchingAttributes(ctx context.Context, cmdCtx cmdCore.CommandContext, resourceType admin.MatchableResource,
+	project, domain, workflow string) (*admin.MatchingAttributes, error) {
+
+	var attributes *admin.MatchingAttributes
+	var err error
+	switch {
+	case workflow != "":
+		var response *admin.WorkflowAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchWorkflowAttributes(ctx, project, domain, workflow, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	case domain != "":
+		var response *admin.ProjectDomainAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchProjectDomainAttributes(ctx, project, domain, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	default:
+		var response *admin.ProjectAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchProjectAttributes(ctx, project, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	}
+	if err != nil && !ext.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+func updateMatchingAttributes(ctx context.Context, cmdCtx cmdCore.CommandContext, project, domain, workflow string,
+	attributes *admin.MatchingAttributes) error {
+
+	switch {
+	case workflow != "":
+		return cmdCtx.AdminUpdaterExt().UpdateWorkflowAttributes(ctx, project, domain, workflow, attributes)
+	case domain != "":
+		return cmdCtx.AdminUpdaterExt().UpdateProjectDomainAttributes(ctx, project, domain, attributes)
+	default:
+		return cmdCtx.AdminUpdaterExt().UpdateProjectAttributes(ctx, project, attributes)
+	}
+}
@@ -0,0 +1,243 @@
+package importer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	projectconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	"github.com/flyteorg/flyte/flytectl/cmd/export"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	sourceProject = "flytesnacks"
+	sourceDomain  = "development"
+	targetProject = "dummyProject"
+	targetDomain  = "dummyDomain"
+)
+
+func sourceIdentifier(resourceType core.ResourceType, name string) *core.Identifier {
+	return &core.Identifier{ResourceType: resourceType, Project: sourceProject, Domain: sourceDomain, Name: name, Version: "v1"}
+}
+
+func gzipped(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// writeTestArchive writes an archive in the format of export project and returns its path.
+func writeTestArchive(t *testing.T) string {
+	task := &admin.TaskSpec{Template: &core.TaskTemplate{
+		Id: sourceIdentifier(core.ResourceType_TASK, "task1"),
+		Target: &core.TaskTemplate_Container{Container: &core.Container{
+			Args: []string{"pyflyte-fast-execute", export.FastRegisterDistributionArg, export.BundlesDir + "/fastabc.tar.gz", "--", "pyflyte-execute"},
+		}},
+	}}
+	workflow := &admin.WorkflowSpec{Template: &core.WorkflowTemplate{
+		Id: sourceIdentifier(core.ResourceType_WORKFLOW, "wf1"),
+		Nodes: []*core.Node{
+			{Id: "n0", Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{ReferenceId: sourceIdentifier(core.ResourceType_TASK, "task1")}}}},
+			{Id: "n1", Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{ReferenceId: &core.Identifier{
+					ResourceType: core.ResourceType_TASK, Project: "shared", Domain: sourceDomain, Name: "common", Version: "v9"}}}}},
+		},
+	}}
+	launchPlan := &admin.LaunchPlan{
+		Id:      sourceIdentifier(core.ResourceType_LAUNCH_PLAN, "wf1"),
+		Spec:    &admin.LaunchPlanSpec{WorkflowId: sourceIdentifier(core.ResourceType_WORKFLOW, "wf1")},
+		Closure: &admin.LaunchPlanClosure{State: admin.LaunchPlanState_ACTIVE},
+	}
+	attributes := &admin.ListMatchableAttributesResponse{Configurations: []*admin.MatchableAttributesConfiguration{
+		{Project: sourceProject, Domain: sourceDomain, Attributes: &admin.MatchingAttributes{Target: &admin.MatchingAttributes_ExecutionQueueAttributes{
+			ExecutionQueueAttributes: &admin.ExecutionQueueAttributes{Tags: []string{"gpu"}}}}},
+		{Project: sourceProject, Attributes: &admin.MatchingAttributes{Target: &admin.MatchingAttributes_ExecutionClusterLabel{
+			ExecutionClusterLabel: &admin.ExecutionClusterLabel{Value: "east"}}}},
+	}}
+
+	metadata, err := json.Marshal(export.Metadata{Project: sourceProject, Domain: sourceDomain})
+	assert.NoError(t, err)
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{export.MetadataFile, metadata},
+		{export.EntitiesDir + "/", nil},
+		{export.EntitiesDir + "/000000_task1_v1_1.pb", nil},
+		{export.EntitiesDir + "/000001_wf1_v1_2.pb", nil},
+		{export.EntitiesDir + "/000002_wf1_v1_3.pb", nil},
+		{export.BundlesDir + "/", nil},
+		{export.BundlesDir + "/fastabc.tar.gz", gzipped(t, []byte("code"))},
+		{export.AttributesFile, nil},
+	}
+	for i, message := range map[int]proto.Message{2: task, 3: workflow, 4: launchPlan, 7: attributes} {
+		files[i].data, err = proto.Marshal(message)
+		assert.NoError(t, err)
+	}
+
+	name := filepath.Join(t.TempDir(), "export.tgz")
+	file, err := os.Create(name)
+	assert.NoError(t, err)
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, f := range files {
+		header := &tar.Header{Typeflag: tar.TypeReg, Name: f.name, Mode: 0644, Size: int64(len(f.data))}
+		if f.data == nil {
+			header = &tar.Header{Typeflag: tar.TypeDir, Name: f.name, Mode: 0755}
+		}
+		assert.NoError(t, tarWriter.WriteHeader(header))
+		_, err := tarWriter.Write(f.data)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tarWriter.Close())
+	assert.NoError(t, gzipWriter.Close())
+	assert.NoError(t, file.Close())
+	return name
+}
+
+func resetImportConfig() {
+	*projectconfig.DefaultImportConfig = projectconfig.ImportConfig{OnConflict: projectconfig.ConflictSkip}
+}
+
+func expectNoEntities(s *testutils.TestStruct) {
+	notFound := status.Error(codes.NotFound, "not found")
+	s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, mock.Anything, mock.Anything, targetProject, targetDomain).Return(nil, notFound)
+	s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, mock.Anything, mock.Anything, targetProject, targetDomain).Return(nil, notFound)
+	s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, mock.Anything, mock.Anything, targetProject, targetDomain).Return(nil, notFound)
+}
+
+func expectAttributes(s *testutils.TestStruct) {
+	s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, targetProject, targetDomain, admin.MatchableResource_EXECUTION_QUEUE).Return(
+		nil, ext.NewNotFoundError("attributes"))
+	s.FetcherExt.EXPECT().FetchProjectAttributes(s.Ctx, targetProject, admin.MatchableResource_EXECUTION_CLUSTER_LABEL).Return(
+		&admin.ProjectAttributesGetResponse{Attributes: &admin.ProjectAttributes{MatchingAttributes: &admin.MatchingAttributes{
+			Target: &admin.MatchingAttributes_ExecutionClusterLabel{ExecutionClusterLabel: &admin.ExecutionClusterLabel{Value: "west"}}}}}, nil)
+}
+
+func TestImportCommand(t *testing.T) {
+	importCommand := CreateImportCommand()
+	assert.Len(t, importCommand, 1)
+	assert.Equal(t, importShort, importCommand["import"].Short)
+	assert.Equal(t, importLong, importCommand["import"].Long)
+	assert.False(t, importCommand["import"].ProjectDomainNotRequired)
+}
+
+func TestImportFunc(t *testing.T) {
+	t.Run("import", func(t *testing.T) {
+		defer resetImportConfig()
+		s := testutils.Setup(t)
+		archive := writeTestArchive(t)
+		uploaded := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			uploaded = r.Method == http.MethodPut
+		}))
+		defer server.Close()
+		expectNoEntities(&s)
+		expectAttributes(&s)
+		s.MockClient.DataProxyClient().(*mocks.DataProxyServiceClient).EXPECT().CreateUploadLocation(s.Ctx, mock.MatchedBy(
+			func(request *service.CreateUploadLocationRequest) bool {
+				return request.GetProject() == targetProject && request.GetDomain() == targetDomain && request.GetFilename() == "fastabc.tar.gz"
+			})).Return(&service.CreateUploadLocationResponse{SignedUrl: server.URL, NativeUrl: "s3://target/fastabc.tar.gz"}, nil).Once()
+		s.MockAdminClient.EXPECT().CreateTask(s.Ctx, mock.MatchedBy(func(request *admin.TaskCreateRequest) bool {
+			args := request.GetSpec().GetTemplate().GetContainer().GetArgs()
+			return request.GetId().GetProject() == targetProject && request.GetSpec().GetTemplate().GetId().GetDomain() == targetDomain &&
+				args[2] == "s3://target/fastabc.tar.gz"
+		})).Return(&admin.TaskCreateResponse{}, nil).Once()
+		s.MockAdminClient.EXPECT().CreateWorkflow(s.Ctx, mock.MatchedBy(func(request *admin.WorkflowCreateRequest) bool {
+			nodes := request.GetSpec().GetTemplate().GetNodes()
+			return proto.Equal(nodes[0].GetTaskNode().GetReferenceId(), &core.Identifier{
+				ResourceType: core.ResourceType_TASK, Project: targetProject, Domain: targetDomain, Name: "task1", Version: "v1"}) &&
+				nodes[1].GetTaskNode().GetReferenceId().GetProject() == "shared"
+		})).Return(&admin.WorkflowCreateResponse{}, nil).Once()
+		s.MockAdminClient.EXPECT().CreateLaunchPlan(s.Ctx, mock.MatchedBy(func(request *admin.LaunchPlanCreateRequest) bool {
+			return request.GetSpec().GetWorkflowId().GetProject() == targetProject
+		})).Return(&admin.LaunchPlanCreateResponse{}, nil).Once()
+		s.MockAdminClient.EXPECT().UpdateLaunchPlan(s.Ctx, &admin.LaunchPlanUpdateRequest{
+			Id:    &core.Identifier{Project: targetProject, Domain: targetDomain, Name: "wf1", Version: "v1"},
+			State: admin.LaunchPlanState_ACTIVE,
+		}).Return(&admin.LaunchPlanUpdateResponse{}, nil).Once()
+		s.UpdaterExt.EXPECT().UpdateProjectDomainAttributes(s.Ctx, targetProject, targetDomain, mock.Anything).Return(nil).Once()
+
+		err := importFunc(s.Ctx, []string{archive}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		assert.True(t, uploaded)
+		s.TearDownAndVerify(t, `Registered task task1 version v1
+Registered workflow wf1 version v1
+Registered launch plan wf1 version v1 (active)
+Updated execution_queue attributes of dummyProject/dummyDomain
+Skipped execution_cluster_label attributes of dummyProject: already exist
+Imported 4 entities and matchable attributes of flytesnacks/development into dummyProject/dummyDomain, skipped 1 existing ones`)
+	})
+	t.Run("override version", func(t *testing.T) {
+		defer resetImportConfig()
+		s := testutils.Setup(t)
+		archive := writeTestArchive(t)
+		projectconfig.DefaultImportConfig.Version = "v2"
+		projectconfig.DefaultImportConfig.DryRun = true
+		notFound := status.Error(codes.NotFound, "not found")
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "task1", "v2", targetProject, targetDomain).Return(nil, notFound)
+		s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, "wf1", "v2", targetProject, targetDomain).Return(nil, notFound)
+		s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, "wf1", "v2", targetProject, targetDomain).Return(nil, notFound)
+		expectAttributes(&s)
+
+		err := importFunc(s.Ctx, []string{archive}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.MockAdminClient.AssertNotCalled(t, "CreateTask", mock.Anything, mock.Anything)
+		s.TearDownAndVerify(t, `Would register task task1 version v2
+Would register workflow wf1 version v2
+Would register launch plan wf1 version v2
+Would update execution_queue attributes of dummyProject/dummyDomain
+Skipped execution_cluster_label attributes of dummyProject: already exist
+Skipping import (dryRun)`)
+	})
+	t.Run("fail on conflict", func(t *testing.T) {
+		defer resetImportConfig()
+		s := testutils.Setup(t)
+		archive := writeTestArchive(t)
+		projectconfig.DefaultImportConfig.OnConflict = projectconfig.ConflictFail
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "task1", "v1", targetProject, targetDomain).Return(&admin.Task{}, nil)
+
+		err := importFunc(s.Ctx, []string{archive}, s.CmdCtx)
+
+		assert.EqualError(t, err, "failed importing 000000_task1_v1_1.pb after 0 entities were imported: task task1 version v1 already exists in dummyProject/dummyDomain")
+	})
+	t.Run("invalid conflict policy", func(t *testing.T) {
+		defer resetImportConfig()
+		s := testutils.Setup(t)
+		projectconfig.DefaultImportConfig.OnConflict = "overwrite"
+
+		err := importFunc(s.Ctx, []string{"export.tgz"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "invalid onConflict [overwrite], must be one of skip or fail")
+	})
+	t.Run("no archive", func(t *testing.T) {
+		s := testutils.Setup(t)
+
+		err := importFunc(s.Ctx, nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "exactly one archive must be passed")
+	})
+}
@@ -0,0 +1,132 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/compile"
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/lint"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flytectl/pkg/lint"
+)
+
+const (
+	lintShort = `Check flyte packages against organizational rules before registration.`
+	lintLong  = `
+Compile the serialized protobuf files of a package (tasks, workflows and launch plans) like the compile command, then
+check them against lint rules such as resource limits, banned images or required retries. No flyte cluster is required.
+The command fails if any violation has the error severity.
+
+::
+
+ flytectl lint --file my-flyte-package.tgz
+
+The rules and their severities (error, warning, info or off) are configured in a YAML file:
+
+::
+
+ flytectl lint --file my-flyte-package.tgz --rules lint.yaml
+
+For example:
+
+.. code-block:: yaml
+
+    rules:
+      resource-limits:
+        maxCpu: "8"
+        maxMemory: 64Gi
+      banned-images:
+        images:
+          - docker.io/
+          - "*:latest"
+      retries:
+        severity: error
+        min: 2
+      timeout:
+        max: 4h
+      cache-version:
+        severity: error
+      interruptible:
+        cpu: "16"
+        gpu: "1"
+      max-parallelism:
+        max: 50
+
+Report the violations in SARIF, e.g. to upload them to code scanning tools:
+
+::
+
+ flytectl lint --file my-flyte-package.tgz --format sarif > lint.sarif
+
+.. note::
+   The same rules can be enforced on registration with register files --lint.
+
+Usage
+`
+)
+
+func lintFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	packageFilePath := config.DefaultLintConfig.File
+	if packageFilePath == "" {
+		return fmt.Errorf("path to package tgz's file is a required flag")
+	}
+	if err := config.DefaultLintConfig.Validate(); err != nil {
+		return err
+	}
+	linter, err := lint.NewLinter(config.DefaultLintConfig.Rules)
+	if err != nil {
+		return err
+	}
+
+	files, tmpDir, err := register.GetSerializeOutputFiles(ctx, []string{packageFilePath}, true)
+	defer os.RemoveAll(tmpDir)
+	if err != nil {
+		return err
+	}
+	var protoFiles []string
+	for _, file := range files {
+		if strings.HasSuffix(file, ".pb") {
+			protoFiles = append(protoFiles, file)
+		}
+	}
+	pkg, err := register.ReadPackage(ctx, protoFiles)
+	if err != nil {
+		return err
+	}
+	if err := compile.CompileEntities(pkg.Tasks, pkg.Workflows, pkg.LaunchPlans, io.Discard); err != nil {
+		return fmt.Errorf("failed to compile %s: %w", packageFilePath, err)
+	}
+
+	violations := linter.Lint(pkg)
+	if config.DefaultLintConfig.Format == lint.FormatSARIF {
+		err = lint.WriteSARIF(os.Stdout, linter.Rules(), violations, packageFilePath)
+	} else {
+		err = lint.WriteHuman(os.Stdout, violations)
+	}
+	if err != nil {
+		return err
+	}
+
+	if errors := lint.Count(violations, lint.SeverityError); errors > 0 {
+		return fmt.Errorf("found %d lint errors in %s", errors, packageFilePath)
+	}
+	return nil
+}
+
+func CreateLintCommand() map[string]cmdCore.CommandEntry {
+	lintResourcesFuncs := map[string]cmdCore.CommandEntry{
+		"lint": {
+			Short:                    lintShort,
+			Long:                     lintLong,
+			CmdFunc:                  lintFunc,
+			PFlagProvider:            config.DefaultLintConfig,
+			ProjectDomainNotRequired: true,
+			DisableFlyteClient:       true,
+		},
+	}
+	return lintResourcesFuncs
+}
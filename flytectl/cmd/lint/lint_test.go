@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"context"
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/lint"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/lint"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+const validPackage = "../compile/testdata/valid-package.tgz"
+
+func TestLintCommand(t *testing.T) {
+	rootCmd := &cobra.Command{
+		Long:              "Flytectl is a CLI tool written in Go to interact with the FlyteAdmin service.",
+		Short:             "Flytectl CLI tool",
+		Use:               "flytectl",
+		DisableAutoGenTag: true,
+	}
+	cmdCore.AddCommands(rootCmd, CreateLintCommand())
+	cmdNouns := rootCmd.Commands()
+	assert.Equal(t, cmdNouns[0].Use, "lint")
+	assert.Equal(t, cmdNouns[0].Short, lintShort)
+	assert.Equal(t, cmdNouns[0].Flags().Lookup("rules").Name, "rules")
+}
+
+func TestLintFunc(t *testing.T) {
+	defer func() { config.DefaultLintConfig = &config.Config{Format: lint.FormatHuman} }()
+
+	t.Run("default rules", func(t *testing.T) {
+		s := testutils.Setup(t)
+		config.DefaultLintConfig = &config.Config{File: validPackage, Format: lint.FormatHuman}
+
+		err := lintFunc(context.Background(), nil, s.CmdCtx)
+
+		assert.EqualError(t, err, "found 4 lint errors in "+validPackage)
+		s.TearDownAndVerifyContains(t, "4 errors, 4 warnings and 0 infos found")
+	})
+	t.Run("configured rules", func(t *testing.T) {
+		s := testutils.Setup(t)
+		config.DefaultLintConfig = &config.Config{File: validPackage, Rules: "testdata/rules.yaml", Format: lint.FormatHuman}
+
+		err := lintFunc(context.Background(), nil, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerifyContains(t, "0 errors, 4 warnings and 0 infos found")
+	})
+	t.Run("sarif", func(t *testing.T) {
+		s := testutils.Setup(t)
+		config.DefaultLintConfig = &config.Config{File: validPackage, Rules: "testdata/rules.yaml", Format: lint.FormatSARIF}
+
+		err := lintFunc(context.Background(), nil, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerifyContains(t, `"level": "warning"`)
+	})
+	t.Run("invalid package", func(t *testing.T) {
+		s := testutils.Setup(t)
+		config.DefaultLintConfig = &config.Config{File: "../compile/testdata/bad-workflow-package.tgz", Format: lint.FormatHuman}
+
+		err := lintFunc(context.Background(), nil, s.CmdCtx)
+
+		assert.ErrorContains(t, err, "failed to compile ../compile/testdata/bad-workflow-package.tgz")
+	})
+	t.Run("invalid flags", func(t *testing.T) {
+		s := testutils.Setup(t)
+		config.DefaultLintConfig = &config.Config{Format: lint.FormatHuman}
+		assert.EqualError(t, lintFunc(context.Background(), nil, s.CmdCtx), "path to package tgz's file is a required flag")
+
+		config.DefaultLintConfig = &config.Config{File: validPackage, Format: "xml"}
+		assert.EqualError(t, lintFunc(context.Background(), nil, s.CmdCtx), "invalid format [xml], must be one of human or sarif")
+	})
+}
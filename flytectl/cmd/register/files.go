@@ -9,6 +9,7 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
 	rconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/register"
 	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/lint"
 	"github.com/flyteorg/flyte/flytectl/pkg/printer"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/storage"
@@ -106,12 +107,59 @@ Enable schedule for the launchplans part of the serialized protobuf files:
 ::
 
  flytectl register file  _pb_output/* -d development  -p flytesnacks --version v2 --enableSchedule
+
+Lint the files before registering them, failing on lint errors. The rules are configured as for the lint command:
+
+::
+
+ flytectl register file  _pb_output/* -d development  -p flytesnacks --version v2 --lint --lintRules lint.yaml
 	
 Usage
 `
 	sourceCodeExtension = ".tar.gz"
 )
 
+// ReadPackage reads the serialized entities of the files, e.g. to lint them.
+func ReadPackage(ctx context.Context, files []string) (*lint.Package, error) {
+	pkg := &lint.Package{}
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		spec, err := UnMarshalContents(ctx, raw, file)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Add(spec)
+	}
+	return pkg, nil
+}
+
+// lintFiles prints the lint violations of the serialized entities of the files and fails on errors.
+func lintFiles(ctx context.Context, files []string, rulesFile string) error {
+	linter, err := lint.NewLinter(rulesFile)
+	if err != nil {
+		return err
+	}
+	pkg, err := ReadPackage(ctx, files)
+	if err != nil {
+		return err
+	}
+
+	violations := linter.Lint(pkg)
+	if len(violations) == 0 {
+		return nil
+	}
+	if err := lint.WriteHuman(os.Stdout, violations); err != nil {
+		return err
+	}
+	if errors := lint.Count(violations, lint.SeverityError); errors > 0 {
+		return fmt.Errorf("found %d lint errors, skipping registration", errors)
+	}
+	return nil
+}
+
 func registerFromFilesFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
 	return Register(ctx, args, config.GetConfig(), cmdCtx)
 }
@@ -139,11 +187,17 @@ func Register(ctx context.Context, args []string, cfg *config.Config, cmdCtx cmd
 		return fmt.Errorf("input package have some invalid files. try to run pyflyte package again %v", InvalidFiles)
 	}
 
+	if rconfig.DefaultFilesConfig.Lint {
+		if err := lintFiles(ctx, validProto, rconfig.DefaultFilesConfig.LintRules); err != nil {
+			return err
+		}
+	}
+
 	// In case of fast serialize input upload source code to destination bucket
 	var uploadLocation storage.DataReference
 	if len(sourceCodePath) > 0 {
 		logger.Infof(ctx, "Fast Registration detected")
-		uploadLocation, err = uploadFastRegisterArtifact(ctx, cfg.Project, cfg.Domain, sourceCodePath, rconfig.DefaultFilesConfig.Version,
+		uploadLocation, err = UploadFastRegisterArtifact(ctx, cfg.Project, cfg.Domain, sourceCodePath, rconfig.DefaultFilesConfig.Version,
 			cmdCtx.ClientSet().DataProxyClient(), rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
 		if err != nil {
 			return fmt.Errorf("failed to upload source code from [%v]. Error: %w", sourceCodePath, err)
@@ -35,6 +35,19 @@ func TestRegisterFromFiles(t *testing.T) {
 		err := registerFromFilesFunc(s.Ctx, args, s.CmdCtx)
 		assert.Nil(t, err)
 	})
+	t.Run("Lint errors", func(t *testing.T) {
+		s := testutils.Setup(t)
+
+		registerFilesSetup()
+		rconfig.DefaultFilesConfig.Archive = true
+		rconfig.DefaultFilesConfig.Lint = true
+		defer func() { rconfig.DefaultFilesConfig.Lint = false }()
+
+		args := []string{"testdata/valid-register.tgz"}
+		err := registerFromFilesFunc(s.Ctx, args, s.CmdCtx)
+		assert.ErrorContains(t, err, "lint errors, skipping registration")
+		s.MockAdminClient.AssertNotCalled(t, "CreateTask", mock.Anything, mock.Anything)
+	})
 	t.Run("Valid fast registration", func(t *testing.T) {
 		s := testutils.Setup(t)
 
@@ -689,6 +689,18 @@ func registerFile(ctx context.Context, fileName string, registerResults []Result
 	return registerResults, nil
 }
 
+// RegisterSpec hydrates the given task, workflow or launch plan spec, validates it and registers it in the configured
+// project and domain.
+func RegisterSpec(ctx context.Context, spec proto.Message, cmdCtx cmdCore.CommandContext, uploadLocation storage.DataReference, config rconfig.FilesConfig) error {
+	if err := hydrateSpec(spec, uploadLocation, config); err != nil {
+		return fmt.Errorf("error hydrating spec due to %w", err)
+	}
+	if err := validateSpec(ctx, spec, cmdCtx); err != nil {
+		return fmt.Errorf("error validating spec due to %w", err)
+	}
+	return register(ctx, spec, cmdCtx, config.DryRun, config.EnableSchedule)
+}
+
 func getArchiveReaderCloser(ctx context.Context, ref string) (io.ReadCloser, error) {
 	dataRef := storage.DataReference(ref)
 	scheme, _, key, err := dataRef.Split()
@@ -784,7 +796,9 @@ func getTotalSize(reader io.Reader) (size int64, err error) {
 	return size, err
 }
 
-func uploadFastRegisterArtifact(ctx context.Context, project, domain, sourceCodeFilePath, version string,
+// UploadFastRegisterArtifact uploads the compressed source code of fast registered entities through the data proxy, or
+// to the configured storage with older versions of FlyteAdmin, and returns its remote location.
+func UploadFastRegisterArtifact(ctx context.Context, project, domain, sourceCodeFilePath, version string,
 	dataProxyClient service.DataProxyServiceClient, deprecatedSourceUploadPath string) (uploadLocation storage.DataReference, err error) {
 
 	fileHandle, err := os.Open(sourceCodeFilePath)
@@ -837,7 +851,7 @@ func uploadFastRegisterArtifact(ctx context.Context, project, domain, sourceCode
 		return storage.DataReference(resp.GetNativeUrl()), DirectUpload(resp.GetSignedUrl(), h, size, dataRefReaderCloser)
 	}
 
-	dataStore, err := getStorageClient(ctx)
+	dataStore, err := GetStorageClient(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -889,7 +903,8 @@ func DirectUpload(url string, contentMD5 []byte, size int64, data io.Reader) err
 	return nil
 }
 
-func getStorageClient(ctx context.Context) (*storage.DataStore, error) {
+// GetStorageClient returns the client of the storage configured in the storage section.
+func GetStorageClient(ctx context.Context) (*storage.DataStore, error) {
 	if Client != nil {
 		return Client, nil
 	}
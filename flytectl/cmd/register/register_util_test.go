@@ -59,6 +59,7 @@ func registerFilesSetup() {
 	rconfig.DefaultFilesConfig.K8sServiceAccount = ""
 	rconfig.DefaultFilesConfig.OutputLocationPrefix = ""
 	rconfig.DefaultFilesConfig.EnableSchedule = true
+	rconfig.DefaultFilesConfig.Lint = false
 }
 
 func TestGetSortedArchivedFileWithParentFolderList(t *testing.T) {
@@ -409,7 +410,7 @@ func TestUploadFastRegisterArtifact(t *testing.T) {
 			Filename:   "flytesnacks-core.tgz",
 			ContentMd5: []uint8{0x19, 0x72, 0x39, 0xcd, 0x85, 0x2d, 0xf1, 0x79, 0x8f, 0x6b, 0x3, 0xb3, 0xa9, 0x6c, 0xec, 0xa0},
 		}).Return(&service.CreateUploadLocationResponse{}, nil)
-		_, err = uploadFastRegisterArtifact(s.Ctx, "flytesnacks", "development", "testdata/flytesnacks-core.tgz", "", s.MockClient.DataProxyClient(), rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
+		_, err = UploadFastRegisterArtifact(s.Ctx, "flytesnacks", "development", "testdata/flytesnacks-core.tgz", "", s.MockClient.DataProxyClient(), rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
 		assert.Nil(t, err)
 	})
 	t.Run("Failed upload", func(t *testing.T) {
@@ -428,7 +429,7 @@ func TestUploadFastRegisterArtifact(t *testing.T) {
 			Filename:   "flytesnacks-core.tgz",
 			ContentMd5: []uint8{0x19, 0x72, 0x39, 0xcd, 0x85, 0x2d, 0xf1, 0x79, 0x8f, 0x6b, 0x3, 0xb3, 0xa9, 0x6c, 0xec, 0xa0},
 		}).Return(&service.CreateUploadLocationResponse{}, nil)
-		_, err = uploadFastRegisterArtifact(context.Background(), "flytesnacks", "development", "testdata/flytesnacks-core.tgz", "", s.MockClient.DataProxyClient(), rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
+		_, err = UploadFastRegisterArtifact(context.Background(), "flytesnacks", "development", "testdata/flytesnacks-core.tgz", "", s.MockClient.DataProxyClient(), rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
 		assert.Nil(t, err)
 	})
 	t.Run("Failed upload", func(t *testing.T) {
@@ -439,7 +440,7 @@ func TestUploadFastRegisterArtifact(t *testing.T) {
 		}, testScope.NewSubScope("flytectl"))
 		assert.Nil(t, err)
 		Client = s
-		_, err = uploadFastRegisterArtifact(context.Background(), "flytesnacks", "development", "testdata/flytesnacksre.tgz", "", nil, rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
+		_, err = UploadFastRegisterArtifact(context.Background(), "flytesnacks", "development", "testdata/flytesnacksre.tgz", "", nil, rconfig.DefaultFilesConfig.DeprecatedSourceUploadPath)
 		assert.NotNil(t, err)
 	})
 }
@@ -447,7 +448,7 @@ func TestUploadFastRegisterArtifact(t *testing.T) {
 func TestGetStorageClient(t *testing.T) {
 	t.Run("Failed to create storage client", func(t *testing.T) {
 		Client = nil
-		s, err := getStorageClient(context.Background())
+		s, err := GetStorageClient(context.Background())
 		assert.NotNil(t, err)
 		assert.Nil(t, s)
 	})
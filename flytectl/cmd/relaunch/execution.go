@@ -0,0 +1,137 @@
+package relaunch
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/bulk"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	relaunchExecutionShort = "Relaunches executions with the same inputs."
+	relaunchExecutionLong  = `
+Relaunch executions with their names:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development oeh94k9r2r wf3j2kxu1l
+
+.. note::
+    The terms execution/executions are interchangeable in these commands.
+
+Relaunch all the executions matching a filter, e.g. the failed executions of a launch plan started after a date:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED,launch_plan.name=core.basic.lp.go_greet,execution.created_at>2021-02-17T00:00:00Z"
+
+The executions can also be selected by their labels, along with the filter:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED" --labels team=ml
+
+Preview the executions to relaunch:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED" --dryRun
+
+Skip cached results and recompute all the outputs:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development oeh94k9r2r --overwriteCache
+
+Executions are relaunched concurrently, 4 at a time and up to 10 per second by default.
+Record the progress in a journal to resume an interrupted run, skipping the executions already relaunched:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED" --concurrency 8 --rate 20 --journal relaunch.jsonl
+
+Usage
+`
+	recoverExecutionShort = "Recovers executions from their last known failure point."
+	recoverExecutionLong  = `
+Recover executions with their names, reusing the outputs of the nodes which succeeded, e.g. after a system failure:
+::
+
+ flytectl recover execution -p flytesnacks -d development oeh94k9r2r wf3j2kxu1l
+
+.. note::
+    The terms execution/executions are interchangeable in these commands.
+
+Recover all the executions matching a filter, e.g. the executions which failed after an infrastructure incident:
+::
+
+ flytectl recover execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED,execution.created_at>2021-02-17T08:00:00Z,execution.created_at<2021-02-17T10:00:00Z"
+
+Preview the executions to recover:
+::
+
+ flytectl recover execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED" --dryRun
+
+Executions are recovered concurrently, 4 at a time and up to 10 per second by default.
+Record the progress in a journal to resume an interrupted run, skipping the executions already recovered:
+::
+
+ flytectl recover execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED" --journal recover.jsonl
+
+Usage
+`
+)
+
+func relaunchExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	relaunchConfig := execution.DefaultExecRelaunchConfig
+	return runExecutions(ctx, args, cmdCtx, relaunchConfig.Filter, relaunchConfig.Labels, bulk.Options{
+		Concurrency: relaunchConfig.Concurrency,
+		Rate:        relaunchConfig.Rate,
+		Journal:     relaunchConfig.Journal,
+		DryRun:      relaunchConfig.DryRun,
+	}, bulk.Operation{
+		Name: "relaunch",
+		Done: "Relaunched",
+		Run: func(ctx context.Context, id *core.WorkflowExecutionIdentifier) (string, error) {
+			relaunched, err := cmdCtx.AdminClient().RelaunchExecution(ctx, &admin.ExecutionRelaunchRequest{
+				Id:             id,
+				OverwriteCache: relaunchConfig.OverwriteCache,
+			})
+			return relaunched.GetId().GetName(), err
+		},
+	})
+}
+
+func recoverExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	recoverConfig := execution.DefaultExecRecoverConfig
+	return runExecutions(ctx, args, cmdCtx, recoverConfig.Filter, recoverConfig.Labels, bulk.Options{
+		Concurrency: recoverConfig.Concurrency,
+		Rate:        recoverConfig.Rate,
+		Journal:     recoverConfig.Journal,
+		DryRun:      recoverConfig.DryRun,
+	}, bulk.Operation{
+		Name: "recover",
+		Done: "Recovered",
+		Run: func(ctx context.Context, id *core.WorkflowExecutionIdentifier) (string, error) {
+			recovered, err := cmdCtx.AdminClient().RecoverExecution(ctx, &admin.ExecutionRecoverRequest{Id: id})
+			return recovered.GetId().GetName(), err
+		},
+	})
+}
+
+// runExecutions runs the operation on the given executions, or on the ones matching the filter and labels.
+func runExecutions(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext, filter filters.Filters, labels string,
+	options bulk.Options, operation bulk.Operation) error {
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	ids := make([]*core.WorkflowExecutionIdentifier, 0, len(args))
+	for _, name := range args {
+		ids = append(ids, &core.WorkflowExecutionIdentifier{Project: project, Domain: domain, Name: name})
+	}
+	if len(args) == 0 {
+		var err error
+		if ids, err = bulk.SelectExecutions(ctx, cmdCtx.AdminFetcherExt(), project, domain, filter, labels); err != nil {
+			return err
+		}
+	}
+	_, err := bulk.Run(ctx, operation, ids, options)
+	return err
+}
@@ -0,0 +1,95 @@
+package relaunch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func executionID(name string) *core.WorkflowExecutionIdentifier {
+	return &core.WorkflowExecutionIdentifier{Project: "dummyProject", Domain: "dummyDomain", Name: name}
+}
+
+func setupRelaunchConfig(t *testing.T) {
+	*execution.DefaultExecRelaunchConfig = execution.ExecRelaunchConfig{Filter: filters.DefaultFilter, Concurrency: 1}
+	t.Cleanup(func() {
+		*execution.DefaultExecRelaunchConfig = execution.ExecRelaunchConfig{Filter: filters.DefaultFilter, Concurrency: 4, Rate: 10}
+	})
+}
+
+func TestRelaunchExecutionFunc(t *testing.T) {
+	t.Run("By filter", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupRelaunchConfig(t)
+		execution.DefaultExecRelaunchConfig.Filter.FieldSelector = "execution.phase=FAILED"
+		execution.DefaultExecRelaunchConfig.OverwriteCache = true
+		s.FetcherExt.EXPECT().ListExecution(s.Ctx, "dummyProject", "dummyDomain", mock.Anything).Return(&admin.ExecutionList{
+			Executions: []*admin.Execution{{Id: executionID("exec1")}, {Id: executionID("exec2")}},
+		}, nil)
+		s.MockAdminClient.EXPECT().RelaunchExecution(s.Ctx, &admin.ExecutionRelaunchRequest{Id: executionID("exec1"), OverwriteCache: true}).
+			Return(&admin.ExecutionCreateResponse{Id: executionID("relaunched1")}, nil)
+		s.MockAdminClient.EXPECT().RelaunchExecution(s.Ctx, &admin.ExecutionRelaunchRequest{Id: executionID("exec2"), OverwriteCache: true}).
+			Return(&admin.ExecutionCreateResponse{Id: executionID("relaunched2")}, nil)
+
+		err := relaunchExecutionFunc(s.Ctx, nil, s.CmdCtx)
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `Relaunched execution exec1 as relaunched1
+Relaunched execution exec2 as relaunched2
+Relaunched 2 executions, 0 failed and 0 skipped`)
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupRelaunchConfig(t)
+		execution.DefaultExecRelaunchConfig.Labels = "team=ml"
+		execution.DefaultExecRelaunchConfig.DryRun = true
+		s.FetcherExt.EXPECT().ListExecution(s.Ctx, "dummyProject", "dummyDomain", mock.Anything).Return(&admin.ExecutionList{
+			Executions: []*admin.Execution{
+				{Id: executionID("exec1"), Spec: &admin.ExecutionSpec{Labels: &admin.Labels{Values: map[string]string{"team": "ml"}}}},
+				{Id: executionID("exec2")},
+			},
+		}, nil)
+
+		err := relaunchExecutionFunc(s.Ctx, nil, s.CmdCtx)
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `Would relaunch execution exec1
+Skipping relaunch of 1 executions (dryRun)`)
+	})
+
+	t.Run("No selector", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupRelaunchConfig(t)
+
+		err := relaunchExecutionFunc(s.Ctx, nil, s.CmdCtx)
+		assert.EqualError(t, err, "filter.fieldSelector or labels are required to select the executions")
+	})
+}
+
+func setupRecoverConfig(t *testing.T) {
+	*execution.DefaultExecRecoverConfig = execution.ExecRecoverConfig{Filter: filters.DefaultFilter, Concurrency: 1}
+	t.Cleanup(func() {
+		*execution.DefaultExecRecoverConfig = execution.ExecRecoverConfig{Filter: filters.DefaultFilter, Concurrency: 4, Rate: 10}
+	})
+}
+
+func TestRecoverExecutionFunc(t *testing.T) {
+	s := testutils.Setup(t)
+	setupRecoverConfig(t)
+	s.MockAdminClient.EXPECT().RecoverExecution(s.Ctx, &admin.ExecutionRecoverRequest{Id: executionID("exec1")}).
+		Return(&admin.ExecutionCreateResponse{Id: executionID("recovered1")}, nil)
+	s.MockAdminClient.EXPECT().RecoverExecution(s.Ctx, &admin.ExecutionRecoverRequest{Id: executionID("exec2")}).
+		Return(nil, fmt.Errorf("not found"))
+
+	err := recoverExecutionFunc(s.Ctx, []string{"exec1", "exec2"}, s.CmdCtx)
+	assert.EqualError(t, err, "failed to recover 1 executions")
+	s.TearDownAndVerify(t, `Recovered execution exec1 as recovered1
+Failed to recover execution exec2: not found
+Recovered 1 executions, 1 failed and 0 skipped`)
+}
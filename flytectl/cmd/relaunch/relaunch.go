@@ -0,0 +1,59 @@
+package relaunch
+
+import (
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	relaunchCmdShort = `Relaunches Flyte resources such as executions.`
+	relaunchCmdLong  = `
+Relaunch the executions matching a filter with the same inputs:
+::
+
+ flytectl relaunch execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED"
+`
+	recoverCmdShort = `Recovers Flyte resources such as executions from their last known failure point.`
+	recoverCmdLong  = `
+Recover the executions matching a filter, reusing the outputs of their successful nodes:
+::
+
+ flytectl recover execution -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED"
+`
+)
+
+// CreateRelaunchCommand will return relaunch command
+func CreateRelaunchCommand() *cobra.Command {
+	relaunchCmd := &cobra.Command{
+		Use:   "relaunch",
+		Short: relaunchCmdShort,
+		Long:  relaunchCmdLong,
+	}
+
+	relaunchResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"execution": {CmdFunc: relaunchExecutionFunc, Aliases: []string{"executions"}, Short: relaunchExecutionShort,
+			Long: relaunchExecutionLong, PFlagProvider: execution.DefaultExecRelaunchConfig},
+	}
+
+	cmdcore.AddCommands(relaunchCmd, relaunchResourcesFuncs)
+	return relaunchCmd
+}
+
+// CreateRecoverCommand will return recover command
+func CreateRecoverCommand() *cobra.Command {
+	recoverCmd := &cobra.Command{
+		Use:   "recover",
+		Short: recoverCmdShort,
+		Long:  recoverCmdLong,
+	}
+
+	recoverResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"execution": {CmdFunc: recoverExecutionFunc, Aliases: []string{"executions"}, Short: recoverExecutionShort,
+			Long: recoverExecutionLong, PFlagProvider: execution.DefaultExecRecoverConfig},
+	}
+
+	cmdcore.AddCommands(recoverCmd, recoverResourcesFuncs)
+	return recoverCmd
+}
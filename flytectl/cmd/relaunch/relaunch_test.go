@@ -0,0 +1,33 @@
+package relaunch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelaunchCommand(t *testing.T) {
+	relaunchCommand := CreateRelaunchCommand()
+	assert.Equal(t, relaunchCommand.Use, "relaunch")
+	assert.Equal(t, relaunchCommand.Short, relaunchCmdShort)
+	assert.Equal(t, relaunchCommand.Long, relaunchCmdLong)
+	assert.Equal(t, len(relaunchCommand.Commands()), 1)
+	cmdNouns := relaunchCommand.Commands()
+	assert.Equal(t, cmdNouns[0].Use, "execution")
+	assert.Equal(t, cmdNouns[0].Aliases, []string{"executions"})
+	assert.Equal(t, cmdNouns[0].Short, relaunchExecutionShort)
+	assert.Equal(t, cmdNouns[0].Long, relaunchExecutionLong)
+}
+
+func TestRecoverCommand(t *testing.T) {
+	recoverCommand := CreateRecoverCommand()
+	assert.Equal(t, recoverCommand.Use, "recover")
+	assert.Equal(t, recoverCommand.Short, recoverCmdShort)
+	assert.Equal(t, recoverCommand.Long, recoverCmdLong)
+	assert.Equal(t, len(recoverCommand.Commands()), 1)
+	cmdNouns := recoverCommand.Commands()
+	assert.Equal(t, cmdNouns[0].Use, "execution")
+	assert.Equal(t, cmdNouns[0].Aliases, []string{"executions"})
+	assert.Equal(t, cmdNouns[0].Short, recoverExecutionShort)
+	assert.Equal(t, cmdNouns[0].Long, recoverExecutionLong)
+}
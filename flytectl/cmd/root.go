@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/flyteorg/flyte/flytectl/cmd/apply"
+	"github.com/flyteorg/flyte/flytectl/cmd/approve"
 	"github.com/flyteorg/flyte/flytectl/cmd/compile"
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
 	configuration "github.com/flyteorg/flyte/flytectl/cmd/configuration"
@@ -12,12 +14,19 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/create"
 	"github.com/flyteorg/flyte/flytectl/cmd/delete"
 	"github.com/flyteorg/flyte/flytectl/cmd/demo"
+	"github.com/flyteorg/flyte/flytectl/cmd/diff"
+	"github.com/flyteorg/flyte/flytectl/cmd/export"
 	"github.com/flyteorg/flyte/flytectl/cmd/get"
+	"github.com/flyteorg/flyte/flytectl/cmd/importer"
+	"github.com/flyteorg/flyte/flytectl/cmd/lint"
 	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flytectl/cmd/relaunch"
 	"github.com/flyteorg/flyte/flytectl/cmd/sandbox"
+	"github.com/flyteorg/flyte/flytectl/cmd/set"
 	"github.com/flyteorg/flyte/flytectl/cmd/update"
 	"github.com/flyteorg/flyte/flytectl/cmd/upgrade"
 	"github.com/flyteorg/flyte/flytectl/cmd/version"
+	"github.com/flyteorg/flyte/flytectl/cmd/watch"
 	f "github.com/flyteorg/flyte/flytectl/pkg/filesystemutils"
 	"github.com/flyteorg/flyte/flytectl/pkg/printer"
 	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
@@ -54,16 +63,26 @@ func newRootCmd() *cobra.Command {
 	// --root.project, this adds a convenience on top to allow --project to be used
 	rootCmd.PersistentFlags().StringVarP(&(config.GetConfig().Project), "project", "p", "", "Specifies the Flyte project.")
 	rootCmd.PersistentFlags().StringVarP(&(config.GetConfig().Domain), "domain", "d", "", "Specifies the Flyte project's domain.")
-	rootCmd.PersistentFlags().StringVarP(&(config.GetConfig().Output), "output", "o", printer.OutputFormatTABLE.String(), fmt.Sprintf("Specifies the output type - supported formats %s. NOTE: dot, doturl are only supported for Workflow", printer.OutputFormats()))
+	rootCmd.PersistentFlags().StringVarP(&(config.GetConfig().Output), "output", "o", printer.OutputFormatTABLE.String(), fmt.Sprintf("Specifies the output type - supported formats %s. NOTE: dot, doturl, mermaid are only supported for Workflow and html for Workflow and Execution", printer.OutputFormats()))
 	rootCmd.PersistentFlags().BoolVarP(&(config.GetConfig().Interactive), "interactive", "i", false, "Set this flag to use an interactive CLI")
 
 	rootCmd.AddCommand(get.CreateGetCommand())
 	compileCmd := compile.CreateCompileCommand()
 	cmdCore.AddCommands(rootCmd, compileCmd)
+	cmdCore.AddCommands(rootCmd, lint.CreateLintCommand())
+	cmdCore.AddCommands(rootCmd, apply.CreateApplyCommand())
+	cmdCore.AddCommands(rootCmd, approve.CreateApproveCommand())
 	rootCmd.AddCommand(create.RemoteCreateCommand())
 	rootCmd.AddCommand(update.CreateUpdateCommand())
 	rootCmd.AddCommand(register.RemoteRegisterCommand())
 	rootCmd.AddCommand(delete.RemoteDeleteCommand())
+	rootCmd.AddCommand(relaunch.CreateRelaunchCommand())
+	rootCmd.AddCommand(relaunch.CreateRecoverCommand())
+	rootCmd.AddCommand(export.CreateExportCommand())
+	cmdCore.AddCommands(rootCmd, importer.CreateImportCommand())
+	rootCmd.AddCommand(watch.CreateWatchCommand())
+	rootCmd.AddCommand(diff.CreateDiffCommand())
+	rootCmd.AddCommand(set.CreateSetCommand())
 	rootCmd.AddCommand(sandbox.CreateSandboxCommand())
 	rootCmd.AddCommand(demo.CreateDemoCommand())
 	rootCmd.AddCommand(configuration.CreateConfigCommand())
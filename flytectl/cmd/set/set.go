@@ -0,0 +1,35 @@
+package set
+
+import (
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	setCmdShort = `Sets the value of Flyte resources such as signals.`
+	setCmdLong  = `
+Provide the input a gate node of an execution is waiting on:
+::
+
+ flytectl set signal -p flytesnacks -d development --execution oeh94k9r2r --value 3 title-input
+`
+)
+
+// CreateSetCommand will return set command
+func CreateSetCommand() *cobra.Command {
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Short: setCmdShort,
+		Long:  setCmdLong,
+	}
+
+	setResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"signal": {CmdFunc: setSignalFunc, Aliases: []string{"signals"}, Short: signalShort,
+			Long: signalLong, PFlagProvider: signal.DefaultSetConfig},
+	}
+
+	cmdcore.AddCommands(setCmd, setResourcesFuncs)
+	return setCmd
+}
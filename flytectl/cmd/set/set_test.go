@@ -0,0 +1,20 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCommand(t *testing.T) {
+	setCommand := CreateSetCommand()
+	assert.Equal(t, setCommand.Use, "set")
+	assert.Equal(t, setCommand.Short, setCmdShort)
+	assert.Equal(t, setCommand.Long, setCmdLong)
+	assert.Equal(t, len(setCommand.Commands()), 1)
+	cmdNouns := setCommand.Commands()
+	assert.Equal(t, cmdNouns[0].Use, "signal")
+	assert.Equal(t, cmdNouns[0].Aliases, []string{"signals"})
+	assert.Equal(t, cmdNouns[0].Short, signalShort)
+	assert.Equal(t, cmdNouns[0].Long, signalLong)
+}
@@ -0,0 +1,94 @@
+package set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/get"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	signalShort = "Sets the value of a signal a gate node of an execution is waiting on."
+	signalLong  = `
+Set the value of a signal, which resumes the gate node waiting on it. The value is parsed as YAML and must match the
+type of the signal, which is shown by get signal:
+::
+
+ flytectl set signal -p flytesnacks -d development --execution oeh94k9r2r --value 3 count-input
+
+Collections and maps are given in YAML flow style:
+::
+
+ flytectl set signal -p flytesnacks -d development --execution oeh94k9r2r --value "[1, 2, 3]" counts-input
+
+Approvals are boolean signals; they can also be given with the approve command:
+::
+
+ flytectl set signal -p flytesnacks -d development --execution oeh94k9r2r --value true approval
+
+Usage
+`
+)
+
+func setSignalFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 1 {
+		return fmt.Errorf("signal id is required")
+	}
+	if len(signal.DefaultSetConfig.Execution) == 0 {
+		return fmt.Errorf("execution is required to set its signal")
+	}
+	if len(signal.DefaultSetConfig.Value) == 0 {
+		return fmt.Errorf("value is required to set the signal")
+	}
+
+	executionID := &core.WorkflowExecutionIdentifier{
+		Project: config.GetConfig().Project,
+		Domain:  config.GetConfig().Domain,
+		Name:    signal.DefaultSetConfig.Execution,
+	}
+	signals, err := get.FetchSignals(ctx, cmdCtx, executionID, false)
+	if err != nil {
+		return err
+	}
+	var s *admin.Signal
+	for _, candidate := range signals {
+		if candidate.GetId().GetSignalId() == args[0] {
+			s = candidate
+		}
+	}
+	if s == nil {
+		return fmt.Errorf("signal %s not found in execution %s", args[0], executionID.GetName())
+	}
+	if s.GetValue() != nil {
+		return fmt.Errorf("signal %s of execution %s is already set", args[0], executionID.GetName())
+	}
+
+	literal, err := parseSignalValue(s.GetType(), signal.DefaultSetConfig.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value for signal %s: %w", args[0], err)
+	}
+	if _, err := cmdCtx.ClientSet().SignalServiceClient().SetSignal(ctx, &admin.SignalSetRequest{Id: s.GetId(), Value: literal}); err != nil {
+		return fmt.Errorf("could not set signal %s of execution %s: %w", args[0], executionID.GetName(), err)
+	}
+	fmt.Printf("Set signal %s of execution %s to %s\n", args[0], executionID.GetName(), signal.DefaultSetConfig.Value)
+	return nil
+}
+
+// parseSignalValue parses the YAML value into a literal of the type of the signal.
+func parseSignalValue(literalType *core.LiteralType, value string) (*core.Literal, error) {
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		v = value
+	}
+	return coreutils.MakeLiteralForType(literalType, v)
+}
@@ -0,0 +1,98 @@
+package set
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/signal"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetSignalFunc(t *testing.T) {
+	executionID := &core.WorkflowExecutionIdentifier{Project: "dummyProject", Domain: "dummyDomain", Name: "exec"}
+	countsID := &core.SignalIdentifier{ExecutionId: executionID, SignalId: "counts"}
+	setupSignals := func(s *testutils.TestStruct) *mocks.SignalServiceClient {
+		signalClient := s.MockClient.SignalServiceClient().(*mocks.SignalServiceClient)
+		signalClient.EXPECT().ListSignals(s.Ctx, mock.Anything).Return(&admin.SignalList{Signals: []*admin.Signal{
+			{
+				Id: countsID,
+				Type: &core.LiteralType{Type: &core.LiteralType_CollectionType{CollectionType: &core.LiteralType{
+					Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}}},
+			},
+			{
+				Id:    &core.SignalIdentifier{ExecutionId: executionID, SignalId: "approval"},
+				Type:  &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_BOOLEAN}},
+				Value: coreutils.MustMakeLiteral(true),
+			},
+		}}, nil)
+		return signalClient
+	}
+	defer func() { signal.DefaultSetConfig = &signal.SetConfig{} }()
+
+	t.Run("set", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signalClient := setupSignals(&s)
+		signalClient.EXPECT().SetSignal(s.Ctx, &admin.SignalSetRequest{
+			Id:    countsID,
+			Value: coreutils.MustMakeLiteral([]interface{}{1, 2}),
+		}).Return(&admin.SignalSetResponse{}, nil)
+		signal.DefaultSetConfig = &signal.SetConfig{Execution: "exec", Value: "[1, 2]"}
+
+		err := setSignalFunc(s.Ctx, []string{"counts"}, s.CmdCtx)
+
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, "Set signal counts of execution exec to [1, 2]")
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultSetConfig = &signal.SetConfig{Execution: "exec", Value: "[a]"}
+
+		err := setSignalFunc(s.Ctx, []string{"counts"}, s.CmdCtx)
+
+		assert.ErrorContains(t, err, "invalid value for signal counts")
+	})
+	t.Run("already set", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultSetConfig = &signal.SetConfig{Execution: "exec", Value: "false"}
+
+		err := setSignalFunc(s.Ctx, []string{"approval"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "signal approval of execution exec is already set")
+	})
+	t.Run("unknown signal", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupSignals(&s)
+		signal.DefaultSetConfig = &signal.SetConfig{Execution: "exec", Value: "1"}
+
+		err := setSignalFunc(s.Ctx, []string{"unknown"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "signal unknown not found in execution exec")
+	})
+	t.Run("set error", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signalClient := setupSignals(&s)
+		signalClient.EXPECT().SetSignal(s.Ctx, mock.Anything).Return(nil, errors.New("unavailable"))
+		signal.DefaultSetConfig = &signal.SetConfig{Execution: "exec", Value: "[1]"}
+
+		err := setSignalFunc(s.Ctx, []string{"counts"}, s.CmdCtx)
+
+		assert.EqualError(t, err, "could not set signal counts of execution exec: unavailable")
+	})
+	t.Run("missing arguments", func(t *testing.T) {
+		s := testutils.Setup(t)
+		signal.DefaultSetConfig = &signal.SetConfig{Execution: "exec"}
+
+		assert.EqualError(t, setSignalFunc(s.Ctx, nil, s.CmdCtx), "signal id is required")
+		assert.EqualError(t, setSignalFunc(s.Ctx, []string{"counts"}, s.CmdCtx), "value is required to set the signal")
+		signal.DefaultSetConfig = &signal.SetConfig{Value: "1"}
+		assert.EqualError(t, setSignalFunc(s.Ctx, []string{"counts"}, s.CmdCtx), "execution is required to set its signal")
+	})
+}
@@ -0,0 +1,401 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/disiqueira/gotree"
+	"github.com/flyteorg/flyte/flytectl/clierrors"
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/bubbletea"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/moby/term"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	executionShort = "Watches the progress of an execution."
+	executionLong  = `
+Watch an execution until it terminates. The node tree of the execution is redrawn as nodes and tasks make progress, along with the duration, the number of retries and the cache status of each node.
+::
+
+ flytectl watch execution -p flytesnacks -d development oeh94k9r2r
+
+Tail the logs of running tasks. Only the log links that serve plain text, e.g. a log aggregator reachable from your machine, are tailed; the others are skipped.
+::
+
+ flytectl watch execution -p flytesnacks -d development oeh94k9r2r --logs --logLines 20
+
+Change the interval between polls for updates.
+::
+
+ flytectl watch execution -p flytesnacks -d development oeh94k9r2r --interval 10s
+
+Print updates line by line instead of redrawing the tree. This is the default when the output is not a terminal, e.g. in CI pipelines.
+::
+
+ flytectl watch execution -p flytesnacks -d development oeh94k9r2r --plain
+
+Once the execution terminates, the exit code reflects its phase:
+
+- 0: the execution succeeded.
+- 2: the execution failed.
+- 3: the execution was aborted.
+- 4: the execution timed out.
+
+Usage
+`
+)
+
+// Exit codes of the watch execution command for the non successful terminal phases of the execution.
+const (
+	exitCodeFailed   = 2
+	exitCodeAborted  = 3
+	exitCodeTimedOut = 4
+)
+
+const (
+	startNodeID = "start-node"
+	endNodeID   = "end-node"
+)
+
+// nodeProgress is the state of a node execution and of its task executions or child node executions.
+type nodeProgress struct {
+	node     *admin.NodeExecution
+	children []*nodeProgress
+	tasks    []*admin.TaskExecution
+}
+
+// executionWatcher polls an execution and renders its progress.
+type executionWatcher struct {
+	cmdCtx  cmdCore.CommandContext
+	project string
+	domain  string
+	name    string
+	config  *execution.ExecWatchConfig
+	logs    *logTailer
+	now     func() time.Time
+
+	execution *admin.Execution
+	nodes     []*nodeProgress
+	// Task executions of the terminated nodes don't change anymore, so they are only fetched once.
+	terminatedNodeTasks map[string][]*admin.TaskExecution
+	// Phases already printed in plain mode, keyed by node or task attempt.
+	printedPhases map[string]string
+}
+
+func newExecutionWatcher(cmdCtx cmdCore.CommandContext, project, domain, name string, cfg *execution.ExecWatchConfig) *executionWatcher {
+	return &executionWatcher{
+		cmdCtx:              cmdCtx,
+		project:             project,
+		domain:              domain,
+		name:                name,
+		config:              cfg,
+		logs:                newLogTailer(),
+		now:                 time.Now,
+		terminatedNodeTasks: map[string][]*admin.TaskExecution{},
+		printedPhases:       map[string]string{},
+	}
+}
+
+func watchExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 1 {
+		return errors.New(clierrors.ErrExecutionNotPassed)
+	}
+
+	interval, err := time.ParseDuration(execution.DefaultExecWatchConfig.Interval)
+	if err != nil || interval <= 0 {
+		return fmt.Errorf("invalid interval [%s], expected a positive duration e.g. 5s", execution.DefaultExecWatchConfig.Interval)
+	}
+
+	w := newExecutionWatcher(cmdCtx, config.GetConfig().Project, config.GetConfig().Domain, args[0], execution.DefaultExecWatchConfig)
+	if execution.DefaultExecWatchConfig.Plain || !term.IsTerminal(os.Stdout.Fd()) {
+		err = w.watchPlain(ctx, cmdCtx.OutputPipe(), interval)
+	} else {
+		err = bubbletea.Watch(func() (string, bool, error) {
+			if err := w.poll(ctx); err != nil {
+				return "", false, err
+			}
+			return w.render(ctx), w.terminated(), nil
+		}, interval)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !w.terminated() {
+		// The user stopped watching before the execution terminated.
+		return nil
+	}
+
+	return w.exitError()
+}
+
+// watchPlain polls the execution until it terminates and prints the phase transitions and new log lines.
+func (w *executionWatcher) watchPlain(ctx context.Context, out io.Writer, interval time.Duration) error {
+	for {
+		if err := w.poll(ctx); err != nil {
+			return err
+		}
+		w.printUpdates(ctx, out)
+		if w.terminated() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// poll fetches the current state of the execution and of all its nodes.
+func (w *executionWatcher) poll(ctx context.Context) error {
+	exec, err := w.cmdCtx.AdminFetcherExt().FetchExecution(ctx, w.name, w.project, w.domain)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := w.fetchNodes(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	w.execution = exec
+	w.nodes = nodes
+	return nil
+}
+
+func (w *executionWatcher) fetchNodes(ctx context.Context, uniqueParentID string) ([]*nodeProgress, error) {
+	nodeExecs, err := w.cmdCtx.AdminFetcherExt().FetchNodeExecutionDetails(ctx, w.name, w.project, w.domain, uniqueParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*nodeProgress
+	for _, nodeExec := range nodeExecs.GetNodeExecutions() {
+		nodeID := nodeExec.GetId().GetNodeId()
+		if nodeID == startNodeID || nodeID == endNodeID {
+			continue
+		}
+
+		node := &nodeProgress{node: nodeExec}
+		nodes = append(nodes, node)
+		if nodeExec.GetMetadata().GetIsParentNode() {
+			if node.children, err = w.fetchNodes(ctx, nodeID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if tasks, found := w.terminatedNodeTasks[nodeID]; found {
+			node.tasks = tasks
+			continue
+		}
+
+		taskExecs, err := w.cmdCtx.AdminFetcherExt().FetchTaskExecutionsOnNode(ctx, nodeID, w.name, w.project, w.domain)
+		if err != nil {
+			return nil, err
+		}
+		node.tasks = taskExecs.GetTaskExecutions()
+		sort.Slice(node.tasks, func(i, j int) bool {
+			return node.tasks[i].GetId().GetRetryAttempt() < node.tasks[j].GetId().GetRetryAttempt()
+		})
+		if isNodeTerminated(nodeExec.GetClosure().GetPhase()) {
+			w.terminatedNodeTasks[nodeID] = node.tasks
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].node.GetClosure().GetCreatedAt().AsTime().Before(nodes[j].node.GetClosure().GetCreatedAt().AsTime())
+	})
+	return nodes, nil
+}
+
+func (w *executionWatcher) terminated() bool {
+	return isExecutionTerminated(w.execution.GetClosure().GetPhase())
+}
+
+// exitError returns the error to exit with once the execution terminated, nil if it succeeded.
+func (w *executionWatcher) exitError() error {
+	phase := w.execution.GetClosure().GetPhase()
+	var code int
+	switch phase {
+	case core.WorkflowExecution_SUCCEEDED:
+		return nil
+	case core.WorkflowExecution_ABORTED:
+		code = exitCodeAborted
+	case core.WorkflowExecution_TIMED_OUT:
+		code = exitCodeTimedOut
+	default:
+		code = exitCodeFailed
+	}
+
+	err := fmt.Errorf("execution [%s] %s", w.name, phase)
+	if message := w.execution.GetClosure().GetError().GetMessage(); len(message) > 0 {
+		err = fmt.Errorf("%w: %s", err, message)
+	}
+
+	return cmdCore.ExitError{Code: code, Err: err}
+}
+
+// render returns the tree view of the execution, with the tail of the logs of running tasks if enabled.
+func (w *executionWatcher) render(ctx context.Context) string {
+	closure := w.execution.GetClosure()
+	root := gotree.New(fmt.Sprintf("%s - %s - %s", w.name, closure.GetPhase(),
+		w.formatDuration(closure.GetStartedAt(), closure.GetDuration(), isExecutionTerminated(closure.GetPhase()))))
+	w.renderNodes(ctx, root, w.nodes)
+	return root.Print()
+}
+
+func (w *executionWatcher) renderNodes(ctx context.Context, view gotree.Tree, nodes []*nodeProgress) {
+	for _, node := range nodes {
+		nodeView := view.Add(w.describeNode(node))
+		w.renderNodes(ctx, nodeView, node.children)
+		for _, task := range node.tasks {
+			taskView := nodeView.Add(w.describeTask(task))
+			if !w.config.Logs || isTaskTerminated(task.GetClosure().GetPhase()) {
+				continue
+			}
+
+			for _, log := range task.GetClosure().GetLogs() {
+				w.logs.Update(ctx, log.GetUri(), w.config.LogLines)
+				if lines := w.logs.Tail(log.GetUri()); len(lines) > 0 {
+					logView := taskView.Add("Logs : " + log.GetName())
+					for _, line := range lines {
+						logView.Add(line)
+					}
+				}
+			}
+		}
+	}
+}
+
+func (w *executionWatcher) describeNode(node *nodeProgress) string {
+	closure := node.node.GetClosure()
+	description := fmt.Sprintf("%s - %s - %s", node.node.GetId().GetNodeId(), closure.GetPhase(),
+		w.formatDuration(closure.GetStartedAt(), closure.GetDuration(), isNodeTerminated(closure.GetPhase())))
+	if len(node.tasks) > 1 {
+		description += fmt.Sprintf(" - retries: %d", len(node.tasks)-1)
+	}
+	if cacheStatus := closure.GetTaskNodeMetadata().GetCacheStatus(); cacheStatus != core.CatalogCacheStatus_CACHE_DISABLED {
+		description += " - cache: " + strings.TrimPrefix(cacheStatus.String(), "CACHE_")
+	}
+
+	return description
+}
+
+func (w *executionWatcher) describeTask(task *admin.TaskExecution) string {
+	closure := task.GetClosure()
+	description := fmt.Sprintf("Attempt %d - %s - %s", task.GetId().GetRetryAttempt(), closure.GetPhase(),
+		w.formatDuration(closure.GetStartedAt(), closure.GetDuration(), isTaskTerminated(closure.GetPhase())))
+	if len(closure.GetReason()) > 0 {
+		description += " - " + closure.GetReason()
+	}
+
+	return description
+}
+
+// printUpdates prints the phase transitions since the previous poll and, if enabled, the new log lines of running tasks.
+func (w *executionWatcher) printUpdates(ctx context.Context, out io.Writer) {
+	closure := w.execution.GetClosure()
+	if w.terminated() {
+		w.printNodeUpdates(ctx, out, w.nodes)
+		if w.phaseChanged("execution", closure.GetPhase().String()) {
+			_, _ = fmt.Fprintf(out, "execution [%s] %s after %s\n", w.name, closure.GetPhase(),
+				w.formatDuration(closure.GetStartedAt(), closure.GetDuration(), true))
+		}
+		return
+	}
+
+	if w.phaseChanged("execution", closure.GetPhase().String()) {
+		_, _ = fmt.Fprintf(out, "execution [%s] %s\n", w.name, closure.GetPhase())
+	}
+	w.printNodeUpdates(ctx, out, w.nodes)
+}
+
+func (w *executionWatcher) printNodeUpdates(ctx context.Context, out io.Writer, nodes []*nodeProgress) {
+	for _, node := range nodes {
+		nodeID := node.node.GetId().GetNodeId()
+		if w.phaseChanged("node/"+nodeID, node.node.GetClosure().GetPhase().String()) {
+			_, _ = fmt.Fprintf(out, "node [%s] %s\n", nodeID, w.describeNode(node))
+		}
+		w.printNodeUpdates(ctx, out, node.children)
+
+		for _, task := range node.tasks {
+			attempt := fmt.Sprintf("%s/%d", nodeID, task.GetId().GetRetryAttempt())
+			if w.phaseChanged("task/"+attempt, task.GetClosure().GetPhase().String()) {
+				_, _ = fmt.Fprintf(out, "node [%s] %s\n", nodeID, w.describeTask(task))
+			}
+			if !w.config.Logs || isTaskTerminated(task.GetClosure().GetPhase()) {
+				continue
+			}
+
+			for _, log := range task.GetClosure().GetLogs() {
+				for _, line := range w.logs.Update(ctx, log.GetUri(), w.config.LogLines) {
+					_, _ = fmt.Fprintf(out, "[%s] %s\n", attempt, line)
+				}
+			}
+		}
+	}
+}
+
+// phaseChanged records the phase of the given key and returns true if it changed since it was last recorded.
+func (w *executionWatcher) phaseChanged(key, phase string) bool {
+	if w.printedPhases[key] == phase {
+		return false
+	}
+
+	w.printedPhases[key] = phase
+	return true
+}
+
+// formatDuration returns the time spent in the phase, using the reported duration once it terminated.
+func (w *executionWatcher) formatDuration(startedAt *timestamppb.Timestamp, duration *durationpb.Duration, terminated bool) string {
+	if terminated && duration != nil {
+		return duration.AsDuration().Round(time.Second).String()
+	}
+	if startedAt == nil || startedAt.AsTime().IsZero() {
+		return "-"
+	}
+
+	return w.now().Sub(startedAt.AsTime()).Round(time.Second).String()
+}
+
+func isExecutionTerminated(phase core.WorkflowExecution_Phase) bool {
+	switch phase {
+	case core.WorkflowExecution_SUCCEEDED, core.WorkflowExecution_FAILED, core.WorkflowExecution_ABORTED,
+		core.WorkflowExecution_TIMED_OUT:
+		return true
+	}
+	return false
+}
+
+func isNodeTerminated(phase core.NodeExecution_Phase) bool {
+	switch phase {
+	case core.NodeExecution_SUCCEEDED, core.NodeExecution_FAILED, core.NodeExecution_ABORTED,
+		core.NodeExecution_SKIPPED, core.NodeExecution_TIMED_OUT, core.NodeExecution_RECOVERED:
+		return true
+	}
+	return false
+}
+
+func isTaskTerminated(phase core.TaskExecution_Phase) bool {
+	switch phase {
+	case core.TaskExecution_SUCCEEDED, core.TaskExecution_FAILED, core.TaskExecution_ABORTED:
+		return true
+	}
+	return false
+}
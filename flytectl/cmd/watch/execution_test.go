@@ -0,0 +1,277 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const execName = "exec1"
+
+var startedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func setupWatchConfig(t *testing.T) {
+	previous := *execution.DefaultExecWatchConfig
+	execution.DefaultExecWatchConfig.Interval = "1ms"
+	execution.DefaultExecWatchConfig.Plain = true
+	t.Cleanup(func() {
+		*execution.DefaultExecWatchConfig = previous
+	})
+}
+
+func newExecution(phase core.WorkflowExecution_Phase, errMessage string) *admin.Execution {
+	exec := &admin.Execution{
+		Id: &core.WorkflowExecutionIdentifier{Name: execName},
+		Closure: &admin.ExecutionClosure{
+			Phase:     phase,
+			StartedAt: timestamppb.New(startedAt),
+			Duration:  durationpb.New(90 * time.Second),
+		},
+	}
+	if len(errMessage) > 0 {
+		exec.Closure.OutputResult = &admin.ExecutionClosure_Error{Error: &core.ExecutionError{Message: errMessage}}
+	}
+
+	return exec
+}
+
+func newNodeExecution(nodeID string, phase core.NodeExecution_Phase, isParent bool) *admin.NodeExecution {
+	return &admin.NodeExecution{
+		Id:       &core.NodeExecutionIdentifier{NodeId: nodeID},
+		Metadata: &admin.NodeExecutionMetaData{IsParentNode: isParent},
+		Closure: &admin.NodeExecutionClosure{
+			Phase:     phase,
+			StartedAt: timestamppb.New(startedAt),
+			Duration:  durationpb.New(time.Minute),
+		},
+	}
+}
+
+func newTaskExecution(attempt uint32, phase core.TaskExecution_Phase, logURI string) *admin.TaskExecution {
+	taskExec := &admin.TaskExecution{
+		Id: &core.TaskExecutionIdentifier{RetryAttempt: attempt},
+		Closure: &admin.TaskExecutionClosure{
+			Phase:     phase,
+			StartedAt: timestamppb.New(startedAt),
+			Duration:  durationpb.New(30 * time.Second),
+		},
+	}
+	if len(logURI) > 0 {
+		taskExec.Closure.Logs = []*core.TaskLog{{Name: "Raw Logs", Uri: logURI}}
+	}
+
+	return taskExec
+}
+
+func TestWatchExecutionFunc(t *testing.T) {
+	t.Run("no execution name", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupWatchConfig(t)
+
+		err := watchExecutionFunc(s.Ctx, []string{}, s.CmdCtx)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupWatchConfig(t)
+		execution.DefaultExecWatchConfig.Interval = "soon"
+
+		err := watchExecutionFunc(s.Ctx, []string{execName}, s.CmdCtx)
+		assert.EqualError(t, err, "invalid interval [soon], expected a positive duration e.g. 5s")
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupWatchConfig(t)
+		s.FetcherExt.EXPECT().FetchExecution(s.Ctx, execName, config.GetConfig().Project, config.GetConfig().Domain).
+			Return(nil, errors.New("unavailable"))
+
+		err := watchExecutionFunc(s.Ctx, []string{execName}, s.CmdCtx)
+		assert.EqualError(t, err, "unavailable")
+	})
+
+	t.Run("succeeded", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupWatchConfig(t)
+		project, domain := config.GetConfig().Project, config.GetConfig().Domain
+
+		s.FetcherExt.EXPECT().FetchExecution(s.Ctx, execName, project, domain).
+			Return(newExecution(core.WorkflowExecution_RUNNING, ""), nil).Once()
+		s.FetcherExt.EXPECT().FetchExecution(s.Ctx, execName, project, domain).
+			Return(newExecution(core.WorkflowExecution_SUCCEEDED, ""), nil).Once()
+		s.FetcherExt.EXPECT().FetchNodeExecutionDetails(s.Ctx, execName, project, domain, "").
+			Return(&admin.NodeExecutionList{NodeExecutions: []*admin.NodeExecution{
+				newNodeExecution(startNodeID, core.NodeExecution_SUCCEEDED, false),
+				newNodeExecution("n0", core.NodeExecution_RUNNING, false),
+			}}, nil).Once()
+		s.FetcherExt.EXPECT().FetchNodeExecutionDetails(s.Ctx, execName, project, domain, "").
+			Return(&admin.NodeExecutionList{NodeExecutions: []*admin.NodeExecution{
+				newNodeExecution(startNodeID, core.NodeExecution_SUCCEEDED, false),
+				newNodeExecution("n0", core.NodeExecution_SUCCEEDED, false),
+			}}, nil).Once()
+		s.FetcherExt.EXPECT().FetchTaskExecutionsOnNode(s.Ctx, "n0", execName, project, domain).
+			Return(&admin.TaskExecutionList{TaskExecutions: []*admin.TaskExecution{
+				newTaskExecution(0, core.TaskExecution_RUNNING, ""),
+			}}, nil).Once()
+		s.FetcherExt.EXPECT().FetchTaskExecutionsOnNode(s.Ctx, "n0", execName, project, domain).
+			Return(&admin.TaskExecutionList{TaskExecutions: []*admin.TaskExecution{
+				newTaskExecution(0, core.TaskExecution_SUCCEEDED, ""),
+			}}, nil).Once()
+
+		err := watchExecutionFunc(s.Ctx, []string{execName}, s.CmdCtx)
+		assert.Nil(t, err)
+		s.TearDownAndVerifyContains(t, "execution [exec1] SUCCEEDED after 1m30s")
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		s := testutils.Setup(t)
+		setupWatchConfig(t)
+		project, domain := config.GetConfig().Project, config.GetConfig().Domain
+
+		s.FetcherExt.EXPECT().FetchExecution(s.Ctx, execName, project, domain).
+			Return(newExecution(core.WorkflowExecution_FAILED, "task failed"), nil)
+		s.FetcherExt.EXPECT().FetchNodeExecutionDetails(s.Ctx, execName, project, domain, "").
+			Return(&admin.NodeExecutionList{}, nil)
+
+		err := watchExecutionFunc(s.Ctx, []string{execName}, s.CmdCtx)
+		var exitErr cmdCore.ExitError
+		assert.True(t, errors.As(err, &exitErr))
+		assert.Equal(t, exitCodeFailed, exitErr.Code)
+		assert.EqualError(t, err, "execution [exec1] FAILED: task failed")
+	})
+}
+
+func TestExecutionWatcherExitError(t *testing.T) {
+	for _, tc := range []struct {
+		phase core.WorkflowExecution_Phase
+		code  int
+	}{
+		{phase: core.WorkflowExecution_FAILED, code: exitCodeFailed},
+		{phase: core.WorkflowExecution_ABORTED, code: exitCodeAborted},
+		{phase: core.WorkflowExecution_TIMED_OUT, code: exitCodeTimedOut},
+	} {
+		t.Run(tc.phase.String(), func(t *testing.T) {
+			w := newExecutionWatcher(cmdCore.CommandContext{}, "p", "d", execName, execution.DefaultExecWatchConfig)
+			w.execution = newExecution(tc.phase, "")
+
+			var exitErr cmdCore.ExitError
+			assert.True(t, errors.As(w.exitError(), &exitErr))
+			assert.Equal(t, tc.code, exitErr.Code)
+		})
+	}
+
+	t.Run("succeeded", func(t *testing.T) {
+		w := newExecutionWatcher(cmdCore.CommandContext{}, "p", "d", execName, execution.DefaultExecWatchConfig)
+		w.execution = newExecution(core.WorkflowExecution_SUCCEEDED, "")
+		assert.Nil(t, w.exitError())
+	})
+}
+
+func TestExecutionWatcherRender(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = fmt.Fprint(w, "line 1\nline 2\nline 3\n")
+	}))
+	defer server.Close()
+
+	w := newExecutionWatcher(cmdCore.CommandContext{}, "p", "d", execName, &execution.ExecWatchConfig{Logs: true, LogLines: 2})
+	w.now = func() time.Time { return startedAt.Add(45 * time.Second) }
+	w.execution = newExecution(core.WorkflowExecution_RUNNING, "")
+
+	cachedNode := newNodeExecution("n0", core.NodeExecution_SUCCEEDED, false)
+	cachedNode.Closure.TargetMetadata = &admin.NodeExecutionClosure_TaskNodeMetadata{
+		TaskNodeMetadata: &admin.TaskNodeMetadata{CacheStatus: core.CatalogCacheStatus_CACHE_HIT},
+	}
+	w.nodes = []*nodeProgress{
+		{node: cachedNode},
+		{
+			node: newNodeExecution("n1", core.NodeExecution_RUNNING, false),
+			tasks: []*admin.TaskExecution{
+				newTaskExecution(0, core.TaskExecution_FAILED, ""),
+				newTaskExecution(1, core.TaskExecution_RUNNING, server.URL),
+			},
+		},
+	}
+
+	view := w.render(context.Background())
+	assert.Contains(t, view, "exec1 - RUNNING - 45s")
+	assert.Contains(t, view, "n0 - SUCCEEDED - 1m0s - cache: HIT")
+	assert.Contains(t, view, "n1 - RUNNING - 45s - retries: 1")
+	assert.Contains(t, view, "Attempt 0 - FAILED - 30s")
+	assert.Contains(t, view, "Attempt 1 - RUNNING - 45s")
+	assert.Contains(t, view, "Logs : Raw Logs")
+	assert.NotContains(t, view, "line 1")
+	assert.Contains(t, view, "line 2")
+	assert.Contains(t, view, "line 3")
+}
+
+func TestLogTailer(t *testing.T) {
+	t.Run("tails appended lines", func(t *testing.T) {
+		content := "line 1\nline 2\nline 3\npart"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			http.ServeContent(w, r, "log", time.Time{}, strings.NewReader(content))
+		}))
+		defer server.Close()
+
+		tailer := newLogTailer()
+		assert.Equal(t, []string{"line 2", "line 3"}, tailer.Update(context.Background(), server.URL, 2))
+		assert.Empty(t, tailer.Update(context.Background(), server.URL, 2))
+
+		content += "ial\nline 5\n"
+		assert.Equal(t, []string{"partial", "line 5"}, tailer.Update(context.Background(), server.URL, 2))
+		assert.Equal(t, []string{"partial", "line 5"}, tailer.Tail(server.URL))
+	})
+
+	t.Run("ignores range", func(t *testing.T) {
+		content := "line 1\n"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = fmt.Fprint(w, content)
+		}))
+		defer server.Close()
+
+		tailer := newLogTailer()
+		assert.Equal(t, []string{"line 1"}, tailer.Update(context.Background(), server.URL, 10))
+		content += "line 2\n"
+		assert.Equal(t, []string{"line 2"}, tailer.Update(context.Background(), server.URL, 10))
+	})
+
+	t.Run("skips web consoles", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = fmt.Fprint(w, "<html></html>")
+		}))
+		defer server.Close()
+
+		tailer := newLogTailer()
+		assert.Empty(t, tailer.Update(context.Background(), server.URL, 10))
+		assert.Empty(t, tailer.Update(context.Background(), server.URL, 10))
+		assert.Equal(t, 1, requests)
+		assert.Empty(t, tailer.Tail(server.URL))
+	})
+
+	t.Run("skips unsupported schemes", func(t *testing.T) {
+		tailer := newLogTailer()
+		assert.Empty(t, tailer.Update(context.Background(), "s3://bucket/log", 10))
+		assert.True(t, tailer.untailable["s3://bucket/log"])
+	})
+}
@@ -0,0 +1,145 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	logRequestTimeout = 5 * time.Second
+	// Maximum number of bytes read from a log link per poll, larger logs are caught up over several polls.
+	maxLogBytesPerPoll = 1 << 20
+)
+
+// logTail is the state of a tailed log link.
+type logTail struct {
+	// Number of bytes of the log read so far.
+	offset int64
+	// Trailing line that is not terminated yet.
+	partial string
+	// Last lines of the log.
+	lines []string
+	// Whether the log link returned plain text at least once.
+	reachable bool
+}
+
+// logTailer incrementally fetches the log links of task executions. Most log links point to web consoles rather
+// than to the raw logs, so only the links that are reachable over http(s) and serve plain text are tailed. The
+// others are skipped once detected.
+type logTailer struct {
+	client     *http.Client
+	tails      map[string]*logTail
+	untailable map[string]bool
+}
+
+func newLogTailer() *logTailer {
+	return &logTailer{
+		client:     &http.Client{Timeout: logRequestTimeout},
+		tails:      map[string]*logTail{},
+		untailable: map[string]bool{},
+	}
+}
+
+// Update fetches the content appended to the log since the previous update and returns the new complete lines. The
+// first update of a log only returns its last maxLines lines.
+func (l *logTailer) Update(ctx context.Context, uri string, maxLines int) []string {
+	if l.untailable[uri] {
+		return nil
+	}
+
+	tail, found := l.tails[uri]
+	if !found {
+		tail = &logTail{}
+		l.tails[uri] = tail
+	}
+
+	content, err := l.fetch(ctx, uri, tail.offset)
+	if err != nil {
+		// A log link that never served plain text is most likely not a raw log, don't request it anymore.
+		if !tail.reachable {
+			l.untailable[uri] = true
+			delete(l.tails, uri)
+		}
+		return nil
+	}
+
+	tail.reachable = true
+	tail.offset += int64(len(content))
+	lines := strings.Split(tail.partial+content, "\n")
+	tail.partial = lines[len(lines)-1]
+	lines = lines[:len(lines)-1]
+
+	tail.lines = lastLines(append(tail.lines, lines...), maxLines)
+	if !found {
+		return lastLines(lines, maxLines)
+	}
+
+	return lines
+}
+
+// Tail returns the last lines of the log fetched so far.
+func (l *logTailer) Tail(uri string) []string {
+	if tail, found := l.tails[uri]; found {
+		return tail.lines
+	}
+
+	return nil
+}
+
+// fetch returns the content of the log starting at offset.
+func (l *logTailer) fetch(ctx context.Context, uri string, offset int64) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", err
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return "", fmt.Errorf("unsupported log link scheme [%s]", req.URL.Scheme)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// Nothing was appended to the log.
+		return "", nil
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/plain") {
+		return "", fmt.Errorf("log link serves [%s] instead of plain text", resp.Header.Get("Content-Type"))
+	}
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// The server ignored the range, skip the content that was already read.
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("log link returned status [%d]", resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxLogBytesPerPoll))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+func lastLines(lines []string, maxLines int) []string {
+	if maxLines >= 0 && len(lines) > maxLines {
+		return lines[len(lines)-maxLines:]
+	}
+
+	return lines
+}
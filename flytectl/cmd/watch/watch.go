@@ -0,0 +1,35 @@
+package watch
+
+import (
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	watchCmdShort = `Watches the progress of Flyte resources such as executions.`
+	watchCmdLong  = `
+Watch an execution until it terminates:
+::
+
+ flytectl watch execution -p flytesnacks -d development oeh94k9r2r
+`
+)
+
+// CreateWatchCommand will return watch command
+func CreateWatchCommand() *cobra.Command {
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: watchCmdShort,
+		Long:  watchCmdLong,
+	}
+
+	watchResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"execution": {CmdFunc: watchExecutionFunc, Aliases: []string{"executions"}, Short: executionShort,
+			Long: executionLong, PFlagProvider: execution.DefaultExecWatchConfig},
+	}
+
+	cmdcore.AddCommands(watchCmd, watchResourcesFuncs)
+	return watchCmd
+}
@@ -0,0 +1,20 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchCommand(t *testing.T) {
+	watchCommand := CreateWatchCommand()
+	assert.Equal(t, watchCommand.Use, "watch")
+	assert.Equal(t, watchCommand.Short, watchCmdShort)
+	assert.Equal(t, watchCommand.Long, watchCmdLong)
+	assert.Equal(t, len(watchCommand.Commands()), 1)
+	cmdNouns := watchCommand.Commands()
+	assert.Equal(t, cmdNouns[0].Use, "execution")
+	assert.Equal(t, cmdNouns[0].Aliases, []string{"executions"})
+	assert.Equal(t, cmdNouns[0].Short, executionShort)
+	assert.Equal(t, cmdNouns[0].Long, executionLong)
+}
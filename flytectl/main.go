@@ -2,15 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"os"
 
 	"github.com/flyteorg/flyte/flytectl/cmd"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 )
 
 func main() {
 	if err := cmd.ExecuteCmd(); err != nil {
 		logger.Error(context.TODO(), err)
+		var exitErr cmdCore.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
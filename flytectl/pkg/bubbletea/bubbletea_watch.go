@@ -0,0 +1,101 @@
+package bubbletea
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WatchCallback renders the current state of a watched resource and reports whether it reached a terminal state.
+type WatchCallback func() (view string, done bool, err error)
+
+type watchMsg struct {
+	view string
+	done bool
+	err  error
+}
+
+type watchModel struct {
+	callback WatchCallback
+	interval time.Duration
+	spinner  spinner.Model
+	view     string
+	done     bool
+	err      error
+}
+
+func newWatchModel(callback WatchCallback, interval time.Duration) watchModel {
+	s := spinner.New()
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("56"))
+	s.Spinner = spinner.Points
+
+	return watchModel{
+		callback: callback,
+		interval: interval,
+		spinner:  s,
+	}
+}
+
+func (m watchModel) refresh() tea.Msg {
+	view, done, err := m.callback()
+	return watchMsg{view: view, done: done, err: err}
+}
+
+func (m watchModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.refresh)
+}
+
+func (m watchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	case watchMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.view = msg.view
+		m.done = msg.done
+		if m.done {
+			return m, tea.Quit
+		}
+		return m, tea.Tick(m.interval, func(time.Time) tea.Msg {
+			return m.refresh()
+		})
+	}
+
+	return m, nil
+}
+
+func (m watchModel) View() string {
+	var b strings.Builder
+	b.WriteString(m.view)
+	if !m.done && m.err == nil {
+		b.WriteString(fmt.Sprintf("\n  %s refreshing every %s • q: quit\n", m.spinner.View(), m.interval))
+	}
+
+	return b.String()
+}
+
+// Watch redraws the view rendered by callback every interval, until the watched resource reaches a terminal state,
+// the callback fails or the user quits.
+func Watch(callback WatchCallback, interval time.Duration) error {
+	p := tea.NewProgram(newWatchModel(callback, interval))
+	m, err := p.Run()
+	if err != nil {
+		return err
+	}
+
+	return m.(watchModel).err
+}
@@ -0,0 +1,185 @@
+// Package bulk operates on many executions at once, e.g. to terminate or recover the executions selected by a filter.
+// Operations run with a bounded concurrency and rate, and record their progress in a journal so that interrupted runs
+// can be resumed.
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"golang.org/x/time/rate"
+)
+
+// Operation on an execution.
+type Operation struct {
+	// Name of the operation, e.g. terminate.
+	Name string
+	// Done describes the operation once done, e.g. Terminated.
+	Done string
+	// Run runs the operation and returns the name of the execution it created, if any.
+	Run func(ctx context.Context, id *core.WorkflowExecutionIdentifier) (string, error)
+}
+
+// Options of a bulk operation.
+type Options struct {
+	// Concurrency is the number of executions operated on concurrently, at least one.
+	Concurrency int
+	// Rate is the maximum number of operations started per second, unlimited if not positive.
+	Rate int
+	// Journal is the path of the progress journal, if any.
+	Journal string
+	DryRun  bool
+}
+
+// Summary of a bulk operation.
+type Summary struct {
+	Done    int
+	Failed  int
+	Skipped int
+}
+
+// ParseLabels parses comma separated key=value labels.
+func ParseLabels(labels string) (map[string]string, error) {
+	parsed := map[string]string{}
+	for _, label := range strings.Split(labels, ",") {
+		if len(strings.TrimSpace(label)) == 0 {
+			continue
+		}
+		key, value, found := strings.Cut(label, "=")
+		if !found || len(strings.TrimSpace(key)) == 0 {
+			return nil, fmt.Errorf("invalid label [%s], must be key=value", label)
+		}
+		parsed[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return parsed, nil
+}
+
+// SelectExecutions returns the executions matching the filter field selector across all the pages, starting from the
+// first one, and having all the comma separated key=value labels. Either is required, since operating on all the
+// executions of a project is too broad to be done by accident.
+func SelectExecutions(ctx context.Context, fetcher ext.AdminFetcherExtInterface, project, domain string,
+	filter filters.Filters, labels string) ([]*core.WorkflowExecutionIdentifier, error) {
+
+	if len(filter.FieldSelector) == 0 && len(labels) == 0 {
+		return nil, fmt.Errorf("filter.fieldSelector or labels are required to select the executions")
+	}
+	labelValues, err := ParseLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = filters.DefaultLimit
+	}
+	var ids []*core.WorkflowExecutionIdentifier
+	for filter.Page = 1; ; filter.Page++ {
+		executionList, err := fetcher.ListExecution(ctx, project, domain, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range executionList.GetExecutions() {
+			if hasLabels(execution, labelValues) {
+				ids = append(ids, execution.GetId())
+			}
+		}
+		if len(executionList.GetExecutions()) < int(filter.Limit) || len(executionList.GetToken()) == 0 {
+			return ids, nil
+		}
+	}
+}
+
+func hasLabels(execution *admin.Execution, labels map[string]string) bool {
+	for key, value := range labels {
+		if actual, found := execution.GetSpec().GetLabels().GetValues()[key]; !found || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Run runs the operation on the executions, skipping the ones the journal records as done, and prints the outcome of
+// each of them. It returns an error if the operation failed on any execution, once all of them are processed.
+func Run(ctx context.Context, operation Operation, ids []*core.WorkflowExecutionIdentifier, options Options) (Summary, error) {
+	summary := Summary{}
+	if options.DryRun {
+		for _, id := range ids {
+			fmt.Printf("Would %s execution %s\n", operation.Name, id.GetName())
+		}
+		fmt.Printf("Skipping %s of %d executions (dryRun)\n", operation.Name, len(ids))
+		return summary, nil
+	}
+
+	journal, err := openJournal(ctx, options.Journal)
+	if err != nil {
+		return summary, err
+	}
+	defer journal.Close()
+
+	limit := rate.Inf
+	if options.Rate > 0 {
+		limit = rate.Limit(options.Rate)
+	}
+	limiter := rate.NewLimiter(limit, 1)
+	concurrency := options.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	pending := make(chan *core.WorkflowExecutionIdentifier)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range pending {
+				result, err := operation.Run(ctx, id)
+				mutex.Lock()
+				if err != nil {
+					summary.Failed++
+					fmt.Printf("Failed to %s execution %s: %v\n", operation.Name, id.GetName(), err)
+				} else {
+					summary.Done++
+					if len(result) > 0 {
+						fmt.Printf("%s execution %s as %s\n", operation.Done, id.GetName(), result)
+					} else {
+						fmt.Printf("%s execution %s\n", operation.Done, id.GetName())
+					}
+				}
+				if journalErr := journal.record(id, result, err); journalErr != nil {
+					fmt.Printf("Failed to record execution %s in the journal: %v\n", id.GetName(), journalErr)
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	var waitErr error
+	for _, id := range ids {
+		if entry, done := journal.done(id); done {
+			summary.Skipped++
+			fmt.Printf("Skipping execution %s, already %s at %s\n", id.GetName(), strings.ToLower(operation.Done), entry.Time)
+			continue
+		}
+		if waitErr = limiter.Wait(ctx); waitErr != nil {
+			break
+		}
+		pending <- id
+	}
+	close(pending)
+	wg.Wait()
+
+	fmt.Printf("%s %d executions, %d failed and %d skipped\n", operation.Done, summary.Done, summary.Failed, summary.Skipped)
+	if waitErr != nil {
+		return summary, waitErr
+	}
+	if summary.Failed > 0 {
+		return summary, fmt.Errorf("failed to %s %d executions", operation.Name, summary.Failed)
+	}
+	return summary, nil
+}
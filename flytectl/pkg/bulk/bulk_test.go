@@ -0,0 +1,153 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/pkg/ext/mocks"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func executionID(name string) *core.WorkflowExecutionIdentifier {
+	return &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: name}
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels("team=ml, env=test,")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ml", "env": "test"}, labels)
+
+	_, err = ParseLabels("team")
+	assert.EqualError(t, err, "invalid label [team], must be key=value")
+}
+
+func TestSelectExecutions(t *testing.T) {
+	ctx := context.Background()
+	execution := func(name string, labels map[string]string) *admin.Execution {
+		return &admin.Execution{Id: executionID(name), Spec: &admin.ExecutionSpec{Labels: &admin.Labels{Values: labels}}}
+	}
+	filter := filters.Filters{FieldSelector: "execution.phase=FAILED", Limit: 2, Page: 3}
+
+	t.Run("All pages", func(t *testing.T) {
+		fetcher := mocks.NewAdminFetcherExtInterface(t)
+		firstPage, secondPage := filter, filter
+		firstPage.Page, secondPage.Page = 1, 2
+		fetcher.EXPECT().ListExecution(ctx, "project", "domain", firstPage).Return(&admin.ExecutionList{
+			Executions: []*admin.Execution{execution("e1", map[string]string{"team": "ml"}), execution("e2", nil)},
+			Token:      "2",
+		}, nil)
+		fetcher.EXPECT().ListExecution(ctx, "project", "domain", secondPage).Return(&admin.ExecutionList{
+			Executions: []*admin.Execution{execution("e3", map[string]string{"team": "ml", "env": "test"})},
+		}, nil)
+
+		ids, err := SelectExecutions(ctx, fetcher, "project", "domain", filter, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []*core.WorkflowExecutionIdentifier{executionID("e1"), executionID("e2"), executionID("e3")}, ids)
+
+		ids, err = SelectExecutions(ctx, fetcher, "project", "domain", filter, "team=ml")
+		assert.NoError(t, err)
+		assert.Equal(t, []*core.WorkflowExecutionIdentifier{executionID("e1"), executionID("e3")}, ids)
+	})
+
+	t.Run("No selector", func(t *testing.T) {
+		_, err := SelectExecutions(ctx, mocks.NewAdminFetcherExtInterface(t), "project", "domain", filters.DefaultFilter, "")
+		assert.EqualError(t, err, "filter.fieldSelector or labels are required to select the executions")
+	})
+
+	t.Run("List error", func(t *testing.T) {
+		fetcher := mocks.NewAdminFetcherExtInterface(t)
+		fetcher.EXPECT().ListExecution(ctx, "project", "domain", mock.Anything).Return(nil, fmt.Errorf("failed"))
+		_, err := SelectExecutions(ctx, fetcher, "project", "domain", filter, "")
+		assert.EqualError(t, err, "failed")
+	})
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	ids := []*core.WorkflowExecutionIdentifier{executionID("e1"), executionID("e2"), executionID("e3")}
+	journalPath := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	var mutex sync.Mutex
+	var operated []string
+	failing := map[string]bool{"e2": true}
+	operation := Operation{
+		Name: "recover",
+		Done: "Recovered",
+		Run: func(ctx context.Context, id *core.WorkflowExecutionIdentifier) (string, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			operated = append(operated, id.GetName())
+			if failing[id.GetName()] {
+				return "", fmt.Errorf("unavailable")
+			}
+			return id.GetName() + "-recovered", nil
+		},
+	}
+	options := Options{Concurrency: 2, Rate: 100, Journal: journalPath}
+
+	summary, err := Run(ctx, operation, ids, options)
+	assert.EqualError(t, err, "failed to recover 1 executions")
+	assert.Equal(t, Summary{Done: 2, Failed: 1}, summary)
+	assert.ElementsMatch(t, []string{"e1", "e2", "e3"}, operated)
+
+	// Resuming retries the failed execution only.
+	operated = nil
+	failing = map[string]bool{}
+	summary, err = Run(ctx, operation, ids, options)
+	assert.NoError(t, err)
+	assert.Equal(t, Summary{Done: 1, Skipped: 2}, summary)
+	assert.Equal(t, []string{"e2"}, operated)
+
+	raw, err := os.ReadFile(journalPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	assert.Len(t, lines, 4)
+	assert.Contains(t, lines[3], `"execution":"project/domain/e2","result":"e2-recovered"`)
+
+	t.Run("Partially written journal", func(t *testing.T) {
+		file, err := os.OpenFile(journalPath, os.O_APPEND|os.O_WRONLY, 0600)
+		require.NoError(t, err)
+		_, err = file.WriteString(`{"execution":"project/dom`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		operated = nil
+		summary, err := Run(ctx, operation, append(ids, executionID("e4")), options)
+		assert.NoError(t, err)
+		assert.Equal(t, Summary{Done: 1, Skipped: 3}, summary)
+		assert.Equal(t, []string{"e4"}, operated)
+
+		raw, err := os.ReadFile(journalPath)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+		assert.Equal(t, `{"execution":"project/dom`, lines[len(lines)-2])
+		assert.Contains(t, lines[len(lines)-1], `{"execution":"project/domain/e4","result":"e4-recovered"`)
+	})
+
+	t.Run("Dry run", func(t *testing.T) {
+		operated = nil
+		summary, err := Run(ctx, operation, ids, Options{DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, Summary{}, summary)
+		assert.Empty(t, operated)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		operated = nil
+		_, err := Run(canceled, operation, ids, Options{Rate: 1})
+		assert.Error(t, err)
+		assert.Empty(t, operated)
+	})
+}
@@ -0,0 +1,98 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// entry of the progress journal, which holds one JSON entry per line for each execution operated on.
+type entry struct {
+	Execution string `json:"execution"`
+	Result    string `json:"result,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Time      string `json:"time"`
+}
+
+type journal struct {
+	file    *os.File
+	entries map[string]entry
+}
+
+func journalKey(id *core.WorkflowExecutionIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", id.GetProject(), id.GetDomain(), id.GetName())
+}
+
+// openJournal reads the journal at the path, if any, and opens it to record the outcome of the next operations. The
+// journal is not persisted if the path is empty.
+func openJournal(ctx context.Context, path string) (*journal, error) {
+	j := &journal{entries: map[string]entry{}}
+	if len(path) == 0 {
+		return j, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for i, line := range bytes.Split(raw, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		e := entry{}
+		// The last entry may be partially written if the previous run was interrupted.
+		if err := json.Unmarshal(line, &e); err != nil {
+			logger.Warnf(ctx, "Ignoring invalid entry at line %d of journal %s: %v", i+1, path, err)
+			continue
+		}
+		j.entries[e.Execution] = e
+	}
+
+	if j.file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err != nil {
+		return nil, err
+	}
+	// Terminate a partially written entry so that it doesn't corrupt the next one.
+	if len(raw) > 0 && raw[len(raw)-1] != '\n' {
+		if _, err := j.file.Write([]byte("\n")); err != nil {
+			_ = j.file.Close()
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// done returns the entry of the execution if it was successfully operated on.
+func (j *journal) done(id *core.WorkflowExecutionIdentifier) (entry, bool) {
+	e, found := j.entries[journalKey(id)]
+	return e, found && len(e.Error) == 0
+}
+
+func (j *journal) record(id *core.WorkflowExecutionIdentifier, result string, err error) error {
+	if j.file == nil {
+		return nil
+	}
+	e := entry{Execution: journalKey(id), Result: result, Time: time.Now().UTC().Format(time.RFC3339)}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	raw, marshalErr := json.Marshal(e)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	_, writeErr := j.file.Write(append(raw, '\n'))
+	return writeErr
+}
+
+func (j *journal) Close() error {
+	if j.file == nil {
+		return nil
+	}
+	return j.file.Close()
+}
@@ -0,0 +1,482 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/pkg/visualize"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/validators"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	startNodeID = "start-node"
+	endNodeID   = "end-node"
+)
+
+func newReport(from, to *core.Identifier) *Report {
+	kind := strings.ReplaceAll(strings.ToLower(to.GetResourceType().String()), "_", " ")
+	return &Report{
+		Entity:  fmt.Sprintf("%s %s", kind, to.GetName()),
+		From:    from.GetVersion(),
+		To:      to.GetVersion(),
+		Changes: []Change{},
+	}
+}
+
+// Tasks compares two versions of a task.
+func Tasks(from, to *admin.Task) *Report {
+	report := newReport(from.GetId(), to.GetId())
+	report.Changes = append(report.Changes,
+		taskChanges("", from.GetClosure().GetCompiledTask().GetTemplate(), to.GetClosure().GetCompiledTask().GetTemplate())...)
+	return report
+}
+
+// Workflows compares two versions of a workflow: its interface, the nodes and edges of its graph and of its
+// subworkflows, and the tasks it runs.
+func Workflows(from, to *admin.Workflow) *Report {
+	report := newReport(from.GetId(), to.GetId())
+	fromClosure := from.GetClosure().GetCompiledWorkflow()
+	toClosure := to.GetClosure().GetCompiledWorkflow()
+	report.Changes = append(report.Changes, interfaceChanges("",
+		fromClosure.GetPrimary().GetTemplate().GetInterface(), toClosure.GetPrimary().GetTemplate().GetInterface())...)
+
+	fromTasks := tasksByName(fromClosure.GetTasks())
+	toTasks := tasksByName(toClosure.GetTasks())
+	var taskDiffs []Change
+	changedTasks := map[string]bool{}
+	for _, name := range sortedKeys(fromTasks, toTasks) {
+		fromTask, inFrom := fromTasks[name]
+		toTask, inTo := toTasks[name]
+		switch {
+		case !inFrom:
+			taskDiffs = append(taskDiffs, Change{Kind: Added, Path: "task " + name})
+		case !inTo:
+			taskDiffs = append(taskDiffs, Change{Kind: Removed, Path: "task " + name})
+		default:
+			if changes := taskChanges("task "+name, fromTask, toTask); len(changes) > 0 {
+				changedTasks[name] = true
+				taskDiffs = append(taskDiffs, changes...)
+			}
+		}
+	}
+
+	changes, highlights := graphChanges("", fromClosure.GetPrimary(), toClosure.GetPrimary(), changedTasks)
+	report.Changes = append(report.Changes, changes...)
+
+	fromSubWorkflows := subWorkflowsByName(fromClosure.GetSubWorkflows())
+	toSubWorkflows := subWorkflowsByName(toClosure.GetSubWorkflows())
+	for _, name := range sortedKeys(fromSubWorkflows, toSubWorkflows) {
+		fromSubWorkflow, inFrom := fromSubWorkflows[name]
+		toSubWorkflow, inTo := toSubWorkflows[name]
+		switch {
+		case !inFrom:
+			report.Changes = append(report.Changes, Change{Kind: Added, Path: "subworkflow " + name})
+		case !inTo:
+			report.Changes = append(report.Changes, Change{Kind: Removed, Path: "subworkflow " + name})
+		default:
+			changes, _ := graphChanges("subworkflow "+name, fromSubWorkflow, toSubWorkflow, changedTasks)
+			report.Changes = append(report.Changes, changes...)
+		}
+	}
+
+	report.Changes = append(report.Changes, taskDiffs...)
+	report.workflow = toClosure
+	report.highlights = highlights
+	return report
+}
+
+// LaunchPlans compares two versions of a launch plan: the workflow it launches, its inputs and their defaults, its
+// fixed inputs, outputs, schedule and max parallelism.
+func LaunchPlans(from, to *admin.LaunchPlan) *Report {
+	report := newReport(from.GetId(), to.GetId())
+	report.Changes = append(report.Changes, valueChanges("", "workflow",
+		identifierString(from.GetSpec().GetWorkflowId()), identifierString(to.GetSpec().GetWorkflowId()))...)
+	report.Changes = append(report.Changes, parameterChanges(launchPlanInputs(from), launchPlanInputs(to))...)
+	report.Changes = append(report.Changes, fixedInputChanges(from.GetSpec().GetFixedInputs().GetLiterals(),
+		to.GetSpec().GetFixedInputs().GetLiterals())...)
+	report.Changes = append(report.Changes, variableChanges("", "output",
+		from.GetClosure().GetExpectedOutputs().GetVariables(), to.GetClosure().GetExpectedOutputs().GetVariables(), false)...)
+	report.Changes = append(report.Changes, valueChanges("", "schedule",
+		scheduleString(from.GetSpec().GetEntityMetadata().GetSchedule()), scheduleString(to.GetSpec().GetEntityMetadata().GetSchedule()))...)
+	report.Changes = append(report.Changes, valueChanges("", "max parallelism",
+		int32String(from.GetSpec().GetMaxParallelism()), int32String(to.GetSpec().GetMaxParallelism()))...)
+	return report
+}
+
+// sortedKeys returns the sorted union of the keys of the maps.
+func sortedKeys[V any](maps ...map[string]V) []string {
+	set := map[string]bool{}
+	for _, m := range maps {
+		for key := range m {
+			set[key] = true
+		}
+	}
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func path(prefix, element string) string {
+	if len(prefix) == 0 {
+		return element
+	}
+	return prefix + " " + element
+}
+
+// valueChanges returns the change of a value between the versions, if any. Empty values stand for unset ones.
+func valueChanges(prefix, element, from, to string) []Change {
+	if from == to {
+		return nil
+	}
+	return []Change{{Kind: Changed, Path: path(prefix, element), From: from, To: to}}
+}
+
+func int32String(value int32) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(value))
+}
+
+func durationString(d *durationpb.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.AsDuration().String()
+}
+
+func identifierString(id *core.Identifier) string {
+	if id == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", id.GetName(), id.GetVersion())
+}
+
+func literalString(literal *core.Literal) string {
+	if literal == nil {
+		return ""
+	}
+	value, err := coreutils.ExtractFromLiteral(literal)
+	if err != nil {
+		return literal.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func scheduleString(schedule *admin.Schedule) string {
+	switch {
+	case len(schedule.GetCronExpression()) > 0:
+		return schedule.GetCronExpression()
+	case schedule.GetCronSchedule() != nil:
+		if len(schedule.GetCronSchedule().GetOffset()) > 0 {
+			return fmt.Sprintf("%s offset by %s", schedule.GetCronSchedule().GetSchedule(), schedule.GetCronSchedule().GetOffset())
+		}
+		return schedule.GetCronSchedule().GetSchedule()
+	case schedule.GetRate() != nil:
+		return fmt.Sprintf("every %d %s", schedule.GetRate().GetValue(), strings.ToLower(schedule.GetRate().GetUnit().String()))
+	}
+	return ""
+}
+
+// typeString returns a short description of the type, e.g. list[integer].
+func typeString(t *core.LiteralType) string {
+	switch v := t.GetType().(type) {
+	case *core.LiteralType_Simple:
+		return strings.ToLower(v.Simple.String())
+	case *core.LiteralType_CollectionType:
+		return fmt.Sprintf("list[%s]", typeString(v.CollectionType))
+	case *core.LiteralType_MapValueType:
+		return fmt.Sprintf("dict[%s]", typeString(v.MapValueType))
+	case *core.LiteralType_Blob:
+		kind := "blob"
+		if v.Blob.GetDimensionality() == core.BlobType_MULTIPART {
+			kind = "multipart blob"
+		}
+		if len(v.Blob.GetFormat()) > 0 {
+			return fmt.Sprintf("%s[%s]", kind, v.Blob.GetFormat())
+		}
+		return kind
+	case *core.LiteralType_EnumType:
+		return fmt.Sprintf("enum[%s]", strings.Join(v.EnumType.GetValues(), ", "))
+	case *core.LiteralType_StructuredDatasetType:
+		if len(v.StructuredDatasetType.GetFormat()) > 0 {
+			return fmt.Sprintf("structured dataset[%s]", v.StructuredDatasetType.GetFormat())
+		}
+		return "structured dataset"
+	case *core.LiteralType_Schema:
+		return "schema"
+	case *core.LiteralType_UnionType:
+		variants := make([]string, 0, len(v.UnionType.GetVariants()))
+		for _, variant := range v.UnionType.GetVariants() {
+			variants = append(variants, typeString(variant))
+		}
+		return fmt.Sprintf("union[%s]", strings.Join(variants, ", "))
+	}
+	return common.LiteralTypeToStr(t)
+}
+
+// typeChanges returns the change of the type of an input or output, if any. The values of the previous type of an
+// input must be castable to the new type, and the values of the new type of an output to the previous type, for the
+// change to be backward compatible.
+func typeChanges(p string, from, to *core.LiteralType, input bool) []Change {
+	if proto.Equal(from, to) {
+		return nil
+	}
+	compatible := validators.AreTypesCastable(to, from)
+	if input {
+		compatible = validators.AreTypesCastable(from, to)
+	}
+	fromType, toType := typeString(from), typeString(to)
+	if fromType == toType {
+		fromType, toType = common.LiteralTypeToStr(from), common.LiteralTypeToStr(to)
+	}
+	return []Change{{Kind: Changed, Path: p, From: fromType, To: toType, Breaking: !compatible}}
+}
+
+// variableChanges compares the inputs or outputs of an interface. Removed variables break the callers, as well as
+// added inputs, which have no default value in task and workflow interfaces.
+func variableChanges(prefix, element string, from, to map[string]*core.Variable, input bool) []Change {
+	var changes []Change
+	for _, name := range sortedKeys(from, to) {
+		p := path(prefix, element+" "+name)
+		fromVariable, inFrom := from[name]
+		toVariable, inTo := to[name]
+		switch {
+		case !inFrom:
+			changes = append(changes, Change{Kind: Added, Path: p, To: typeString(toVariable.GetType()), Breaking: input})
+		case !inTo:
+			changes = append(changes, Change{Kind: Removed, Path: p, From: typeString(fromVariable.GetType()), Breaking: true})
+		default:
+			changes = append(changes, typeChanges(p, fromVariable.GetType(), toVariable.GetType(), input)...)
+		}
+	}
+	return changes
+}
+
+func interfaceChanges(prefix string, from, to *core.TypedInterface) []Change {
+	changes := variableChanges(prefix, "input", from.GetInputs().GetVariables(), to.GetInputs().GetVariables(), true)
+	return append(changes, variableChanges(prefix, "output", from.GetOutputs().GetVariables(), to.GetOutputs().GetVariables(), false)...)
+}
+
+// launchPlanInputs returns the inputs expected by the launch plan, or its default inputs if it isn't registered.
+func launchPlanInputs(launchPlan *admin.LaunchPlan) map[string]*core.Parameter {
+	if launchPlan.GetClosure().GetExpectedInputs() != nil {
+		return launchPlan.GetClosure().GetExpectedInputs().GetParameters()
+	}
+	return launchPlan.GetSpec().GetDefaultInputs().GetParameters()
+}
+
+// parameterChanges compares the inputs of launch plans. Added inputs break the callers unless they have a default
+// value, and so do removed default values.
+func parameterChanges(from, to map[string]*core.Parameter) []Change {
+	var changes []Change
+	for _, name := range sortedKeys(from, to) {
+		p := "input " + name
+		fromParameter, inFrom := from[name]
+		toParameter, inTo := to[name]
+		switch {
+		case !inFrom:
+			changes = append(changes, Change{Kind: Added, Path: p, To: typeString(toParameter.GetVar().GetType()),
+				Breaking: toParameter.GetDefault() == nil})
+		case !inTo:
+			changes = append(changes, Change{Kind: Removed, Path: p, From: typeString(fromParameter.GetVar().GetType()), Breaking: true})
+		default:
+			changes = append(changes, typeChanges(p, fromParameter.GetVar().GetType(), toParameter.GetVar().GetType(), true)...)
+			if !proto.Equal(fromParameter.GetDefault(), toParameter.GetDefault()) {
+				changes = append(changes, Change{Kind: Changed, Path: "default " + name,
+					From: literalString(fromParameter.GetDefault()), To: literalString(toParameter.GetDefault()),
+					Breaking: toParameter.GetDefault() == nil})
+			}
+		}
+	}
+	return changes
+}
+
+func fixedInputChanges(from, to map[string]*core.Literal) []Change {
+	var changes []Change
+	for _, name := range sortedKeys(from, to) {
+		p := "fixed input " + name
+		fromLiteral, inFrom := from[name]
+		toLiteral, inTo := to[name]
+		switch {
+		case !inFrom:
+			changes = append(changes, Change{Kind: Added, Path: p, To: literalString(toLiteral)})
+		case !inTo:
+			changes = append(changes, Change{Kind: Removed, Path: p, From: literalString(fromLiteral)})
+		case !proto.Equal(fromLiteral, toLiteral):
+			changes = append(changes, Change{Kind: Changed, Path: p, From: literalString(fromLiteral), To: literalString(toLiteral)})
+		}
+	}
+	return changes
+}
+
+// resources returns the resources of the container by name, e.g. cpu.
+func resources(entries []*core.Resources_ResourceEntry) map[string]string {
+	values := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		values[strings.ToLower(entry.GetName().String())] = entry.GetValue()
+	}
+	return values
+}
+
+func cacheString(metadata *core.TaskMetadata) string {
+	if !metadata.GetDiscoverable() {
+		return "disabled"
+	}
+	return "version " + metadata.GetDiscoveryVersion()
+}
+
+// taskChanges compares the type, image, resources, retries, timeout, interruptibility, caching and interface of two
+// versions of a task.
+func taskChanges(prefix string, from, to *core.TaskTemplate) []Change {
+	changes := valueChanges(prefix, "type", from.GetType(), to.GetType())
+	changes = append(changes, valueChanges(prefix, "image", from.GetContainer().GetImage(), to.GetContainer().GetImage())...)
+	for _, resourceKind := range []struct {
+		name     string
+		from, to []*core.Resources_ResourceEntry
+	}{
+		{"request", from.GetContainer().GetResources().GetRequests(), to.GetContainer().GetResources().GetRequests()},
+		{"limit", from.GetContainer().GetResources().GetLimits(), to.GetContainer().GetResources().GetLimits()},
+	} {
+		fromResources, toResources := resources(resourceKind.from), resources(resourceKind.to)
+		for _, name := range sortedKeys(fromResources, toResources) {
+			changes = append(changes, valueChanges(prefix, name+" "+resourceKind.name, fromResources[name], toResources[name])...)
+		}
+	}
+	changes = append(changes, valueChanges(prefix, "retries",
+		strconv.Itoa(int(from.GetMetadata().GetRetries().GetRetries())), strconv.Itoa(int(to.GetMetadata().GetRetries().GetRetries())))...)
+	changes = append(changes, valueChanges(prefix, "timeout",
+		durationString(from.GetMetadata().GetTimeout()), durationString(to.GetMetadata().GetTimeout()))...)
+	changes = append(changes, valueChanges(prefix, "interruptible",
+		strconv.FormatBool(from.GetMetadata().GetInterruptible()), strconv.FormatBool(to.GetMetadata().GetInterruptible()))...)
+	changes = append(changes, valueChanges(prefix, "cache", cacheString(from.GetMetadata()), cacheString(to.GetMetadata()))...)
+	return append(changes, interfaceChanges(prefix, from.GetInterface(), to.GetInterface())...)
+}
+
+func tasksByName(tasks []*core.CompiledTask) map[string]*core.TaskTemplate {
+	templates := make(map[string]*core.TaskTemplate, len(tasks))
+	for _, task := range tasks {
+		templates[task.GetTemplate().GetId().GetName()] = task.GetTemplate()
+	}
+	return templates
+}
+
+func subWorkflowsByName(workflows []*core.CompiledWorkflow) map[string]*core.CompiledWorkflow {
+	byName := make(map[string]*core.CompiledWorkflow, len(workflows))
+	for _, workflow := range workflows {
+		byName[workflow.GetTemplate().GetId().GetName()] = workflow
+	}
+	return byName
+}
+
+// nodeTarget returns what the node runs, e.g. task core.basic.t1.
+func nodeTarget(node *core.Node) string {
+	switch target := node.GetTarget().(type) {
+	case *core.Node_TaskNode:
+		return "task " + target.TaskNode.GetReferenceId().GetName()
+	case *core.Node_WorkflowNode:
+		if launchPlan := target.WorkflowNode.GetLaunchplanRef(); launchPlan != nil {
+			return "launch plan " + launchPlan.GetName()
+		}
+		return "subworkflow " + target.WorkflowNode.GetSubWorkflowRef().GetName()
+	case *core.Node_BranchNode:
+		return "branch"
+	case *core.Node_GateNode:
+		return "gate"
+	case *core.Node_ArrayNode:
+		return "array of " + nodeTarget(target.ArrayNode.GetNode())
+	}
+	return "node"
+}
+
+func nodesByID(workflow *core.CompiledWorkflow) map[string]*core.Node {
+	nodes := map[string]*core.Node{}
+	for _, node := range workflow.GetTemplate().GetNodes() {
+		if node.GetId() != startNodeID && node.GetId() != endNodeID {
+			nodes[node.GetId()] = node
+		}
+	}
+	return nodes
+}
+
+func edges(workflow *core.CompiledWorkflow) map[visualize.Edge]bool {
+	graphEdges := map[visualize.Edge]bool{}
+	for from, downstream := range workflow.GetConnections().GetDownstream() {
+		for _, to := range downstream.GetIds() {
+			graphEdges[visualize.Edge{From: from, To: to}] = true
+		}
+	}
+	return graphEdges
+}
+
+// graphChanges compares the nodes and edges of two versions of a workflow. The returned highlights also mark the nodes
+// running one of the changed tasks.
+func graphChanges(prefix string, from, to *core.CompiledWorkflow, changedTasks map[string]bool) ([]Change, visualize.Highlights) {
+	var changes []Change
+	highlights := visualize.Highlights{Nodes: map[string]visualize.Highlight{}, Edges: map[visualize.Edge]visualize.Highlight{}}
+
+	fromNodes, toNodes := nodesByID(from), nodesByID(to)
+	for _, id := range sortedKeys(fromNodes, toNodes) {
+		p := path(prefix, "node "+id)
+		fromNode, inFrom := fromNodes[id]
+		toNode, inTo := toNodes[id]
+		switch {
+		case !inFrom:
+			changes = append(changes, Change{Kind: Added, Path: p, To: nodeTarget(toNode)})
+			highlights.Nodes[id] = visualize.Added
+		case !inTo:
+			changes = append(changes, Change{Kind: Removed, Path: p, From: nodeTarget(fromNode)})
+			highlights.Nodes[id] = visualize.Removed
+		case nodeTarget(fromNode) != nodeTarget(toNode):
+			changes = append(changes, Change{Kind: Changed, Path: p, From: nodeTarget(fromNode), To: nodeTarget(toNode)})
+			highlights.Nodes[id] = visualize.Changed
+		case changedTasks[toNode.GetTaskNode().GetReferenceId().GetName()]:
+			highlights.Nodes[id] = visualize.Changed
+		}
+	}
+
+	fromEdges, toEdges := edges(from), edges(to)
+	for _, e := range sortedEdgeKeys(fromEdges, toEdges) {
+		p := path(prefix, fmt.Sprintf("edge %s -> %s", e.From, e.To))
+		switch {
+		case !fromEdges[e]:
+			changes = append(changes, Change{Kind: Added, Path: p})
+			highlights.Edges[e] = visualize.Added
+		case !toEdges[e]:
+			changes = append(changes, Change{Kind: Removed, Path: p})
+			highlights.Edges[e] = visualize.Removed
+		}
+	}
+	return changes, highlights
+}
+
+func sortedEdgeKeys(maps ...map[visualize.Edge]bool) []visualize.Edge {
+	set := map[visualize.Edge]bool{}
+	for _, m := range maps {
+		for e := range m {
+			set[e] = true
+		}
+	}
+	sorted := make([]visualize.Edge, 0, len(set))
+	for e := range set {
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].From != sorted[j].From {
+			return sorted[i].From < sorted[j].From
+		}
+		return sorted[i].To < sorted[j].To
+	})
+	return sorted
+}
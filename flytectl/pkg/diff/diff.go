@@ -0,0 +1,141 @@
+// Package diff compares two versions of a task, workflow or launch plan structurally: added or removed nodes, changed
+// edges, interface changes and whether they are backward compatible, changed images, resources and defaults.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/flyteorg/flyte/flytectl/pkg/visualize"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatDOT  = "dot"
+)
+
+// Kind of change.
+type Kind string
+
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Changed Kind = "changed"
+)
+
+var kindSymbols = map[Kind]string{
+	Added:   "+",
+	Removed: "-",
+	Changed: "~",
+}
+
+// Change between two versions of an entity.
+type Change struct {
+	Kind Kind `json:"kind"`
+	// Path of the changed element, e.g. node n1, input x or task core.basic.t1 image.
+	Path string `json:"path"`
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+	// Breaking is set if the change is not backward compatible, e.g. an input whose type the values of the previous
+	// version can't be cast to.
+	Breaking bool `json:"breaking,omitempty"`
+}
+
+func orNone(value string) string {
+	if len(value) == 0 {
+		return "none"
+	}
+	return value
+}
+
+// String returns the change on one line, e.g. ~ input x: integer -> string (breaking).
+func (c Change) String() string {
+	line := fmt.Sprintf("%s %s", kindSymbols[c.Kind], c.Path)
+	switch {
+	case c.Kind == Changed:
+		line = fmt.Sprintf("%s: %s -> %s", line, orNone(c.From), orNone(c.To))
+	case c.Kind == Added && len(c.To) > 0:
+		line = fmt.Sprintf("%s: %s", line, c.To)
+	case c.Kind == Removed && len(c.From) > 0:
+		line = fmt.Sprintf("%s: %s", line, c.From)
+	}
+	if c.Breaking {
+		line += " (breaking)"
+	}
+	return line
+}
+
+// Report of the changes from a version of an entity to another.
+type Report struct {
+	// Entity is the kind and name of the entity, e.g. workflow core.basic.my_wf.
+	Entity  string   `json:"entity"`
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Changes []Change `json:"changes"`
+
+	// workflow is the newer version of a compared workflow, rendered with the highlighted changes in DOT format.
+	workflow   *core.CompiledWorkflowClosure
+	highlights visualize.Highlights
+}
+
+// Breaking returns the number of changes that are not backward compatible.
+func (r *Report) Breaking() int {
+	breaking := 0
+	for _, c := range r.Changes {
+		if c.Breaking {
+			breaking++
+		}
+	}
+	return breaking
+}
+
+// WriteText writes the changes one per line, followed by a summary line.
+func (r *Report) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "%s %s -> %s\n", r.Entity, r.From, r.To); err != nil {
+		return err
+	}
+	for _, c := range r.Changes {
+		if _, err := fmt.Fprintln(w, c.String()); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d changes, %d breaking\n", len(r.Changes), r.Breaking())
+	return err
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteDOT writes the graph of the newer version of a workflow in DOT format, highlighting the added, changed and
+// removed nodes and edges.
+func (r *Report) WriteDOT(w io.Writer) error {
+	if r.workflow == nil {
+		return fmt.Errorf("%s format is only supported for workflows", FormatDOT)
+	}
+	graph, err := visualize.RenderWorkflowWithHighlights(r.workflow, r.highlights)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, graph)
+	return err
+}
+
+// Write writes the report in the text, json or dot format.
+func (r *Report) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatText:
+		return r.WriteText(w)
+	case FormatJSON:
+		return r.WriteJSON(w)
+	case FormatDOT:
+		return r.WriteDOT(w)
+	}
+	return fmt.Errorf("invalid format [%s], must be one of %s, %s or %s", format, FormatText, FormatJSON, FormatDOT)
+}
@@ -0,0 +1,211 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	integerType  = &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}
+	stringType   = &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}}
+	optionalType = &core.LiteralType{Type: &core.LiteralType_UnionType{UnionType: &core.UnionType{Variants: []*core.LiteralType{
+		integerType, {Type: &core.LiteralType_Simple{Simple: core.SimpleType_NONE}},
+	}}}}
+)
+
+func typedInterface(inputs, outputs map[string]*core.LiteralType) *core.TypedInterface {
+	variables := func(types map[string]*core.LiteralType) *core.VariableMap {
+		m := &core.VariableMap{Variables: map[string]*core.Variable{}}
+		for name, t := range types {
+			m.Variables[name] = &core.Variable{Type: t}
+		}
+		return m
+	}
+	return &core.TypedInterface{Inputs: variables(inputs), Outputs: variables(outputs)}
+}
+
+func taskTemplate(name, image, memory string) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id:   &core.Identifier{ResourceType: core.ResourceType_TASK, Name: name, Version: "v1"},
+		Type: "python-task",
+		Target: &core.TaskTemplate_Container{Container: &core.Container{
+			Image: image,
+			Resources: &core.Resources{Limits: []*core.Resources_ResourceEntry{
+				{Name: core.Resources_MEMORY, Value: memory},
+			}},
+		}},
+		Metadata:  &core.TaskMetadata{},
+		Interface: typedInterface(map[string]*core.LiteralType{"a": integerType}, nil),
+	}
+}
+
+func taskNode(id, task string) *core.Node {
+	return &core.Node{Id: id, Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{
+		Reference: &core.TaskNode_ReferenceId{ReferenceId: &core.Identifier{ResourceType: core.ResourceType_TASK, Name: task, Version: "v1"}},
+	}}}
+}
+
+func workflow(version string, iface *core.TypedInterface, tasks []*core.TaskTemplate, nodes []*core.Node, downstream map[string][]string) *admin.Workflow {
+	nodes = append([]*core.Node{{Id: startNodeID}, {Id: endNodeID}}, nodes...)
+	connections := &core.ConnectionSet{Downstream: map[string]*core.ConnectionSet_IdList{}, Upstream: map[string]*core.ConnectionSet_IdList{}}
+	for from, to := range downstream {
+		connections.Downstream[from] = &core.ConnectionSet_IdList{Ids: to}
+	}
+	compiledTasks := make([]*core.CompiledTask, 0, len(tasks))
+	for _, task := range tasks {
+		compiledTasks = append(compiledTasks, &core.CompiledTask{Template: task})
+	}
+	id := &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "core.basic.wf", Version: version}
+	return &admin.Workflow{
+		Id: id,
+		Closure: &admin.WorkflowClosure{CompiledWorkflow: &core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template:    &core.WorkflowTemplate{Id: id, Interface: iface, Nodes: nodes},
+				Connections: connections,
+			},
+			Tasks: compiledTasks,
+		}},
+	}
+}
+
+func workflowVersions() (*admin.Workflow, *admin.Workflow) {
+	from := workflow("v1",
+		typedInterface(map[string]*core.LiteralType{"x": integerType, "y": integerType}, map[string]*core.LiteralType{"o": integerType}),
+		[]*core.TaskTemplate{taskTemplate("t1", "image:v1", "1Gi"), taskTemplate("t2", "image:v1", "1Gi")},
+		[]*core.Node{taskNode("n0", "t1"), taskNode("n1", "t2")},
+		map[string][]string{startNodeID: {"n0"}, "n0": {"n1"}, "n1": {endNodeID}})
+	to := workflow("v2",
+		typedInterface(map[string]*core.LiteralType{"x": optionalType, "y": stringType}, map[string]*core.LiteralType{"o": optionalType}),
+		[]*core.TaskTemplate{taskTemplate("t1", "image:v2", "2Gi"), taskTemplate("t3", "image:v1", "1Gi")},
+		[]*core.Node{taskNode("n0", "t1"), taskNode("n2", "t3")},
+		map[string][]string{startNodeID: {"n0"}, "n0": {"n2"}, "n2": {endNodeID}})
+	return from, to
+}
+
+func TestWorkflows(t *testing.T) {
+	from, to := workflowVersions()
+	report := Workflows(from, to)
+
+	var b bytes.Buffer
+	require.NoError(t, report.Write(&b, FormatText))
+	assert.Equal(t, `workflow core.basic.wf v1 -> v2
+~ input x: integer -> union[integer, none]
+~ input y: integer -> string (breaking)
+~ output o: integer -> union[integer, none] (breaking)
+- node n1: task t2
++ node n2: task t3
+- edge n0 -> n1
++ edge n0 -> n2
+- edge n1 -> end-node
++ edge n2 -> end-node
+~ task t1 image: image:v1 -> image:v2
+~ task t1 memory limit: 1Gi -> 2Gi
+- task t2
++ task t3
+13 changes, 2 breaking
+`, b.String())
+
+	t.Run("json", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, report.Write(&b, FormatJSON))
+		decoded := Report{}
+		require.NoError(t, json.Unmarshal(b.Bytes(), &decoded))
+		assert.Equal(t, "workflow core.basic.wf", decoded.Entity)
+		assert.Equal(t, report.Changes, decoded.Changes)
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, report.Write(&b, FormatDOT))
+		assert.Contains(t, b.String(), "n0 [ color=orange")
+		assert.Contains(t, b.String(), "n1 [ color=red, label=\"n1\", shape=box, style=dashed ]")
+		assert.Contains(t, b.String(), "n2 [ color=green")
+		assert.Contains(t, b.String(), "n0->n1[ color=red, style=dashed ]")
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		from, _ := workflowVersions()
+		report := Workflows(from, from)
+		var b bytes.Buffer
+		require.NoError(t, report.WriteText(&b))
+		assert.Equal(t, "workflow core.basic.wf v1 -> v1\n0 changes, 0 breaking\n", b.String())
+	})
+}
+
+func TestTasks(t *testing.T) {
+	fromTemplate := taskTemplate("t1", "image:v1", "1Gi")
+	toTemplate := taskTemplate("t1", "image:v1", "1Gi")
+	toTemplate.Metadata = &core.TaskMetadata{Retries: &core.RetryStrategy{Retries: 3}, Discoverable: true, DiscoveryVersion: "1"}
+	toTemplate.GetContainer().Resources.Requests = []*core.Resources_ResourceEntry{{Name: core.Resources_CPU, Value: "1"}}
+	toTemplate.Interface = typedInterface(map[string]*core.LiteralType{"a": integerType, "b": stringType}, map[string]*core.LiteralType{"o": stringType})
+
+	report := Tasks(
+		&admin.Task{Id: fromTemplate.GetId(), Closure: &admin.TaskClosure{CompiledTask: &core.CompiledTask{Template: fromTemplate}}},
+		&admin.Task{Id: &core.Identifier{ResourceType: core.ResourceType_TASK, Name: "t1", Version: "v2"},
+			Closure: &admin.TaskClosure{CompiledTask: &core.CompiledTask{Template: toTemplate}}})
+
+	var b bytes.Buffer
+	require.NoError(t, report.Write(&b, FormatText))
+	assert.Equal(t, `task t1 v1 -> v2
+~ cpu request: none -> 1
+~ retries: 0 -> 3
+~ cache: disabled -> version 1
++ input b: string (breaking)
++ output o: string
+5 changes, 1 breaking
+`, b.String())
+
+	assert.EqualError(t, report.Write(&b, FormatDOT), "dot format is only supported for workflows")
+	assert.EqualError(t, report.Write(&b, "yaml"), "invalid format [yaml], must be one of text, json or dot")
+}
+
+func TestLaunchPlans(t *testing.T) {
+	launchPlan := func(version string, parameters map[string]*core.Parameter, maxParallelism int32, schedule *admin.Schedule) *admin.LaunchPlan {
+		return &admin.LaunchPlan{
+			Id: &core.Identifier{ResourceType: core.ResourceType_LAUNCH_PLAN, Name: "core.basic.lp", Version: version},
+			Spec: &admin.LaunchPlanSpec{
+				WorkflowId:     &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "core.basic.wf", Version: version},
+				EntityMetadata: &admin.LaunchPlanMetadata{Schedule: schedule},
+				MaxParallelism: maxParallelism,
+			},
+			Closure: &admin.LaunchPlanClosure{ExpectedInputs: &core.ParameterMap{Parameters: parameters}},
+		}
+	}
+	withDefault := func(t *core.LiteralType, value interface{}) *core.Parameter {
+		return &core.Parameter{Var: &core.Variable{Type: t}, Behavior: &core.Parameter_Default{Default: coreutils.MustMakeLiteral(value)}}
+	}
+	required := func(t *core.LiteralType) *core.Parameter {
+		return &core.Parameter{Var: &core.Variable{Type: t}, Behavior: &core.Parameter_Required{Required: true}}
+	}
+
+	from := launchPlan("v1", map[string]*core.Parameter{
+		"x": withDefault(integerType, 1),
+		"y": withDefault(stringType, "a"),
+	}, 0, nil)
+	to := launchPlan("v2", map[string]*core.Parameter{
+		"x": withDefault(integerType, 2),
+		"y": required(stringType),
+		"z": withDefault(stringType, "b"),
+		"w": required(integerType),
+	}, 10, &admin.Schedule{ScheduleExpression: &admin.Schedule_Rate{Rate: &admin.FixedRate{Value: 1, Unit: admin.FixedRateUnit_HOUR}}})
+
+	var b bytes.Buffer
+	require.NoError(t, LaunchPlans(from, to).Write(&b, FormatText))
+	assert.Equal(t, `launch plan core.basic.lp v1 -> v2
+~ workflow: core.basic.wf:v1 -> core.basic.wf:v2
++ input w: integer (breaking)
+~ default x: 1 -> 2
+~ default y: a -> none (breaking)
++ input z: string
+~ schedule: none -> every 1 hour
+~ max parallelism: none -> 10
+7 changes, 2 breaking
+`, b.String())
+}
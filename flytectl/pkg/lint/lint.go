@@ -0,0 +1,197 @@
+// Package lint checks serialized tasks, workflows and launch plans against organizational rules such as resource
+// limits, banned images or required retries. Rules are pluggable through RegisterRule and configured in YAML.
+package lint
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"go.yaml.in/yaml/v3"
+)
+
+// Severity of the violations of a rule.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+	SeverityOff     Severity = "off"
+)
+
+// Package holds the entities of a serialized package.
+type Package struct {
+	Tasks       []*admin.TaskSpec
+	Workflows   []*admin.WorkflowSpec
+	LaunchPlans []*admin.LaunchPlan
+}
+
+// Add adds the task, workflow or launch plan to the package. Other entities are ignored.
+func (p *Package) Add(spec proto.Message) {
+	switch v := spec.(type) {
+	case *admin.TaskSpec:
+		p.Tasks = append(p.Tasks, v)
+	case *admin.WorkflowSpec:
+		p.Workflows = append(p.Workflows, v)
+	case *admin.LaunchPlan:
+		p.LaunchPlans = append(p.LaunchPlans, v)
+	}
+}
+
+// Violation of a rule by an entity of the package.
+type Violation struct {
+	Rule     string
+	Severity Severity
+	Entity   *core.Identifier
+	Message  string
+}
+
+// Rule checks the entities of a package. The section of the rule in the configuration is decoded into the value
+// returned by Config before the package is checked.
+type Rule interface {
+	ID() string
+	Description() string
+	DefaultSeverity() Severity
+	Config() interface{}
+	// Check returns the violations of the rule, whose severity is set by the linter.
+	Check(pkg *Package) []Violation
+}
+
+// validator is implemented by the rules whose configuration needs to be validated after being decoded.
+type validator interface {
+	Validate() error
+}
+
+var rules = map[string]func() Rule{}
+
+// RegisterRule adds a rule to the rule set of the linter. The factory is called for every linter, so that rules can be
+// configured independently.
+func RegisterRule(factory func() Rule) {
+	id := factory().ID()
+	if _, found := rules[id]; found {
+		panic(fmt.Sprintf("lint rule %s is already registered", id))
+	}
+	rules[id] = factory
+}
+
+// Config of the linter, e.g.
+//
+//	rules:
+//	  banned-images:
+//	    severity: error
+//	    images: ["docker.io/", "*:latest"]
+//	  interruptible:
+//	    severity: off
+type Config struct {
+	Rules map[string]yaml.Node `yaml:"rules"`
+}
+
+type configuredRule struct {
+	Rule
+	severity Severity
+}
+
+// Linter checks packages against the registered rules.
+type Linter struct {
+	rules []configuredRule
+}
+
+// NewLinter returns a linter of the registered rules configured by the YAML file. Rules have their default
+// configuration if the file is empty.
+func NewLinter(configFile string) (*Linter, error) {
+	config := Config{}
+	if len(configFile) > 0 {
+		raw, err := os.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(raw, &config); err != nil {
+			return nil, fmt.Errorf("invalid lint configuration %s: %w", configFile, err)
+		}
+	}
+	return newLinter(config)
+}
+
+func newLinter(config Config) (*Linter, error) {
+	for id := range config.Rules {
+		if _, found := rules[id]; !found {
+			return nil, fmt.Errorf("unknown lint rule %s", id)
+		}
+	}
+
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	linter := &Linter{}
+	for _, id := range ids {
+		rule := rules[id]()
+		severity := rule.DefaultSeverity()
+		if node, found := config.Rules[id]; found {
+			ruleSeverity := struct {
+				Severity Severity `yaml:"severity"`
+			}{}
+			if err := node.Decode(&ruleSeverity); err != nil {
+				return nil, fmt.Errorf("invalid configuration of lint rule %s: %w", id, err)
+			}
+			switch ruleSeverity.Severity {
+			case "":
+			case SeverityError, SeverityWarning, SeverityInfo, SeverityOff:
+				severity = ruleSeverity.Severity
+			default:
+				return nil, fmt.Errorf("invalid severity %s of lint rule %s", ruleSeverity.Severity, id)
+			}
+			if err := node.Decode(rule.Config()); err != nil {
+				return nil, fmt.Errorf("invalid configuration of lint rule %s: %w", id, err)
+			}
+			if v, ok := rule.(validator); ok {
+				if err := v.Validate(); err != nil {
+					return nil, fmt.Errorf("invalid configuration of lint rule %s: %w", id, err)
+				}
+			}
+		}
+		if severity != SeverityOff {
+			linter.rules = append(linter.rules, configuredRule{Rule: rule, severity: severity})
+		}
+	}
+	return linter, nil
+}
+
+// Rules returns the enabled rules of the linter.
+func (l *Linter) Rules() []Rule {
+	enabled := make([]Rule, 0, len(l.rules))
+	for _, rule := range l.rules {
+		enabled = append(enabled, rule.Rule)
+	}
+	return enabled
+}
+
+// Lint returns the violations of the enabled rules by the package.
+func (l *Linter) Lint(pkg *Package) []Violation {
+	var violations []Violation
+	for _, rule := range l.rules {
+		for _, violation := range rule.Check(pkg) {
+			violation.Rule = rule.ID()
+			violation.Severity = rule.severity
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// Count returns the number of violations of the given severity.
+func Count(violations []Violation, severity Severity) int {
+	count := 0
+	for _, violation := range violations {
+		if violation.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
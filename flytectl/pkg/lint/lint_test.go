@@ -0,0 +1,132 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func taskID(name string) *core.Identifier {
+	return &core.Identifier{ResourceType: core.ResourceType_TASK, Name: name, Version: "v1"}
+}
+
+func containerTask(name, image string, limits, requests map[core.Resources_ResourceName]string, metadata *core.TaskMetadata) *admin.TaskSpec {
+	resources := &core.Resources{}
+	for resourceName, value := range limits {
+		resources.Limits = append(resources.Limits, &core.Resources_ResourceEntry{Name: resourceName, Value: value})
+	}
+	for resourceName, value := range requests {
+		resources.Requests = append(resources.Requests, &core.Resources_ResourceEntry{Name: resourceName, Value: value})
+	}
+	return &admin.TaskSpec{Template: &core.TaskTemplate{
+		Id:       taskID(name),
+		Metadata: metadata,
+		Target:   &core.TaskTemplate_Container{Container: &core.Container{Image: image, Resources: resources}},
+	}}
+}
+
+func testPackage() *Package {
+	compliant := &core.TaskMetadata{
+		Retries:          &core.RetryStrategy{Retries: 3},
+		Timeout:          durationpb.New(3600e9),
+		Discoverable:     true,
+		DiscoveryVersion: "1",
+	}
+	pkg := &Package{}
+	pkg.Add(containerTask("good", "ghcr.io/org/image:v1",
+		map[core.Resources_ResourceName]string{core.Resources_CPU: "2", core.Resources_MEMORY: "1Gi"}, nil, compliant))
+	pkg.Add(containerTask("bad", "docker.io/library/python:latest",
+		map[core.Resources_ResourceName]string{core.Resources_CPU: "32"},
+		map[core.Resources_ResourceName]string{core.Resources_CPU: "16"},
+		&core.TaskMetadata{Discoverable: true}))
+	pkg.Add(&admin.WorkflowSpec{Template: &core.WorkflowTemplate{
+		Id: &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "wf", Version: "v1"},
+		Nodes: []*core.Node{{
+			Id:       "n0",
+			Metadata: &core.NodeMetadata{InterruptibleValue: &core.NodeMetadata_Interruptible{Interruptible: false}},
+			Target:   &core.Node_TaskNode{TaskNode: &core.TaskNode{Reference: &core.TaskNode_ReferenceId{ReferenceId: taskID("bad")}}},
+		}},
+	}})
+	pkg.Add(&admin.LaunchPlan{
+		Id:   &core.Identifier{ResourceType: core.ResourceType_LAUNCH_PLAN, Name: "lp", Version: "v1"},
+		Spec: &admin.LaunchPlanSpec{MaxParallelism: 20},
+	})
+	return pkg
+}
+
+func TestLinter(t *testing.T) {
+	t.Run("configured rules", func(t *testing.T) {
+		linter, err := NewLinter("testdata/rules.yaml")
+		assert.NoError(t, err)
+
+		violations := linter.Lint(testPackage())
+
+		var messages []string
+		for _, violation := range violations {
+			messages = append(messages, string(violation.Severity)+" "+violation.Rule+" "+violation.Entity.GetName()+": "+violation.Message)
+		}
+		assert.Equal(t, []string{
+			"error banned-images bad: uses the banned image docker.io/library/python:latest",
+			"error cache-version bad: enables caching without a cache version",
+			"warning interruptible bad: requests 16 cpu and is not interruptible",
+			"warning interruptible wf: node n0 running a task requesting 16 cpu is not interruptible",
+			"warning max-parallelism lp: runs up to 20 nodes in parallel, more than the maximum of 10",
+			"error resource-limits bad: does not set a memory limit",
+			"error resource-limits bad: cpu limit 32 exceeds the maximum of 8",
+			"error retries bad: is retried 0 times, less than the minimum of 2",
+		}, messages)
+		assert.Equal(t, 5, Count(violations, SeverityError))
+		assert.Len(t, linter.Rules(), 6)
+	})
+	t.Run("default rules", func(t *testing.T) {
+		linter, err := NewLinter("")
+		assert.NoError(t, err)
+
+		violations := linter.Lint(testPackage())
+
+		assert.Equal(t, 2, Count(violations, SeverityError))
+		assert.Equal(t, 2, Count(violations, SeverityWarning))
+		assert.Len(t, linter.Rules(), 7)
+	})
+	t.Run("unknown rule", func(t *testing.T) {
+		_, err := newLinter(Config{Rules: map[string]yaml.Node{"unknown": {}}})
+		assert.EqualError(t, err, "unknown lint rule unknown")
+	})
+	t.Run("missing file", func(t *testing.T) {
+		_, err := NewLinter("testdata/missing.yaml")
+		assert.Error(t, err)
+	})
+}
+
+func TestWriteSARIF(t *testing.T) {
+	linter, err := NewLinter("testdata/rules.yaml")
+	assert.NoError(t, err)
+	violations := linter.Lint(testPackage())
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteSARIF(buf, linter.Rules(), violations, "package.tgz"))
+
+	log := sarifLog{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	assert.Equal(t, sarifVersion, log.Version)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, 6)
+	assert.Len(t, log.Runs[0].Results, len(violations))
+	result := log.Runs[0].Results[0]
+	assert.Equal(t, "banned-images", result.RuleID)
+	assert.Equal(t, "error", result.Level)
+	assert.Equal(t, "package.tgz", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, "bad:v1", result.Locations[0].LogicalLocations[0].FullyQualifiedName)
+	assert.Equal(t, "warning", log.Runs[0].Results[2].Level)
+}
+
+func TestWriteHuman(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, WriteHuman(buf, []Violation{{Rule: "retries", Severity: SeverityInfo, Entity: taskID("t"), Message: "is retried 0 times"}}))
+	assert.Equal(t, "info     retries           task t: is retried 0 times\n0 errors, 0 warnings and 1 infos found\n", buf.String())
+}
@@ -0,0 +1,136 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	FormatHuman = "human"
+	FormatSARIF = "sarif"
+
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// entityName returns the kind and name of the entity, e.g. task core.basic.hello.
+func entityName(id *core.Identifier) string {
+	kind := strings.ReplaceAll(strings.ToLower(id.GetResourceType().String()), "_", " ")
+	return fmt.Sprintf("%s %s", kind, id.GetName())
+}
+
+// WriteHuman writes the violations one per line, followed by a summary line.
+func WriteHuman(w io.Writer, violations []Violation) error {
+	for _, violation := range violations {
+		if _, err := fmt.Fprintf(w, "%-7s  %-16s  %s: %s\n", violation.Severity, violation.Rule, entityName(violation.Entity), violation.Message); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d errors, %d warnings and %d infos found\n",
+		Count(violations, SeverityError), Count(violations, SeverityWarning), Count(violations, SeverityInfo))
+	return err
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+var sarifLevels = map[Severity]string{
+	SeverityError:   "error",
+	SeverityWarning: "warning",
+	SeverityInfo:    "note",
+}
+
+// WriteSARIF writes the violations of the rules by the entities of the artifact, e.g. the package file, as a SARIF log
+// to be uploaded to code scanning tools.
+func WriteSARIF(w io.Writer, rules []Rule, violations []Violation, artifact string) error {
+	driver := sarifDriver{Name: "flytectl", InformationURI: "https://github.com/flyteorg/flyte", Rules: []sarifRule{}}
+	for _, rule := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{ID: rule.ID(), ShortDescription: sarifMessage{Text: rule.Description()}})
+	}
+
+	results := make([]sarifResult, 0, len(violations))
+	for _, violation := range violations {
+		qualifiedName := violation.Entity.GetName()
+		if len(violation.Entity.GetVersion()) > 0 {
+			qualifiedName = fmt.Sprintf("%s:%s", qualifiedName, violation.Entity.GetVersion())
+		}
+		results = append(results, sarifResult{
+			RuleID:  violation.Rule,
+			Level:   sarifLevels[violation.Severity],
+			Message: sarifMessage{Text: fmt.Sprintf("%s: %s", entityName(violation.Entity), violation.Message)},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: artifact}},
+				LogicalLocations: []sarifLogicalLocation{{
+					Name:               violation.Entity.GetName(),
+					FullyQualifiedName: qualifiedName,
+					Kind:               strings.ToLower(violation.Entity.GetResourceType().String()),
+				}},
+			}},
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	})
+}
@@ -0,0 +1,310 @@
+package lint
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func init() {
+	RegisterRule(func() Rule { return &resourceLimitsRule{} })
+	RegisterRule(func() Rule { return &bannedImagesRule{} })
+	RegisterRule(func() Rule { return &retriesRule{config: retriesConfig{Min: 1}} })
+	RegisterRule(func() Rule { return &timeoutRule{} })
+	RegisterRule(func() Rule { return &cacheVersionRule{} })
+	RegisterRule(func() Rule { return &interruptibleRule{config: interruptibleConfig{GPU: "1"}} })
+	RegisterRule(func() Rule { return &maxParallelismRule{} })
+}
+
+// taskRule implements the rules checking each task template of a package.
+type taskRule struct {
+	check func(task *core.TaskTemplate) []string
+}
+
+func (r taskRule) Check(pkg *Package) []Violation {
+	var violations []Violation
+	for _, task := range pkg.Tasks {
+		for _, message := range r.check(task.GetTemplate()) {
+			violations = append(violations, Violation{Entity: task.GetTemplate().GetId(), Message: message})
+		}
+	}
+	return violations
+}
+
+// resourceEntries returns the resources of the container by name, e.g. cpu.
+func resourceEntries(entries []*core.Resources_ResourceEntry) map[string]string {
+	values := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		values[strings.ToLower(entry.GetName().String())] = entry.GetValue()
+	}
+	return values
+}
+
+// exceeds returns whether the quantity exceeds the maximum. Invalid quantities are left to the compiler.
+func exceeds(quantity, maximum string) bool {
+	if len(quantity) == 0 || len(maximum) == 0 {
+		return false
+	}
+	q, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		return false
+	}
+	m, err := resource.ParseQuantity(maximum)
+	if err != nil {
+		return false
+	}
+	return q.Cmp(m) > 0
+}
+
+type resourceLimitsConfig struct {
+	MaxCPU    string `yaml:"maxCpu"`
+	MaxMemory string `yaml:"maxMemory"`
+	MaxGPU    string `yaml:"maxGpu"`
+}
+
+// resourceLimitsRule requires container tasks to set cpu and memory limits, optionally below a maximum.
+type resourceLimitsRule struct {
+	config resourceLimitsConfig
+}
+
+func (r *resourceLimitsRule) ID() string { return "resource-limits" }
+func (r *resourceLimitsRule) Description() string {
+	return "Container tasks set cpu and memory limits, optionally below maxCpu, maxMemory and maxGpu."
+}
+func (r *resourceLimitsRule) DefaultSeverity() Severity { return SeverityError }
+func (r *resourceLimitsRule) Config() interface{}       { return &r.config }
+
+func (r *resourceLimitsRule) Check(pkg *Package) []Violation {
+	return taskRule{check: func(task *core.TaskTemplate) []string {
+		if task.GetContainer() == nil {
+			return nil
+		}
+		var messages []string
+		limits := resourceEntries(task.GetContainer().GetResources().GetLimits())
+		for _, name := range []string{"cpu", "memory"} {
+			if len(limits[name]) == 0 {
+				messages = append(messages, fmt.Sprintf("does not set a %s limit", name))
+			}
+		}
+		for _, maximum := range []struct{ name, value string }{{"cpu", r.config.MaxCPU}, {"memory", r.config.MaxMemory}, {"gpu", r.config.MaxGPU}} {
+			if exceeds(limits[maximum.name], maximum.value) {
+				messages = append(messages, fmt.Sprintf("%s limit %s exceeds the maximum of %s", maximum.name, limits[maximum.name], maximum.value))
+			}
+		}
+		return messages
+	}}.Check(pkg)
+}
+
+type bannedImagesConfig struct {
+	Images []string `yaml:"images"`
+}
+
+// bannedImagesRule bans the container images starting with or matching one of the patterns.
+type bannedImagesRule struct {
+	config bannedImagesConfig
+}
+
+func (r *bannedImagesRule) ID() string { return "banned-images" }
+func (r *bannedImagesRule) Description() string {
+	return "Container tasks don't use images starting with or matching one of the images patterns."
+}
+func (r *bannedImagesRule) DefaultSeverity() Severity { return SeverityError }
+func (r *bannedImagesRule) Config() interface{}       { return &r.config }
+
+func (r *bannedImagesRule) Check(pkg *Package) []Violation {
+	return taskRule{check: func(task *core.TaskTemplate) []string {
+		image := task.GetContainer().GetImage()
+		if len(image) == 0 {
+			return nil
+		}
+		for _, pattern := range r.config.Images {
+			if matched, _ := path.Match(pattern, image); matched || strings.HasPrefix(image, pattern) {
+				return []string{fmt.Sprintf("uses the banned image %s", image)}
+			}
+		}
+		return nil
+	}}.Check(pkg)
+}
+
+type retriesConfig struct {
+	Min uint32 `yaml:"min"`
+}
+
+// retriesRule requires tasks to be retried.
+type retriesRule struct {
+	config retriesConfig
+}
+
+func (r *retriesRule) ID() string                { return "retries" }
+func (r *retriesRule) Description() string       { return "Tasks are retried at least min times." }
+func (r *retriesRule) DefaultSeverity() Severity { return SeverityWarning }
+func (r *retriesRule) Config() interface{}       { return &r.config }
+
+func (r *retriesRule) Check(pkg *Package) []Violation {
+	return taskRule{check: func(task *core.TaskTemplate) []string {
+		if retries := task.GetMetadata().GetRetries().GetRetries(); retries < r.config.Min {
+			return []string{fmt.Sprintf("is retried %d times, less than the minimum of %d", retries, r.config.Min)}
+		}
+		return nil
+	}}.Check(pkg)
+}
+
+type timeoutConfig struct {
+	Max string `yaml:"max"`
+}
+
+// timeoutRule requires tasks to time out, optionally before a maximum.
+type timeoutRule struct {
+	config timeoutConfig
+}
+
+func (r *timeoutRule) ID() string { return "timeout" }
+func (r *timeoutRule) Description() string {
+	return "Tasks set a timeout, optionally below max e.g. 2h."
+}
+func (r *timeoutRule) DefaultSeverity() Severity { return SeverityWarning }
+func (r *timeoutRule) Config() interface{}       { return &r.config }
+
+func (r *timeoutRule) Validate() error {
+	if len(r.config.Max) == 0 {
+		return nil
+	}
+	_, err := time.ParseDuration(r.config.Max)
+	return err
+}
+
+func (r *timeoutRule) Check(pkg *Package) []Violation {
+	var maximum time.Duration
+	if len(r.config.Max) > 0 {
+		maximum, _ = time.ParseDuration(r.config.Max)
+	}
+	return taskRule{check: func(task *core.TaskTemplate) []string {
+		timeout := task.GetMetadata().GetTimeout().AsDuration()
+		if timeout <= 0 {
+			return []string{"does not set a timeout"}
+		}
+		if maximum > 0 && timeout > maximum {
+			return []string{fmt.Sprintf("timeout %s exceeds the maximum of %s", timeout, maximum)}
+		}
+		return nil
+	}}.Check(pkg)
+}
+
+// cacheVersionRule requires cached tasks to set a cache version, which is bumped to invalidate the cache.
+type cacheVersionRule struct{}
+
+func (r *cacheVersionRule) ID() string                { return "cache-version" }
+func (r *cacheVersionRule) Description() string       { return "Cached tasks set a cache version." }
+func (r *cacheVersionRule) DefaultSeverity() Severity { return SeverityError }
+func (r *cacheVersionRule) Config() interface{}       { return &struct{}{} }
+
+func (r *cacheVersionRule) Check(pkg *Package) []Violation {
+	return taskRule{check: func(task *core.TaskTemplate) []string {
+		if task.GetMetadata().GetDiscoverable() && len(task.GetMetadata().GetDiscoveryVersion()) == 0 {
+			return []string{"enables caching without a cache version"}
+		}
+		return nil
+	}}.Check(pkg)
+}
+
+type interruptibleConfig struct {
+	CPU    string `yaml:"cpu"`
+	Memory string `yaml:"memory"`
+	GPU    string `yaml:"gpu"`
+}
+
+// interruptibleRule requires the tasks requesting large resources to run on interruptible, i.e. cheaper, nodes.
+type interruptibleRule struct {
+	config interruptibleConfig
+}
+
+func (r *interruptibleRule) ID() string { return "interruptible" }
+func (r *interruptibleRule) Description() string {
+	return "Tasks requesting at least the cpu, memory or gpu thresholds are interruptible and workflows don't override it."
+}
+func (r *interruptibleRule) DefaultSeverity() Severity { return SeverityWarning }
+func (r *interruptibleRule) Config() interface{}       { return &r.config }
+
+// largeResource returns the first resource of the task reaching its threshold, if any.
+func (r *interruptibleRule) largeResource(task *core.TaskTemplate) (string, bool) {
+	requests := resourceEntries(task.GetContainer().GetResources().GetRequests())
+	for name, value := range resourceEntries(task.GetContainer().GetResources().GetLimits()) {
+		if len(requests[name]) == 0 {
+			requests[name] = value
+		}
+	}
+	for _, threshold := range []struct{ name, value string }{{"cpu", r.config.CPU}, {"memory", r.config.Memory}, {"gpu", r.config.GPU}} {
+		if len(threshold.value) > 0 && len(requests[threshold.name]) > 0 && !exceeds(threshold.value, requests[threshold.name]) {
+			return fmt.Sprintf("%s %s", requests[threshold.name], threshold.name), true
+		}
+	}
+	return "", false
+}
+
+func (r *interruptibleRule) Check(pkg *Package) []Violation {
+	violations := taskRule{check: func(task *core.TaskTemplate) []string {
+		if request, large := r.largeResource(task); large && !task.GetMetadata().GetInterruptible() {
+			return []string{fmt.Sprintf("requests %s and is not interruptible", request)}
+		}
+		return nil
+	}}.Check(pkg)
+
+	largeTasks := map[string]string{}
+	for _, task := range pkg.Tasks {
+		if request, large := r.largeResource(task.GetTemplate()); large {
+			largeTasks[task.GetTemplate().GetId().GetName()] = request
+		}
+	}
+	for _, workflow := range pkg.Workflows {
+		templates := append([]*core.WorkflowTemplate{workflow.GetTemplate()}, workflow.GetSubWorkflows()...)
+		for _, template := range templates {
+			for _, node := range template.GetNodes() {
+				interruptible, overridden := node.GetMetadata().GetInterruptibleValue().(*core.NodeMetadata_Interruptible)
+				request, large := largeTasks[node.GetTaskNode().GetReferenceId().GetName()]
+				if large && overridden && !interruptible.Interruptible {
+					violations = append(violations, Violation{
+						Entity:  workflow.GetTemplate().GetId(),
+						Message: fmt.Sprintf("node %s running a task requesting %s is not interruptible", node.GetId(), request),
+					})
+				}
+			}
+		}
+	}
+	return violations
+}
+
+type maxParallelismConfig struct {
+	Max int32 `yaml:"max"`
+}
+
+// maxParallelismRule bounds the parallelism launch plans run workflows with.
+type maxParallelismRule struct {
+	config maxParallelismConfig
+}
+
+func (r *maxParallelismRule) ID() string { return "max-parallelism" }
+func (r *maxParallelismRule) Description() string {
+	return "Launch plans don't run more than max nodes in parallel, if max is set."
+}
+func (r *maxParallelismRule) DefaultSeverity() Severity { return SeverityWarning }
+func (r *maxParallelismRule) Config() interface{}       { return &r.config }
+
+func (r *maxParallelismRule) Check(pkg *Package) []Violation {
+	if r.config.Max <= 0 {
+		return nil
+	}
+	var violations []Violation
+	for _, launchPlan := range pkg.LaunchPlans {
+		if parallelism := launchPlan.GetSpec().GetMaxParallelism(); parallelism > r.config.Max {
+			violations = append(violations, Violation{
+				Entity:  launchPlan.GetId(),
+				Message: fmt.Sprintf("runs up to %d nodes in parallel, more than the maximum of %d", parallelism, r.config.Max),
+			})
+		}
+	}
+	return violations
+}
@@ -7,9 +7,9 @@ import (
 	"fmt"
 )
 
-const _OutputFormatName = "TABLEJSONYAMLDOTDOTURL"
+const _OutputFormatName = "TABLEJSONYAMLDOTDOTURLMERMAIDHTML"
 
-var _OutputFormatIndex = [...]uint8{0, 5, 9, 13, 16, 22}
+var _OutputFormatIndex = [...]uint8{0, 5, 9, 13, 16, 22, 29, 33}
 
 func (i OutputFormat) String() string {
 	if i >= OutputFormat(len(_OutputFormatIndex)-1) {
@@ -18,7 +18,7 @@ func (i OutputFormat) String() string {
 	return _OutputFormatName[_OutputFormatIndex[i]:_OutputFormatIndex[i+1]]
 }
 
-var _OutputFormatValues = []OutputFormat{0, 1, 2, 3, 4}
+var _OutputFormatValues = []OutputFormat{0, 1, 2, 3, 4, 5, 6}
 
 var _OutputFormatNameToValueMap = map[string]OutputFormat{
 	_OutputFormatName[0:5]:   0,
@@ -26,6 +26,8 @@ var _OutputFormatNameToValueMap = map[string]OutputFormat{
 	_OutputFormatName[9:13]:  2,
 	_OutputFormatName[13:16]: 3,
 	_OutputFormatName[16:22]: 4,
+	_OutputFormatName[22:29]: 5,
+	_OutputFormatName[29:33]: 6,
 }
 
 // OutputFormatString retrieves an enum value from the enum constants string name.
@@ -32,6 +32,8 @@ const (
 	OutputFormatYAML
 	OutputFormatDOT
 	OutputFormatDOTURL
+	OutputFormatMERMAID
+	OutputFormatHTML
 )
 
 // Set implements PFlag's Value interface to attempt to set the value of the flag from string.
@@ -259,7 +261,7 @@ func (p Printer) Print(format OutputFormat, columns []Column, messages ...proto.
 			v = printableMessages
 		}
 		return printJSONYaml(format, v)
-	case OutputFormatDOT, OutputFormatDOTURL:
+	case OutputFormatDOT, OutputFormatDOTURL, OutputFormatMERMAID, OutputFormatHTML:
 		var workflows []*admin.Workflow
 		for _, m := range messages {
 			if w, ok := m.(*admin.Workflow); ok {
@@ -272,7 +274,16 @@ func (p Printer) Print(format OutputFormat, columns []Column, messages ...proto.
 			return fmt.Errorf("at least one workflow required for visualization")
 		}
 		workflow := workflows[0]
-		graphStr, err := visualize.RenderWorkflow(workflow.GetClosure().GetCompiledWorkflow())
+		var graphStr string
+		var err error
+		switch format {
+		case OutputFormatMERMAID:
+			graphStr, err = visualize.RenderWorkflowMermaid(workflow.GetClosure().GetCompiledWorkflow())
+		case OutputFormatHTML:
+			graphStr, err = visualize.RenderWorkflowHTML(workflow.GetId().GetName(), workflow.GetClosure().GetCompiledWorkflow(), nil)
+		default:
+			graphStr, err = visualize.RenderWorkflow(workflow.GetClosure().GetCompiledWorkflow())
+		}
 		if err != nil {
 			return errors.Wrapf("VisualizationError", err, "failed to visualize workflow")
 		}
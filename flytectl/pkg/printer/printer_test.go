@@ -67,9 +67,9 @@ func TestJSONToTable(t *testing.T) {
 }
 
 func TestOutputFormats(t *testing.T) {
-	expected := []string{"TABLE", "JSON", "YAML", "DOT", "DOTURL"}
+	expected := []string{"TABLE", "JSON", "YAML", "DOT", "DOTURL", "MERMAID", "HTML"}
 	outputs := OutputFormats()
-	assert.Equal(t, 5, len(outputs))
+	assert.Equal(t, 7, len(outputs))
 	assert.Equal(t, expected, outputs)
 }
 
@@ -87,7 +87,7 @@ func TestOutputFormatStringErr(t *testing.T) {
 }
 
 func TestIsAOutputFormat(t *testing.T) {
-	o := OutputFormat(5)
+	o := OutputFormat(7)
 	check := o.IsAOutputFormat()
 	assert.Equal(t, false, check)
 
@@ -138,6 +138,8 @@ func TestPrint(t *testing.T) {
 	err = p.Print(OutputFormat(4), lp, LaunchplanToProtoMessages(launchPlans)...)
 	assert.NotNil(t, err)
 	assert.Equal(t, fmt.Errorf("visualization is only supported on workflows"), err)
+	err = p.Print(OutputFormatMERMAID, lp, LaunchplanToProtoMessages(launchPlans)...)
+	assert.Equal(t, fmt.Errorf("visualization is only supported on workflows"), err)
 
 	sortedListLiteralType := core.Variable{
 		Type: &core.LiteralType{
@@ -189,6 +191,10 @@ func TestPrint(t *testing.T) {
 
 	err = p.Print(OutputFormat(3), lp, WorkflowToProtoMessages(workflows)...)
 	assert.Nil(t, err)
+	err = p.Print(OutputFormatMERMAID, lp, WorkflowToProtoMessages(workflows)...)
+	assert.Nil(t, err)
+	err = p.Print(OutputFormatHTML, lp, WorkflowToProtoMessages(workflows)...)
+	assert.Nil(t, err)
 	workflows = []*admin.Workflow{}
 	err = p.Print(OutputFormat(3), lp, WorkflowToProtoMessages(workflows)...)
 	assert.NotNil(t, err)
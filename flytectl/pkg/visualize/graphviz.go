@@ -27,6 +27,11 @@ const (
 	ColorAttr string = "color"
 	Red       string = "red"
 	Green     string = "green"
+	Orange    string = "orange"
+
+	// style attributes
+	StyleAttr string = "style"
+	Dashed    string = "dashed"
 
 	// structural attributes
 	LabelAttr string = "label"
@@ -333,24 +338,33 @@ func (gb *graphBuilder) CompiledWorkflowClosureToGraph(w *core.CompiledWorkflowC
 	_ = dotGraph.SetDir(true)
 	_ = dotGraph.SetStrict(true)
 
+	tLookup, wLookup, err := closureLookups(w)
+	if err != nil {
+		return FlyteGraph{}, err
+	}
+	gb.tasks = tLookup
+	gb.subWf = wLookup
+
+	return dotGraph, gb.constructGraph("", "", dotGraph, w.GetPrimary())
+}
+
+// closureLookups returns the tasks and the sub workflows of the closure by their identifier.
+func closureLookups(w *core.CompiledWorkflowClosure) (map[string]*core.CompiledTask, map[string]*core.CompiledWorkflow, error) {
 	tLookup := make(map[string]*core.CompiledTask)
 	for _, t := range w.GetTasks() {
 		if t.GetTemplate() == nil || t.GetTemplate().GetId() == nil {
-			return FlyteGraph{}, fmt.Errorf("no template found in the workflow task %v", t)
+			return nil, nil, fmt.Errorf("no template found in the workflow task %v", t)
 		}
 		tLookup[t.GetTemplate().GetId().String()] = t
 	}
-	gb.tasks = tLookup
 	wLookup := make(map[string]*core.CompiledWorkflow)
 	for _, swf := range w.GetSubWorkflows() {
 		if swf.GetTemplate() == nil || swf.GetTemplate().GetId() == nil {
-			return FlyteGraph{}, fmt.Errorf("no template found in the sub workflow %v", swf)
+			return nil, nil, fmt.Errorf("no template found in the sub workflow %v", swf)
 		}
 		wLookup[swf.GetTemplate().GetId().String()] = swf
 	}
-	gb.subWf = wLookup
-
-	return dotGraph, gb.constructGraph("", "", dotGraph, w.GetPrimary())
+	return tLookup, wLookup, nil
 }
 
 func newGraphBuilder() *graphBuilder {
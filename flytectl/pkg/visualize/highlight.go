@@ -0,0 +1,155 @@
+package visualize
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Highlight of a node or an edge of a rendered workflow.
+type Highlight string
+
+const (
+	Added   Highlight = "added"
+	Removed Highlight = "removed"
+	Changed Highlight = "changed"
+)
+
+// Edge between the nodes of a workflow, by node id.
+type Edge struct {
+	From string
+	To   string
+}
+
+// Highlights of the top-level nodes and edges of a workflow, e.g. the changes from a previous version.
+type Highlights struct {
+	Nodes map[string]Highlight
+	Edges map[Edge]Highlight
+}
+
+var highlightColors = map[Highlight]string{
+	Added:   Green,
+	Removed: Red,
+	Changed: Orange,
+}
+
+// highlight sets the color of the attributes, as well as the dashed style of removed nodes and edges.
+func highlight(attrs map[string]string, h Highlight) map[string]string {
+	attrs[ColorAttr] = highlightColors[h]
+	if h == Removed {
+		attrs[StyleAttr] = Dashed
+	}
+	return attrs
+}
+
+func sortedNodeIDs(nodes map[string]Highlight) []string {
+	ids := make([]string, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func sortedEdges[V any](edges map[Edge]V) []Edge {
+	sorted := make([]Edge, 0, len(edges))
+	for e := range edges {
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].From != sorted[j].From {
+			return sorted[i].From < sorted[j].From
+		}
+		return sorted[i].To < sorted[j].To
+	})
+	return sorted
+}
+
+// clusterOf returns the cluster rendering the top-level node, if it runs a subworkflow or a branch.
+func clusterOf(gb *graphBuilder, id, name string) string {
+	for _, key := range []string{name, id} {
+		if cluster := gb.nodeClusters[key]; strings.HasPrefix(cluster, SubgraphPrefix) {
+			return cluster
+		}
+	}
+	return ""
+}
+
+// RenderWorkflowWithHighlights renders the workflow graph like RenderWorkflow, with added nodes and edges in green and
+// changed ones in orange. Removed nodes and edges, which are not part of the workflow, are added dashed in red.
+func RenderWorkflowWithHighlights(w *core.CompiledWorkflowClosure, h Highlights) (string, error) {
+	if w == nil {
+		return "", fmt.Errorf("empty workflow closure")
+	}
+	gb := newGraphBuilder()
+	graph, err := gb.CompiledWorkflowClosureToGraph(w)
+	if err != nil {
+		return "", err
+	}
+
+	names := make(map[string]string, len(gb.graphNodes))
+	for id, n := range gb.graphNodes {
+		if n != nil {
+			names[id] = n.Name
+		}
+	}
+	for _, id := range sortedNodeIDs(h.Nodes) {
+		if h.Nodes[id] == Removed {
+			name := strings.ReplaceAll(id, "-", "_")
+			attrs := highlight(map[string]string{ShapeType: BoxShape, LabelAttr: fmt.Sprintf("\"%s\"", id)}, Removed)
+			if err := graph.AddNode("", name, attrs); err != nil {
+				return "", err
+			}
+			names[id] = name
+			continue
+		}
+		name, ok := names[id]
+		if !ok {
+			continue
+		}
+		// Subworkflow and branch nodes are rendered as clusters, which are highlighted as a whole.
+		if cluster := clusterOf(gb, id, name); len(cluster) > 0 {
+			if err := graph.AddAttr(cluster, ColorAttr, highlightColors[h.Nodes[id]]); err != nil {
+				return "", err
+			}
+			continue
+		}
+		for attr, value := range highlight(map[string]string{}, h.Nodes[id]) {
+			if err := graph.GetNode(name).Attrs.Add(attr, value); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	for _, e := range sortedEdges(h.Edges) {
+		from, fromOk := names[e.From]
+		to, toOk := names[e.To]
+		if !fromOk || !toOk {
+			continue
+		}
+		if h.Edges[e] == Removed {
+			attrs := highlight(map[string]string{}, Removed)
+			if cluster := clusterOf(gb, e.From, from); len(cluster) > 0 {
+				attrs[LTailAttr] = fmt.Sprintf("\"%s\"", cluster)
+			}
+			if cluster := clusterOf(gb, e.To, to); len(cluster) > 0 {
+				attrs[LHeadAttr] = fmt.Sprintf("\"%s\"", cluster)
+			}
+			if err := graph.AddEdge(from, to, true, attrs); err != nil {
+				return "", err
+			}
+			continue
+		}
+		if !graph.DoesEdgeExist(from, to) {
+			continue
+		}
+		for attr, value := range highlight(map[string]string{}, h.Edges[e]) {
+			if err := graph.GetEdge(from, to).Attrs.Add(attr, value); err != nil {
+				return "", err
+			}
+		}
+	}
+	return graph.String(), nil
+}
@@ -0,0 +1,36 @@
+package visualize
+
+import (
+	"os"
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWorkflowWithHighlights(t *testing.T) {
+	r, err := os.ReadFile("testdata/compiled_subworkflows.json")
+	require.NoError(t, err)
+	c := &core.CompiledWorkflowClosure{}
+	require.NoError(t, utils.UnmarshalBytesToPb(r, c))
+
+	b, err := RenderWorkflowWithHighlights(c, Highlights{
+		Nodes: map[string]Highlight{"n1": Changed, "n0": Removed, "unknown": Added},
+		Edges: map[Edge]Highlight{
+			{From: "n0", To: "n1"}:            Removed,
+			{From: "n1", To: "end-node"}:      Added,
+			{From: "unknown", To: "end-node"}: Removed,
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, b, "subgraph cluster_n1 {\n\tcolor=orange;")
+	assert.Contains(t, b, "n0 [ color=red, label=\"n0\", shape=box, style=dashed ]")
+	assert.Contains(t, b, "n0->n1_start_node[ color=red, lhead=\"cluster_n1\", style=dashed ]")
+	assert.Contains(t, b, "n1_start_node->end_node[ color=green")
+	assert.NotContains(t, b, "unknown")
+
+	_, err = RenderWorkflowWithHighlights(nil, Highlights{})
+	assert.EqualError(t, err, "empty workflow closure")
+}
@@ -0,0 +1,266 @@
+package visualize
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	htmlNodeWidth  = 200
+	htmlNodeHeight = 56
+	htmlHGap       = 40
+	htmlVGap       = 56
+	htmlPadding    = 24
+)
+
+// NodeStatus of a node of an execution, overlaid on the rendered workflow graph.
+type NodeStatus struct {
+	Phase    string
+	Duration time.Duration
+	Retries  int
+}
+
+// String returns the status on one line, e.g. SUCCEEDED in 1m2s after 1 retries.
+func (s NodeStatus) String() string {
+	status := s.Phase
+	if s.Duration > 0 {
+		status = fmt.Sprintf("%s in %s", status, s.Duration.Round(time.Second))
+	}
+	if s.Retries > 0 {
+		status = fmt.Sprintf("%s after %d retries", status, s.Retries)
+	}
+	return status
+}
+
+// phaseColors are the fill and stroke colors of the nodes by phase.
+var phaseColors = map[string][2]string{
+	core.NodeExecution_SUCCEEDED.String():       {"#e8f5e9", "#2e7d32"},
+	core.NodeExecution_RECOVERED.String():       {"#e8f5e9", "#2e7d32"},
+	core.NodeExecution_FAILED.String():          {"#ffebee", "#c62828"},
+	core.NodeExecution_FAILING.String():         {"#ffebee", "#c62828"},
+	core.NodeExecution_TIMED_OUT.String():       {"#ffebee", "#c62828"},
+	core.NodeExecution_RUNNING.String():         {"#e3f2fd", "#1565c0"},
+	core.NodeExecution_DYNAMIC_RUNNING.String(): {"#e3f2fd", "#1565c0"},
+	core.NodeExecution_QUEUED.String():          {"#e3f2fd", "#1565c0"},
+	core.NodeExecution_ABORTED.String():         {"#f5f5f5", "#616161"},
+	core.NodeExecution_SKIPPED.String():         {"#f5f5f5", "#616161"},
+}
+
+var defaultPhaseColors = [2]string{"#ffffff", "#9e9e9e"}
+
+type htmlNode struct {
+	ID     string
+	Label  string
+	Status string
+	X, Y   int
+	// CX is the horizontal center of the node, which its labels are anchored at.
+	CX     int
+	Fill   string
+	Stroke string
+}
+
+type htmlEdge struct {
+	X1, Y1, X2, Y2 int
+}
+
+type htmlRow struct {
+	NodeID   string
+	Phase    string
+	Duration string
+	Retries  int
+}
+
+type htmlPage struct {
+	Title      string
+	Width      int
+	Height     int
+	NodeWidth  int
+	NodeHeight int
+	Nodes      []htmlNode
+	Edges      []htmlEdge
+	Rows       []htmlRow
+}
+
+var htmlTemplate = template.Must(template.New("workflow").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 24px; color: #212121; }
+svg text { font-size: 12px; }
+svg text.status { font-size: 11px; fill: #424242; }
+table { border-collapse: collapse; margin-top: 24px; }
+th, td { border: 1px solid #e0e0e0; padding: 4px 12px; text-align: left; font-size: 13px; }
+th { background: #fafafa; }
+</style>
+</head>
+<body>
+<h2>{{.Title}}</h2>
+<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="{{.Height}}">
+<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto-start-reverse"><path d="M 0 0 L 10 5 L 0 10 z" fill="#757575"/></marker></defs>
+{{- range .Edges}}
+<line x1="{{.X1}}" y1="{{.Y1}}" x2="{{.X2}}" y2="{{.Y2}}" stroke="#757575" marker-end="url(#arrow)"/>
+{{- end}}
+{{- range .Nodes}}
+<g id="{{.ID}}"><title>{{.ID}}{{if .Status}}: {{.Status}}{{end}}</title>
+<rect x="{{.X}}" y="{{.Y}}" width="{{$.NodeWidth}}" height="{{$.NodeHeight}}" rx="8" fill="{{.Fill}}" stroke="{{.Stroke}}" stroke-width="2"/>
+<text x="{{.CX}}" y="{{.Y}}" dy="24" text-anchor="middle">{{.Label}}</text>
+{{- if .Status}}
+<text class="status" x="{{.CX}}" y="{{.Y}}" dy="42" text-anchor="middle">{{.Status}}</text>
+{{- end}}
+</g>
+{{- end}}
+</svg>
+{{- if .Rows}}
+<table>
+<tr><th>Node</th><th>Phase</th><th>Duration</th><th>Retries</th></tr>
+{{- range .Rows}}
+<tr><td>{{.NodeID}}</td><td>{{.Phase}}</td><td>{{.Duration}}</td><td>{{.Retries}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// layers assigns each node to the layer of its longest path from the nodes without upstream nodes, so that all the
+// edges point downwards.
+func layers(nodes []*core.Node, edges []Edge) map[string]int {
+	layer := make(map[string]int, len(nodes))
+	inDegree := make(map[string]int, len(nodes))
+	downstream := map[string][]string{}
+	for _, e := range edges {
+		inDegree[e.To]++
+		downstream[e.From] = append(downstream[e.From], e.To)
+	}
+	var ready []string
+	for _, n := range nodes {
+		if inDegree[n.GetId()] == 0 {
+			ready = append(ready, n.GetId())
+		}
+	}
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		for _, to := range downstream[id] {
+			if layer[id]+1 > layer[to] {
+				layer[to] = layer[id] + 1
+			}
+			if inDegree[to]--; inDegree[to] == 0 {
+				ready = append(ready, to)
+			}
+		}
+	}
+	return layer
+}
+
+// RenderWorkflowHTML renders a self-contained HTML page with the graph of the top level nodes of the workflow as an
+// inline SVG, without any external script or stylesheet. The statuses of the nodes of an execution, if any, are
+// overlaid on the graph, coloring the nodes by phase, and listed in a table along with those of the nested nodes.
+func RenderWorkflowHTML(title string, w *core.CompiledWorkflowClosure, statuses map[string]NodeStatus) (string, error) {
+	if w == nil {
+		return "", fmt.Errorf("empty workflow closure")
+	}
+	tasks, subWf, err := closureLookups(w)
+	if err != nil {
+		return "", err
+	}
+	mb := &mermaidBuilder{tasks: tasks, subWf: subWf}
+
+	nodes := w.GetPrimary().GetTemplate().GetNodes()
+	edges := workflowEdges(w.GetPrimary())
+	layer := layers(nodes, edges)
+
+	byLayer := map[int][]*core.Node{}
+	depth, breadth := 0, 0
+	for _, n := range nodes {
+		l := layer[n.GetId()]
+		byLayer[l] = append(byLayer[l], n)
+		if l+1 > depth {
+			depth = l + 1
+		}
+		if len(byLayer[l]) > breadth {
+			breadth = len(byLayer[l])
+		}
+	}
+	page := htmlPage{
+		Title:      title,
+		Width:      2*htmlPadding + breadth*(htmlNodeWidth+htmlHGap) - htmlHGap,
+		Height:     2*htmlPadding + depth*(htmlNodeHeight+htmlVGap) - htmlVGap,
+		NodeWidth:  htmlNodeWidth,
+		NodeHeight: htmlNodeHeight,
+	}
+
+	positions := map[string]htmlNode{}
+	for l := 0; l < depth; l++ {
+		// Layers narrower than the widest one are centered.
+		offset := (breadth - len(byLayer[l])) * (htmlNodeWidth + htmlHGap) / 2
+		for i, n := range byLayer[l] {
+			label, err := mb.nodeLabel(n)
+			if err != nil {
+				return "", err
+			}
+			switch n.GetId() {
+			case StartNode:
+				label = "start"
+			case EndNode:
+				label = "end"
+			}
+			colors := defaultPhaseColors
+			status := ""
+			if s, ok := statuses[n.GetId()]; ok {
+				status = s.String()
+				if c, ok := phaseColors[s.Phase]; ok {
+					colors = c
+				}
+			}
+			hn := htmlNode{
+				ID:     n.GetId(),
+				Label:  label,
+				Status: status,
+				X:      htmlPadding + offset + i*(htmlNodeWidth+htmlHGap),
+				Y:      htmlPadding + l*(htmlNodeHeight+htmlVGap),
+				CX:     htmlPadding + offset + i*(htmlNodeWidth+htmlHGap) + htmlNodeWidth/2,
+				Fill:   colors[0],
+				Stroke: colors[1],
+			}
+			positions[n.GetId()] = hn
+			page.Nodes = append(page.Nodes, hn)
+		}
+	}
+	for _, e := range edges {
+		from, fromOk := positions[e.From]
+		to, toOk := positions[e.To]
+		// Nodes nested in branches are not rendered.
+		if !fromOk || !toOk {
+			continue
+		}
+		page.Edges = append(page.Edges, htmlEdge{
+			X1: from.CX, Y1: from.Y + htmlNodeHeight,
+			X2: to.CX, Y2: to.Y,
+		})
+	}
+
+	ids := make([]string, 0, len(statuses))
+	for id := range statuses {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		s := statuses[id]
+		page.Rows = append(page.Rows, htmlRow{NodeID: id, Phase: s.Phase, Duration: s.Duration.Round(time.Millisecond).String(), Retries: s.Retries})
+	}
+
+	var b bytes.Buffer
+	if err := htmlTemplate.Execute(&b, page); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
@@ -0,0 +1,40 @@
+package visualize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWorkflowHTML(t *testing.T) {
+	b, err := RenderWorkflowHTML("f0a1b2c3", readClosure(t, "compiled_subworkflows"), map[string]NodeStatus{
+		"node-t1-parent": {Phase: "SUCCEEDED", Duration: 62 * time.Second, Retries: 1},
+		"n1":             {Phase: "FAILED", Duration: 3 * time.Second},
+		"n1-0-n0":        {Phase: "FAILED", Duration: 2500 * time.Millisecond, Retries: 2},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, b, "<title>f0a1b2c3</title>")
+	assert.NotContains(t, b, "<script")
+	// Nodes are laid out top down by their longest path from the start node.
+	assert.Contains(t, b, `<g id="node-t1-parent"><title>node-t1-parent: SUCCEEDED in 1m2s after 1 retries</title>
+<rect x="24" y="136" width="200" height="56" rx="8" fill="#e8f5e9" stroke="#2e7d32" stroke-width="2"/>
+<text x="124" y="136" dy="24" text-anchor="middle">t1 [python-task]</text>`)
+	assert.Contains(t, b, `<rect x="24" y="248" width="200" height="56" rx="8" fill="#ffebee" stroke="#c62828" stroke-width="2"/>
+<text x="124" y="248" dy="24" text-anchor="middle">my_subwf</text>`)
+	assert.Contains(t, b, `<line x1="124" y1="80" x2="124" y2="136" stroke="#757575" marker-end="url(#arrow)"/>`)
+	assert.Contains(t, b, "<tr><td>n1-0-n0</td><td>FAILED</td><td>2.5s</td><td>2</td></tr>")
+
+	t.Run("without statuses", func(t *testing.T) {
+		b, err := RenderWorkflowHTML("wf", readClosure(t, "compiled_closure_branch_nested"), nil)
+		require.NoError(t, err)
+		assert.Contains(t, b, `<text x="124" y="136" dy="24" text-anchor="middle">[fractions]</text>`)
+		assert.NotContains(t, b, "<table>")
+	})
+
+	t.Run("empty closure", func(t *testing.T) {
+		_, err := RenderWorkflowHTML("wf", nil, nil)
+		assert.EqualError(t, err, "empty workflow closure")
+	})
+}
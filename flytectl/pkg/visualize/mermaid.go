@@ -0,0 +1,227 @@
+package visualize
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	mermaidIndent = "    "
+	// ArrayPrefix prefixes the label of array nodes with the label of the node they map over.
+	ArrayPrefix = "array of "
+)
+
+var mermaidUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// mermaidID returns a Mermaid node identifier for the node of the workflow with the prefix.
+func mermaidID(prefix, id string) string {
+	return mermaidUnsafeChars.ReplaceAllString(getName(prefix, id), "_")
+}
+
+var mermaidEscaper = strings.NewReplacer("\"", "#quot;", "<", "#lt;", ">", "#gt;")
+
+// mermaidLabel quotes the label, escaping the characters Mermaid would otherwise interpret.
+func mermaidLabel(label string) string {
+	return fmt.Sprintf("\"%s\"", mermaidEscaper.Replace(label))
+}
+
+type mermaidBuilder struct {
+	// a lookup table for all tasks in the graph
+	tasks map[string]*core.CompiledTask
+	// lookup table for all sub workflows in the graph
+	subWf map[string]*core.CompiledWorkflow
+	// ids are the Mermaid identifiers of the nodes of the workflow being rendered, including the nodes of its branches,
+	// by node id.
+	ids   map[string]string
+	lines []string
+}
+
+func (mb *mermaidBuilder) add(indent, format string, args ...interface{}) {
+	mb.lines = append(mb.lines, indent+fmt.Sprintf(format, args...))
+}
+
+// taskLabel returns the label of a task node, i.e. the short name of the node and the type of its task.
+func (mb *mermaidBuilder) taskLabel(n *core.Node) (string, error) {
+	tID := n.GetTaskNode().GetReferenceId().String()
+	t, ok := mb.tasks[tID]
+	if !ok {
+		return "", fmt.Errorf("failed to find task [%s] in closure", tID)
+	}
+	if n.GetMetadata().GetName() == "" {
+		return fmt.Sprintf("%s [%s]", n.GetId(), t.GetTemplate().GetType()), nil
+	}
+	v := strings.LastIndexAny(n.GetMetadata().GetName(), ".")
+	return fmt.Sprintf("%s [%s]", n.GetMetadata().GetName()[v+1:], t.GetTemplate().GetType()), nil
+}
+
+func (mb *mermaidBuilder) addBranchNode(indent, prefix, id string, n *core.Node) error {
+	name := n.GetMetadata().GetName()
+	if name == "" {
+		name = n.GetId()
+	}
+	mb.add(indent, "%s{%s}", id, mermaidLabel(name))
+	ifElse := n.GetBranchNode().GetIfElse()
+	if ifElse == nil {
+		return nil
+	}
+
+	addCase := func(then *core.Node, label string) error {
+		thenID, err := mb.addNode(indent, prefix, then)
+		if err != nil {
+			return err
+		}
+		mb.add(indent, "%s -->|%s| %s", id, mermaidLabel(label), thenID)
+		return nil
+	}
+	if err := addCase(ifElse.GetCase().GetThenNode(), booleanExprToString(ifElse.GetCase().GetCondition())); err != nil {
+		return err
+	}
+	for _, c := range ifElse.GetOther() {
+		if err := addCase(c.GetThenNode(), booleanExprToString(c.GetCondition())); err != nil {
+			return err
+		}
+	}
+	if ifElse.GetError() != nil {
+		errorID := id + "_error"
+		mb.add(indent, "%s[%s]", errorID, mermaidLabel(ifElse.GetError().GetMessage()))
+		mb.add(indent, "%s -->|%s| %s", id, mermaidLabel(ElseFail), errorID)
+		return nil
+	}
+	if ifElse.GetElseNode() != nil {
+		return addCase(ifElse.GetElseNode(), Else)
+	}
+	return nil
+}
+
+// nodeLabel returns the label of a node rendered as a single box, e.g. the node an array node maps over.
+func (mb *mermaidBuilder) nodeLabel(n *core.Node) (string, error) {
+	switch n.GetTarget().(type) {
+	case *core.Node_TaskNode:
+		return mb.taskLabel(n)
+	case *core.Node_WorkflowNode:
+		name := n.GetWorkflowNode().GetSubWorkflowRef().GetName()
+		if n.GetWorkflowNode().GetLaunchplanRef() != nil {
+			name = n.GetWorkflowNode().GetLaunchplanRef().GetName()
+		}
+		return name[strings.LastIndexAny(name, ".")+1:], nil
+	case *core.Node_ArrayNode:
+		label, err := mb.nodeLabel(n.GetArrayNode().GetNode())
+		return ArrayPrefix + label, err
+	case *core.Node_BranchNode:
+		if n.GetMetadata().GetName() != "" {
+			return fmt.Sprintf("[%s]", n.GetMetadata().GetName()), nil
+		}
+	}
+	return n.GetId(), nil
+}
+
+// addNode adds the node and returns its identifier, which is the identifier of its subgraph for sub workflows.
+func (mb *mermaidBuilder) addNode(indent, prefix string, n *core.Node) (string, error) {
+	id := mermaidID(prefix, n.GetId())
+	mb.ids[n.GetId()] = id
+	if n.GetId() == StartNode {
+		mb.add(indent, "%s((start))", id)
+		return id, nil
+	}
+	if n.GetId() == EndNode {
+		mb.add(indent, "%s((end))", id)
+		return id, nil
+	}
+
+	switch n.GetTarget().(type) {
+	case *core.Node_TaskNode:
+		label, err := mb.taskLabel(n)
+		if err != nil {
+			return "", err
+		}
+		mb.add(indent, "%s[%s]", id, mermaidLabel(label))
+	case *core.Node_BranchNode:
+		if err := mb.addBranchNode(indent, prefix, id, n); err != nil {
+			return "", err
+		}
+	case *core.Node_WorkflowNode:
+		if lp := n.GetWorkflowNode().GetLaunchplanRef(); lp != nil {
+			mb.add(indent, "%s[[%s]]", id, mermaidLabel(lp.GetName()))
+			break
+		}
+		swf, ok := mb.subWf[n.GetWorkflowNode().GetSubWorkflowRef().String()]
+		if !ok {
+			return "", fmt.Errorf("subworkfow [%s] not found", n.GetWorkflowNode().GetSubWorkflowRef().String())
+		}
+		mb.add(indent, "subgraph %s [%s]", id, mermaidLabel(getName(prefix, n.GetId())))
+		parentIDs := mb.ids
+		err := mb.addWorkflow(indent+mermaidIndent, id, swf)
+		mb.ids = parentIDs
+		if err != nil {
+			return "", err
+		}
+		mb.add(indent, "end")
+	case *core.Node_ArrayNode:
+		label, err := mb.nodeLabel(n)
+		if err != nil {
+			return "", err
+		}
+		mb.add(indent, "%s[[%s]]", id, mermaidLabel(label))
+	default:
+		mb.add(indent, "%s[%s]", id, mermaidLabel(n.GetId()))
+	}
+	return id, nil
+}
+
+// workflowEdges returns the edges between the nodes of the workflow, from both its downstream and upstream connections.
+func workflowEdges(w *core.CompiledWorkflow) []Edge {
+	edges := map[Edge]bool{}
+	for from, to := range w.GetConnections().GetDownstream() {
+		for _, id := range to.GetIds() {
+			edges[Edge{From: from, To: id}] = true
+		}
+	}
+	for to, from := range w.GetConnections().GetUpstream() {
+		for _, id := range from.GetIds() {
+			edges[Edge{From: id, To: to}] = true
+		}
+	}
+	return sortedEdges(edges)
+}
+
+func (mb *mermaidBuilder) addWorkflow(indent, prefix string, w *core.CompiledWorkflow) error {
+	mb.ids = make(map[string]string, len(w.GetTemplate().GetNodes()))
+	for _, n := range w.GetTemplate().GetNodes() {
+		if _, err := mb.addNode(indent, prefix, n); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range workflowEdges(w) {
+		from, fromOk := mb.ids[e.From]
+		to, toOk := mb.ids[e.To]
+		// The connections of a workflow with nested branches reference nodes of the branches out of their scope.
+		if !fromOk || !toOk {
+			continue
+		}
+		mb.add(indent, "%s --> %s", from, to)
+	}
+	return nil
+}
+
+// RenderWorkflowMermaid renders the workflow graph as a Mermaid flowchart, which GitHub and most documentation tools
+// render without installing graphviz. Sub workflows are rendered as subgraphs and branch conditions as edge labels.
+func RenderWorkflowMermaid(w *core.CompiledWorkflowClosure) (string, error) {
+	if w == nil {
+		return "", fmt.Errorf("empty workflow closure")
+	}
+	tasks, subWf, err := closureLookups(w)
+	if err != nil {
+		return "", err
+	}
+	mb := &mermaidBuilder{tasks: tasks, subWf: subWf, lines: []string{"flowchart TD"}}
+	if w.GetPrimary().GetTemplate() != nil {
+		if err := mb.addWorkflow(mermaidIndent, "", w.GetPrimary()); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(mb.lines, "\n"), nil
+}
@@ -0,0 +1,92 @@
+package visualize
+
+import (
+	"os"
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readClosure(t *testing.T, name string) *core.CompiledWorkflowClosure {
+	r, err := os.ReadFile("testdata/" + name + ".json")
+	require.NoError(t, err)
+	c := &core.CompiledWorkflowClosure{}
+	require.NoError(t, utils.UnmarshalBytesToPb(r, c))
+	return c
+}
+
+func TestRenderWorkflowMermaid(t *testing.T) {
+	t.Run("subworkflows", func(t *testing.T) {
+		b, err := RenderWorkflowMermaid(readClosure(t, "compiled_subworkflows"))
+		require.NoError(t, err)
+		assert.Equal(t, `flowchart TD
+    start_node((start))
+    end_node((end))
+    node_t1_parent["t1 [python-task]"]
+    subgraph n1 ["n1"]
+        n1_start_node((start))
+        n1_end_node((end))
+        n1_n0["t1 [python-task]"]
+        n1_n1["t1 [python-task]"]
+        n1_n0 --> n1_end_node
+        n1_n0 --> n1_n1
+        n1_n1 --> n1_end_node
+        n1_start_node --> n1_n0
+    end
+    n1 --> end_node
+    node_t1_parent --> end_node
+    node_t1_parent --> n1
+    start_node --> node_t1_parent`, b)
+	})
+
+	t.Run("branches", func(t *testing.T) {
+		b, err := RenderWorkflowMermaid(readClosure(t, "compiled_closure_branch_nested"))
+		require.NoError(t, err)
+		assert.Contains(t, b, `n0{"fractions"}`)
+		assert.Contains(t, b, `n0 -->|"(.my_input GT 0.1) AND (.my_input LT 1)"| n0_n0`)
+		assert.Contains(t, b, `n0_n0_error["Only #lt;0.7 allowed"]`)
+		assert.Contains(t, b, `n0_n0 -->|"orElse - Fail"| n0_n0_error`)
+		assert.Contains(t, b, `n0 -->|"orElse"| n0_n2`)
+		assert.Contains(t, b, "n0 --> end_node")
+	})
+
+	t.Run("array node", func(t *testing.T) {
+		taskID := &core.Identifier{ResourceType: core.ResourceType_TASK, Name: "core.map.square", Version: "v1"}
+		closure := &core.CompiledWorkflowClosure{
+			Primary: &core.CompiledWorkflow{
+				Template: &core.WorkflowTemplate{Nodes: []*core.Node{
+					{Id: StartNode},
+					{Id: "n0", Target: &core.Node_ArrayNode{ArrayNode: &core.ArrayNode{Node: &core.Node{
+						Id:       "n0",
+						Metadata: &core.NodeMetadata{Name: "core.map.square"},
+						Target:   &core.Node_TaskNode{TaskNode: &core.TaskNode{Reference: &core.TaskNode_ReferenceId{ReferenceId: taskID}}},
+					}}}},
+				}},
+				Connections: &core.ConnectionSet{Downstream: map[string]*core.ConnectionSet_IdList{StartNode: {Ids: []string{"n0"}}}},
+			},
+			Tasks: []*core.CompiledTask{{Template: &core.TaskTemplate{Id: taskID, Type: "python-task"}}},
+		}
+		b, err := RenderWorkflowMermaid(closure)
+		require.NoError(t, err)
+		assert.Equal(t, `flowchart TD
+    start_node((start))
+    n0[["array of square [python-task]"]]
+    start_node --> n0`, b)
+
+		closure.Tasks = nil
+		_, err = RenderWorkflowMermaid(closure)
+		// The id is formatted as proto text, whose spacing is deliberately unstable.
+		assert.ErrorContains(t, err, "failed to find task [")
+		assert.ErrorContains(t, err, `"core.map.square"`)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := RenderWorkflowMermaid(nil)
+		assert.EqualError(t, err, "empty workflow closure")
+		_, err = RenderWorkflowMermaid(&core.CompiledWorkflowClosure{Tasks: []*core.CompiledTask{{Template: &core.TaskTemplate{}}}})
+		assert.ErrorContains(t, err, "no template found in the workflow task")
+	})
+}
@@ -220,6 +220,7 @@ func InitializeMockClientset() *Clientset {
 		authMetadataServiceClient: &mocks.AuthMetadataServiceClient{},
 		identityServiceClient:     &mocks.IdentityServiceClient{},
 		dataProxyServiceClient:    &mocks.DataProxyServiceClient{},
+		signalServiceClient:       &mocks.SignalServiceClient{},
 		healthServiceClient:       grpc_health_v1.NewHealthClient(nil),
 	}
 }
@@ -10,6 +10,8 @@ import (
 	grpc "google.golang.org/grpc"
 
 	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
 )
 
 // AdminServiceClient is an autogenerated mock type for the AdminServiceClient type
@@ -1801,6 +1803,80 @@ func (_c *AdminServiceClient_GetProjectDomainAttributes_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetPromotion provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) GetPromotion(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.Promotion, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromotion")
+	}
+
+	var r0 *admin.Promotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ObjectGetRequest, ...grpc.CallOption) (*admin.Promotion, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ObjectGetRequest, ...grpc.CallOption) *admin.Promotion); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.Promotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ObjectGetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_GetPromotion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromotion'
+type AdminServiceClient_GetPromotion_Call struct {
+	*mock.Call
+}
+
+// GetPromotion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.ObjectGetRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) GetPromotion(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_GetPromotion_Call {
+	return &AdminServiceClient_GetPromotion_Call{Call: _e.mock.On("GetPromotion",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_GetPromotion_Call) Run(run func(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption)) *AdminServiceClient_GetPromotion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.ObjectGetRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_GetPromotion_Call) Return(_a0 *admin.Promotion, _a1 error) *AdminServiceClient_GetPromotion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_GetPromotion_Call) RunAndReturn(run func(context.Context, *admin.ObjectGetRequest, ...grpc.CallOption) (*admin.Promotion, error)) *AdminServiceClient_GetPromotion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTask provides a mock function with given fields: ctx, in, opts
 func (_m *AdminServiceClient) GetTask(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.Task, error) {
 	_va := make([]interface{}, len(opts))
@@ -3207,6 +3283,80 @@ func (_c *AdminServiceClient_ListTasks_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListTriggerFirings provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) ListTriggerFirings(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.TriggerFiringList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTriggerFirings")
+	}
+
+	var r0 *admin.TriggerFiringList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ResourceListRequest, ...grpc.CallOption) (*admin.TriggerFiringList, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ResourceListRequest, ...grpc.CallOption) *admin.TriggerFiringList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.TriggerFiringList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ResourceListRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_ListTriggerFirings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTriggerFirings'
+type AdminServiceClient_ListTriggerFirings_Call struct {
+	*mock.Call
+}
+
+// ListTriggerFirings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.ResourceListRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) ListTriggerFirings(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_ListTriggerFirings_Call {
+	return &AdminServiceClient_ListTriggerFirings_Call{Call: _e.mock.On("ListTriggerFirings",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_ListTriggerFirings_Call) Run(run func(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption)) *AdminServiceClient_ListTriggerFirings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.ResourceListRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_ListTriggerFirings_Call) Return(_a0 *admin.TriggerFiringList, _a1 error) *AdminServiceClient_ListTriggerFirings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_ListTriggerFirings_Call) RunAndReturn(run func(context.Context, *admin.ResourceListRequest, ...grpc.CallOption) (*admin.TriggerFiringList, error)) *AdminServiceClient_ListTriggerFirings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowIds provides a mock function with given fields: ctx, in, opts
 func (_m *AdminServiceClient) ListWorkflowIds(ctx context.Context, in *admin.NamedEntityIdentifierListRequest, opts ...grpc.CallOption) (*admin.NamedEntityIdentifierList, error) {
 	_va := make([]interface{}, len(opts))
@@ -3355,6 +3505,154 @@ func (_c *AdminServiceClient_ListWorkflows_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// PauseExecution provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) PauseExecution(ctx context.Context, in *admin.ExecutionPauseRequest, opts ...grpc.CallOption) (*admin.ExecutionPauseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseExecution")
+	}
+
+	var r0 *admin.ExecutionPauseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionPauseRequest, ...grpc.CallOption) (*admin.ExecutionPauseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionPauseRequest, ...grpc.CallOption) *admin.ExecutionPauseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ExecutionPauseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ExecutionPauseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_PauseExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseExecution'
+type AdminServiceClient_PauseExecution_Call struct {
+	*mock.Call
+}
+
+// PauseExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.ExecutionPauseRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) PauseExecution(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_PauseExecution_Call {
+	return &AdminServiceClient_PauseExecution_Call{Call: _e.mock.On("PauseExecution",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_PauseExecution_Call) Run(run func(ctx context.Context, in *admin.ExecutionPauseRequest, opts ...grpc.CallOption)) *AdminServiceClient_PauseExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.ExecutionPauseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_PauseExecution_Call) Return(_a0 *admin.ExecutionPauseResponse, _a1 error) *AdminServiceClient_PauseExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_PauseExecution_Call) RunAndReturn(run func(context.Context, *admin.ExecutionPauseRequest, ...grpc.CallOption) (*admin.ExecutionPauseResponse, error)) *AdminServiceClient_PauseExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteWorkflow provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) PromoteWorkflow(ctx context.Context, in *admin.WorkflowPromoteRequest, opts ...grpc.CallOption) (*admin.WorkflowPromoteResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteWorkflow")
+	}
+
+	var r0 *admin.WorkflowPromoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowPromoteRequest, ...grpc.CallOption) (*admin.WorkflowPromoteResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowPromoteRequest, ...grpc.CallOption) *admin.WorkflowPromoteResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.WorkflowPromoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.WorkflowPromoteRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_PromoteWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteWorkflow'
+type AdminServiceClient_PromoteWorkflow_Call struct {
+	*mock.Call
+}
+
+// PromoteWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.WorkflowPromoteRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) PromoteWorkflow(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_PromoteWorkflow_Call {
+	return &AdminServiceClient_PromoteWorkflow_Call{Call: _e.mock.On("PromoteWorkflow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_PromoteWorkflow_Call) Run(run func(ctx context.Context, in *admin.WorkflowPromoteRequest, opts ...grpc.CallOption)) *AdminServiceClient_PromoteWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.WorkflowPromoteRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_PromoteWorkflow_Call) Return(_a0 *admin.WorkflowPromoteResponse, _a1 error) *AdminServiceClient_PromoteWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_PromoteWorkflow_Call) RunAndReturn(run func(context.Context, *admin.WorkflowPromoteRequest, ...grpc.CallOption) (*admin.WorkflowPromoteResponse, error)) *AdminServiceClient_PromoteWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecoverExecution provides a mock function with given fields: ctx, in, opts
 func (_m *AdminServiceClient) RecoverExecution(ctx context.Context, in *admin.ExecutionRecoverRequest, opts ...grpc.CallOption) (*admin.ExecutionCreateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3577,6 +3875,80 @@ func (_c *AdminServiceClient_RelaunchExecution_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ResumeExecution provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) ResumeExecution(ctx context.Context, in *admin.ExecutionResumeRequest, opts ...grpc.CallOption) (*admin.ExecutionResumeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeExecution")
+	}
+
+	var r0 *admin.ExecutionResumeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionResumeRequest, ...grpc.CallOption) (*admin.ExecutionResumeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionResumeRequest, ...grpc.CallOption) *admin.ExecutionResumeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ExecutionResumeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ExecutionResumeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_ResumeExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeExecution'
+type AdminServiceClient_ResumeExecution_Call struct {
+	*mock.Call
+}
+
+// ResumeExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.ExecutionResumeRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) ResumeExecution(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_ResumeExecution_Call {
+	return &AdminServiceClient_ResumeExecution_Call{Call: _e.mock.On("ResumeExecution",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_ResumeExecution_Call) Run(run func(ctx context.Context, in *admin.ExecutionResumeRequest, opts ...grpc.CallOption)) *AdminServiceClient_ResumeExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.ExecutionResumeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_ResumeExecution_Call) Return(_a0 *admin.ExecutionResumeResponse, _a1 error) *AdminServiceClient_ResumeExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_ResumeExecution_Call) RunAndReturn(run func(context.Context, *admin.ExecutionResumeRequest, ...grpc.CallOption) (*admin.ExecutionResumeResponse, error)) *AdminServiceClient_ResumeExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateExecution provides a mock function with given fields: ctx, in, opts
 func (_m *AdminServiceClient) TerminateExecution(ctx context.Context, in *admin.ExecutionTerminateRequest, opts ...grpc.CallOption) (*admin.ExecutionTerminateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -4169,6 +4541,80 @@ func (_c *AdminServiceClient_UpdateWorkflowAttributes_Call) RunAndReturn(run fun
 	return _c
 }
 
+// WatchExecution provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) WatchExecution(ctx context.Context, in *admin.WatchExecutionRequest, opts ...grpc.CallOption) (service.AdminService_WatchExecutionClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchExecution")
+	}
+
+	var r0 service.AdminService_WatchExecutionClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WatchExecutionRequest, ...grpc.CallOption) (service.AdminService_WatchExecutionClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WatchExecutionRequest, ...grpc.CallOption) service.AdminService_WatchExecutionClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.AdminService_WatchExecutionClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.WatchExecutionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_WatchExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchExecution'
+type AdminServiceClient_WatchExecution_Call struct {
+	*mock.Call
+}
+
+// WatchExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.WatchExecutionRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) WatchExecution(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_WatchExecution_Call {
+	return &AdminServiceClient_WatchExecution_Call{Call: _e.mock.On("WatchExecution",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_WatchExecution_Call) Run(run func(ctx context.Context, in *admin.WatchExecutionRequest, opts ...grpc.CallOption)) *AdminServiceClient_WatchExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.WatchExecutionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_WatchExecution_Call) Return(_a0 service.AdminService_WatchExecutionClient, _a1 error) *AdminServiceClient_WatchExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_WatchExecution_Call) RunAndReturn(run func(context.Context, *admin.WatchExecutionRequest, ...grpc.CallOption) (service.AdminService_WatchExecutionClient, error)) *AdminServiceClient_WatchExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAdminServiceClient creates a new instance of AdminServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAdminServiceClient(t interface {
@@ -8,6 +8,8 @@ import (
 	admin "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 
 	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
 )
 
 // AdminServiceServer is an autogenerated mock type for the AdminServiceServer type
@@ -1439,6 +1441,65 @@ func (_c *AdminServiceServer_GetProjectDomainAttributes_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetPromotion provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) GetPromotion(_a0 context.Context, _a1 *admin.ObjectGetRequest) (*admin.Promotion, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromotion")
+	}
+
+	var r0 *admin.Promotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ObjectGetRequest) (*admin.Promotion, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ObjectGetRequest) *admin.Promotion); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.Promotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ObjectGetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_GetPromotion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromotion'
+type AdminServiceServer_GetPromotion_Call struct {
+	*mock.Call
+}
+
+// GetPromotion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.ObjectGetRequest
+func (_e *AdminServiceServer_Expecter) GetPromotion(_a0 interface{}, _a1 interface{}) *AdminServiceServer_GetPromotion_Call {
+	return &AdminServiceServer_GetPromotion_Call{Call: _e.mock.On("GetPromotion", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_GetPromotion_Call) Run(run func(_a0 context.Context, _a1 *admin.ObjectGetRequest)) *AdminServiceServer_GetPromotion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ObjectGetRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_GetPromotion_Call) Return(_a0 *admin.Promotion, _a1 error) *AdminServiceServer_GetPromotion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_GetPromotion_Call) RunAndReturn(run func(context.Context, *admin.ObjectGetRequest) (*admin.Promotion, error)) *AdminServiceServer_GetPromotion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTask provides a mock function with given fields: _a0, _a1
 func (_m *AdminServiceServer) GetTask(_a0 context.Context, _a1 *admin.ObjectGetRequest) (*admin.Task, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2560,6 +2621,65 @@ func (_c *AdminServiceServer_ListTasks_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListTriggerFirings provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) ListTriggerFirings(_a0 context.Context, _a1 *admin.ResourceListRequest) (*admin.TriggerFiringList, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTriggerFirings")
+	}
+
+	var r0 *admin.TriggerFiringList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ResourceListRequest) (*admin.TriggerFiringList, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ResourceListRequest) *admin.TriggerFiringList); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.TriggerFiringList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ResourceListRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_ListTriggerFirings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTriggerFirings'
+type AdminServiceServer_ListTriggerFirings_Call struct {
+	*mock.Call
+}
+
+// ListTriggerFirings is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.ResourceListRequest
+func (_e *AdminServiceServer_Expecter) ListTriggerFirings(_a0 interface{}, _a1 interface{}) *AdminServiceServer_ListTriggerFirings_Call {
+	return &AdminServiceServer_ListTriggerFirings_Call{Call: _e.mock.On("ListTriggerFirings", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_ListTriggerFirings_Call) Run(run func(_a0 context.Context, _a1 *admin.ResourceListRequest)) *AdminServiceServer_ListTriggerFirings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ResourceListRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_ListTriggerFirings_Call) Return(_a0 *admin.TriggerFiringList, _a1 error) *AdminServiceServer_ListTriggerFirings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_ListTriggerFirings_Call) RunAndReturn(run func(context.Context, *admin.ResourceListRequest) (*admin.TriggerFiringList, error)) *AdminServiceServer_ListTriggerFirings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWorkflowIds provides a mock function with given fields: _a0, _a1
 func (_m *AdminServiceServer) ListWorkflowIds(_a0 context.Context, _a1 *admin.NamedEntityIdentifierListRequest) (*admin.NamedEntityIdentifierList, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2678,6 +2798,124 @@ func (_c *AdminServiceServer_ListWorkflows_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// PauseExecution provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) PauseExecution(_a0 context.Context, _a1 *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseExecution")
+	}
+
+	var r0 *admin.ExecutionPauseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionPauseRequest) *admin.ExecutionPauseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ExecutionPauseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ExecutionPauseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_PauseExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseExecution'
+type AdminServiceServer_PauseExecution_Call struct {
+	*mock.Call
+}
+
+// PauseExecution is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.ExecutionPauseRequest
+func (_e *AdminServiceServer_Expecter) PauseExecution(_a0 interface{}, _a1 interface{}) *AdminServiceServer_PauseExecution_Call {
+	return &AdminServiceServer_PauseExecution_Call{Call: _e.mock.On("PauseExecution", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_PauseExecution_Call) Run(run func(_a0 context.Context, _a1 *admin.ExecutionPauseRequest)) *AdminServiceServer_PauseExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ExecutionPauseRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_PauseExecution_Call) Return(_a0 *admin.ExecutionPauseResponse, _a1 error) *AdminServiceServer_PauseExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_PauseExecution_Call) RunAndReturn(run func(context.Context, *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error)) *AdminServiceServer_PauseExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteWorkflow provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) PromoteWorkflow(_a0 context.Context, _a1 *admin.WorkflowPromoteRequest) (*admin.WorkflowPromoteResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteWorkflow")
+	}
+
+	var r0 *admin.WorkflowPromoteResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowPromoteRequest) (*admin.WorkflowPromoteResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowPromoteRequest) *admin.WorkflowPromoteResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.WorkflowPromoteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.WorkflowPromoteRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_PromoteWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteWorkflow'
+type AdminServiceServer_PromoteWorkflow_Call struct {
+	*mock.Call
+}
+
+// PromoteWorkflow is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.WorkflowPromoteRequest
+func (_e *AdminServiceServer_Expecter) PromoteWorkflow(_a0 interface{}, _a1 interface{}) *AdminServiceServer_PromoteWorkflow_Call {
+	return &AdminServiceServer_PromoteWorkflow_Call{Call: _e.mock.On("PromoteWorkflow", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_PromoteWorkflow_Call) Run(run func(_a0 context.Context, _a1 *admin.WorkflowPromoteRequest)) *AdminServiceServer_PromoteWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.WorkflowPromoteRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_PromoteWorkflow_Call) Return(_a0 *admin.WorkflowPromoteResponse, _a1 error) *AdminServiceServer_PromoteWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_PromoteWorkflow_Call) RunAndReturn(run func(context.Context, *admin.WorkflowPromoteRequest) (*admin.WorkflowPromoteResponse, error)) *AdminServiceServer_PromoteWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecoverExecution provides a mock function with given fields: _a0, _a1
 func (_m *AdminServiceServer) RecoverExecution(_a0 context.Context, _a1 *admin.ExecutionRecoverRequest) (*admin.ExecutionCreateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2855,6 +3093,65 @@ func (_c *AdminServiceServer_RelaunchExecution_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ResumeExecution provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) ResumeExecution(_a0 context.Context, _a1 *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeExecution")
+	}
+
+	var r0 *admin.ExecutionResumeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ExecutionResumeRequest) *admin.ExecutionResumeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ExecutionResumeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ExecutionResumeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_ResumeExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeExecution'
+type AdminServiceServer_ResumeExecution_Call struct {
+	*mock.Call
+}
+
+// ResumeExecution is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.ExecutionResumeRequest
+func (_e *AdminServiceServer_Expecter) ResumeExecution(_a0 interface{}, _a1 interface{}) *AdminServiceServer_ResumeExecution_Call {
+	return &AdminServiceServer_ResumeExecution_Call{Call: _e.mock.On("ResumeExecution", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_ResumeExecution_Call) Run(run func(_a0 context.Context, _a1 *admin.ExecutionResumeRequest)) *AdminServiceServer_ResumeExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ExecutionResumeRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_ResumeExecution_Call) Return(_a0 *admin.ExecutionResumeResponse, _a1 error) *AdminServiceServer_ResumeExecution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_ResumeExecution_Call) RunAndReturn(run func(context.Context, *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error)) *AdminServiceServer_ResumeExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TerminateExecution provides a mock function with given fields: _a0, _a1
 func (_m *AdminServiceServer) TerminateExecution(_a0 context.Context, _a1 *admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3327,6 +3624,53 @@ func (_c *AdminServiceServer_UpdateWorkflowAttributes_Call) RunAndReturn(run fun
 	return _c
 }
 
+// WatchExecution provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) WatchExecution(_a0 *admin.WatchExecutionRequest, _a1 service.AdminService_WatchExecutionServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchExecution")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*admin.WatchExecutionRequest, service.AdminService_WatchExecutionServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdminServiceServer_WatchExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchExecution'
+type AdminServiceServer_WatchExecution_Call struct {
+	*mock.Call
+}
+
+// WatchExecution is a helper method to define mock.On call
+//   - _a0 *admin.WatchExecutionRequest
+//   - _a1 service.AdminService_WatchExecutionServer
+func (_e *AdminServiceServer_Expecter) WatchExecution(_a0 interface{}, _a1 interface{}) *AdminServiceServer_WatchExecution_Call {
+	return &AdminServiceServer_WatchExecution_Call{Call: _e.mock.On("WatchExecution", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_WatchExecution_Call) Run(run func(_a0 *admin.WatchExecutionRequest, _a1 service.AdminService_WatchExecutionServer)) *AdminServiceServer_WatchExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*admin.WatchExecutionRequest), args[1].(service.AdminService_WatchExecutionServer))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_WatchExecution_Call) Return(_a0 error) *AdminServiceServer_WatchExecution_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AdminServiceServer_WatchExecution_Call) RunAndReturn(run func(*admin.WatchExecutionRequest, service.AdminService_WatchExecutionServer) error) *AdminServiceServer_WatchExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAdminServiceServer creates a new instance of AdminServiceServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAdminServiceServer(t interface {
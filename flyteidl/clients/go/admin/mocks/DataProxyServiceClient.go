@@ -321,6 +321,80 @@ func (_c *DataProxyServiceClient_GetData_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// PreviewStructuredDataset provides a mock function with given fields: ctx, in, opts
+func (_m *DataProxyServiceClient) PreviewStructuredDataset(ctx context.Context, in *service.PreviewStructuredDatasetRequest, opts ...grpc.CallOption) (*service.PreviewStructuredDatasetResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewStructuredDataset")
+	}
+
+	var r0 *service.PreviewStructuredDatasetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *service.PreviewStructuredDatasetRequest, ...grpc.CallOption) (*service.PreviewStructuredDatasetResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *service.PreviewStructuredDatasetRequest, ...grpc.CallOption) *service.PreviewStructuredDatasetResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.PreviewStructuredDatasetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *service.PreviewStructuredDatasetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataProxyServiceClient_PreviewStructuredDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewStructuredDataset'
+type DataProxyServiceClient_PreviewStructuredDataset_Call struct {
+	*mock.Call
+}
+
+// PreviewStructuredDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *service.PreviewStructuredDatasetRequest
+//   - opts ...grpc.CallOption
+func (_e *DataProxyServiceClient_Expecter) PreviewStructuredDataset(ctx interface{}, in interface{}, opts ...interface{}) *DataProxyServiceClient_PreviewStructuredDataset_Call {
+	return &DataProxyServiceClient_PreviewStructuredDataset_Call{Call: _e.mock.On("PreviewStructuredDataset",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *DataProxyServiceClient_PreviewStructuredDataset_Call) Run(run func(ctx context.Context, in *service.PreviewStructuredDatasetRequest, opts ...grpc.CallOption)) *DataProxyServiceClient_PreviewStructuredDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*service.PreviewStructuredDatasetRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DataProxyServiceClient_PreviewStructuredDataset_Call) Return(_a0 *service.PreviewStructuredDatasetResponse, _a1 error) *DataProxyServiceClient_PreviewStructuredDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataProxyServiceClient_PreviewStructuredDataset_Call) RunAndReturn(run func(context.Context, *service.PreviewStructuredDatasetRequest, ...grpc.CallOption) (*service.PreviewStructuredDatasetResponse, error)) *DataProxyServiceClient_PreviewStructuredDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDataProxyServiceClient creates a new instance of DataProxyServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDataProxyServiceClient(t interface {
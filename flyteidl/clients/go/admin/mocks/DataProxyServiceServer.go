@@ -258,6 +258,65 @@ func (_c *DataProxyServiceServer_GetData_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// PreviewStructuredDataset provides a mock function with given fields: _a0, _a1
+func (_m *DataProxyServiceServer) PreviewStructuredDataset(_a0 context.Context, _a1 *service.PreviewStructuredDatasetRequest) (*service.PreviewStructuredDatasetResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewStructuredDataset")
+	}
+
+	var r0 *service.PreviewStructuredDatasetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *service.PreviewStructuredDatasetRequest) (*service.PreviewStructuredDatasetResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *service.PreviewStructuredDatasetRequest) *service.PreviewStructuredDatasetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.PreviewStructuredDatasetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *service.PreviewStructuredDatasetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataProxyServiceServer_PreviewStructuredDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewStructuredDataset'
+type DataProxyServiceServer_PreviewStructuredDataset_Call struct {
+	*mock.Call
+}
+
+// PreviewStructuredDataset is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *service.PreviewStructuredDatasetRequest
+func (_e *DataProxyServiceServer_Expecter) PreviewStructuredDataset(_a0 interface{}, _a1 interface{}) *DataProxyServiceServer_PreviewStructuredDataset_Call {
+	return &DataProxyServiceServer_PreviewStructuredDataset_Call{Call: _e.mock.On("PreviewStructuredDataset", _a0, _a1)}
+}
+
+func (_c *DataProxyServiceServer_PreviewStructuredDataset_Call) Run(run func(_a0 context.Context, _a1 *service.PreviewStructuredDatasetRequest)) *DataProxyServiceServer_PreviewStructuredDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*service.PreviewStructuredDatasetRequest))
+	})
+	return _c
+}
+
+func (_c *DataProxyServiceServer_PreviewStructuredDataset_Call) Return(_a0 *service.PreviewStructuredDatasetResponse, _a1 error) *DataProxyServiceServer_PreviewStructuredDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataProxyServiceServer_PreviewStructuredDataset_Call) RunAndReturn(run func(context.Context, *service.PreviewStructuredDatasetRequest) (*service.PreviewStructuredDatasetResponse, error)) *DataProxyServiceServer_PreviewStructuredDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDataProxyServiceServer creates a new instance of DataProxyServiceServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDataProxyServiceServer(t interface {
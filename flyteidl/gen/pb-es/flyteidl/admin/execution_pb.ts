@@ -6,8 +6,8 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { BoolValue, Duration, Message, proto3, Timestamp } from "@bufbuild/protobuf";
 import { LiteralMap } from "../core/literals_pb.js";
-import { Identifier, NodeExecutionIdentifier, WorkflowExecutionIdentifier } from "../core/identifier_pb.js";
-import { ExecutionError, QualityOfService, WorkflowExecution_Phase } from "../core/execution_pb.js";
+import { Identifier, NodeExecutionIdentifier, TaskExecutionIdentifier, WorkflowExecutionIdentifier } from "../core/identifier_pb.js";
+import { ExecutionError, NodeExecution_Phase, QualityOfService, TaskExecution_Phase, WorkflowExecution_Phase } from "../core/execution_pb.js";
 import { Annotations, AuthRole, Envs, Labels, Notification, RawOutputDataConfig, UrlBlob } from "./common_pb.js";
 import { ArtifactID } from "../core/artifact_id_pb.js";
 import { SecurityContext } from "../core/security_pb.js";
@@ -222,6 +222,24 @@ export class ExecutionRecoverRequest extends Message<ExecutionRecoverRequest> {
    */
   metadata?: ExecutionMetadata;
 
+  /**
+   * Re-runs a node of the workflow and all the nodes downstream of it instead of recovering them, even if they
+   * succeeded in the reference execution.
+   * +optional
+   *
+   * @generated from field: flyteidl.admin.RerunFromNode rerun_from = 4;
+   */
+  rerunFrom?: RerunFromNode;
+
+  /**
+   * Launch plan to execute in place of the one of the reference execution, e.g. a version of it registered with
+   * fixed tasks. The ids of the nodes to recover must be the same in both workflows.
+   * +optional
+   *
+   * @generated from field: flyteidl.core.Identifier launch_plan = 5;
+   */
+  launchPlan?: Identifier;
+
   constructor(data?: PartialMessage<ExecutionRecoverRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -233,6 +251,8 @@ export class ExecutionRecoverRequest extends Message<ExecutionRecoverRequest> {
     { no: 1, name: "id", kind: "message", T: WorkflowExecutionIdentifier },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "metadata", kind: "message", T: ExecutionMetadata },
+    { no: 4, name: "rerun_from", kind: "message", T: RerunFromNode },
+    { no: 5, name: "launch_plan", kind: "message", T: Identifier },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionRecoverRequest {
@@ -252,6 +272,56 @@ export class ExecutionRecoverRequest extends Message<ExecutionRecoverRequest> {
   }
 }
 
+/**
+ * Selects the node a recovered execution re-runs from.
+ *
+ * @generated from message flyteidl.admin.RerunFromNode
+ */
+export class RerunFromNode extends Message<RerunFromNode> {
+  /**
+   * Id of a node of the workflow, which must not be nested in a sub-workflow or a branch.
+   *
+   * @generated from field: string node_id = 1;
+   */
+  nodeId = "";
+
+  /**
+   * Inputs overriding the ones the node is bound to. The inputs missing from it are resolved as usual.
+   * +optional
+   *
+   * @generated from field: flyteidl.core.LiteralMap inputs = 2;
+   */
+  inputs?: LiteralMap;
+
+  constructor(data?: PartialMessage<RerunFromNode>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.RerunFromNode";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "node_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "inputs", kind: "message", T: LiteralMap },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RerunFromNode {
+    return new RerunFromNode().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RerunFromNode {
+    return new RerunFromNode().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RerunFromNode {
+    return new RerunFromNode().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RerunFromNode | PlainMessage<RerunFromNode> | undefined, b: RerunFromNode | PlainMessage<RerunFromNode> | undefined): boolean {
+    return proto3.util.equals(RerunFromNode, a, b);
+  }
+}
+
 /**
  * The unique identifier for a successfully created execution.
  * If the name was *not* specified in the create request, this identifier will include a generated name.
@@ -834,6 +904,13 @@ export class ExecutionMetadata extends Message<ExecutionMetadata> {
    */
   artifactIds: ArtifactID[] = [];
 
+  /**
+   * Optional, the node a recovered execution re-runs from, with all the nodes downstream of it.
+   *
+   * @generated from field: flyteidl.admin.RerunFromNode rerun_from = 20;
+   */
+  rerunFrom?: RerunFromNode;
+
   constructor(data?: PartialMessage<ExecutionMetadata>) {
     super();
     proto3.util.initPartial(data, this);
@@ -850,6 +927,7 @@ export class ExecutionMetadata extends Message<ExecutionMetadata> {
     { no: 16, name: "reference_execution", kind: "message", T: WorkflowExecutionIdentifier },
     { no: 17, name: "system_metadata", kind: "message", T: SystemMetadata },
     { no: 18, name: "artifact_ids", kind: "message", T: ArtifactID, repeated: true },
+    { no: 20, name: "rerun_from", kind: "message", T: RerunFromNode },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionMetadata {
@@ -1264,6 +1342,163 @@ export class ExecutionTerminateResponse extends Message<ExecutionTerminateRespon
   }
 }
 
+/**
+ * Request to pause a running execution with the given identifier.
+ * While paused, no new nodes of the execution are started and the phase "PAUSED" is recorded for it.
+ *
+ * @generated from message flyteidl.admin.ExecutionPauseRequest
+ */
+export class ExecutionPauseRequest extends Message<ExecutionPauseRequest> {
+  /**
+   * Uniquely identifies the individual workflow execution to be paused.
+   *
+   * @generated from field: flyteidl.core.WorkflowExecutionIdentifier id = 1;
+   */
+  id?: WorkflowExecutionIdentifier;
+
+  /**
+   * Aborts the running nodes of the execution, to re-queue them once it is resumed, instead of letting them finish.
+   *
+   * @generated from field: bool abort_running_nodes = 2;
+   */
+  abortRunningNodes = false;
+
+  constructor(data?: PartialMessage<ExecutionPauseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ExecutionPauseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: WorkflowExecutionIdentifier },
+    { no: 2, name: "abort_running_nodes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionPauseRequest {
+    return new ExecutionPauseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecutionPauseRequest {
+    return new ExecutionPauseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecutionPauseRequest {
+    return new ExecutionPauseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExecutionPauseRequest | PlainMessage<ExecutionPauseRequest> | undefined, b: ExecutionPauseRequest | PlainMessage<ExecutionPauseRequest> | undefined): boolean {
+    return proto3.util.equals(ExecutionPauseRequest, a, b);
+  }
+}
+
+/**
+ * Purposefully empty, may be populated in the future.
+ *
+ * @generated from message flyteidl.admin.ExecutionPauseResponse
+ */
+export class ExecutionPauseResponse extends Message<ExecutionPauseResponse> {
+  constructor(data?: PartialMessage<ExecutionPauseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ExecutionPauseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionPauseResponse {
+    return new ExecutionPauseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecutionPauseResponse {
+    return new ExecutionPauseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecutionPauseResponse {
+    return new ExecutionPauseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExecutionPauseResponse | PlainMessage<ExecutionPauseResponse> | undefined, b: ExecutionPauseResponse | PlainMessage<ExecutionPauseResponse> | undefined): boolean {
+    return proto3.util.equals(ExecutionPauseResponse, a, b);
+  }
+}
+
+/**
+ * Request to resume a paused execution with the given identifier.
+ *
+ * @generated from message flyteidl.admin.ExecutionResumeRequest
+ */
+export class ExecutionResumeRequest extends Message<ExecutionResumeRequest> {
+  /**
+   * Uniquely identifies the individual workflow execution to be resumed.
+   *
+   * @generated from field: flyteidl.core.WorkflowExecutionIdentifier id = 1;
+   */
+  id?: WorkflowExecutionIdentifier;
+
+  constructor(data?: PartialMessage<ExecutionResumeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ExecutionResumeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: WorkflowExecutionIdentifier },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionResumeRequest {
+    return new ExecutionResumeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecutionResumeRequest {
+    return new ExecutionResumeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecutionResumeRequest {
+    return new ExecutionResumeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExecutionResumeRequest | PlainMessage<ExecutionResumeRequest> | undefined, b: ExecutionResumeRequest | PlainMessage<ExecutionResumeRequest> | undefined): boolean {
+    return proto3.util.equals(ExecutionResumeRequest, a, b);
+  }
+}
+
+/**
+ * Purposefully empty, may be populated in the future.
+ *
+ * @generated from message flyteidl.admin.ExecutionResumeResponse
+ */
+export class ExecutionResumeResponse extends Message<ExecutionResumeResponse> {
+  constructor(data?: PartialMessage<ExecutionResumeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ExecutionResumeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecutionResumeResponse {
+    return new ExecutionResumeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecutionResumeResponse {
+    return new ExecutionResumeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecutionResumeResponse {
+    return new ExecutionResumeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExecutionResumeResponse | PlainMessage<ExecutionResumeResponse> | undefined, b: ExecutionResumeResponse | PlainMessage<ExecutionResumeResponse> | undefined): boolean {
+    return proto3.util.equals(ExecutionResumeResponse, a, b);
+  }
+}
+
 /**
  * Request structure to fetch inputs, output and other data produced by an execution.
  * By default this data is not returned inline in :ref:`ref_flyteidl.admin.WorkflowExecutionGetRequest`
@@ -1599,3 +1834,290 @@ export class WorkflowExecutionGetMetricsResponse extends Message<WorkflowExecuti
   }
 }
 
+/**
+ * Request to watch the phase changes of a workflow execution, of its nodes and of their tasks.
+ *
+ * @generated from message flyteidl.admin.WatchExecutionRequest
+ */
+export class WatchExecutionRequest extends Message<WatchExecutionRequest> {
+  /**
+   * Identifier of the execution to watch.
+   * +required
+   *
+   * @generated from field: flyteidl.core.WorkflowExecutionIdentifier id = 1;
+   */
+  id?: WorkflowExecutionIdentifier;
+
+  /**
+   * If set, only the phase changes of this node, of the nodes nested in it and of their tasks are sent.
+   * +optional
+   *
+   * @generated from field: string node_id = 2;
+   */
+  nodeId = "";
+
+  /**
+   * Cursor of the last phase change received, to resume watching after a reconnect. The phase changes persisted
+   * after it are sent before the new ones. If empty, all the phase changes of the execution are sent.
+   * +optional
+   *
+   * @generated from field: string cursor = 3;
+   */
+  cursor = "";
+
+  constructor(data?: PartialMessage<WatchExecutionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WatchExecutionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: WorkflowExecutionIdentifier },
+    { no: 2, name: "node_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchExecutionRequest {
+    return new WatchExecutionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchExecutionRequest {
+    return new WatchExecutionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchExecutionRequest {
+    return new WatchExecutionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchExecutionRequest | PlainMessage<WatchExecutionRequest> | undefined, b: WatchExecutionRequest | PlainMessage<WatchExecutionRequest> | undefined): boolean {
+    return proto3.util.equals(WatchExecutionRequest, a, b);
+  }
+}
+
+/**
+ * Phase change of a workflow execution, of one of its nodes or of one of their tasks.
+ *
+ * @generated from message flyteidl.admin.WatchExecutionResponse
+ */
+export class WatchExecutionResponse extends Message<WatchExecutionResponse> {
+  /**
+   * Cursor of the phase change, to resume watching after it.
+   *
+   * @generated from field: string cursor = 1;
+   */
+  cursor = "";
+
+  /**
+   * Time at which the phase change occurred.
+   *
+   * @generated from field: google.protobuf.Timestamp occurred_at = 2;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * @generated from oneof flyteidl.admin.WatchExecutionResponse.change
+   */
+  change: {
+    /**
+     * @generated from field: flyteidl.admin.WorkflowExecutionPhaseChange workflow_execution = 3;
+     */
+    value: WorkflowExecutionPhaseChange;
+    case: "workflowExecution";
+  } | {
+    /**
+     * @generated from field: flyteidl.admin.NodeExecutionPhaseChange node_execution = 4;
+     */
+    value: NodeExecutionPhaseChange;
+    case: "nodeExecution";
+  } | {
+    /**
+     * @generated from field: flyteidl.admin.TaskExecutionPhaseChange task_execution = 5;
+     */
+    value: TaskExecutionPhaseChange;
+    case: "taskExecution";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<WatchExecutionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WatchExecutionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "occurred_at", kind: "message", T: Timestamp },
+    { no: 3, name: "workflow_execution", kind: "message", T: WorkflowExecutionPhaseChange, oneof: "change" },
+    { no: 4, name: "node_execution", kind: "message", T: NodeExecutionPhaseChange, oneof: "change" },
+    { no: 5, name: "task_execution", kind: "message", T: TaskExecutionPhaseChange, oneof: "change" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchExecutionResponse {
+    return new WatchExecutionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchExecutionResponse {
+    return new WatchExecutionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchExecutionResponse {
+    return new WatchExecutionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchExecutionResponse | PlainMessage<WatchExecutionResponse> | undefined, b: WatchExecutionResponse | PlainMessage<WatchExecutionResponse> | undefined): boolean {
+    return proto3.util.equals(WatchExecutionResponse, a, b);
+  }
+}
+
+/**
+ * Phase change of a workflow execution.
+ *
+ * @generated from message flyteidl.admin.WorkflowExecutionPhaseChange
+ */
+export class WorkflowExecutionPhaseChange extends Message<WorkflowExecutionPhaseChange> {
+  /**
+   * @generated from field: flyteidl.core.WorkflowExecutionIdentifier id = 1;
+   */
+  id?: WorkflowExecutionIdentifier;
+
+  /**
+   * @generated from field: flyteidl.core.WorkflowExecution.Phase phase = 2;
+   */
+  phase = WorkflowExecution_Phase.UNDEFINED;
+
+  constructor(data?: PartialMessage<WorkflowExecutionPhaseChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WorkflowExecutionPhaseChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: WorkflowExecutionIdentifier },
+    { no: 2, name: "phase", kind: "enum", T: proto3.getEnumType(WorkflowExecution_Phase) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowExecutionPhaseChange {
+    return new WorkflowExecutionPhaseChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowExecutionPhaseChange {
+    return new WorkflowExecutionPhaseChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowExecutionPhaseChange {
+    return new WorkflowExecutionPhaseChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowExecutionPhaseChange | PlainMessage<WorkflowExecutionPhaseChange> | undefined, b: WorkflowExecutionPhaseChange | PlainMessage<WorkflowExecutionPhaseChange> | undefined): boolean {
+    return proto3.util.equals(WorkflowExecutionPhaseChange, a, b);
+  }
+}
+
+/**
+ * Phase change of a node execution.
+ *
+ * @generated from message flyteidl.admin.NodeExecutionPhaseChange
+ */
+export class NodeExecutionPhaseChange extends Message<NodeExecutionPhaseChange> {
+  /**
+   * @generated from field: flyteidl.core.NodeExecutionIdentifier id = 1;
+   */
+  id?: NodeExecutionIdentifier;
+
+  /**
+   * @generated from field: flyteidl.core.NodeExecution.Phase phase = 2;
+   */
+  phase = NodeExecution_Phase.UNDEFINED;
+
+  /**
+   * Id of the node this node is nested in, e.g. a sub-workflow or dynamic node. Empty for the top level nodes.
+   *
+   * @generated from field: string parent_node_id = 3;
+   */
+  parentNodeId = "";
+
+  constructor(data?: PartialMessage<NodeExecutionPhaseChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.NodeExecutionPhaseChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: NodeExecutionIdentifier },
+    { no: 2, name: "phase", kind: "enum", T: proto3.getEnumType(NodeExecution_Phase) },
+    { no: 3, name: "parent_node_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NodeExecutionPhaseChange {
+    return new NodeExecutionPhaseChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NodeExecutionPhaseChange {
+    return new NodeExecutionPhaseChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NodeExecutionPhaseChange {
+    return new NodeExecutionPhaseChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NodeExecutionPhaseChange | PlainMessage<NodeExecutionPhaseChange> | undefined, b: NodeExecutionPhaseChange | PlainMessage<NodeExecutionPhaseChange> | undefined): boolean {
+    return proto3.util.equals(NodeExecutionPhaseChange, a, b);
+  }
+}
+
+/**
+ * Phase change of a task execution.
+ *
+ * @generated from message flyteidl.admin.TaskExecutionPhaseChange
+ */
+export class TaskExecutionPhaseChange extends Message<TaskExecutionPhaseChange> {
+  /**
+   * @generated from field: flyteidl.core.TaskExecutionIdentifier id = 1;
+   */
+  id?: TaskExecutionIdentifier;
+
+  /**
+   * @generated from field: flyteidl.core.TaskExecution.Phase phase = 2;
+   */
+  phase = TaskExecution_Phase.UNDEFINED;
+
+  /**
+   * Version of the phase, incremented when the task reports progress without changing phase.
+   *
+   * @generated from field: uint32 phase_version = 3;
+   */
+  phaseVersion = 0;
+
+  constructor(data?: PartialMessage<TaskExecutionPhaseChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.TaskExecutionPhaseChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: TaskExecutionIdentifier },
+    { no: 2, name: "phase", kind: "enum", T: proto3.getEnumType(TaskExecution_Phase) },
+    { no: 3, name: "phase_version", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TaskExecutionPhaseChange {
+    return new TaskExecutionPhaseChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TaskExecutionPhaseChange {
+    return new TaskExecutionPhaseChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TaskExecutionPhaseChange {
+    return new TaskExecutionPhaseChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TaskExecutionPhaseChange | PlainMessage<TaskExecutionPhaseChange> | undefined, b: TaskExecutionPhaseChange | PlainMessage<TaskExecutionPhaseChange> | undefined): boolean {
+    return proto3.util.equals(TaskExecutionPhaseChange, a, b);
+  }
+}
+
@@ -14,6 +14,7 @@ import { QualityOfService } from "../core/execution_pb.js";
 import { ExecutionEnvAssignment } from "../core/execution_envs_pb.js";
 import { ClusterAssignment } from "./cluster_assignment_pb.js";
 import { Schedule } from "./schedule_pb.js";
+import { Trigger } from "./trigger_pb.js";
 
 /**
  * By default any launch plan regardless of state can be used to launch a workflow execution.
@@ -654,6 +655,13 @@ export class LaunchPlanMetadata extends Message<LaunchPlanMetadata> {
    */
   launchConditions?: Any;
 
+  /**
+   * Triggers launching executions of the active version of the launch plan on events
+   *
+   * @generated from field: repeated flyteidl.admin.Trigger triggers = 4;
+   */
+  triggers: Trigger[] = [];
+
   constructor(data?: PartialMessage<LaunchPlanMetadata>) {
     super();
     proto3.util.initPartial(data, this);
@@ -665,6 +673,7 @@ export class LaunchPlanMetadata extends Message<LaunchPlanMetadata> {
     { no: 1, name: "schedule", kind: "message", T: Schedule },
     { no: 2, name: "notifications", kind: "message", T: Notification, repeated: true },
     { no: 3, name: "launch_conditions", kind: "message", T: Any },
+    { no: 4, name: "triggers", kind: "message", T: Trigger, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LaunchPlanMetadata {
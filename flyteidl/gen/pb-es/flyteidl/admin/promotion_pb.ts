@@ -0,0 +1,190 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file flyteidl/admin/promotion.proto (package flyteidl.admin, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Identifier } from "../core/identifier_pb.js";
+
+/**
+ * Request to promote a version of a workflow, with the tasks, subworkflows and launch plans it was registered with, to
+ * another project and domain.
+ * See :ref:`ref_flyteidl.admin.Promotion` for more details
+ *
+ * @generated from message flyteidl.admin.WorkflowPromoteRequest
+ */
+export class WorkflowPromoteRequest extends Message<WorkflowPromoteRequest> {
+  /**
+   * Identifier of the workflow version to promote.
+   * +required
+   *
+   * @generated from field: flyteidl.core.Identifier id = 1;
+   */
+  id?: Identifier;
+
+  /**
+   * Project to promote the workflow to. Defaults to the project of the workflow when unset.
+   *
+   * @generated from field: string target_project = 2;
+   */
+  targetProject = "";
+
+  /**
+   * Domain to promote the workflow to.
+   * +required
+   *
+   * @generated from field: string target_domain = 3;
+   */
+  targetDomain = "";
+
+  /**
+   * Whether to activate the promoted launch plans in the target domain.
+   *
+   * @generated from field: bool activate_launch_plans = 4;
+   */
+  activateLaunchPlans = false;
+
+  constructor(data?: PartialMessage<WorkflowPromoteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WorkflowPromoteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: Identifier },
+    { no: 2, name: "target_project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "target_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "activate_launch_plans", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowPromoteRequest {
+    return new WorkflowPromoteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowPromoteRequest {
+    return new WorkflowPromoteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowPromoteRequest {
+    return new WorkflowPromoteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowPromoteRequest | PlainMessage<WorkflowPromoteRequest> | undefined, b: WorkflowPromoteRequest | PlainMessage<WorkflowPromoteRequest> | undefined): boolean {
+    return proto3.util.equals(WorkflowPromoteRequest, a, b);
+  }
+}
+
+/**
+ * Promotion records where an entity registered by a promotion was promoted from.
+ *
+ * @generated from message flyteidl.admin.Promotion
+ */
+export class Promotion extends Message<Promotion> {
+  /**
+   * Identifier of the entity the promotion copied.
+   *
+   * @generated from field: flyteidl.core.Identifier source = 1;
+   */
+  source?: Identifier;
+
+  /**
+   * Identifier of the entity in the project and domain it was promoted to.
+   *
+   * @generated from field: flyteidl.core.Identifier target = 2;
+   */
+  target?: Identifier;
+
+  /**
+   * Identity of the user who promoted the entity.
+   *
+   * @generated from field: string promoted_by = 3;
+   */
+  promotedBy = "";
+
+  /**
+   * Time at which the entity was promoted.
+   *
+   * @generated from field: google.protobuf.Timestamp promoted_at = 4;
+   */
+  promotedAt?: Timestamp;
+
+  /**
+   * Whether the target entity was already registered with an identical structure, in which case it was left as is.
+   *
+   * @generated from field: bool existed = 5;
+   */
+  existed = false;
+
+  constructor(data?: PartialMessage<Promotion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.Promotion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "message", T: Identifier },
+    { no: 2, name: "target", kind: "message", T: Identifier },
+    { no: 3, name: "promoted_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "promoted_at", kind: "message", T: Timestamp },
+    { no: 5, name: "existed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Promotion {
+    return new Promotion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Promotion {
+    return new Promotion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Promotion {
+    return new Promotion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Promotion | PlainMessage<Promotion> | undefined, b: Promotion | PlainMessage<Promotion> | undefined): boolean {
+    return proto3.util.equals(Promotion, a, b);
+  }
+}
+
+/**
+ * Response of a workflow promotion, holding a promotion for each entity registered in the target project and domain.
+ *
+ * @generated from message flyteidl.admin.WorkflowPromoteResponse
+ */
+export class WorkflowPromoteResponse extends Message<WorkflowPromoteResponse> {
+  /**
+   * @generated from field: repeated flyteidl.admin.Promotion promotions = 1;
+   */
+  promotions: Promotion[] = [];
+
+  constructor(data?: PartialMessage<WorkflowPromoteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WorkflowPromoteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "promotions", kind: "message", T: Promotion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowPromoteResponse {
+    return new WorkflowPromoteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowPromoteResponse {
+    return new WorkflowPromoteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowPromoteResponse {
+    return new WorkflowPromoteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowPromoteResponse | PlainMessage<WorkflowPromoteResponse> | undefined, b: WorkflowPromoteResponse | PlainMessage<WorkflowPromoteResponse> | undefined): boolean {
+    return proto3.util.equals(WorkflowPromoteResponse, a, b);
+  }
+}
+
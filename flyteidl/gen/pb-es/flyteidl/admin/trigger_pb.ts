@@ -0,0 +1,413 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file flyteidl/admin/trigger.proto (package flyteidl.admin, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Identifier, WorkflowExecutionIdentifier } from "../core/identifier_pb.js";
+
+/**
+ * TriggerFiringOutcome is what came out of an event matching a trigger.
+ *
+ * @generated from enum flyteidl.admin.TriggerFiringOutcome
+ */
+export enum TriggerFiringOutcome {
+  /**
+   * @generated from enum value: TRIGGER_FIRING_OUTCOME_UNSPECIFIED = 0;
+   */
+  TRIGGER_FIRING_OUTCOME_UNSPECIFIED = 0,
+
+  /**
+   * An execution was launched.
+   *
+   * @generated from enum value: FIRED = 1;
+   */
+  FIRED = 1,
+
+  /**
+   * The event was already received, and the execution it launched was not launched again.
+   *
+   * @generated from enum value: DUPLICATE = 2;
+   */
+  DUPLICATE = 2,
+
+  /**
+   * The trigger had already launched the maximum number of executions it launches in an hour.
+   *
+   * @generated from enum value: RATE_LIMITED = 3;
+   */
+  RATE_LIMITED = 3,
+
+  /**
+   * The execution failed to launch.
+   *
+   * @generated from enum value: FAILED = 4;
+   */
+  FAILED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TriggerFiringOutcome)
+proto3.util.setEnumType(TriggerFiringOutcome, "flyteidl.admin.TriggerFiringOutcome", [
+  { no: 0, name: "TRIGGER_FIRING_OUTCOME_UNSPECIFIED" },
+  { no: 1, name: "FIRED" },
+  { no: 2, name: "DUPLICATE" },
+  { no: 3, name: "RATE_LIMITED" },
+  { no: 4, name: "FAILED" },
+]);
+
+/**
+ * Trigger launches an execution of the active version of a launch plan whenever an event matching it is received.
+ * See :ref:`ref_flyteidl.admin.LaunchPlanMetadata` for more details
+ *
+ * @generated from message flyteidl.admin.Trigger
+ */
+export class Trigger extends Message<Trigger> {
+  /**
+   * Name of the trigger, unique among the triggers of a launch plan.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Events the trigger fires on.
+   *
+   * @generated from oneof flyteidl.admin.Trigger.event
+   */
+  event: {
+    /**
+     * @generated from field: flyteidl.admin.StorageEventTrigger storage = 2;
+     */
+    value: StorageEventTrigger;
+    case: "storage";
+  } | {
+    /**
+     * @generated from field: flyteidl.admin.WebhookTrigger webhook = 3;
+     */
+    value: WebhookTrigger;
+    case: "webhook";
+  } | {
+    /**
+     * @generated from field: flyteidl.admin.CloudEventTrigger cloud_event = 4;
+     */
+    value: CloudEventTrigger;
+    case: "cloudEvent";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * Maps names of launch plan inputs onto the fields of the event payload holding their values, as dot separated
+   * paths such as "key" or "data.date". Inputs which are not mapped take their default or fixed values.
+   *
+   * @generated from field: map<string, string> inputs = 5;
+   */
+  inputs: { [key: string]: string } = {};
+
+  /**
+   * Maximum number of executions the trigger launches in an hour. Events received past the limit are recorded and
+   * dropped. Zero means no limit.
+   *
+   * @generated from field: uint32 max_firings_per_hour = 6;
+   */
+  maxFiringsPerHour = 0;
+
+  constructor(data?: PartialMessage<Trigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.Trigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "storage", kind: "message", T: StorageEventTrigger, oneof: "event" },
+    { no: 3, name: "webhook", kind: "message", T: WebhookTrigger, oneof: "event" },
+    { no: 4, name: "cloud_event", kind: "message", T: CloudEventTrigger, oneof: "event" },
+    { no: 5, name: "inputs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "max_firings_per_hour", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Trigger {
+    return new Trigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Trigger {
+    return new Trigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Trigger {
+    return new Trigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Trigger | PlainMessage<Trigger> | undefined, b: Trigger | PlainMessage<Trigger> | undefined): boolean {
+    return proto3.util.equals(Trigger, a, b);
+  }
+}
+
+/**
+ * StorageEventTrigger fires when an object is created at a location matching a pattern. The payload of the event has
+ * the fields uri, bucket, key, size and etag of the created object.
+ *
+ * @generated from message flyteidl.admin.StorageEventTrigger
+ */
+export class StorageEventTrigger extends Message<StorageEventTrigger> {
+  /**
+   * Pattern the uri of created objects must match, such as s3://bucket/prefix/*.csv. A * matches any sequence of
+   * characters but /, and a ** any sequence of characters.
+   *
+   * @generated from field: string uri_pattern = 1;
+   */
+  uriPattern = "";
+
+  constructor(data?: PartialMessage<StorageEventTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.StorageEventTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "uri_pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StorageEventTrigger {
+    return new StorageEventTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StorageEventTrigger {
+    return new StorageEventTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StorageEventTrigger {
+    return new StorageEventTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StorageEventTrigger | PlainMessage<StorageEventTrigger> | undefined, b: StorageEventTrigger | PlainMessage<StorageEventTrigger> | undefined): boolean {
+    return proto3.util.equals(StorageEventTrigger, a, b);
+  }
+}
+
+/**
+ * WebhookTrigger fires on POST requests to /triggers/v1/{project}/{domain}/{launch_plan}/{trigger} carrying the token
+ * of the trigger as a bearer token. The payload of the event is the json body of the request.
+ *
+ * @generated from message flyteidl.admin.WebhookTrigger
+ */
+export class WebhookTrigger extends Message<WebhookTrigger> {
+  /**
+   * Hex encoded SHA-256 digest of the token of the trigger.
+   *
+   * @generated from field: string token_sha256 = 1;
+   */
+  tokenSha256 = "";
+
+  constructor(data?: PartialMessage<WebhookTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WebhookTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token_sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookTrigger {
+    return new WebhookTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookTrigger {
+    return new WebhookTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookTrigger {
+    return new WebhookTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookTrigger | PlainMessage<WebhookTrigger> | undefined, b: WebhookTrigger | PlainMessage<WebhookTrigger> | undefined): boolean {
+    return proto3.util.equals(WebhookTrigger, a, b);
+  }
+}
+
+/**
+ * CloudEventTrigger fires on CloudEvents posted to /triggers/v1/cloudevents with a matching type and source. The
+ * payload of the event is the structured json representation of the CloudEvent, holding its data in the data field.
+ *
+ * @generated from message flyteidl.admin.CloudEventTrigger
+ */
+export class CloudEventTrigger extends Message<CloudEventTrigger> {
+  /**
+   * Type of the events, such as com.example.object.created.
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * Source of the events. Events from any source match when unset.
+   *
+   * @generated from field: string source = 2;
+   */
+  source = "";
+
+  constructor(data?: PartialMessage<CloudEventTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.CloudEventTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloudEventTrigger {
+    return new CloudEventTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloudEventTrigger {
+    return new CloudEventTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloudEventTrigger {
+    return new CloudEventTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CloudEventTrigger | PlainMessage<CloudEventTrigger> | undefined, b: CloudEventTrigger | PlainMessage<CloudEventTrigger> | undefined): boolean {
+    return proto3.util.equals(CloudEventTrigger, a, b);
+  }
+}
+
+/**
+ * TriggerFiring records an event which matched a trigger.
+ *
+ * @generated from message flyteidl.admin.TriggerFiring
+ */
+export class TriggerFiring extends Message<TriggerFiring> {
+  /**
+   * Version of the launch plan which was active when the event was received.
+   *
+   * @generated from field: flyteidl.core.Identifier launch_plan = 1;
+   */
+  launchPlan?: Identifier;
+
+  /**
+   * Name of the trigger.
+   *
+   * @generated from field: string trigger_name = 2;
+   */
+  triggerName = "";
+
+  /**
+   * Identifier of the event, shared by the deliveries of the same event.
+   *
+   * @generated from field: string event_id = 3;
+   */
+  eventId = "";
+
+  /**
+   * @generated from field: flyteidl.admin.TriggerFiringOutcome outcome = 4;
+   */
+  outcome = TriggerFiringOutcome.TRIGGER_FIRING_OUTCOME_UNSPECIFIED;
+
+  /**
+   * Execution the event launched.
+   *
+   * @generated from field: flyteidl.core.WorkflowExecutionIdentifier execution_id = 5;
+   */
+  executionId?: WorkflowExecutionIdentifier;
+
+  /**
+   * Reason of a failure to launch the execution.
+   *
+   * @generated from field: string message = 6;
+   */
+  message = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp received_at = 7;
+   */
+  receivedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<TriggerFiring>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.TriggerFiring";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "launch_plan", kind: "message", T: Identifier },
+    { no: 2, name: "trigger_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "outcome", kind: "enum", T: proto3.getEnumType(TriggerFiringOutcome) },
+    { no: 5, name: "execution_id", kind: "message", T: WorkflowExecutionIdentifier },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "received_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerFiring {
+    return new TriggerFiring().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerFiring {
+    return new TriggerFiring().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerFiring {
+    return new TriggerFiring().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerFiring | PlainMessage<TriggerFiring> | undefined, b: TriggerFiring | PlainMessage<TriggerFiring> | undefined): boolean {
+    return proto3.util.equals(TriggerFiring, a, b);
+  }
+}
+
+/**
+ * TriggerFiringList is a page of the firings of the triggers of a launch plan.
+ *
+ * @generated from message flyteidl.admin.TriggerFiringList
+ */
+export class TriggerFiringList extends Message<TriggerFiringList> {
+  /**
+   * @generated from field: repeated flyteidl.admin.TriggerFiring firings = 1;
+   */
+  firings: TriggerFiring[] = [];
+
+  /**
+   * In the case of multiple pages of results, the server-provided token can be used to fetch the next page
+   * in a query. If there are no more results, this value will be empty.
+   *
+   * @generated from field: string token = 2;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<TriggerFiringList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.TriggerFiringList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "firings", kind: "message", T: TriggerFiring, repeated: true },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerFiringList {
+    return new TriggerFiringList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerFiringList {
+    return new TriggerFiringList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerFiringList {
+    return new TriggerFiringList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerFiringList | PlainMessage<TriggerFiringList> | undefined, b: TriggerFiringList | PlainMessage<TriggerFiringList> | undefined): boolean {
+    return proto3.util.equals(TriggerFiringList, a, b);
+  }
+}
+
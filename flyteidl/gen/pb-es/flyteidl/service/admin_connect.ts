@@ -7,8 +7,10 @@ import { Task, TaskCreateRequest, TaskCreateResponse, TaskList } from "../admin/
 import { MethodKind } from "@bufbuild/protobuf";
 import { NamedEntity, NamedEntityGetRequest, NamedEntityIdentifierList, NamedEntityIdentifierListRequest, NamedEntityList, NamedEntityListRequest, NamedEntityUpdateRequest, NamedEntityUpdateResponse, ObjectGetRequest, ResourceListRequest } from "../admin/common_pb.js";
 import { Workflow, WorkflowCreateRequest, WorkflowCreateResponse, WorkflowList } from "../admin/workflow_pb.js";
+import { Promotion, WorkflowPromoteRequest, WorkflowPromoteResponse } from "../admin/promotion_pb.js";
 import { ActiveLaunchPlanListRequest, ActiveLaunchPlanRequest, LaunchPlan, LaunchPlanCreateRequest, LaunchPlanCreateResponse, LaunchPlanList, LaunchPlanUpdateRequest, LaunchPlanUpdateResponse } from "../admin/launch_plan_pb.js";
-import { Execution, ExecutionCreateRequest, ExecutionCreateResponse, ExecutionList, ExecutionRecoverRequest, ExecutionRelaunchRequest, ExecutionTerminateRequest, ExecutionTerminateResponse, ExecutionUpdateRequest, ExecutionUpdateResponse, WorkflowExecutionGetDataRequest, WorkflowExecutionGetDataResponse, WorkflowExecutionGetMetricsRequest, WorkflowExecutionGetMetricsResponse, WorkflowExecutionGetRequest } from "../admin/execution_pb.js";
+import { TriggerFiringList } from "../admin/trigger_pb.js";
+import { Execution, ExecutionCreateRequest, ExecutionCreateResponse, ExecutionList, ExecutionPauseRequest, ExecutionPauseResponse, ExecutionRecoverRequest, ExecutionRelaunchRequest, ExecutionResumeRequest, ExecutionResumeResponse, ExecutionTerminateRequest, ExecutionTerminateResponse, ExecutionUpdateRequest, ExecutionUpdateResponse, WatchExecutionRequest, WatchExecutionResponse, WorkflowExecutionGetDataRequest, WorkflowExecutionGetDataResponse, WorkflowExecutionGetMetricsRequest, WorkflowExecutionGetMetricsResponse, WorkflowExecutionGetRequest } from "../admin/execution_pb.js";
 import { DynamicNodeWorkflowResponse, GetDynamicNodeWorkflowRequest, NodeExecution, NodeExecutionForTaskListRequest, NodeExecutionGetDataRequest, NodeExecutionGetDataResponse, NodeExecutionGetRequest, NodeExecutionList, NodeExecutionListRequest } from "../admin/node_execution_pb.js";
 import { GetDomainRequest, GetDomainsResponse, Project, ProjectGetRequest, ProjectListRequest, ProjectRegisterRequest, ProjectRegisterResponse, Projects, ProjectUpdateResponse } from "../admin/project_pb.js";
 import { NodeExecutionEventRequest, NodeExecutionEventResponse, TaskExecutionEventRequest, TaskExecutionEventResponse, WorkflowExecutionEventRequest, WorkflowExecutionEventResponse } from "../admin/event_pb.js";
@@ -117,6 +119,28 @@ export const AdminService = {
       O: WorkflowList,
       kind: MethodKind.Unary,
     },
+    /**
+     * Promotes a :ref:`ref_flyteidl.admin.Workflow` version, with its tasks, subworkflows and launch plans, to another project and domain.
+     *
+     * @generated from rpc flyteidl.service.AdminService.PromoteWorkflow
+     */
+    promoteWorkflow: {
+      name: "PromoteWorkflow",
+      I: WorkflowPromoteRequest,
+      O: WorkflowPromoteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Fetch the :ref:`ref_flyteidl.admin.Promotion` which registered an entity.
+     *
+     * @generated from rpc flyteidl.service.AdminService.GetPromotion
+     */
+    getPromotion: {
+      name: "GetPromotion",
+      I: ObjectGetRequest,
+      O: Promotion,
+      kind: MethodKind.Unary,
+    },
     /**
      * Create and upload a :ref:`ref_flyteidl.admin.LaunchPlan` definition
      *
@@ -183,6 +207,17 @@ export const AdminService = {
       O: LaunchPlanList,
       kind: MethodKind.Unary,
     },
+    /**
+     * Fetch a list of :ref:`ref_flyteidl.admin.TriggerFiring` of the triggers of a launch plan.
+     *
+     * @generated from rpc flyteidl.service.AdminService.ListTriggerFirings
+     */
+    listTriggerFirings: {
+      name: "ListTriggerFirings",
+      I: ResourceListRequest,
+      O: TriggerFiringList,
+      kind: MethodKind.Unary,
+    },
     /**
      * Updates the status of a registered :ref:`ref_flyteidl.admin.LaunchPlan`.
      *
@@ -286,6 +321,28 @@ export const AdminService = {
       O: ExecutionTerminateResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Pauses an in-progress :ref:`ref_flyteidl.admin.Execution`, no new nodes of which are started until it is resumed.
+     *
+     * @generated from rpc flyteidl.service.AdminService.PauseExecution
+     */
+    pauseExecution: {
+      name: "PauseExecution",
+      I: ExecutionPauseRequest,
+      O: ExecutionPauseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Resumes a paused :ref:`ref_flyteidl.admin.Execution`.
+     *
+     * @generated from rpc flyteidl.service.AdminService.ResumeExecution
+     */
+    resumeExecution: {
+      name: "ResumeExecution",
+      I: ExecutionResumeRequest,
+      O: ExecutionResumeResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Fetches a :ref:`ref_flyteidl.admin.NodeExecution`.
      *
@@ -647,6 +704,18 @@ export const AdminService = {
       O: WorkflowExecutionGetMetricsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Streams the phase changes of a :ref:`ref_flyteidl.admin.Execution`, of its nodes and of their tasks as they are
+     * recorded, optionally limited to the subtree of a node.
+     *
+     * @generated from rpc flyteidl.service.AdminService.WatchExecution
+     */
+    watchExecution: {
+      name: "WatchExecution",
+      I: WatchExecutionRequest,
+      O: WatchExecutionResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateDownloadLinkRequest, CreateDownloadLinkResponse, CreateDownloadLocationRequest, CreateDownloadLocationResponse, CreateUploadLocationRequest, CreateUploadLocationResponse, GetDataRequest, GetDataResponse } from "./dataproxy_pb.js";
+import { CreateDownloadLinkRequest, CreateDownloadLinkResponse, CreateDownloadLocationRequest, CreateDownloadLocationResponse, CreateUploadLocationRequest, CreateUploadLocationResponse, GetDataRequest, GetDataResponse, PreviewStructuredDatasetRequest, PreviewStructuredDatasetResponse } from "./dataproxy_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -57,6 +57,17 @@ export const DataProxyService = {
       O: GetDataResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * PreviewStructuredDataset reads the first rows and the column schema of a structured dataset.
+     *
+     * @generated from rpc flyteidl.service.DataProxyService.PreviewStructuredDataset
+     */
+    previewStructuredDataset: {
+      name: "PreviewStructuredDataset",
+      I: PreviewStructuredDatasetRequest,
+      O: PreviewStructuredDatasetResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -6,7 +6,8 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Duration, Message, proto3, Timestamp } from "@bufbuild/protobuf";
 import { NodeExecutionIdentifier } from "../core/identifier_pb.js";
-import { Literal, LiteralMap } from "../core/literals_pb.js";
+import { Literal, LiteralMap, Scalar } from "../core/literals_pb.js";
+import { StructuredDatasetType } from "../core/types_pb.js";
 
 /**
  * ArtifactType
@@ -594,3 +595,166 @@ export class GetDataResponse extends Message<GetDataResponse> {
   }
 }
 
+/**
+ * Request to preview the first rows of a structured dataset, or of a parquet or CSV blob.
+ *
+ * @generated from message flyteidl.service.PreviewStructuredDatasetRequest
+ */
+export class PreviewStructuredDatasetRequest extends Message<PreviewStructuredDatasetRequest> {
+  /**
+   * A flyte url addressing a single structured dataset or blob input or output of an execution.
+   * e.g. flyte://v1/proj/development/execid/n2/0/o/o0
+   *
+   * @generated from field: string flyte_url = 1;
+   */
+  flyteUrl = "";
+
+  /**
+   * The maximum number of rows to return. Defaults to, and is capped at, the limit configured in admin.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit = 0;
+
+  /**
+   * The names of the columns to return, in order. All columns are returned if empty.
+   *
+   * @generated from field: repeated string columns = 3;
+   */
+  columns: string[] = [];
+
+  constructor(data?: PartialMessage<PreviewStructuredDatasetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.service.PreviewStructuredDatasetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "flyte_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewStructuredDatasetRequest {
+    return new PreviewStructuredDatasetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewStructuredDatasetRequest {
+    return new PreviewStructuredDatasetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewStructuredDatasetRequest {
+    return new PreviewStructuredDatasetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewStructuredDatasetRequest | PlainMessage<PreviewStructuredDatasetRequest> | undefined, b: PreviewStructuredDatasetRequest | PlainMessage<PreviewStructuredDatasetRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewStructuredDatasetRequest, a, b);
+  }
+}
+
+/**
+ * A row of a structured dataset preview.
+ *
+ * @generated from message flyteidl.service.StructuredDatasetRow
+ */
+export class StructuredDatasetRow extends Message<StructuredDatasetRow> {
+  /**
+   * The values of the row, in the order of the columns of the schema. Null values are set to none_type.
+   *
+   * @generated from field: repeated flyteidl.core.Scalar values = 1;
+   */
+  values: Scalar[] = [];
+
+  constructor(data?: PartialMessage<StructuredDatasetRow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.service.StructuredDatasetRow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "values", kind: "message", T: Scalar, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StructuredDatasetRow {
+    return new StructuredDatasetRow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StructuredDatasetRow {
+    return new StructuredDatasetRow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StructuredDatasetRow {
+    return new StructuredDatasetRow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StructuredDatasetRow | PlainMessage<StructuredDatasetRow> | undefined, b: StructuredDatasetRow | PlainMessage<StructuredDatasetRow> | undefined): boolean {
+    return proto3.util.equals(StructuredDatasetRow, a, b);
+  }
+}
+
+/**
+ * @generated from message flyteidl.service.PreviewStructuredDatasetResponse
+ */
+export class PreviewStructuredDatasetResponse extends Message<PreviewStructuredDatasetResponse> {
+  /**
+   * The schema of the dataset, restricted to the returned columns.
+   *
+   * @generated from field: flyteidl.core.StructuredDatasetType schema = 1;
+   */
+  schema?: StructuredDatasetType;
+
+  /**
+   * The first rows of the dataset.
+   *
+   * @generated from field: repeated flyteidl.service.StructuredDatasetRow rows = 2;
+   */
+  rows: StructuredDatasetRow[] = [];
+
+  /**
+   * Whether the dataset holds more rows than returned, left out because of the requested limit or because reading
+   * them would exceed the memory limit of previews.
+   *
+   * @generated from field: bool truncated = 3;
+   */
+  truncated = false;
+
+  /**
+   * The top-level columns of the dataset left out of the preview, as nested and repeated columns can't be previewed.
+   *
+   * @generated from field: repeated string omitted_columns = 4;
+   */
+  omittedColumns: string[] = [];
+
+  constructor(data?: PartialMessage<PreviewStructuredDatasetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.service.PreviewStructuredDatasetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "message", T: StructuredDatasetType },
+    { no: 2, name: "rows", kind: "message", T: StructuredDatasetRow, repeated: true },
+    { no: 3, name: "truncated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "omitted_columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewStructuredDatasetResponse {
+    return new PreviewStructuredDatasetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewStructuredDatasetResponse {
+    return new PreviewStructuredDatasetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewStructuredDatasetResponse {
+    return new PreviewStructuredDatasetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewStructuredDatasetResponse | PlainMessage<PreviewStructuredDatasetResponse> | undefined, b: PreviewStructuredDatasetResponse | PlainMessage<PreviewStructuredDatasetResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewStructuredDatasetResponse, a, b);
+  }
+}
+
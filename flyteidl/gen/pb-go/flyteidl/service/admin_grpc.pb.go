@@ -28,12 +28,15 @@ const (
 	AdminService_GetWorkflow_FullMethodName                   = "/flyteidl.service.AdminService/GetWorkflow"
 	AdminService_ListWorkflowIds_FullMethodName               = "/flyteidl.service.AdminService/ListWorkflowIds"
 	AdminService_ListWorkflows_FullMethodName                 = "/flyteidl.service.AdminService/ListWorkflows"
+	AdminService_PromoteWorkflow_FullMethodName               = "/flyteidl.service.AdminService/PromoteWorkflow"
+	AdminService_GetPromotion_FullMethodName                  = "/flyteidl.service.AdminService/GetPromotion"
 	AdminService_CreateLaunchPlan_FullMethodName              = "/flyteidl.service.AdminService/CreateLaunchPlan"
 	AdminService_GetLaunchPlan_FullMethodName                 = "/flyteidl.service.AdminService/GetLaunchPlan"
 	AdminService_GetActiveLaunchPlan_FullMethodName           = "/flyteidl.service.AdminService/GetActiveLaunchPlan"
 	AdminService_ListActiveLaunchPlans_FullMethodName         = "/flyteidl.service.AdminService/ListActiveLaunchPlans"
 	AdminService_ListLaunchPlanIds_FullMethodName             = "/flyteidl.service.AdminService/ListLaunchPlanIds"
 	AdminService_ListLaunchPlans_FullMethodName               = "/flyteidl.service.AdminService/ListLaunchPlans"
+	AdminService_ListTriggerFirings_FullMethodName            = "/flyteidl.service.AdminService/ListTriggerFirings"
 	AdminService_UpdateLaunchPlan_FullMethodName              = "/flyteidl.service.AdminService/UpdateLaunchPlan"
 	AdminService_CreateExecution_FullMethodName               = "/flyteidl.service.AdminService/CreateExecution"
 	AdminService_RelaunchExecution_FullMethodName             = "/flyteidl.service.AdminService/RelaunchExecution"
@@ -43,6 +46,8 @@ const (
 	AdminService_GetExecutionData_FullMethodName              = "/flyteidl.service.AdminService/GetExecutionData"
 	AdminService_ListExecutions_FullMethodName                = "/flyteidl.service.AdminService/ListExecutions"
 	AdminService_TerminateExecution_FullMethodName            = "/flyteidl.service.AdminService/TerminateExecution"
+	AdminService_PauseExecution_FullMethodName                = "/flyteidl.service.AdminService/PauseExecution"
+	AdminService_ResumeExecution_FullMethodName               = "/flyteidl.service.AdminService/ResumeExecution"
 	AdminService_GetNodeExecution_FullMethodName              = "/flyteidl.service.AdminService/GetNodeExecution"
 	AdminService_GetDynamicNodeWorkflow_FullMethodName        = "/flyteidl.service.AdminService/GetDynamicNodeWorkflow"
 	AdminService_ListNodeExecutions_FullMethodName            = "/flyteidl.service.AdminService/ListNodeExecutions"
@@ -76,6 +81,7 @@ const (
 	AdminService_GetDescriptionEntity_FullMethodName          = "/flyteidl.service.AdminService/GetDescriptionEntity"
 	AdminService_ListDescriptionEntities_FullMethodName       = "/flyteidl.service.AdminService/ListDescriptionEntities"
 	AdminService_GetExecutionMetrics_FullMethodName           = "/flyteidl.service.AdminService/GetExecutionMetrics"
+	AdminService_WatchExecution_FullMethodName                = "/flyteidl.service.AdminService/WatchExecution"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -98,6 +104,10 @@ type AdminServiceClient interface {
 	ListWorkflowIds(ctx context.Context, in *admin.NamedEntityIdentifierListRequest, opts ...grpc.CallOption) (*admin.NamedEntityIdentifierList, error)
 	// Fetch a list of :ref:`ref_flyteidl.admin.Workflow` definitions.
 	ListWorkflows(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.WorkflowList, error)
+	// Promotes a :ref:`ref_flyteidl.admin.Workflow` version, with its tasks, subworkflows and launch plans, to another project and domain.
+	PromoteWorkflow(ctx context.Context, in *admin.WorkflowPromoteRequest, opts ...grpc.CallOption) (*admin.WorkflowPromoteResponse, error)
+	// Fetch the :ref:`ref_flyteidl.admin.Promotion` which registered an entity.
+	GetPromotion(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.Promotion, error)
 	// Create and upload a :ref:`ref_flyteidl.admin.LaunchPlan` definition
 	CreateLaunchPlan(ctx context.Context, in *admin.LaunchPlanCreateRequest, opts ...grpc.CallOption) (*admin.LaunchPlanCreateResponse, error)
 	// Fetch a :ref:`ref_flyteidl.admin.LaunchPlan` definition.
@@ -110,6 +120,8 @@ type AdminServiceClient interface {
 	ListLaunchPlanIds(ctx context.Context, in *admin.NamedEntityIdentifierListRequest, opts ...grpc.CallOption) (*admin.NamedEntityIdentifierList, error)
 	// Fetch a list of :ref:`ref_flyteidl.admin.LaunchPlan` definitions.
 	ListLaunchPlans(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.LaunchPlanList, error)
+	// Fetch a list of :ref:`ref_flyteidl.admin.TriggerFiring` of the triggers of a launch plan.
+	ListTriggerFirings(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.TriggerFiringList, error)
 	// Updates the status of a registered :ref:`ref_flyteidl.admin.LaunchPlan`.
 	UpdateLaunchPlan(ctx context.Context, in *admin.LaunchPlanUpdateRequest, opts ...grpc.CallOption) (*admin.LaunchPlanUpdateResponse, error)
 	// Triggers the creation of a :ref:`ref_flyteidl.admin.Execution`
@@ -132,6 +144,10 @@ type AdminServiceClient interface {
 	ListExecutions(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.ExecutionList, error)
 	// Terminates an in-progress :ref:`ref_flyteidl.admin.Execution`.
 	TerminateExecution(ctx context.Context, in *admin.ExecutionTerminateRequest, opts ...grpc.CallOption) (*admin.ExecutionTerminateResponse, error)
+	// Pauses an in-progress :ref:`ref_flyteidl.admin.Execution`, no new nodes of which are started until it is resumed.
+	PauseExecution(ctx context.Context, in *admin.ExecutionPauseRequest, opts ...grpc.CallOption) (*admin.ExecutionPauseResponse, error)
+	// Resumes a paused :ref:`ref_flyteidl.admin.Execution`.
+	ResumeExecution(ctx context.Context, in *admin.ExecutionResumeRequest, opts ...grpc.CallOption) (*admin.ExecutionResumeResponse, error)
 	// Fetches a :ref:`ref_flyteidl.admin.NodeExecution`.
 	GetNodeExecution(ctx context.Context, in *admin.NodeExecutionGetRequest, opts ...grpc.CallOption) (*admin.NodeExecution, error)
 	// Fetches a :ref:`ref_flyteidl.admin.DynamicNodeWorkflowResponse`.
@@ -198,6 +214,9 @@ type AdminServiceClient interface {
 	ListDescriptionEntities(ctx context.Context, in *admin.DescriptionEntityListRequest, opts ...grpc.CallOption) (*admin.DescriptionEntityList, error)
 	// Fetches runtime metrics for a :ref:`ref_flyteidl.admin.Execution`.
 	GetExecutionMetrics(ctx context.Context, in *admin.WorkflowExecutionGetMetricsRequest, opts ...grpc.CallOption) (*admin.WorkflowExecutionGetMetricsResponse, error)
+	// Streams the phase changes of a :ref:`ref_flyteidl.admin.Execution`, of its nodes and of their tasks as they are
+	// recorded, optionally limited to the subtree of a node.
+	WatchExecution(ctx context.Context, in *admin.WatchExecutionRequest, opts ...grpc.CallOption) (AdminService_WatchExecutionClient, error)
 }
 
 type adminServiceClient struct {
@@ -280,6 +299,24 @@ func (c *adminServiceClient) ListWorkflows(ctx context.Context, in *admin.Resour
 	return out, nil
 }
 
+func (c *adminServiceClient) PromoteWorkflow(ctx context.Context, in *admin.WorkflowPromoteRequest, opts ...grpc.CallOption) (*admin.WorkflowPromoteResponse, error) {
+	out := new(admin.WorkflowPromoteResponse)
+	err := c.cc.Invoke(ctx, AdminService_PromoteWorkflow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPromotion(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.Promotion, error) {
+	out := new(admin.Promotion)
+	err := c.cc.Invoke(ctx, AdminService_GetPromotion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateLaunchPlan(ctx context.Context, in *admin.LaunchPlanCreateRequest, opts ...grpc.CallOption) (*admin.LaunchPlanCreateResponse, error) {
 	out := new(admin.LaunchPlanCreateResponse)
 	err := c.cc.Invoke(ctx, AdminService_CreateLaunchPlan_FullMethodName, in, out, opts...)
@@ -334,6 +371,15 @@ func (c *adminServiceClient) ListLaunchPlans(ctx context.Context, in *admin.Reso
 	return out, nil
 }
 
+func (c *adminServiceClient) ListTriggerFirings(ctx context.Context, in *admin.ResourceListRequest, opts ...grpc.CallOption) (*admin.TriggerFiringList, error) {
+	out := new(admin.TriggerFiringList)
+	err := c.cc.Invoke(ctx, AdminService_ListTriggerFirings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) UpdateLaunchPlan(ctx context.Context, in *admin.LaunchPlanUpdateRequest, opts ...grpc.CallOption) (*admin.LaunchPlanUpdateResponse, error) {
 	out := new(admin.LaunchPlanUpdateResponse)
 	err := c.cc.Invoke(ctx, AdminService_UpdateLaunchPlan_FullMethodName, in, out, opts...)
@@ -415,6 +461,24 @@ func (c *adminServiceClient) TerminateExecution(ctx context.Context, in *admin.E
 	return out, nil
 }
 
+func (c *adminServiceClient) PauseExecution(ctx context.Context, in *admin.ExecutionPauseRequest, opts ...grpc.CallOption) (*admin.ExecutionPauseResponse, error) {
+	out := new(admin.ExecutionPauseResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeExecution(ctx context.Context, in *admin.ExecutionResumeRequest, opts ...grpc.CallOption) (*admin.ExecutionResumeResponse, error) {
+	out := new(admin.ExecutionResumeResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetNodeExecution(ctx context.Context, in *admin.NodeExecutionGetRequest, opts ...grpc.CallOption) (*admin.NodeExecution, error) {
 	out := new(admin.NodeExecution)
 	err := c.cc.Invoke(ctx, AdminService_GetNodeExecution_FullMethodName, in, out, opts...)
@@ -712,6 +776,38 @@ func (c *adminServiceClient) GetExecutionMetrics(ctx context.Context, in *admin.
 	return out, nil
 }
 
+func (c *adminServiceClient) WatchExecution(ctx context.Context, in *admin.WatchExecutionRequest, opts ...grpc.CallOption) (AdminService_WatchExecutionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_WatchExecution_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceWatchExecutionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_WatchExecutionClient interface {
+	Recv() (*admin.WatchExecutionResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceWatchExecutionClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceWatchExecutionClient) Recv() (*admin.WatchExecutionResponse, error) {
+	m := new(admin.WatchExecutionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations should embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -732,6 +828,10 @@ type AdminServiceServer interface {
 	ListWorkflowIds(context.Context, *admin.NamedEntityIdentifierListRequest) (*admin.NamedEntityIdentifierList, error)
 	// Fetch a list of :ref:`ref_flyteidl.admin.Workflow` definitions.
 	ListWorkflows(context.Context, *admin.ResourceListRequest) (*admin.WorkflowList, error)
+	// Promotes a :ref:`ref_flyteidl.admin.Workflow` version, with its tasks, subworkflows and launch plans, to another project and domain.
+	PromoteWorkflow(context.Context, *admin.WorkflowPromoteRequest) (*admin.WorkflowPromoteResponse, error)
+	// Fetch the :ref:`ref_flyteidl.admin.Promotion` which registered an entity.
+	GetPromotion(context.Context, *admin.ObjectGetRequest) (*admin.Promotion, error)
 	// Create and upload a :ref:`ref_flyteidl.admin.LaunchPlan` definition
 	CreateLaunchPlan(context.Context, *admin.LaunchPlanCreateRequest) (*admin.LaunchPlanCreateResponse, error)
 	// Fetch a :ref:`ref_flyteidl.admin.LaunchPlan` definition.
@@ -744,6 +844,8 @@ type AdminServiceServer interface {
 	ListLaunchPlanIds(context.Context, *admin.NamedEntityIdentifierListRequest) (*admin.NamedEntityIdentifierList, error)
 	// Fetch a list of :ref:`ref_flyteidl.admin.LaunchPlan` definitions.
 	ListLaunchPlans(context.Context, *admin.ResourceListRequest) (*admin.LaunchPlanList, error)
+	// Fetch a list of :ref:`ref_flyteidl.admin.TriggerFiring` of the triggers of a launch plan.
+	ListTriggerFirings(context.Context, *admin.ResourceListRequest) (*admin.TriggerFiringList, error)
 	// Updates the status of a registered :ref:`ref_flyteidl.admin.LaunchPlan`.
 	UpdateLaunchPlan(context.Context, *admin.LaunchPlanUpdateRequest) (*admin.LaunchPlanUpdateResponse, error)
 	// Triggers the creation of a :ref:`ref_flyteidl.admin.Execution`
@@ -766,6 +868,10 @@ type AdminServiceServer interface {
 	ListExecutions(context.Context, *admin.ResourceListRequest) (*admin.ExecutionList, error)
 	// Terminates an in-progress :ref:`ref_flyteidl.admin.Execution`.
 	TerminateExecution(context.Context, *admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error)
+	// Pauses an in-progress :ref:`ref_flyteidl.admin.Execution`, no new nodes of which are started until it is resumed.
+	PauseExecution(context.Context, *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error)
+	// Resumes a paused :ref:`ref_flyteidl.admin.Execution`.
+	ResumeExecution(context.Context, *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error)
 	// Fetches a :ref:`ref_flyteidl.admin.NodeExecution`.
 	GetNodeExecution(context.Context, *admin.NodeExecutionGetRequest) (*admin.NodeExecution, error)
 	// Fetches a :ref:`ref_flyteidl.admin.DynamicNodeWorkflowResponse`.
@@ -832,6 +938,9 @@ type AdminServiceServer interface {
 	ListDescriptionEntities(context.Context, *admin.DescriptionEntityListRequest) (*admin.DescriptionEntityList, error)
 	// Fetches runtime metrics for a :ref:`ref_flyteidl.admin.Execution`.
 	GetExecutionMetrics(context.Context, *admin.WorkflowExecutionGetMetricsRequest) (*admin.WorkflowExecutionGetMetricsResponse, error)
+	// Streams the phase changes of a :ref:`ref_flyteidl.admin.Execution`, of its nodes and of their tasks as they are
+	// recorded, optionally limited to the subtree of a node.
+	WatchExecution(*admin.WatchExecutionRequest, AdminService_WatchExecutionServer) error
 }
 
 // UnimplementedAdminServiceServer should be embedded to have forward compatible implementations.
@@ -862,6 +971,12 @@ func (UnimplementedAdminServiceServer) ListWorkflowIds(context.Context, *admin.N
 func (UnimplementedAdminServiceServer) ListWorkflows(context.Context, *admin.ResourceListRequest) (*admin.WorkflowList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflows not implemented")
 }
+func (UnimplementedAdminServiceServer) PromoteWorkflow(context.Context, *admin.WorkflowPromoteRequest) (*admin.WorkflowPromoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteWorkflow not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPromotion(context.Context, *admin.ObjectGetRequest) (*admin.Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromotion not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateLaunchPlan(context.Context, *admin.LaunchPlanCreateRequest) (*admin.LaunchPlanCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLaunchPlan not implemented")
 }
@@ -880,6 +995,9 @@ func (UnimplementedAdminServiceServer) ListLaunchPlanIds(context.Context, *admin
 func (UnimplementedAdminServiceServer) ListLaunchPlans(context.Context, *admin.ResourceListRequest) (*admin.LaunchPlanList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLaunchPlans not implemented")
 }
+func (UnimplementedAdminServiceServer) ListTriggerFirings(context.Context, *admin.ResourceListRequest) (*admin.TriggerFiringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTriggerFirings not implemented")
+}
 func (UnimplementedAdminServiceServer) UpdateLaunchPlan(context.Context, *admin.LaunchPlanUpdateRequest) (*admin.LaunchPlanUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLaunchPlan not implemented")
 }
@@ -907,6 +1025,12 @@ func (UnimplementedAdminServiceServer) ListExecutions(context.Context, *admin.Re
 func (UnimplementedAdminServiceServer) TerminateExecution(context.Context, *admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateExecution not implemented")
 }
+func (UnimplementedAdminServiceServer) PauseExecution(context.Context, *admin.ExecutionPauseRequest) (*admin.ExecutionPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeExecution(context.Context, *admin.ExecutionResumeRequest) (*admin.ExecutionResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecution not implemented")
+}
 func (UnimplementedAdminServiceServer) GetNodeExecution(context.Context, *admin.NodeExecutionGetRequest) (*admin.NodeExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeExecution not implemented")
 }
@@ -1006,6 +1130,9 @@ func (UnimplementedAdminServiceServer) ListDescriptionEntities(context.Context,
 func (UnimplementedAdminServiceServer) GetExecutionMetrics(context.Context, *admin.WorkflowExecutionGetMetricsRequest) (*admin.WorkflowExecutionGetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionMetrics not implemented")
 }
+func (UnimplementedAdminServiceServer) WatchExecution(*admin.WatchExecutionRequest, AdminService_WatchExecutionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchExecution not implemented")
+}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AdminServiceServer will
@@ -1162,6 +1289,42 @@ func _AdminService_ListWorkflows_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PromoteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.WorkflowPromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PromoteWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PromoteWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PromoteWorkflow(ctx, req.(*admin.WorkflowPromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ObjectGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPromotion(ctx, req.(*admin.ObjectGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateLaunchPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(admin.LaunchPlanCreateRequest)
 	if err := dec(in); err != nil {
@@ -1270,6 +1433,24 @@ func _AdminService_ListLaunchPlans_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListTriggerFirings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ResourceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTriggerFirings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTriggerFirings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTriggerFirings(ctx, req.(*admin.ResourceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_UpdateLaunchPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(admin.LaunchPlanUpdateRequest)
 	if err := dec(in); err != nil {
@@ -1432,6 +1613,42 @@ func _AdminService_TerminateExecution_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PauseExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ExecutionPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseExecution(ctx, req.(*admin.ExecutionPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ExecutionResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeExecution(ctx, req.(*admin.ExecutionResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetNodeExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(admin.NodeExecutionGetRequest)
 	if err := dec(in); err != nil {
@@ -2026,6 +2243,27 @@ func _AdminService_GetExecutionMetrics_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_WatchExecution_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(admin.WatchExecutionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).WatchExecution(m, &adminServiceWatchExecutionServer{stream})
+}
+
+type AdminService_WatchExecutionServer interface {
+	Send(*admin.WatchExecutionResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceWatchExecutionServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceWatchExecutionServer) Send(m *admin.WatchExecutionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2065,6 +2303,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflows",
 			Handler:    _AdminService_ListWorkflows_Handler,
 		},
+		{
+			MethodName: "PromoteWorkflow",
+			Handler:    _AdminService_PromoteWorkflow_Handler,
+		},
+		{
+			MethodName: "GetPromotion",
+			Handler:    _AdminService_GetPromotion_Handler,
+		},
 		{
 			MethodName: "CreateLaunchPlan",
 			Handler:    _AdminService_CreateLaunchPlan_Handler,
@@ -2089,6 +2335,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLaunchPlans",
 			Handler:    _AdminService_ListLaunchPlans_Handler,
 		},
+		{
+			MethodName: "ListTriggerFirings",
+			Handler:    _AdminService_ListTriggerFirings_Handler,
+		},
 		{
 			MethodName: "UpdateLaunchPlan",
 			Handler:    _AdminService_UpdateLaunchPlan_Handler,
@@ -2125,6 +2375,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateExecution",
 			Handler:    _AdminService_TerminateExecution_Handler,
 		},
+		{
+			MethodName: "PauseExecution",
+			Handler:    _AdminService_PauseExecution_Handler,
+		},
+		{
+			MethodName: "ResumeExecution",
+			Handler:    _AdminService_ResumeExecution_Handler,
+		},
 		{
 			MethodName: "GetNodeExecution",
 			Handler:    _AdminService_GetNodeExecution_Handler,
@@ -2258,6 +2516,12 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AdminService_GetExecutionMetrics_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchExecution",
+			Handler:       _AdminService_WatchExecution_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "flyteidl/service/admin.proto",
 }
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DataProxyService_CreateUploadLocation_FullMethodName   = "/flyteidl.service.DataProxyService/CreateUploadLocation"
-	DataProxyService_CreateDownloadLocation_FullMethodName = "/flyteidl.service.DataProxyService/CreateDownloadLocation"
-	DataProxyService_CreateDownloadLink_FullMethodName     = "/flyteidl.service.DataProxyService/CreateDownloadLink"
-	DataProxyService_GetData_FullMethodName                = "/flyteidl.service.DataProxyService/GetData"
+	DataProxyService_CreateUploadLocation_FullMethodName     = "/flyteidl.service.DataProxyService/CreateUploadLocation"
+	DataProxyService_CreateDownloadLocation_FullMethodName   = "/flyteidl.service.DataProxyService/CreateDownloadLocation"
+	DataProxyService_CreateDownloadLink_FullMethodName       = "/flyteidl.service.DataProxyService/CreateDownloadLink"
+	DataProxyService_GetData_FullMethodName                  = "/flyteidl.service.DataProxyService/GetData"
+	DataProxyService_PreviewStructuredDataset_FullMethodName = "/flyteidl.service.DataProxyService/PreviewStructuredDataset"
 )
 
 // DataProxyServiceClient is the client API for DataProxyService service.
@@ -37,6 +38,8 @@ type DataProxyServiceClient interface {
 	// CreateDownloadLocation creates a signed url to download artifacts.
 	CreateDownloadLink(ctx context.Context, in *CreateDownloadLinkRequest, opts ...grpc.CallOption) (*CreateDownloadLinkResponse, error)
 	GetData(ctx context.Context, in *GetDataRequest, opts ...grpc.CallOption) (*GetDataResponse, error)
+	// PreviewStructuredDataset reads the first rows and the column schema of a structured dataset.
+	PreviewStructuredDataset(ctx context.Context, in *PreviewStructuredDatasetRequest, opts ...grpc.CallOption) (*PreviewStructuredDatasetResponse, error)
 }
 
 type dataProxyServiceClient struct {
@@ -84,6 +87,15 @@ func (c *dataProxyServiceClient) GetData(ctx context.Context, in *GetDataRequest
 	return out, nil
 }
 
+func (c *dataProxyServiceClient) PreviewStructuredDataset(ctx context.Context, in *PreviewStructuredDatasetRequest, opts ...grpc.CallOption) (*PreviewStructuredDatasetResponse, error) {
+	out := new(PreviewStructuredDatasetResponse)
+	err := c.cc.Invoke(ctx, DataProxyService_PreviewStructuredDataset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataProxyServiceServer is the server API for DataProxyService service.
 // All implementations should embed UnimplementedDataProxyServiceServer
 // for forward compatibility
@@ -96,6 +108,8 @@ type DataProxyServiceServer interface {
 	// CreateDownloadLocation creates a signed url to download artifacts.
 	CreateDownloadLink(context.Context, *CreateDownloadLinkRequest) (*CreateDownloadLinkResponse, error)
 	GetData(context.Context, *GetDataRequest) (*GetDataResponse, error)
+	// PreviewStructuredDataset reads the first rows and the column schema of a structured dataset.
+	PreviewStructuredDataset(context.Context, *PreviewStructuredDatasetRequest) (*PreviewStructuredDatasetResponse, error)
 }
 
 // UnimplementedDataProxyServiceServer should be embedded to have forward compatible implementations.
@@ -114,6 +128,9 @@ func (UnimplementedDataProxyServiceServer) CreateDownloadLink(context.Context, *
 func (UnimplementedDataProxyServiceServer) GetData(context.Context, *GetDataRequest) (*GetDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetData not implemented")
 }
+func (UnimplementedDataProxyServiceServer) PreviewStructuredDataset(context.Context, *PreviewStructuredDatasetRequest) (*PreviewStructuredDatasetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewStructuredDataset not implemented")
+}
 
 // UnsafeDataProxyServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DataProxyServiceServer will
@@ -198,6 +215,24 @@ func _DataProxyService_GetData_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataProxyService_PreviewStructuredDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewStructuredDatasetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataProxyServiceServer).PreviewStructuredDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataProxyService_PreviewStructuredDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataProxyServiceServer).PreviewStructuredDataset(ctx, req.(*PreviewStructuredDatasetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataProxyService_ServiceDesc is the grpc.ServiceDesc for DataProxyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +256,10 @@ var DataProxyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetData",
 			Handler:    _DataProxyService_GetData_Handler,
 		},
+		{
+			MethodName: "PreviewStructuredDataset",
+			Handler:    _DataProxyService_PreviewStructuredDataset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "flyteidl/service/dataproxy.proto",
@@ -5653,7 +5653,8 @@ export namespace flyteidl {
                 FAILED = 6,
                 ABORTED = 7,
                 TIMED_OUT = 8,
-                ABORTING = 9
+                ABORTING = 9,
+                PAUSED = 10
             }
         }
 
@@ -14044,6 +14045,12 @@ export namespace flyteidl {
 
             /** ExecutionRecoverRequest metadata */
             metadata?: (flyteidl.admin.IExecutionMetadata|null);
+
+            /** ExecutionRecoverRequest rerunFrom */
+            rerunFrom?: (flyteidl.admin.IRerunFromNode|null);
+
+            /** ExecutionRecoverRequest launchPlan */
+            launchPlan?: (flyteidl.core.IIdentifier|null);
         }
 
         /** Represents an ExecutionRecoverRequest. */
@@ -14064,6 +14071,12 @@ export namespace flyteidl {
             /** ExecutionRecoverRequest metadata. */
             public metadata?: (flyteidl.admin.IExecutionMetadata|null);
 
+            /** ExecutionRecoverRequest rerunFrom. */
+            public rerunFrom?: (flyteidl.admin.IRerunFromNode|null);
+
+            /** ExecutionRecoverRequest launchPlan. */
+            public launchPlan?: (flyteidl.core.IIdentifier|null);
+
             /**
              * Creates a new ExecutionRecoverRequest instance using the specified properties.
              * @param [properties] Properties to set
@@ -14097,6 +14110,64 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a RerunFromNode. */
+        interface IRerunFromNode {
+
+            /** RerunFromNode nodeId */
+            nodeId?: (string|null);
+
+            /** RerunFromNode inputs */
+            inputs?: (flyteidl.core.ILiteralMap|null);
+        }
+
+        /** Represents a RerunFromNode. */
+        class RerunFromNode implements IRerunFromNode {
+
+            /**
+             * Constructs a new RerunFromNode.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IRerunFromNode);
+
+            /** RerunFromNode nodeId. */
+            public nodeId: string;
+
+            /** RerunFromNode inputs. */
+            public inputs?: (flyteidl.core.ILiteralMap|null);
+
+            /**
+             * Creates a new RerunFromNode instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns RerunFromNode instance
+             */
+            public static create(properties?: flyteidl.admin.IRerunFromNode): flyteidl.admin.RerunFromNode;
+
+            /**
+             * Encodes the specified RerunFromNode message. Does not implicitly {@link flyteidl.admin.RerunFromNode.verify|verify} messages.
+             * @param message RerunFromNode message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IRerunFromNode, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a RerunFromNode message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns RerunFromNode
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.RerunFromNode;
+
+            /**
+             * Verifies a RerunFromNode message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of an ExecutionCreateResponse. */
         interface IExecutionCreateResponse {
 
@@ -14659,6 +14730,9 @@ export namespace flyteidl {
 
             /** ExecutionMetadata artifactIds */
             artifactIds?: (flyteidl.core.IArtifactID[]|null);
+
+            /** ExecutionMetadata rerunFrom */
+            rerunFrom?: (flyteidl.admin.IRerunFromNode|null);
         }
 
         /** Represents an ExecutionMetadata. */
@@ -14694,6 +14768,9 @@ export namespace flyteidl {
             /** ExecutionMetadata artifactIds. */
             public artifactIds: flyteidl.core.IArtifactID[];
 
+            /** ExecutionMetadata rerunFrom. */
+            public rerunFrom?: (flyteidl.admin.IRerunFromNode|null);
+
             /**
              * Creates a new ExecutionMetadata instance using the specified properties.
              * @param [properties] Properties to set
@@ -15060,6 +15137,208 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of an ExecutionPauseRequest. */
+        interface IExecutionPauseRequest {
+
+            /** ExecutionPauseRequest id */
+            id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** ExecutionPauseRequest abortRunningNodes */
+            abortRunningNodes?: (boolean|null);
+        }
+
+        /** Represents an ExecutionPauseRequest. */
+        class ExecutionPauseRequest implements IExecutionPauseRequest {
+
+            /**
+             * Constructs a new ExecutionPauseRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IExecutionPauseRequest);
+
+            /** ExecutionPauseRequest id. */
+            public id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** ExecutionPauseRequest abortRunningNodes. */
+            public abortRunningNodes: boolean;
+
+            /**
+             * Creates a new ExecutionPauseRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ExecutionPauseRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IExecutionPauseRequest): flyteidl.admin.ExecutionPauseRequest;
+
+            /**
+             * Encodes the specified ExecutionPauseRequest message. Does not implicitly {@link flyteidl.admin.ExecutionPauseRequest.verify|verify} messages.
+             * @param message ExecutionPauseRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IExecutionPauseRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ExecutionPauseRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ExecutionPauseRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionPauseRequest;
+
+            /**
+             * Verifies an ExecutionPauseRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ExecutionPauseResponse. */
+        interface IExecutionPauseResponse {
+        }
+
+        /** Represents an ExecutionPauseResponse. */
+        class ExecutionPauseResponse implements IExecutionPauseResponse {
+
+            /**
+             * Constructs a new ExecutionPauseResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IExecutionPauseResponse);
+
+            /**
+             * Creates a new ExecutionPauseResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ExecutionPauseResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IExecutionPauseResponse): flyteidl.admin.ExecutionPauseResponse;
+
+            /**
+             * Encodes the specified ExecutionPauseResponse message. Does not implicitly {@link flyteidl.admin.ExecutionPauseResponse.verify|verify} messages.
+             * @param message ExecutionPauseResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IExecutionPauseResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ExecutionPauseResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ExecutionPauseResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionPauseResponse;
+
+            /**
+             * Verifies an ExecutionPauseResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ExecutionResumeRequest. */
+        interface IExecutionResumeRequest {
+
+            /** ExecutionResumeRequest id */
+            id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+        }
+
+        /** Represents an ExecutionResumeRequest. */
+        class ExecutionResumeRequest implements IExecutionResumeRequest {
+
+            /**
+             * Constructs a new ExecutionResumeRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IExecutionResumeRequest);
+
+            /** ExecutionResumeRequest id. */
+            public id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /**
+             * Creates a new ExecutionResumeRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ExecutionResumeRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IExecutionResumeRequest): flyteidl.admin.ExecutionResumeRequest;
+
+            /**
+             * Encodes the specified ExecutionResumeRequest message. Does not implicitly {@link flyteidl.admin.ExecutionResumeRequest.verify|verify} messages.
+             * @param message ExecutionResumeRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IExecutionResumeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ExecutionResumeRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ExecutionResumeRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionResumeRequest;
+
+            /**
+             * Verifies an ExecutionResumeRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ExecutionResumeResponse. */
+        interface IExecutionResumeResponse {
+        }
+
+        /** Represents an ExecutionResumeResponse. */
+        class ExecutionResumeResponse implements IExecutionResumeResponse {
+
+            /**
+             * Constructs a new ExecutionResumeResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IExecutionResumeResponse);
+
+            /**
+             * Creates a new ExecutionResumeResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ExecutionResumeResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IExecutionResumeResponse): flyteidl.admin.ExecutionResumeResponse;
+
+            /**
+             * Encodes the specified ExecutionResumeResponse message. Does not implicitly {@link flyteidl.admin.ExecutionResumeResponse.verify|verify} messages.
+             * @param message ExecutionResumeResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IExecutionResumeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ExecutionResumeResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ExecutionResumeResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionResumeResponse;
+
+            /**
+             * Verifies an ExecutionResumeResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a WorkflowExecutionGetDataRequest. */
         interface IWorkflowExecutionGetDataRequest {
 
@@ -15466,894 +15745,1223 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** MatchableResource enum. */
-        enum MatchableResource {
-            TASK_RESOURCE = 0,
-            CLUSTER_RESOURCE = 1,
-            EXECUTION_QUEUE = 2,
-            EXECUTION_CLUSTER_LABEL = 3,
-            QUALITY_OF_SERVICE_SPECIFICATION = 4,
-            PLUGIN_OVERRIDE = 5,
-            WORKFLOW_EXECUTION_CONFIG = 6,
-            CLUSTER_ASSIGNMENT = 7
-        }
-
-        /** Properties of a TaskResourceSpec. */
-        interface ITaskResourceSpec {
-
-            /** TaskResourceSpec cpu */
-            cpu?: (string|null);
-
-            /** TaskResourceSpec gpu */
-            gpu?: (string|null);
+        /** Properties of a WatchExecutionRequest. */
+        interface IWatchExecutionRequest {
 
-            /** TaskResourceSpec memory */
-            memory?: (string|null);
+            /** WatchExecutionRequest id */
+            id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
 
-            /** TaskResourceSpec storage */
-            storage?: (string|null);
+            /** WatchExecutionRequest nodeId */
+            nodeId?: (string|null);
 
-            /** TaskResourceSpec ephemeralStorage */
-            ephemeralStorage?: (string|null);
+            /** WatchExecutionRequest cursor */
+            cursor?: (string|null);
         }
 
-        /** Represents a TaskResourceSpec. */
-        class TaskResourceSpec implements ITaskResourceSpec {
+        /** Represents a WatchExecutionRequest. */
+        class WatchExecutionRequest implements IWatchExecutionRequest {
 
             /**
-             * Constructs a new TaskResourceSpec.
+             * Constructs a new WatchExecutionRequest.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ITaskResourceSpec);
+            constructor(properties?: flyteidl.admin.IWatchExecutionRequest);
 
-            /** TaskResourceSpec cpu. */
-            public cpu: string;
-
-            /** TaskResourceSpec gpu. */
-            public gpu: string;
-
-            /** TaskResourceSpec memory. */
-            public memory: string;
+            /** WatchExecutionRequest id. */
+            public id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
 
-            /** TaskResourceSpec storage. */
-            public storage: string;
+            /** WatchExecutionRequest nodeId. */
+            public nodeId: string;
 
-            /** TaskResourceSpec ephemeralStorage. */
-            public ephemeralStorage: string;
+            /** WatchExecutionRequest cursor. */
+            public cursor: string;
 
             /**
-             * Creates a new TaskResourceSpec instance using the specified properties.
+             * Creates a new WatchExecutionRequest instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns TaskResourceSpec instance
+             * @returns WatchExecutionRequest instance
              */
-            public static create(properties?: flyteidl.admin.ITaskResourceSpec): flyteidl.admin.TaskResourceSpec;
+            public static create(properties?: flyteidl.admin.IWatchExecutionRequest): flyteidl.admin.WatchExecutionRequest;
 
             /**
-             * Encodes the specified TaskResourceSpec message. Does not implicitly {@link flyteidl.admin.TaskResourceSpec.verify|verify} messages.
-             * @param message TaskResourceSpec message or plain object to encode
+             * Encodes the specified WatchExecutionRequest message. Does not implicitly {@link flyteidl.admin.WatchExecutionRequest.verify|verify} messages.
+             * @param message WatchExecutionRequest message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ITaskResourceSpec, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWatchExecutionRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a TaskResourceSpec message from the specified reader or buffer.
+             * Decodes a WatchExecutionRequest message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns TaskResourceSpec
+             * @returns WatchExecutionRequest
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TaskResourceSpec;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WatchExecutionRequest;
 
             /**
-             * Verifies a TaskResourceSpec message.
+             * Verifies a WatchExecutionRequest message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a TaskResourceAttributes. */
-        interface ITaskResourceAttributes {
+        /** Properties of a WatchExecutionResponse. */
+        interface IWatchExecutionResponse {
 
-            /** TaskResourceAttributes defaults */
-            defaults?: (flyteidl.admin.ITaskResourceSpec|null);
+            /** WatchExecutionResponse cursor */
+            cursor?: (string|null);
 
-            /** TaskResourceAttributes limits */
-            limits?: (flyteidl.admin.ITaskResourceSpec|null);
+            /** WatchExecutionResponse occurredAt */
+            occurredAt?: (google.protobuf.ITimestamp|null);
+
+            /** WatchExecutionResponse workflowExecution */
+            workflowExecution?: (flyteidl.admin.IWorkflowExecutionPhaseChange|null);
+
+            /** WatchExecutionResponse nodeExecution */
+            nodeExecution?: (flyteidl.admin.INodeExecutionPhaseChange|null);
+
+            /** WatchExecutionResponse taskExecution */
+            taskExecution?: (flyteidl.admin.ITaskExecutionPhaseChange|null);
         }
 
-        /** Represents a TaskResourceAttributes. */
-        class TaskResourceAttributes implements ITaskResourceAttributes {
+        /** Represents a WatchExecutionResponse. */
+        class WatchExecutionResponse implements IWatchExecutionResponse {
 
             /**
-             * Constructs a new TaskResourceAttributes.
+             * Constructs a new WatchExecutionResponse.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ITaskResourceAttributes);
+            constructor(properties?: flyteidl.admin.IWatchExecutionResponse);
 
-            /** TaskResourceAttributes defaults. */
-            public defaults?: (flyteidl.admin.ITaskResourceSpec|null);
+            /** WatchExecutionResponse cursor. */
+            public cursor: string;
 
-            /** TaskResourceAttributes limits. */
-            public limits?: (flyteidl.admin.ITaskResourceSpec|null);
+            /** WatchExecutionResponse occurredAt. */
+            public occurredAt?: (google.protobuf.ITimestamp|null);
+
+            /** WatchExecutionResponse workflowExecution. */
+            public workflowExecution?: (flyteidl.admin.IWorkflowExecutionPhaseChange|null);
+
+            /** WatchExecutionResponse nodeExecution. */
+            public nodeExecution?: (flyteidl.admin.INodeExecutionPhaseChange|null);
+
+            /** WatchExecutionResponse taskExecution. */
+            public taskExecution?: (flyteidl.admin.ITaskExecutionPhaseChange|null);
+
+            /** WatchExecutionResponse change. */
+            public change?: ("workflowExecution"|"nodeExecution"|"taskExecution");
 
             /**
-             * Creates a new TaskResourceAttributes instance using the specified properties.
+             * Creates a new WatchExecutionResponse instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns TaskResourceAttributes instance
+             * @returns WatchExecutionResponse instance
              */
-            public static create(properties?: flyteidl.admin.ITaskResourceAttributes): flyteidl.admin.TaskResourceAttributes;
+            public static create(properties?: flyteidl.admin.IWatchExecutionResponse): flyteidl.admin.WatchExecutionResponse;
 
             /**
-             * Encodes the specified TaskResourceAttributes message. Does not implicitly {@link flyteidl.admin.TaskResourceAttributes.verify|verify} messages.
-             * @param message TaskResourceAttributes message or plain object to encode
+             * Encodes the specified WatchExecutionResponse message. Does not implicitly {@link flyteidl.admin.WatchExecutionResponse.verify|verify} messages.
+             * @param message WatchExecutionResponse message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ITaskResourceAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWatchExecutionResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a TaskResourceAttributes message from the specified reader or buffer.
+             * Decodes a WatchExecutionResponse message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns TaskResourceAttributes
+             * @returns WatchExecutionResponse
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TaskResourceAttributes;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WatchExecutionResponse;
 
             /**
-             * Verifies a TaskResourceAttributes message.
+             * Verifies a WatchExecutionResponse message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a ClusterResourceAttributes. */
-        interface IClusterResourceAttributes {
+        /** Properties of a WorkflowExecutionPhaseChange. */
+        interface IWorkflowExecutionPhaseChange {
 
-            /** ClusterResourceAttributes attributes */
-            attributes?: ({ [k: string]: string }|null);
+            /** WorkflowExecutionPhaseChange id */
+            id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** WorkflowExecutionPhaseChange phase */
+            phase?: (flyteidl.core.WorkflowExecution.Phase|null);
         }
 
-        /** Represents a ClusterResourceAttributes. */
-        class ClusterResourceAttributes implements IClusterResourceAttributes {
+        /** Represents a WorkflowExecutionPhaseChange. */
+        class WorkflowExecutionPhaseChange implements IWorkflowExecutionPhaseChange {
 
             /**
-             * Constructs a new ClusterResourceAttributes.
+             * Constructs a new WorkflowExecutionPhaseChange.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IClusterResourceAttributes);
+            constructor(properties?: flyteidl.admin.IWorkflowExecutionPhaseChange);
 
-            /** ClusterResourceAttributes attributes. */
-            public attributes: { [k: string]: string };
+            /** WorkflowExecutionPhaseChange id. */
+            public id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** WorkflowExecutionPhaseChange phase. */
+            public phase: flyteidl.core.WorkflowExecution.Phase;
 
             /**
-             * Creates a new ClusterResourceAttributes instance using the specified properties.
+             * Creates a new WorkflowExecutionPhaseChange instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ClusterResourceAttributes instance
+             * @returns WorkflowExecutionPhaseChange instance
              */
-            public static create(properties?: flyteidl.admin.IClusterResourceAttributes): flyteidl.admin.ClusterResourceAttributes;
+            public static create(properties?: flyteidl.admin.IWorkflowExecutionPhaseChange): flyteidl.admin.WorkflowExecutionPhaseChange;
 
             /**
-             * Encodes the specified ClusterResourceAttributes message. Does not implicitly {@link flyteidl.admin.ClusterResourceAttributes.verify|verify} messages.
-             * @param message ClusterResourceAttributes message or plain object to encode
+             * Encodes the specified WorkflowExecutionPhaseChange message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionPhaseChange.verify|verify} messages.
+             * @param message WorkflowExecutionPhaseChange message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IClusterResourceAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWorkflowExecutionPhaseChange, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a ClusterResourceAttributes message from the specified reader or buffer.
+             * Decodes a WorkflowExecutionPhaseChange message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ClusterResourceAttributes
+             * @returns WorkflowExecutionPhaseChange
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ClusterResourceAttributes;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowExecutionPhaseChange;
 
             /**
-             * Verifies a ClusterResourceAttributes message.
+             * Verifies a WorkflowExecutionPhaseChange message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of an ExecutionQueueAttributes. */
-        interface IExecutionQueueAttributes {
+        /** Properties of a NodeExecutionPhaseChange. */
+        interface INodeExecutionPhaseChange {
 
-            /** ExecutionQueueAttributes tags */
-            tags?: (string[]|null);
+            /** NodeExecutionPhaseChange id */
+            id?: (flyteidl.core.INodeExecutionIdentifier|null);
+
+            /** NodeExecutionPhaseChange phase */
+            phase?: (flyteidl.core.NodeExecution.Phase|null);
+
+            /** NodeExecutionPhaseChange parentNodeId */
+            parentNodeId?: (string|null);
         }
 
-        /** Represents an ExecutionQueueAttributes. */
-        class ExecutionQueueAttributes implements IExecutionQueueAttributes {
+        /** Represents a NodeExecutionPhaseChange. */
+        class NodeExecutionPhaseChange implements INodeExecutionPhaseChange {
 
             /**
-             * Constructs a new ExecutionQueueAttributes.
+             * Constructs a new NodeExecutionPhaseChange.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IExecutionQueueAttributes);
+            constructor(properties?: flyteidl.admin.INodeExecutionPhaseChange);
 
-            /** ExecutionQueueAttributes tags. */
-            public tags: string[];
+            /** NodeExecutionPhaseChange id. */
+            public id?: (flyteidl.core.INodeExecutionIdentifier|null);
+
+            /** NodeExecutionPhaseChange phase. */
+            public phase: flyteidl.core.NodeExecution.Phase;
+
+            /** NodeExecutionPhaseChange parentNodeId. */
+            public parentNodeId: string;
 
             /**
-             * Creates a new ExecutionQueueAttributes instance using the specified properties.
+             * Creates a new NodeExecutionPhaseChange instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ExecutionQueueAttributes instance
+             * @returns NodeExecutionPhaseChange instance
              */
-            public static create(properties?: flyteidl.admin.IExecutionQueueAttributes): flyteidl.admin.ExecutionQueueAttributes;
+            public static create(properties?: flyteidl.admin.INodeExecutionPhaseChange): flyteidl.admin.NodeExecutionPhaseChange;
 
             /**
-             * Encodes the specified ExecutionQueueAttributes message. Does not implicitly {@link flyteidl.admin.ExecutionQueueAttributes.verify|verify} messages.
-             * @param message ExecutionQueueAttributes message or plain object to encode
+             * Encodes the specified NodeExecutionPhaseChange message. Does not implicitly {@link flyteidl.admin.NodeExecutionPhaseChange.verify|verify} messages.
+             * @param message NodeExecutionPhaseChange message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IExecutionQueueAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.INodeExecutionPhaseChange, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes an ExecutionQueueAttributes message from the specified reader or buffer.
+             * Decodes a NodeExecutionPhaseChange message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ExecutionQueueAttributes
+             * @returns NodeExecutionPhaseChange
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionQueueAttributes;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.NodeExecutionPhaseChange;
 
             /**
-             * Verifies an ExecutionQueueAttributes message.
+             * Verifies a NodeExecutionPhaseChange message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of an ExecutionClusterLabel. */
-        interface IExecutionClusterLabel {
+        /** Properties of a TaskExecutionPhaseChange. */
+        interface ITaskExecutionPhaseChange {
 
-            /** ExecutionClusterLabel value */
-            value?: (string|null);
+            /** TaskExecutionPhaseChange id */
+            id?: (flyteidl.core.ITaskExecutionIdentifier|null);
+
+            /** TaskExecutionPhaseChange phase */
+            phase?: (flyteidl.core.TaskExecution.Phase|null);
+
+            /** TaskExecutionPhaseChange phaseVersion */
+            phaseVersion?: (number|null);
         }
 
-        /** Represents an ExecutionClusterLabel. */
-        class ExecutionClusterLabel implements IExecutionClusterLabel {
+        /** Represents a TaskExecutionPhaseChange. */
+        class TaskExecutionPhaseChange implements ITaskExecutionPhaseChange {
 
             /**
-             * Constructs a new ExecutionClusterLabel.
+             * Constructs a new TaskExecutionPhaseChange.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IExecutionClusterLabel);
+            constructor(properties?: flyteidl.admin.ITaskExecutionPhaseChange);
 
-            /** ExecutionClusterLabel value. */
-            public value: string;
+            /** TaskExecutionPhaseChange id. */
+            public id?: (flyteidl.core.ITaskExecutionIdentifier|null);
+
+            /** TaskExecutionPhaseChange phase. */
+            public phase: flyteidl.core.TaskExecution.Phase;
+
+            /** TaskExecutionPhaseChange phaseVersion. */
+            public phaseVersion: number;
 
             /**
-             * Creates a new ExecutionClusterLabel instance using the specified properties.
+             * Creates a new TaskExecutionPhaseChange instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ExecutionClusterLabel instance
+             * @returns TaskExecutionPhaseChange instance
              */
-            public static create(properties?: flyteidl.admin.IExecutionClusterLabel): flyteidl.admin.ExecutionClusterLabel;
+            public static create(properties?: flyteidl.admin.ITaskExecutionPhaseChange): flyteidl.admin.TaskExecutionPhaseChange;
 
             /**
-             * Encodes the specified ExecutionClusterLabel message. Does not implicitly {@link flyteidl.admin.ExecutionClusterLabel.verify|verify} messages.
-             * @param message ExecutionClusterLabel message or plain object to encode
+             * Encodes the specified TaskExecutionPhaseChange message. Does not implicitly {@link flyteidl.admin.TaskExecutionPhaseChange.verify|verify} messages.
+             * @param message TaskExecutionPhaseChange message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IExecutionClusterLabel, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ITaskExecutionPhaseChange, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes an ExecutionClusterLabel message from the specified reader or buffer.
+             * Decodes a TaskExecutionPhaseChange message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ExecutionClusterLabel
+             * @returns TaskExecutionPhaseChange
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionClusterLabel;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TaskExecutionPhaseChange;
 
             /**
-             * Verifies an ExecutionClusterLabel message.
+             * Verifies a TaskExecutionPhaseChange message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a PluginOverride. */
-        interface IPluginOverride {
+        /** MatchableResource enum. */
+        enum MatchableResource {
+            TASK_RESOURCE = 0,
+            CLUSTER_RESOURCE = 1,
+            EXECUTION_QUEUE = 2,
+            EXECUTION_CLUSTER_LABEL = 3,
+            QUALITY_OF_SERVICE_SPECIFICATION = 4,
+            PLUGIN_OVERRIDE = 5,
+            WORKFLOW_EXECUTION_CONFIG = 6,
+            CLUSTER_ASSIGNMENT = 7
+        }
 
-            /** PluginOverride taskType */
-            taskType?: (string|null);
+        /** Properties of a TaskResourceSpec. */
+        interface ITaskResourceSpec {
 
-            /** PluginOverride pluginId */
-            pluginId?: (string[]|null);
+            /** TaskResourceSpec cpu */
+            cpu?: (string|null);
 
-            /** PluginOverride missingPluginBehavior */
-            missingPluginBehavior?: (flyteidl.admin.PluginOverride.MissingPluginBehavior|null);
+            /** TaskResourceSpec gpu */
+            gpu?: (string|null);
+
+            /** TaskResourceSpec memory */
+            memory?: (string|null);
+
+            /** TaskResourceSpec storage */
+            storage?: (string|null);
+
+            /** TaskResourceSpec ephemeralStorage */
+            ephemeralStorage?: (string|null);
         }
 
-        /** Represents a PluginOverride. */
-        class PluginOverride implements IPluginOverride {
+        /** Represents a TaskResourceSpec. */
+        class TaskResourceSpec implements ITaskResourceSpec {
 
             /**
-             * Constructs a new PluginOverride.
+             * Constructs a new TaskResourceSpec.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IPluginOverride);
+            constructor(properties?: flyteidl.admin.ITaskResourceSpec);
 
-            /** PluginOverride taskType. */
-            public taskType: string;
+            /** TaskResourceSpec cpu. */
+            public cpu: string;
 
-            /** PluginOverride pluginId. */
-            public pluginId: string[];
+            /** TaskResourceSpec gpu. */
+            public gpu: string;
 
-            /** PluginOverride missingPluginBehavior. */
-            public missingPluginBehavior: flyteidl.admin.PluginOverride.MissingPluginBehavior;
+            /** TaskResourceSpec memory. */
+            public memory: string;
+
+            /** TaskResourceSpec storage. */
+            public storage: string;
+
+            /** TaskResourceSpec ephemeralStorage. */
+            public ephemeralStorage: string;
 
             /**
-             * Creates a new PluginOverride instance using the specified properties.
+             * Creates a new TaskResourceSpec instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns PluginOverride instance
+             * @returns TaskResourceSpec instance
              */
-            public static create(properties?: flyteidl.admin.IPluginOverride): flyteidl.admin.PluginOverride;
+            public static create(properties?: flyteidl.admin.ITaskResourceSpec): flyteidl.admin.TaskResourceSpec;
 
             /**
-             * Encodes the specified PluginOverride message. Does not implicitly {@link flyteidl.admin.PluginOverride.verify|verify} messages.
-             * @param message PluginOverride message or plain object to encode
+             * Encodes the specified TaskResourceSpec message. Does not implicitly {@link flyteidl.admin.TaskResourceSpec.verify|verify} messages.
+             * @param message TaskResourceSpec message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IPluginOverride, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ITaskResourceSpec, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a PluginOverride message from the specified reader or buffer.
+             * Decodes a TaskResourceSpec message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns PluginOverride
+             * @returns TaskResourceSpec
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.PluginOverride;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TaskResourceSpec;
 
             /**
-             * Verifies a PluginOverride message.
+             * Verifies a TaskResourceSpec message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        namespace PluginOverride {
-
-            /** MissingPluginBehavior enum. */
-            enum MissingPluginBehavior {
-                FAIL = 0,
-                USE_DEFAULT = 1
-            }
-        }
+        /** Properties of a TaskResourceAttributes. */
+        interface ITaskResourceAttributes {
 
-        /** Properties of a PluginOverrides. */
-        interface IPluginOverrides {
+            /** TaskResourceAttributes defaults */
+            defaults?: (flyteidl.admin.ITaskResourceSpec|null);
 
-            /** PluginOverrides overrides */
-            overrides?: (flyteidl.admin.IPluginOverride[]|null);
+            /** TaskResourceAttributes limits */
+            limits?: (flyteidl.admin.ITaskResourceSpec|null);
         }
 
-        /** Represents a PluginOverrides. */
-        class PluginOverrides implements IPluginOverrides {
+        /** Represents a TaskResourceAttributes. */
+        class TaskResourceAttributes implements ITaskResourceAttributes {
 
             /**
-             * Constructs a new PluginOverrides.
+             * Constructs a new TaskResourceAttributes.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IPluginOverrides);
+            constructor(properties?: flyteidl.admin.ITaskResourceAttributes);
 
-            /** PluginOverrides overrides. */
-            public overrides: flyteidl.admin.IPluginOverride[];
+            /** TaskResourceAttributes defaults. */
+            public defaults?: (flyteidl.admin.ITaskResourceSpec|null);
+
+            /** TaskResourceAttributes limits. */
+            public limits?: (flyteidl.admin.ITaskResourceSpec|null);
 
             /**
-             * Creates a new PluginOverrides instance using the specified properties.
+             * Creates a new TaskResourceAttributes instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns PluginOverrides instance
+             * @returns TaskResourceAttributes instance
              */
-            public static create(properties?: flyteidl.admin.IPluginOverrides): flyteidl.admin.PluginOverrides;
+            public static create(properties?: flyteidl.admin.ITaskResourceAttributes): flyteidl.admin.TaskResourceAttributes;
 
             /**
-             * Encodes the specified PluginOverrides message. Does not implicitly {@link flyteidl.admin.PluginOverrides.verify|verify} messages.
-             * @param message PluginOverrides message or plain object to encode
+             * Encodes the specified TaskResourceAttributes message. Does not implicitly {@link flyteidl.admin.TaskResourceAttributes.verify|verify} messages.
+             * @param message TaskResourceAttributes message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IPluginOverrides, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ITaskResourceAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a PluginOverrides message from the specified reader or buffer.
+             * Decodes a TaskResourceAttributes message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns PluginOverrides
+             * @returns TaskResourceAttributes
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.PluginOverrides;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TaskResourceAttributes;
 
             /**
-             * Verifies a PluginOverrides message.
+             * Verifies a TaskResourceAttributes message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a WorkflowExecutionConfig. */
-        interface IWorkflowExecutionConfig {
-
-            /** WorkflowExecutionConfig maxParallelism */
-            maxParallelism?: (number|null);
-
-            /** WorkflowExecutionConfig securityContext */
-            securityContext?: (flyteidl.core.ISecurityContext|null);
+        /** Properties of a ClusterResourceAttributes. */
+        interface IClusterResourceAttributes {
 
-            /** WorkflowExecutionConfig rawOutputDataConfig */
-            rawOutputDataConfig?: (flyteidl.admin.IRawOutputDataConfig|null);
+            /** ClusterResourceAttributes attributes */
+            attributes?: ({ [k: string]: string }|null);
+        }
 
-            /** WorkflowExecutionConfig labels */
-            labels?: (flyteidl.admin.ILabels|null);
+        /** Represents a ClusterResourceAttributes. */
+        class ClusterResourceAttributes implements IClusterResourceAttributes {
 
-            /** WorkflowExecutionConfig annotations */
-            annotations?: (flyteidl.admin.IAnnotations|null);
+            /**
+             * Constructs a new ClusterResourceAttributes.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IClusterResourceAttributes);
 
-            /** WorkflowExecutionConfig interruptible */
-            interruptible?: (google.protobuf.IBoolValue|null);
-
-            /** WorkflowExecutionConfig overwriteCache */
-            overwriteCache?: (boolean|null);
-
-            /** WorkflowExecutionConfig envs */
-            envs?: (flyteidl.admin.IEnvs|null);
-
-            /** WorkflowExecutionConfig executionEnvAssignments */
-            executionEnvAssignments?: (flyteidl.core.IExecutionEnvAssignment[]|null);
-        }
-
-        /** Represents a WorkflowExecutionConfig. */
-        class WorkflowExecutionConfig implements IWorkflowExecutionConfig {
+            /** ClusterResourceAttributes attributes. */
+            public attributes: { [k: string]: string };
 
             /**
-             * Constructs a new WorkflowExecutionConfig.
+             * Creates a new ClusterResourceAttributes instance using the specified properties.
              * @param [properties] Properties to set
+             * @returns ClusterResourceAttributes instance
              */
-            constructor(properties?: flyteidl.admin.IWorkflowExecutionConfig);
-
-            /** WorkflowExecutionConfig maxParallelism. */
-            public maxParallelism: number;
+            public static create(properties?: flyteidl.admin.IClusterResourceAttributes): flyteidl.admin.ClusterResourceAttributes;
 
-            /** WorkflowExecutionConfig securityContext. */
-            public securityContext?: (flyteidl.core.ISecurityContext|null);
+            /**
+             * Encodes the specified ClusterResourceAttributes message. Does not implicitly {@link flyteidl.admin.ClusterResourceAttributes.verify|verify} messages.
+             * @param message ClusterResourceAttributes message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IClusterResourceAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
 
-            /** WorkflowExecutionConfig rawOutputDataConfig. */
-            public rawOutputDataConfig?: (flyteidl.admin.IRawOutputDataConfig|null);
+            /**
+             * Decodes a ClusterResourceAttributes message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ClusterResourceAttributes
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ClusterResourceAttributes;
 
-            /** WorkflowExecutionConfig labels. */
-            public labels?: (flyteidl.admin.ILabels|null);
+            /**
+             * Verifies a ClusterResourceAttributes message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
 
-            /** WorkflowExecutionConfig annotations. */
-            public annotations?: (flyteidl.admin.IAnnotations|null);
+        /** Properties of an ExecutionQueueAttributes. */
+        interface IExecutionQueueAttributes {
 
-            /** WorkflowExecutionConfig interruptible. */
-            public interruptible?: (google.protobuf.IBoolValue|null);
+            /** ExecutionQueueAttributes tags */
+            tags?: (string[]|null);
+        }
 
-            /** WorkflowExecutionConfig overwriteCache. */
-            public overwriteCache: boolean;
+        /** Represents an ExecutionQueueAttributes. */
+        class ExecutionQueueAttributes implements IExecutionQueueAttributes {
 
-            /** WorkflowExecutionConfig envs. */
-            public envs?: (flyteidl.admin.IEnvs|null);
+            /**
+             * Constructs a new ExecutionQueueAttributes.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IExecutionQueueAttributes);
 
-            /** WorkflowExecutionConfig executionEnvAssignments. */
-            public executionEnvAssignments: flyteidl.core.IExecutionEnvAssignment[];
+            /** ExecutionQueueAttributes tags. */
+            public tags: string[];
 
             /**
-             * Creates a new WorkflowExecutionConfig instance using the specified properties.
+             * Creates a new ExecutionQueueAttributes instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns WorkflowExecutionConfig instance
+             * @returns ExecutionQueueAttributes instance
              */
-            public static create(properties?: flyteidl.admin.IWorkflowExecutionConfig): flyteidl.admin.WorkflowExecutionConfig;
+            public static create(properties?: flyteidl.admin.IExecutionQueueAttributes): flyteidl.admin.ExecutionQueueAttributes;
 
             /**
-             * Encodes the specified WorkflowExecutionConfig message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionConfig.verify|verify} messages.
-             * @param message WorkflowExecutionConfig message or plain object to encode
+             * Encodes the specified ExecutionQueueAttributes message. Does not implicitly {@link flyteidl.admin.ExecutionQueueAttributes.verify|verify} messages.
+             * @param message ExecutionQueueAttributes message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IWorkflowExecutionConfig, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IExecutionQueueAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a WorkflowExecutionConfig message from the specified reader or buffer.
+             * Decodes an ExecutionQueueAttributes message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns WorkflowExecutionConfig
+             * @returns ExecutionQueueAttributes
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowExecutionConfig;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionQueueAttributes;
 
             /**
-             * Verifies a WorkflowExecutionConfig message.
+             * Verifies an ExecutionQueueAttributes message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a MatchingAttributes. */
-        interface IMatchingAttributes {
-
-            /** MatchingAttributes taskResourceAttributes */
-            taskResourceAttributes?: (flyteidl.admin.ITaskResourceAttributes|null);
-
-            /** MatchingAttributes clusterResourceAttributes */
-            clusterResourceAttributes?: (flyteidl.admin.IClusterResourceAttributes|null);
-
-            /** MatchingAttributes executionQueueAttributes */
-            executionQueueAttributes?: (flyteidl.admin.IExecutionQueueAttributes|null);
-
-            /** MatchingAttributes executionClusterLabel */
-            executionClusterLabel?: (flyteidl.admin.IExecutionClusterLabel|null);
-
-            /** MatchingAttributes qualityOfService */
-            qualityOfService?: (flyteidl.core.IQualityOfService|null);
-
-            /** MatchingAttributes pluginOverrides */
-            pluginOverrides?: (flyteidl.admin.IPluginOverrides|null);
-
-            /** MatchingAttributes workflowExecutionConfig */
-            workflowExecutionConfig?: (flyteidl.admin.IWorkflowExecutionConfig|null);
+        /** Properties of an ExecutionClusterLabel. */
+        interface IExecutionClusterLabel {
 
-            /** MatchingAttributes clusterAssignment */
-            clusterAssignment?: (flyteidl.admin.IClusterAssignment|null);
+            /** ExecutionClusterLabel value */
+            value?: (string|null);
         }
 
-        /** Represents a MatchingAttributes. */
-        class MatchingAttributes implements IMatchingAttributes {
+        /** Represents an ExecutionClusterLabel. */
+        class ExecutionClusterLabel implements IExecutionClusterLabel {
 
             /**
-             * Constructs a new MatchingAttributes.
+             * Constructs a new ExecutionClusterLabel.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IMatchingAttributes);
-
-            /** MatchingAttributes taskResourceAttributes. */
-            public taskResourceAttributes?: (flyteidl.admin.ITaskResourceAttributes|null);
-
-            /** MatchingAttributes clusterResourceAttributes. */
-            public clusterResourceAttributes?: (flyteidl.admin.IClusterResourceAttributes|null);
-
-            /** MatchingAttributes executionQueueAttributes. */
-            public executionQueueAttributes?: (flyteidl.admin.IExecutionQueueAttributes|null);
-
-            /** MatchingAttributes executionClusterLabel. */
-            public executionClusterLabel?: (flyteidl.admin.IExecutionClusterLabel|null);
-
-            /** MatchingAttributes qualityOfService. */
-            public qualityOfService?: (flyteidl.core.IQualityOfService|null);
-
-            /** MatchingAttributes pluginOverrides. */
-            public pluginOverrides?: (flyteidl.admin.IPluginOverrides|null);
-
-            /** MatchingAttributes workflowExecutionConfig. */
-            public workflowExecutionConfig?: (flyteidl.admin.IWorkflowExecutionConfig|null);
-
-            /** MatchingAttributes clusterAssignment. */
-            public clusterAssignment?: (flyteidl.admin.IClusterAssignment|null);
+            constructor(properties?: flyteidl.admin.IExecutionClusterLabel);
 
-            /** MatchingAttributes target. */
-            public target?: ("taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment");
+            /** ExecutionClusterLabel value. */
+            public value: string;
 
             /**
-             * Creates a new MatchingAttributes instance using the specified properties.
+             * Creates a new ExecutionClusterLabel instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns MatchingAttributes instance
+             * @returns ExecutionClusterLabel instance
              */
-            public static create(properties?: flyteidl.admin.IMatchingAttributes): flyteidl.admin.MatchingAttributes;
+            public static create(properties?: flyteidl.admin.IExecutionClusterLabel): flyteidl.admin.ExecutionClusterLabel;
 
             /**
-             * Encodes the specified MatchingAttributes message. Does not implicitly {@link flyteidl.admin.MatchingAttributes.verify|verify} messages.
-             * @param message MatchingAttributes message or plain object to encode
+             * Encodes the specified ExecutionClusterLabel message. Does not implicitly {@link flyteidl.admin.ExecutionClusterLabel.verify|verify} messages.
+             * @param message ExecutionClusterLabel message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IMatchingAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IExecutionClusterLabel, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a MatchingAttributes message from the specified reader or buffer.
+             * Decodes an ExecutionClusterLabel message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns MatchingAttributes
+             * @returns ExecutionClusterLabel
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.MatchingAttributes;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ExecutionClusterLabel;
 
             /**
-             * Verifies a MatchingAttributes message.
+             * Verifies an ExecutionClusterLabel message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a MatchableAttributesConfiguration. */
-        interface IMatchableAttributesConfiguration {
-
-            /** MatchableAttributesConfiguration attributes */
-            attributes?: (flyteidl.admin.IMatchingAttributes|null);
-
-            /** MatchableAttributesConfiguration domain */
-            domain?: (string|null);
-
-            /** MatchableAttributesConfiguration project */
-            project?: (string|null);
+        /** Properties of a PluginOverride. */
+        interface IPluginOverride {
 
-            /** MatchableAttributesConfiguration workflow */
-            workflow?: (string|null);
+            /** PluginOverride taskType */
+            taskType?: (string|null);
 
-            /** MatchableAttributesConfiguration launchPlan */
-            launchPlan?: (string|null);
+            /** PluginOverride pluginId */
+            pluginId?: (string[]|null);
 
-            /** MatchableAttributesConfiguration org */
-            org?: (string|null);
+            /** PluginOverride missingPluginBehavior */
+            missingPluginBehavior?: (flyteidl.admin.PluginOverride.MissingPluginBehavior|null);
         }
 
-        /** Represents a MatchableAttributesConfiguration. */
-        class MatchableAttributesConfiguration implements IMatchableAttributesConfiguration {
+        /** Represents a PluginOverride. */
+        class PluginOverride implements IPluginOverride {
 
             /**
-             * Constructs a new MatchableAttributesConfiguration.
+             * Constructs a new PluginOverride.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IMatchableAttributesConfiguration);
-
-            /** MatchableAttributesConfiguration attributes. */
-            public attributes?: (flyteidl.admin.IMatchingAttributes|null);
-
-            /** MatchableAttributesConfiguration domain. */
-            public domain: string;
-
-            /** MatchableAttributesConfiguration project. */
-            public project: string;
+            constructor(properties?: flyteidl.admin.IPluginOverride);
 
-            /** MatchableAttributesConfiguration workflow. */
-            public workflow: string;
+            /** PluginOverride taskType. */
+            public taskType: string;
 
-            /** MatchableAttributesConfiguration launchPlan. */
-            public launchPlan: string;
+            /** PluginOverride pluginId. */
+            public pluginId: string[];
 
-            /** MatchableAttributesConfiguration org. */
-            public org: string;
+            /** PluginOverride missingPluginBehavior. */
+            public missingPluginBehavior: flyteidl.admin.PluginOverride.MissingPluginBehavior;
 
             /**
-             * Creates a new MatchableAttributesConfiguration instance using the specified properties.
+             * Creates a new PluginOverride instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns MatchableAttributesConfiguration instance
+             * @returns PluginOverride instance
              */
-            public static create(properties?: flyteidl.admin.IMatchableAttributesConfiguration): flyteidl.admin.MatchableAttributesConfiguration;
+            public static create(properties?: flyteidl.admin.IPluginOverride): flyteidl.admin.PluginOverride;
 
             /**
-             * Encodes the specified MatchableAttributesConfiguration message. Does not implicitly {@link flyteidl.admin.MatchableAttributesConfiguration.verify|verify} messages.
-             * @param message MatchableAttributesConfiguration message or plain object to encode
+             * Encodes the specified PluginOverride message. Does not implicitly {@link flyteidl.admin.PluginOverride.verify|verify} messages.
+             * @param message PluginOverride message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IMatchableAttributesConfiguration, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IPluginOverride, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a MatchableAttributesConfiguration message from the specified reader or buffer.
+             * Decodes a PluginOverride message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns MatchableAttributesConfiguration
+             * @returns PluginOverride
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.MatchableAttributesConfiguration;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.PluginOverride;
 
             /**
-             * Verifies a MatchableAttributesConfiguration message.
+             * Verifies a PluginOverride message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a ListMatchableAttributesRequest. */
-        interface IListMatchableAttributesRequest {
+        namespace PluginOverride {
 
-            /** ListMatchableAttributesRequest resourceType */
-            resourceType?: (flyteidl.admin.MatchableResource|null);
+            /** MissingPluginBehavior enum. */
+            enum MissingPluginBehavior {
+                FAIL = 0,
+                USE_DEFAULT = 1
+            }
+        }
 
-            /** ListMatchableAttributesRequest org */
-            org?: (string|null);
+        /** Properties of a PluginOverrides. */
+        interface IPluginOverrides {
+
+            /** PluginOverrides overrides */
+            overrides?: (flyteidl.admin.IPluginOverride[]|null);
         }
 
-        /** Represents a ListMatchableAttributesRequest. */
-        class ListMatchableAttributesRequest implements IListMatchableAttributesRequest {
+        /** Represents a PluginOverrides. */
+        class PluginOverrides implements IPluginOverrides {
 
             /**
-             * Constructs a new ListMatchableAttributesRequest.
+             * Constructs a new PluginOverrides.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IListMatchableAttributesRequest);
-
-            /** ListMatchableAttributesRequest resourceType. */
-            public resourceType: flyteidl.admin.MatchableResource;
+            constructor(properties?: flyteidl.admin.IPluginOverrides);
 
-            /** ListMatchableAttributesRequest org. */
-            public org: string;
+            /** PluginOverrides overrides. */
+            public overrides: flyteidl.admin.IPluginOverride[];
 
             /**
-             * Creates a new ListMatchableAttributesRequest instance using the specified properties.
+             * Creates a new PluginOverrides instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ListMatchableAttributesRequest instance
+             * @returns PluginOverrides instance
              */
-            public static create(properties?: flyteidl.admin.IListMatchableAttributesRequest): flyteidl.admin.ListMatchableAttributesRequest;
+            public static create(properties?: flyteidl.admin.IPluginOverrides): flyteidl.admin.PluginOverrides;
 
             /**
-             * Encodes the specified ListMatchableAttributesRequest message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesRequest.verify|verify} messages.
-             * @param message ListMatchableAttributesRequest message or plain object to encode
+             * Encodes the specified PluginOverrides message. Does not implicitly {@link flyteidl.admin.PluginOverrides.verify|verify} messages.
+             * @param message PluginOverrides message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IListMatchableAttributesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IPluginOverrides, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a ListMatchableAttributesRequest message from the specified reader or buffer.
+             * Decodes a PluginOverrides message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ListMatchableAttributesRequest
+             * @returns PluginOverrides
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ListMatchableAttributesRequest;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.PluginOverrides;
 
             /**
-             * Verifies a ListMatchableAttributesRequest message.
+             * Verifies a PluginOverrides message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a ListMatchableAttributesResponse. */
-        interface IListMatchableAttributesResponse {
+        /** Properties of a WorkflowExecutionConfig. */
+        interface IWorkflowExecutionConfig {
 
-            /** ListMatchableAttributesResponse configurations */
-            configurations?: (flyteidl.admin.IMatchableAttributesConfiguration[]|null);
+            /** WorkflowExecutionConfig maxParallelism */
+            maxParallelism?: (number|null);
+
+            /** WorkflowExecutionConfig securityContext */
+            securityContext?: (flyteidl.core.ISecurityContext|null);
+
+            /** WorkflowExecutionConfig rawOutputDataConfig */
+            rawOutputDataConfig?: (flyteidl.admin.IRawOutputDataConfig|null);
+
+            /** WorkflowExecutionConfig labels */
+            labels?: (flyteidl.admin.ILabels|null);
+
+            /** WorkflowExecutionConfig annotations */
+            annotations?: (flyteidl.admin.IAnnotations|null);
+
+            /** WorkflowExecutionConfig interruptible */
+            interruptible?: (google.protobuf.IBoolValue|null);
+
+            /** WorkflowExecutionConfig overwriteCache */
+            overwriteCache?: (boolean|null);
+
+            /** WorkflowExecutionConfig envs */
+            envs?: (flyteidl.admin.IEnvs|null);
+
+            /** WorkflowExecutionConfig executionEnvAssignments */
+            executionEnvAssignments?: (flyteidl.core.IExecutionEnvAssignment[]|null);
         }
 
-        /** Represents a ListMatchableAttributesResponse. */
-        class ListMatchableAttributesResponse implements IListMatchableAttributesResponse {
+        /** Represents a WorkflowExecutionConfig. */
+        class WorkflowExecutionConfig implements IWorkflowExecutionConfig {
 
             /**
-             * Constructs a new ListMatchableAttributesResponse.
+             * Constructs a new WorkflowExecutionConfig.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IListMatchableAttributesResponse);
+            constructor(properties?: flyteidl.admin.IWorkflowExecutionConfig);
 
-            /** ListMatchableAttributesResponse configurations. */
-            public configurations: flyteidl.admin.IMatchableAttributesConfiguration[];
+            /** WorkflowExecutionConfig maxParallelism. */
+            public maxParallelism: number;
+
+            /** WorkflowExecutionConfig securityContext. */
+            public securityContext?: (flyteidl.core.ISecurityContext|null);
+
+            /** WorkflowExecutionConfig rawOutputDataConfig. */
+            public rawOutputDataConfig?: (flyteidl.admin.IRawOutputDataConfig|null);
+
+            /** WorkflowExecutionConfig labels. */
+            public labels?: (flyteidl.admin.ILabels|null);
+
+            /** WorkflowExecutionConfig annotations. */
+            public annotations?: (flyteidl.admin.IAnnotations|null);
+
+            /** WorkflowExecutionConfig interruptible. */
+            public interruptible?: (google.protobuf.IBoolValue|null);
+
+            /** WorkflowExecutionConfig overwriteCache. */
+            public overwriteCache: boolean;
+
+            /** WorkflowExecutionConfig envs. */
+            public envs?: (flyteidl.admin.IEnvs|null);
+
+            /** WorkflowExecutionConfig executionEnvAssignments. */
+            public executionEnvAssignments: flyteidl.core.IExecutionEnvAssignment[];
 
             /**
-             * Creates a new ListMatchableAttributesResponse instance using the specified properties.
+             * Creates a new WorkflowExecutionConfig instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ListMatchableAttributesResponse instance
+             * @returns WorkflowExecutionConfig instance
              */
-            public static create(properties?: flyteidl.admin.IListMatchableAttributesResponse): flyteidl.admin.ListMatchableAttributesResponse;
+            public static create(properties?: flyteidl.admin.IWorkflowExecutionConfig): flyteidl.admin.WorkflowExecutionConfig;
 
             /**
-             * Encodes the specified ListMatchableAttributesResponse message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesResponse.verify|verify} messages.
-             * @param message ListMatchableAttributesResponse message or plain object to encode
+             * Encodes the specified WorkflowExecutionConfig message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionConfig.verify|verify} messages.
+             * @param message WorkflowExecutionConfig message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IListMatchableAttributesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWorkflowExecutionConfig, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a ListMatchableAttributesResponse message from the specified reader or buffer.
+             * Decodes a WorkflowExecutionConfig message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ListMatchableAttributesResponse
+             * @returns WorkflowExecutionConfig
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ListMatchableAttributesResponse;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowExecutionConfig;
 
             /**
-             * Verifies a ListMatchableAttributesResponse message.
+             * Verifies a WorkflowExecutionConfig message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a LaunchPlanCreateRequest. */
-        interface ILaunchPlanCreateRequest {
+        /** Properties of a MatchingAttributes. */
+        interface IMatchingAttributes {
 
-            /** LaunchPlanCreateRequest id */
-            id?: (flyteidl.core.IIdentifier|null);
+            /** MatchingAttributes taskResourceAttributes */
+            taskResourceAttributes?: (flyteidl.admin.ITaskResourceAttributes|null);
 
-            /** LaunchPlanCreateRequest spec */
-            spec?: (flyteidl.admin.ILaunchPlanSpec|null);
+            /** MatchingAttributes clusterResourceAttributes */
+            clusterResourceAttributes?: (flyteidl.admin.IClusterResourceAttributes|null);
+
+            /** MatchingAttributes executionQueueAttributes */
+            executionQueueAttributes?: (flyteidl.admin.IExecutionQueueAttributes|null);
+
+            /** MatchingAttributes executionClusterLabel */
+            executionClusterLabel?: (flyteidl.admin.IExecutionClusterLabel|null);
+
+            /** MatchingAttributes qualityOfService */
+            qualityOfService?: (flyteidl.core.IQualityOfService|null);
+
+            /** MatchingAttributes pluginOverrides */
+            pluginOverrides?: (flyteidl.admin.IPluginOverrides|null);
+
+            /** MatchingAttributes workflowExecutionConfig */
+            workflowExecutionConfig?: (flyteidl.admin.IWorkflowExecutionConfig|null);
+
+            /** MatchingAttributes clusterAssignment */
+            clusterAssignment?: (flyteidl.admin.IClusterAssignment|null);
         }
 
-        /** Represents a LaunchPlanCreateRequest. */
-        class LaunchPlanCreateRequest implements ILaunchPlanCreateRequest {
+        /** Represents a MatchingAttributes. */
+        class MatchingAttributes implements IMatchingAttributes {
 
             /**
-             * Constructs a new LaunchPlanCreateRequest.
+             * Constructs a new MatchingAttributes.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ILaunchPlanCreateRequest);
+            constructor(properties?: flyteidl.admin.IMatchingAttributes);
 
-            /** LaunchPlanCreateRequest id. */
-            public id?: (flyteidl.core.IIdentifier|null);
+            /** MatchingAttributes taskResourceAttributes. */
+            public taskResourceAttributes?: (flyteidl.admin.ITaskResourceAttributes|null);
 
-            /** LaunchPlanCreateRequest spec. */
-            public spec?: (flyteidl.admin.ILaunchPlanSpec|null);
+            /** MatchingAttributes clusterResourceAttributes. */
+            public clusterResourceAttributes?: (flyteidl.admin.IClusterResourceAttributes|null);
+
+            /** MatchingAttributes executionQueueAttributes. */
+            public executionQueueAttributes?: (flyteidl.admin.IExecutionQueueAttributes|null);
+
+            /** MatchingAttributes executionClusterLabel. */
+            public executionClusterLabel?: (flyteidl.admin.IExecutionClusterLabel|null);
+
+            /** MatchingAttributes qualityOfService. */
+            public qualityOfService?: (flyteidl.core.IQualityOfService|null);
+
+            /** MatchingAttributes pluginOverrides. */
+            public pluginOverrides?: (flyteidl.admin.IPluginOverrides|null);
+
+            /** MatchingAttributes workflowExecutionConfig. */
+            public workflowExecutionConfig?: (flyteidl.admin.IWorkflowExecutionConfig|null);
+
+            /** MatchingAttributes clusterAssignment. */
+            public clusterAssignment?: (flyteidl.admin.IClusterAssignment|null);
+
+            /** MatchingAttributes target. */
+            public target?: ("taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment");
 
             /**
-             * Creates a new LaunchPlanCreateRequest instance using the specified properties.
+             * Creates a new MatchingAttributes instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns LaunchPlanCreateRequest instance
+             * @returns MatchingAttributes instance
              */
-            public static create(properties?: flyteidl.admin.ILaunchPlanCreateRequest): flyteidl.admin.LaunchPlanCreateRequest;
+            public static create(properties?: flyteidl.admin.IMatchingAttributes): flyteidl.admin.MatchingAttributes;
 
             /**
-             * Encodes the specified LaunchPlanCreateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanCreateRequest.verify|verify} messages.
-             * @param message LaunchPlanCreateRequest message or plain object to encode
+             * Encodes the specified MatchingAttributes message. Does not implicitly {@link flyteidl.admin.MatchingAttributes.verify|verify} messages.
+             * @param message MatchingAttributes message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ILaunchPlanCreateRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IMatchingAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a LaunchPlanCreateRequest message from the specified reader or buffer.
+             * Decodes a MatchingAttributes message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns LaunchPlanCreateRequest
+             * @returns MatchingAttributes
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanCreateRequest;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.MatchingAttributes;
 
             /**
-             * Verifies a LaunchPlanCreateRequest message.
+             * Verifies a MatchingAttributes message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a LaunchPlanCreateResponse. */
-        interface ILaunchPlanCreateResponse {
+        /** Properties of a MatchableAttributesConfiguration. */
+        interface IMatchableAttributesConfiguration {
+
+            /** MatchableAttributesConfiguration attributes */
+            attributes?: (flyteidl.admin.IMatchingAttributes|null);
+
+            /** MatchableAttributesConfiguration domain */
+            domain?: (string|null);
+
+            /** MatchableAttributesConfiguration project */
+            project?: (string|null);
+
+            /** MatchableAttributesConfiguration workflow */
+            workflow?: (string|null);
+
+            /** MatchableAttributesConfiguration launchPlan */
+            launchPlan?: (string|null);
+
+            /** MatchableAttributesConfiguration org */
+            org?: (string|null);
         }
 
-        /** Represents a LaunchPlanCreateResponse. */
-        class LaunchPlanCreateResponse implements ILaunchPlanCreateResponse {
+        /** Represents a MatchableAttributesConfiguration. */
+        class MatchableAttributesConfiguration implements IMatchableAttributesConfiguration {
 
             /**
-             * Constructs a new LaunchPlanCreateResponse.
+             * Constructs a new MatchableAttributesConfiguration.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ILaunchPlanCreateResponse);
+            constructor(properties?: flyteidl.admin.IMatchableAttributesConfiguration);
 
-            /**
+            /** MatchableAttributesConfiguration attributes. */
+            public attributes?: (flyteidl.admin.IMatchingAttributes|null);
+
+            /** MatchableAttributesConfiguration domain. */
+            public domain: string;
+
+            /** MatchableAttributesConfiguration project. */
+            public project: string;
+
+            /** MatchableAttributesConfiguration workflow. */
+            public workflow: string;
+
+            /** MatchableAttributesConfiguration launchPlan. */
+            public launchPlan: string;
+
+            /** MatchableAttributesConfiguration org. */
+            public org: string;
+
+            /**
+             * Creates a new MatchableAttributesConfiguration instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns MatchableAttributesConfiguration instance
+             */
+            public static create(properties?: flyteidl.admin.IMatchableAttributesConfiguration): flyteidl.admin.MatchableAttributesConfiguration;
+
+            /**
+             * Encodes the specified MatchableAttributesConfiguration message. Does not implicitly {@link flyteidl.admin.MatchableAttributesConfiguration.verify|verify} messages.
+             * @param message MatchableAttributesConfiguration message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IMatchableAttributesConfiguration, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a MatchableAttributesConfiguration message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns MatchableAttributesConfiguration
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.MatchableAttributesConfiguration;
+
+            /**
+             * Verifies a MatchableAttributesConfiguration message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a ListMatchableAttributesRequest. */
+        interface IListMatchableAttributesRequest {
+
+            /** ListMatchableAttributesRequest resourceType */
+            resourceType?: (flyteidl.admin.MatchableResource|null);
+
+            /** ListMatchableAttributesRequest org */
+            org?: (string|null);
+        }
+
+        /** Represents a ListMatchableAttributesRequest. */
+        class ListMatchableAttributesRequest implements IListMatchableAttributesRequest {
+
+            /**
+             * Constructs a new ListMatchableAttributesRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IListMatchableAttributesRequest);
+
+            /** ListMatchableAttributesRequest resourceType. */
+            public resourceType: flyteidl.admin.MatchableResource;
+
+            /** ListMatchableAttributesRequest org. */
+            public org: string;
+
+            /**
+             * Creates a new ListMatchableAttributesRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ListMatchableAttributesRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IListMatchableAttributesRequest): flyteidl.admin.ListMatchableAttributesRequest;
+
+            /**
+             * Encodes the specified ListMatchableAttributesRequest message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesRequest.verify|verify} messages.
+             * @param message ListMatchableAttributesRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IListMatchableAttributesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ListMatchableAttributesRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ListMatchableAttributesRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ListMatchableAttributesRequest;
+
+            /**
+             * Verifies a ListMatchableAttributesRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a ListMatchableAttributesResponse. */
+        interface IListMatchableAttributesResponse {
+
+            /** ListMatchableAttributesResponse configurations */
+            configurations?: (flyteidl.admin.IMatchableAttributesConfiguration[]|null);
+        }
+
+        /** Represents a ListMatchableAttributesResponse. */
+        class ListMatchableAttributesResponse implements IListMatchableAttributesResponse {
+
+            /**
+             * Constructs a new ListMatchableAttributesResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IListMatchableAttributesResponse);
+
+            /** ListMatchableAttributesResponse configurations. */
+            public configurations: flyteidl.admin.IMatchableAttributesConfiguration[];
+
+            /**
+             * Creates a new ListMatchableAttributesResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ListMatchableAttributesResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IListMatchableAttributesResponse): flyteidl.admin.ListMatchableAttributesResponse;
+
+            /**
+             * Encodes the specified ListMatchableAttributesResponse message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesResponse.verify|verify} messages.
+             * @param message ListMatchableAttributesResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IListMatchableAttributesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ListMatchableAttributesResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ListMatchableAttributesResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ListMatchableAttributesResponse;
+
+            /**
+             * Verifies a ListMatchableAttributesResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a LaunchPlanCreateRequest. */
+        interface ILaunchPlanCreateRequest {
+
+            /** LaunchPlanCreateRequest id */
+            id?: (flyteidl.core.IIdentifier|null);
+
+            /** LaunchPlanCreateRequest spec */
+            spec?: (flyteidl.admin.ILaunchPlanSpec|null);
+        }
+
+        /** Represents a LaunchPlanCreateRequest. */
+        class LaunchPlanCreateRequest implements ILaunchPlanCreateRequest {
+
+            /**
+             * Constructs a new LaunchPlanCreateRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ILaunchPlanCreateRequest);
+
+            /** LaunchPlanCreateRequest id. */
+            public id?: (flyteidl.core.IIdentifier|null);
+
+            /** LaunchPlanCreateRequest spec. */
+            public spec?: (flyteidl.admin.ILaunchPlanSpec|null);
+
+            /**
+             * Creates a new LaunchPlanCreateRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns LaunchPlanCreateRequest instance
+             */
+            public static create(properties?: flyteidl.admin.ILaunchPlanCreateRequest): flyteidl.admin.LaunchPlanCreateRequest;
+
+            /**
+             * Encodes the specified LaunchPlanCreateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanCreateRequest.verify|verify} messages.
+             * @param message LaunchPlanCreateRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ILaunchPlanCreateRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a LaunchPlanCreateRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns LaunchPlanCreateRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanCreateRequest;
+
+            /**
+             * Verifies a LaunchPlanCreateRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a LaunchPlanCreateResponse. */
+        interface ILaunchPlanCreateResponse {
+        }
+
+        /** Represents a LaunchPlanCreateResponse. */
+        class LaunchPlanCreateResponse implements ILaunchPlanCreateResponse {
+
+            /**
+             * Constructs a new LaunchPlanCreateResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ILaunchPlanCreateResponse);
+
+            /**
              * Creates a new LaunchPlanCreateResponse instance using the specified properties.
              * @param [properties] Properties to set
              * @returns LaunchPlanCreateResponse instance
@@ -16855,515 +17463,923 @@ export namespace flyteidl {
             public static encode(message: flyteidl.admin.ILaunchPlanClosure, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a LaunchPlanClosure message from the specified reader or buffer.
+             * Decodes a LaunchPlanClosure message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns LaunchPlanClosure
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanClosure;
+
+            /**
+             * Verifies a LaunchPlanClosure message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a LaunchPlanMetadata. */
+        interface ILaunchPlanMetadata {
+
+            /** LaunchPlanMetadata schedule */
+            schedule?: (flyteidl.admin.ISchedule|null);
+
+            /** LaunchPlanMetadata notifications */
+            notifications?: (flyteidl.admin.INotification[]|null);
+
+            /** LaunchPlanMetadata launchConditions */
+            launchConditions?: (google.protobuf.IAny|null);
+
+            /** LaunchPlanMetadata triggers */
+            triggers?: (flyteidl.admin.ITrigger[]|null);
+        }
+
+        /** Represents a LaunchPlanMetadata. */
+        class LaunchPlanMetadata implements ILaunchPlanMetadata {
+
+            /**
+             * Constructs a new LaunchPlanMetadata.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ILaunchPlanMetadata);
+
+            /** LaunchPlanMetadata schedule. */
+            public schedule?: (flyteidl.admin.ISchedule|null);
+
+            /** LaunchPlanMetadata notifications. */
+            public notifications: flyteidl.admin.INotification[];
+
+            /** LaunchPlanMetadata launchConditions. */
+            public launchConditions?: (google.protobuf.IAny|null);
+
+            /** LaunchPlanMetadata triggers. */
+            public triggers: flyteidl.admin.ITrigger[];
+
+            /**
+             * Creates a new LaunchPlanMetadata instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns LaunchPlanMetadata instance
+             */
+            public static create(properties?: flyteidl.admin.ILaunchPlanMetadata): flyteidl.admin.LaunchPlanMetadata;
+
+            /**
+             * Encodes the specified LaunchPlanMetadata message. Does not implicitly {@link flyteidl.admin.LaunchPlanMetadata.verify|verify} messages.
+             * @param message LaunchPlanMetadata message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ILaunchPlanMetadata, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a LaunchPlanMetadata message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns LaunchPlanMetadata
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanMetadata;
+
+            /**
+             * Verifies a LaunchPlanMetadata message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a LaunchPlanUpdateRequest. */
+        interface ILaunchPlanUpdateRequest {
+
+            /** LaunchPlanUpdateRequest id */
+            id?: (flyteidl.core.IIdentifier|null);
+
+            /** LaunchPlanUpdateRequest state */
+            state?: (flyteidl.admin.LaunchPlanState|null);
+        }
+
+        /** Represents a LaunchPlanUpdateRequest. */
+        class LaunchPlanUpdateRequest implements ILaunchPlanUpdateRequest {
+
+            /**
+             * Constructs a new LaunchPlanUpdateRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ILaunchPlanUpdateRequest);
+
+            /** LaunchPlanUpdateRequest id. */
+            public id?: (flyteidl.core.IIdentifier|null);
+
+            /** LaunchPlanUpdateRequest state. */
+            public state: flyteidl.admin.LaunchPlanState;
+
+            /**
+             * Creates a new LaunchPlanUpdateRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns LaunchPlanUpdateRequest instance
+             */
+            public static create(properties?: flyteidl.admin.ILaunchPlanUpdateRequest): flyteidl.admin.LaunchPlanUpdateRequest;
+
+            /**
+             * Encodes the specified LaunchPlanUpdateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateRequest.verify|verify} messages.
+             * @param message LaunchPlanUpdateRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ILaunchPlanUpdateRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a LaunchPlanUpdateRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns LaunchPlanUpdateRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanUpdateRequest;
+
+            /**
+             * Verifies a LaunchPlanUpdateRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a LaunchPlanUpdateResponse. */
+        interface ILaunchPlanUpdateResponse {
+        }
+
+        /** Represents a LaunchPlanUpdateResponse. */
+        class LaunchPlanUpdateResponse implements ILaunchPlanUpdateResponse {
+
+            /**
+             * Constructs a new LaunchPlanUpdateResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ILaunchPlanUpdateResponse);
+
+            /**
+             * Creates a new LaunchPlanUpdateResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns LaunchPlanUpdateResponse instance
+             */
+            public static create(properties?: flyteidl.admin.ILaunchPlanUpdateResponse): flyteidl.admin.LaunchPlanUpdateResponse;
+
+            /**
+             * Encodes the specified LaunchPlanUpdateResponse message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateResponse.verify|verify} messages.
+             * @param message LaunchPlanUpdateResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ILaunchPlanUpdateResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a LaunchPlanUpdateResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns LaunchPlanUpdateResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanUpdateResponse;
+
+            /**
+             * Verifies a LaunchPlanUpdateResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ActiveLaunchPlanRequest. */
+        interface IActiveLaunchPlanRequest {
+
+            /** ActiveLaunchPlanRequest id */
+            id?: (flyteidl.admin.INamedEntityIdentifier|null);
+        }
+
+        /** Represents an ActiveLaunchPlanRequest. */
+        class ActiveLaunchPlanRequest implements IActiveLaunchPlanRequest {
+
+            /**
+             * Constructs a new ActiveLaunchPlanRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IActiveLaunchPlanRequest);
+
+            /** ActiveLaunchPlanRequest id. */
+            public id?: (flyteidl.admin.INamedEntityIdentifier|null);
+
+            /**
+             * Creates a new ActiveLaunchPlanRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ActiveLaunchPlanRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IActiveLaunchPlanRequest): flyteidl.admin.ActiveLaunchPlanRequest;
+
+            /**
+             * Encodes the specified ActiveLaunchPlanRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanRequest.verify|verify} messages.
+             * @param message ActiveLaunchPlanRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IActiveLaunchPlanRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ActiveLaunchPlanRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ActiveLaunchPlanRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ActiveLaunchPlanRequest;
+
+            /**
+             * Verifies an ActiveLaunchPlanRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ActiveLaunchPlanListRequest. */
+        interface IActiveLaunchPlanListRequest {
+
+            /** ActiveLaunchPlanListRequest project */
+            project?: (string|null);
+
+            /** ActiveLaunchPlanListRequest domain */
+            domain?: (string|null);
+
+            /** ActiveLaunchPlanListRequest limit */
+            limit?: (number|null);
+
+            /** ActiveLaunchPlanListRequest token */
+            token?: (string|null);
+
+            /** ActiveLaunchPlanListRequest sortBy */
+            sortBy?: (flyteidl.admin.ISort|null);
+
+            /** ActiveLaunchPlanListRequest org */
+            org?: (string|null);
+        }
+
+        /** Represents an ActiveLaunchPlanListRequest. */
+        class ActiveLaunchPlanListRequest implements IActiveLaunchPlanListRequest {
+
+            /**
+             * Constructs a new ActiveLaunchPlanListRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IActiveLaunchPlanListRequest);
+
+            /** ActiveLaunchPlanListRequest project. */
+            public project: string;
+
+            /** ActiveLaunchPlanListRequest domain. */
+            public domain: string;
+
+            /** ActiveLaunchPlanListRequest limit. */
+            public limit: number;
+
+            /** ActiveLaunchPlanListRequest token. */
+            public token: string;
+
+            /** ActiveLaunchPlanListRequest sortBy. */
+            public sortBy?: (flyteidl.admin.ISort|null);
+
+            /** ActiveLaunchPlanListRequest org. */
+            public org: string;
+
+            /**
+             * Creates a new ActiveLaunchPlanListRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ActiveLaunchPlanListRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IActiveLaunchPlanListRequest): flyteidl.admin.ActiveLaunchPlanListRequest;
+
+            /**
+             * Encodes the specified ActiveLaunchPlanListRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanListRequest.verify|verify} messages.
+             * @param message ActiveLaunchPlanListRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IActiveLaunchPlanListRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ActiveLaunchPlanListRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ActiveLaunchPlanListRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ActiveLaunchPlanListRequest;
+
+            /**
+             * Verifies an ActiveLaunchPlanListRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** FixedRateUnit enum. */
+        enum FixedRateUnit {
+            MINUTE = 0,
+            HOUR = 1,
+            DAY = 2
+        }
+
+        /** Properties of a FixedRate. */
+        interface IFixedRate {
+
+            /** FixedRate value */
+            value?: (number|null);
+
+            /** FixedRate unit */
+            unit?: (flyteidl.admin.FixedRateUnit|null);
+        }
+
+        /** Represents a FixedRate. */
+        class FixedRate implements IFixedRate {
+
+            /**
+             * Constructs a new FixedRate.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IFixedRate);
+
+            /** FixedRate value. */
+            public value: number;
+
+            /** FixedRate unit. */
+            public unit: flyteidl.admin.FixedRateUnit;
+
+            /**
+             * Creates a new FixedRate instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns FixedRate instance
+             */
+            public static create(properties?: flyteidl.admin.IFixedRate): flyteidl.admin.FixedRate;
+
+            /**
+             * Encodes the specified FixedRate message. Does not implicitly {@link flyteidl.admin.FixedRate.verify|verify} messages.
+             * @param message FixedRate message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IFixedRate, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a FixedRate message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns LaunchPlanClosure
+             * @returns FixedRate
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanClosure;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.FixedRate;
 
             /**
-             * Verifies a LaunchPlanClosure message.
+             * Verifies a FixedRate message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a LaunchPlanMetadata. */
-        interface ILaunchPlanMetadata {
-
-            /** LaunchPlanMetadata schedule */
-            schedule?: (flyteidl.admin.ISchedule|null);
+        /** Properties of a CronSchedule. */
+        interface ICronSchedule {
 
-            /** LaunchPlanMetadata notifications */
-            notifications?: (flyteidl.admin.INotification[]|null);
+            /** CronSchedule schedule */
+            schedule?: (string|null);
 
-            /** LaunchPlanMetadata launchConditions */
-            launchConditions?: (google.protobuf.IAny|null);
+            /** CronSchedule offset */
+            offset?: (string|null);
         }
 
-        /** Represents a LaunchPlanMetadata. */
-        class LaunchPlanMetadata implements ILaunchPlanMetadata {
+        /** Represents a CronSchedule. */
+        class CronSchedule implements ICronSchedule {
 
             /**
-             * Constructs a new LaunchPlanMetadata.
+             * Constructs a new CronSchedule.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ILaunchPlanMetadata);
-
-            /** LaunchPlanMetadata schedule. */
-            public schedule?: (flyteidl.admin.ISchedule|null);
+            constructor(properties?: flyteidl.admin.ICronSchedule);
 
-            /** LaunchPlanMetadata notifications. */
-            public notifications: flyteidl.admin.INotification[];
+            /** CronSchedule schedule. */
+            public schedule: string;
 
-            /** LaunchPlanMetadata launchConditions. */
-            public launchConditions?: (google.protobuf.IAny|null);
+            /** CronSchedule offset. */
+            public offset: string;
 
             /**
-             * Creates a new LaunchPlanMetadata instance using the specified properties.
+             * Creates a new CronSchedule instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns LaunchPlanMetadata instance
+             * @returns CronSchedule instance
              */
-            public static create(properties?: flyteidl.admin.ILaunchPlanMetadata): flyteidl.admin.LaunchPlanMetadata;
+            public static create(properties?: flyteidl.admin.ICronSchedule): flyteidl.admin.CronSchedule;
 
             /**
-             * Encodes the specified LaunchPlanMetadata message. Does not implicitly {@link flyteidl.admin.LaunchPlanMetadata.verify|verify} messages.
-             * @param message LaunchPlanMetadata message or plain object to encode
+             * Encodes the specified CronSchedule message. Does not implicitly {@link flyteidl.admin.CronSchedule.verify|verify} messages.
+             * @param message CronSchedule message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ILaunchPlanMetadata, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ICronSchedule, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a LaunchPlanMetadata message from the specified reader or buffer.
+             * Decodes a CronSchedule message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns LaunchPlanMetadata
+             * @returns CronSchedule
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanMetadata;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.CronSchedule;
 
             /**
-             * Verifies a LaunchPlanMetadata message.
+             * Verifies a CronSchedule message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a LaunchPlanUpdateRequest. */
-        interface ILaunchPlanUpdateRequest {
+        /** Properties of a Schedule. */
+        interface ISchedule {
 
-            /** LaunchPlanUpdateRequest id */
-            id?: (flyteidl.core.IIdentifier|null);
+            /** Schedule cronExpression */
+            cronExpression?: (string|null);
 
-            /** LaunchPlanUpdateRequest state */
-            state?: (flyteidl.admin.LaunchPlanState|null);
+            /** Schedule rate */
+            rate?: (flyteidl.admin.IFixedRate|null);
+
+            /** Schedule cronSchedule */
+            cronSchedule?: (flyteidl.admin.ICronSchedule|null);
+
+            /** Schedule kickoffTimeInputArg */
+            kickoffTimeInputArg?: (string|null);
         }
 
-        /** Represents a LaunchPlanUpdateRequest. */
-        class LaunchPlanUpdateRequest implements ILaunchPlanUpdateRequest {
+        /** Represents a Schedule. */
+        class Schedule implements ISchedule {
 
             /**
-             * Constructs a new LaunchPlanUpdateRequest.
+             * Constructs a new Schedule.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ILaunchPlanUpdateRequest);
+            constructor(properties?: flyteidl.admin.ISchedule);
 
-            /** LaunchPlanUpdateRequest id. */
-            public id?: (flyteidl.core.IIdentifier|null);
+            /** Schedule cronExpression. */
+            public cronExpression: string;
 
-            /** LaunchPlanUpdateRequest state. */
-            public state: flyteidl.admin.LaunchPlanState;
+            /** Schedule rate. */
+            public rate?: (flyteidl.admin.IFixedRate|null);
+
+            /** Schedule cronSchedule. */
+            public cronSchedule?: (flyteidl.admin.ICronSchedule|null);
+
+            /** Schedule kickoffTimeInputArg. */
+            public kickoffTimeInputArg: string;
+
+            /** Schedule ScheduleExpression. */
+            public ScheduleExpression?: ("cronExpression"|"rate"|"cronSchedule");
 
             /**
-             * Creates a new LaunchPlanUpdateRequest instance using the specified properties.
+             * Creates a new Schedule instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns LaunchPlanUpdateRequest instance
+             * @returns Schedule instance
              */
-            public static create(properties?: flyteidl.admin.ILaunchPlanUpdateRequest): flyteidl.admin.LaunchPlanUpdateRequest;
+            public static create(properties?: flyteidl.admin.ISchedule): flyteidl.admin.Schedule;
 
             /**
-             * Encodes the specified LaunchPlanUpdateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateRequest.verify|verify} messages.
-             * @param message LaunchPlanUpdateRequest message or plain object to encode
+             * Encodes the specified Schedule message. Does not implicitly {@link flyteidl.admin.Schedule.verify|verify} messages.
+             * @param message Schedule message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ILaunchPlanUpdateRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ISchedule, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a LaunchPlanUpdateRequest message from the specified reader or buffer.
+             * Decodes a Schedule message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns LaunchPlanUpdateRequest
+             * @returns Schedule
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanUpdateRequest;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.Schedule;
 
             /**
-             * Verifies a LaunchPlanUpdateRequest message.
+             * Verifies a Schedule message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a LaunchPlanUpdateResponse. */
-        interface ILaunchPlanUpdateResponse {
+        /** Properties of a Trigger. */
+        interface ITrigger {
+
+            /** Trigger name */
+            name?: (string|null);
+
+            /** Trigger storage */
+            storage?: (flyteidl.admin.IStorageEventTrigger|null);
+
+            /** Trigger webhook */
+            webhook?: (flyteidl.admin.IWebhookTrigger|null);
+
+            /** Trigger cloudEvent */
+            cloudEvent?: (flyteidl.admin.ICloudEventTrigger|null);
+
+            /** Trigger inputs */
+            inputs?: ({ [k: string]: string }|null);
+
+            /** Trigger maxFiringsPerHour */
+            maxFiringsPerHour?: (number|null);
         }
 
-        /** Represents a LaunchPlanUpdateResponse. */
-        class LaunchPlanUpdateResponse implements ILaunchPlanUpdateResponse {
+        /** Represents a Trigger. */
+        class Trigger implements ITrigger {
 
             /**
-             * Constructs a new LaunchPlanUpdateResponse.
+             * Constructs a new Trigger.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ILaunchPlanUpdateResponse);
+            constructor(properties?: flyteidl.admin.ITrigger);
+
+            /** Trigger name. */
+            public name: string;
+
+            /** Trigger storage. */
+            public storage?: (flyteidl.admin.IStorageEventTrigger|null);
+
+            /** Trigger webhook. */
+            public webhook?: (flyteidl.admin.IWebhookTrigger|null);
+
+            /** Trigger cloudEvent. */
+            public cloudEvent?: (flyteidl.admin.ICloudEventTrigger|null);
+
+            /** Trigger inputs. */
+            public inputs: { [k: string]: string };
+
+            /** Trigger maxFiringsPerHour. */
+            public maxFiringsPerHour: number;
+
+            /** Trigger event. */
+            public event?: ("storage"|"webhook"|"cloudEvent");
 
             /**
-             * Creates a new LaunchPlanUpdateResponse instance using the specified properties.
+             * Creates a new Trigger instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns LaunchPlanUpdateResponse instance
+             * @returns Trigger instance
              */
-            public static create(properties?: flyteidl.admin.ILaunchPlanUpdateResponse): flyteidl.admin.LaunchPlanUpdateResponse;
+            public static create(properties?: flyteidl.admin.ITrigger): flyteidl.admin.Trigger;
 
             /**
-             * Encodes the specified LaunchPlanUpdateResponse message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateResponse.verify|verify} messages.
-             * @param message LaunchPlanUpdateResponse message or plain object to encode
+             * Encodes the specified Trigger message. Does not implicitly {@link flyteidl.admin.Trigger.verify|verify} messages.
+             * @param message Trigger message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ILaunchPlanUpdateResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ITrigger, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a LaunchPlanUpdateResponse message from the specified reader or buffer.
+             * Decodes a Trigger message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns LaunchPlanUpdateResponse
+             * @returns Trigger
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.LaunchPlanUpdateResponse;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.Trigger;
 
             /**
-             * Verifies a LaunchPlanUpdateResponse message.
+             * Verifies a Trigger message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of an ActiveLaunchPlanRequest. */
-        interface IActiveLaunchPlanRequest {
+        /** Properties of a StorageEventTrigger. */
+        interface IStorageEventTrigger {
 
-            /** ActiveLaunchPlanRequest id */
-            id?: (flyteidl.admin.INamedEntityIdentifier|null);
+            /** StorageEventTrigger uriPattern */
+            uriPattern?: (string|null);
         }
 
-        /** Represents an ActiveLaunchPlanRequest. */
-        class ActiveLaunchPlanRequest implements IActiveLaunchPlanRequest {
+        /** Represents a StorageEventTrigger. */
+        class StorageEventTrigger implements IStorageEventTrigger {
 
             /**
-             * Constructs a new ActiveLaunchPlanRequest.
+             * Constructs a new StorageEventTrigger.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IActiveLaunchPlanRequest);
+            constructor(properties?: flyteidl.admin.IStorageEventTrigger);
 
-            /** ActiveLaunchPlanRequest id. */
-            public id?: (flyteidl.admin.INamedEntityIdentifier|null);
+            /** StorageEventTrigger uriPattern. */
+            public uriPattern: string;
 
             /**
-             * Creates a new ActiveLaunchPlanRequest instance using the specified properties.
+             * Creates a new StorageEventTrigger instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ActiveLaunchPlanRequest instance
+             * @returns StorageEventTrigger instance
              */
-            public static create(properties?: flyteidl.admin.IActiveLaunchPlanRequest): flyteidl.admin.ActiveLaunchPlanRequest;
+            public static create(properties?: flyteidl.admin.IStorageEventTrigger): flyteidl.admin.StorageEventTrigger;
 
             /**
-             * Encodes the specified ActiveLaunchPlanRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanRequest.verify|verify} messages.
-             * @param message ActiveLaunchPlanRequest message or plain object to encode
+             * Encodes the specified StorageEventTrigger message. Does not implicitly {@link flyteidl.admin.StorageEventTrigger.verify|verify} messages.
+             * @param message StorageEventTrigger message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IActiveLaunchPlanRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IStorageEventTrigger, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes an ActiveLaunchPlanRequest message from the specified reader or buffer.
+             * Decodes a StorageEventTrigger message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ActiveLaunchPlanRequest
+             * @returns StorageEventTrigger
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ActiveLaunchPlanRequest;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.StorageEventTrigger;
 
             /**
-             * Verifies an ActiveLaunchPlanRequest message.
+             * Verifies a StorageEventTrigger message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of an ActiveLaunchPlanListRequest. */
-        interface IActiveLaunchPlanListRequest {
-
-            /** ActiveLaunchPlanListRequest project */
-            project?: (string|null);
-
-            /** ActiveLaunchPlanListRequest domain */
-            domain?: (string|null);
-
-            /** ActiveLaunchPlanListRequest limit */
-            limit?: (number|null);
-
-            /** ActiveLaunchPlanListRequest token */
-            token?: (string|null);
-
-            /** ActiveLaunchPlanListRequest sortBy */
-            sortBy?: (flyteidl.admin.ISort|null);
+        /** Properties of a WebhookTrigger. */
+        interface IWebhookTrigger {
 
-            /** ActiveLaunchPlanListRequest org */
-            org?: (string|null);
+            /** WebhookTrigger tokenSha256 */
+            tokenSha256?: (string|null);
         }
 
-        /** Represents an ActiveLaunchPlanListRequest. */
-        class ActiveLaunchPlanListRequest implements IActiveLaunchPlanListRequest {
+        /** Represents a WebhookTrigger. */
+        class WebhookTrigger implements IWebhookTrigger {
 
             /**
-             * Constructs a new ActiveLaunchPlanListRequest.
+             * Constructs a new WebhookTrigger.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IActiveLaunchPlanListRequest);
-
-            /** ActiveLaunchPlanListRequest project. */
-            public project: string;
-
-            /** ActiveLaunchPlanListRequest domain. */
-            public domain: string;
-
-            /** ActiveLaunchPlanListRequest limit. */
-            public limit: number;
-
-            /** ActiveLaunchPlanListRequest token. */
-            public token: string;
+            constructor(properties?: flyteidl.admin.IWebhookTrigger);
 
-            /** ActiveLaunchPlanListRequest sortBy. */
-            public sortBy?: (flyteidl.admin.ISort|null);
-
-            /** ActiveLaunchPlanListRequest org. */
-            public org: string;
+            /** WebhookTrigger tokenSha256. */
+            public tokenSha256: string;
 
             /**
-             * Creates a new ActiveLaunchPlanListRequest instance using the specified properties.
+             * Creates a new WebhookTrigger instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ActiveLaunchPlanListRequest instance
+             * @returns WebhookTrigger instance
              */
-            public static create(properties?: flyteidl.admin.IActiveLaunchPlanListRequest): flyteidl.admin.ActiveLaunchPlanListRequest;
+            public static create(properties?: flyteidl.admin.IWebhookTrigger): flyteidl.admin.WebhookTrigger;
 
             /**
-             * Encodes the specified ActiveLaunchPlanListRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanListRequest.verify|verify} messages.
-             * @param message ActiveLaunchPlanListRequest message or plain object to encode
+             * Encodes the specified WebhookTrigger message. Does not implicitly {@link flyteidl.admin.WebhookTrigger.verify|verify} messages.
+             * @param message WebhookTrigger message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IActiveLaunchPlanListRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWebhookTrigger, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes an ActiveLaunchPlanListRequest message from the specified reader or buffer.
+             * Decodes a WebhookTrigger message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ActiveLaunchPlanListRequest
+             * @returns WebhookTrigger
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ActiveLaunchPlanListRequest;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WebhookTrigger;
 
             /**
-             * Verifies an ActiveLaunchPlanListRequest message.
+             * Verifies a WebhookTrigger message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** FixedRateUnit enum. */
-        enum FixedRateUnit {
-            MINUTE = 0,
-            HOUR = 1,
-            DAY = 2
-        }
-
-        /** Properties of a FixedRate. */
-        interface IFixedRate {
+        /** Properties of a CloudEventTrigger. */
+        interface ICloudEventTrigger {
 
-            /** FixedRate value */
-            value?: (number|null);
+            /** CloudEventTrigger type */
+            type?: (string|null);
 
-            /** FixedRate unit */
-            unit?: (flyteidl.admin.FixedRateUnit|null);
+            /** CloudEventTrigger source */
+            source?: (string|null);
         }
 
-        /** Represents a FixedRate. */
-        class FixedRate implements IFixedRate {
+        /** Represents a CloudEventTrigger. */
+        class CloudEventTrigger implements ICloudEventTrigger {
 
             /**
-             * Constructs a new FixedRate.
+             * Constructs a new CloudEventTrigger.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IFixedRate);
+            constructor(properties?: flyteidl.admin.ICloudEventTrigger);
 
-            /** FixedRate value. */
-            public value: number;
+            /** CloudEventTrigger type. */
+            public type: string;
 
-            /** FixedRate unit. */
-            public unit: flyteidl.admin.FixedRateUnit;
+            /** CloudEventTrigger source. */
+            public source: string;
 
             /**
-             * Creates a new FixedRate instance using the specified properties.
+             * Creates a new CloudEventTrigger instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns FixedRate instance
+             * @returns CloudEventTrigger instance
              */
-            public static create(properties?: flyteidl.admin.IFixedRate): flyteidl.admin.FixedRate;
+            public static create(properties?: flyteidl.admin.ICloudEventTrigger): flyteidl.admin.CloudEventTrigger;
 
-            /**
-             * Encodes the specified FixedRate message. Does not implicitly {@link flyteidl.admin.FixedRate.verify|verify} messages.
-             * @param message FixedRate message or plain object to encode
+            /**
+             * Encodes the specified CloudEventTrigger message. Does not implicitly {@link flyteidl.admin.CloudEventTrigger.verify|verify} messages.
+             * @param message CloudEventTrigger message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IFixedRate, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ICloudEventTrigger, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a FixedRate message from the specified reader or buffer.
+             * Decodes a CloudEventTrigger message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns FixedRate
+             * @returns CloudEventTrigger
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.FixedRate;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.CloudEventTrigger;
 
             /**
-             * Verifies a FixedRate message.
+             * Verifies a CloudEventTrigger message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a CronSchedule. */
-        interface ICronSchedule {
+        /** TriggerFiringOutcome enum. */
+        enum TriggerFiringOutcome {
+            TRIGGER_FIRING_OUTCOME_UNSPECIFIED = 0,
+            FIRED = 1,
+            DUPLICATE = 2,
+            RATE_LIMITED = 3,
+            FAILED = 4
+        }
 
-            /** CronSchedule schedule */
-            schedule?: (string|null);
+        /** Properties of a TriggerFiring. */
+        interface ITriggerFiring {
 
-            /** CronSchedule offset */
-            offset?: (string|null);
+            /** TriggerFiring launchPlan */
+            launchPlan?: (flyteidl.core.IIdentifier|null);
+
+            /** TriggerFiring triggerName */
+            triggerName?: (string|null);
+
+            /** TriggerFiring eventId */
+            eventId?: (string|null);
+
+            /** TriggerFiring outcome */
+            outcome?: (flyteidl.admin.TriggerFiringOutcome|null);
+
+            /** TriggerFiring executionId */
+            executionId?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** TriggerFiring message */
+            message?: (string|null);
+
+            /** TriggerFiring receivedAt */
+            receivedAt?: (google.protobuf.ITimestamp|null);
         }
 
-        /** Represents a CronSchedule. */
-        class CronSchedule implements ICronSchedule {
+        /** Represents a TriggerFiring. */
+        class TriggerFiring implements ITriggerFiring {
 
             /**
-             * Constructs a new CronSchedule.
+             * Constructs a new TriggerFiring.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ICronSchedule);
+            constructor(properties?: flyteidl.admin.ITriggerFiring);
 
-            /** CronSchedule schedule. */
-            public schedule: string;
+            /** TriggerFiring launchPlan. */
+            public launchPlan?: (flyteidl.core.IIdentifier|null);
 
-            /** CronSchedule offset. */
-            public offset: string;
+            /** TriggerFiring triggerName. */
+            public triggerName: string;
+
+            /** TriggerFiring eventId. */
+            public eventId: string;
+
+            /** TriggerFiring outcome. */
+            public outcome: flyteidl.admin.TriggerFiringOutcome;
+
+            /** TriggerFiring executionId. */
+            public executionId?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** TriggerFiring message. */
+            public message: string;
+
+            /** TriggerFiring receivedAt. */
+            public receivedAt?: (google.protobuf.ITimestamp|null);
 
             /**
-             * Creates a new CronSchedule instance using the specified properties.
+             * Creates a new TriggerFiring instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns CronSchedule instance
+             * @returns TriggerFiring instance
              */
-            public static create(properties?: flyteidl.admin.ICronSchedule): flyteidl.admin.CronSchedule;
+            public static create(properties?: flyteidl.admin.ITriggerFiring): flyteidl.admin.TriggerFiring;
 
             /**
-             * Encodes the specified CronSchedule message. Does not implicitly {@link flyteidl.admin.CronSchedule.verify|verify} messages.
-             * @param message CronSchedule message or plain object to encode
+             * Encodes the specified TriggerFiring message. Does not implicitly {@link flyteidl.admin.TriggerFiring.verify|verify} messages.
+             * @param message TriggerFiring message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ICronSchedule, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ITriggerFiring, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a CronSchedule message from the specified reader or buffer.
+             * Decodes a TriggerFiring message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns CronSchedule
+             * @returns TriggerFiring
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.CronSchedule;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TriggerFiring;
 
             /**
-             * Verifies a CronSchedule message.
+             * Verifies a TriggerFiring message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a Schedule. */
-        interface ISchedule {
-
-            /** Schedule cronExpression */
-            cronExpression?: (string|null);
-
-            /** Schedule rate */
-            rate?: (flyteidl.admin.IFixedRate|null);
+        /** Properties of a TriggerFiringList. */
+        interface ITriggerFiringList {
 
-            /** Schedule cronSchedule */
-            cronSchedule?: (flyteidl.admin.ICronSchedule|null);
+            /** TriggerFiringList firings */
+            firings?: (flyteidl.admin.ITriggerFiring[]|null);
 
-            /** Schedule kickoffTimeInputArg */
-            kickoffTimeInputArg?: (string|null);
+            /** TriggerFiringList token */
+            token?: (string|null);
         }
 
-        /** Represents a Schedule. */
-        class Schedule implements ISchedule {
+        /** Represents a TriggerFiringList. */
+        class TriggerFiringList implements ITriggerFiringList {
 
             /**
-             * Constructs a new Schedule.
+             * Constructs a new TriggerFiringList.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.ISchedule);
-
-            /** Schedule cronExpression. */
-            public cronExpression: string;
-
-            /** Schedule rate. */
-            public rate?: (flyteidl.admin.IFixedRate|null);
-
-            /** Schedule cronSchedule. */
-            public cronSchedule?: (flyteidl.admin.ICronSchedule|null);
+            constructor(properties?: flyteidl.admin.ITriggerFiringList);
 
-            /** Schedule kickoffTimeInputArg. */
-            public kickoffTimeInputArg: string;
+            /** TriggerFiringList firings. */
+            public firings: flyteidl.admin.ITriggerFiring[];
 
-            /** Schedule ScheduleExpression. */
-            public ScheduleExpression?: ("cronExpression"|"rate"|"cronSchedule");
+            /** TriggerFiringList token. */
+            public token: string;
 
             /**
-             * Creates a new Schedule instance using the specified properties.
+             * Creates a new TriggerFiringList instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns Schedule instance
+             * @returns TriggerFiringList instance
              */
-            public static create(properties?: flyteidl.admin.ISchedule): flyteidl.admin.Schedule;
+            public static create(properties?: flyteidl.admin.ITriggerFiringList): flyteidl.admin.TriggerFiringList;
 
             /**
-             * Encodes the specified Schedule message. Does not implicitly {@link flyteidl.admin.Schedule.verify|verify} messages.
-             * @param message Schedule message or plain object to encode
+             * Encodes the specified TriggerFiringList message. Does not implicitly {@link flyteidl.admin.TriggerFiringList.verify|verify} messages.
+             * @param message TriggerFiringList message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.ISchedule, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.ITriggerFiringList, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a Schedule message from the specified reader or buffer.
+             * Decodes a TriggerFiringList message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns Schedule
+             * @returns TriggerFiringList
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.Schedule;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.TriggerFiringList;
 
             /**
-             * Verifies a Schedule message.
+             * Verifies a TriggerFiringList message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
@@ -19693,152 +20709,350 @@ export namespace flyteidl {
              */
             constructor(properties?: flyteidl.admin.IProjectDomainAttributesGetResponse);
 
-            /** ProjectDomainAttributesGetResponse attributes. */
-            public attributes?: (flyteidl.admin.IProjectDomainAttributes|null);
+            /** ProjectDomainAttributesGetResponse attributes. */
+            public attributes?: (flyteidl.admin.IProjectDomainAttributes|null);
+
+            /**
+             * Creates a new ProjectDomainAttributesGetResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ProjectDomainAttributesGetResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IProjectDomainAttributesGetResponse): flyteidl.admin.ProjectDomainAttributesGetResponse;
+
+            /**
+             * Encodes the specified ProjectDomainAttributesGetResponse message. Does not implicitly {@link flyteidl.admin.ProjectDomainAttributesGetResponse.verify|verify} messages.
+             * @param message ProjectDomainAttributesGetResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IProjectDomainAttributesGetResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ProjectDomainAttributesGetResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ProjectDomainAttributesGetResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainAttributesGetResponse;
+
+            /**
+             * Verifies a ProjectDomainAttributesGetResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a ProjectDomainAttributesDeleteRequest. */
+        interface IProjectDomainAttributesDeleteRequest {
+
+            /** ProjectDomainAttributesDeleteRequest project */
+            project?: (string|null);
+
+            /** ProjectDomainAttributesDeleteRequest domain */
+            domain?: (string|null);
+
+            /** ProjectDomainAttributesDeleteRequest resourceType */
+            resourceType?: (flyteidl.admin.MatchableResource|null);
+
+            /** ProjectDomainAttributesDeleteRequest org */
+            org?: (string|null);
+        }
+
+        /** Represents a ProjectDomainAttributesDeleteRequest. */
+        class ProjectDomainAttributesDeleteRequest implements IProjectDomainAttributesDeleteRequest {
+
+            /**
+             * Constructs a new ProjectDomainAttributesDeleteRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IProjectDomainAttributesDeleteRequest);
+
+            /** ProjectDomainAttributesDeleteRequest project. */
+            public project: string;
+
+            /** ProjectDomainAttributesDeleteRequest domain. */
+            public domain: string;
+
+            /** ProjectDomainAttributesDeleteRequest resourceType. */
+            public resourceType: flyteidl.admin.MatchableResource;
+
+            /** ProjectDomainAttributesDeleteRequest org. */
+            public org: string;
+
+            /**
+             * Creates a new ProjectDomainAttributesDeleteRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ProjectDomainAttributesDeleteRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IProjectDomainAttributesDeleteRequest): flyteidl.admin.ProjectDomainAttributesDeleteRequest;
+
+            /**
+             * Encodes the specified ProjectDomainAttributesDeleteRequest message. Does not implicitly {@link flyteidl.admin.ProjectDomainAttributesDeleteRequest.verify|verify} messages.
+             * @param message ProjectDomainAttributesDeleteRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IProjectDomainAttributesDeleteRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ProjectDomainAttributesDeleteRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ProjectDomainAttributesDeleteRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainAttributesDeleteRequest;
+
+            /**
+             * Verifies a ProjectDomainAttributesDeleteRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a ProjectDomainAttributesDeleteResponse. */
+        interface IProjectDomainAttributesDeleteResponse {
+        }
+
+        /** Represents a ProjectDomainAttributesDeleteResponse. */
+        class ProjectDomainAttributesDeleteResponse implements IProjectDomainAttributesDeleteResponse {
+
+            /**
+             * Constructs a new ProjectDomainAttributesDeleteResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IProjectDomainAttributesDeleteResponse);
+
+            /**
+             * Creates a new ProjectDomainAttributesDeleteResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ProjectDomainAttributesDeleteResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IProjectDomainAttributesDeleteResponse): flyteidl.admin.ProjectDomainAttributesDeleteResponse;
+
+            /**
+             * Encodes the specified ProjectDomainAttributesDeleteResponse message. Does not implicitly {@link flyteidl.admin.ProjectDomainAttributesDeleteResponse.verify|verify} messages.
+             * @param message ProjectDomainAttributesDeleteResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IProjectDomainAttributesDeleteResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ProjectDomainAttributesDeleteResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ProjectDomainAttributesDeleteResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainAttributesDeleteResponse;
+
+            /**
+             * Verifies a ProjectDomainAttributesDeleteResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a WorkflowPromoteRequest. */
+        interface IWorkflowPromoteRequest {
+
+            /** WorkflowPromoteRequest id */
+            id?: (flyteidl.core.IIdentifier|null);
+
+            /** WorkflowPromoteRequest targetProject */
+            targetProject?: (string|null);
+
+            /** WorkflowPromoteRequest targetDomain */
+            targetDomain?: (string|null);
+
+            /** WorkflowPromoteRequest activateLaunchPlans */
+            activateLaunchPlans?: (boolean|null);
+        }
+
+        /** Represents a WorkflowPromoteRequest. */
+        class WorkflowPromoteRequest implements IWorkflowPromoteRequest {
+
+            /**
+             * Constructs a new WorkflowPromoteRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IWorkflowPromoteRequest);
+
+            /** WorkflowPromoteRequest id. */
+            public id?: (flyteidl.core.IIdentifier|null);
+
+            /** WorkflowPromoteRequest targetProject. */
+            public targetProject: string;
+
+            /** WorkflowPromoteRequest targetDomain. */
+            public targetDomain: string;
+
+            /** WorkflowPromoteRequest activateLaunchPlans. */
+            public activateLaunchPlans: boolean;
 
             /**
-             * Creates a new ProjectDomainAttributesGetResponse instance using the specified properties.
+             * Creates a new WorkflowPromoteRequest instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ProjectDomainAttributesGetResponse instance
+             * @returns WorkflowPromoteRequest instance
              */
-            public static create(properties?: flyteidl.admin.IProjectDomainAttributesGetResponse): flyteidl.admin.ProjectDomainAttributesGetResponse;
+            public static create(properties?: flyteidl.admin.IWorkflowPromoteRequest): flyteidl.admin.WorkflowPromoteRequest;
 
             /**
-             * Encodes the specified ProjectDomainAttributesGetResponse message. Does not implicitly {@link flyteidl.admin.ProjectDomainAttributesGetResponse.verify|verify} messages.
-             * @param message ProjectDomainAttributesGetResponse message or plain object to encode
+             * Encodes the specified WorkflowPromoteRequest message. Does not implicitly {@link flyteidl.admin.WorkflowPromoteRequest.verify|verify} messages.
+             * @param message WorkflowPromoteRequest message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IProjectDomainAttributesGetResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWorkflowPromoteRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a ProjectDomainAttributesGetResponse message from the specified reader or buffer.
+             * Decodes a WorkflowPromoteRequest message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ProjectDomainAttributesGetResponse
+             * @returns WorkflowPromoteRequest
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainAttributesGetResponse;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowPromoteRequest;
 
             /**
-             * Verifies a ProjectDomainAttributesGetResponse message.
+             * Verifies a WorkflowPromoteRequest message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a ProjectDomainAttributesDeleteRequest. */
-        interface IProjectDomainAttributesDeleteRequest {
+        /** Properties of a Promotion. */
+        interface IPromotion {
 
-            /** ProjectDomainAttributesDeleteRequest project */
-            project?: (string|null);
+            /** Promotion source */
+            source?: (flyteidl.core.IIdentifier|null);
 
-            /** ProjectDomainAttributesDeleteRequest domain */
-            domain?: (string|null);
+            /** Promotion target */
+            target?: (flyteidl.core.IIdentifier|null);
 
-            /** ProjectDomainAttributesDeleteRequest resourceType */
-            resourceType?: (flyteidl.admin.MatchableResource|null);
+            /** Promotion promotedBy */
+            promotedBy?: (string|null);
 
-            /** ProjectDomainAttributesDeleteRequest org */
-            org?: (string|null);
+            /** Promotion promotedAt */
+            promotedAt?: (google.protobuf.ITimestamp|null);
+
+            /** Promotion existed */
+            existed?: (boolean|null);
         }
 
-        /** Represents a ProjectDomainAttributesDeleteRequest. */
-        class ProjectDomainAttributesDeleteRequest implements IProjectDomainAttributesDeleteRequest {
+        /** Represents a Promotion. */
+        class Promotion implements IPromotion {
 
             /**
-             * Constructs a new ProjectDomainAttributesDeleteRequest.
+             * Constructs a new Promotion.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IProjectDomainAttributesDeleteRequest);
+            constructor(properties?: flyteidl.admin.IPromotion);
 
-            /** ProjectDomainAttributesDeleteRequest project. */
-            public project: string;
+            /** Promotion source. */
+            public source?: (flyteidl.core.IIdentifier|null);
 
-            /** ProjectDomainAttributesDeleteRequest domain. */
-            public domain: string;
+            /** Promotion target. */
+            public target?: (flyteidl.core.IIdentifier|null);
 
-            /** ProjectDomainAttributesDeleteRequest resourceType. */
-            public resourceType: flyteidl.admin.MatchableResource;
+            /** Promotion promotedBy. */
+            public promotedBy: string;
 
-            /** ProjectDomainAttributesDeleteRequest org. */
-            public org: string;
+            /** Promotion promotedAt. */
+            public promotedAt?: (google.protobuf.ITimestamp|null);
+
+            /** Promotion existed. */
+            public existed: boolean;
 
             /**
-             * Creates a new ProjectDomainAttributesDeleteRequest instance using the specified properties.
+             * Creates a new Promotion instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ProjectDomainAttributesDeleteRequest instance
+             * @returns Promotion instance
              */
-            public static create(properties?: flyteidl.admin.IProjectDomainAttributesDeleteRequest): flyteidl.admin.ProjectDomainAttributesDeleteRequest;
+            public static create(properties?: flyteidl.admin.IPromotion): flyteidl.admin.Promotion;
 
             /**
-             * Encodes the specified ProjectDomainAttributesDeleteRequest message. Does not implicitly {@link flyteidl.admin.ProjectDomainAttributesDeleteRequest.verify|verify} messages.
-             * @param message ProjectDomainAttributesDeleteRequest message or plain object to encode
+             * Encodes the specified Promotion message. Does not implicitly {@link flyteidl.admin.Promotion.verify|verify} messages.
+             * @param message Promotion message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IProjectDomainAttributesDeleteRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IPromotion, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a ProjectDomainAttributesDeleteRequest message from the specified reader or buffer.
+             * Decodes a Promotion message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ProjectDomainAttributesDeleteRequest
+             * @returns Promotion
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainAttributesDeleteRequest;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.Promotion;
 
             /**
-             * Verifies a ProjectDomainAttributesDeleteRequest message.
+             * Verifies a Promotion message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
-        /** Properties of a ProjectDomainAttributesDeleteResponse. */
-        interface IProjectDomainAttributesDeleteResponse {
+        /** Properties of a WorkflowPromoteResponse. */
+        interface IWorkflowPromoteResponse {
+
+            /** WorkflowPromoteResponse promotions */
+            promotions?: (flyteidl.admin.IPromotion[]|null);
         }
 
-        /** Represents a ProjectDomainAttributesDeleteResponse. */
-        class ProjectDomainAttributesDeleteResponse implements IProjectDomainAttributesDeleteResponse {
+        /** Represents a WorkflowPromoteResponse. */
+        class WorkflowPromoteResponse implements IWorkflowPromoteResponse {
 
             /**
-             * Constructs a new ProjectDomainAttributesDeleteResponse.
+             * Constructs a new WorkflowPromoteResponse.
              * @param [properties] Properties to set
              */
-            constructor(properties?: flyteidl.admin.IProjectDomainAttributesDeleteResponse);
+            constructor(properties?: flyteidl.admin.IWorkflowPromoteResponse);
+
+            /** WorkflowPromoteResponse promotions. */
+            public promotions: flyteidl.admin.IPromotion[];
 
             /**
-             * Creates a new ProjectDomainAttributesDeleteResponse instance using the specified properties.
+             * Creates a new WorkflowPromoteResponse instance using the specified properties.
              * @param [properties] Properties to set
-             * @returns ProjectDomainAttributesDeleteResponse instance
+             * @returns WorkflowPromoteResponse instance
              */
-            public static create(properties?: flyteidl.admin.IProjectDomainAttributesDeleteResponse): flyteidl.admin.ProjectDomainAttributesDeleteResponse;
+            public static create(properties?: flyteidl.admin.IWorkflowPromoteResponse): flyteidl.admin.WorkflowPromoteResponse;
 
             /**
-             * Encodes the specified ProjectDomainAttributesDeleteResponse message. Does not implicitly {@link flyteidl.admin.ProjectDomainAttributesDeleteResponse.verify|verify} messages.
-             * @param message ProjectDomainAttributesDeleteResponse message or plain object to encode
+             * Encodes the specified WorkflowPromoteResponse message. Does not implicitly {@link flyteidl.admin.WorkflowPromoteResponse.verify|verify} messages.
+             * @param message WorkflowPromoteResponse message or plain object to encode
              * @param [writer] Writer to encode to
              * @returns Writer
              */
-            public static encode(message: flyteidl.admin.IProjectDomainAttributesDeleteResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+            public static encode(message: flyteidl.admin.IWorkflowPromoteResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
             /**
-             * Decodes a ProjectDomainAttributesDeleteResponse message from the specified reader or buffer.
+             * Decodes a WorkflowPromoteResponse message from the specified reader or buffer.
              * @param reader Reader or buffer to decode from
              * @param [length] Message length if known beforehand
-             * @returns ProjectDomainAttributesDeleteResponse
+             * @returns WorkflowPromoteResponse
              * @throws {Error} If the payload is not a reader or valid buffer
              * @throws {$protobuf.util.ProtocolError} If required fields are missing
              */
-            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainAttributesDeleteResponse;
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowPromoteResponse;
 
             /**
-             * Verifies a ProjectDomainAttributesDeleteResponse message.
+             * Verifies a WorkflowPromoteResponse message.
              * @param message Plain object to verify
              * @returns `null` if valid, otherwise the reason why it is not
              */
@@ -22369,6 +23583,34 @@ export namespace flyteidl {
              */
             public listWorkflows(request: flyteidl.admin.IResourceListRequest): Promise<flyteidl.admin.WorkflowList>;
 
+            /**
+             * Calls PromoteWorkflow.
+             * @param request WorkflowPromoteRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and WorkflowPromoteResponse
+             */
+            public promoteWorkflow(request: flyteidl.admin.IWorkflowPromoteRequest, callback: flyteidl.service.AdminService.PromoteWorkflowCallback): void;
+
+            /**
+             * Calls PromoteWorkflow.
+             * @param request WorkflowPromoteRequest message or plain object
+             * @returns Promise
+             */
+            public promoteWorkflow(request: flyteidl.admin.IWorkflowPromoteRequest): Promise<flyteidl.admin.WorkflowPromoteResponse>;
+
+            /**
+             * Calls GetPromotion.
+             * @param request ObjectGetRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and Promotion
+             */
+            public getPromotion(request: flyteidl.admin.IObjectGetRequest, callback: flyteidl.service.AdminService.GetPromotionCallback): void;
+
+            /**
+             * Calls GetPromotion.
+             * @param request ObjectGetRequest message or plain object
+             * @returns Promise
+             */
+            public getPromotion(request: flyteidl.admin.IObjectGetRequest): Promise<flyteidl.admin.Promotion>;
+
             /**
              * Calls CreateLaunchPlan.
              * @param request LaunchPlanCreateRequest message or plain object
@@ -22453,6 +23695,20 @@ export namespace flyteidl {
              */
             public listLaunchPlans(request: flyteidl.admin.IResourceListRequest): Promise<flyteidl.admin.LaunchPlanList>;
 
+            /**
+             * Calls ListTriggerFirings.
+             * @param request ResourceListRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and TriggerFiringList
+             */
+            public listTriggerFirings(request: flyteidl.admin.IResourceListRequest, callback: flyteidl.service.AdminService.ListTriggerFiringsCallback): void;
+
+            /**
+             * Calls ListTriggerFirings.
+             * @param request ResourceListRequest message or plain object
+             * @returns Promise
+             */
+            public listTriggerFirings(request: flyteidl.admin.IResourceListRequest): Promise<flyteidl.admin.TriggerFiringList>;
+
             /**
              * Calls UpdateLaunchPlan.
              * @param request LaunchPlanUpdateRequest message or plain object
@@ -22579,6 +23835,34 @@ export namespace flyteidl {
              */
             public terminateExecution(request: flyteidl.admin.IExecutionTerminateRequest): Promise<flyteidl.admin.ExecutionTerminateResponse>;
 
+            /**
+             * Calls PauseExecution.
+             * @param request ExecutionPauseRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and ExecutionPauseResponse
+             */
+            public pauseExecution(request: flyteidl.admin.IExecutionPauseRequest, callback: flyteidl.service.AdminService.PauseExecutionCallback): void;
+
+            /**
+             * Calls PauseExecution.
+             * @param request ExecutionPauseRequest message or plain object
+             * @returns Promise
+             */
+            public pauseExecution(request: flyteidl.admin.IExecutionPauseRequest): Promise<flyteidl.admin.ExecutionPauseResponse>;
+
+            /**
+             * Calls ResumeExecution.
+             * @param request ExecutionResumeRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and ExecutionResumeResponse
+             */
+            public resumeExecution(request: flyteidl.admin.IExecutionResumeRequest, callback: flyteidl.service.AdminService.ResumeExecutionCallback): void;
+
+            /**
+             * Calls ResumeExecution.
+             * @param request ExecutionResumeRequest message or plain object
+             * @returns Promise
+             */
+            public resumeExecution(request: flyteidl.admin.IExecutionResumeRequest): Promise<flyteidl.admin.ExecutionResumeResponse>;
+
             /**
              * Calls GetNodeExecution.
              * @param request NodeExecutionGetRequest message or plain object
@@ -23040,6 +24324,20 @@ export namespace flyteidl {
              * @returns Promise
              */
             public getExecutionMetrics(request: flyteidl.admin.IWorkflowExecutionGetMetricsRequest): Promise<flyteidl.admin.WorkflowExecutionGetMetricsResponse>;
+
+            /**
+             * Calls WatchExecution.
+             * @param request WatchExecutionRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and WatchExecutionResponse
+             */
+            public watchExecution(request: flyteidl.admin.IWatchExecutionRequest, callback: flyteidl.service.AdminService.WatchExecutionCallback): void;
+
+            /**
+             * Calls WatchExecution.
+             * @param request WatchExecutionRequest message or plain object
+             * @returns Promise
+             */
+            public watchExecution(request: flyteidl.admin.IWatchExecutionRequest): Promise<flyteidl.admin.WatchExecutionResponse>;
         }
 
         namespace AdminService {
@@ -23100,6 +24398,20 @@ export namespace flyteidl {
              */
             type ListWorkflowsCallback = (error: (Error|null), response?: flyteidl.admin.WorkflowList) => void;
 
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#promoteWorkflow}.
+             * @param error Error, if any
+             * @param [response] WorkflowPromoteResponse
+             */
+            type PromoteWorkflowCallback = (error: (Error|null), response?: flyteidl.admin.WorkflowPromoteResponse) => void;
+
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#getPromotion}.
+             * @param error Error, if any
+             * @param [response] Promotion
+             */
+            type GetPromotionCallback = (error: (Error|null), response?: flyteidl.admin.Promotion) => void;
+
             /**
              * Callback as used by {@link flyteidl.service.AdminService#createLaunchPlan}.
              * @param error Error, if any
@@ -23142,6 +24454,13 @@ export namespace flyteidl {
              */
             type ListLaunchPlansCallback = (error: (Error|null), response?: flyteidl.admin.LaunchPlanList) => void;
 
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#listTriggerFirings}.
+             * @param error Error, if any
+             * @param [response] TriggerFiringList
+             */
+            type ListTriggerFiringsCallback = (error: (Error|null), response?: flyteidl.admin.TriggerFiringList) => void;
+
             /**
              * Callback as used by {@link flyteidl.service.AdminService#updateLaunchPlan}.
              * @param error Error, if any
@@ -23205,6 +24524,20 @@ export namespace flyteidl {
              */
             type TerminateExecutionCallback = (error: (Error|null), response?: flyteidl.admin.ExecutionTerminateResponse) => void;
 
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#pauseExecution}.
+             * @param error Error, if any
+             * @param [response] ExecutionPauseResponse
+             */
+            type PauseExecutionCallback = (error: (Error|null), response?: flyteidl.admin.ExecutionPauseResponse) => void;
+
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#resumeExecution}.
+             * @param error Error, if any
+             * @param [response] ExecutionResumeResponse
+             */
+            type ResumeExecutionCallback = (error: (Error|null), response?: flyteidl.admin.ExecutionResumeResponse) => void;
+
             /**
              * Callback as used by {@link flyteidl.service.AdminService#getNodeExecution}.
              * @param error Error, if any
@@ -23435,6 +24768,13 @@ export namespace flyteidl {
              * @param [response] WorkflowExecutionGetMetricsResponse
              */
             type GetExecutionMetricsCallback = (error: (Error|null), response?: flyteidl.admin.WorkflowExecutionGetMetricsResponse) => void;
+
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#watchExecution}.
+             * @param error Error, if any
+             * @param [response] WatchExecutionResponse
+             */
+            type WatchExecutionCallback = (error: (Error|null), response?: flyteidl.admin.WatchExecutionResponse) => void;
         }
 
         /** Represents a SyncAgentService */
@@ -24617,6 +25957,192 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a PreviewStructuredDatasetRequest. */
+        interface IPreviewStructuredDatasetRequest {
+
+            /** PreviewStructuredDatasetRequest flyteUrl */
+            flyteUrl?: (string|null);
+
+            /** PreviewStructuredDatasetRequest limit */
+            limit?: (number|null);
+
+            /** PreviewStructuredDatasetRequest columns */
+            columns?: (string[]|null);
+        }
+
+        /** Represents a PreviewStructuredDatasetRequest. */
+        class PreviewStructuredDatasetRequest implements IPreviewStructuredDatasetRequest {
+
+            /**
+             * Constructs a new PreviewStructuredDatasetRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.service.IPreviewStructuredDatasetRequest);
+
+            /** PreviewStructuredDatasetRequest flyteUrl. */
+            public flyteUrl: string;
+
+            /** PreviewStructuredDatasetRequest limit. */
+            public limit: number;
+
+            /** PreviewStructuredDatasetRequest columns. */
+            public columns: string[];
+
+            /**
+             * Creates a new PreviewStructuredDatasetRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns PreviewStructuredDatasetRequest instance
+             */
+            public static create(properties?: flyteidl.service.IPreviewStructuredDatasetRequest): flyteidl.service.PreviewStructuredDatasetRequest;
+
+            /**
+             * Encodes the specified PreviewStructuredDatasetRequest message. Does not implicitly {@link flyteidl.service.PreviewStructuredDatasetRequest.verify|verify} messages.
+             * @param message PreviewStructuredDatasetRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.service.IPreviewStructuredDatasetRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a PreviewStructuredDatasetRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns PreviewStructuredDatasetRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.service.PreviewStructuredDatasetRequest;
+
+            /**
+             * Verifies a PreviewStructuredDatasetRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a StructuredDatasetRow. */
+        interface IStructuredDatasetRow {
+
+            /** StructuredDatasetRow values */
+            values?: (flyteidl.core.IScalar[]|null);
+        }
+
+        /** Represents a StructuredDatasetRow. */
+        class StructuredDatasetRow implements IStructuredDatasetRow {
+
+            /**
+             * Constructs a new StructuredDatasetRow.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.service.IStructuredDatasetRow);
+
+            /** StructuredDatasetRow values. */
+            public values: flyteidl.core.IScalar[];
+
+            /**
+             * Creates a new StructuredDatasetRow instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns StructuredDatasetRow instance
+             */
+            public static create(properties?: flyteidl.service.IStructuredDatasetRow): flyteidl.service.StructuredDatasetRow;
+
+            /**
+             * Encodes the specified StructuredDatasetRow message. Does not implicitly {@link flyteidl.service.StructuredDatasetRow.verify|verify} messages.
+             * @param message StructuredDatasetRow message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.service.IStructuredDatasetRow, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a StructuredDatasetRow message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns StructuredDatasetRow
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.service.StructuredDatasetRow;
+
+            /**
+             * Verifies a StructuredDatasetRow message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a PreviewStructuredDatasetResponse. */
+        interface IPreviewStructuredDatasetResponse {
+
+            /** PreviewStructuredDatasetResponse schema */
+            schema?: (flyteidl.core.IStructuredDatasetType|null);
+
+            /** PreviewStructuredDatasetResponse rows */
+            rows?: (flyteidl.service.IStructuredDatasetRow[]|null);
+
+            /** PreviewStructuredDatasetResponse truncated */
+            truncated?: (boolean|null);
+
+            /** PreviewStructuredDatasetResponse omittedColumns */
+            omittedColumns?: (string[]|null);
+        }
+
+        /** Represents a PreviewStructuredDatasetResponse. */
+        class PreviewStructuredDatasetResponse implements IPreviewStructuredDatasetResponse {
+
+            /**
+             * Constructs a new PreviewStructuredDatasetResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.service.IPreviewStructuredDatasetResponse);
+
+            /** PreviewStructuredDatasetResponse schema. */
+            public schema?: (flyteidl.core.IStructuredDatasetType|null);
+
+            /** PreviewStructuredDatasetResponse rows. */
+            public rows: flyteidl.service.IStructuredDatasetRow[];
+
+            /** PreviewStructuredDatasetResponse truncated. */
+            public truncated: boolean;
+
+            /** PreviewStructuredDatasetResponse omittedColumns. */
+            public omittedColumns: string[];
+
+            /**
+             * Creates a new PreviewStructuredDatasetResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns PreviewStructuredDatasetResponse instance
+             */
+            public static create(properties?: flyteidl.service.IPreviewStructuredDatasetResponse): flyteidl.service.PreviewStructuredDatasetResponse;
+
+            /**
+             * Encodes the specified PreviewStructuredDatasetResponse message. Does not implicitly {@link flyteidl.service.PreviewStructuredDatasetResponse.verify|verify} messages.
+             * @param message PreviewStructuredDatasetResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.service.IPreviewStructuredDatasetResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a PreviewStructuredDatasetResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns PreviewStructuredDatasetResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.service.PreviewStructuredDatasetResponse;
+
+            /**
+             * Verifies a PreviewStructuredDatasetResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Represents a DataProxyService */
         class DataProxyService extends $protobuf.rpc.Service {
 
@@ -24692,6 +26218,20 @@ export namespace flyteidl {
              * @returns Promise
              */
             public getData(request: flyteidl.service.IGetDataRequest): Promise<flyteidl.service.GetDataResponse>;
+
+            /**
+             * Calls PreviewStructuredDataset.
+             * @param request PreviewStructuredDatasetRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and PreviewStructuredDatasetResponse
+             */
+            public previewStructuredDataset(request: flyteidl.service.IPreviewStructuredDatasetRequest, callback: flyteidl.service.DataProxyService.PreviewStructuredDatasetCallback): void;
+
+            /**
+             * Calls PreviewStructuredDataset.
+             * @param request PreviewStructuredDatasetRequest message or plain object
+             * @returns Promise
+             */
+            public previewStructuredDataset(request: flyteidl.service.IPreviewStructuredDatasetRequest): Promise<flyteidl.service.PreviewStructuredDatasetResponse>;
         }
 
         namespace DataProxyService {
@@ -24723,6 +26263,13 @@ export namespace flyteidl {
              * @param [response] GetDataResponse
              */
             type GetDataCallback = (error: (Error|null), response?: flyteidl.service.GetDataResponse) => void;
+
+            /**
+             * Callback as used by {@link flyteidl.service.DataProxyService#previewStructuredDataset}.
+             * @param error Error, if any
+             * @param [response] PreviewStructuredDatasetResponse
+             */
+            type PreviewStructuredDatasetCallback = (error: (Error|null), response?: flyteidl.service.PreviewStructuredDatasetResponse) => void;
         }
 
         /** Represents an ExternalPluginService */
@@ -13719,6 +13719,7 @@
                  * @property {number} ABORTED=7 ABORTED value
                  * @property {number} TIMED_OUT=8 TIMED_OUT value
                  * @property {number} ABORTING=9 ABORTING value
+                 * @property {number} PAUSED=10 PAUSED value
                  */
                 WorkflowExecution.Phase = (function() {
                     var valuesById = {}, values = Object.create(valuesById);
@@ -13732,6 +13733,7 @@
                     values[valuesById[7] = "ABORTED"] = 7;
                     values[valuesById[8] = "TIMED_OUT"] = 8;
                     values[valuesById[9] = "ABORTING"] = 9;
+                    values[valuesById[10] = "PAUSED"] = 10;
                     return values;
                 })();
     
@@ -21615,6 +21617,7 @@
                         case 7:
                         case 8:
                         case 9:
+                        case 10:
                             break;
                         }
                     if (message.occurredAt != null && message.hasOwnProperty("occurredAt")) {
@@ -30991,6 +30994,7 @@
                             case 7:
                             case 8:
                             case 9:
+                            case 10:
                                 break;
                             }
                     }
@@ -34147,6 +34151,8 @@
                  * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [id] ExecutionRecoverRequest id
                  * @property {string|null} [name] ExecutionRecoverRequest name
                  * @property {flyteidl.admin.IExecutionMetadata|null} [metadata] ExecutionRecoverRequest metadata
+                 * @property {flyteidl.admin.IRerunFromNode|null} [rerunFrom] ExecutionRecoverRequest rerunFrom
+                 * @property {flyteidl.core.IIdentifier|null} [launchPlan] ExecutionRecoverRequest launchPlan
                  */
     
                 /**
@@ -34188,6 +34194,22 @@
                  */
                 ExecutionRecoverRequest.prototype.metadata = null;
     
+                /**
+                 * ExecutionRecoverRequest rerunFrom.
+                 * @member {flyteidl.admin.IRerunFromNode|null|undefined} rerunFrom
+                 * @memberof flyteidl.admin.ExecutionRecoverRequest
+                 * @instance
+                 */
+                ExecutionRecoverRequest.prototype.rerunFrom = null;
+    
+                /**
+                 * ExecutionRecoverRequest launchPlan.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} launchPlan
+                 * @memberof flyteidl.admin.ExecutionRecoverRequest
+                 * @instance
+                 */
+                ExecutionRecoverRequest.prototype.launchPlan = null;
+    
                 /**
                  * Creates a new ExecutionRecoverRequest instance using the specified properties.
                  * @function create
@@ -34218,6 +34240,10 @@
                         writer.uint32(/* id 2, wireType 2 =*/18).string(message.name);
                     if (message.metadata != null && message.hasOwnProperty("metadata"))
                         $root.flyteidl.admin.ExecutionMetadata.encode(message.metadata, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.rerunFrom != null && message.hasOwnProperty("rerunFrom"))
+                        $root.flyteidl.admin.RerunFromNode.encode(message.rerunFrom, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan"))
+                        $root.flyteidl.core.Identifier.encode(message.launchPlan, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
                     return writer;
                 };
     
@@ -34248,6 +34274,12 @@
                         case 3:
                             message.metadata = $root.flyteidl.admin.ExecutionMetadata.decode(reader, reader.uint32());
                             break;
+                        case 4:
+                            message.rerunFrom = $root.flyteidl.admin.RerunFromNode.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.launchPlan = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -34280,12 +34312,151 @@
                         if (error)
                             return "metadata." + error;
                     }
+                    if (message.rerunFrom != null && message.hasOwnProperty("rerunFrom")) {
+                        var error = $root.flyteidl.admin.RerunFromNode.verify(message.rerunFrom);
+                        if (error)
+                            return "rerunFrom." + error;
+                    }
+                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.launchPlan);
+                        if (error)
+                            return "launchPlan." + error;
+                    }
                     return null;
                 };
     
                 return ExecutionRecoverRequest;
             })();
     
+            admin.RerunFromNode = (function() {
+    
+                /**
+                 * Properties of a RerunFromNode.
+                 * @memberof flyteidl.admin
+                 * @interface IRerunFromNode
+                 * @property {string|null} [nodeId] RerunFromNode nodeId
+                 * @property {flyteidl.core.ILiteralMap|null} [inputs] RerunFromNode inputs
+                 */
+    
+                /**
+                 * Constructs a new RerunFromNode.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a RerunFromNode.
+                 * @implements IRerunFromNode
+                 * @constructor
+                 * @param {flyteidl.admin.IRerunFromNode=} [properties] Properties to set
+                 */
+                function RerunFromNode(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * RerunFromNode nodeId.
+                 * @member {string} nodeId
+                 * @memberof flyteidl.admin.RerunFromNode
+                 * @instance
+                 */
+                RerunFromNode.prototype.nodeId = "";
+    
+                /**
+                 * RerunFromNode inputs.
+                 * @member {flyteidl.core.ILiteralMap|null|undefined} inputs
+                 * @memberof flyteidl.admin.RerunFromNode
+                 * @instance
+                 */
+                RerunFromNode.prototype.inputs = null;
+    
+                /**
+                 * Creates a new RerunFromNode instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.RerunFromNode
+                 * @static
+                 * @param {flyteidl.admin.IRerunFromNode=} [properties] Properties to set
+                 * @returns {flyteidl.admin.RerunFromNode} RerunFromNode instance
+                 */
+                RerunFromNode.create = function create(properties) {
+                    return new RerunFromNode(properties);
+                };
+    
+                /**
+                 * Encodes the specified RerunFromNode message. Does not implicitly {@link flyteidl.admin.RerunFromNode.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.RerunFromNode
+                 * @static
+                 * @param {flyteidl.admin.IRerunFromNode} message RerunFromNode message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                RerunFromNode.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.nodeId);
+                    if (message.inputs != null && message.hasOwnProperty("inputs"))
+                        $root.flyteidl.core.LiteralMap.encode(message.inputs, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a RerunFromNode message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.RerunFromNode
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.RerunFromNode} RerunFromNode
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                RerunFromNode.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.RerunFromNode();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.nodeId = reader.string();
+                            break;
+                        case 2:
+                            message.inputs = $root.flyteidl.core.LiteralMap.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a RerunFromNode message.
+                 * @function verify
+                 * @memberof flyteidl.admin.RerunFromNode
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                RerunFromNode.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        if (!$util.isString(message.nodeId))
+                            return "nodeId: string expected";
+                    if (message.inputs != null && message.hasOwnProperty("inputs")) {
+                        var error = $root.flyteidl.core.LiteralMap.verify(message.inputs);
+                        if (error)
+                            return "inputs." + error;
+                    }
+                    return null;
+                };
+    
+                return RerunFromNode;
+            })();
+    
             admin.ExecutionCreateResponse = (function() {
     
                 /**
@@ -35441,6 +35612,7 @@
                         case 7:
                         case 8:
                         case 9:
+                        case 10:
                             break;
                         }
                     if (message.startedAt != null && message.hasOwnProperty("startedAt")) {
@@ -35629,6 +35801,7 @@
                  * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [referenceExecution] ExecutionMetadata referenceExecution
                  * @property {flyteidl.admin.ISystemMetadata|null} [systemMetadata] ExecutionMetadata systemMetadata
                  * @property {Array.<flyteidl.core.IArtifactID>|null} [artifactIds] ExecutionMetadata artifactIds
+                 * @property {flyteidl.admin.IRerunFromNode|null} [rerunFrom] ExecutionMetadata rerunFrom
                  */
     
                 /**
@@ -35711,6 +35884,14 @@
                  */
                 ExecutionMetadata.prototype.artifactIds = $util.emptyArray;
     
+                /**
+                 * ExecutionMetadata rerunFrom.
+                 * @member {flyteidl.admin.IRerunFromNode|null|undefined} rerunFrom
+                 * @memberof flyteidl.admin.ExecutionMetadata
+                 * @instance
+                 */
+                ExecutionMetadata.prototype.rerunFrom = null;
+    
                 /**
                  * Creates a new ExecutionMetadata instance using the specified properties.
                  * @function create
@@ -35752,6 +35933,8 @@
                     if (message.artifactIds != null && message.artifactIds.length)
                         for (var i = 0; i < message.artifactIds.length; ++i)
                             $root.flyteidl.core.ArtifactID.encode(message.artifactIds[i], writer.uint32(/* id 18, wireType 2 =*/146).fork()).ldelim();
+                    if (message.rerunFrom != null && message.hasOwnProperty("rerunFrom"))
+                        $root.flyteidl.admin.RerunFromNode.encode(message.rerunFrom, writer.uint32(/* id 20, wireType 2 =*/162).fork()).ldelim();
                     return writer;
                 };
     
@@ -35799,6 +35982,9 @@
                                 message.artifactIds = [];
                             message.artifactIds.push($root.flyteidl.core.ArtifactID.decode(reader, reader.uint32()));
                             break;
+                        case 20:
+                            message.rerunFrom = $root.flyteidl.admin.RerunFromNode.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -35866,6 +36052,11 @@
                                 return "artifactIds." + error;
                         }
                     }
+                    if (message.rerunFrom != null && message.hasOwnProperty("rerunFrom")) {
+                        var error = $root.flyteidl.admin.RerunFromNode.verify(message.rerunFrom);
+                        if (error)
+                            return "rerunFrom." + error;
+                    }
                     return null;
                 };
     
@@ -36722,6 +36913,433 @@
                 return ExecutionTerminateResponse;
             })();
     
+            admin.ExecutionPauseRequest = (function() {
+    
+                /**
+                 * Properties of an ExecutionPauseRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IExecutionPauseRequest
+                 * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [id] ExecutionPauseRequest id
+                 * @property {boolean|null} [abortRunningNodes] ExecutionPauseRequest abortRunningNodes
+                 */
+    
+                /**
+                 * Constructs a new ExecutionPauseRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ExecutionPauseRequest.
+                 * @implements IExecutionPauseRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IExecutionPauseRequest=} [properties] Properties to set
+                 */
+                function ExecutionPauseRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ExecutionPauseRequest id.
+                 * @member {flyteidl.core.IWorkflowExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.ExecutionPauseRequest
+                 * @instance
+                 */
+                ExecutionPauseRequest.prototype.id = null;
+    
+                /**
+                 * ExecutionPauseRequest abortRunningNodes.
+                 * @member {boolean} abortRunningNodes
+                 * @memberof flyteidl.admin.ExecutionPauseRequest
+                 * @instance
+                 */
+                ExecutionPauseRequest.prototype.abortRunningNodes = false;
+    
+                /**
+                 * Creates a new ExecutionPauseRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ExecutionPauseRequest
+                 * @static
+                 * @param {flyteidl.admin.IExecutionPauseRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ExecutionPauseRequest} ExecutionPauseRequest instance
+                 */
+                ExecutionPauseRequest.create = function create(properties) {
+                    return new ExecutionPauseRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified ExecutionPauseRequest message. Does not implicitly {@link flyteidl.admin.ExecutionPauseRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ExecutionPauseRequest
+                 * @static
+                 * @param {flyteidl.admin.IExecutionPauseRequest} message ExecutionPauseRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ExecutionPauseRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.WorkflowExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.abortRunningNodes != null && message.hasOwnProperty("abortRunningNodes"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).bool(message.abortRunningNodes);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ExecutionPauseRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ExecutionPauseRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ExecutionPauseRequest} ExecutionPauseRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ExecutionPauseRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionPauseRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.WorkflowExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.abortRunningNodes = reader.bool();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ExecutionPauseRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ExecutionPauseRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ExecutionPauseRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.WorkflowExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.abortRunningNodes != null && message.hasOwnProperty("abortRunningNodes"))
+                        if (typeof message.abortRunningNodes !== "boolean")
+                            return "abortRunningNodes: boolean expected";
+                    return null;
+                };
+    
+                return ExecutionPauseRequest;
+            })();
+    
+            admin.ExecutionPauseResponse = (function() {
+    
+                /**
+                 * Properties of an ExecutionPauseResponse.
+                 * @memberof flyteidl.admin
+                 * @interface IExecutionPauseResponse
+                 */
+    
+                /**
+                 * Constructs a new ExecutionPauseResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ExecutionPauseResponse.
+                 * @implements IExecutionPauseResponse
+                 * @constructor
+                 * @param {flyteidl.admin.IExecutionPauseResponse=} [properties] Properties to set
+                 */
+                function ExecutionPauseResponse(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * Creates a new ExecutionPauseResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ExecutionPauseResponse
+                 * @static
+                 * @param {flyteidl.admin.IExecutionPauseResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ExecutionPauseResponse} ExecutionPauseResponse instance
+                 */
+                ExecutionPauseResponse.create = function create(properties) {
+                    return new ExecutionPauseResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified ExecutionPauseResponse message. Does not implicitly {@link flyteidl.admin.ExecutionPauseResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ExecutionPauseResponse
+                 * @static
+                 * @param {flyteidl.admin.IExecutionPauseResponse} message ExecutionPauseResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ExecutionPauseResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ExecutionPauseResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ExecutionPauseResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ExecutionPauseResponse} ExecutionPauseResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ExecutionPauseResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionPauseResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ExecutionPauseResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ExecutionPauseResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ExecutionPauseResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    return null;
+                };
+    
+                return ExecutionPauseResponse;
+            })();
+    
+            admin.ExecutionResumeRequest = (function() {
+    
+                /**
+                 * Properties of an ExecutionResumeRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IExecutionResumeRequest
+                 * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [id] ExecutionResumeRequest id
+                 */
+    
+                /**
+                 * Constructs a new ExecutionResumeRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ExecutionResumeRequest.
+                 * @implements IExecutionResumeRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IExecutionResumeRequest=} [properties] Properties to set
+                 */
+                function ExecutionResumeRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ExecutionResumeRequest id.
+                 * @member {flyteidl.core.IWorkflowExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.ExecutionResumeRequest
+                 * @instance
+                 */
+                ExecutionResumeRequest.prototype.id = null;
+    
+                /**
+                 * Creates a new ExecutionResumeRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ExecutionResumeRequest
+                 * @static
+                 * @param {flyteidl.admin.IExecutionResumeRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ExecutionResumeRequest} ExecutionResumeRequest instance
+                 */
+                ExecutionResumeRequest.create = function create(properties) {
+                    return new ExecutionResumeRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified ExecutionResumeRequest message. Does not implicitly {@link flyteidl.admin.ExecutionResumeRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ExecutionResumeRequest
+                 * @static
+                 * @param {flyteidl.admin.IExecutionResumeRequest} message ExecutionResumeRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ExecutionResumeRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.WorkflowExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ExecutionResumeRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ExecutionResumeRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ExecutionResumeRequest} ExecutionResumeRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ExecutionResumeRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionResumeRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.WorkflowExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ExecutionResumeRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ExecutionResumeRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ExecutionResumeRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.WorkflowExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    return null;
+                };
+    
+                return ExecutionResumeRequest;
+            })();
+    
+            admin.ExecutionResumeResponse = (function() {
+    
+                /**
+                 * Properties of an ExecutionResumeResponse.
+                 * @memberof flyteidl.admin
+                 * @interface IExecutionResumeResponse
+                 */
+    
+                /**
+                 * Constructs a new ExecutionResumeResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ExecutionResumeResponse.
+                 * @implements IExecutionResumeResponse
+                 * @constructor
+                 * @param {flyteidl.admin.IExecutionResumeResponse=} [properties] Properties to set
+                 */
+                function ExecutionResumeResponse(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * Creates a new ExecutionResumeResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ExecutionResumeResponse
+                 * @static
+                 * @param {flyteidl.admin.IExecutionResumeResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ExecutionResumeResponse} ExecutionResumeResponse instance
+                 */
+                ExecutionResumeResponse.create = function create(properties) {
+                    return new ExecutionResumeResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified ExecutionResumeResponse message. Does not implicitly {@link flyteidl.admin.ExecutionResumeResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ExecutionResumeResponse
+                 * @static
+                 * @param {flyteidl.admin.IExecutionResumeResponse} message ExecutionResumeResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ExecutionResumeResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ExecutionResumeResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ExecutionResumeResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ExecutionResumeResponse} ExecutionResumeResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ExecutionResumeResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionResumeResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ExecutionResumeResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ExecutionResumeResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ExecutionResumeResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    return null;
+                };
+    
+                return ExecutionResumeResponse;
+            })();
+    
             admin.WorkflowExecutionGetDataRequest = (function() {
     
                 /**
@@ -37636,54 +38254,26 @@
                 return WorkflowExecutionGetMetricsResponse;
             })();
     
-            /**
-             * MatchableResource enum.
-             * @name flyteidl.admin.MatchableResource
-             * @enum {string}
-             * @property {number} TASK_RESOURCE=0 TASK_RESOURCE value
-             * @property {number} CLUSTER_RESOURCE=1 CLUSTER_RESOURCE value
-             * @property {number} EXECUTION_QUEUE=2 EXECUTION_QUEUE value
-             * @property {number} EXECUTION_CLUSTER_LABEL=3 EXECUTION_CLUSTER_LABEL value
-             * @property {number} QUALITY_OF_SERVICE_SPECIFICATION=4 QUALITY_OF_SERVICE_SPECIFICATION value
-             * @property {number} PLUGIN_OVERRIDE=5 PLUGIN_OVERRIDE value
-             * @property {number} WORKFLOW_EXECUTION_CONFIG=6 WORKFLOW_EXECUTION_CONFIG value
-             * @property {number} CLUSTER_ASSIGNMENT=7 CLUSTER_ASSIGNMENT value
-             */
-            admin.MatchableResource = (function() {
-                var valuesById = {}, values = Object.create(valuesById);
-                values[valuesById[0] = "TASK_RESOURCE"] = 0;
-                values[valuesById[1] = "CLUSTER_RESOURCE"] = 1;
-                values[valuesById[2] = "EXECUTION_QUEUE"] = 2;
-                values[valuesById[3] = "EXECUTION_CLUSTER_LABEL"] = 3;
-                values[valuesById[4] = "QUALITY_OF_SERVICE_SPECIFICATION"] = 4;
-                values[valuesById[5] = "PLUGIN_OVERRIDE"] = 5;
-                values[valuesById[6] = "WORKFLOW_EXECUTION_CONFIG"] = 6;
-                values[valuesById[7] = "CLUSTER_ASSIGNMENT"] = 7;
-                return values;
-            })();
-    
-            admin.TaskResourceSpec = (function() {
+            admin.WatchExecutionRequest = (function() {
     
                 /**
-                 * Properties of a TaskResourceSpec.
+                 * Properties of a WatchExecutionRequest.
                  * @memberof flyteidl.admin
-                 * @interface ITaskResourceSpec
-                 * @property {string|null} [cpu] TaskResourceSpec cpu
-                 * @property {string|null} [gpu] TaskResourceSpec gpu
-                 * @property {string|null} [memory] TaskResourceSpec memory
-                 * @property {string|null} [storage] TaskResourceSpec storage
-                 * @property {string|null} [ephemeralStorage] TaskResourceSpec ephemeralStorage
+                 * @interface IWatchExecutionRequest
+                 * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [id] WatchExecutionRequest id
+                 * @property {string|null} [nodeId] WatchExecutionRequest nodeId
+                 * @property {string|null} [cursor] WatchExecutionRequest cursor
                  */
     
                 /**
-                 * Constructs a new TaskResourceSpec.
+                 * Constructs a new WatchExecutionRequest.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a TaskResourceSpec.
-                 * @implements ITaskResourceSpec
+                 * @classdesc Represents a WatchExecutionRequest.
+                 * @implements IWatchExecutionRequest
                  * @constructor
-                 * @param {flyteidl.admin.ITaskResourceSpec=} [properties] Properties to set
+                 * @param {flyteidl.admin.IWatchExecutionRequest=} [properties] Properties to set
                  */
-                function TaskResourceSpec(properties) {
+                function WatchExecutionRequest(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -37691,114 +38281,88 @@
                 }
     
                 /**
-                 * TaskResourceSpec cpu.
-                 * @member {string} cpu
-                 * @memberof flyteidl.admin.TaskResourceSpec
-                 * @instance
-                 */
-                TaskResourceSpec.prototype.cpu = "";
-    
-                /**
-                 * TaskResourceSpec gpu.
-                 * @member {string} gpu
-                 * @memberof flyteidl.admin.TaskResourceSpec
-                 * @instance
-                 */
-                TaskResourceSpec.prototype.gpu = "";
-    
-                /**
-                 * TaskResourceSpec memory.
-                 * @member {string} memory
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * WatchExecutionRequest id.
+                 * @member {flyteidl.core.IWorkflowExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @instance
                  */
-                TaskResourceSpec.prototype.memory = "";
+                WatchExecutionRequest.prototype.id = null;
     
                 /**
-                 * TaskResourceSpec storage.
-                 * @member {string} storage
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * WatchExecutionRequest nodeId.
+                 * @member {string} nodeId
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @instance
                  */
-                TaskResourceSpec.prototype.storage = "";
+                WatchExecutionRequest.prototype.nodeId = "";
     
                 /**
-                 * TaskResourceSpec ephemeralStorage.
-                 * @member {string} ephemeralStorage
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * WatchExecutionRequest cursor.
+                 * @member {string} cursor
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @instance
                  */
-                TaskResourceSpec.prototype.ephemeralStorage = "";
+                WatchExecutionRequest.prototype.cursor = "";
     
                 /**
-                 * Creates a new TaskResourceSpec instance using the specified properties.
+                 * Creates a new WatchExecutionRequest instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @static
-                 * @param {flyteidl.admin.ITaskResourceSpec=} [properties] Properties to set
-                 * @returns {flyteidl.admin.TaskResourceSpec} TaskResourceSpec instance
+                 * @param {flyteidl.admin.IWatchExecutionRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WatchExecutionRequest} WatchExecutionRequest instance
                  */
-                TaskResourceSpec.create = function create(properties) {
-                    return new TaskResourceSpec(properties);
+                WatchExecutionRequest.create = function create(properties) {
+                    return new WatchExecutionRequest(properties);
                 };
     
                 /**
-                 * Encodes the specified TaskResourceSpec message. Does not implicitly {@link flyteidl.admin.TaskResourceSpec.verify|verify} messages.
+                 * Encodes the specified WatchExecutionRequest message. Does not implicitly {@link flyteidl.admin.WatchExecutionRequest.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @static
-                 * @param {flyteidl.admin.ITaskResourceSpec} message TaskResourceSpec message or plain object to encode
+                 * @param {flyteidl.admin.IWatchExecutionRequest} message WatchExecutionRequest message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                TaskResourceSpec.encode = function encode(message, writer) {
+                WatchExecutionRequest.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.cpu != null && message.hasOwnProperty("cpu"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.cpu);
-                    if (message.gpu != null && message.hasOwnProperty("gpu"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.gpu);
-                    if (message.memory != null && message.hasOwnProperty("memory"))
-                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.memory);
-                    if (message.storage != null && message.hasOwnProperty("storage"))
-                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.storage);
-                    if (message.ephemeralStorage != null && message.hasOwnProperty("ephemeralStorage"))
-                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.ephemeralStorage);
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.WorkflowExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.nodeId);
+                    if (message.cursor != null && message.hasOwnProperty("cursor"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.cursor);
                     return writer;
                 };
     
                 /**
-                 * Decodes a TaskResourceSpec message from the specified reader or buffer.
+                 * Decodes a WatchExecutionRequest message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.TaskResourceSpec} TaskResourceSpec
+                 * @returns {flyteidl.admin.WatchExecutionRequest} WatchExecutionRequest
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                TaskResourceSpec.decode = function decode(reader, length) {
+                WatchExecutionRequest.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TaskResourceSpec();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WatchExecutionRequest();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.cpu = reader.string();
+                            message.id = $root.flyteidl.core.WorkflowExecutionIdentifier.decode(reader, reader.uint32());
                             break;
                         case 2:
-                            message.gpu = reader.string();
+                            message.nodeId = reader.string();
                             break;
                         case 3:
-                            message.memory = reader.string();
-                            break;
-                        case 4:
-                            message.storage = reader.string();
-                            break;
-                        case 5:
-                            message.ephemeralStorage = reader.string();
+                            message.cursor = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -37809,56 +38373,55 @@
                 };
     
                 /**
-                 * Verifies a TaskResourceSpec message.
+                 * Verifies a WatchExecutionRequest message.
                  * @function verify
-                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * @memberof flyteidl.admin.WatchExecutionRequest
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                TaskResourceSpec.verify = function verify(message) {
+                WatchExecutionRequest.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.cpu != null && message.hasOwnProperty("cpu"))
-                        if (!$util.isString(message.cpu))
-                            return "cpu: string expected";
-                    if (message.gpu != null && message.hasOwnProperty("gpu"))
-                        if (!$util.isString(message.gpu))
-                            return "gpu: string expected";
-                    if (message.memory != null && message.hasOwnProperty("memory"))
-                        if (!$util.isString(message.memory))
-                            return "memory: string expected";
-                    if (message.storage != null && message.hasOwnProperty("storage"))
-                        if (!$util.isString(message.storage))
-                            return "storage: string expected";
-                    if (message.ephemeralStorage != null && message.hasOwnProperty("ephemeralStorage"))
-                        if (!$util.isString(message.ephemeralStorage))
-                            return "ephemeralStorage: string expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.WorkflowExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        if (!$util.isString(message.nodeId))
+                            return "nodeId: string expected";
+                    if (message.cursor != null && message.hasOwnProperty("cursor"))
+                        if (!$util.isString(message.cursor))
+                            return "cursor: string expected";
                     return null;
                 };
     
-                return TaskResourceSpec;
+                return WatchExecutionRequest;
             })();
     
-            admin.TaskResourceAttributes = (function() {
+            admin.WatchExecutionResponse = (function() {
     
                 /**
-                 * Properties of a TaskResourceAttributes.
+                 * Properties of a WatchExecutionResponse.
                  * @memberof flyteidl.admin
-                 * @interface ITaskResourceAttributes
-                 * @property {flyteidl.admin.ITaskResourceSpec|null} [defaults] TaskResourceAttributes defaults
-                 * @property {flyteidl.admin.ITaskResourceSpec|null} [limits] TaskResourceAttributes limits
+                 * @interface IWatchExecutionResponse
+                 * @property {string|null} [cursor] WatchExecutionResponse cursor
+                 * @property {google.protobuf.ITimestamp|null} [occurredAt] WatchExecutionResponse occurredAt
+                 * @property {flyteidl.admin.IWorkflowExecutionPhaseChange|null} [workflowExecution] WatchExecutionResponse workflowExecution
+                 * @property {flyteidl.admin.INodeExecutionPhaseChange|null} [nodeExecution] WatchExecutionResponse nodeExecution
+                 * @property {flyteidl.admin.ITaskExecutionPhaseChange|null} [taskExecution] WatchExecutionResponse taskExecution
                  */
     
                 /**
-                 * Constructs a new TaskResourceAttributes.
+                 * Constructs a new WatchExecutionResponse.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a TaskResourceAttributes.
-                 * @implements ITaskResourceAttributes
+                 * @classdesc Represents a WatchExecutionResponse.
+                 * @implements IWatchExecutionResponse
                  * @constructor
-                 * @param {flyteidl.admin.ITaskResourceAttributes=} [properties] Properties to set
+                 * @param {flyteidl.admin.IWatchExecutionResponse=} [properties] Properties to set
                  */
-                function TaskResourceAttributes(properties) {
+                function WatchExecutionResponse(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -37866,75 +38429,128 @@
                 }
     
                 /**
-                 * TaskResourceAttributes defaults.
-                 * @member {flyteidl.admin.ITaskResourceSpec|null|undefined} defaults
-                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * WatchExecutionResponse cursor.
+                 * @member {string} cursor
+                 * @memberof flyteidl.admin.WatchExecutionResponse
                  * @instance
                  */
-                TaskResourceAttributes.prototype.defaults = null;
+                WatchExecutionResponse.prototype.cursor = "";
     
                 /**
-                 * TaskResourceAttributes limits.
-                 * @member {flyteidl.admin.ITaskResourceSpec|null|undefined} limits
-                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * WatchExecutionResponse occurredAt.
+                 * @member {google.protobuf.ITimestamp|null|undefined} occurredAt
+                 * @memberof flyteidl.admin.WatchExecutionResponse
                  * @instance
                  */
-                TaskResourceAttributes.prototype.limits = null;
+                WatchExecutionResponse.prototype.occurredAt = null;
     
                 /**
-                 * Creates a new TaskResourceAttributes instance using the specified properties.
+                 * WatchExecutionResponse workflowExecution.
+                 * @member {flyteidl.admin.IWorkflowExecutionPhaseChange|null|undefined} workflowExecution
+                 * @memberof flyteidl.admin.WatchExecutionResponse
+                 * @instance
+                 */
+                WatchExecutionResponse.prototype.workflowExecution = null;
+    
+                /**
+                 * WatchExecutionResponse nodeExecution.
+                 * @member {flyteidl.admin.INodeExecutionPhaseChange|null|undefined} nodeExecution
+                 * @memberof flyteidl.admin.WatchExecutionResponse
+                 * @instance
+                 */
+                WatchExecutionResponse.prototype.nodeExecution = null;
+    
+                /**
+                 * WatchExecutionResponse taskExecution.
+                 * @member {flyteidl.admin.ITaskExecutionPhaseChange|null|undefined} taskExecution
+                 * @memberof flyteidl.admin.WatchExecutionResponse
+                 * @instance
+                 */
+                WatchExecutionResponse.prototype.taskExecution = null;
+    
+                // OneOf field names bound to virtual getters and setters
+                var $oneOfFields;
+    
+                /**
+                 * WatchExecutionResponse change.
+                 * @member {"workflowExecution"|"nodeExecution"|"taskExecution"|undefined} change
+                 * @memberof flyteidl.admin.WatchExecutionResponse
+                 * @instance
+                 */
+                Object.defineProperty(WatchExecutionResponse.prototype, "change", {
+                    get: $util.oneOfGetter($oneOfFields = ["workflowExecution", "nodeExecution", "taskExecution"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
+                /**
+                 * Creates a new WatchExecutionResponse instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * @memberof flyteidl.admin.WatchExecutionResponse
                  * @static
-                 * @param {flyteidl.admin.ITaskResourceAttributes=} [properties] Properties to set
-                 * @returns {flyteidl.admin.TaskResourceAttributes} TaskResourceAttributes instance
+                 * @param {flyteidl.admin.IWatchExecutionResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WatchExecutionResponse} WatchExecutionResponse instance
                  */
-                TaskResourceAttributes.create = function create(properties) {
-                    return new TaskResourceAttributes(properties);
+                WatchExecutionResponse.create = function create(properties) {
+                    return new WatchExecutionResponse(properties);
                 };
     
                 /**
-                 * Encodes the specified TaskResourceAttributes message. Does not implicitly {@link flyteidl.admin.TaskResourceAttributes.verify|verify} messages.
+                 * Encodes the specified WatchExecutionResponse message. Does not implicitly {@link flyteidl.admin.WatchExecutionResponse.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * @memberof flyteidl.admin.WatchExecutionResponse
                  * @static
-                 * @param {flyteidl.admin.ITaskResourceAttributes} message TaskResourceAttributes message or plain object to encode
+                 * @param {flyteidl.admin.IWatchExecutionResponse} message WatchExecutionResponse message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                TaskResourceAttributes.encode = function encode(message, writer) {
+                WatchExecutionResponse.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.defaults != null && message.hasOwnProperty("defaults"))
-                        $root.flyteidl.admin.TaskResourceSpec.encode(message.defaults, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.limits != null && message.hasOwnProperty("limits"))
-                        $root.flyteidl.admin.TaskResourceSpec.encode(message.limits, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.cursor != null && message.hasOwnProperty("cursor"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.cursor);
+                    if (message.occurredAt != null && message.hasOwnProperty("occurredAt"))
+                        $root.google.protobuf.Timestamp.encode(message.occurredAt, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.workflowExecution != null && message.hasOwnProperty("workflowExecution"))
+                        $root.flyteidl.admin.WorkflowExecutionPhaseChange.encode(message.workflowExecution, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.nodeExecution != null && message.hasOwnProperty("nodeExecution"))
+                        $root.flyteidl.admin.NodeExecutionPhaseChange.encode(message.nodeExecution, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.taskExecution != null && message.hasOwnProperty("taskExecution"))
+                        $root.flyteidl.admin.TaskExecutionPhaseChange.encode(message.taskExecution, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a TaskResourceAttributes message from the specified reader or buffer.
+                 * Decodes a WatchExecutionResponse message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * @memberof flyteidl.admin.WatchExecutionResponse
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.TaskResourceAttributes} TaskResourceAttributes
+                 * @returns {flyteidl.admin.WatchExecutionResponse} WatchExecutionResponse
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                TaskResourceAttributes.decode = function decode(reader, length) {
+                WatchExecutionResponse.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TaskResourceAttributes();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WatchExecutionResponse();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.defaults = $root.flyteidl.admin.TaskResourceSpec.decode(reader, reader.uint32());
+                            message.cursor = reader.string();
                             break;
                         case 2:
-                            message.limits = $root.flyteidl.admin.TaskResourceSpec.decode(reader, reader.uint32());
+                            message.occurredAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.workflowExecution = $root.flyteidl.admin.WorkflowExecutionPhaseChange.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.nodeExecution = $root.flyteidl.admin.NodeExecutionPhaseChange.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.taskExecution = $root.flyteidl.admin.TaskExecutionPhaseChange.decode(reader, reader.uint32());
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -37945,51 +38561,78 @@
                 };
     
                 /**
-                 * Verifies a TaskResourceAttributes message.
+                 * Verifies a WatchExecutionResponse message.
                  * @function verify
-                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * @memberof flyteidl.admin.WatchExecutionResponse
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                TaskResourceAttributes.verify = function verify(message) {
+                WatchExecutionResponse.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.defaults != null && message.hasOwnProperty("defaults")) {
-                        var error = $root.flyteidl.admin.TaskResourceSpec.verify(message.defaults);
+                    var properties = {};
+                    if (message.cursor != null && message.hasOwnProperty("cursor"))
+                        if (!$util.isString(message.cursor))
+                            return "cursor: string expected";
+                    if (message.occurredAt != null && message.hasOwnProperty("occurredAt")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.occurredAt);
                         if (error)
-                            return "defaults." + error;
+                            return "occurredAt." + error;
                     }
-                    if (message.limits != null && message.hasOwnProperty("limits")) {
-                        var error = $root.flyteidl.admin.TaskResourceSpec.verify(message.limits);
-                        if (error)
-                            return "limits." + error;
+                    if (message.workflowExecution != null && message.hasOwnProperty("workflowExecution")) {
+                        properties.change = 1;
+                        {
+                            var error = $root.flyteidl.admin.WorkflowExecutionPhaseChange.verify(message.workflowExecution);
+                            if (error)
+                                return "workflowExecution." + error;
+                        }
+                    }
+                    if (message.nodeExecution != null && message.hasOwnProperty("nodeExecution")) {
+                        if (properties.change === 1)
+                            return "change: multiple values";
+                        properties.change = 1;
+                        {
+                            var error = $root.flyteidl.admin.NodeExecutionPhaseChange.verify(message.nodeExecution);
+                            if (error)
+                                return "nodeExecution." + error;
+                        }
+                    }
+                    if (message.taskExecution != null && message.hasOwnProperty("taskExecution")) {
+                        if (properties.change === 1)
+                            return "change: multiple values";
+                        properties.change = 1;
+                        {
+                            var error = $root.flyteidl.admin.TaskExecutionPhaseChange.verify(message.taskExecution);
+                            if (error)
+                                return "taskExecution." + error;
+                        }
                     }
                     return null;
                 };
     
-                return TaskResourceAttributes;
+                return WatchExecutionResponse;
             })();
     
-            admin.ClusterResourceAttributes = (function() {
+            admin.WorkflowExecutionPhaseChange = (function() {
     
                 /**
-                 * Properties of a ClusterResourceAttributes.
+                 * Properties of a WorkflowExecutionPhaseChange.
                  * @memberof flyteidl.admin
-                 * @interface IClusterResourceAttributes
-                 * @property {Object.<string,string>|null} [attributes] ClusterResourceAttributes attributes
+                 * @interface IWorkflowExecutionPhaseChange
+                 * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [id] WorkflowExecutionPhaseChange id
+                 * @property {flyteidl.core.WorkflowExecution.Phase|null} [phase] WorkflowExecutionPhaseChange phase
                  */
     
                 /**
-                 * Constructs a new ClusterResourceAttributes.
+                 * Constructs a new WorkflowExecutionPhaseChange.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a ClusterResourceAttributes.
-                 * @implements IClusterResourceAttributes
+                 * @classdesc Represents a WorkflowExecutionPhaseChange.
+                 * @implements IWorkflowExecutionPhaseChange
                  * @constructor
-                 * @param {flyteidl.admin.IClusterResourceAttributes=} [properties] Properties to set
+                 * @param {flyteidl.admin.IWorkflowExecutionPhaseChange=} [properties] Properties to set
                  */
-                function ClusterResourceAttributes(properties) {
-                    this.attributes = {};
+                function WorkflowExecutionPhaseChange(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -37997,68 +38640,75 @@
                 }
     
                 /**
-                 * ClusterResourceAttributes attributes.
-                 * @member {Object.<string,string>} attributes
-                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * WorkflowExecutionPhaseChange id.
+                 * @member {flyteidl.core.IWorkflowExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.WorkflowExecutionPhaseChange
                  * @instance
                  */
-                ClusterResourceAttributes.prototype.attributes = $util.emptyObject;
+                WorkflowExecutionPhaseChange.prototype.id = null;
     
                 /**
-                 * Creates a new ClusterResourceAttributes instance using the specified properties.
+                 * WorkflowExecutionPhaseChange phase.
+                 * @member {flyteidl.core.WorkflowExecution.Phase} phase
+                 * @memberof flyteidl.admin.WorkflowExecutionPhaseChange
+                 * @instance
+                 */
+                WorkflowExecutionPhaseChange.prototype.phase = 0;
+    
+                /**
+                 * Creates a new WorkflowExecutionPhaseChange instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @memberof flyteidl.admin.WorkflowExecutionPhaseChange
                  * @static
-                 * @param {flyteidl.admin.IClusterResourceAttributes=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ClusterResourceAttributes} ClusterResourceAttributes instance
+                 * @param {flyteidl.admin.IWorkflowExecutionPhaseChange=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WorkflowExecutionPhaseChange} WorkflowExecutionPhaseChange instance
                  */
-                ClusterResourceAttributes.create = function create(properties) {
-                    return new ClusterResourceAttributes(properties);
+                WorkflowExecutionPhaseChange.create = function create(properties) {
+                    return new WorkflowExecutionPhaseChange(properties);
                 };
     
                 /**
-                 * Encodes the specified ClusterResourceAttributes message. Does not implicitly {@link flyteidl.admin.ClusterResourceAttributes.verify|verify} messages.
+                 * Encodes the specified WorkflowExecutionPhaseChange message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionPhaseChange.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @memberof flyteidl.admin.WorkflowExecutionPhaseChange
                  * @static
-                 * @param {flyteidl.admin.IClusterResourceAttributes} message ClusterResourceAttributes message or plain object to encode
+                 * @param {flyteidl.admin.IWorkflowExecutionPhaseChange} message WorkflowExecutionPhaseChange message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ClusterResourceAttributes.encode = function encode(message, writer) {
+                WorkflowExecutionPhaseChange.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.attributes != null && message.hasOwnProperty("attributes"))
-                        for (var keys = Object.keys(message.attributes), i = 0; i < keys.length; ++i)
-                            writer.uint32(/* id 1, wireType 2 =*/10).fork().uint32(/* id 1, wireType 2 =*/10).string(keys[i]).uint32(/* id 2, wireType 2 =*/18).string(message.attributes[keys[i]]).ldelim();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.WorkflowExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.phase != null && message.hasOwnProperty("phase"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.phase);
                     return writer;
                 };
     
                 /**
-                 * Decodes a ClusterResourceAttributes message from the specified reader or buffer.
+                 * Decodes a WorkflowExecutionPhaseChange message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @memberof flyteidl.admin.WorkflowExecutionPhaseChange
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ClusterResourceAttributes} ClusterResourceAttributes
+                 * @returns {flyteidl.admin.WorkflowExecutionPhaseChange} WorkflowExecutionPhaseChange
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ClusterResourceAttributes.decode = function decode(reader, length) {
+                WorkflowExecutionPhaseChange.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ClusterResourceAttributes(), key;
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowExecutionPhaseChange();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            reader.skip().pos++;
-                            if (message.attributes === $util.emptyObject)
-                                message.attributes = {};
-                            key = reader.string();
-                            reader.pos++;
-                            message.attributes[key] = reader.string();
+                            message.id = $root.flyteidl.core.WorkflowExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.phase = reader.int32();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -38069,49 +38719,64 @@
                 };
     
                 /**
-                 * Verifies a ClusterResourceAttributes message.
+                 * Verifies a WorkflowExecutionPhaseChange message.
                  * @function verify
-                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @memberof flyteidl.admin.WorkflowExecutionPhaseChange
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ClusterResourceAttributes.verify = function verify(message) {
+                WorkflowExecutionPhaseChange.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.attributes != null && message.hasOwnProperty("attributes")) {
-                        if (!$util.isObject(message.attributes))
-                            return "attributes: object expected";
-                        var key = Object.keys(message.attributes);
-                        for (var i = 0; i < key.length; ++i)
-                            if (!$util.isString(message.attributes[key[i]]))
-                                return "attributes: string{k:string} expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.WorkflowExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
                     }
+                    if (message.phase != null && message.hasOwnProperty("phase"))
+                        switch (message.phase) {
+                        default:
+                            return "phase: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                        case 5:
+                        case 6:
+                        case 7:
+                        case 8:
+                        case 9:
+                        case 10:
+                            break;
+                        }
                     return null;
                 };
     
-                return ClusterResourceAttributes;
+                return WorkflowExecutionPhaseChange;
             })();
     
-            admin.ExecutionQueueAttributes = (function() {
+            admin.NodeExecutionPhaseChange = (function() {
     
                 /**
-                 * Properties of an ExecutionQueueAttributes.
+                 * Properties of a NodeExecutionPhaseChange.
                  * @memberof flyteidl.admin
-                 * @interface IExecutionQueueAttributes
-                 * @property {Array.<string>|null} [tags] ExecutionQueueAttributes tags
+                 * @interface INodeExecutionPhaseChange
+                 * @property {flyteidl.core.INodeExecutionIdentifier|null} [id] NodeExecutionPhaseChange id
+                 * @property {flyteidl.core.NodeExecution.Phase|null} [phase] NodeExecutionPhaseChange phase
+                 * @property {string|null} [parentNodeId] NodeExecutionPhaseChange parentNodeId
                  */
     
                 /**
-                 * Constructs a new ExecutionQueueAttributes.
+                 * Constructs a new NodeExecutionPhaseChange.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents an ExecutionQueueAttributes.
-                 * @implements IExecutionQueueAttributes
+                 * @classdesc Represents a NodeExecutionPhaseChange.
+                 * @implements INodeExecutionPhaseChange
                  * @constructor
-                 * @param {flyteidl.admin.IExecutionQueueAttributes=} [properties] Properties to set
+                 * @param {flyteidl.admin.INodeExecutionPhaseChange=} [properties] Properties to set
                  */
-                function ExecutionQueueAttributes(properties) {
-                    this.tags = [];
+                function NodeExecutionPhaseChange(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -38119,65 +38784,88 @@
                 }
     
                 /**
-                 * ExecutionQueueAttributes tags.
-                 * @member {Array.<string>} tags
-                 * @memberof flyteidl.admin.ExecutionQueueAttributes
+                 * NodeExecutionPhaseChange id.
+                 * @member {flyteidl.core.INodeExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
                  * @instance
                  */
-                ExecutionQueueAttributes.prototype.tags = $util.emptyArray;
+                NodeExecutionPhaseChange.prototype.id = null;
     
                 /**
-                 * Creates a new ExecutionQueueAttributes instance using the specified properties.
+                 * NodeExecutionPhaseChange phase.
+                 * @member {flyteidl.core.NodeExecution.Phase} phase
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
+                 * @instance
+                 */
+                NodeExecutionPhaseChange.prototype.phase = 0;
+    
+                /**
+                 * NodeExecutionPhaseChange parentNodeId.
+                 * @member {string} parentNodeId
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
+                 * @instance
+                 */
+                NodeExecutionPhaseChange.prototype.parentNodeId = "";
+    
+                /**
+                 * Creates a new NodeExecutionPhaseChange instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ExecutionQueueAttributes
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
                  * @static
-                 * @param {flyteidl.admin.IExecutionQueueAttributes=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ExecutionQueueAttributes} ExecutionQueueAttributes instance
+                 * @param {flyteidl.admin.INodeExecutionPhaseChange=} [properties] Properties to set
+                 * @returns {flyteidl.admin.NodeExecutionPhaseChange} NodeExecutionPhaseChange instance
                  */
-                ExecutionQueueAttributes.create = function create(properties) {
-                    return new ExecutionQueueAttributes(properties);
+                NodeExecutionPhaseChange.create = function create(properties) {
+                    return new NodeExecutionPhaseChange(properties);
                 };
     
                 /**
-                 * Encodes the specified ExecutionQueueAttributes message. Does not implicitly {@link flyteidl.admin.ExecutionQueueAttributes.verify|verify} messages.
+                 * Encodes the specified NodeExecutionPhaseChange message. Does not implicitly {@link flyteidl.admin.NodeExecutionPhaseChange.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ExecutionQueueAttributes
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
                  * @static
-                 * @param {flyteidl.admin.IExecutionQueueAttributes} message ExecutionQueueAttributes message or plain object to encode
+                 * @param {flyteidl.admin.INodeExecutionPhaseChange} message NodeExecutionPhaseChange message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ExecutionQueueAttributes.encode = function encode(message, writer) {
+                NodeExecutionPhaseChange.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.tags != null && message.tags.length)
-                        for (var i = 0; i < message.tags.length; ++i)
-                            writer.uint32(/* id 1, wireType 2 =*/10).string(message.tags[i]);
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.NodeExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.phase != null && message.hasOwnProperty("phase"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.phase);
+                    if (message.parentNodeId != null && message.hasOwnProperty("parentNodeId"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.parentNodeId);
                     return writer;
                 };
     
                 /**
-                 * Decodes an ExecutionQueueAttributes message from the specified reader or buffer.
+                 * Decodes a NodeExecutionPhaseChange message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ExecutionQueueAttributes
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ExecutionQueueAttributes} ExecutionQueueAttributes
+                 * @returns {flyteidl.admin.NodeExecutionPhaseChange} NodeExecutionPhaseChange
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ExecutionQueueAttributes.decode = function decode(reader, length) {
+                NodeExecutionPhaseChange.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionQueueAttributes();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.NodeExecutionPhaseChange();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            if (!(message.tags && message.tags.length))
-                                message.tags = [];
-                            message.tags.push(reader.string());
+                            message.id = $root.flyteidl.core.NodeExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.phase = reader.int32();
+                            break;
+                        case 3:
+                            message.parentNodeId = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -38188,47 +38876,67 @@
                 };
     
                 /**
-                 * Verifies an ExecutionQueueAttributes message.
+                 * Verifies a NodeExecutionPhaseChange message.
                  * @function verify
-                 * @memberof flyteidl.admin.ExecutionQueueAttributes
+                 * @memberof flyteidl.admin.NodeExecutionPhaseChange
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ExecutionQueueAttributes.verify = function verify(message) {
+                NodeExecutionPhaseChange.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.tags != null && message.hasOwnProperty("tags")) {
-                        if (!Array.isArray(message.tags))
-                            return "tags: array expected";
-                        for (var i = 0; i < message.tags.length; ++i)
-                            if (!$util.isString(message.tags[i]))
-                                return "tags: string[] expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.NodeExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
                     }
+                    if (message.phase != null && message.hasOwnProperty("phase"))
+                        switch (message.phase) {
+                        default:
+                            return "phase: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                        case 5:
+                        case 6:
+                        case 7:
+                        case 8:
+                        case 9:
+                        case 10:
+                            break;
+                        }
+                    if (message.parentNodeId != null && message.hasOwnProperty("parentNodeId"))
+                        if (!$util.isString(message.parentNodeId))
+                            return "parentNodeId: string expected";
                     return null;
                 };
     
-                return ExecutionQueueAttributes;
+                return NodeExecutionPhaseChange;
             })();
     
-            admin.ExecutionClusterLabel = (function() {
+            admin.TaskExecutionPhaseChange = (function() {
     
                 /**
-                 * Properties of an ExecutionClusterLabel.
+                 * Properties of a TaskExecutionPhaseChange.
                  * @memberof flyteidl.admin
-                 * @interface IExecutionClusterLabel
-                 * @property {string|null} [value] ExecutionClusterLabel value
+                 * @interface ITaskExecutionPhaseChange
+                 * @property {flyteidl.core.ITaskExecutionIdentifier|null} [id] TaskExecutionPhaseChange id
+                 * @property {flyteidl.core.TaskExecution.Phase|null} [phase] TaskExecutionPhaseChange phase
+                 * @property {number|null} [phaseVersion] TaskExecutionPhaseChange phaseVersion
                  */
     
                 /**
-                 * Constructs a new ExecutionClusterLabel.
+                 * Constructs a new TaskExecutionPhaseChange.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents an ExecutionClusterLabel.
-                 * @implements IExecutionClusterLabel
+                 * @classdesc Represents a TaskExecutionPhaseChange.
+                 * @implements ITaskExecutionPhaseChange
                  * @constructor
-                 * @param {flyteidl.admin.IExecutionClusterLabel=} [properties] Properties to set
+                 * @param {flyteidl.admin.ITaskExecutionPhaseChange=} [properties] Properties to set
                  */
-                function ExecutionClusterLabel(properties) {
+                function TaskExecutionPhaseChange(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -38236,62 +38944,88 @@
                 }
     
                 /**
-                 * ExecutionClusterLabel value.
-                 * @member {string} value
-                 * @memberof flyteidl.admin.ExecutionClusterLabel
+                 * TaskExecutionPhaseChange id.
+                 * @member {flyteidl.core.ITaskExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
                  * @instance
                  */
-                ExecutionClusterLabel.prototype.value = "";
+                TaskExecutionPhaseChange.prototype.id = null;
     
                 /**
-                 * Creates a new ExecutionClusterLabel instance using the specified properties.
+                 * TaskExecutionPhaseChange phase.
+                 * @member {flyteidl.core.TaskExecution.Phase} phase
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
+                 * @instance
+                 */
+                TaskExecutionPhaseChange.prototype.phase = 0;
+    
+                /**
+                 * TaskExecutionPhaseChange phaseVersion.
+                 * @member {number} phaseVersion
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
+                 * @instance
+                 */
+                TaskExecutionPhaseChange.prototype.phaseVersion = 0;
+    
+                /**
+                 * Creates a new TaskExecutionPhaseChange instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ExecutionClusterLabel
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
                  * @static
-                 * @param {flyteidl.admin.IExecutionClusterLabel=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ExecutionClusterLabel} ExecutionClusterLabel instance
+                 * @param {flyteidl.admin.ITaskExecutionPhaseChange=} [properties] Properties to set
+                 * @returns {flyteidl.admin.TaskExecutionPhaseChange} TaskExecutionPhaseChange instance
                  */
-                ExecutionClusterLabel.create = function create(properties) {
-                    return new ExecutionClusterLabel(properties);
+                TaskExecutionPhaseChange.create = function create(properties) {
+                    return new TaskExecutionPhaseChange(properties);
                 };
     
                 /**
-                 * Encodes the specified ExecutionClusterLabel message. Does not implicitly {@link flyteidl.admin.ExecutionClusterLabel.verify|verify} messages.
+                 * Encodes the specified TaskExecutionPhaseChange message. Does not implicitly {@link flyteidl.admin.TaskExecutionPhaseChange.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ExecutionClusterLabel
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
                  * @static
-                 * @param {flyteidl.admin.IExecutionClusterLabel} message ExecutionClusterLabel message or plain object to encode
+                 * @param {flyteidl.admin.ITaskExecutionPhaseChange} message TaskExecutionPhaseChange message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ExecutionClusterLabel.encode = function encode(message, writer) {
+                TaskExecutionPhaseChange.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.value != null && message.hasOwnProperty("value"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.value);
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.TaskExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.phase != null && message.hasOwnProperty("phase"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.phase);
+                    if (message.phaseVersion != null && message.hasOwnProperty("phaseVersion"))
+                        writer.uint32(/* id 3, wireType 0 =*/24).uint32(message.phaseVersion);
                     return writer;
                 };
     
                 /**
-                 * Decodes an ExecutionClusterLabel message from the specified reader or buffer.
+                 * Decodes a TaskExecutionPhaseChange message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ExecutionClusterLabel
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ExecutionClusterLabel} ExecutionClusterLabel
+                 * @returns {flyteidl.admin.TaskExecutionPhaseChange} TaskExecutionPhaseChange
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ExecutionClusterLabel.decode = function decode(reader, length) {
+                TaskExecutionPhaseChange.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionClusterLabel();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TaskExecutionPhaseChange();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.value = reader.string();
+                            message.id = $root.flyteidl.core.TaskExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.phase = reader.int32();
+                            break;
+                        case 3:
+                            message.phaseVersion = reader.uint32();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -38302,46 +39036,93 @@
                 };
     
                 /**
-                 * Verifies an ExecutionClusterLabel message.
+                 * Verifies a TaskExecutionPhaseChange message.
                  * @function verify
-                 * @memberof flyteidl.admin.ExecutionClusterLabel
+                 * @memberof flyteidl.admin.TaskExecutionPhaseChange
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ExecutionClusterLabel.verify = function verify(message) {
+                TaskExecutionPhaseChange.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.value != null && message.hasOwnProperty("value"))
-                        if (!$util.isString(message.value))
-                            return "value: string expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.TaskExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.phase != null && message.hasOwnProperty("phase"))
+                        switch (message.phase) {
+                        default:
+                            return "phase: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                        case 5:
+                        case 6:
+                        case 7:
+                        case 8:
+                            break;
+                        }
+                    if (message.phaseVersion != null && message.hasOwnProperty("phaseVersion"))
+                        if (!$util.isInteger(message.phaseVersion))
+                            return "phaseVersion: integer expected";
                     return null;
                 };
     
-                return ExecutionClusterLabel;
+                return TaskExecutionPhaseChange;
             })();
     
-            admin.PluginOverride = (function() {
+            /**
+             * MatchableResource enum.
+             * @name flyteidl.admin.MatchableResource
+             * @enum {string}
+             * @property {number} TASK_RESOURCE=0 TASK_RESOURCE value
+             * @property {number} CLUSTER_RESOURCE=1 CLUSTER_RESOURCE value
+             * @property {number} EXECUTION_QUEUE=2 EXECUTION_QUEUE value
+             * @property {number} EXECUTION_CLUSTER_LABEL=3 EXECUTION_CLUSTER_LABEL value
+             * @property {number} QUALITY_OF_SERVICE_SPECIFICATION=4 QUALITY_OF_SERVICE_SPECIFICATION value
+             * @property {number} PLUGIN_OVERRIDE=5 PLUGIN_OVERRIDE value
+             * @property {number} WORKFLOW_EXECUTION_CONFIG=6 WORKFLOW_EXECUTION_CONFIG value
+             * @property {number} CLUSTER_ASSIGNMENT=7 CLUSTER_ASSIGNMENT value
+             */
+            admin.MatchableResource = (function() {
+                var valuesById = {}, values = Object.create(valuesById);
+                values[valuesById[0] = "TASK_RESOURCE"] = 0;
+                values[valuesById[1] = "CLUSTER_RESOURCE"] = 1;
+                values[valuesById[2] = "EXECUTION_QUEUE"] = 2;
+                values[valuesById[3] = "EXECUTION_CLUSTER_LABEL"] = 3;
+                values[valuesById[4] = "QUALITY_OF_SERVICE_SPECIFICATION"] = 4;
+                values[valuesById[5] = "PLUGIN_OVERRIDE"] = 5;
+                values[valuesById[6] = "WORKFLOW_EXECUTION_CONFIG"] = 6;
+                values[valuesById[7] = "CLUSTER_ASSIGNMENT"] = 7;
+                return values;
+            })();
+    
+            admin.TaskResourceSpec = (function() {
     
                 /**
-                 * Properties of a PluginOverride.
+                 * Properties of a TaskResourceSpec.
                  * @memberof flyteidl.admin
-                 * @interface IPluginOverride
-                 * @property {string|null} [taskType] PluginOverride taskType
-                 * @property {Array.<string>|null} [pluginId] PluginOverride pluginId
-                 * @property {flyteidl.admin.PluginOverride.MissingPluginBehavior|null} [missingPluginBehavior] PluginOverride missingPluginBehavior
+                 * @interface ITaskResourceSpec
+                 * @property {string|null} [cpu] TaskResourceSpec cpu
+                 * @property {string|null} [gpu] TaskResourceSpec gpu
+                 * @property {string|null} [memory] TaskResourceSpec memory
+                 * @property {string|null} [storage] TaskResourceSpec storage
+                 * @property {string|null} [ephemeralStorage] TaskResourceSpec ephemeralStorage
                  */
     
                 /**
-                 * Constructs a new PluginOverride.
+                 * Constructs a new TaskResourceSpec.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a PluginOverride.
-                 * @implements IPluginOverride
+                 * @classdesc Represents a TaskResourceSpec.
+                 * @implements ITaskResourceSpec
                  * @constructor
-                 * @param {flyteidl.admin.IPluginOverride=} [properties] Properties to set
+                 * @param {flyteidl.admin.ITaskResourceSpec=} [properties] Properties to set
                  */
-                function PluginOverride(properties) {
-                    this.pluginId = [];
+                function TaskResourceSpec(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -38349,91 +39130,114 @@
                 }
     
                 /**
-                 * PluginOverride taskType.
-                 * @member {string} taskType
-                 * @memberof flyteidl.admin.PluginOverride
+                 * TaskResourceSpec cpu.
+                 * @member {string} cpu
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @instance
                  */
-                PluginOverride.prototype.taskType = "";
+                TaskResourceSpec.prototype.cpu = "";
     
                 /**
-                 * PluginOverride pluginId.
-                 * @member {Array.<string>} pluginId
-                 * @memberof flyteidl.admin.PluginOverride
+                 * TaskResourceSpec gpu.
+                 * @member {string} gpu
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @instance
                  */
-                PluginOverride.prototype.pluginId = $util.emptyArray;
+                TaskResourceSpec.prototype.gpu = "";
     
                 /**
-                 * PluginOverride missingPluginBehavior.
-                 * @member {flyteidl.admin.PluginOverride.MissingPluginBehavior} missingPluginBehavior
-                 * @memberof flyteidl.admin.PluginOverride
+                 * TaskResourceSpec memory.
+                 * @member {string} memory
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @instance
                  */
-                PluginOverride.prototype.missingPluginBehavior = 0;
+                TaskResourceSpec.prototype.memory = "";
     
                 /**
-                 * Creates a new PluginOverride instance using the specified properties.
+                 * TaskResourceSpec storage.
+                 * @member {string} storage
+                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * @instance
+                 */
+                TaskResourceSpec.prototype.storage = "";
+    
+                /**
+                 * TaskResourceSpec ephemeralStorage.
+                 * @member {string} ephemeralStorage
+                 * @memberof flyteidl.admin.TaskResourceSpec
+                 * @instance
+                 */
+                TaskResourceSpec.prototype.ephemeralStorage = "";
+    
+                /**
+                 * Creates a new TaskResourceSpec instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.PluginOverride
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @static
-                 * @param {flyteidl.admin.IPluginOverride=} [properties] Properties to set
-                 * @returns {flyteidl.admin.PluginOverride} PluginOverride instance
+                 * @param {flyteidl.admin.ITaskResourceSpec=} [properties] Properties to set
+                 * @returns {flyteidl.admin.TaskResourceSpec} TaskResourceSpec instance
                  */
-                PluginOverride.create = function create(properties) {
-                    return new PluginOverride(properties);
+                TaskResourceSpec.create = function create(properties) {
+                    return new TaskResourceSpec(properties);
                 };
     
                 /**
-                 * Encodes the specified PluginOverride message. Does not implicitly {@link flyteidl.admin.PluginOverride.verify|verify} messages.
+                 * Encodes the specified TaskResourceSpec message. Does not implicitly {@link flyteidl.admin.TaskResourceSpec.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.PluginOverride
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @static
-                 * @param {flyteidl.admin.IPluginOverride} message PluginOverride message or plain object to encode
+                 * @param {flyteidl.admin.ITaskResourceSpec} message TaskResourceSpec message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                PluginOverride.encode = function encode(message, writer) {
+                TaskResourceSpec.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.taskType != null && message.hasOwnProperty("taskType"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.taskType);
-                    if (message.pluginId != null && message.pluginId.length)
-                        for (var i = 0; i < message.pluginId.length; ++i)
-                            writer.uint32(/* id 2, wireType 2 =*/18).string(message.pluginId[i]);
-                    if (message.missingPluginBehavior != null && message.hasOwnProperty("missingPluginBehavior"))
-                        writer.uint32(/* id 4, wireType 0 =*/32).int32(message.missingPluginBehavior);
+                    if (message.cpu != null && message.hasOwnProperty("cpu"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.cpu);
+                    if (message.gpu != null && message.hasOwnProperty("gpu"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.gpu);
+                    if (message.memory != null && message.hasOwnProperty("memory"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.memory);
+                    if (message.storage != null && message.hasOwnProperty("storage"))
+                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.storage);
+                    if (message.ephemeralStorage != null && message.hasOwnProperty("ephemeralStorage"))
+                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.ephemeralStorage);
                     return writer;
                 };
     
                 /**
-                 * Decodes a PluginOverride message from the specified reader or buffer.
+                 * Decodes a TaskResourceSpec message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.PluginOverride
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.PluginOverride} PluginOverride
+                 * @returns {flyteidl.admin.TaskResourceSpec} TaskResourceSpec
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                PluginOverride.decode = function decode(reader, length) {
+                TaskResourceSpec.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.PluginOverride();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TaskResourceSpec();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.taskType = reader.string();
+                            message.cpu = reader.string();
                             break;
                         case 2:
-                            if (!(message.pluginId && message.pluginId.length))
-                                message.pluginId = [];
-                            message.pluginId.push(reader.string());
+                            message.gpu = reader.string();
+                            break;
+                        case 3:
+                            message.memory = reader.string();
                             break;
                         case 4:
-                            message.missingPluginBehavior = reader.int32();
+                            message.storage = reader.string();
+                            break;
+                        case 5:
+                            message.ephemeralStorage = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -38444,73 +39248,56 @@
                 };
     
                 /**
-                 * Verifies a PluginOverride message.
+                 * Verifies a TaskResourceSpec message.
                  * @function verify
-                 * @memberof flyteidl.admin.PluginOverride
+                 * @memberof flyteidl.admin.TaskResourceSpec
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                PluginOverride.verify = function verify(message) {
+                TaskResourceSpec.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.taskType != null && message.hasOwnProperty("taskType"))
-                        if (!$util.isString(message.taskType))
-                            return "taskType: string expected";
-                    if (message.pluginId != null && message.hasOwnProperty("pluginId")) {
-                        if (!Array.isArray(message.pluginId))
-                            return "pluginId: array expected";
-                        for (var i = 0; i < message.pluginId.length; ++i)
-                            if (!$util.isString(message.pluginId[i]))
-                                return "pluginId: string[] expected";
-                    }
-                    if (message.missingPluginBehavior != null && message.hasOwnProperty("missingPluginBehavior"))
-                        switch (message.missingPluginBehavior) {
-                        default:
-                            return "missingPluginBehavior: enum value expected";
-                        case 0:
-                        case 1:
-                            break;
-                        }
+                    if (message.cpu != null && message.hasOwnProperty("cpu"))
+                        if (!$util.isString(message.cpu))
+                            return "cpu: string expected";
+                    if (message.gpu != null && message.hasOwnProperty("gpu"))
+                        if (!$util.isString(message.gpu))
+                            return "gpu: string expected";
+                    if (message.memory != null && message.hasOwnProperty("memory"))
+                        if (!$util.isString(message.memory))
+                            return "memory: string expected";
+                    if (message.storage != null && message.hasOwnProperty("storage"))
+                        if (!$util.isString(message.storage))
+                            return "storage: string expected";
+                    if (message.ephemeralStorage != null && message.hasOwnProperty("ephemeralStorage"))
+                        if (!$util.isString(message.ephemeralStorage))
+                            return "ephemeralStorage: string expected";
                     return null;
                 };
     
-                /**
-                 * MissingPluginBehavior enum.
-                 * @name flyteidl.admin.PluginOverride.MissingPluginBehavior
-                 * @enum {string}
-                 * @property {number} FAIL=0 FAIL value
-                 * @property {number} USE_DEFAULT=1 USE_DEFAULT value
-                 */
-                PluginOverride.MissingPluginBehavior = (function() {
-                    var valuesById = {}, values = Object.create(valuesById);
-                    values[valuesById[0] = "FAIL"] = 0;
-                    values[valuesById[1] = "USE_DEFAULT"] = 1;
-                    return values;
-                })();
-    
-                return PluginOverride;
+                return TaskResourceSpec;
             })();
     
-            admin.PluginOverrides = (function() {
+            admin.TaskResourceAttributes = (function() {
     
                 /**
-                 * Properties of a PluginOverrides.
+                 * Properties of a TaskResourceAttributes.
                  * @memberof flyteidl.admin
-                 * @interface IPluginOverrides
-                 * @property {Array.<flyteidl.admin.IPluginOverride>|null} [overrides] PluginOverrides overrides
+                 * @interface ITaskResourceAttributes
+                 * @property {flyteidl.admin.ITaskResourceSpec|null} [defaults] TaskResourceAttributes defaults
+                 * @property {flyteidl.admin.ITaskResourceSpec|null} [limits] TaskResourceAttributes limits
                  */
     
                 /**
-                 * Constructs a new PluginOverrides.
+                 * Constructs a new TaskResourceAttributes.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a PluginOverrides.
-                 * @implements IPluginOverrides
+                 * @classdesc Represents a TaskResourceAttributes.
+                 * @implements ITaskResourceAttributes
                  * @constructor
-                 * @param {flyteidl.admin.IPluginOverrides=} [properties] Properties to set
+                 * @param {flyteidl.admin.ITaskResourceAttributes=} [properties] Properties to set
                  */
-                function PluginOverrides(properties) {
-                    this.overrides = [];
+                function TaskResourceAttributes(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -38518,65 +39305,75 @@
                 }
     
                 /**
-                 * PluginOverrides overrides.
-                 * @member {Array.<flyteidl.admin.IPluginOverride>} overrides
-                 * @memberof flyteidl.admin.PluginOverrides
+                 * TaskResourceAttributes defaults.
+                 * @member {flyteidl.admin.ITaskResourceSpec|null|undefined} defaults
+                 * @memberof flyteidl.admin.TaskResourceAttributes
                  * @instance
                  */
-                PluginOverrides.prototype.overrides = $util.emptyArray;
+                TaskResourceAttributes.prototype.defaults = null;
     
                 /**
-                 * Creates a new PluginOverrides instance using the specified properties.
+                 * TaskResourceAttributes limits.
+                 * @member {flyteidl.admin.ITaskResourceSpec|null|undefined} limits
+                 * @memberof flyteidl.admin.TaskResourceAttributes
+                 * @instance
+                 */
+                TaskResourceAttributes.prototype.limits = null;
+    
+                /**
+                 * Creates a new TaskResourceAttributes instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.PluginOverrides
+                 * @memberof flyteidl.admin.TaskResourceAttributes
                  * @static
-                 * @param {flyteidl.admin.IPluginOverrides=} [properties] Properties to set
-                 * @returns {flyteidl.admin.PluginOverrides} PluginOverrides instance
+                 * @param {flyteidl.admin.ITaskResourceAttributes=} [properties] Properties to set
+                 * @returns {flyteidl.admin.TaskResourceAttributes} TaskResourceAttributes instance
                  */
-                PluginOverrides.create = function create(properties) {
-                    return new PluginOverrides(properties);
+                TaskResourceAttributes.create = function create(properties) {
+                    return new TaskResourceAttributes(properties);
                 };
     
                 /**
-                 * Encodes the specified PluginOverrides message. Does not implicitly {@link flyteidl.admin.PluginOverrides.verify|verify} messages.
+                 * Encodes the specified TaskResourceAttributes message. Does not implicitly {@link flyteidl.admin.TaskResourceAttributes.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.PluginOverrides
+                 * @memberof flyteidl.admin.TaskResourceAttributes
                  * @static
-                 * @param {flyteidl.admin.IPluginOverrides} message PluginOverrides message or plain object to encode
+                 * @param {flyteidl.admin.ITaskResourceAttributes} message TaskResourceAttributes message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                PluginOverrides.encode = function encode(message, writer) {
+                TaskResourceAttributes.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.overrides != null && message.overrides.length)
-                        for (var i = 0; i < message.overrides.length; ++i)
-                            $root.flyteidl.admin.PluginOverride.encode(message.overrides[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.defaults != null && message.hasOwnProperty("defaults"))
+                        $root.flyteidl.admin.TaskResourceSpec.encode(message.defaults, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.limits != null && message.hasOwnProperty("limits"))
+                        $root.flyteidl.admin.TaskResourceSpec.encode(message.limits, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a PluginOverrides message from the specified reader or buffer.
+                 * Decodes a TaskResourceAttributes message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.PluginOverrides
+                 * @memberof flyteidl.admin.TaskResourceAttributes
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.PluginOverrides} PluginOverrides
+                 * @returns {flyteidl.admin.TaskResourceAttributes} TaskResourceAttributes
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                PluginOverrides.decode = function decode(reader, length) {
+                TaskResourceAttributes.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.PluginOverrides();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TaskResourceAttributes();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            if (!(message.overrides && message.overrides.length))
-                                message.overrides = [];
-                            message.overrides.push($root.flyteidl.admin.PluginOverride.decode(reader, reader.uint32()));
+                            message.defaults = $root.flyteidl.admin.TaskResourceSpec.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.limits = $root.flyteidl.admin.TaskResourceSpec.decode(reader, reader.uint32());
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -38587,58 +39384,51 @@
                 };
     
                 /**
-                 * Verifies a PluginOverrides message.
+                 * Verifies a TaskResourceAttributes message.
                  * @function verify
-                 * @memberof flyteidl.admin.PluginOverrides
+                 * @memberof flyteidl.admin.TaskResourceAttributes
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                PluginOverrides.verify = function verify(message) {
+                TaskResourceAttributes.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.overrides != null && message.hasOwnProperty("overrides")) {
-                        if (!Array.isArray(message.overrides))
-                            return "overrides: array expected";
-                        for (var i = 0; i < message.overrides.length; ++i) {
-                            var error = $root.flyteidl.admin.PluginOverride.verify(message.overrides[i]);
-                            if (error)
-                                return "overrides." + error;
-                        }
+                    if (message.defaults != null && message.hasOwnProperty("defaults")) {
+                        var error = $root.flyteidl.admin.TaskResourceSpec.verify(message.defaults);
+                        if (error)
+                            return "defaults." + error;
+                    }
+                    if (message.limits != null && message.hasOwnProperty("limits")) {
+                        var error = $root.flyteidl.admin.TaskResourceSpec.verify(message.limits);
+                        if (error)
+                            return "limits." + error;
                     }
                     return null;
                 };
     
-                return PluginOverrides;
+                return TaskResourceAttributes;
             })();
     
-            admin.WorkflowExecutionConfig = (function() {
+            admin.ClusterResourceAttributes = (function() {
     
                 /**
-                 * Properties of a WorkflowExecutionConfig.
+                 * Properties of a ClusterResourceAttributes.
                  * @memberof flyteidl.admin
-                 * @interface IWorkflowExecutionConfig
-                 * @property {number|null} [maxParallelism] WorkflowExecutionConfig maxParallelism
-                 * @property {flyteidl.core.ISecurityContext|null} [securityContext] WorkflowExecutionConfig securityContext
-                 * @property {flyteidl.admin.IRawOutputDataConfig|null} [rawOutputDataConfig] WorkflowExecutionConfig rawOutputDataConfig
-                 * @property {flyteidl.admin.ILabels|null} [labels] WorkflowExecutionConfig labels
-                 * @property {flyteidl.admin.IAnnotations|null} [annotations] WorkflowExecutionConfig annotations
-                 * @property {google.protobuf.IBoolValue|null} [interruptible] WorkflowExecutionConfig interruptible
-                 * @property {boolean|null} [overwriteCache] WorkflowExecutionConfig overwriteCache
-                 * @property {flyteidl.admin.IEnvs|null} [envs] WorkflowExecutionConfig envs
-                 * @property {Array.<flyteidl.core.IExecutionEnvAssignment>|null} [executionEnvAssignments] WorkflowExecutionConfig executionEnvAssignments
+                 * @interface IClusterResourceAttributes
+                 * @property {Object.<string,string>|null} [attributes] ClusterResourceAttributes attributes
                  */
     
                 /**
-                 * Constructs a new WorkflowExecutionConfig.
+                 * Constructs a new ClusterResourceAttributes.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a WorkflowExecutionConfig.
-                 * @implements IWorkflowExecutionConfig
+                 * @classdesc Represents a ClusterResourceAttributes.
+                 * @implements IClusterResourceAttributes
                  * @constructor
-                 * @param {flyteidl.admin.IWorkflowExecutionConfig=} [properties] Properties to set
+                 * @param {flyteidl.admin.IClusterResourceAttributes=} [properties] Properties to set
                  */
-                function WorkflowExecutionConfig(properties) {
-                    this.executionEnvAssignments = [];
+                function ClusterResourceAttributes(properties) {
+                    this.attributes = {};
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -38646,169 +39436,187 @@
                 }
     
                 /**
-                 * WorkflowExecutionConfig maxParallelism.
-                 * @member {number} maxParallelism
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * ClusterResourceAttributes attributes.
+                 * @member {Object.<string,string>} attributes
+                 * @memberof flyteidl.admin.ClusterResourceAttributes
                  * @instance
                  */
-                WorkflowExecutionConfig.prototype.maxParallelism = 0;
+                ClusterResourceAttributes.prototype.attributes = $util.emptyObject;
     
                 /**
-                 * WorkflowExecutionConfig securityContext.
-                 * @member {flyteidl.core.ISecurityContext|null|undefined} securityContext
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
+                 * Creates a new ClusterResourceAttributes instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @static
+                 * @param {flyteidl.admin.IClusterResourceAttributes=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ClusterResourceAttributes} ClusterResourceAttributes instance
                  */
-                WorkflowExecutionConfig.prototype.securityContext = null;
+                ClusterResourceAttributes.create = function create(properties) {
+                    return new ClusterResourceAttributes(properties);
+                };
     
                 /**
-                 * WorkflowExecutionConfig rawOutputDataConfig.
-                 * @member {flyteidl.admin.IRawOutputDataConfig|null|undefined} rawOutputDataConfig
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
+                 * Encodes the specified ClusterResourceAttributes message. Does not implicitly {@link flyteidl.admin.ClusterResourceAttributes.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @static
+                 * @param {flyteidl.admin.IClusterResourceAttributes} message ClusterResourceAttributes message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
                  */
-                WorkflowExecutionConfig.prototype.rawOutputDataConfig = null;
+                ClusterResourceAttributes.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.attributes != null && message.hasOwnProperty("attributes"))
+                        for (var keys = Object.keys(message.attributes), i = 0; i < keys.length; ++i)
+                            writer.uint32(/* id 1, wireType 2 =*/10).fork().uint32(/* id 1, wireType 2 =*/10).string(keys[i]).uint32(/* id 2, wireType 2 =*/18).string(message.attributes[keys[i]]).ldelim();
+                    return writer;
+                };
     
                 /**
-                 * WorkflowExecutionConfig labels.
-                 * @member {flyteidl.admin.ILabels|null|undefined} labels
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
+                 * Decodes a ClusterResourceAttributes message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ClusterResourceAttributes} ClusterResourceAttributes
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                WorkflowExecutionConfig.prototype.labels = null;
+                ClusterResourceAttributes.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ClusterResourceAttributes(), key;
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            reader.skip().pos++;
+                            if (message.attributes === $util.emptyObject)
+                                message.attributes = {};
+                            key = reader.string();
+                            reader.pos++;
+                            message.attributes[key] = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
     
                 /**
-                 * WorkflowExecutionConfig annotations.
-                 * @member {flyteidl.admin.IAnnotations|null|undefined} annotations
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
+                 * Verifies a ClusterResourceAttributes message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ClusterResourceAttributes
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                WorkflowExecutionConfig.prototype.annotations = null;
+                ClusterResourceAttributes.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.attributes != null && message.hasOwnProperty("attributes")) {
+                        if (!$util.isObject(message.attributes))
+                            return "attributes: object expected";
+                        var key = Object.keys(message.attributes);
+                        for (var i = 0; i < key.length; ++i)
+                            if (!$util.isString(message.attributes[key[i]]))
+                                return "attributes: string{k:string} expected";
+                    }
+                    return null;
+                };
     
-                /**
-                 * WorkflowExecutionConfig interruptible.
-                 * @member {google.protobuf.IBoolValue|null|undefined} interruptible
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
-                 */
-                WorkflowExecutionConfig.prototype.interruptible = null;
+                return ClusterResourceAttributes;
+            })();
+    
+            admin.ExecutionQueueAttributes = (function() {
     
                 /**
-                 * WorkflowExecutionConfig overwriteCache.
-                 * @member {boolean} overwriteCache
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
+                 * Properties of an ExecutionQueueAttributes.
+                 * @memberof flyteidl.admin
+                 * @interface IExecutionQueueAttributes
+                 * @property {Array.<string>|null} [tags] ExecutionQueueAttributes tags
                  */
-                WorkflowExecutionConfig.prototype.overwriteCache = false;
     
                 /**
-                 * WorkflowExecutionConfig envs.
-                 * @member {flyteidl.admin.IEnvs|null|undefined} envs
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
-                 * @instance
+                 * Constructs a new ExecutionQueueAttributes.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ExecutionQueueAttributes.
+                 * @implements IExecutionQueueAttributes
+                 * @constructor
+                 * @param {flyteidl.admin.IExecutionQueueAttributes=} [properties] Properties to set
                  */
-                WorkflowExecutionConfig.prototype.envs = null;
+                function ExecutionQueueAttributes(properties) {
+                    this.tags = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
     
                 /**
-                 * WorkflowExecutionConfig executionEnvAssignments.
-                 * @member {Array.<flyteidl.core.IExecutionEnvAssignment>} executionEnvAssignments
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * ExecutionQueueAttributes tags.
+                 * @member {Array.<string>} tags
+                 * @memberof flyteidl.admin.ExecutionQueueAttributes
                  * @instance
                  */
-                WorkflowExecutionConfig.prototype.executionEnvAssignments = $util.emptyArray;
+                ExecutionQueueAttributes.prototype.tags = $util.emptyArray;
     
                 /**
-                 * Creates a new WorkflowExecutionConfig instance using the specified properties.
+                 * Creates a new ExecutionQueueAttributes instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @memberof flyteidl.admin.ExecutionQueueAttributes
                  * @static
-                 * @param {flyteidl.admin.IWorkflowExecutionConfig=} [properties] Properties to set
-                 * @returns {flyteidl.admin.WorkflowExecutionConfig} WorkflowExecutionConfig instance
+                 * @param {flyteidl.admin.IExecutionQueueAttributes=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ExecutionQueueAttributes} ExecutionQueueAttributes instance
                  */
-                WorkflowExecutionConfig.create = function create(properties) {
-                    return new WorkflowExecutionConfig(properties);
+                ExecutionQueueAttributes.create = function create(properties) {
+                    return new ExecutionQueueAttributes(properties);
                 };
     
                 /**
-                 * Encodes the specified WorkflowExecutionConfig message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionConfig.verify|verify} messages.
+                 * Encodes the specified ExecutionQueueAttributes message. Does not implicitly {@link flyteidl.admin.ExecutionQueueAttributes.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @memberof flyteidl.admin.ExecutionQueueAttributes
                  * @static
-                 * @param {flyteidl.admin.IWorkflowExecutionConfig} message WorkflowExecutionConfig message or plain object to encode
+                 * @param {flyteidl.admin.IExecutionQueueAttributes} message ExecutionQueueAttributes message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                WorkflowExecutionConfig.encode = function encode(message, writer) {
+                ExecutionQueueAttributes.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
-                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.maxParallelism);
-                    if (message.securityContext != null && message.hasOwnProperty("securityContext"))
-                        $root.flyteidl.core.SecurityContext.encode(message.securityContext, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig"))
-                        $root.flyteidl.admin.RawOutputDataConfig.encode(message.rawOutputDataConfig, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
-                    if (message.labels != null && message.hasOwnProperty("labels"))
-                        $root.flyteidl.admin.Labels.encode(message.labels, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
-                    if (message.annotations != null && message.hasOwnProperty("annotations"))
-                        $root.flyteidl.admin.Annotations.encode(message.annotations, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
-                    if (message.interruptible != null && message.hasOwnProperty("interruptible"))
-                        $root.google.protobuf.BoolValue.encode(message.interruptible, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
-                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
-                        writer.uint32(/* id 7, wireType 0 =*/56).bool(message.overwriteCache);
-                    if (message.envs != null && message.hasOwnProperty("envs"))
-                        $root.flyteidl.admin.Envs.encode(message.envs, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
-                    if (message.executionEnvAssignments != null && message.executionEnvAssignments.length)
-                        for (var i = 0; i < message.executionEnvAssignments.length; ++i)
-                            $root.flyteidl.core.ExecutionEnvAssignment.encode(message.executionEnvAssignments[i], writer.uint32(/* id 9, wireType 2 =*/74).fork()).ldelim();
+                    if (message.tags != null && message.tags.length)
+                        for (var i = 0; i < message.tags.length; ++i)
+                            writer.uint32(/* id 1, wireType 2 =*/10).string(message.tags[i]);
                     return writer;
                 };
     
                 /**
-                 * Decodes a WorkflowExecutionConfig message from the specified reader or buffer.
+                 * Decodes an ExecutionQueueAttributes message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @memberof flyteidl.admin.ExecutionQueueAttributes
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.WorkflowExecutionConfig} WorkflowExecutionConfig
+                 * @returns {flyteidl.admin.ExecutionQueueAttributes} ExecutionQueueAttributes
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                WorkflowExecutionConfig.decode = function decode(reader, length) {
+                ExecutionQueueAttributes.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowExecutionConfig();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionQueueAttributes();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.maxParallelism = reader.int32();
-                            break;
-                        case 2:
-                            message.securityContext = $root.flyteidl.core.SecurityContext.decode(reader, reader.uint32());
-                            break;
-                        case 3:
-                            message.rawOutputDataConfig = $root.flyteidl.admin.RawOutputDataConfig.decode(reader, reader.uint32());
-                            break;
-                        case 4:
-                            message.labels = $root.flyteidl.admin.Labels.decode(reader, reader.uint32());
-                            break;
-                        case 5:
-                            message.annotations = $root.flyteidl.admin.Annotations.decode(reader, reader.uint32());
-                            break;
-                        case 6:
-                            message.interruptible = $root.google.protobuf.BoolValue.decode(reader, reader.uint32());
-                            break;
-                        case 7:
-                            message.overwriteCache = reader.bool();
-                            break;
-                        case 8:
-                            message.envs = $root.flyteidl.admin.Envs.decode(reader, reader.uint32());
-                            break;
-                        case 9:
-                            if (!(message.executionEnvAssignments && message.executionEnvAssignments.length))
-                                message.executionEnvAssignments = [];
-                            message.executionEnvAssignments.push($root.flyteidl.core.ExecutionEnvAssignment.decode(reader, reader.uint32()));
+                            if (!(message.tags && message.tags.length))
+                                message.tags = [];
+                            message.tags.push(reader.string());
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -38819,92 +39627,47 @@
                 };
     
                 /**
-                 * Verifies a WorkflowExecutionConfig message.
+                 * Verifies an ExecutionQueueAttributes message.
                  * @function verify
-                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @memberof flyteidl.admin.ExecutionQueueAttributes
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                WorkflowExecutionConfig.verify = function verify(message) {
+                ExecutionQueueAttributes.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
-                        if (!$util.isInteger(message.maxParallelism))
-                            return "maxParallelism: integer expected";
-                    if (message.securityContext != null && message.hasOwnProperty("securityContext")) {
-                        var error = $root.flyteidl.core.SecurityContext.verify(message.securityContext);
-                        if (error)
-                            return "securityContext." + error;
-                    }
-                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig")) {
-                        var error = $root.flyteidl.admin.RawOutputDataConfig.verify(message.rawOutputDataConfig);
-                        if (error)
-                            return "rawOutputDataConfig." + error;
-                    }
-                    if (message.labels != null && message.hasOwnProperty("labels")) {
-                        var error = $root.flyteidl.admin.Labels.verify(message.labels);
-                        if (error)
-                            return "labels." + error;
-                    }
-                    if (message.annotations != null && message.hasOwnProperty("annotations")) {
-                        var error = $root.flyteidl.admin.Annotations.verify(message.annotations);
-                        if (error)
-                            return "annotations." + error;
-                    }
-                    if (message.interruptible != null && message.hasOwnProperty("interruptible")) {
-                        var error = $root.google.protobuf.BoolValue.verify(message.interruptible);
-                        if (error)
-                            return "interruptible." + error;
-                    }
-                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
-                        if (typeof message.overwriteCache !== "boolean")
-                            return "overwriteCache: boolean expected";
-                    if (message.envs != null && message.hasOwnProperty("envs")) {
-                        var error = $root.flyteidl.admin.Envs.verify(message.envs);
-                        if (error)
-                            return "envs." + error;
-                    }
-                    if (message.executionEnvAssignments != null && message.hasOwnProperty("executionEnvAssignments")) {
-                        if (!Array.isArray(message.executionEnvAssignments))
-                            return "executionEnvAssignments: array expected";
-                        for (var i = 0; i < message.executionEnvAssignments.length; ++i) {
-                            var error = $root.flyteidl.core.ExecutionEnvAssignment.verify(message.executionEnvAssignments[i]);
-                            if (error)
-                                return "executionEnvAssignments." + error;
-                        }
+                    if (message.tags != null && message.hasOwnProperty("tags")) {
+                        if (!Array.isArray(message.tags))
+                            return "tags: array expected";
+                        for (var i = 0; i < message.tags.length; ++i)
+                            if (!$util.isString(message.tags[i]))
+                                return "tags: string[] expected";
                     }
                     return null;
                 };
     
-                return WorkflowExecutionConfig;
+                return ExecutionQueueAttributes;
             })();
     
-            admin.MatchingAttributes = (function() {
+            admin.ExecutionClusterLabel = (function() {
     
                 /**
-                 * Properties of a MatchingAttributes.
+                 * Properties of an ExecutionClusterLabel.
                  * @memberof flyteidl.admin
-                 * @interface IMatchingAttributes
-                 * @property {flyteidl.admin.ITaskResourceAttributes|null} [taskResourceAttributes] MatchingAttributes taskResourceAttributes
-                 * @property {flyteidl.admin.IClusterResourceAttributes|null} [clusterResourceAttributes] MatchingAttributes clusterResourceAttributes
-                 * @property {flyteidl.admin.IExecutionQueueAttributes|null} [executionQueueAttributes] MatchingAttributes executionQueueAttributes
-                 * @property {flyteidl.admin.IExecutionClusterLabel|null} [executionClusterLabel] MatchingAttributes executionClusterLabel
-                 * @property {flyteidl.core.IQualityOfService|null} [qualityOfService] MatchingAttributes qualityOfService
-                 * @property {flyteidl.admin.IPluginOverrides|null} [pluginOverrides] MatchingAttributes pluginOverrides
-                 * @property {flyteidl.admin.IWorkflowExecutionConfig|null} [workflowExecutionConfig] MatchingAttributes workflowExecutionConfig
-                 * @property {flyteidl.admin.IClusterAssignment|null} [clusterAssignment] MatchingAttributes clusterAssignment
+                 * @interface IExecutionClusterLabel
+                 * @property {string|null} [value] ExecutionClusterLabel value
                  */
     
                 /**
-                 * Constructs a new MatchingAttributes.
+                 * Constructs a new ExecutionClusterLabel.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a MatchingAttributes.
-                 * @implements IMatchingAttributes
+                 * @classdesc Represents an ExecutionClusterLabel.
+                 * @implements IExecutionClusterLabel
                  * @constructor
-                 * @param {flyteidl.admin.IMatchingAttributes=} [properties] Properties to set
+                 * @param {flyteidl.admin.IExecutionClusterLabel=} [properties] Properties to set
                  */
-                function MatchingAttributes(properties) {
+                function ExecutionClusterLabel(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -38912,167 +39675,62 @@
                 }
     
                 /**
-                 * MatchingAttributes taskResourceAttributes.
-                 * @member {flyteidl.admin.ITaskResourceAttributes|null|undefined} taskResourceAttributes
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.taskResourceAttributes = null;
-    
-                /**
-                 * MatchingAttributes clusterResourceAttributes.
-                 * @member {flyteidl.admin.IClusterResourceAttributes|null|undefined} clusterResourceAttributes
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.clusterResourceAttributes = null;
-    
-                /**
-                 * MatchingAttributes executionQueueAttributes.
-                 * @member {flyteidl.admin.IExecutionQueueAttributes|null|undefined} executionQueueAttributes
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.executionQueueAttributes = null;
-    
-                /**
-                 * MatchingAttributes executionClusterLabel.
-                 * @member {flyteidl.admin.IExecutionClusterLabel|null|undefined} executionClusterLabel
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.executionClusterLabel = null;
-    
-                /**
-                 * MatchingAttributes qualityOfService.
-                 * @member {flyteidl.core.IQualityOfService|null|undefined} qualityOfService
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.qualityOfService = null;
-    
-                /**
-                 * MatchingAttributes pluginOverrides.
-                 * @member {flyteidl.admin.IPluginOverrides|null|undefined} pluginOverrides
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.pluginOverrides = null;
-    
-                /**
-                 * MatchingAttributes workflowExecutionConfig.
-                 * @member {flyteidl.admin.IWorkflowExecutionConfig|null|undefined} workflowExecutionConfig
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.workflowExecutionConfig = null;
-    
-                /**
-                 * MatchingAttributes clusterAssignment.
-                 * @member {flyteidl.admin.IClusterAssignment|null|undefined} clusterAssignment
-                 * @memberof flyteidl.admin.MatchingAttributes
-                 * @instance
-                 */
-                MatchingAttributes.prototype.clusterAssignment = null;
-    
-                // OneOf field names bound to virtual getters and setters
-                var $oneOfFields;
-    
-                /**
-                 * MatchingAttributes target.
-                 * @member {"taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment"|undefined} target
-                 * @memberof flyteidl.admin.MatchingAttributes
+                 * ExecutionClusterLabel value.
+                 * @member {string} value
+                 * @memberof flyteidl.admin.ExecutionClusterLabel
                  * @instance
                  */
-                Object.defineProperty(MatchingAttributes.prototype, "target", {
-                    get: $util.oneOfGetter($oneOfFields = ["taskResourceAttributes", "clusterResourceAttributes", "executionQueueAttributes", "executionClusterLabel", "qualityOfService", "pluginOverrides", "workflowExecutionConfig", "clusterAssignment"]),
-                    set: $util.oneOfSetter($oneOfFields)
-                });
+                ExecutionClusterLabel.prototype.value = "";
     
                 /**
-                 * Creates a new MatchingAttributes instance using the specified properties.
+                 * Creates a new ExecutionClusterLabel instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @memberof flyteidl.admin.ExecutionClusterLabel
                  * @static
-                 * @param {flyteidl.admin.IMatchingAttributes=} [properties] Properties to set
-                 * @returns {flyteidl.admin.MatchingAttributes} MatchingAttributes instance
+                 * @param {flyteidl.admin.IExecutionClusterLabel=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ExecutionClusterLabel} ExecutionClusterLabel instance
                  */
-                MatchingAttributes.create = function create(properties) {
-                    return new MatchingAttributes(properties);
+                ExecutionClusterLabel.create = function create(properties) {
+                    return new ExecutionClusterLabel(properties);
                 };
     
                 /**
-                 * Encodes the specified MatchingAttributes message. Does not implicitly {@link flyteidl.admin.MatchingAttributes.verify|verify} messages.
+                 * Encodes the specified ExecutionClusterLabel message. Does not implicitly {@link flyteidl.admin.ExecutionClusterLabel.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @memberof flyteidl.admin.ExecutionClusterLabel
                  * @static
-                 * @param {flyteidl.admin.IMatchingAttributes} message MatchingAttributes message or plain object to encode
+                 * @param {flyteidl.admin.IExecutionClusterLabel} message ExecutionClusterLabel message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                MatchingAttributes.encode = function encode(message, writer) {
+                ExecutionClusterLabel.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.taskResourceAttributes != null && message.hasOwnProperty("taskResourceAttributes"))
-                        $root.flyteidl.admin.TaskResourceAttributes.encode(message.taskResourceAttributes, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.clusterResourceAttributes != null && message.hasOwnProperty("clusterResourceAttributes"))
-                        $root.flyteidl.admin.ClusterResourceAttributes.encode(message.clusterResourceAttributes, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.executionQueueAttributes != null && message.hasOwnProperty("executionQueueAttributes"))
-                        $root.flyteidl.admin.ExecutionQueueAttributes.encode(message.executionQueueAttributes, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
-                    if (message.executionClusterLabel != null && message.hasOwnProperty("executionClusterLabel"))
-                        $root.flyteidl.admin.ExecutionClusterLabel.encode(message.executionClusterLabel, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
-                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService"))
-                        $root.flyteidl.core.QualityOfService.encode(message.qualityOfService, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
-                    if (message.pluginOverrides != null && message.hasOwnProperty("pluginOverrides"))
-                        $root.flyteidl.admin.PluginOverrides.encode(message.pluginOverrides, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
-                    if (message.workflowExecutionConfig != null && message.hasOwnProperty("workflowExecutionConfig"))
-                        $root.flyteidl.admin.WorkflowExecutionConfig.encode(message.workflowExecutionConfig, writer.uint32(/* id 7, wireType 2 =*/58).fork()).ldelim();
-                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment"))
-                        $root.flyteidl.admin.ClusterAssignment.encode(message.clusterAssignment, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
+                    if (message.value != null && message.hasOwnProperty("value"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.value);
                     return writer;
                 };
     
                 /**
-                 * Decodes a MatchingAttributes message from the specified reader or buffer.
+                 * Decodes an ExecutionClusterLabel message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @memberof flyteidl.admin.ExecutionClusterLabel
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.MatchingAttributes} MatchingAttributes
+                 * @returns {flyteidl.admin.ExecutionClusterLabel} ExecutionClusterLabel
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                MatchingAttributes.decode = function decode(reader, length) {
+                ExecutionClusterLabel.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.MatchingAttributes();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ExecutionClusterLabel();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.taskResourceAttributes = $root.flyteidl.admin.TaskResourceAttributes.decode(reader, reader.uint32());
-                            break;
-                        case 2:
-                            message.clusterResourceAttributes = $root.flyteidl.admin.ClusterResourceAttributes.decode(reader, reader.uint32());
-                            break;
-                        case 3:
-                            message.executionQueueAttributes = $root.flyteidl.admin.ExecutionQueueAttributes.decode(reader, reader.uint32());
-                            break;
-                        case 4:
-                            message.executionClusterLabel = $root.flyteidl.admin.ExecutionClusterLabel.decode(reader, reader.uint32());
-                            break;
-                        case 5:
-                            message.qualityOfService = $root.flyteidl.core.QualityOfService.decode(reader, reader.uint32());
-                            break;
-                        case 6:
-                            message.pluginOverrides = $root.flyteidl.admin.PluginOverrides.decode(reader, reader.uint32());
-                            break;
-                        case 7:
-                            message.workflowExecutionConfig = $root.flyteidl.admin.WorkflowExecutionConfig.decode(reader, reader.uint32());
-                            break;
-                        case 8:
-                            message.clusterAssignment = $root.flyteidl.admin.ClusterAssignment.decode(reader, reader.uint32());
+                            message.value = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -39083,124 +39741,46 @@
                 };
     
                 /**
-                 * Verifies a MatchingAttributes message.
+                 * Verifies an ExecutionClusterLabel message.
                  * @function verify
-                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @memberof flyteidl.admin.ExecutionClusterLabel
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                MatchingAttributes.verify = function verify(message) {
+                ExecutionClusterLabel.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    var properties = {};
-                    if (message.taskResourceAttributes != null && message.hasOwnProperty("taskResourceAttributes")) {
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.TaskResourceAttributes.verify(message.taskResourceAttributes);
-                            if (error)
-                                return "taskResourceAttributes." + error;
-                        }
-                    }
-                    if (message.clusterResourceAttributes != null && message.hasOwnProperty("clusterResourceAttributes")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.ClusterResourceAttributes.verify(message.clusterResourceAttributes);
-                            if (error)
-                                return "clusterResourceAttributes." + error;
-                        }
-                    }
-                    if (message.executionQueueAttributes != null && message.hasOwnProperty("executionQueueAttributes")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.ExecutionQueueAttributes.verify(message.executionQueueAttributes);
-                            if (error)
-                                return "executionQueueAttributes." + error;
-                        }
-                    }
-                    if (message.executionClusterLabel != null && message.hasOwnProperty("executionClusterLabel")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.ExecutionClusterLabel.verify(message.executionClusterLabel);
-                            if (error)
-                                return "executionClusterLabel." + error;
-                        }
-                    }
-                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.core.QualityOfService.verify(message.qualityOfService);
-                            if (error)
-                                return "qualityOfService." + error;
-                        }
-                    }
-                    if (message.pluginOverrides != null && message.hasOwnProperty("pluginOverrides")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.PluginOverrides.verify(message.pluginOverrides);
-                            if (error)
-                                return "pluginOverrides." + error;
-                        }
-                    }
-                    if (message.workflowExecutionConfig != null && message.hasOwnProperty("workflowExecutionConfig")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.WorkflowExecutionConfig.verify(message.workflowExecutionConfig);
-                            if (error)
-                                return "workflowExecutionConfig." + error;
-                        }
-                    }
-                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment")) {
-                        if (properties.target === 1)
-                            return "target: multiple values";
-                        properties.target = 1;
-                        {
-                            var error = $root.flyteidl.admin.ClusterAssignment.verify(message.clusterAssignment);
-                            if (error)
-                                return "clusterAssignment." + error;
-                        }
-                    }
+                    if (message.value != null && message.hasOwnProperty("value"))
+                        if (!$util.isString(message.value))
+                            return "value: string expected";
                     return null;
                 };
     
-                return MatchingAttributes;
+                return ExecutionClusterLabel;
             })();
     
-            admin.MatchableAttributesConfiguration = (function() {
+            admin.PluginOverride = (function() {
     
                 /**
-                 * Properties of a MatchableAttributesConfiguration.
+                 * Properties of a PluginOverride.
                  * @memberof flyteidl.admin
-                 * @interface IMatchableAttributesConfiguration
-                 * @property {flyteidl.admin.IMatchingAttributes|null} [attributes] MatchableAttributesConfiguration attributes
-                 * @property {string|null} [domain] MatchableAttributesConfiguration domain
-                 * @property {string|null} [project] MatchableAttributesConfiguration project
-                 * @property {string|null} [workflow] MatchableAttributesConfiguration workflow
-                 * @property {string|null} [launchPlan] MatchableAttributesConfiguration launchPlan
-                 * @property {string|null} [org] MatchableAttributesConfiguration org
+                 * @interface IPluginOverride
+                 * @property {string|null} [taskType] PluginOverride taskType
+                 * @property {Array.<string>|null} [pluginId] PluginOverride pluginId
+                 * @property {flyteidl.admin.PluginOverride.MissingPluginBehavior|null} [missingPluginBehavior] PluginOverride missingPluginBehavior
                  */
     
                 /**
-                 * Constructs a new MatchableAttributesConfiguration.
+                 * Constructs a new PluginOverride.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a MatchableAttributesConfiguration.
-                 * @implements IMatchableAttributesConfiguration
+                 * @classdesc Represents a PluginOverride.
+                 * @implements IPluginOverride
                  * @constructor
-                 * @param {flyteidl.admin.IMatchableAttributesConfiguration=} [properties] Properties to set
+                 * @param {flyteidl.admin.IPluginOverride=} [properties] Properties to set
                  */
-                function MatchableAttributesConfiguration(properties) {
+                function PluginOverride(properties) {
+                    this.pluginId = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -39208,127 +39788,91 @@
                 }
     
                 /**
-                 * MatchableAttributesConfiguration attributes.
-                 * @member {flyteidl.admin.IMatchingAttributes|null|undefined} attributes
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
-                 * @instance
-                 */
-                MatchableAttributesConfiguration.prototype.attributes = null;
-    
-                /**
-                 * MatchableAttributesConfiguration domain.
-                 * @member {string} domain
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
-                 * @instance
-                 */
-                MatchableAttributesConfiguration.prototype.domain = "";
-    
-                /**
-                 * MatchableAttributesConfiguration project.
-                 * @member {string} project
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
-                 * @instance
-                 */
-                MatchableAttributesConfiguration.prototype.project = "";
-    
-                /**
-                 * MatchableAttributesConfiguration workflow.
-                 * @member {string} workflow
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * PluginOverride taskType.
+                 * @member {string} taskType
+                 * @memberof flyteidl.admin.PluginOverride
                  * @instance
                  */
-                MatchableAttributesConfiguration.prototype.workflow = "";
+                PluginOverride.prototype.taskType = "";
     
                 /**
-                 * MatchableAttributesConfiguration launchPlan.
-                 * @member {string} launchPlan
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * PluginOverride pluginId.
+                 * @member {Array.<string>} pluginId
+                 * @memberof flyteidl.admin.PluginOverride
                  * @instance
                  */
-                MatchableAttributesConfiguration.prototype.launchPlan = "";
+                PluginOverride.prototype.pluginId = $util.emptyArray;
     
                 /**
-                 * MatchableAttributesConfiguration org.
-                 * @member {string} org
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * PluginOverride missingPluginBehavior.
+                 * @member {flyteidl.admin.PluginOverride.MissingPluginBehavior} missingPluginBehavior
+                 * @memberof flyteidl.admin.PluginOverride
                  * @instance
                  */
-                MatchableAttributesConfiguration.prototype.org = "";
+                PluginOverride.prototype.missingPluginBehavior = 0;
     
                 /**
-                 * Creates a new MatchableAttributesConfiguration instance using the specified properties.
+                 * Creates a new PluginOverride instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @memberof flyteidl.admin.PluginOverride
                  * @static
-                 * @param {flyteidl.admin.IMatchableAttributesConfiguration=} [properties] Properties to set
-                 * @returns {flyteidl.admin.MatchableAttributesConfiguration} MatchableAttributesConfiguration instance
+                 * @param {flyteidl.admin.IPluginOverride=} [properties] Properties to set
+                 * @returns {flyteidl.admin.PluginOverride} PluginOverride instance
                  */
-                MatchableAttributesConfiguration.create = function create(properties) {
-                    return new MatchableAttributesConfiguration(properties);
+                PluginOverride.create = function create(properties) {
+                    return new PluginOverride(properties);
                 };
     
                 /**
-                 * Encodes the specified MatchableAttributesConfiguration message. Does not implicitly {@link flyteidl.admin.MatchableAttributesConfiguration.verify|verify} messages.
+                 * Encodes the specified PluginOverride message. Does not implicitly {@link flyteidl.admin.PluginOverride.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @memberof flyteidl.admin.PluginOverride
                  * @static
-                 * @param {flyteidl.admin.IMatchableAttributesConfiguration} message MatchableAttributesConfiguration message or plain object to encode
+                 * @param {flyteidl.admin.IPluginOverride} message PluginOverride message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                MatchableAttributesConfiguration.encode = function encode(message, writer) {
+                PluginOverride.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.attributes != null && message.hasOwnProperty("attributes"))
-                        $root.flyteidl.admin.MatchingAttributes.encode(message.attributes, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.domain != null && message.hasOwnProperty("domain"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.domain);
-                    if (message.project != null && message.hasOwnProperty("project"))
-                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.project);
-                    if (message.workflow != null && message.hasOwnProperty("workflow"))
-                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.workflow);
-                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan"))
-                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.launchPlan);
-                    if (message.org != null && message.hasOwnProperty("org"))
-                        writer.uint32(/* id 6, wireType 2 =*/50).string(message.org);
+                    if (message.taskType != null && message.hasOwnProperty("taskType"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.taskType);
+                    if (message.pluginId != null && message.pluginId.length)
+                        for (var i = 0; i < message.pluginId.length; ++i)
+                            writer.uint32(/* id 2, wireType 2 =*/18).string(message.pluginId[i]);
+                    if (message.missingPluginBehavior != null && message.hasOwnProperty("missingPluginBehavior"))
+                        writer.uint32(/* id 4, wireType 0 =*/32).int32(message.missingPluginBehavior);
                     return writer;
                 };
     
                 /**
-                 * Decodes a MatchableAttributesConfiguration message from the specified reader or buffer.
+                 * Decodes a PluginOverride message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @memberof flyteidl.admin.PluginOverride
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.MatchableAttributesConfiguration} MatchableAttributesConfiguration
+                 * @returns {flyteidl.admin.PluginOverride} PluginOverride
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                MatchableAttributesConfiguration.decode = function decode(reader, length) {
+                PluginOverride.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.MatchableAttributesConfiguration();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.PluginOverride();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.attributes = $root.flyteidl.admin.MatchingAttributes.decode(reader, reader.uint32());
+                            message.taskType = reader.string();
                             break;
                         case 2:
-                            message.domain = reader.string();
-                            break;
-                        case 3:
-                            message.project = reader.string();
+                            if (!(message.pluginId && message.pluginId.length))
+                                message.pluginId = [];
+                            message.pluginId.push(reader.string());
                             break;
                         case 4:
-                            message.workflow = reader.string();
-                            break;
-                        case 5:
-                            message.launchPlan = reader.string();
-                            break;
-                        case 6:
-                            message.org = reader.string();
+                            message.missingPluginBehavior = reader.int32();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -39339,61 +39883,73 @@
                 };
     
                 /**
-                 * Verifies a MatchableAttributesConfiguration message.
+                 * Verifies a PluginOverride message.
                  * @function verify
-                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @memberof flyteidl.admin.PluginOverride
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                MatchableAttributesConfiguration.verify = function verify(message) {
+                PluginOverride.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.attributes != null && message.hasOwnProperty("attributes")) {
-                        var error = $root.flyteidl.admin.MatchingAttributes.verify(message.attributes);
-                        if (error)
-                            return "attributes." + error;
+                    if (message.taskType != null && message.hasOwnProperty("taskType"))
+                        if (!$util.isString(message.taskType))
+                            return "taskType: string expected";
+                    if (message.pluginId != null && message.hasOwnProperty("pluginId")) {
+                        if (!Array.isArray(message.pluginId))
+                            return "pluginId: array expected";
+                        for (var i = 0; i < message.pluginId.length; ++i)
+                            if (!$util.isString(message.pluginId[i]))
+                                return "pluginId: string[] expected";
                     }
-                    if (message.domain != null && message.hasOwnProperty("domain"))
-                        if (!$util.isString(message.domain))
-                            return "domain: string expected";
-                    if (message.project != null && message.hasOwnProperty("project"))
-                        if (!$util.isString(message.project))
-                            return "project: string expected";
-                    if (message.workflow != null && message.hasOwnProperty("workflow"))
-                        if (!$util.isString(message.workflow))
-                            return "workflow: string expected";
-                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan"))
-                        if (!$util.isString(message.launchPlan))
-                            return "launchPlan: string expected";
-                    if (message.org != null && message.hasOwnProperty("org"))
-                        if (!$util.isString(message.org))
-                            return "org: string expected";
+                    if (message.missingPluginBehavior != null && message.hasOwnProperty("missingPluginBehavior"))
+                        switch (message.missingPluginBehavior) {
+                        default:
+                            return "missingPluginBehavior: enum value expected";
+                        case 0:
+                        case 1:
+                            break;
+                        }
                     return null;
                 };
     
-                return MatchableAttributesConfiguration;
+                /**
+                 * MissingPluginBehavior enum.
+                 * @name flyteidl.admin.PluginOverride.MissingPluginBehavior
+                 * @enum {string}
+                 * @property {number} FAIL=0 FAIL value
+                 * @property {number} USE_DEFAULT=1 USE_DEFAULT value
+                 */
+                PluginOverride.MissingPluginBehavior = (function() {
+                    var valuesById = {}, values = Object.create(valuesById);
+                    values[valuesById[0] = "FAIL"] = 0;
+                    values[valuesById[1] = "USE_DEFAULT"] = 1;
+                    return values;
+                })();
+    
+                return PluginOverride;
             })();
     
-            admin.ListMatchableAttributesRequest = (function() {
+            admin.PluginOverrides = (function() {
     
                 /**
-                 * Properties of a ListMatchableAttributesRequest.
+                 * Properties of a PluginOverrides.
                  * @memberof flyteidl.admin
-                 * @interface IListMatchableAttributesRequest
-                 * @property {flyteidl.admin.MatchableResource|null} [resourceType] ListMatchableAttributesRequest resourceType
-                 * @property {string|null} [org] ListMatchableAttributesRequest org
+                 * @interface IPluginOverrides
+                 * @property {Array.<flyteidl.admin.IPluginOverride>|null} [overrides] PluginOverrides overrides
                  */
     
                 /**
-                 * Constructs a new ListMatchableAttributesRequest.
+                 * Constructs a new PluginOverrides.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a ListMatchableAttributesRequest.
-                 * @implements IListMatchableAttributesRequest
+                 * @classdesc Represents a PluginOverrides.
+                 * @implements IPluginOverrides
                  * @constructor
-                 * @param {flyteidl.admin.IListMatchableAttributesRequest=} [properties] Properties to set
+                 * @param {flyteidl.admin.IPluginOverrides=} [properties] Properties to set
                  */
-                function ListMatchableAttributesRequest(properties) {
+                function PluginOverrides(properties) {
+                    this.overrides = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -39401,75 +39957,65 @@
                 }
     
                 /**
-                 * ListMatchableAttributesRequest resourceType.
-                 * @member {flyteidl.admin.MatchableResource} resourceType
-                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
-                 * @instance
-                 */
-                ListMatchableAttributesRequest.prototype.resourceType = 0;
-    
-                /**
-                 * ListMatchableAttributesRequest org.
-                 * @member {string} org
-                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
+                 * PluginOverrides overrides.
+                 * @member {Array.<flyteidl.admin.IPluginOverride>} overrides
+                 * @memberof flyteidl.admin.PluginOverrides
                  * @instance
                  */
-                ListMatchableAttributesRequest.prototype.org = "";
+                PluginOverrides.prototype.overrides = $util.emptyArray;
     
                 /**
-                 * Creates a new ListMatchableAttributesRequest instance using the specified properties.
+                 * Creates a new PluginOverrides instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
+                 * @memberof flyteidl.admin.PluginOverrides
                  * @static
-                 * @param {flyteidl.admin.IListMatchableAttributesRequest=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ListMatchableAttributesRequest} ListMatchableAttributesRequest instance
+                 * @param {flyteidl.admin.IPluginOverrides=} [properties] Properties to set
+                 * @returns {flyteidl.admin.PluginOverrides} PluginOverrides instance
                  */
-                ListMatchableAttributesRequest.create = function create(properties) {
-                    return new ListMatchableAttributesRequest(properties);
+                PluginOverrides.create = function create(properties) {
+                    return new PluginOverrides(properties);
                 };
     
                 /**
-                 * Encodes the specified ListMatchableAttributesRequest message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesRequest.verify|verify} messages.
+                 * Encodes the specified PluginOverrides message. Does not implicitly {@link flyteidl.admin.PluginOverrides.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
+                 * @memberof flyteidl.admin.PluginOverrides
                  * @static
-                 * @param {flyteidl.admin.IListMatchableAttributesRequest} message ListMatchableAttributesRequest message or plain object to encode
+                 * @param {flyteidl.admin.IPluginOverrides} message PluginOverrides message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ListMatchableAttributesRequest.encode = function encode(message, writer) {
+                PluginOverrides.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.resourceType != null && message.hasOwnProperty("resourceType"))
-                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.resourceType);
-                    if (message.org != null && message.hasOwnProperty("org"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.org);
+                    if (message.overrides != null && message.overrides.length)
+                        for (var i = 0; i < message.overrides.length; ++i)
+                            $root.flyteidl.admin.PluginOverride.encode(message.overrides[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a ListMatchableAttributesRequest message from the specified reader or buffer.
+                 * Decodes a PluginOverrides message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
+                 * @memberof flyteidl.admin.PluginOverrides
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ListMatchableAttributesRequest} ListMatchableAttributesRequest
+                 * @returns {flyteidl.admin.PluginOverrides} PluginOverrides
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ListMatchableAttributesRequest.decode = function decode(reader, length) {
+                PluginOverrides.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ListMatchableAttributesRequest();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.PluginOverrides();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.resourceType = reader.int32();
-                            break;
-                        case 2:
-                            message.org = reader.string();
+                            if (!(message.overrides && message.overrides.length))
+                                message.overrides = [];
+                            message.overrides.push($root.flyteidl.admin.PluginOverride.decode(reader, reader.uint32()));
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -39480,58 +40026,58 @@
                 };
     
                 /**
-                 * Verifies a ListMatchableAttributesRequest message.
+                 * Verifies a PluginOverrides message.
                  * @function verify
-                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
+                 * @memberof flyteidl.admin.PluginOverrides
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ListMatchableAttributesRequest.verify = function verify(message) {
+                PluginOverrides.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.resourceType != null && message.hasOwnProperty("resourceType"))
-                        switch (message.resourceType) {
-                        default:
-                            return "resourceType: enum value expected";
-                        case 0:
-                        case 1:
-                        case 2:
-                        case 3:
-                        case 4:
-                        case 5:
-                        case 6:
-                        case 7:
-                            break;
+                    if (message.overrides != null && message.hasOwnProperty("overrides")) {
+                        if (!Array.isArray(message.overrides))
+                            return "overrides: array expected";
+                        for (var i = 0; i < message.overrides.length; ++i) {
+                            var error = $root.flyteidl.admin.PluginOverride.verify(message.overrides[i]);
+                            if (error)
+                                return "overrides." + error;
                         }
-                    if (message.org != null && message.hasOwnProperty("org"))
-                        if (!$util.isString(message.org))
-                            return "org: string expected";
+                    }
                     return null;
                 };
     
-                return ListMatchableAttributesRequest;
+                return PluginOverrides;
             })();
     
-            admin.ListMatchableAttributesResponse = (function() {
+            admin.WorkflowExecutionConfig = (function() {
     
                 /**
-                 * Properties of a ListMatchableAttributesResponse.
+                 * Properties of a WorkflowExecutionConfig.
                  * @memberof flyteidl.admin
-                 * @interface IListMatchableAttributesResponse
-                 * @property {Array.<flyteidl.admin.IMatchableAttributesConfiguration>|null} [configurations] ListMatchableAttributesResponse configurations
+                 * @interface IWorkflowExecutionConfig
+                 * @property {number|null} [maxParallelism] WorkflowExecutionConfig maxParallelism
+                 * @property {flyteidl.core.ISecurityContext|null} [securityContext] WorkflowExecutionConfig securityContext
+                 * @property {flyteidl.admin.IRawOutputDataConfig|null} [rawOutputDataConfig] WorkflowExecutionConfig rawOutputDataConfig
+                 * @property {flyteidl.admin.ILabels|null} [labels] WorkflowExecutionConfig labels
+                 * @property {flyteidl.admin.IAnnotations|null} [annotations] WorkflowExecutionConfig annotations
+                 * @property {google.protobuf.IBoolValue|null} [interruptible] WorkflowExecutionConfig interruptible
+                 * @property {boolean|null} [overwriteCache] WorkflowExecutionConfig overwriteCache
+                 * @property {flyteidl.admin.IEnvs|null} [envs] WorkflowExecutionConfig envs
+                 * @property {Array.<flyteidl.core.IExecutionEnvAssignment>|null} [executionEnvAssignments] WorkflowExecutionConfig executionEnvAssignments
                  */
     
                 /**
-                 * Constructs a new ListMatchableAttributesResponse.
+                 * Constructs a new WorkflowExecutionConfig.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a ListMatchableAttributesResponse.
-                 * @implements IListMatchableAttributesResponse
+                 * @classdesc Represents a WorkflowExecutionConfig.
+                 * @implements IWorkflowExecutionConfig
                  * @constructor
-                 * @param {flyteidl.admin.IListMatchableAttributesResponse=} [properties] Properties to set
+                 * @param {flyteidl.admin.IWorkflowExecutionConfig=} [properties] Properties to set
                  */
-                function ListMatchableAttributesResponse(properties) {
-                    this.configurations = [];
+                function WorkflowExecutionConfig(properties) {
+                    this.executionEnvAssignments = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -39539,195 +40085,169 @@
                 }
     
                 /**
-                 * ListMatchableAttributesResponse configurations.
-                 * @member {Array.<flyteidl.admin.IMatchableAttributesConfiguration>} configurations
-                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
+                 * WorkflowExecutionConfig maxParallelism.
+                 * @member {number} maxParallelism
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @instance
                  */
-                ListMatchableAttributesResponse.prototype.configurations = $util.emptyArray;
+                WorkflowExecutionConfig.prototype.maxParallelism = 0;
     
                 /**
-                 * Creates a new ListMatchableAttributesResponse instance using the specified properties.
-                 * @function create
-                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
-                 * @static
-                 * @param {flyteidl.admin.IListMatchableAttributesResponse=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ListMatchableAttributesResponse} ListMatchableAttributesResponse instance
+                 * WorkflowExecutionConfig securityContext.
+                 * @member {flyteidl.core.ISecurityContext|null|undefined} securityContext
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @instance
                  */
-                ListMatchableAttributesResponse.create = function create(properties) {
-                    return new ListMatchableAttributesResponse(properties);
-                };
+                WorkflowExecutionConfig.prototype.securityContext = null;
     
                 /**
-                 * Encodes the specified ListMatchableAttributesResponse message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesResponse.verify|verify} messages.
-                 * @function encode
-                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
-                 * @static
-                 * @param {flyteidl.admin.IListMatchableAttributesResponse} message ListMatchableAttributesResponse message or plain object to encode
-                 * @param {$protobuf.Writer} [writer] Writer to encode to
-                 * @returns {$protobuf.Writer} Writer
+                 * WorkflowExecutionConfig rawOutputDataConfig.
+                 * @member {flyteidl.admin.IRawOutputDataConfig|null|undefined} rawOutputDataConfig
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @instance
                  */
-                ListMatchableAttributesResponse.encode = function encode(message, writer) {
-                    if (!writer)
-                        writer = $Writer.create();
-                    if (message.configurations != null && message.configurations.length)
-                        for (var i = 0; i < message.configurations.length; ++i)
-                            $root.flyteidl.admin.MatchableAttributesConfiguration.encode(message.configurations[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    return writer;
-                };
+                WorkflowExecutionConfig.prototype.rawOutputDataConfig = null;
     
                 /**
-                 * Decodes a ListMatchableAttributesResponse message from the specified reader or buffer.
-                 * @function decode
-                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
-                 * @static
-                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
-                 * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ListMatchableAttributesResponse} ListMatchableAttributesResponse
-                 * @throws {Error} If the payload is not a reader or valid buffer
-                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 * WorkflowExecutionConfig labels.
+                 * @member {flyteidl.admin.ILabels|null|undefined} labels
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @instance
                  */
-                ListMatchableAttributesResponse.decode = function decode(reader, length) {
-                    if (!(reader instanceof $Reader))
-                        reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ListMatchableAttributesResponse();
-                    while (reader.pos < end) {
-                        var tag = reader.uint32();
-                        switch (tag >>> 3) {
-                        case 1:
-                            if (!(message.configurations && message.configurations.length))
-                                message.configurations = [];
-                            message.configurations.push($root.flyteidl.admin.MatchableAttributesConfiguration.decode(reader, reader.uint32()));
-                            break;
-                        default:
-                            reader.skipType(tag & 7);
-                            break;
-                        }
-                    }
-                    return message;
-                };
+                WorkflowExecutionConfig.prototype.labels = null;
     
                 /**
-                 * Verifies a ListMatchableAttributesResponse message.
-                 * @function verify
-                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
-                 * @static
-                 * @param {Object.<string,*>} message Plain object to verify
-                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 * WorkflowExecutionConfig annotations.
+                 * @member {flyteidl.admin.IAnnotations|null|undefined} annotations
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @instance
                  */
-                ListMatchableAttributesResponse.verify = function verify(message) {
-                    if (typeof message !== "object" || message === null)
-                        return "object expected";
-                    if (message.configurations != null && message.hasOwnProperty("configurations")) {
-                        if (!Array.isArray(message.configurations))
-                            return "configurations: array expected";
-                        for (var i = 0; i < message.configurations.length; ++i) {
-                            var error = $root.flyteidl.admin.MatchableAttributesConfiguration.verify(message.configurations[i]);
-                            if (error)
-                                return "configurations." + error;
-                        }
-                    }
-                    return null;
-                };
-    
-                return ListMatchableAttributesResponse;
-            })();
-    
-            admin.LaunchPlanCreateRequest = (function() {
+                WorkflowExecutionConfig.prototype.annotations = null;
     
                 /**
-                 * Properties of a LaunchPlanCreateRequest.
-                 * @memberof flyteidl.admin
-                 * @interface ILaunchPlanCreateRequest
-                 * @property {flyteidl.core.IIdentifier|null} [id] LaunchPlanCreateRequest id
-                 * @property {flyteidl.admin.ILaunchPlanSpec|null} [spec] LaunchPlanCreateRequest spec
+                 * WorkflowExecutionConfig interruptible.
+                 * @member {google.protobuf.IBoolValue|null|undefined} interruptible
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @instance
                  */
+                WorkflowExecutionConfig.prototype.interruptible = null;
     
                 /**
-                 * Constructs a new LaunchPlanCreateRequest.
-                 * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanCreateRequest.
-                 * @implements ILaunchPlanCreateRequest
-                 * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanCreateRequest=} [properties] Properties to set
+                 * WorkflowExecutionConfig overwriteCache.
+                 * @member {boolean} overwriteCache
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
+                 * @instance
                  */
-                function LaunchPlanCreateRequest(properties) {
-                    if (properties)
-                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
-                            if (properties[keys[i]] != null)
-                                this[keys[i]] = properties[keys[i]];
-                }
+                WorkflowExecutionConfig.prototype.overwriteCache = false;
     
                 /**
-                 * LaunchPlanCreateRequest id.
-                 * @member {flyteidl.core.IIdentifier|null|undefined} id
-                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * WorkflowExecutionConfig envs.
+                 * @member {flyteidl.admin.IEnvs|null|undefined} envs
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @instance
                  */
-                LaunchPlanCreateRequest.prototype.id = null;
+                WorkflowExecutionConfig.prototype.envs = null;
     
                 /**
-                 * LaunchPlanCreateRequest spec.
-                 * @member {flyteidl.admin.ILaunchPlanSpec|null|undefined} spec
-                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * WorkflowExecutionConfig executionEnvAssignments.
+                 * @member {Array.<flyteidl.core.IExecutionEnvAssignment>} executionEnvAssignments
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @instance
                  */
-                LaunchPlanCreateRequest.prototype.spec = null;
+                WorkflowExecutionConfig.prototype.executionEnvAssignments = $util.emptyArray;
     
                 /**
-                 * Creates a new LaunchPlanCreateRequest instance using the specified properties.
+                 * Creates a new WorkflowExecutionConfig instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanCreateRequest=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanCreateRequest} LaunchPlanCreateRequest instance
+                 * @param {flyteidl.admin.IWorkflowExecutionConfig=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WorkflowExecutionConfig} WorkflowExecutionConfig instance
                  */
-                LaunchPlanCreateRequest.create = function create(properties) {
-                    return new LaunchPlanCreateRequest(properties);
+                WorkflowExecutionConfig.create = function create(properties) {
+                    return new WorkflowExecutionConfig(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanCreateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanCreateRequest.verify|verify} messages.
+                 * Encodes the specified WorkflowExecutionConfig message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionConfig.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanCreateRequest} message LaunchPlanCreateRequest message or plain object to encode
+                 * @param {flyteidl.admin.IWorkflowExecutionConfig} message WorkflowExecutionConfig message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanCreateRequest.encode = function encode(message, writer) {
+                WorkflowExecutionConfig.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.id != null && message.hasOwnProperty("id"))
-                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.spec != null && message.hasOwnProperty("spec"))
-                        $root.flyteidl.admin.LaunchPlanSpec.encode(message.spec, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.maxParallelism);
+                    if (message.securityContext != null && message.hasOwnProperty("securityContext"))
+                        $root.flyteidl.core.SecurityContext.encode(message.securityContext, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig"))
+                        $root.flyteidl.admin.RawOutputDataConfig.encode(message.rawOutputDataConfig, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.labels != null && message.hasOwnProperty("labels"))
+                        $root.flyteidl.admin.Labels.encode(message.labels, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.annotations != null && message.hasOwnProperty("annotations"))
+                        $root.flyteidl.admin.Annotations.encode(message.annotations, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    if (message.interruptible != null && message.hasOwnProperty("interruptible"))
+                        $root.google.protobuf.BoolValue.encode(message.interruptible, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
+                        writer.uint32(/* id 7, wireType 0 =*/56).bool(message.overwriteCache);
+                    if (message.envs != null && message.hasOwnProperty("envs"))
+                        $root.flyteidl.admin.Envs.encode(message.envs, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
+                    if (message.executionEnvAssignments != null && message.executionEnvAssignments.length)
+                        for (var i = 0; i < message.executionEnvAssignments.length; ++i)
+                            $root.flyteidl.core.ExecutionEnvAssignment.encode(message.executionEnvAssignments[i], writer.uint32(/* id 9, wireType 2 =*/74).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanCreateRequest message from the specified reader or buffer.
+                 * Decodes a WorkflowExecutionConfig message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanCreateRequest} LaunchPlanCreateRequest
+                 * @returns {flyteidl.admin.WorkflowExecutionConfig} WorkflowExecutionConfig
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanCreateRequest.decode = function decode(reader, length) {
+                WorkflowExecutionConfig.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanCreateRequest();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowExecutionConfig();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            message.maxParallelism = reader.int32();
                             break;
                         case 2:
-                            message.spec = $root.flyteidl.admin.LaunchPlanSpec.decode(reader, reader.uint32());
+                            message.securityContext = $root.flyteidl.core.SecurityContext.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.rawOutputDataConfig = $root.flyteidl.admin.RawOutputDataConfig.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.labels = $root.flyteidl.admin.Labels.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.annotations = $root.flyteidl.admin.Annotations.decode(reader, reader.uint32());
+                            break;
+                        case 6:
+                            message.interruptible = $root.google.protobuf.BoolValue.decode(reader, reader.uint32());
+                            break;
+                        case 7:
+                            message.overwriteCache = reader.bool();
+                            break;
+                        case 8:
+                            message.envs = $root.flyteidl.admin.Envs.decode(reader, reader.uint32());
+                            break;
+                        case 9:
+                            if (!(message.executionEnvAssignments && message.executionEnvAssignments.length))
+                                message.executionEnvAssignments = [];
+                            message.executionEnvAssignments.push($root.flyteidl.core.ExecutionEnvAssignment.decode(reader, reader.uint32()));
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -39738,49 +40258,92 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanCreateRequest message.
+                 * Verifies a WorkflowExecutionConfig message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @memberof flyteidl.admin.WorkflowExecutionConfig
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanCreateRequest.verify = function verify(message) {
+                WorkflowExecutionConfig.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.id != null && message.hasOwnProperty("id")) {
-                        var error = $root.flyteidl.core.Identifier.verify(message.id);
+                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
+                        if (!$util.isInteger(message.maxParallelism))
+                            return "maxParallelism: integer expected";
+                    if (message.securityContext != null && message.hasOwnProperty("securityContext")) {
+                        var error = $root.flyteidl.core.SecurityContext.verify(message.securityContext);
                         if (error)
-                            return "id." + error;
+                            return "securityContext." + error;
                     }
-                    if (message.spec != null && message.hasOwnProperty("spec")) {
-                        var error = $root.flyteidl.admin.LaunchPlanSpec.verify(message.spec);
+                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig")) {
+                        var error = $root.flyteidl.admin.RawOutputDataConfig.verify(message.rawOutputDataConfig);
                         if (error)
-                            return "spec." + error;
+                            return "rawOutputDataConfig." + error;
+                    }
+                    if (message.labels != null && message.hasOwnProperty("labels")) {
+                        var error = $root.flyteidl.admin.Labels.verify(message.labels);
+                        if (error)
+                            return "labels." + error;
+                    }
+                    if (message.annotations != null && message.hasOwnProperty("annotations")) {
+                        var error = $root.flyteidl.admin.Annotations.verify(message.annotations);
+                        if (error)
+                            return "annotations." + error;
+                    }
+                    if (message.interruptible != null && message.hasOwnProperty("interruptible")) {
+                        var error = $root.google.protobuf.BoolValue.verify(message.interruptible);
+                        if (error)
+                            return "interruptible." + error;
+                    }
+                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
+                        if (typeof message.overwriteCache !== "boolean")
+                            return "overwriteCache: boolean expected";
+                    if (message.envs != null && message.hasOwnProperty("envs")) {
+                        var error = $root.flyteidl.admin.Envs.verify(message.envs);
+                        if (error)
+                            return "envs." + error;
+                    }
+                    if (message.executionEnvAssignments != null && message.hasOwnProperty("executionEnvAssignments")) {
+                        if (!Array.isArray(message.executionEnvAssignments))
+                            return "executionEnvAssignments: array expected";
+                        for (var i = 0; i < message.executionEnvAssignments.length; ++i) {
+                            var error = $root.flyteidl.core.ExecutionEnvAssignment.verify(message.executionEnvAssignments[i]);
+                            if (error)
+                                return "executionEnvAssignments." + error;
+                        }
                     }
                     return null;
                 };
     
-                return LaunchPlanCreateRequest;
+                return WorkflowExecutionConfig;
             })();
     
-            admin.LaunchPlanCreateResponse = (function() {
+            admin.MatchingAttributes = (function() {
     
                 /**
-                 * Properties of a LaunchPlanCreateResponse.
+                 * Properties of a MatchingAttributes.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanCreateResponse
+                 * @interface IMatchingAttributes
+                 * @property {flyteidl.admin.ITaskResourceAttributes|null} [taskResourceAttributes] MatchingAttributes taskResourceAttributes
+                 * @property {flyteidl.admin.IClusterResourceAttributes|null} [clusterResourceAttributes] MatchingAttributes clusterResourceAttributes
+                 * @property {flyteidl.admin.IExecutionQueueAttributes|null} [executionQueueAttributes] MatchingAttributes executionQueueAttributes
+                 * @property {flyteidl.admin.IExecutionClusterLabel|null} [executionClusterLabel] MatchingAttributes executionClusterLabel
+                 * @property {flyteidl.core.IQualityOfService|null} [qualityOfService] MatchingAttributes qualityOfService
+                 * @property {flyteidl.admin.IPluginOverrides|null} [pluginOverrides] MatchingAttributes pluginOverrides
+                 * @property {flyteidl.admin.IWorkflowExecutionConfig|null} [workflowExecutionConfig] MatchingAttributes workflowExecutionConfig
+                 * @property {flyteidl.admin.IClusterAssignment|null} [clusterAssignment] MatchingAttributes clusterAssignment
                  */
     
                 /**
-                 * Constructs a new LaunchPlanCreateResponse.
+                 * Constructs a new MatchingAttributes.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanCreateResponse.
-                 * @implements ILaunchPlanCreateResponse
+                 * @classdesc Represents a MatchingAttributes.
+                 * @implements IMatchingAttributes
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanCreateResponse=} [properties] Properties to set
+                 * @param {flyteidl.admin.IMatchingAttributes=} [properties] Properties to set
                  */
-                function LaunchPlanCreateResponse(properties) {
+                function MatchingAttributes(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -39788,50 +40351,168 @@
                 }
     
                 /**
-                 * Creates a new LaunchPlanCreateResponse instance using the specified properties.
-                 * @function create
-                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
-                 * @static
-                 * @param {flyteidl.admin.ILaunchPlanCreateResponse=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanCreateResponse} LaunchPlanCreateResponse instance
+                 * MatchingAttributes taskResourceAttributes.
+                 * @member {flyteidl.admin.ITaskResourceAttributes|null|undefined} taskResourceAttributes
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
                  */
-                LaunchPlanCreateResponse.create = function create(properties) {
-                    return new LaunchPlanCreateResponse(properties);
-                };
+                MatchingAttributes.prototype.taskResourceAttributes = null;
     
                 /**
-                 * Encodes the specified LaunchPlanCreateResponse message. Does not implicitly {@link flyteidl.admin.LaunchPlanCreateResponse.verify|verify} messages.
-                 * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
-                 * @static
-                 * @param {flyteidl.admin.ILaunchPlanCreateResponse} message LaunchPlanCreateResponse message or plain object to encode
-                 * @param {$protobuf.Writer} [writer] Writer to encode to
-                 * @returns {$protobuf.Writer} Writer
+                 * MatchingAttributes clusterResourceAttributes.
+                 * @member {flyteidl.admin.IClusterResourceAttributes|null|undefined} clusterResourceAttributes
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
                  */
-                LaunchPlanCreateResponse.encode = function encode(message, writer) {
-                    if (!writer)
-                        writer = $Writer.create();
-                    return writer;
+                MatchingAttributes.prototype.clusterResourceAttributes = null;
+    
+                /**
+                 * MatchingAttributes executionQueueAttributes.
+                 * @member {flyteidl.admin.IExecutionQueueAttributes|null|undefined} executionQueueAttributes
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.executionQueueAttributes = null;
+    
+                /**
+                 * MatchingAttributes executionClusterLabel.
+                 * @member {flyteidl.admin.IExecutionClusterLabel|null|undefined} executionClusterLabel
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.executionClusterLabel = null;
+    
+                /**
+                 * MatchingAttributes qualityOfService.
+                 * @member {flyteidl.core.IQualityOfService|null|undefined} qualityOfService
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.qualityOfService = null;
+    
+                /**
+                 * MatchingAttributes pluginOverrides.
+                 * @member {flyteidl.admin.IPluginOverrides|null|undefined} pluginOverrides
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.pluginOverrides = null;
+    
+                /**
+                 * MatchingAttributes workflowExecutionConfig.
+                 * @member {flyteidl.admin.IWorkflowExecutionConfig|null|undefined} workflowExecutionConfig
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.workflowExecutionConfig = null;
+    
+                /**
+                 * MatchingAttributes clusterAssignment.
+                 * @member {flyteidl.admin.IClusterAssignment|null|undefined} clusterAssignment
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.clusterAssignment = null;
+    
+                // OneOf field names bound to virtual getters and setters
+                var $oneOfFields;
+    
+                /**
+                 * MatchingAttributes target.
+                 * @member {"taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment"|undefined} target
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                Object.defineProperty(MatchingAttributes.prototype, "target", {
+                    get: $util.oneOfGetter($oneOfFields = ["taskResourceAttributes", "clusterResourceAttributes", "executionQueueAttributes", "executionClusterLabel", "qualityOfService", "pluginOverrides", "workflowExecutionConfig", "clusterAssignment"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
+                /**
+                 * Creates a new MatchingAttributes instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @static
+                 * @param {flyteidl.admin.IMatchingAttributes=} [properties] Properties to set
+                 * @returns {flyteidl.admin.MatchingAttributes} MatchingAttributes instance
+                 */
+                MatchingAttributes.create = function create(properties) {
+                    return new MatchingAttributes(properties);
                 };
     
                 /**
-                 * Decodes a LaunchPlanCreateResponse message from the specified reader or buffer.
+                 * Encodes the specified MatchingAttributes message. Does not implicitly {@link flyteidl.admin.MatchingAttributes.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @static
+                 * @param {flyteidl.admin.IMatchingAttributes} message MatchingAttributes message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                MatchingAttributes.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.taskResourceAttributes != null && message.hasOwnProperty("taskResourceAttributes"))
+                        $root.flyteidl.admin.TaskResourceAttributes.encode(message.taskResourceAttributes, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.clusterResourceAttributes != null && message.hasOwnProperty("clusterResourceAttributes"))
+                        $root.flyteidl.admin.ClusterResourceAttributes.encode(message.clusterResourceAttributes, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.executionQueueAttributes != null && message.hasOwnProperty("executionQueueAttributes"))
+                        $root.flyteidl.admin.ExecutionQueueAttributes.encode(message.executionQueueAttributes, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.executionClusterLabel != null && message.hasOwnProperty("executionClusterLabel"))
+                        $root.flyteidl.admin.ExecutionClusterLabel.encode(message.executionClusterLabel, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService"))
+                        $root.flyteidl.core.QualityOfService.encode(message.qualityOfService, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    if (message.pluginOverrides != null && message.hasOwnProperty("pluginOverrides"))
+                        $root.flyteidl.admin.PluginOverrides.encode(message.pluginOverrides, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+                    if (message.workflowExecutionConfig != null && message.hasOwnProperty("workflowExecutionConfig"))
+                        $root.flyteidl.admin.WorkflowExecutionConfig.encode(message.workflowExecutionConfig, writer.uint32(/* id 7, wireType 2 =*/58).fork()).ldelim();
+                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment"))
+                        $root.flyteidl.admin.ClusterAssignment.encode(message.clusterAssignment, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a MatchingAttributes message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
+                 * @memberof flyteidl.admin.MatchingAttributes
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanCreateResponse} LaunchPlanCreateResponse
+                 * @returns {flyteidl.admin.MatchingAttributes} MatchingAttributes
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanCreateResponse.decode = function decode(reader, length) {
+                MatchingAttributes.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanCreateResponse();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.MatchingAttributes();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
+                        case 1:
+                            message.taskResourceAttributes = $root.flyteidl.admin.TaskResourceAttributes.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.clusterResourceAttributes = $root.flyteidl.admin.ClusterResourceAttributes.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.executionQueueAttributes = $root.flyteidl.admin.ExecutionQueueAttributes.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.executionClusterLabel = $root.flyteidl.admin.ExecutionClusterLabel.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.qualityOfService = $root.flyteidl.core.QualityOfService.decode(reader, reader.uint32());
+                            break;
+                        case 6:
+                            message.pluginOverrides = $root.flyteidl.admin.PluginOverrides.decode(reader, reader.uint32());
+                            break;
+                        case 7:
+                            message.workflowExecutionConfig = $root.flyteidl.admin.WorkflowExecutionConfig.decode(reader, reader.uint32());
+                            break;
+                        case 8:
+                            message.clusterAssignment = $root.flyteidl.admin.ClusterAssignment.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -39841,56 +40522,124 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanCreateResponse message.
+                 * Verifies a MatchingAttributes message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
+                 * @memberof flyteidl.admin.MatchingAttributes
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanCreateResponse.verify = function verify(message) {
+                MatchingAttributes.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
+                    var properties = {};
+                    if (message.taskResourceAttributes != null && message.hasOwnProperty("taskResourceAttributes")) {
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.TaskResourceAttributes.verify(message.taskResourceAttributes);
+                            if (error)
+                                return "taskResourceAttributes." + error;
+                        }
+                    }
+                    if (message.clusterResourceAttributes != null && message.hasOwnProperty("clusterResourceAttributes")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.ClusterResourceAttributes.verify(message.clusterResourceAttributes);
+                            if (error)
+                                return "clusterResourceAttributes." + error;
+                        }
+                    }
+                    if (message.executionQueueAttributes != null && message.hasOwnProperty("executionQueueAttributes")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.ExecutionQueueAttributes.verify(message.executionQueueAttributes);
+                            if (error)
+                                return "executionQueueAttributes." + error;
+                        }
+                    }
+                    if (message.executionClusterLabel != null && message.hasOwnProperty("executionClusterLabel")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.ExecutionClusterLabel.verify(message.executionClusterLabel);
+                            if (error)
+                                return "executionClusterLabel." + error;
+                        }
+                    }
+                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.core.QualityOfService.verify(message.qualityOfService);
+                            if (error)
+                                return "qualityOfService." + error;
+                        }
+                    }
+                    if (message.pluginOverrides != null && message.hasOwnProperty("pluginOverrides")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.PluginOverrides.verify(message.pluginOverrides);
+                            if (error)
+                                return "pluginOverrides." + error;
+                        }
+                    }
+                    if (message.workflowExecutionConfig != null && message.hasOwnProperty("workflowExecutionConfig")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.WorkflowExecutionConfig.verify(message.workflowExecutionConfig);
+                            if (error)
+                                return "workflowExecutionConfig." + error;
+                        }
+                    }
+                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.ClusterAssignment.verify(message.clusterAssignment);
+                            if (error)
+                                return "clusterAssignment." + error;
+                        }
+                    }
                     return null;
                 };
     
-                return LaunchPlanCreateResponse;
-            })();
-    
-            /**
-             * LaunchPlanState enum.
-             * @name flyteidl.admin.LaunchPlanState
-             * @enum {string}
-             * @property {number} INACTIVE=0 INACTIVE value
-             * @property {number} ACTIVE=1 ACTIVE value
-             */
-            admin.LaunchPlanState = (function() {
-                var valuesById = {}, values = Object.create(valuesById);
-                values[valuesById[0] = "INACTIVE"] = 0;
-                values[valuesById[1] = "ACTIVE"] = 1;
-                return values;
+                return MatchingAttributes;
             })();
     
-            admin.LaunchPlan = (function() {
+            admin.MatchableAttributesConfiguration = (function() {
     
                 /**
-                 * Properties of a LaunchPlan.
+                 * Properties of a MatchableAttributesConfiguration.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlan
-                 * @property {flyteidl.core.IIdentifier|null} [id] LaunchPlan id
-                 * @property {flyteidl.admin.ILaunchPlanSpec|null} [spec] LaunchPlan spec
-                 * @property {flyteidl.admin.ILaunchPlanClosure|null} [closure] LaunchPlan closure
+                 * @interface IMatchableAttributesConfiguration
+                 * @property {flyteidl.admin.IMatchingAttributes|null} [attributes] MatchableAttributesConfiguration attributes
+                 * @property {string|null} [domain] MatchableAttributesConfiguration domain
+                 * @property {string|null} [project] MatchableAttributesConfiguration project
+                 * @property {string|null} [workflow] MatchableAttributesConfiguration workflow
+                 * @property {string|null} [launchPlan] MatchableAttributesConfiguration launchPlan
+                 * @property {string|null} [org] MatchableAttributesConfiguration org
                  */
     
                 /**
-                 * Constructs a new LaunchPlan.
+                 * Constructs a new MatchableAttributesConfiguration.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlan.
-                 * @implements ILaunchPlan
+                 * @classdesc Represents a MatchableAttributesConfiguration.
+                 * @implements IMatchableAttributesConfiguration
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlan=} [properties] Properties to set
+                 * @param {flyteidl.admin.IMatchableAttributesConfiguration=} [properties] Properties to set
                  */
-                function LaunchPlan(properties) {
+                function MatchableAttributesConfiguration(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -39898,88 +40647,127 @@
                 }
     
                 /**
-                 * LaunchPlan id.
-                 * @member {flyteidl.core.IIdentifier|null|undefined} id
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * MatchableAttributesConfiguration attributes.
+                 * @member {flyteidl.admin.IMatchingAttributes|null|undefined} attributes
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @instance
                  */
-                LaunchPlan.prototype.id = null;
+                MatchableAttributesConfiguration.prototype.attributes = null;
     
                 /**
-                 * LaunchPlan spec.
-                 * @member {flyteidl.admin.ILaunchPlanSpec|null|undefined} spec
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * MatchableAttributesConfiguration domain.
+                 * @member {string} domain
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @instance
                  */
-                LaunchPlan.prototype.spec = null;
+                MatchableAttributesConfiguration.prototype.domain = "";
     
                 /**
-                 * LaunchPlan closure.
-                 * @member {flyteidl.admin.ILaunchPlanClosure|null|undefined} closure
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * MatchableAttributesConfiguration project.
+                 * @member {string} project
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @instance
                  */
-                LaunchPlan.prototype.closure = null;
+                MatchableAttributesConfiguration.prototype.project = "";
     
                 /**
-                 * Creates a new LaunchPlan instance using the specified properties.
+                 * MatchableAttributesConfiguration workflow.
+                 * @member {string} workflow
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @instance
+                 */
+                MatchableAttributesConfiguration.prototype.workflow = "";
+    
+                /**
+                 * MatchableAttributesConfiguration launchPlan.
+                 * @member {string} launchPlan
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @instance
+                 */
+                MatchableAttributesConfiguration.prototype.launchPlan = "";
+    
+                /**
+                 * MatchableAttributesConfiguration org.
+                 * @member {string} org
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
+                 * @instance
+                 */
+                MatchableAttributesConfiguration.prototype.org = "";
+    
+                /**
+                 * Creates a new MatchableAttributesConfiguration instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlan=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlan} LaunchPlan instance
+                 * @param {flyteidl.admin.IMatchableAttributesConfiguration=} [properties] Properties to set
+                 * @returns {flyteidl.admin.MatchableAttributesConfiguration} MatchableAttributesConfiguration instance
                  */
-                LaunchPlan.create = function create(properties) {
-                    return new LaunchPlan(properties);
+                MatchableAttributesConfiguration.create = function create(properties) {
+                    return new MatchableAttributesConfiguration(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlan message. Does not implicitly {@link flyteidl.admin.LaunchPlan.verify|verify} messages.
+                 * Encodes the specified MatchableAttributesConfiguration message. Does not implicitly {@link flyteidl.admin.MatchableAttributesConfiguration.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlan} message LaunchPlan message or plain object to encode
+                 * @param {flyteidl.admin.IMatchableAttributesConfiguration} message MatchableAttributesConfiguration message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlan.encode = function encode(message, writer) {
+                MatchableAttributesConfiguration.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.id != null && message.hasOwnProperty("id"))
-                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.spec != null && message.hasOwnProperty("spec"))
-                        $root.flyteidl.admin.LaunchPlanSpec.encode(message.spec, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.closure != null && message.hasOwnProperty("closure"))
-                        $root.flyteidl.admin.LaunchPlanClosure.encode(message.closure, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.attributes != null && message.hasOwnProperty("attributes"))
+                        $root.flyteidl.admin.MatchingAttributes.encode(message.attributes, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.domain);
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.project);
+                    if (message.workflow != null && message.hasOwnProperty("workflow"))
+                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.workflow);
+                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan"))
+                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.launchPlan);
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        writer.uint32(/* id 6, wireType 2 =*/50).string(message.org);
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlan message from the specified reader or buffer.
+                 * Decodes a MatchableAttributesConfiguration message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlan} LaunchPlan
+                 * @returns {flyteidl.admin.MatchableAttributesConfiguration} MatchableAttributesConfiguration
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlan.decode = function decode(reader, length) {
+                MatchableAttributesConfiguration.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlan();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.MatchableAttributesConfiguration();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            message.attributes = $root.flyteidl.admin.MatchingAttributes.decode(reader, reader.uint32());
                             break;
                         case 2:
-                            message.spec = $root.flyteidl.admin.LaunchPlanSpec.decode(reader, reader.uint32());
+                            message.domain = reader.string();
                             break;
                         case 3:
-                            message.closure = $root.flyteidl.admin.LaunchPlanClosure.decode(reader, reader.uint32());
+                            message.project = reader.string();
+                            break;
+                        case 4:
+                            message.workflow = reader.string();
+                            break;
+                        case 5:
+                            message.launchPlan = reader.string();
+                            break;
+                        case 6:
+                            message.org = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -39990,57 +40778,61 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlan message.
+                 * Verifies a MatchableAttributesConfiguration message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlan
+                 * @memberof flyteidl.admin.MatchableAttributesConfiguration
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlan.verify = function verify(message) {
+                MatchableAttributesConfiguration.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.id != null && message.hasOwnProperty("id")) {
-                        var error = $root.flyteidl.core.Identifier.verify(message.id);
-                        if (error)
-                            return "id." + error;
-                    }
-                    if (message.spec != null && message.hasOwnProperty("spec")) {
-                        var error = $root.flyteidl.admin.LaunchPlanSpec.verify(message.spec);
-                        if (error)
-                            return "spec." + error;
-                    }
-                    if (message.closure != null && message.hasOwnProperty("closure")) {
-                        var error = $root.flyteidl.admin.LaunchPlanClosure.verify(message.closure);
+                    if (message.attributes != null && message.hasOwnProperty("attributes")) {
+                        var error = $root.flyteidl.admin.MatchingAttributes.verify(message.attributes);
                         if (error)
-                            return "closure." + error;
+                            return "attributes." + error;
                     }
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        if (!$util.isString(message.domain))
+                            return "domain: string expected";
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        if (!$util.isString(message.project))
+                            return "project: string expected";
+                    if (message.workflow != null && message.hasOwnProperty("workflow"))
+                        if (!$util.isString(message.workflow))
+                            return "workflow: string expected";
+                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan"))
+                        if (!$util.isString(message.launchPlan))
+                            return "launchPlan: string expected";
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        if (!$util.isString(message.org))
+                            return "org: string expected";
                     return null;
                 };
     
-                return LaunchPlan;
+                return MatchableAttributesConfiguration;
             })();
     
-            admin.LaunchPlanList = (function() {
+            admin.ListMatchableAttributesRequest = (function() {
     
                 /**
-                 * Properties of a LaunchPlanList.
+                 * Properties of a ListMatchableAttributesRequest.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanList
-                 * @property {Array.<flyteidl.admin.ILaunchPlan>|null} [launchPlans] LaunchPlanList launchPlans
-                 * @property {string|null} [token] LaunchPlanList token
+                 * @interface IListMatchableAttributesRequest
+                 * @property {flyteidl.admin.MatchableResource|null} [resourceType] ListMatchableAttributesRequest resourceType
+                 * @property {string|null} [org] ListMatchableAttributesRequest org
                  */
     
                 /**
-                 * Constructs a new LaunchPlanList.
+                 * Constructs a new ListMatchableAttributesRequest.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanList.
-                 * @implements ILaunchPlanList
+                 * @classdesc Represents a ListMatchableAttributesRequest.
+                 * @implements IListMatchableAttributesRequest
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanList=} [properties] Properties to set
+                 * @param {flyteidl.admin.IListMatchableAttributesRequest=} [properties] Properties to set
                  */
-                function LaunchPlanList(properties) {
-                    this.launchPlans = [];
+                function ListMatchableAttributesRequest(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -40048,78 +40840,75 @@
                 }
     
                 /**
-                 * LaunchPlanList launchPlans.
-                 * @member {Array.<flyteidl.admin.ILaunchPlan>} launchPlans
-                 * @memberof flyteidl.admin.LaunchPlanList
+                 * ListMatchableAttributesRequest resourceType.
+                 * @member {flyteidl.admin.MatchableResource} resourceType
+                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
                  * @instance
                  */
-                LaunchPlanList.prototype.launchPlans = $util.emptyArray;
+                ListMatchableAttributesRequest.prototype.resourceType = 0;
     
                 /**
-                 * LaunchPlanList token.
-                 * @member {string} token
-                 * @memberof flyteidl.admin.LaunchPlanList
+                 * ListMatchableAttributesRequest org.
+                 * @member {string} org
+                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
                  * @instance
                  */
-                LaunchPlanList.prototype.token = "";
+                ListMatchableAttributesRequest.prototype.org = "";
     
                 /**
-                 * Creates a new LaunchPlanList instance using the specified properties.
+                 * Creates a new ListMatchableAttributesRequest instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanList=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanList} LaunchPlanList instance
+                 * @param {flyteidl.admin.IListMatchableAttributesRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ListMatchableAttributesRequest} ListMatchableAttributesRequest instance
                  */
-                LaunchPlanList.create = function create(properties) {
-                    return new LaunchPlanList(properties);
+                ListMatchableAttributesRequest.create = function create(properties) {
+                    return new ListMatchableAttributesRequest(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanList message. Does not implicitly {@link flyteidl.admin.LaunchPlanList.verify|verify} messages.
+                 * Encodes the specified ListMatchableAttributesRequest message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesRequest.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanList} message LaunchPlanList message or plain object to encode
+                 * @param {flyteidl.admin.IListMatchableAttributesRequest} message ListMatchableAttributesRequest message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanList.encode = function encode(message, writer) {
+                ListMatchableAttributesRequest.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.launchPlans != null && message.launchPlans.length)
-                        for (var i = 0; i < message.launchPlans.length; ++i)
-                            $root.flyteidl.admin.LaunchPlan.encode(message.launchPlans[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.token != null && message.hasOwnProperty("token"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.token);
+                    if (message.resourceType != null && message.hasOwnProperty("resourceType"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.resourceType);
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.org);
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanList message from the specified reader or buffer.
+                 * Decodes a ListMatchableAttributesRequest message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanList} LaunchPlanList
+                 * @returns {flyteidl.admin.ListMatchableAttributesRequest} ListMatchableAttributesRequest
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanList.decode = function decode(reader, length) {
+                ListMatchableAttributesRequest.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanList();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ListMatchableAttributesRequest();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            if (!(message.launchPlans && message.launchPlans.length))
-                                message.launchPlans = [];
-                            message.launchPlans.push($root.flyteidl.admin.LaunchPlan.decode(reader, reader.uint32()));
+                            message.resourceType = reader.int32();
                             break;
                         case 2:
-                            message.token = reader.string();
+                            message.org = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -40130,53 +40919,58 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanList message.
+                 * Verifies a ListMatchableAttributesRequest message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @memberof flyteidl.admin.ListMatchableAttributesRequest
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanList.verify = function verify(message) {
+                ListMatchableAttributesRequest.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.launchPlans != null && message.hasOwnProperty("launchPlans")) {
-                        if (!Array.isArray(message.launchPlans))
-                            return "launchPlans: array expected";
-                        for (var i = 0; i < message.launchPlans.length; ++i) {
-                            var error = $root.flyteidl.admin.LaunchPlan.verify(message.launchPlans[i]);
-                            if (error)
-                                return "launchPlans." + error;
-                        }
-                    }
-                    if (message.token != null && message.hasOwnProperty("token"))
-                        if (!$util.isString(message.token))
-                            return "token: string expected";
+                    if (message.resourceType != null && message.hasOwnProperty("resourceType"))
+                        switch (message.resourceType) {
+                        default:
+                            return "resourceType: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                        case 5:
+                        case 6:
+                        case 7:
+                            break;
+                        }
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        if (!$util.isString(message.org))
+                            return "org: string expected";
                     return null;
                 };
     
-                return LaunchPlanList;
+                return ListMatchableAttributesRequest;
             })();
     
-            admin.Auth = (function() {
+            admin.ListMatchableAttributesResponse = (function() {
     
                 /**
-                 * Properties of an Auth.
+                 * Properties of a ListMatchableAttributesResponse.
                  * @memberof flyteidl.admin
-                 * @interface IAuth
-                 * @property {string|null} [assumableIamRole] Auth assumableIamRole
-                 * @property {string|null} [kubernetesServiceAccount] Auth kubernetesServiceAccount
+                 * @interface IListMatchableAttributesResponse
+                 * @property {Array.<flyteidl.admin.IMatchableAttributesConfiguration>|null} [configurations] ListMatchableAttributesResponse configurations
                  */
     
                 /**
-                 * Constructs a new Auth.
+                 * Constructs a new ListMatchableAttributesResponse.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents an Auth.
-                 * @implements IAuth
+                 * @classdesc Represents a ListMatchableAttributesResponse.
+                 * @implements IListMatchableAttributesResponse
                  * @constructor
-                 * @param {flyteidl.admin.IAuth=} [properties] Properties to set
+                 * @param {flyteidl.admin.IListMatchableAttributesResponse=} [properties] Properties to set
                  */
-                function Auth(properties) {
+                function ListMatchableAttributesResponse(properties) {
+                    this.configurations = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -40184,75 +40978,65 @@
                 }
     
                 /**
-                 * Auth assumableIamRole.
-                 * @member {string} assumableIamRole
-                 * @memberof flyteidl.admin.Auth
-                 * @instance
-                 */
-                Auth.prototype.assumableIamRole = "";
-    
-                /**
-                 * Auth kubernetesServiceAccount.
-                 * @member {string} kubernetesServiceAccount
-                 * @memberof flyteidl.admin.Auth
+                 * ListMatchableAttributesResponse configurations.
+                 * @member {Array.<flyteidl.admin.IMatchableAttributesConfiguration>} configurations
+                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
                  * @instance
                  */
-                Auth.prototype.kubernetesServiceAccount = "";
+                ListMatchableAttributesResponse.prototype.configurations = $util.emptyArray;
     
                 /**
-                 * Creates a new Auth instance using the specified properties.
+                 * Creates a new ListMatchableAttributesResponse instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.Auth
+                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
                  * @static
-                 * @param {flyteidl.admin.IAuth=} [properties] Properties to set
-                 * @returns {flyteidl.admin.Auth} Auth instance
+                 * @param {flyteidl.admin.IListMatchableAttributesResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ListMatchableAttributesResponse} ListMatchableAttributesResponse instance
                  */
-                Auth.create = function create(properties) {
-                    return new Auth(properties);
+                ListMatchableAttributesResponse.create = function create(properties) {
+                    return new ListMatchableAttributesResponse(properties);
                 };
     
                 /**
-                 * Encodes the specified Auth message. Does not implicitly {@link flyteidl.admin.Auth.verify|verify} messages.
+                 * Encodes the specified ListMatchableAttributesResponse message. Does not implicitly {@link flyteidl.admin.ListMatchableAttributesResponse.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.Auth
+                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
                  * @static
-                 * @param {flyteidl.admin.IAuth} message Auth message or plain object to encode
+                 * @param {flyteidl.admin.IListMatchableAttributesResponse} message ListMatchableAttributesResponse message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                Auth.encode = function encode(message, writer) {
+                ListMatchableAttributesResponse.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.assumableIamRole != null && message.hasOwnProperty("assumableIamRole"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.assumableIamRole);
-                    if (message.kubernetesServiceAccount != null && message.hasOwnProperty("kubernetesServiceAccount"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.kubernetesServiceAccount);
+                    if (message.configurations != null && message.configurations.length)
+                        for (var i = 0; i < message.configurations.length; ++i)
+                            $root.flyteidl.admin.MatchableAttributesConfiguration.encode(message.configurations[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes an Auth message from the specified reader or buffer.
+                 * Decodes a ListMatchableAttributesResponse message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.Auth
+                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.Auth} Auth
+                 * @returns {flyteidl.admin.ListMatchableAttributesResponse} ListMatchableAttributesResponse
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                Auth.decode = function decode(reader, length) {
+                ListMatchableAttributesResponse.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.Auth();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ListMatchableAttributesResponse();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.assumableIamRole = reader.string();
-                            break;
-                        case 2:
-                            message.kubernetesServiceAccount = reader.string();
+                            if (!(message.configurations && message.configurations.length))
+                                message.configurations = [];
+                            message.configurations.push($root.flyteidl.admin.MatchableAttributesConfiguration.decode(reader, reader.uint32()));
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -40263,65 +41047,50 @@
                 };
     
                 /**
-                 * Verifies an Auth message.
+                 * Verifies a ListMatchableAttributesResponse message.
                  * @function verify
-                 * @memberof flyteidl.admin.Auth
+                 * @memberof flyteidl.admin.ListMatchableAttributesResponse
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                Auth.verify = function verify(message) {
+                ListMatchableAttributesResponse.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.assumableIamRole != null && message.hasOwnProperty("assumableIamRole"))
-                        if (!$util.isString(message.assumableIamRole))
-                            return "assumableIamRole: string expected";
-                    if (message.kubernetesServiceAccount != null && message.hasOwnProperty("kubernetesServiceAccount"))
-                        if (!$util.isString(message.kubernetesServiceAccount))
-                            return "kubernetesServiceAccount: string expected";
+                    if (message.configurations != null && message.hasOwnProperty("configurations")) {
+                        if (!Array.isArray(message.configurations))
+                            return "configurations: array expected";
+                        for (var i = 0; i < message.configurations.length; ++i) {
+                            var error = $root.flyteidl.admin.MatchableAttributesConfiguration.verify(message.configurations[i]);
+                            if (error)
+                                return "configurations." + error;
+                        }
+                    }
                     return null;
                 };
     
-                return Auth;
+                return ListMatchableAttributesResponse;
             })();
     
-            admin.LaunchPlanSpec = (function() {
+            admin.LaunchPlanCreateRequest = (function() {
     
                 /**
-                 * Properties of a LaunchPlanSpec.
+                 * Properties of a LaunchPlanCreateRequest.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanSpec
-                 * @property {flyteidl.core.IIdentifier|null} [workflowId] LaunchPlanSpec workflowId
-                 * @property {flyteidl.admin.ILaunchPlanMetadata|null} [entityMetadata] LaunchPlanSpec entityMetadata
-                 * @property {flyteidl.core.IParameterMap|null} [defaultInputs] LaunchPlanSpec defaultInputs
-                 * @property {flyteidl.core.ILiteralMap|null} [fixedInputs] LaunchPlanSpec fixedInputs
-                 * @property {string|null} [role] LaunchPlanSpec role
-                 * @property {flyteidl.admin.ILabels|null} [labels] LaunchPlanSpec labels
-                 * @property {flyteidl.admin.IAnnotations|null} [annotations] LaunchPlanSpec annotations
-                 * @property {flyteidl.admin.IAuth|null} [auth] LaunchPlanSpec auth
-                 * @property {flyteidl.admin.IAuthRole|null} [authRole] LaunchPlanSpec authRole
-                 * @property {flyteidl.core.ISecurityContext|null} [securityContext] LaunchPlanSpec securityContext
-                 * @property {flyteidl.core.IQualityOfService|null} [qualityOfService] LaunchPlanSpec qualityOfService
-                 * @property {flyteidl.admin.IRawOutputDataConfig|null} [rawOutputDataConfig] LaunchPlanSpec rawOutputDataConfig
-                 * @property {number|null} [maxParallelism] LaunchPlanSpec maxParallelism
-                 * @property {google.protobuf.IBoolValue|null} [interruptible] LaunchPlanSpec interruptible
-                 * @property {boolean|null} [overwriteCache] LaunchPlanSpec overwriteCache
-                 * @property {flyteidl.admin.IEnvs|null} [envs] LaunchPlanSpec envs
-                 * @property {Array.<flyteidl.core.IExecutionEnvAssignment>|null} [executionEnvAssignments] LaunchPlanSpec executionEnvAssignments
-                 * @property {flyteidl.admin.IClusterAssignment|null} [clusterAssignment] LaunchPlanSpec clusterAssignment
-                 * @property {flyteidl.admin.IConcurrencyPolicy|null} [concurrencyPolicy] LaunchPlanSpec concurrencyPolicy
+                 * @interface ILaunchPlanCreateRequest
+                 * @property {flyteidl.core.IIdentifier|null} [id] LaunchPlanCreateRequest id
+                 * @property {flyteidl.admin.ILaunchPlanSpec|null} [spec] LaunchPlanCreateRequest spec
                  */
     
                 /**
-                 * Constructs a new LaunchPlanSpec.
+                 * Constructs a new LaunchPlanCreateRequest.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanSpec.
-                 * @implements ILaunchPlanSpec
+                 * @classdesc Represents a LaunchPlanCreateRequest.
+                 * @implements ILaunchPlanCreateRequest
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanSpec=} [properties] Properties to set
+                 * @param {flyteidl.admin.ILaunchPlanCreateRequest=} [properties] Properties to set
                  */
-                function LaunchPlanSpec(properties) {
-                    this.executionEnvAssignments = [];
+                function LaunchPlanCreateRequest(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -40329,299 +41098,327 @@
                 }
     
                 /**
-                 * LaunchPlanSpec workflowId.
-                 * @member {flyteidl.core.IIdentifier|null|undefined} workflowId
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * LaunchPlanCreateRequest id.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
                  * @instance
                  */
-                LaunchPlanSpec.prototype.workflowId = null;
+                LaunchPlanCreateRequest.prototype.id = null;
     
                 /**
-                 * LaunchPlanSpec entityMetadata.
-                 * @member {flyteidl.admin.ILaunchPlanMetadata|null|undefined} entityMetadata
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * LaunchPlanCreateRequest spec.
+                 * @member {flyteidl.admin.ILaunchPlanSpec|null|undefined} spec
+                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
                  * @instance
                  */
-                LaunchPlanSpec.prototype.entityMetadata = null;
+                LaunchPlanCreateRequest.prototype.spec = null;
     
                 /**
-                 * LaunchPlanSpec defaultInputs.
-                 * @member {flyteidl.core.IParameterMap|null|undefined} defaultInputs
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Creates a new LaunchPlanCreateRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanCreateRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanCreateRequest} LaunchPlanCreateRequest instance
                  */
-                LaunchPlanSpec.prototype.defaultInputs = null;
+                LaunchPlanCreateRequest.create = function create(properties) {
+                    return new LaunchPlanCreateRequest(properties);
+                };
     
                 /**
-                 * LaunchPlanSpec fixedInputs.
-                 * @member {flyteidl.core.ILiteralMap|null|undefined} fixedInputs
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Encodes the specified LaunchPlanCreateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanCreateRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanCreateRequest} message LaunchPlanCreateRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanSpec.prototype.fixedInputs = null;
+                LaunchPlanCreateRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.spec != null && message.hasOwnProperty("spec"))
+                        $root.flyteidl.admin.LaunchPlanSpec.encode(message.spec, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    return writer;
+                };
     
                 /**
-                 * LaunchPlanSpec role.
-                 * @member {string} role
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Decodes a LaunchPlanCreateRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanCreateRequest} LaunchPlanCreateRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanSpec.prototype.role = "";
+                LaunchPlanCreateRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanCreateRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.spec = $root.flyteidl.admin.LaunchPlanSpec.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
     
                 /**
-                 * LaunchPlanSpec labels.
-                 * @member {flyteidl.admin.ILabels|null|undefined} labels
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Verifies a LaunchPlanCreateRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanCreateRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanSpec.prototype.labels = null;
+                LaunchPlanCreateRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.spec != null && message.hasOwnProperty("spec")) {
+                        var error = $root.flyteidl.admin.LaunchPlanSpec.verify(message.spec);
+                        if (error)
+                            return "spec." + error;
+                    }
+                    return null;
+                };
     
-                /**
-                 * LaunchPlanSpec annotations.
-                 * @member {flyteidl.admin.IAnnotations|null|undefined} annotations
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
-                 */
-                LaunchPlanSpec.prototype.annotations = null;
+                return LaunchPlanCreateRequest;
+            })();
     
-                /**
-                 * LaunchPlanSpec auth.
-                 * @member {flyteidl.admin.IAuth|null|undefined} auth
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
-                 */
-                LaunchPlanSpec.prototype.auth = null;
+            admin.LaunchPlanCreateResponse = (function() {
     
                 /**
-                 * LaunchPlanSpec authRole.
-                 * @member {flyteidl.admin.IAuthRole|null|undefined} authRole
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Properties of a LaunchPlanCreateResponse.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanCreateResponse
                  */
-                LaunchPlanSpec.prototype.authRole = null;
     
                 /**
-                 * LaunchPlanSpec securityContext.
-                 * @member {flyteidl.core.ISecurityContext|null|undefined} securityContext
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Constructs a new LaunchPlanCreateResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlanCreateResponse.
+                 * @implements ILaunchPlanCreateResponse
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanCreateResponse=} [properties] Properties to set
                  */
-                LaunchPlanSpec.prototype.securityContext = null;
+                function LaunchPlanCreateResponse(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
     
                 /**
-                 * LaunchPlanSpec qualityOfService.
-                 * @member {flyteidl.core.IQualityOfService|null|undefined} qualityOfService
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Creates a new LaunchPlanCreateResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanCreateResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanCreateResponse} LaunchPlanCreateResponse instance
                  */
-                LaunchPlanSpec.prototype.qualityOfService = null;
+                LaunchPlanCreateResponse.create = function create(properties) {
+                    return new LaunchPlanCreateResponse(properties);
+                };
     
                 /**
-                 * LaunchPlanSpec rawOutputDataConfig.
-                 * @member {flyteidl.admin.IRawOutputDataConfig|null|undefined} rawOutputDataConfig
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Encodes the specified LaunchPlanCreateResponse message. Does not implicitly {@link flyteidl.admin.LaunchPlanCreateResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanCreateResponse} message LaunchPlanCreateResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanSpec.prototype.rawOutputDataConfig = null;
+                LaunchPlanCreateResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    return writer;
+                };
     
                 /**
-                 * LaunchPlanSpec maxParallelism.
-                 * @member {number} maxParallelism
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Decodes a LaunchPlanCreateResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanCreateResponse} LaunchPlanCreateResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanSpec.prototype.maxParallelism = 0;
+                LaunchPlanCreateResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanCreateResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
     
                 /**
-                 * LaunchPlanSpec interruptible.
-                 * @member {google.protobuf.IBoolValue|null|undefined} interruptible
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Verifies a LaunchPlanCreateResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanCreateResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanSpec.prototype.interruptible = null;
+                LaunchPlanCreateResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    return null;
+                };
+    
+                return LaunchPlanCreateResponse;
+            })();
+    
+            /**
+             * LaunchPlanState enum.
+             * @name flyteidl.admin.LaunchPlanState
+             * @enum {string}
+             * @property {number} INACTIVE=0 INACTIVE value
+             * @property {number} ACTIVE=1 ACTIVE value
+             */
+            admin.LaunchPlanState = (function() {
+                var valuesById = {}, values = Object.create(valuesById);
+                values[valuesById[0] = "INACTIVE"] = 0;
+                values[valuesById[1] = "ACTIVE"] = 1;
+                return values;
+            })();
+    
+            admin.LaunchPlan = (function() {
     
                 /**
-                 * LaunchPlanSpec overwriteCache.
-                 * @member {boolean} overwriteCache
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Properties of a LaunchPlan.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlan
+                 * @property {flyteidl.core.IIdentifier|null} [id] LaunchPlan id
+                 * @property {flyteidl.admin.ILaunchPlanSpec|null} [spec] LaunchPlan spec
+                 * @property {flyteidl.admin.ILaunchPlanClosure|null} [closure] LaunchPlan closure
                  */
-                LaunchPlanSpec.prototype.overwriteCache = false;
     
                 /**
-                 * LaunchPlanSpec envs.
-                 * @member {flyteidl.admin.IEnvs|null|undefined} envs
-                 * @memberof flyteidl.admin.LaunchPlanSpec
-                 * @instance
+                 * Constructs a new LaunchPlan.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlan.
+                 * @implements ILaunchPlan
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlan=} [properties] Properties to set
                  */
-                LaunchPlanSpec.prototype.envs = null;
+                function LaunchPlan(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
     
                 /**
-                 * LaunchPlanSpec executionEnvAssignments.
-                 * @member {Array.<flyteidl.core.IExecutionEnvAssignment>} executionEnvAssignments
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * LaunchPlan id.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @instance
                  */
-                LaunchPlanSpec.prototype.executionEnvAssignments = $util.emptyArray;
+                LaunchPlan.prototype.id = null;
     
                 /**
-                 * LaunchPlanSpec clusterAssignment.
-                 * @member {flyteidl.admin.IClusterAssignment|null|undefined} clusterAssignment
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * LaunchPlan spec.
+                 * @member {flyteidl.admin.ILaunchPlanSpec|null|undefined} spec
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @instance
                  */
-                LaunchPlanSpec.prototype.clusterAssignment = null;
+                LaunchPlan.prototype.spec = null;
     
                 /**
-                 * LaunchPlanSpec concurrencyPolicy.
-                 * @member {flyteidl.admin.IConcurrencyPolicy|null|undefined} concurrencyPolicy
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * LaunchPlan closure.
+                 * @member {flyteidl.admin.ILaunchPlanClosure|null|undefined} closure
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @instance
                  */
-                LaunchPlanSpec.prototype.concurrencyPolicy = null;
+                LaunchPlan.prototype.closure = null;
     
                 /**
-                 * Creates a new LaunchPlanSpec instance using the specified properties.
+                 * Creates a new LaunchPlan instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanSpec=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanSpec} LaunchPlanSpec instance
+                 * @param {flyteidl.admin.ILaunchPlan=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlan} LaunchPlan instance
                  */
-                LaunchPlanSpec.create = function create(properties) {
-                    return new LaunchPlanSpec(properties);
+                LaunchPlan.create = function create(properties) {
+                    return new LaunchPlan(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanSpec message. Does not implicitly {@link flyteidl.admin.LaunchPlanSpec.verify|verify} messages.
+                 * Encodes the specified LaunchPlan message. Does not implicitly {@link flyteidl.admin.LaunchPlan.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanSpec} message LaunchPlanSpec message or plain object to encode
+                 * @param {flyteidl.admin.ILaunchPlan} message LaunchPlan message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanSpec.encode = function encode(message, writer) {
+                LaunchPlan.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.workflowId != null && message.hasOwnProperty("workflowId"))
-                        $root.flyteidl.core.Identifier.encode(message.workflowId, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.entityMetadata != null && message.hasOwnProperty("entityMetadata"))
-                        $root.flyteidl.admin.LaunchPlanMetadata.encode(message.entityMetadata, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.defaultInputs != null && message.hasOwnProperty("defaultInputs"))
-                        $root.flyteidl.core.ParameterMap.encode(message.defaultInputs, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
-                    if (message.fixedInputs != null && message.hasOwnProperty("fixedInputs"))
-                        $root.flyteidl.core.LiteralMap.encode(message.fixedInputs, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
-                    if (message.role != null && message.hasOwnProperty("role"))
-                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.role);
-                    if (message.labels != null && message.hasOwnProperty("labels"))
-                        $root.flyteidl.admin.Labels.encode(message.labels, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
-                    if (message.annotations != null && message.hasOwnProperty("annotations"))
-                        $root.flyteidl.admin.Annotations.encode(message.annotations, writer.uint32(/* id 7, wireType 2 =*/58).fork()).ldelim();
-                    if (message.auth != null && message.hasOwnProperty("auth"))
-                        $root.flyteidl.admin.Auth.encode(message.auth, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
-                    if (message.authRole != null && message.hasOwnProperty("authRole"))
-                        $root.flyteidl.admin.AuthRole.encode(message.authRole, writer.uint32(/* id 9, wireType 2 =*/74).fork()).ldelim();
-                    if (message.securityContext != null && message.hasOwnProperty("securityContext"))
-                        $root.flyteidl.core.SecurityContext.encode(message.securityContext, writer.uint32(/* id 10, wireType 2 =*/82).fork()).ldelim();
-                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService"))
-                        $root.flyteidl.core.QualityOfService.encode(message.qualityOfService, writer.uint32(/* id 16, wireType 2 =*/130).fork()).ldelim();
-                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig"))
-                        $root.flyteidl.admin.RawOutputDataConfig.encode(message.rawOutputDataConfig, writer.uint32(/* id 17, wireType 2 =*/138).fork()).ldelim();
-                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
-                        writer.uint32(/* id 18, wireType 0 =*/144).int32(message.maxParallelism);
-                    if (message.interruptible != null && message.hasOwnProperty("interruptible"))
-                        $root.google.protobuf.BoolValue.encode(message.interruptible, writer.uint32(/* id 19, wireType 2 =*/154).fork()).ldelim();
-                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
-                        writer.uint32(/* id 20, wireType 0 =*/160).bool(message.overwriteCache);
-                    if (message.envs != null && message.hasOwnProperty("envs"))
-                        $root.flyteidl.admin.Envs.encode(message.envs, writer.uint32(/* id 21, wireType 2 =*/170).fork()).ldelim();
-                    if (message.executionEnvAssignments != null && message.executionEnvAssignments.length)
-                        for (var i = 0; i < message.executionEnvAssignments.length; ++i)
-                            $root.flyteidl.core.ExecutionEnvAssignment.encode(message.executionEnvAssignments[i], writer.uint32(/* id 22, wireType 2 =*/178).fork()).ldelim();
-                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment"))
-                        $root.flyteidl.admin.ClusterAssignment.encode(message.clusterAssignment, writer.uint32(/* id 23, wireType 2 =*/186).fork()).ldelim();
-                    if (message.concurrencyPolicy != null && message.hasOwnProperty("concurrencyPolicy"))
-                        $root.flyteidl.admin.ConcurrencyPolicy.encode(message.concurrencyPolicy, writer.uint32(/* id 24, wireType 2 =*/194).fork()).ldelim();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.spec != null && message.hasOwnProperty("spec"))
+                        $root.flyteidl.admin.LaunchPlanSpec.encode(message.spec, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.closure != null && message.hasOwnProperty("closure"))
+                        $root.flyteidl.admin.LaunchPlanClosure.encode(message.closure, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanSpec message from the specified reader or buffer.
+                 * Decodes a LaunchPlan message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanSpec} LaunchPlanSpec
+                 * @returns {flyteidl.admin.LaunchPlan} LaunchPlan
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanSpec.decode = function decode(reader, length) {
+                LaunchPlan.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanSpec();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlan();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.workflowId = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
                             break;
                         case 2:
-                            message.entityMetadata = $root.flyteidl.admin.LaunchPlanMetadata.decode(reader, reader.uint32());
+                            message.spec = $root.flyteidl.admin.LaunchPlanSpec.decode(reader, reader.uint32());
                             break;
                         case 3:
-                            message.defaultInputs = $root.flyteidl.core.ParameterMap.decode(reader, reader.uint32());
-                            break;
-                        case 4:
-                            message.fixedInputs = $root.flyteidl.core.LiteralMap.decode(reader, reader.uint32());
-                            break;
-                        case 5:
-                            message.role = reader.string();
-                            break;
-                        case 6:
-                            message.labels = $root.flyteidl.admin.Labels.decode(reader, reader.uint32());
-                            break;
-                        case 7:
-                            message.annotations = $root.flyteidl.admin.Annotations.decode(reader, reader.uint32());
-                            break;
-                        case 8:
-                            message.auth = $root.flyteidl.admin.Auth.decode(reader, reader.uint32());
-                            break;
-                        case 9:
-                            message.authRole = $root.flyteidl.admin.AuthRole.decode(reader, reader.uint32());
-                            break;
-                        case 10:
-                            message.securityContext = $root.flyteidl.core.SecurityContext.decode(reader, reader.uint32());
-                            break;
-                        case 16:
-                            message.qualityOfService = $root.flyteidl.core.QualityOfService.decode(reader, reader.uint32());
-                            break;
-                        case 17:
-                            message.rawOutputDataConfig = $root.flyteidl.admin.RawOutputDataConfig.decode(reader, reader.uint32());
-                            break;
-                        case 18:
-                            message.maxParallelism = reader.int32();
-                            break;
-                        case 19:
-                            message.interruptible = $root.google.protobuf.BoolValue.decode(reader, reader.uint32());
-                            break;
-                        case 20:
-                            message.overwriteCache = reader.bool();
-                            break;
-                        case 21:
-                            message.envs = $root.flyteidl.admin.Envs.decode(reader, reader.uint32());
-                            break;
-                        case 22:
-                            if (!(message.executionEnvAssignments && message.executionEnvAssignments.length))
-                                message.executionEnvAssignments = [];
-                            message.executionEnvAssignments.push($root.flyteidl.core.ExecutionEnvAssignment.decode(reader, reader.uint32()));
-                            break;
-                        case 23:
-                            message.clusterAssignment = $root.flyteidl.admin.ClusterAssignment.decode(reader, reader.uint32());
-                            break;
-                        case 24:
-                            message.concurrencyPolicy = $root.flyteidl.admin.ConcurrencyPolicy.decode(reader, reader.uint32());
+                            message.closure = $root.flyteidl.admin.LaunchPlanClosure.decode(reader, reader.uint32());
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -40632,134 +41429,193 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanSpec message.
+                 * Verifies a LaunchPlan message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @memberof flyteidl.admin.LaunchPlan
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanSpec.verify = function verify(message) {
+                LaunchPlan.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.workflowId != null && message.hasOwnProperty("workflowId")) {
-                        var error = $root.flyteidl.core.Identifier.verify(message.workflowId);
-                        if (error)
-                            return "workflowId." + error;
-                    }
-                    if (message.entityMetadata != null && message.hasOwnProperty("entityMetadata")) {
-                        var error = $root.flyteidl.admin.LaunchPlanMetadata.verify(message.entityMetadata);
-                        if (error)
-                            return "entityMetadata." + error;
-                    }
-                    if (message.defaultInputs != null && message.hasOwnProperty("defaultInputs")) {
-                        var error = $root.flyteidl.core.ParameterMap.verify(message.defaultInputs);
-                        if (error)
-                            return "defaultInputs." + error;
-                    }
-                    if (message.fixedInputs != null && message.hasOwnProperty("fixedInputs")) {
-                        var error = $root.flyteidl.core.LiteralMap.verify(message.fixedInputs);
-                        if (error)
-                            return "fixedInputs." + error;
-                    }
-                    if (message.role != null && message.hasOwnProperty("role"))
-                        if (!$util.isString(message.role))
-                            return "role: string expected";
-                    if (message.labels != null && message.hasOwnProperty("labels")) {
-                        var error = $root.flyteidl.admin.Labels.verify(message.labels);
-                        if (error)
-                            return "labels." + error;
-                    }
-                    if (message.annotations != null && message.hasOwnProperty("annotations")) {
-                        var error = $root.flyteidl.admin.Annotations.verify(message.annotations);
-                        if (error)
-                            return "annotations." + error;
-                    }
-                    if (message.auth != null && message.hasOwnProperty("auth")) {
-                        var error = $root.flyteidl.admin.Auth.verify(message.auth);
-                        if (error)
-                            return "auth." + error;
-                    }
-                    if (message.authRole != null && message.hasOwnProperty("authRole")) {
-                        var error = $root.flyteidl.admin.AuthRole.verify(message.authRole);
-                        if (error)
-                            return "authRole." + error;
-                    }
-                    if (message.securityContext != null && message.hasOwnProperty("securityContext")) {
-                        var error = $root.flyteidl.core.SecurityContext.verify(message.securityContext);
-                        if (error)
-                            return "securityContext." + error;
-                    }
-                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService")) {
-                        var error = $root.flyteidl.core.QualityOfService.verify(message.qualityOfService);
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.id);
                         if (error)
-                            return "qualityOfService." + error;
+                            return "id." + error;
                     }
-                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig")) {
-                        var error = $root.flyteidl.admin.RawOutputDataConfig.verify(message.rawOutputDataConfig);
+                    if (message.spec != null && message.hasOwnProperty("spec")) {
+                        var error = $root.flyteidl.admin.LaunchPlanSpec.verify(message.spec);
                         if (error)
-                            return "rawOutputDataConfig." + error;
+                            return "spec." + error;
                     }
-                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
-                        if (!$util.isInteger(message.maxParallelism))
-                            return "maxParallelism: integer expected";
-                    if (message.interruptible != null && message.hasOwnProperty("interruptible")) {
-                        var error = $root.google.protobuf.BoolValue.verify(message.interruptible);
+                    if (message.closure != null && message.hasOwnProperty("closure")) {
+                        var error = $root.flyteidl.admin.LaunchPlanClosure.verify(message.closure);
                         if (error)
-                            return "interruptible." + error;
+                            return "closure." + error;
                     }
-                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
-                        if (typeof message.overwriteCache !== "boolean")
-                            return "overwriteCache: boolean expected";
-                    if (message.envs != null && message.hasOwnProperty("envs")) {
-                        var error = $root.flyteidl.admin.Envs.verify(message.envs);
-                        if (error)
-                            return "envs." + error;
+                    return null;
+                };
+    
+                return LaunchPlan;
+            })();
+    
+            admin.LaunchPlanList = (function() {
+    
+                /**
+                 * Properties of a LaunchPlanList.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanList
+                 * @property {Array.<flyteidl.admin.ILaunchPlan>|null} [launchPlans] LaunchPlanList launchPlans
+                 * @property {string|null} [token] LaunchPlanList token
+                 */
+    
+                /**
+                 * Constructs a new LaunchPlanList.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlanList.
+                 * @implements ILaunchPlanList
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanList=} [properties] Properties to set
+                 */
+                function LaunchPlanList(properties) {
+                    this.launchPlans = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * LaunchPlanList launchPlans.
+                 * @member {Array.<flyteidl.admin.ILaunchPlan>} launchPlans
+                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @instance
+                 */
+                LaunchPlanList.prototype.launchPlans = $util.emptyArray;
+    
+                /**
+                 * LaunchPlanList token.
+                 * @member {string} token
+                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @instance
+                 */
+                LaunchPlanList.prototype.token = "";
+    
+                /**
+                 * Creates a new LaunchPlanList instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanList=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanList} LaunchPlanList instance
+                 */
+                LaunchPlanList.create = function create(properties) {
+                    return new LaunchPlanList(properties);
+                };
+    
+                /**
+                 * Encodes the specified LaunchPlanList message. Does not implicitly {@link flyteidl.admin.LaunchPlanList.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanList} message LaunchPlanList message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                LaunchPlanList.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.launchPlans != null && message.launchPlans.length)
+                        for (var i = 0; i < message.launchPlans.length; ++i)
+                            $root.flyteidl.admin.LaunchPlan.encode(message.launchPlans[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.token != null && message.hasOwnProperty("token"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.token);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a LaunchPlanList message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanList} LaunchPlanList
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                LaunchPlanList.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanList();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            if (!(message.launchPlans && message.launchPlans.length))
+                                message.launchPlans = [];
+                            message.launchPlans.push($root.flyteidl.admin.LaunchPlan.decode(reader, reader.uint32()));
+                            break;
+                        case 2:
+                            message.token = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
                     }
-                    if (message.executionEnvAssignments != null && message.hasOwnProperty("executionEnvAssignments")) {
-                        if (!Array.isArray(message.executionEnvAssignments))
-                            return "executionEnvAssignments: array expected";
-                        for (var i = 0; i < message.executionEnvAssignments.length; ++i) {
-                            var error = $root.flyteidl.core.ExecutionEnvAssignment.verify(message.executionEnvAssignments[i]);
+                    return message;
+                };
+    
+                /**
+                 * Verifies a LaunchPlanList message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanList
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                LaunchPlanList.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.launchPlans != null && message.hasOwnProperty("launchPlans")) {
+                        if (!Array.isArray(message.launchPlans))
+                            return "launchPlans: array expected";
+                        for (var i = 0; i < message.launchPlans.length; ++i) {
+                            var error = $root.flyteidl.admin.LaunchPlan.verify(message.launchPlans[i]);
                             if (error)
-                                return "executionEnvAssignments." + error;
+                                return "launchPlans." + error;
                         }
                     }
-                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment")) {
-                        var error = $root.flyteidl.admin.ClusterAssignment.verify(message.clusterAssignment);
-                        if (error)
-                            return "clusterAssignment." + error;
-                    }
-                    if (message.concurrencyPolicy != null && message.hasOwnProperty("concurrencyPolicy")) {
-                        var error = $root.flyteidl.admin.ConcurrencyPolicy.verify(message.concurrencyPolicy);
-                        if (error)
-                            return "concurrencyPolicy." + error;
-                    }
+                    if (message.token != null && message.hasOwnProperty("token"))
+                        if (!$util.isString(message.token))
+                            return "token: string expected";
                     return null;
                 };
     
-                return LaunchPlanSpec;
+                return LaunchPlanList;
             })();
     
-            admin.ConcurrencyPolicy = (function() {
+            admin.Auth = (function() {
     
                 /**
-                 * Properties of a ConcurrencyPolicy.
+                 * Properties of an Auth.
                  * @memberof flyteidl.admin
-                 * @interface IConcurrencyPolicy
-                 * @property {number|null} [max] ConcurrencyPolicy max
-                 * @property {flyteidl.admin.ConcurrencyLimitBehavior|null} [behavior] ConcurrencyPolicy behavior
+                 * @interface IAuth
+                 * @property {string|null} [assumableIamRole] Auth assumableIamRole
+                 * @property {string|null} [kubernetesServiceAccount] Auth kubernetesServiceAccount
                  */
     
                 /**
-                 * Constructs a new ConcurrencyPolicy.
+                 * Constructs a new Auth.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a ConcurrencyPolicy.
-                 * @implements IConcurrencyPolicy
+                 * @classdesc Represents an Auth.
+                 * @implements IAuth
                  * @constructor
-                 * @param {flyteidl.admin.IConcurrencyPolicy=} [properties] Properties to set
+                 * @param {flyteidl.admin.IAuth=} [properties] Properties to set
                  */
-                function ConcurrencyPolicy(properties) {
+                function Auth(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -40767,75 +41623,75 @@
                 }
     
                 /**
-                 * ConcurrencyPolicy max.
-                 * @member {number} max
-                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * Auth assumableIamRole.
+                 * @member {string} assumableIamRole
+                 * @memberof flyteidl.admin.Auth
                  * @instance
                  */
-                ConcurrencyPolicy.prototype.max = 0;
+                Auth.prototype.assumableIamRole = "";
     
                 /**
-                 * ConcurrencyPolicy behavior.
-                 * @member {flyteidl.admin.ConcurrencyLimitBehavior} behavior
-                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * Auth kubernetesServiceAccount.
+                 * @member {string} kubernetesServiceAccount
+                 * @memberof flyteidl.admin.Auth
                  * @instance
                  */
-                ConcurrencyPolicy.prototype.behavior = 0;
+                Auth.prototype.kubernetesServiceAccount = "";
     
                 /**
-                 * Creates a new ConcurrencyPolicy instance using the specified properties.
+                 * Creates a new Auth instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @memberof flyteidl.admin.Auth
                  * @static
-                 * @param {flyteidl.admin.IConcurrencyPolicy=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ConcurrencyPolicy} ConcurrencyPolicy instance
+                 * @param {flyteidl.admin.IAuth=} [properties] Properties to set
+                 * @returns {flyteidl.admin.Auth} Auth instance
                  */
-                ConcurrencyPolicy.create = function create(properties) {
-                    return new ConcurrencyPolicy(properties);
+                Auth.create = function create(properties) {
+                    return new Auth(properties);
                 };
     
                 /**
-                 * Encodes the specified ConcurrencyPolicy message. Does not implicitly {@link flyteidl.admin.ConcurrencyPolicy.verify|verify} messages.
+                 * Encodes the specified Auth message. Does not implicitly {@link flyteidl.admin.Auth.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @memberof flyteidl.admin.Auth
                  * @static
-                 * @param {flyteidl.admin.IConcurrencyPolicy} message ConcurrencyPolicy message or plain object to encode
+                 * @param {flyteidl.admin.IAuth} message Auth message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ConcurrencyPolicy.encode = function encode(message, writer) {
+                Auth.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.max != null && message.hasOwnProperty("max"))
-                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.max);
-                    if (message.behavior != null && message.hasOwnProperty("behavior"))
-                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.behavior);
+                    if (message.assumableIamRole != null && message.hasOwnProperty("assumableIamRole"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.assumableIamRole);
+                    if (message.kubernetesServiceAccount != null && message.hasOwnProperty("kubernetesServiceAccount"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.kubernetesServiceAccount);
                     return writer;
                 };
     
                 /**
-                 * Decodes a ConcurrencyPolicy message from the specified reader or buffer.
+                 * Decodes an Auth message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @memberof flyteidl.admin.Auth
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ConcurrencyPolicy} ConcurrencyPolicy
+                 * @returns {flyteidl.admin.Auth} Auth
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ConcurrencyPolicy.decode = function decode(reader, length) {
+                Auth.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ConcurrencyPolicy();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.Auth();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.max = reader.int32();
+                            message.assumableIamRole = reader.string();
                             break;
                         case 2:
-                            message.behavior = reader.int32();
+                            message.kubernetesServiceAccount = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -40846,69 +41702,1577 @@
                 };
     
                 /**
-                 * Verifies a ConcurrencyPolicy message.
+                 * Verifies an Auth message.
                  * @function verify
-                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @memberof flyteidl.admin.Auth
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ConcurrencyPolicy.verify = function verify(message) {
+                Auth.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.max != null && message.hasOwnProperty("max"))
-                        if (!$util.isInteger(message.max))
-                            return "max: integer expected";
-                    if (message.behavior != null && message.hasOwnProperty("behavior"))
-                        switch (message.behavior) {
-                        default:
-                            return "behavior: enum value expected";
-                        case 0:
-                        case 1:
-                            break;
-                        }
+                    if (message.assumableIamRole != null && message.hasOwnProperty("assumableIamRole"))
+                        if (!$util.isString(message.assumableIamRole))
+                            return "assumableIamRole: string expected";
+                    if (message.kubernetesServiceAccount != null && message.hasOwnProperty("kubernetesServiceAccount"))
+                        if (!$util.isString(message.kubernetesServiceAccount))
+                            return "kubernetesServiceAccount: string expected";
                     return null;
                 };
     
-                return ConcurrencyPolicy;
+                return Auth;
             })();
     
-            /**
-             * ConcurrencyLimitBehavior enum.
-             * @name flyteidl.admin.ConcurrencyLimitBehavior
-             * @enum {string}
-             * @property {number} CONCURRENCY_LIMIT_BEHAVIOR_UNSPECIFIED=0 CONCURRENCY_LIMIT_BEHAVIOR_UNSPECIFIED value
-             * @property {number} CONCURRENCY_LIMIT_BEHAVIOR_SKIP=1 CONCURRENCY_LIMIT_BEHAVIOR_SKIP value
-             */
-            admin.ConcurrencyLimitBehavior = (function() {
-                var valuesById = {}, values = Object.create(valuesById);
-                values[valuesById[0] = "CONCURRENCY_LIMIT_BEHAVIOR_UNSPECIFIED"] = 0;
-                values[valuesById[1] = "CONCURRENCY_LIMIT_BEHAVIOR_SKIP"] = 1;
-                return values;
-            })();
+            admin.LaunchPlanSpec = (function() {
     
-            admin.LaunchPlanClosure = (function() {
+                /**
+                 * Properties of a LaunchPlanSpec.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanSpec
+                 * @property {flyteidl.core.IIdentifier|null} [workflowId] LaunchPlanSpec workflowId
+                 * @property {flyteidl.admin.ILaunchPlanMetadata|null} [entityMetadata] LaunchPlanSpec entityMetadata
+                 * @property {flyteidl.core.IParameterMap|null} [defaultInputs] LaunchPlanSpec defaultInputs
+                 * @property {flyteidl.core.ILiteralMap|null} [fixedInputs] LaunchPlanSpec fixedInputs
+                 * @property {string|null} [role] LaunchPlanSpec role
+                 * @property {flyteidl.admin.ILabels|null} [labels] LaunchPlanSpec labels
+                 * @property {flyteidl.admin.IAnnotations|null} [annotations] LaunchPlanSpec annotations
+                 * @property {flyteidl.admin.IAuth|null} [auth] LaunchPlanSpec auth
+                 * @property {flyteidl.admin.IAuthRole|null} [authRole] LaunchPlanSpec authRole
+                 * @property {flyteidl.core.ISecurityContext|null} [securityContext] LaunchPlanSpec securityContext
+                 * @property {flyteidl.core.IQualityOfService|null} [qualityOfService] LaunchPlanSpec qualityOfService
+                 * @property {flyteidl.admin.IRawOutputDataConfig|null} [rawOutputDataConfig] LaunchPlanSpec rawOutputDataConfig
+                 * @property {number|null} [maxParallelism] LaunchPlanSpec maxParallelism
+                 * @property {google.protobuf.IBoolValue|null} [interruptible] LaunchPlanSpec interruptible
+                 * @property {boolean|null} [overwriteCache] LaunchPlanSpec overwriteCache
+                 * @property {flyteidl.admin.IEnvs|null} [envs] LaunchPlanSpec envs
+                 * @property {Array.<flyteidl.core.IExecutionEnvAssignment>|null} [executionEnvAssignments] LaunchPlanSpec executionEnvAssignments
+                 * @property {flyteidl.admin.IClusterAssignment|null} [clusterAssignment] LaunchPlanSpec clusterAssignment
+                 * @property {flyteidl.admin.IConcurrencyPolicy|null} [concurrencyPolicy] LaunchPlanSpec concurrencyPolicy
+                 */
     
                 /**
-                 * Properties of a LaunchPlanClosure.
+                 * Constructs a new LaunchPlanSpec.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanClosure
-                 * @property {flyteidl.admin.LaunchPlanState|null} [state] LaunchPlanClosure state
-                 * @property {flyteidl.core.IParameterMap|null} [expectedInputs] LaunchPlanClosure expectedInputs
-                 * @property {flyteidl.core.IVariableMap|null} [expectedOutputs] LaunchPlanClosure expectedOutputs
-                 * @property {google.protobuf.ITimestamp|null} [createdAt] LaunchPlanClosure createdAt
-                 * @property {google.protobuf.ITimestamp|null} [updatedAt] LaunchPlanClosure updatedAt
+                 * @classdesc Represents a LaunchPlanSpec.
+                 * @implements ILaunchPlanSpec
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanSpec=} [properties] Properties to set
+                 */
+                function LaunchPlanSpec(properties) {
+                    this.executionEnvAssignments = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * LaunchPlanSpec workflowId.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} workflowId
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.workflowId = null;
+    
+                /**
+                 * LaunchPlanSpec entityMetadata.
+                 * @member {flyteidl.admin.ILaunchPlanMetadata|null|undefined} entityMetadata
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.entityMetadata = null;
+    
+                /**
+                 * LaunchPlanSpec defaultInputs.
+                 * @member {flyteidl.core.IParameterMap|null|undefined} defaultInputs
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.defaultInputs = null;
+    
+                /**
+                 * LaunchPlanSpec fixedInputs.
+                 * @member {flyteidl.core.ILiteralMap|null|undefined} fixedInputs
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.fixedInputs = null;
+    
+                /**
+                 * LaunchPlanSpec role.
+                 * @member {string} role
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.role = "";
+    
+                /**
+                 * LaunchPlanSpec labels.
+                 * @member {flyteidl.admin.ILabels|null|undefined} labels
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.labels = null;
+    
+                /**
+                 * LaunchPlanSpec annotations.
+                 * @member {flyteidl.admin.IAnnotations|null|undefined} annotations
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.annotations = null;
+    
+                /**
+                 * LaunchPlanSpec auth.
+                 * @member {flyteidl.admin.IAuth|null|undefined} auth
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.auth = null;
+    
+                /**
+                 * LaunchPlanSpec authRole.
+                 * @member {flyteidl.admin.IAuthRole|null|undefined} authRole
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.authRole = null;
+    
+                /**
+                 * LaunchPlanSpec securityContext.
+                 * @member {flyteidl.core.ISecurityContext|null|undefined} securityContext
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.securityContext = null;
+    
+                /**
+                 * LaunchPlanSpec qualityOfService.
+                 * @member {flyteidl.core.IQualityOfService|null|undefined} qualityOfService
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.qualityOfService = null;
+    
+                /**
+                 * LaunchPlanSpec rawOutputDataConfig.
+                 * @member {flyteidl.admin.IRawOutputDataConfig|null|undefined} rawOutputDataConfig
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.rawOutputDataConfig = null;
+    
+                /**
+                 * LaunchPlanSpec maxParallelism.
+                 * @member {number} maxParallelism
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.maxParallelism = 0;
+    
+                /**
+                 * LaunchPlanSpec interruptible.
+                 * @member {google.protobuf.IBoolValue|null|undefined} interruptible
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.interruptible = null;
+    
+                /**
+                 * LaunchPlanSpec overwriteCache.
+                 * @member {boolean} overwriteCache
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.overwriteCache = false;
+    
+                /**
+                 * LaunchPlanSpec envs.
+                 * @member {flyteidl.admin.IEnvs|null|undefined} envs
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.envs = null;
+    
+                /**
+                 * LaunchPlanSpec executionEnvAssignments.
+                 * @member {Array.<flyteidl.core.IExecutionEnvAssignment>} executionEnvAssignments
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.executionEnvAssignments = $util.emptyArray;
+    
+                /**
+                 * LaunchPlanSpec clusterAssignment.
+                 * @member {flyteidl.admin.IClusterAssignment|null|undefined} clusterAssignment
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.clusterAssignment = null;
+    
+                /**
+                 * LaunchPlanSpec concurrencyPolicy.
+                 * @member {flyteidl.admin.IConcurrencyPolicy|null|undefined} concurrencyPolicy
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @instance
+                 */
+                LaunchPlanSpec.prototype.concurrencyPolicy = null;
+    
+                /**
+                 * Creates a new LaunchPlanSpec instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanSpec=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanSpec} LaunchPlanSpec instance
+                 */
+                LaunchPlanSpec.create = function create(properties) {
+                    return new LaunchPlanSpec(properties);
+                };
+    
+                /**
+                 * Encodes the specified LaunchPlanSpec message. Does not implicitly {@link flyteidl.admin.LaunchPlanSpec.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanSpec} message LaunchPlanSpec message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                LaunchPlanSpec.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.workflowId != null && message.hasOwnProperty("workflowId"))
+                        $root.flyteidl.core.Identifier.encode(message.workflowId, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.entityMetadata != null && message.hasOwnProperty("entityMetadata"))
+                        $root.flyteidl.admin.LaunchPlanMetadata.encode(message.entityMetadata, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.defaultInputs != null && message.hasOwnProperty("defaultInputs"))
+                        $root.flyteidl.core.ParameterMap.encode(message.defaultInputs, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.fixedInputs != null && message.hasOwnProperty("fixedInputs"))
+                        $root.flyteidl.core.LiteralMap.encode(message.fixedInputs, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.role != null && message.hasOwnProperty("role"))
+                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.role);
+                    if (message.labels != null && message.hasOwnProperty("labels"))
+                        $root.flyteidl.admin.Labels.encode(message.labels, writer.uint32(/* id 6, wireType 2 =*/50).fork()).ldelim();
+                    if (message.annotations != null && message.hasOwnProperty("annotations"))
+                        $root.flyteidl.admin.Annotations.encode(message.annotations, writer.uint32(/* id 7, wireType 2 =*/58).fork()).ldelim();
+                    if (message.auth != null && message.hasOwnProperty("auth"))
+                        $root.flyteidl.admin.Auth.encode(message.auth, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
+                    if (message.authRole != null && message.hasOwnProperty("authRole"))
+                        $root.flyteidl.admin.AuthRole.encode(message.authRole, writer.uint32(/* id 9, wireType 2 =*/74).fork()).ldelim();
+                    if (message.securityContext != null && message.hasOwnProperty("securityContext"))
+                        $root.flyteidl.core.SecurityContext.encode(message.securityContext, writer.uint32(/* id 10, wireType 2 =*/82).fork()).ldelim();
+                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService"))
+                        $root.flyteidl.core.QualityOfService.encode(message.qualityOfService, writer.uint32(/* id 16, wireType 2 =*/130).fork()).ldelim();
+                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig"))
+                        $root.flyteidl.admin.RawOutputDataConfig.encode(message.rawOutputDataConfig, writer.uint32(/* id 17, wireType 2 =*/138).fork()).ldelim();
+                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
+                        writer.uint32(/* id 18, wireType 0 =*/144).int32(message.maxParallelism);
+                    if (message.interruptible != null && message.hasOwnProperty("interruptible"))
+                        $root.google.protobuf.BoolValue.encode(message.interruptible, writer.uint32(/* id 19, wireType 2 =*/154).fork()).ldelim();
+                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
+                        writer.uint32(/* id 20, wireType 0 =*/160).bool(message.overwriteCache);
+                    if (message.envs != null && message.hasOwnProperty("envs"))
+                        $root.flyteidl.admin.Envs.encode(message.envs, writer.uint32(/* id 21, wireType 2 =*/170).fork()).ldelim();
+                    if (message.executionEnvAssignments != null && message.executionEnvAssignments.length)
+                        for (var i = 0; i < message.executionEnvAssignments.length; ++i)
+                            $root.flyteidl.core.ExecutionEnvAssignment.encode(message.executionEnvAssignments[i], writer.uint32(/* id 22, wireType 2 =*/178).fork()).ldelim();
+                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment"))
+                        $root.flyteidl.admin.ClusterAssignment.encode(message.clusterAssignment, writer.uint32(/* id 23, wireType 2 =*/186).fork()).ldelim();
+                    if (message.concurrencyPolicy != null && message.hasOwnProperty("concurrencyPolicy"))
+                        $root.flyteidl.admin.ConcurrencyPolicy.encode(message.concurrencyPolicy, writer.uint32(/* id 24, wireType 2 =*/194).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a LaunchPlanSpec message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanSpec} LaunchPlanSpec
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                LaunchPlanSpec.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanSpec();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.workflowId = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.entityMetadata = $root.flyteidl.admin.LaunchPlanMetadata.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.defaultInputs = $root.flyteidl.core.ParameterMap.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.fixedInputs = $root.flyteidl.core.LiteralMap.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.role = reader.string();
+                            break;
+                        case 6:
+                            message.labels = $root.flyteidl.admin.Labels.decode(reader, reader.uint32());
+                            break;
+                        case 7:
+                            message.annotations = $root.flyteidl.admin.Annotations.decode(reader, reader.uint32());
+                            break;
+                        case 8:
+                            message.auth = $root.flyteidl.admin.Auth.decode(reader, reader.uint32());
+                            break;
+                        case 9:
+                            message.authRole = $root.flyteidl.admin.AuthRole.decode(reader, reader.uint32());
+                            break;
+                        case 10:
+                            message.securityContext = $root.flyteidl.core.SecurityContext.decode(reader, reader.uint32());
+                            break;
+                        case 16:
+                            message.qualityOfService = $root.flyteidl.core.QualityOfService.decode(reader, reader.uint32());
+                            break;
+                        case 17:
+                            message.rawOutputDataConfig = $root.flyteidl.admin.RawOutputDataConfig.decode(reader, reader.uint32());
+                            break;
+                        case 18:
+                            message.maxParallelism = reader.int32();
+                            break;
+                        case 19:
+                            message.interruptible = $root.google.protobuf.BoolValue.decode(reader, reader.uint32());
+                            break;
+                        case 20:
+                            message.overwriteCache = reader.bool();
+                            break;
+                        case 21:
+                            message.envs = $root.flyteidl.admin.Envs.decode(reader, reader.uint32());
+                            break;
+                        case 22:
+                            if (!(message.executionEnvAssignments && message.executionEnvAssignments.length))
+                                message.executionEnvAssignments = [];
+                            message.executionEnvAssignments.push($root.flyteidl.core.ExecutionEnvAssignment.decode(reader, reader.uint32()));
+                            break;
+                        case 23:
+                            message.clusterAssignment = $root.flyteidl.admin.ClusterAssignment.decode(reader, reader.uint32());
+                            break;
+                        case 24:
+                            message.concurrencyPolicy = $root.flyteidl.admin.ConcurrencyPolicy.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a LaunchPlanSpec message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanSpec
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                LaunchPlanSpec.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.workflowId != null && message.hasOwnProperty("workflowId")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.workflowId);
+                        if (error)
+                            return "workflowId." + error;
+                    }
+                    if (message.entityMetadata != null && message.hasOwnProperty("entityMetadata")) {
+                        var error = $root.flyteidl.admin.LaunchPlanMetadata.verify(message.entityMetadata);
+                        if (error)
+                            return "entityMetadata." + error;
+                    }
+                    if (message.defaultInputs != null && message.hasOwnProperty("defaultInputs")) {
+                        var error = $root.flyteidl.core.ParameterMap.verify(message.defaultInputs);
+                        if (error)
+                            return "defaultInputs." + error;
+                    }
+                    if (message.fixedInputs != null && message.hasOwnProperty("fixedInputs")) {
+                        var error = $root.flyteidl.core.LiteralMap.verify(message.fixedInputs);
+                        if (error)
+                            return "fixedInputs." + error;
+                    }
+                    if (message.role != null && message.hasOwnProperty("role"))
+                        if (!$util.isString(message.role))
+                            return "role: string expected";
+                    if (message.labels != null && message.hasOwnProperty("labels")) {
+                        var error = $root.flyteidl.admin.Labels.verify(message.labels);
+                        if (error)
+                            return "labels." + error;
+                    }
+                    if (message.annotations != null && message.hasOwnProperty("annotations")) {
+                        var error = $root.flyteidl.admin.Annotations.verify(message.annotations);
+                        if (error)
+                            return "annotations." + error;
+                    }
+                    if (message.auth != null && message.hasOwnProperty("auth")) {
+                        var error = $root.flyteidl.admin.Auth.verify(message.auth);
+                        if (error)
+                            return "auth." + error;
+                    }
+                    if (message.authRole != null && message.hasOwnProperty("authRole")) {
+                        var error = $root.flyteidl.admin.AuthRole.verify(message.authRole);
+                        if (error)
+                            return "authRole." + error;
+                    }
+                    if (message.securityContext != null && message.hasOwnProperty("securityContext")) {
+                        var error = $root.flyteidl.core.SecurityContext.verify(message.securityContext);
+                        if (error)
+                            return "securityContext." + error;
+                    }
+                    if (message.qualityOfService != null && message.hasOwnProperty("qualityOfService")) {
+                        var error = $root.flyteidl.core.QualityOfService.verify(message.qualityOfService);
+                        if (error)
+                            return "qualityOfService." + error;
+                    }
+                    if (message.rawOutputDataConfig != null && message.hasOwnProperty("rawOutputDataConfig")) {
+                        var error = $root.flyteidl.admin.RawOutputDataConfig.verify(message.rawOutputDataConfig);
+                        if (error)
+                            return "rawOutputDataConfig." + error;
+                    }
+                    if (message.maxParallelism != null && message.hasOwnProperty("maxParallelism"))
+                        if (!$util.isInteger(message.maxParallelism))
+                            return "maxParallelism: integer expected";
+                    if (message.interruptible != null && message.hasOwnProperty("interruptible")) {
+                        var error = $root.google.protobuf.BoolValue.verify(message.interruptible);
+                        if (error)
+                            return "interruptible." + error;
+                    }
+                    if (message.overwriteCache != null && message.hasOwnProperty("overwriteCache"))
+                        if (typeof message.overwriteCache !== "boolean")
+                            return "overwriteCache: boolean expected";
+                    if (message.envs != null && message.hasOwnProperty("envs")) {
+                        var error = $root.flyteidl.admin.Envs.verify(message.envs);
+                        if (error)
+                            return "envs." + error;
+                    }
+                    if (message.executionEnvAssignments != null && message.hasOwnProperty("executionEnvAssignments")) {
+                        if (!Array.isArray(message.executionEnvAssignments))
+                            return "executionEnvAssignments: array expected";
+                        for (var i = 0; i < message.executionEnvAssignments.length; ++i) {
+                            var error = $root.flyteidl.core.ExecutionEnvAssignment.verify(message.executionEnvAssignments[i]);
+                            if (error)
+                                return "executionEnvAssignments." + error;
+                        }
+                    }
+                    if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment")) {
+                        var error = $root.flyteidl.admin.ClusterAssignment.verify(message.clusterAssignment);
+                        if (error)
+                            return "clusterAssignment." + error;
+                    }
+                    if (message.concurrencyPolicy != null && message.hasOwnProperty("concurrencyPolicy")) {
+                        var error = $root.flyteidl.admin.ConcurrencyPolicy.verify(message.concurrencyPolicy);
+                        if (error)
+                            return "concurrencyPolicy." + error;
+                    }
+                    return null;
+                };
+    
+                return LaunchPlanSpec;
+            })();
+    
+            admin.ConcurrencyPolicy = (function() {
+    
+                /**
+                 * Properties of a ConcurrencyPolicy.
+                 * @memberof flyteidl.admin
+                 * @interface IConcurrencyPolicy
+                 * @property {number|null} [max] ConcurrencyPolicy max
+                 * @property {flyteidl.admin.ConcurrencyLimitBehavior|null} [behavior] ConcurrencyPolicy behavior
+                 */
+    
+                /**
+                 * Constructs a new ConcurrencyPolicy.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a ConcurrencyPolicy.
+                 * @implements IConcurrencyPolicy
+                 * @constructor
+                 * @param {flyteidl.admin.IConcurrencyPolicy=} [properties] Properties to set
+                 */
+                function ConcurrencyPolicy(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ConcurrencyPolicy max.
+                 * @member {number} max
+                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @instance
+                 */
+                ConcurrencyPolicy.prototype.max = 0;
+    
+                /**
+                 * ConcurrencyPolicy behavior.
+                 * @member {flyteidl.admin.ConcurrencyLimitBehavior} behavior
+                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @instance
+                 */
+                ConcurrencyPolicy.prototype.behavior = 0;
+    
+                /**
+                 * Creates a new ConcurrencyPolicy instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @static
+                 * @param {flyteidl.admin.IConcurrencyPolicy=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ConcurrencyPolicy} ConcurrencyPolicy instance
+                 */
+                ConcurrencyPolicy.create = function create(properties) {
+                    return new ConcurrencyPolicy(properties);
+                };
+    
+                /**
+                 * Encodes the specified ConcurrencyPolicy message. Does not implicitly {@link flyteidl.admin.ConcurrencyPolicy.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @static
+                 * @param {flyteidl.admin.IConcurrencyPolicy} message ConcurrencyPolicy message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ConcurrencyPolicy.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.max != null && message.hasOwnProperty("max"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.max);
+                    if (message.behavior != null && message.hasOwnProperty("behavior"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.behavior);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a ConcurrencyPolicy message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ConcurrencyPolicy} ConcurrencyPolicy
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ConcurrencyPolicy.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ConcurrencyPolicy();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.max = reader.int32();
+                            break;
+                        case 2:
+                            message.behavior = reader.int32();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a ConcurrencyPolicy message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ConcurrencyPolicy
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ConcurrencyPolicy.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.max != null && message.hasOwnProperty("max"))
+                        if (!$util.isInteger(message.max))
+                            return "max: integer expected";
+                    if (message.behavior != null && message.hasOwnProperty("behavior"))
+                        switch (message.behavior) {
+                        default:
+                            return "behavior: enum value expected";
+                        case 0:
+                        case 1:
+                            break;
+                        }
+                    return null;
+                };
+    
+                return ConcurrencyPolicy;
+            })();
+    
+            /**
+             * ConcurrencyLimitBehavior enum.
+             * @name flyteidl.admin.ConcurrencyLimitBehavior
+             * @enum {string}
+             * @property {number} CONCURRENCY_LIMIT_BEHAVIOR_UNSPECIFIED=0 CONCURRENCY_LIMIT_BEHAVIOR_UNSPECIFIED value
+             * @property {number} CONCURRENCY_LIMIT_BEHAVIOR_SKIP=1 CONCURRENCY_LIMIT_BEHAVIOR_SKIP value
+             */
+            admin.ConcurrencyLimitBehavior = (function() {
+                var valuesById = {}, values = Object.create(valuesById);
+                values[valuesById[0] = "CONCURRENCY_LIMIT_BEHAVIOR_UNSPECIFIED"] = 0;
+                values[valuesById[1] = "CONCURRENCY_LIMIT_BEHAVIOR_SKIP"] = 1;
+                return values;
+            })();
+    
+            admin.LaunchPlanClosure = (function() {
+    
+                /**
+                 * Properties of a LaunchPlanClosure.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanClosure
+                 * @property {flyteidl.admin.LaunchPlanState|null} [state] LaunchPlanClosure state
+                 * @property {flyteidl.core.IParameterMap|null} [expectedInputs] LaunchPlanClosure expectedInputs
+                 * @property {flyteidl.core.IVariableMap|null} [expectedOutputs] LaunchPlanClosure expectedOutputs
+                 * @property {google.protobuf.ITimestamp|null} [createdAt] LaunchPlanClosure createdAt
+                 * @property {google.protobuf.ITimestamp|null} [updatedAt] LaunchPlanClosure updatedAt
+                 */
+    
+                /**
+                 * Constructs a new LaunchPlanClosure.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlanClosure.
+                 * @implements ILaunchPlanClosure
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanClosure=} [properties] Properties to set
+                 */
+                function LaunchPlanClosure(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * LaunchPlanClosure state.
+                 * @member {flyteidl.admin.LaunchPlanState} state
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @instance
+                 */
+                LaunchPlanClosure.prototype.state = 0;
+    
+                /**
+                 * LaunchPlanClosure expectedInputs.
+                 * @member {flyteidl.core.IParameterMap|null|undefined} expectedInputs
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @instance
+                 */
+                LaunchPlanClosure.prototype.expectedInputs = null;
+    
+                /**
+                 * LaunchPlanClosure expectedOutputs.
+                 * @member {flyteidl.core.IVariableMap|null|undefined} expectedOutputs
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @instance
+                 */
+                LaunchPlanClosure.prototype.expectedOutputs = null;
+    
+                /**
+                 * LaunchPlanClosure createdAt.
+                 * @member {google.protobuf.ITimestamp|null|undefined} createdAt
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @instance
+                 */
+                LaunchPlanClosure.prototype.createdAt = null;
+    
+                /**
+                 * LaunchPlanClosure updatedAt.
+                 * @member {google.protobuf.ITimestamp|null|undefined} updatedAt
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @instance
+                 */
+                LaunchPlanClosure.prototype.updatedAt = null;
+    
+                /**
+                 * Creates a new LaunchPlanClosure instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanClosure=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanClosure} LaunchPlanClosure instance
+                 */
+                LaunchPlanClosure.create = function create(properties) {
+                    return new LaunchPlanClosure(properties);
+                };
+    
+                /**
+                 * Encodes the specified LaunchPlanClosure message. Does not implicitly {@link flyteidl.admin.LaunchPlanClosure.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanClosure} message LaunchPlanClosure message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                LaunchPlanClosure.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.state != null && message.hasOwnProperty("state"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.state);
+                    if (message.expectedInputs != null && message.hasOwnProperty("expectedInputs"))
+                        $root.flyteidl.core.ParameterMap.encode(message.expectedInputs, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.expectedOutputs != null && message.hasOwnProperty("expectedOutputs"))
+                        $root.flyteidl.core.VariableMap.encode(message.expectedOutputs, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.createdAt != null && message.hasOwnProperty("createdAt"))
+                        $root.google.protobuf.Timestamp.encode(message.createdAt, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.updatedAt != null && message.hasOwnProperty("updatedAt"))
+                        $root.google.protobuf.Timestamp.encode(message.updatedAt, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a LaunchPlanClosure message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanClosure} LaunchPlanClosure
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                LaunchPlanClosure.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanClosure();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.state = reader.int32();
+                            break;
+                        case 2:
+                            message.expectedInputs = $root.flyteidl.core.ParameterMap.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.expectedOutputs = $root.flyteidl.core.VariableMap.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.createdAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.updatedAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a LaunchPlanClosure message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                LaunchPlanClosure.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.state != null && message.hasOwnProperty("state"))
+                        switch (message.state) {
+                        default:
+                            return "state: enum value expected";
+                        case 0:
+                        case 1:
+                            break;
+                        }
+                    if (message.expectedInputs != null && message.hasOwnProperty("expectedInputs")) {
+                        var error = $root.flyteidl.core.ParameterMap.verify(message.expectedInputs);
+                        if (error)
+                            return "expectedInputs." + error;
+                    }
+                    if (message.expectedOutputs != null && message.hasOwnProperty("expectedOutputs")) {
+                        var error = $root.flyteidl.core.VariableMap.verify(message.expectedOutputs);
+                        if (error)
+                            return "expectedOutputs." + error;
+                    }
+                    if (message.createdAt != null && message.hasOwnProperty("createdAt")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.createdAt);
+                        if (error)
+                            return "createdAt." + error;
+                    }
+                    if (message.updatedAt != null && message.hasOwnProperty("updatedAt")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.updatedAt);
+                        if (error)
+                            return "updatedAt." + error;
+                    }
+                    return null;
+                };
+    
+                return LaunchPlanClosure;
+            })();
+    
+            admin.LaunchPlanMetadata = (function() {
+    
+                /**
+                 * Properties of a LaunchPlanMetadata.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanMetadata
+                 * @property {flyteidl.admin.ISchedule|null} [schedule] LaunchPlanMetadata schedule
+                 * @property {Array.<flyteidl.admin.INotification>|null} [notifications] LaunchPlanMetadata notifications
+                 * @property {google.protobuf.IAny|null} [launchConditions] LaunchPlanMetadata launchConditions
+                 * @property {Array.<flyteidl.admin.ITrigger>|null} [triggers] LaunchPlanMetadata triggers
+                 */
+    
+                /**
+                 * Constructs a new LaunchPlanMetadata.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlanMetadata.
+                 * @implements ILaunchPlanMetadata
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanMetadata=} [properties] Properties to set
+                 */
+                function LaunchPlanMetadata(properties) {
+                    this.notifications = [];
+                    this.triggers = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * LaunchPlanMetadata schedule.
+                 * @member {flyteidl.admin.ISchedule|null|undefined} schedule
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @instance
+                 */
+                LaunchPlanMetadata.prototype.schedule = null;
+    
+                /**
+                 * LaunchPlanMetadata notifications.
+                 * @member {Array.<flyteidl.admin.INotification>} notifications
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @instance
+                 */
+                LaunchPlanMetadata.prototype.notifications = $util.emptyArray;
+    
+                /**
+                 * LaunchPlanMetadata launchConditions.
+                 * @member {google.protobuf.IAny|null|undefined} launchConditions
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @instance
+                 */
+                LaunchPlanMetadata.prototype.launchConditions = null;
+    
+                /**
+                 * LaunchPlanMetadata triggers.
+                 * @member {Array.<flyteidl.admin.ITrigger>} triggers
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @instance
+                 */
+                LaunchPlanMetadata.prototype.triggers = $util.emptyArray;
+    
+                /**
+                 * Creates a new LaunchPlanMetadata instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanMetadata=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanMetadata} LaunchPlanMetadata instance
+                 */
+                LaunchPlanMetadata.create = function create(properties) {
+                    return new LaunchPlanMetadata(properties);
+                };
+    
+                /**
+                 * Encodes the specified LaunchPlanMetadata message. Does not implicitly {@link flyteidl.admin.LaunchPlanMetadata.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanMetadata} message LaunchPlanMetadata message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                LaunchPlanMetadata.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.schedule != null && message.hasOwnProperty("schedule"))
+                        $root.flyteidl.admin.Schedule.encode(message.schedule, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.notifications != null && message.notifications.length)
+                        for (var i = 0; i < message.notifications.length; ++i)
+                            $root.flyteidl.admin.Notification.encode(message.notifications[i], writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.launchConditions != null && message.hasOwnProperty("launchConditions"))
+                        $root.google.protobuf.Any.encode(message.launchConditions, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.triggers != null && message.triggers.length)
+                        for (var i = 0; i < message.triggers.length; ++i)
+                            $root.flyteidl.admin.Trigger.encode(message.triggers[i], writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a LaunchPlanMetadata message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanMetadata} LaunchPlanMetadata
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                LaunchPlanMetadata.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanMetadata();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.schedule = $root.flyteidl.admin.Schedule.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            if (!(message.notifications && message.notifications.length))
+                                message.notifications = [];
+                            message.notifications.push($root.flyteidl.admin.Notification.decode(reader, reader.uint32()));
+                            break;
+                        case 3:
+                            message.launchConditions = $root.google.protobuf.Any.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            if (!(message.triggers && message.triggers.length))
+                                message.triggers = [];
+                            message.triggers.push($root.flyteidl.admin.Trigger.decode(reader, reader.uint32()));
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a LaunchPlanMetadata message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                LaunchPlanMetadata.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.schedule != null && message.hasOwnProperty("schedule")) {
+                        var error = $root.flyteidl.admin.Schedule.verify(message.schedule);
+                        if (error)
+                            return "schedule." + error;
+                    }
+                    if (message.notifications != null && message.hasOwnProperty("notifications")) {
+                        if (!Array.isArray(message.notifications))
+                            return "notifications: array expected";
+                        for (var i = 0; i < message.notifications.length; ++i) {
+                            var error = $root.flyteidl.admin.Notification.verify(message.notifications[i]);
+                            if (error)
+                                return "notifications." + error;
+                        }
+                    }
+                    if (message.launchConditions != null && message.hasOwnProperty("launchConditions")) {
+                        var error = $root.google.protobuf.Any.verify(message.launchConditions);
+                        if (error)
+                            return "launchConditions." + error;
+                    }
+                    if (message.triggers != null && message.hasOwnProperty("triggers")) {
+                        if (!Array.isArray(message.triggers))
+                            return "triggers: array expected";
+                        for (var i = 0; i < message.triggers.length; ++i) {
+                            var error = $root.flyteidl.admin.Trigger.verify(message.triggers[i]);
+                            if (error)
+                                return "triggers." + error;
+                        }
+                    }
+                    return null;
+                };
+    
+                return LaunchPlanMetadata;
+            })();
+    
+            admin.LaunchPlanUpdateRequest = (function() {
+    
+                /**
+                 * Properties of a LaunchPlanUpdateRequest.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanUpdateRequest
+                 * @property {flyteidl.core.IIdentifier|null} [id] LaunchPlanUpdateRequest id
+                 * @property {flyteidl.admin.LaunchPlanState|null} [state] LaunchPlanUpdateRequest state
+                 */
+    
+                /**
+                 * Constructs a new LaunchPlanUpdateRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlanUpdateRequest.
+                 * @implements ILaunchPlanUpdateRequest
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanUpdateRequest=} [properties] Properties to set
+                 */
+                function LaunchPlanUpdateRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * LaunchPlanUpdateRequest id.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @instance
+                 */
+                LaunchPlanUpdateRequest.prototype.id = null;
+    
+                /**
+                 * LaunchPlanUpdateRequest state.
+                 * @member {flyteidl.admin.LaunchPlanState} state
+                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @instance
+                 */
+                LaunchPlanUpdateRequest.prototype.state = 0;
+    
+                /**
+                 * Creates a new LaunchPlanUpdateRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanUpdateRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanUpdateRequest} LaunchPlanUpdateRequest instance
+                 */
+                LaunchPlanUpdateRequest.create = function create(properties) {
+                    return new LaunchPlanUpdateRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified LaunchPlanUpdateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanUpdateRequest} message LaunchPlanUpdateRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                LaunchPlanUpdateRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.state != null && message.hasOwnProperty("state"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.state);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a LaunchPlanUpdateRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanUpdateRequest} LaunchPlanUpdateRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                LaunchPlanUpdateRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanUpdateRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.state = reader.int32();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a LaunchPlanUpdateRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                LaunchPlanUpdateRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.state != null && message.hasOwnProperty("state"))
+                        switch (message.state) {
+                        default:
+                            return "state: enum value expected";
+                        case 0:
+                        case 1:
+                            break;
+                        }
+                    return null;
+                };
+    
+                return LaunchPlanUpdateRequest;
+            })();
+    
+            admin.LaunchPlanUpdateResponse = (function() {
+    
+                /**
+                 * Properties of a LaunchPlanUpdateResponse.
+                 * @memberof flyteidl.admin
+                 * @interface ILaunchPlanUpdateResponse
+                 */
+    
+                /**
+                 * Constructs a new LaunchPlanUpdateResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a LaunchPlanUpdateResponse.
+                 * @implements ILaunchPlanUpdateResponse
+                 * @constructor
+                 * @param {flyteidl.admin.ILaunchPlanUpdateResponse=} [properties] Properties to set
+                 */
+                function LaunchPlanUpdateResponse(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * Creates a new LaunchPlanUpdateResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanUpdateResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.LaunchPlanUpdateResponse} LaunchPlanUpdateResponse instance
+                 */
+                LaunchPlanUpdateResponse.create = function create(properties) {
+                    return new LaunchPlanUpdateResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified LaunchPlanUpdateResponse message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @static
+                 * @param {flyteidl.admin.ILaunchPlanUpdateResponse} message LaunchPlanUpdateResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                LaunchPlanUpdateResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a LaunchPlanUpdateResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.LaunchPlanUpdateResponse} LaunchPlanUpdateResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                LaunchPlanUpdateResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanUpdateResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a LaunchPlanUpdateResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                LaunchPlanUpdateResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    return null;
+                };
+    
+                return LaunchPlanUpdateResponse;
+            })();
+    
+            admin.ActiveLaunchPlanRequest = (function() {
+    
+                /**
+                 * Properties of an ActiveLaunchPlanRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IActiveLaunchPlanRequest
+                 * @property {flyteidl.admin.INamedEntityIdentifier|null} [id] ActiveLaunchPlanRequest id
+                 */
+    
+                /**
+                 * Constructs a new ActiveLaunchPlanRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ActiveLaunchPlanRequest.
+                 * @implements IActiveLaunchPlanRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IActiveLaunchPlanRequest=} [properties] Properties to set
+                 */
+                function ActiveLaunchPlanRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ActiveLaunchPlanRequest id.
+                 * @member {flyteidl.admin.INamedEntityIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanRequest.prototype.id = null;
+    
+                /**
+                 * Creates a new ActiveLaunchPlanRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @static
+                 * @param {flyteidl.admin.IActiveLaunchPlanRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ActiveLaunchPlanRequest} ActiveLaunchPlanRequest instance
+                 */
+                ActiveLaunchPlanRequest.create = function create(properties) {
+                    return new ActiveLaunchPlanRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified ActiveLaunchPlanRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @static
+                 * @param {flyteidl.admin.IActiveLaunchPlanRequest} message ActiveLaunchPlanRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ActiveLaunchPlanRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.admin.NamedEntityIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ActiveLaunchPlanRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ActiveLaunchPlanRequest} ActiveLaunchPlanRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ActiveLaunchPlanRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ActiveLaunchPlanRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.admin.NamedEntityIdentifier.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ActiveLaunchPlanRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ActiveLaunchPlanRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.admin.NamedEntityIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    return null;
+                };
+    
+                return ActiveLaunchPlanRequest;
+            })();
+    
+            admin.ActiveLaunchPlanListRequest = (function() {
+    
+                /**
+                 * Properties of an ActiveLaunchPlanListRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IActiveLaunchPlanListRequest
+                 * @property {string|null} [project] ActiveLaunchPlanListRequest project
+                 * @property {string|null} [domain] ActiveLaunchPlanListRequest domain
+                 * @property {number|null} [limit] ActiveLaunchPlanListRequest limit
+                 * @property {string|null} [token] ActiveLaunchPlanListRequest token
+                 * @property {flyteidl.admin.ISort|null} [sortBy] ActiveLaunchPlanListRequest sortBy
+                 * @property {string|null} [org] ActiveLaunchPlanListRequest org
+                 */
+    
+                /**
+                 * Constructs a new ActiveLaunchPlanListRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ActiveLaunchPlanListRequest.
+                 * @implements IActiveLaunchPlanListRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IActiveLaunchPlanListRequest=} [properties] Properties to set
+                 */
+                function ActiveLaunchPlanListRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ActiveLaunchPlanListRequest project.
+                 * @member {string} project
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanListRequest.prototype.project = "";
+    
+                /**
+                 * ActiveLaunchPlanListRequest domain.
+                 * @member {string} domain
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanListRequest.prototype.domain = "";
+    
+                /**
+                 * ActiveLaunchPlanListRequest limit.
+                 * @member {number} limit
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanListRequest.prototype.limit = 0;
+    
+                /**
+                 * ActiveLaunchPlanListRequest token.
+                 * @member {string} token
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanListRequest.prototype.token = "";
+    
+                /**
+                 * ActiveLaunchPlanListRequest sortBy.
+                 * @member {flyteidl.admin.ISort|null|undefined} sortBy
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanListRequest.prototype.sortBy = null;
+    
+                /**
+                 * ActiveLaunchPlanListRequest org.
+                 * @member {string} org
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @instance
+                 */
+                ActiveLaunchPlanListRequest.prototype.org = "";
+    
+                /**
+                 * Creates a new ActiveLaunchPlanListRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @static
+                 * @param {flyteidl.admin.IActiveLaunchPlanListRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ActiveLaunchPlanListRequest} ActiveLaunchPlanListRequest instance
+                 */
+                ActiveLaunchPlanListRequest.create = function create(properties) {
+                    return new ActiveLaunchPlanListRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified ActiveLaunchPlanListRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanListRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @static
+                 * @param {flyteidl.admin.IActiveLaunchPlanListRequest} message ActiveLaunchPlanListRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ActiveLaunchPlanListRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.project);
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.domain);
+                    if (message.limit != null && message.hasOwnProperty("limit"))
+                        writer.uint32(/* id 3, wireType 0 =*/24).uint32(message.limit);
+                    if (message.token != null && message.hasOwnProperty("token"))
+                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.token);
+                    if (message.sortBy != null && message.hasOwnProperty("sortBy"))
+                        $root.flyteidl.admin.Sort.encode(message.sortBy, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        writer.uint32(/* id 6, wireType 2 =*/50).string(message.org);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ActiveLaunchPlanListRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ActiveLaunchPlanListRequest} ActiveLaunchPlanListRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ActiveLaunchPlanListRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ActiveLaunchPlanListRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.project = reader.string();
+                            break;
+                        case 2:
+                            message.domain = reader.string();
+                            break;
+                        case 3:
+                            message.limit = reader.uint32();
+                            break;
+                        case 4:
+                            message.token = reader.string();
+                            break;
+                        case 5:
+                            message.sortBy = $root.flyteidl.admin.Sort.decode(reader, reader.uint32());
+                            break;
+                        case 6:
+                            message.org = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ActiveLaunchPlanListRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ActiveLaunchPlanListRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        if (!$util.isString(message.project))
+                            return "project: string expected";
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        if (!$util.isString(message.domain))
+                            return "domain: string expected";
+                    if (message.limit != null && message.hasOwnProperty("limit"))
+                        if (!$util.isInteger(message.limit))
+                            return "limit: integer expected";
+                    if (message.token != null && message.hasOwnProperty("token"))
+                        if (!$util.isString(message.token))
+                            return "token: string expected";
+                    if (message.sortBy != null && message.hasOwnProperty("sortBy")) {
+                        var error = $root.flyteidl.admin.Sort.verify(message.sortBy);
+                        if (error)
+                            return "sortBy." + error;
+                    }
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        if (!$util.isString(message.org))
+                            return "org: string expected";
+                    return null;
+                };
+    
+                return ActiveLaunchPlanListRequest;
+            })();
+    
+            /**
+             * FixedRateUnit enum.
+             * @name flyteidl.admin.FixedRateUnit
+             * @enum {string}
+             * @property {number} MINUTE=0 MINUTE value
+             * @property {number} HOUR=1 HOUR value
+             * @property {number} DAY=2 DAY value
+             */
+            admin.FixedRateUnit = (function() {
+                var valuesById = {}, values = Object.create(valuesById);
+                values[valuesById[0] = "MINUTE"] = 0;
+                values[valuesById[1] = "HOUR"] = 1;
+                values[valuesById[2] = "DAY"] = 2;
+                return values;
+            })();
+    
+            admin.FixedRate = (function() {
+    
+                /**
+                 * Properties of a FixedRate.
+                 * @memberof flyteidl.admin
+                 * @interface IFixedRate
+                 * @property {number|null} [value] FixedRate value
+                 * @property {flyteidl.admin.FixedRateUnit|null} [unit] FixedRate unit
                  */
     
                 /**
-                 * Constructs a new LaunchPlanClosure.
+                 * Constructs a new FixedRate.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanClosure.
-                 * @implements ILaunchPlanClosure
+                 * @classdesc Represents a FixedRate.
+                 * @implements IFixedRate
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanClosure=} [properties] Properties to set
+                 * @param {flyteidl.admin.IFixedRate=} [properties] Properties to set
                  */
-                function LaunchPlanClosure(properties) {
+                function FixedRate(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -40916,114 +43280,75 @@
                 }
     
                 /**
-                 * LaunchPlanClosure state.
-                 * @member {flyteidl.admin.LaunchPlanState} state
-                 * @memberof flyteidl.admin.LaunchPlanClosure
-                 * @instance
-                 */
-                LaunchPlanClosure.prototype.state = 0;
-    
-                /**
-                 * LaunchPlanClosure expectedInputs.
-                 * @member {flyteidl.core.IParameterMap|null|undefined} expectedInputs
-                 * @memberof flyteidl.admin.LaunchPlanClosure
-                 * @instance
-                 */
-                LaunchPlanClosure.prototype.expectedInputs = null;
-    
-                /**
-                 * LaunchPlanClosure expectedOutputs.
-                 * @member {flyteidl.core.IVariableMap|null|undefined} expectedOutputs
-                 * @memberof flyteidl.admin.LaunchPlanClosure
-                 * @instance
-                 */
-                LaunchPlanClosure.prototype.expectedOutputs = null;
-    
-                /**
-                 * LaunchPlanClosure createdAt.
-                 * @member {google.protobuf.ITimestamp|null|undefined} createdAt
-                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * FixedRate value.
+                 * @member {number} value
+                 * @memberof flyteidl.admin.FixedRate
                  * @instance
                  */
-                LaunchPlanClosure.prototype.createdAt = null;
+                FixedRate.prototype.value = 0;
     
                 /**
-                 * LaunchPlanClosure updatedAt.
-                 * @member {google.protobuf.ITimestamp|null|undefined} updatedAt
-                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * FixedRate unit.
+                 * @member {flyteidl.admin.FixedRateUnit} unit
+                 * @memberof flyteidl.admin.FixedRate
                  * @instance
                  */
-                LaunchPlanClosure.prototype.updatedAt = null;
+                FixedRate.prototype.unit = 0;
     
                 /**
-                 * Creates a new LaunchPlanClosure instance using the specified properties.
+                 * Creates a new FixedRate instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @memberof flyteidl.admin.FixedRate
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanClosure=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanClosure} LaunchPlanClosure instance
+                 * @param {flyteidl.admin.IFixedRate=} [properties] Properties to set
+                 * @returns {flyteidl.admin.FixedRate} FixedRate instance
                  */
-                LaunchPlanClosure.create = function create(properties) {
-                    return new LaunchPlanClosure(properties);
+                FixedRate.create = function create(properties) {
+                    return new FixedRate(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanClosure message. Does not implicitly {@link flyteidl.admin.LaunchPlanClosure.verify|verify} messages.
+                 * Encodes the specified FixedRate message. Does not implicitly {@link flyteidl.admin.FixedRate.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @memberof flyteidl.admin.FixedRate
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanClosure} message LaunchPlanClosure message or plain object to encode
+                 * @param {flyteidl.admin.IFixedRate} message FixedRate message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanClosure.encode = function encode(message, writer) {
+                FixedRate.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.state != null && message.hasOwnProperty("state"))
-                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.state);
-                    if (message.expectedInputs != null && message.hasOwnProperty("expectedInputs"))
-                        $root.flyteidl.core.ParameterMap.encode(message.expectedInputs, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.expectedOutputs != null && message.hasOwnProperty("expectedOutputs"))
-                        $root.flyteidl.core.VariableMap.encode(message.expectedOutputs, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
-                    if (message.createdAt != null && message.hasOwnProperty("createdAt"))
-                        $root.google.protobuf.Timestamp.encode(message.createdAt, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
-                    if (message.updatedAt != null && message.hasOwnProperty("updatedAt"))
-                        $root.google.protobuf.Timestamp.encode(message.updatedAt, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    if (message.value != null && message.hasOwnProperty("value"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).uint32(message.value);
+                    if (message.unit != null && message.hasOwnProperty("unit"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.unit);
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanClosure message from the specified reader or buffer.
+                 * Decodes a FixedRate message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @memberof flyteidl.admin.FixedRate
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanClosure} LaunchPlanClosure
+                 * @returns {flyteidl.admin.FixedRate} FixedRate
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanClosure.decode = function decode(reader, length) {
+                FixedRate.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanClosure();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.FixedRate();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.state = reader.int32();
+                            message.value = reader.uint32();
                             break;
                         case 2:
-                            message.expectedInputs = $root.flyteidl.core.ParameterMap.decode(reader, reader.uint32());
-                            break;
-                        case 3:
-                            message.expectedOutputs = $root.flyteidl.core.VariableMap.decode(reader, reader.uint32());
-                            break;
-                        case 4:
-                            message.createdAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
-                            break;
-                        case 5:
-                            message.updatedAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            message.unit = reader.int32();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -41034,71 +43359,53 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanClosure message.
+                 * Verifies a FixedRate message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanClosure
+                 * @memberof flyteidl.admin.FixedRate
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanClosure.verify = function verify(message) {
+                FixedRate.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.state != null && message.hasOwnProperty("state"))
-                        switch (message.state) {
+                    if (message.value != null && message.hasOwnProperty("value"))
+                        if (!$util.isInteger(message.value))
+                            return "value: integer expected";
+                    if (message.unit != null && message.hasOwnProperty("unit"))
+                        switch (message.unit) {
                         default:
-                            return "state: enum value expected";
+                            return "unit: enum value expected";
                         case 0:
                         case 1:
+                        case 2:
                             break;
                         }
-                    if (message.expectedInputs != null && message.hasOwnProperty("expectedInputs")) {
-                        var error = $root.flyteidl.core.ParameterMap.verify(message.expectedInputs);
-                        if (error)
-                            return "expectedInputs." + error;
-                    }
-                    if (message.expectedOutputs != null && message.hasOwnProperty("expectedOutputs")) {
-                        var error = $root.flyteidl.core.VariableMap.verify(message.expectedOutputs);
-                        if (error)
-                            return "expectedOutputs." + error;
-                    }
-                    if (message.createdAt != null && message.hasOwnProperty("createdAt")) {
-                        var error = $root.google.protobuf.Timestamp.verify(message.createdAt);
-                        if (error)
-                            return "createdAt." + error;
-                    }
-                    if (message.updatedAt != null && message.hasOwnProperty("updatedAt")) {
-                        var error = $root.google.protobuf.Timestamp.verify(message.updatedAt);
-                        if (error)
-                            return "updatedAt." + error;
-                    }
                     return null;
                 };
     
-                return LaunchPlanClosure;
+                return FixedRate;
             })();
     
-            admin.LaunchPlanMetadata = (function() {
+            admin.CronSchedule = (function() {
     
                 /**
-                 * Properties of a LaunchPlanMetadata.
+                 * Properties of a CronSchedule.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanMetadata
-                 * @property {flyteidl.admin.ISchedule|null} [schedule] LaunchPlanMetadata schedule
-                 * @property {Array.<flyteidl.admin.INotification>|null} [notifications] LaunchPlanMetadata notifications
-                 * @property {google.protobuf.IAny|null} [launchConditions] LaunchPlanMetadata launchConditions
+                 * @interface ICronSchedule
+                 * @property {string|null} [schedule] CronSchedule schedule
+                 * @property {string|null} [offset] CronSchedule offset
                  */
     
                 /**
-                 * Constructs a new LaunchPlanMetadata.
+                 * Constructs a new CronSchedule.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanMetadata.
-                 * @implements ILaunchPlanMetadata
+                 * @classdesc Represents a CronSchedule.
+                 * @implements ICronSchedule
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanMetadata=} [properties] Properties to set
+                 * @param {flyteidl.admin.ICronSchedule=} [properties] Properties to set
                  */
-                function LaunchPlanMetadata(properties) {
-                    this.notifications = [];
+                function CronSchedule(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41106,91 +43413,75 @@
                 }
     
                 /**
-                 * LaunchPlanMetadata schedule.
-                 * @member {flyteidl.admin.ISchedule|null|undefined} schedule
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
-                 * @instance
-                 */
-                LaunchPlanMetadata.prototype.schedule = null;
-    
-                /**
-                 * LaunchPlanMetadata notifications.
-                 * @member {Array.<flyteidl.admin.INotification>} notifications
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * CronSchedule schedule.
+                 * @member {string} schedule
+                 * @memberof flyteidl.admin.CronSchedule
                  * @instance
                  */
-                LaunchPlanMetadata.prototype.notifications = $util.emptyArray;
+                CronSchedule.prototype.schedule = "";
     
                 /**
-                 * LaunchPlanMetadata launchConditions.
-                 * @member {google.protobuf.IAny|null|undefined} launchConditions
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * CronSchedule offset.
+                 * @member {string} offset
+                 * @memberof flyteidl.admin.CronSchedule
                  * @instance
                  */
-                LaunchPlanMetadata.prototype.launchConditions = null;
+                CronSchedule.prototype.offset = "";
     
                 /**
-                 * Creates a new LaunchPlanMetadata instance using the specified properties.
+                 * Creates a new CronSchedule instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @memberof flyteidl.admin.CronSchedule
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanMetadata=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanMetadata} LaunchPlanMetadata instance
+                 * @param {flyteidl.admin.ICronSchedule=} [properties] Properties to set
+                 * @returns {flyteidl.admin.CronSchedule} CronSchedule instance
                  */
-                LaunchPlanMetadata.create = function create(properties) {
-                    return new LaunchPlanMetadata(properties);
+                CronSchedule.create = function create(properties) {
+                    return new CronSchedule(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanMetadata message. Does not implicitly {@link flyteidl.admin.LaunchPlanMetadata.verify|verify} messages.
+                 * Encodes the specified CronSchedule message. Does not implicitly {@link flyteidl.admin.CronSchedule.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @memberof flyteidl.admin.CronSchedule
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanMetadata} message LaunchPlanMetadata message or plain object to encode
+                 * @param {flyteidl.admin.ICronSchedule} message CronSchedule message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanMetadata.encode = function encode(message, writer) {
+                CronSchedule.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
                     if (message.schedule != null && message.hasOwnProperty("schedule"))
-                        $root.flyteidl.admin.Schedule.encode(message.schedule, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.notifications != null && message.notifications.length)
-                        for (var i = 0; i < message.notifications.length; ++i)
-                            $root.flyteidl.admin.Notification.encode(message.notifications[i], writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.launchConditions != null && message.hasOwnProperty("launchConditions"))
-                        $root.google.protobuf.Any.encode(message.launchConditions, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.schedule);
+                    if (message.offset != null && message.hasOwnProperty("offset"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.offset);
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanMetadata message from the specified reader or buffer.
+                 * Decodes a CronSchedule message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @memberof flyteidl.admin.CronSchedule
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanMetadata} LaunchPlanMetadata
+                 * @returns {flyteidl.admin.CronSchedule} CronSchedule
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanMetadata.decode = function decode(reader, length) {
+                CronSchedule.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanMetadata();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.CronSchedule();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.schedule = $root.flyteidl.admin.Schedule.decode(reader, reader.uint32());
+                            message.schedule = reader.string();
                             break;
                         case 2:
-                            if (!(message.notifications && message.notifications.length))
-                                message.notifications = [];
-                            message.notifications.push($root.flyteidl.admin.Notification.decode(reader, reader.uint32()));
-                            break;
-                        case 3:
-                            message.launchConditions = $root.google.protobuf.Any.decode(reader, reader.uint32());
+                            message.offset = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -41201,60 +43492,49 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanMetadata message.
+                 * Verifies a CronSchedule message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanMetadata
+                 * @memberof flyteidl.admin.CronSchedule
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanMetadata.verify = function verify(message) {
+                CronSchedule.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.schedule != null && message.hasOwnProperty("schedule")) {
-                        var error = $root.flyteidl.admin.Schedule.verify(message.schedule);
-                        if (error)
-                            return "schedule." + error;
-                    }
-                    if (message.notifications != null && message.hasOwnProperty("notifications")) {
-                        if (!Array.isArray(message.notifications))
-                            return "notifications: array expected";
-                        for (var i = 0; i < message.notifications.length; ++i) {
-                            var error = $root.flyteidl.admin.Notification.verify(message.notifications[i]);
-                            if (error)
-                                return "notifications." + error;
-                        }
-                    }
-                    if (message.launchConditions != null && message.hasOwnProperty("launchConditions")) {
-                        var error = $root.google.protobuf.Any.verify(message.launchConditions);
-                        if (error)
-                            return "launchConditions." + error;
-                    }
+                    if (message.schedule != null && message.hasOwnProperty("schedule"))
+                        if (!$util.isString(message.schedule))
+                            return "schedule: string expected";
+                    if (message.offset != null && message.hasOwnProperty("offset"))
+                        if (!$util.isString(message.offset))
+                            return "offset: string expected";
                     return null;
                 };
     
-                return LaunchPlanMetadata;
+                return CronSchedule;
             })();
     
-            admin.LaunchPlanUpdateRequest = (function() {
+            admin.Schedule = (function() {
     
                 /**
-                 * Properties of a LaunchPlanUpdateRequest.
+                 * Properties of a Schedule.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanUpdateRequest
-                 * @property {flyteidl.core.IIdentifier|null} [id] LaunchPlanUpdateRequest id
-                 * @property {flyteidl.admin.LaunchPlanState|null} [state] LaunchPlanUpdateRequest state
+                 * @interface ISchedule
+                 * @property {string|null} [cronExpression] Schedule cronExpression
+                 * @property {flyteidl.admin.IFixedRate|null} [rate] Schedule rate
+                 * @property {flyteidl.admin.ICronSchedule|null} [cronSchedule] Schedule cronSchedule
+                 * @property {string|null} [kickoffTimeInputArg] Schedule kickoffTimeInputArg
                  */
     
                 /**
-                 * Constructs a new LaunchPlanUpdateRequest.
+                 * Constructs a new Schedule.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanUpdateRequest.
-                 * @implements ILaunchPlanUpdateRequest
+                 * @classdesc Represents a Schedule.
+                 * @implements ISchedule
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanUpdateRequest=} [properties] Properties to set
+                 * @param {flyteidl.admin.ISchedule=} [properties] Properties to set
                  */
-                function LaunchPlanUpdateRequest(properties) {
+                function Schedule(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41262,75 +43542,115 @@
                 }
     
                 /**
-                 * LaunchPlanUpdateRequest id.
-                 * @member {flyteidl.core.IIdentifier|null|undefined} id
-                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * Schedule cronExpression.
+                 * @member {string} cronExpression
+                 * @memberof flyteidl.admin.Schedule
                  * @instance
                  */
-                LaunchPlanUpdateRequest.prototype.id = null;
+                Schedule.prototype.cronExpression = "";
     
                 /**
-                 * LaunchPlanUpdateRequest state.
-                 * @member {flyteidl.admin.LaunchPlanState} state
-                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * Schedule rate.
+                 * @member {flyteidl.admin.IFixedRate|null|undefined} rate
+                 * @memberof flyteidl.admin.Schedule
                  * @instance
                  */
-                LaunchPlanUpdateRequest.prototype.state = 0;
+                Schedule.prototype.rate = null;
     
                 /**
-                 * Creates a new LaunchPlanUpdateRequest instance using the specified properties.
+                 * Schedule cronSchedule.
+                 * @member {flyteidl.admin.ICronSchedule|null|undefined} cronSchedule
+                 * @memberof flyteidl.admin.Schedule
+                 * @instance
+                 */
+                Schedule.prototype.cronSchedule = null;
+    
+                /**
+                 * Schedule kickoffTimeInputArg.
+                 * @member {string} kickoffTimeInputArg
+                 * @memberof flyteidl.admin.Schedule
+                 * @instance
+                 */
+                Schedule.prototype.kickoffTimeInputArg = "";
+    
+                // OneOf field names bound to virtual getters and setters
+                var $oneOfFields;
+    
+                /**
+                 * Schedule ScheduleExpression.
+                 * @member {"cronExpression"|"rate"|"cronSchedule"|undefined} ScheduleExpression
+                 * @memberof flyteidl.admin.Schedule
+                 * @instance
+                 */
+                Object.defineProperty(Schedule.prototype, "ScheduleExpression", {
+                    get: $util.oneOfGetter($oneOfFields = ["cronExpression", "rate", "cronSchedule"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
+                /**
+                 * Creates a new Schedule instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @memberof flyteidl.admin.Schedule
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanUpdateRequest=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanUpdateRequest} LaunchPlanUpdateRequest instance
+                 * @param {flyteidl.admin.ISchedule=} [properties] Properties to set
+                 * @returns {flyteidl.admin.Schedule} Schedule instance
                  */
-                LaunchPlanUpdateRequest.create = function create(properties) {
-                    return new LaunchPlanUpdateRequest(properties);
+                Schedule.create = function create(properties) {
+                    return new Schedule(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanUpdateRequest message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateRequest.verify|verify} messages.
+                 * Encodes the specified Schedule message. Does not implicitly {@link flyteidl.admin.Schedule.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @memberof flyteidl.admin.Schedule
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanUpdateRequest} message LaunchPlanUpdateRequest message or plain object to encode
+                 * @param {flyteidl.admin.ISchedule} message Schedule message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanUpdateRequest.encode = function encode(message, writer) {
+                Schedule.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.id != null && message.hasOwnProperty("id"))
-                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
-                    if (message.state != null && message.hasOwnProperty("state"))
-                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.state);
+                    if (message.cronExpression != null && message.hasOwnProperty("cronExpression"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.cronExpression);
+                    if (message.rate != null && message.hasOwnProperty("rate"))
+                        $root.flyteidl.admin.FixedRate.encode(message.rate, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.kickoffTimeInputArg != null && message.hasOwnProperty("kickoffTimeInputArg"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.kickoffTimeInputArg);
+                    if (message.cronSchedule != null && message.hasOwnProperty("cronSchedule"))
+                        $root.flyteidl.admin.CronSchedule.encode(message.cronSchedule, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanUpdateRequest message from the specified reader or buffer.
+                 * Decodes a Schedule message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @memberof flyteidl.admin.Schedule
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanUpdateRequest} LaunchPlanUpdateRequest
+                 * @returns {flyteidl.admin.Schedule} Schedule
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanUpdateRequest.decode = function decode(reader, length) {
+                Schedule.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanUpdateRequest();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.Schedule();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            message.cronExpression = reader.string();
                             break;
                         case 2:
-                            message.state = reader.int32();
+                            message.rate = $root.flyteidl.admin.FixedRate.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.cronSchedule = $root.flyteidl.admin.CronSchedule.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.kickoffTimeInputArg = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -41341,52 +43661,75 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanUpdateRequest message.
+                 * Verifies a Schedule message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanUpdateRequest
+                 * @memberof flyteidl.admin.Schedule
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanUpdateRequest.verify = function verify(message) {
+                Schedule.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.id != null && message.hasOwnProperty("id")) {
-                        var error = $root.flyteidl.core.Identifier.verify(message.id);
-                        if (error)
-                            return "id." + error;
+                    var properties = {};
+                    if (message.cronExpression != null && message.hasOwnProperty("cronExpression")) {
+                        properties.ScheduleExpression = 1;
+                        if (!$util.isString(message.cronExpression))
+                            return "cronExpression: string expected";
                     }
-                    if (message.state != null && message.hasOwnProperty("state"))
-                        switch (message.state) {
-                        default:
-                            return "state: enum value expected";
-                        case 0:
-                        case 1:
-                            break;
+                    if (message.rate != null && message.hasOwnProperty("rate")) {
+                        if (properties.ScheduleExpression === 1)
+                            return "ScheduleExpression: multiple values";
+                        properties.ScheduleExpression = 1;
+                        {
+                            var error = $root.flyteidl.admin.FixedRate.verify(message.rate);
+                            if (error)
+                                return "rate." + error;
+                        }
+                    }
+                    if (message.cronSchedule != null && message.hasOwnProperty("cronSchedule")) {
+                        if (properties.ScheduleExpression === 1)
+                            return "ScheduleExpression: multiple values";
+                        properties.ScheduleExpression = 1;
+                        {
+                            var error = $root.flyteidl.admin.CronSchedule.verify(message.cronSchedule);
+                            if (error)
+                                return "cronSchedule." + error;
                         }
+                    }
+                    if (message.kickoffTimeInputArg != null && message.hasOwnProperty("kickoffTimeInputArg"))
+                        if (!$util.isString(message.kickoffTimeInputArg))
+                            return "kickoffTimeInputArg: string expected";
                     return null;
                 };
     
-                return LaunchPlanUpdateRequest;
+                return Schedule;
             })();
     
-            admin.LaunchPlanUpdateResponse = (function() {
+            admin.Trigger = (function() {
     
                 /**
-                 * Properties of a LaunchPlanUpdateResponse.
+                 * Properties of a Trigger.
                  * @memberof flyteidl.admin
-                 * @interface ILaunchPlanUpdateResponse
+                 * @interface ITrigger
+                 * @property {string|null} [name] Trigger name
+                 * @property {flyteidl.admin.IStorageEventTrigger|null} [storage] Trigger storage
+                 * @property {flyteidl.admin.IWebhookTrigger|null} [webhook] Trigger webhook
+                 * @property {flyteidl.admin.ICloudEventTrigger|null} [cloudEvent] Trigger cloudEvent
+                 * @property {Object.<string,string>|null} [inputs] Trigger inputs
+                 * @property {number|null} [maxFiringsPerHour] Trigger maxFiringsPerHour
                  */
     
                 /**
-                 * Constructs a new LaunchPlanUpdateResponse.
+                 * Constructs a new Trigger.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a LaunchPlanUpdateResponse.
-                 * @implements ILaunchPlanUpdateResponse
+                 * @classdesc Represents a Trigger.
+                 * @implements ITrigger
                  * @constructor
-                 * @param {flyteidl.admin.ILaunchPlanUpdateResponse=} [properties] Properties to set
+                 * @param {flyteidl.admin.ITrigger=} [properties] Properties to set
                  */
-                function LaunchPlanUpdateResponse(properties) {
+                function Trigger(properties) {
+                    this.inputs = {};
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41394,50 +43737,148 @@
                 }
     
                 /**
-                 * Creates a new LaunchPlanUpdateResponse instance using the specified properties.
+                 * Trigger name.
+                 * @member {string} name
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Trigger.prototype.name = "";
+    
+                /**
+                 * Trigger storage.
+                 * @member {flyteidl.admin.IStorageEventTrigger|null|undefined} storage
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Trigger.prototype.storage = null;
+    
+                /**
+                 * Trigger webhook.
+                 * @member {flyteidl.admin.IWebhookTrigger|null|undefined} webhook
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Trigger.prototype.webhook = null;
+    
+                /**
+                 * Trigger cloudEvent.
+                 * @member {flyteidl.admin.ICloudEventTrigger|null|undefined} cloudEvent
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Trigger.prototype.cloudEvent = null;
+    
+                /**
+                 * Trigger inputs.
+                 * @member {Object.<string,string>} inputs
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Trigger.prototype.inputs = $util.emptyObject;
+    
+                /**
+                 * Trigger maxFiringsPerHour.
+                 * @member {number} maxFiringsPerHour
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Trigger.prototype.maxFiringsPerHour = 0;
+    
+                // OneOf field names bound to virtual getters and setters
+                var $oneOfFields;
+    
+                /**
+                 * Trigger event.
+                 * @member {"storage"|"webhook"|"cloudEvent"|undefined} event
+                 * @memberof flyteidl.admin.Trigger
+                 * @instance
+                 */
+                Object.defineProperty(Trigger.prototype, "event", {
+                    get: $util.oneOfGetter($oneOfFields = ["storage", "webhook", "cloudEvent"]),
+                    set: $util.oneOfSetter($oneOfFields)
+                });
+    
+                /**
+                 * Creates a new Trigger instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @memberof flyteidl.admin.Trigger
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanUpdateResponse=} [properties] Properties to set
-                 * @returns {flyteidl.admin.LaunchPlanUpdateResponse} LaunchPlanUpdateResponse instance
+                 * @param {flyteidl.admin.ITrigger=} [properties] Properties to set
+                 * @returns {flyteidl.admin.Trigger} Trigger instance
                  */
-                LaunchPlanUpdateResponse.create = function create(properties) {
-                    return new LaunchPlanUpdateResponse(properties);
+                Trigger.create = function create(properties) {
+                    return new Trigger(properties);
                 };
     
                 /**
-                 * Encodes the specified LaunchPlanUpdateResponse message. Does not implicitly {@link flyteidl.admin.LaunchPlanUpdateResponse.verify|verify} messages.
+                 * Encodes the specified Trigger message. Does not implicitly {@link flyteidl.admin.Trigger.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @memberof flyteidl.admin.Trigger
                  * @static
-                 * @param {flyteidl.admin.ILaunchPlanUpdateResponse} message LaunchPlanUpdateResponse message or plain object to encode
+                 * @param {flyteidl.admin.ITrigger} message Trigger message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                LaunchPlanUpdateResponse.encode = function encode(message, writer) {
+                Trigger.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
+                    if (message.name != null && message.hasOwnProperty("name"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.name);
+                    if (message.storage != null && message.hasOwnProperty("storage"))
+                        $root.flyteidl.admin.StorageEventTrigger.encode(message.storage, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.webhook != null && message.hasOwnProperty("webhook"))
+                        $root.flyteidl.admin.WebhookTrigger.encode(message.webhook, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.cloudEvent != null && message.hasOwnProperty("cloudEvent"))
+                        $root.flyteidl.admin.CloudEventTrigger.encode(message.cloudEvent, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.inputs != null && message.hasOwnProperty("inputs"))
+                        for (var keys = Object.keys(message.inputs), i = 0; i < keys.length; ++i)
+                            writer.uint32(/* id 5, wireType 2 =*/42).fork().uint32(/* id 1, wireType 2 =*/10).string(keys[i]).uint32(/* id 2, wireType 2 =*/18).string(message.inputs[keys[i]]).ldelim();
+                    if (message.maxFiringsPerHour != null && message.hasOwnProperty("maxFiringsPerHour"))
+                        writer.uint32(/* id 6, wireType 0 =*/48).uint32(message.maxFiringsPerHour);
                     return writer;
                 };
     
                 /**
-                 * Decodes a LaunchPlanUpdateResponse message from the specified reader or buffer.
+                 * Decodes a Trigger message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @memberof flyteidl.admin.Trigger
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.LaunchPlanUpdateResponse} LaunchPlanUpdateResponse
+                 * @returns {flyteidl.admin.Trigger} Trigger
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                LaunchPlanUpdateResponse.decode = function decode(reader, length) {
+                Trigger.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.LaunchPlanUpdateResponse();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.Trigger(), key;
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
+                        case 1:
+                            message.name = reader.string();
+                            break;
+                        case 2:
+                            message.storage = $root.flyteidl.admin.StorageEventTrigger.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.webhook = $root.flyteidl.admin.WebhookTrigger.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.cloudEvent = $root.flyteidl.admin.CloudEventTrigger.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            reader.skip().pos++;
+                            if (message.inputs === $util.emptyObject)
+                                message.inputs = {};
+                            key = reader.string();
+                            reader.pos++;
+                            message.inputs[key] = reader.string();
+                            break;
+                        case 6:
+                            message.maxFiringsPerHour = reader.uint32();
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -41447,40 +43888,83 @@
                 };
     
                 /**
-                 * Verifies a LaunchPlanUpdateResponse message.
+                 * Verifies a Trigger message.
                  * @function verify
-                 * @memberof flyteidl.admin.LaunchPlanUpdateResponse
+                 * @memberof flyteidl.admin.Trigger
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                LaunchPlanUpdateResponse.verify = function verify(message) {
+                Trigger.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
+                    var properties = {};
+                    if (message.name != null && message.hasOwnProperty("name"))
+                        if (!$util.isString(message.name))
+                            return "name: string expected";
+                    if (message.storage != null && message.hasOwnProperty("storage")) {
+                        properties.event = 1;
+                        {
+                            var error = $root.flyteidl.admin.StorageEventTrigger.verify(message.storage);
+                            if (error)
+                                return "storage." + error;
+                        }
+                    }
+                    if (message.webhook != null && message.hasOwnProperty("webhook")) {
+                        if (properties.event === 1)
+                            return "event: multiple values";
+                        properties.event = 1;
+                        {
+                            var error = $root.flyteidl.admin.WebhookTrigger.verify(message.webhook);
+                            if (error)
+                                return "webhook." + error;
+                        }
+                    }
+                    if (message.cloudEvent != null && message.hasOwnProperty("cloudEvent")) {
+                        if (properties.event === 1)
+                            return "event: multiple values";
+                        properties.event = 1;
+                        {
+                            var error = $root.flyteidl.admin.CloudEventTrigger.verify(message.cloudEvent);
+                            if (error)
+                                return "cloudEvent." + error;
+                        }
+                    }
+                    if (message.inputs != null && message.hasOwnProperty("inputs")) {
+                        if (!$util.isObject(message.inputs))
+                            return "inputs: object expected";
+                        var key = Object.keys(message.inputs);
+                        for (var i = 0; i < key.length; ++i)
+                            if (!$util.isString(message.inputs[key[i]]))
+                                return "inputs: string{k:string} expected";
+                    }
+                    if (message.maxFiringsPerHour != null && message.hasOwnProperty("maxFiringsPerHour"))
+                        if (!$util.isInteger(message.maxFiringsPerHour))
+                            return "maxFiringsPerHour: integer expected";
                     return null;
                 };
     
-                return LaunchPlanUpdateResponse;
+                return Trigger;
             })();
     
-            admin.ActiveLaunchPlanRequest = (function() {
+            admin.StorageEventTrigger = (function() {
     
                 /**
-                 * Properties of an ActiveLaunchPlanRequest.
+                 * Properties of a StorageEventTrigger.
                  * @memberof flyteidl.admin
-                 * @interface IActiveLaunchPlanRequest
-                 * @property {flyteidl.admin.INamedEntityIdentifier|null} [id] ActiveLaunchPlanRequest id
+                 * @interface IStorageEventTrigger
+                 * @property {string|null} [uriPattern] StorageEventTrigger uriPattern
                  */
     
                 /**
-                 * Constructs a new ActiveLaunchPlanRequest.
+                 * Constructs a new StorageEventTrigger.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents an ActiveLaunchPlanRequest.
-                 * @implements IActiveLaunchPlanRequest
+                 * @classdesc Represents a StorageEventTrigger.
+                 * @implements IStorageEventTrigger
                  * @constructor
-                 * @param {flyteidl.admin.IActiveLaunchPlanRequest=} [properties] Properties to set
+                 * @param {flyteidl.admin.IStorageEventTrigger=} [properties] Properties to set
                  */
-                function ActiveLaunchPlanRequest(properties) {
+                function StorageEventTrigger(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41488,62 +43972,62 @@
                 }
     
                 /**
-                 * ActiveLaunchPlanRequest id.
-                 * @member {flyteidl.admin.INamedEntityIdentifier|null|undefined} id
-                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * StorageEventTrigger uriPattern.
+                 * @member {string} uriPattern
+                 * @memberof flyteidl.admin.StorageEventTrigger
                  * @instance
                  */
-                ActiveLaunchPlanRequest.prototype.id = null;
+                StorageEventTrigger.prototype.uriPattern = "";
     
                 /**
-                 * Creates a new ActiveLaunchPlanRequest instance using the specified properties.
+                 * Creates a new StorageEventTrigger instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @memberof flyteidl.admin.StorageEventTrigger
                  * @static
-                 * @param {flyteidl.admin.IActiveLaunchPlanRequest=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ActiveLaunchPlanRequest} ActiveLaunchPlanRequest instance
+                 * @param {flyteidl.admin.IStorageEventTrigger=} [properties] Properties to set
+                 * @returns {flyteidl.admin.StorageEventTrigger} StorageEventTrigger instance
                  */
-                ActiveLaunchPlanRequest.create = function create(properties) {
-                    return new ActiveLaunchPlanRequest(properties);
+                StorageEventTrigger.create = function create(properties) {
+                    return new StorageEventTrigger(properties);
                 };
     
                 /**
-                 * Encodes the specified ActiveLaunchPlanRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanRequest.verify|verify} messages.
+                 * Encodes the specified StorageEventTrigger message. Does not implicitly {@link flyteidl.admin.StorageEventTrigger.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @memberof flyteidl.admin.StorageEventTrigger
                  * @static
-                 * @param {flyteidl.admin.IActiveLaunchPlanRequest} message ActiveLaunchPlanRequest message or plain object to encode
+                 * @param {flyteidl.admin.IStorageEventTrigger} message StorageEventTrigger message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ActiveLaunchPlanRequest.encode = function encode(message, writer) {
+                StorageEventTrigger.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.id != null && message.hasOwnProperty("id"))
-                        $root.flyteidl.admin.NamedEntityIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.uriPattern != null && message.hasOwnProperty("uriPattern"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.uriPattern);
                     return writer;
                 };
     
                 /**
-                 * Decodes an ActiveLaunchPlanRequest message from the specified reader or buffer.
+                 * Decodes a StorageEventTrigger message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @memberof flyteidl.admin.StorageEventTrigger
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ActiveLaunchPlanRequest} ActiveLaunchPlanRequest
+                 * @returns {flyteidl.admin.StorageEventTrigger} StorageEventTrigger
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ActiveLaunchPlanRequest.decode = function decode(reader, length) {
+                StorageEventTrigger.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ActiveLaunchPlanRequest();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.StorageEventTrigger();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.id = $root.flyteidl.admin.NamedEntityIdentifier.decode(reader, reader.uint32());
+                            message.uriPattern = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -41554,50 +44038,43 @@
                 };
     
                 /**
-                 * Verifies an ActiveLaunchPlanRequest message.
+                 * Verifies a StorageEventTrigger message.
                  * @function verify
-                 * @memberof flyteidl.admin.ActiveLaunchPlanRequest
+                 * @memberof flyteidl.admin.StorageEventTrigger
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ActiveLaunchPlanRequest.verify = function verify(message) {
+                StorageEventTrigger.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.id != null && message.hasOwnProperty("id")) {
-                        var error = $root.flyteidl.admin.NamedEntityIdentifier.verify(message.id);
-                        if (error)
-                            return "id." + error;
-                    }
+                    if (message.uriPattern != null && message.hasOwnProperty("uriPattern"))
+                        if (!$util.isString(message.uriPattern))
+                            return "uriPattern: string expected";
                     return null;
                 };
     
-                return ActiveLaunchPlanRequest;
+                return StorageEventTrigger;
             })();
     
-            admin.ActiveLaunchPlanListRequest = (function() {
+            admin.WebhookTrigger = (function() {
     
                 /**
-                 * Properties of an ActiveLaunchPlanListRequest.
+                 * Properties of a WebhookTrigger.
                  * @memberof flyteidl.admin
-                 * @interface IActiveLaunchPlanListRequest
-                 * @property {string|null} [project] ActiveLaunchPlanListRequest project
-                 * @property {string|null} [domain] ActiveLaunchPlanListRequest domain
-                 * @property {number|null} [limit] ActiveLaunchPlanListRequest limit
-                 * @property {string|null} [token] ActiveLaunchPlanListRequest token
-                 * @property {flyteidl.admin.ISort|null} [sortBy] ActiveLaunchPlanListRequest sortBy
-                 * @property {string|null} [org] ActiveLaunchPlanListRequest org
+                 * @interface IWebhookTrigger
+                 * @property {string|null} [tokenSha256] WebhookTrigger tokenSha256
                  */
     
                 /**
-                 * Constructs a new ActiveLaunchPlanListRequest.
+                 * Constructs a new WebhookTrigger.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents an ActiveLaunchPlanListRequest.
-                 * @implements IActiveLaunchPlanListRequest
+                 * @classdesc Represents a WebhookTrigger.
+                 * @implements IWebhookTrigger
                  * @constructor
-                 * @param {flyteidl.admin.IActiveLaunchPlanListRequest=} [properties] Properties to set
+                 * @param {flyteidl.admin.IWebhookTrigger=} [properties] Properties to set
                  */
-                function ActiveLaunchPlanListRequest(properties) {
+                function WebhookTrigger(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41605,127 +44082,62 @@
                 }
     
                 /**
-                 * ActiveLaunchPlanListRequest project.
-                 * @member {string} project
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
-                 * @instance
-                 */
-                ActiveLaunchPlanListRequest.prototype.project = "";
-    
-                /**
-                 * ActiveLaunchPlanListRequest domain.
-                 * @member {string} domain
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
-                 * @instance
-                 */
-                ActiveLaunchPlanListRequest.prototype.domain = "";
-    
-                /**
-                 * ActiveLaunchPlanListRequest limit.
-                 * @member {number} limit
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
-                 * @instance
-                 */
-                ActiveLaunchPlanListRequest.prototype.limit = 0;
-    
-                /**
-                 * ActiveLaunchPlanListRequest token.
-                 * @member {string} token
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
-                 * @instance
-                 */
-                ActiveLaunchPlanListRequest.prototype.token = "";
-    
-                /**
-                 * ActiveLaunchPlanListRequest sortBy.
-                 * @member {flyteidl.admin.ISort|null|undefined} sortBy
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
-                 * @instance
-                 */
-                ActiveLaunchPlanListRequest.prototype.sortBy = null;
-    
-                /**
-                 * ActiveLaunchPlanListRequest org.
-                 * @member {string} org
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * WebhookTrigger tokenSha256.
+                 * @member {string} tokenSha256
+                 * @memberof flyteidl.admin.WebhookTrigger
                  * @instance
                  */
-                ActiveLaunchPlanListRequest.prototype.org = "";
+                WebhookTrigger.prototype.tokenSha256 = "";
     
                 /**
-                 * Creates a new ActiveLaunchPlanListRequest instance using the specified properties.
+                 * Creates a new WebhookTrigger instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @memberof flyteidl.admin.WebhookTrigger
                  * @static
-                 * @param {flyteidl.admin.IActiveLaunchPlanListRequest=} [properties] Properties to set
-                 * @returns {flyteidl.admin.ActiveLaunchPlanListRequest} ActiveLaunchPlanListRequest instance
+                 * @param {flyteidl.admin.IWebhookTrigger=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WebhookTrigger} WebhookTrigger instance
                  */
-                ActiveLaunchPlanListRequest.create = function create(properties) {
-                    return new ActiveLaunchPlanListRequest(properties);
+                WebhookTrigger.create = function create(properties) {
+                    return new WebhookTrigger(properties);
                 };
     
                 /**
-                 * Encodes the specified ActiveLaunchPlanListRequest message. Does not implicitly {@link flyteidl.admin.ActiveLaunchPlanListRequest.verify|verify} messages.
+                 * Encodes the specified WebhookTrigger message. Does not implicitly {@link flyteidl.admin.WebhookTrigger.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @memberof flyteidl.admin.WebhookTrigger
                  * @static
-                 * @param {flyteidl.admin.IActiveLaunchPlanListRequest} message ActiveLaunchPlanListRequest message or plain object to encode
+                 * @param {flyteidl.admin.IWebhookTrigger} message WebhookTrigger message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                ActiveLaunchPlanListRequest.encode = function encode(message, writer) {
+                WebhookTrigger.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.project != null && message.hasOwnProperty("project"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.project);
-                    if (message.domain != null && message.hasOwnProperty("domain"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.domain);
-                    if (message.limit != null && message.hasOwnProperty("limit"))
-                        writer.uint32(/* id 3, wireType 0 =*/24).uint32(message.limit);
-                    if (message.token != null && message.hasOwnProperty("token"))
-                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.token);
-                    if (message.sortBy != null && message.hasOwnProperty("sortBy"))
-                        $root.flyteidl.admin.Sort.encode(message.sortBy, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
-                    if (message.org != null && message.hasOwnProperty("org"))
-                        writer.uint32(/* id 6, wireType 2 =*/50).string(message.org);
+                    if (message.tokenSha256 != null && message.hasOwnProperty("tokenSha256"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.tokenSha256);
                     return writer;
                 };
     
                 /**
-                 * Decodes an ActiveLaunchPlanListRequest message from the specified reader or buffer.
+                 * Decodes a WebhookTrigger message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @memberof flyteidl.admin.WebhookTrigger
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.ActiveLaunchPlanListRequest} ActiveLaunchPlanListRequest
+                 * @returns {flyteidl.admin.WebhookTrigger} WebhookTrigger
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                ActiveLaunchPlanListRequest.decode = function decode(reader, length) {
+                WebhookTrigger.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ActiveLaunchPlanListRequest();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WebhookTrigger();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.project = reader.string();
-                            break;
-                        case 2:
-                            message.domain = reader.string();
-                            break;
-                        case 3:
-                            message.limit = reader.uint32();
-                            break;
-                        case 4:
-                            message.token = reader.string();
-                            break;
-                        case 5:
-                            message.sortBy = $root.flyteidl.admin.Sort.decode(reader, reader.uint32());
-                            break;
-                        case 6:
-                            message.org = reader.string();
+                            message.tokenSha256 = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -41736,77 +44148,44 @@
                 };
     
                 /**
-                 * Verifies an ActiveLaunchPlanListRequest message.
+                 * Verifies a WebhookTrigger message.
                  * @function verify
-                 * @memberof flyteidl.admin.ActiveLaunchPlanListRequest
+                 * @memberof flyteidl.admin.WebhookTrigger
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                ActiveLaunchPlanListRequest.verify = function verify(message) {
+                WebhookTrigger.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.project != null && message.hasOwnProperty("project"))
-                        if (!$util.isString(message.project))
-                            return "project: string expected";
-                    if (message.domain != null && message.hasOwnProperty("domain"))
-                        if (!$util.isString(message.domain))
-                            return "domain: string expected";
-                    if (message.limit != null && message.hasOwnProperty("limit"))
-                        if (!$util.isInteger(message.limit))
-                            return "limit: integer expected";
-                    if (message.token != null && message.hasOwnProperty("token"))
-                        if (!$util.isString(message.token))
-                            return "token: string expected";
-                    if (message.sortBy != null && message.hasOwnProperty("sortBy")) {
-                        var error = $root.flyteidl.admin.Sort.verify(message.sortBy);
-                        if (error)
-                            return "sortBy." + error;
-                    }
-                    if (message.org != null && message.hasOwnProperty("org"))
-                        if (!$util.isString(message.org))
-                            return "org: string expected";
+                    if (message.tokenSha256 != null && message.hasOwnProperty("tokenSha256"))
+                        if (!$util.isString(message.tokenSha256))
+                            return "tokenSha256: string expected";
                     return null;
                 };
     
-                return ActiveLaunchPlanListRequest;
-            })();
-    
-            /**
-             * FixedRateUnit enum.
-             * @name flyteidl.admin.FixedRateUnit
-             * @enum {string}
-             * @property {number} MINUTE=0 MINUTE value
-             * @property {number} HOUR=1 HOUR value
-             * @property {number} DAY=2 DAY value
-             */
-            admin.FixedRateUnit = (function() {
-                var valuesById = {}, values = Object.create(valuesById);
-                values[valuesById[0] = "MINUTE"] = 0;
-                values[valuesById[1] = "HOUR"] = 1;
-                values[valuesById[2] = "DAY"] = 2;
-                return values;
+                return WebhookTrigger;
             })();
     
-            admin.FixedRate = (function() {
+            admin.CloudEventTrigger = (function() {
     
                 /**
-                 * Properties of a FixedRate.
+                 * Properties of a CloudEventTrigger.
                  * @memberof flyteidl.admin
-                 * @interface IFixedRate
-                 * @property {number|null} [value] FixedRate value
-                 * @property {flyteidl.admin.FixedRateUnit|null} [unit] FixedRate unit
+                 * @interface ICloudEventTrigger
+                 * @property {string|null} [type] CloudEventTrigger type
+                 * @property {string|null} [source] CloudEventTrigger source
                  */
     
                 /**
-                 * Constructs a new FixedRate.
+                 * Constructs a new CloudEventTrigger.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a FixedRate.
-                 * @implements IFixedRate
+                 * @classdesc Represents a CloudEventTrigger.
+                 * @implements ICloudEventTrigger
                  * @constructor
-                 * @param {flyteidl.admin.IFixedRate=} [properties] Properties to set
+                 * @param {flyteidl.admin.ICloudEventTrigger=} [properties] Properties to set
                  */
-                function FixedRate(properties) {
+                function CloudEventTrigger(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41814,75 +44193,75 @@
                 }
     
                 /**
-                 * FixedRate value.
-                 * @member {number} value
-                 * @memberof flyteidl.admin.FixedRate
+                 * CloudEventTrigger type.
+                 * @member {string} type
+                 * @memberof flyteidl.admin.CloudEventTrigger
                  * @instance
                  */
-                FixedRate.prototype.value = 0;
+                CloudEventTrigger.prototype.type = "";
     
                 /**
-                 * FixedRate unit.
-                 * @member {flyteidl.admin.FixedRateUnit} unit
-                 * @memberof flyteidl.admin.FixedRate
+                 * CloudEventTrigger source.
+                 * @member {string} source
+                 * @memberof flyteidl.admin.CloudEventTrigger
                  * @instance
                  */
-                FixedRate.prototype.unit = 0;
+                CloudEventTrigger.prototype.source = "";
     
                 /**
-                 * Creates a new FixedRate instance using the specified properties.
+                 * Creates a new CloudEventTrigger instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.FixedRate
+                 * @memberof flyteidl.admin.CloudEventTrigger
                  * @static
-                 * @param {flyteidl.admin.IFixedRate=} [properties] Properties to set
-                 * @returns {flyteidl.admin.FixedRate} FixedRate instance
+                 * @param {flyteidl.admin.ICloudEventTrigger=} [properties] Properties to set
+                 * @returns {flyteidl.admin.CloudEventTrigger} CloudEventTrigger instance
                  */
-                FixedRate.create = function create(properties) {
-                    return new FixedRate(properties);
+                CloudEventTrigger.create = function create(properties) {
+                    return new CloudEventTrigger(properties);
                 };
     
                 /**
-                 * Encodes the specified FixedRate message. Does not implicitly {@link flyteidl.admin.FixedRate.verify|verify} messages.
+                 * Encodes the specified CloudEventTrigger message. Does not implicitly {@link flyteidl.admin.CloudEventTrigger.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.FixedRate
+                 * @memberof flyteidl.admin.CloudEventTrigger
                  * @static
-                 * @param {flyteidl.admin.IFixedRate} message FixedRate message or plain object to encode
+                 * @param {flyteidl.admin.ICloudEventTrigger} message CloudEventTrigger message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                FixedRate.encode = function encode(message, writer) {
+                CloudEventTrigger.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.value != null && message.hasOwnProperty("value"))
-                        writer.uint32(/* id 1, wireType 0 =*/8).uint32(message.value);
-                    if (message.unit != null && message.hasOwnProperty("unit"))
-                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.unit);
+                    if (message.type != null && message.hasOwnProperty("type"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.type);
+                    if (message.source != null && message.hasOwnProperty("source"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.source);
                     return writer;
                 };
     
                 /**
-                 * Decodes a FixedRate message from the specified reader or buffer.
+                 * Decodes a CloudEventTrigger message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.FixedRate
+                 * @memberof flyteidl.admin.CloudEventTrigger
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.FixedRate} FixedRate
+                 * @returns {flyteidl.admin.CloudEventTrigger} CloudEventTrigger
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                FixedRate.decode = function decode(reader, length) {
+                CloudEventTrigger.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.FixedRate();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.CloudEventTrigger();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.value = reader.uint32();
+                            message.type = reader.string();
                             break;
                         case 2:
-                            message.unit = reader.int32();
+                            message.source = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -41893,53 +44272,72 @@
                 };
     
                 /**
-                 * Verifies a FixedRate message.
+                 * Verifies a CloudEventTrigger message.
                  * @function verify
-                 * @memberof flyteidl.admin.FixedRate
+                 * @memberof flyteidl.admin.CloudEventTrigger
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                FixedRate.verify = function verify(message) {
+                CloudEventTrigger.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.value != null && message.hasOwnProperty("value"))
-                        if (!$util.isInteger(message.value))
-                            return "value: integer expected";
-                    if (message.unit != null && message.hasOwnProperty("unit"))
-                        switch (message.unit) {
-                        default:
-                            return "unit: enum value expected";
-                        case 0:
-                        case 1:
-                        case 2:
-                            break;
-                        }
+                    if (message.type != null && message.hasOwnProperty("type"))
+                        if (!$util.isString(message.type))
+                            return "type: string expected";
+                    if (message.source != null && message.hasOwnProperty("source"))
+                        if (!$util.isString(message.source))
+                            return "source: string expected";
                     return null;
                 };
     
-                return FixedRate;
+                return CloudEventTrigger;
             })();
     
-            admin.CronSchedule = (function() {
+            /**
+             * TriggerFiringOutcome enum.
+             * @name flyteidl.admin.TriggerFiringOutcome
+             * @enum {string}
+             * @property {number} TRIGGER_FIRING_OUTCOME_UNSPECIFIED=0 TRIGGER_FIRING_OUTCOME_UNSPECIFIED value
+             * @property {number} FIRED=1 FIRED value
+             * @property {number} DUPLICATE=2 DUPLICATE value
+             * @property {number} RATE_LIMITED=3 RATE_LIMITED value
+             * @property {number} FAILED=4 FAILED value
+             */
+            admin.TriggerFiringOutcome = (function() {
+                var valuesById = {}, values = Object.create(valuesById);
+                values[valuesById[0] = "TRIGGER_FIRING_OUTCOME_UNSPECIFIED"] = 0;
+                values[valuesById[1] = "FIRED"] = 1;
+                values[valuesById[2] = "DUPLICATE"] = 2;
+                values[valuesById[3] = "RATE_LIMITED"] = 3;
+                values[valuesById[4] = "FAILED"] = 4;
+                return values;
+            })();
+    
+            admin.TriggerFiring = (function() {
     
                 /**
-                 * Properties of a CronSchedule.
+                 * Properties of a TriggerFiring.
                  * @memberof flyteidl.admin
-                 * @interface ICronSchedule
-                 * @property {string|null} [schedule] CronSchedule schedule
-                 * @property {string|null} [offset] CronSchedule offset
+                 * @interface ITriggerFiring
+                 * @property {flyteidl.core.IIdentifier|null} [launchPlan] TriggerFiring launchPlan
+                 * @property {string|null} [triggerName] TriggerFiring triggerName
+                 * @property {string|null} [eventId] TriggerFiring eventId
+                 * @property {flyteidl.admin.TriggerFiringOutcome|null} [outcome] TriggerFiring outcome
+                 * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [executionId] TriggerFiring executionId
+                 * @property {string|null} [message] TriggerFiring message
+                 * @property {google.protobuf.ITimestamp|null} [receivedAt] TriggerFiring receivedAt
                  */
     
                 /**
-                 * Constructs a new CronSchedule.
+                 * Constructs a new TriggerFiring.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a CronSchedule.
-                 * @implements ICronSchedule
+                 * @classdesc Represents a TriggerFiring.
+                 * @implements ITriggerFiring
                  * @constructor
-                 * @param {flyteidl.admin.ICronSchedule=} [properties] Properties to set
+                 * @param {flyteidl.admin.ITriggerFiring=} [properties] Properties to set
                  */
-                function CronSchedule(properties) {
+                function TriggerFiring(properties) {
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -41947,75 +44345,140 @@
                 }
     
                 /**
-                 * CronSchedule schedule.
-                 * @member {string} schedule
-                 * @memberof flyteidl.admin.CronSchedule
+                 * TriggerFiring launchPlan.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} launchPlan
+                 * @memberof flyteidl.admin.TriggerFiring
                  * @instance
                  */
-                CronSchedule.prototype.schedule = "";
+                TriggerFiring.prototype.launchPlan = null;
     
                 /**
-                 * CronSchedule offset.
-                 * @member {string} offset
-                 * @memberof flyteidl.admin.CronSchedule
+                 * TriggerFiring triggerName.
+                 * @member {string} triggerName
+                 * @memberof flyteidl.admin.TriggerFiring
                  * @instance
                  */
-                CronSchedule.prototype.offset = "";
+                TriggerFiring.prototype.triggerName = "";
     
                 /**
-                 * Creates a new CronSchedule instance using the specified properties.
+                 * TriggerFiring eventId.
+                 * @member {string} eventId
+                 * @memberof flyteidl.admin.TriggerFiring
+                 * @instance
+                 */
+                TriggerFiring.prototype.eventId = "";
+    
+                /**
+                 * TriggerFiring outcome.
+                 * @member {flyteidl.admin.TriggerFiringOutcome} outcome
+                 * @memberof flyteidl.admin.TriggerFiring
+                 * @instance
+                 */
+                TriggerFiring.prototype.outcome = 0;
+    
+                /**
+                 * TriggerFiring executionId.
+                 * @member {flyteidl.core.IWorkflowExecutionIdentifier|null|undefined} executionId
+                 * @memberof flyteidl.admin.TriggerFiring
+                 * @instance
+                 */
+                TriggerFiring.prototype.executionId = null;
+    
+                /**
+                 * TriggerFiring message.
+                 * @member {string} message
+                 * @memberof flyteidl.admin.TriggerFiring
+                 * @instance
+                 */
+                TriggerFiring.prototype.message = "";
+    
+                /**
+                 * TriggerFiring receivedAt.
+                 * @member {google.protobuf.ITimestamp|null|undefined} receivedAt
+                 * @memberof flyteidl.admin.TriggerFiring
+                 * @instance
+                 */
+                TriggerFiring.prototype.receivedAt = null;
+    
+                /**
+                 * Creates a new TriggerFiring instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.CronSchedule
+                 * @memberof flyteidl.admin.TriggerFiring
                  * @static
-                 * @param {flyteidl.admin.ICronSchedule=} [properties] Properties to set
-                 * @returns {flyteidl.admin.CronSchedule} CronSchedule instance
+                 * @param {flyteidl.admin.ITriggerFiring=} [properties] Properties to set
+                 * @returns {flyteidl.admin.TriggerFiring} TriggerFiring instance
                  */
-                CronSchedule.create = function create(properties) {
-                    return new CronSchedule(properties);
+                TriggerFiring.create = function create(properties) {
+                    return new TriggerFiring(properties);
                 };
     
                 /**
-                 * Encodes the specified CronSchedule message. Does not implicitly {@link flyteidl.admin.CronSchedule.verify|verify} messages.
+                 * Encodes the specified TriggerFiring message. Does not implicitly {@link flyteidl.admin.TriggerFiring.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.CronSchedule
+                 * @memberof flyteidl.admin.TriggerFiring
                  * @static
-                 * @param {flyteidl.admin.ICronSchedule} message CronSchedule message or plain object to encode
+                 * @param {flyteidl.admin.ITriggerFiring} message TriggerFiring message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                CronSchedule.encode = function encode(message, writer) {
+                TriggerFiring.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.schedule != null && message.hasOwnProperty("schedule"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.schedule);
-                    if (message.offset != null && message.hasOwnProperty("offset"))
-                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.offset);
+                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan"))
+                        $root.flyteidl.core.Identifier.encode(message.launchPlan, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.triggerName != null && message.hasOwnProperty("triggerName"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.triggerName);
+                    if (message.eventId != null && message.hasOwnProperty("eventId"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.eventId);
+                    if (message.outcome != null && message.hasOwnProperty("outcome"))
+                        writer.uint32(/* id 4, wireType 0 =*/32).int32(message.outcome);
+                    if (message.executionId != null && message.hasOwnProperty("executionId"))
+                        $root.flyteidl.core.WorkflowExecutionIdentifier.encode(message.executionId, writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    if (message.message != null && message.hasOwnProperty("message"))
+                        writer.uint32(/* id 6, wireType 2 =*/50).string(message.message);
+                    if (message.receivedAt != null && message.hasOwnProperty("receivedAt"))
+                        $root.google.protobuf.Timestamp.encode(message.receivedAt, writer.uint32(/* id 7, wireType 2 =*/58).fork()).ldelim();
                     return writer;
                 };
     
                 /**
-                 * Decodes a CronSchedule message from the specified reader or buffer.
+                 * Decodes a TriggerFiring message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.CronSchedule
+                 * @memberof flyteidl.admin.TriggerFiring
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.CronSchedule} CronSchedule
+                 * @returns {flyteidl.admin.TriggerFiring} TriggerFiring
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                CronSchedule.decode = function decode(reader, length) {
+                TriggerFiring.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.CronSchedule();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TriggerFiring();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.schedule = reader.string();
+                            message.launchPlan = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
                             break;
                         case 2:
-                            message.offset = reader.string();
+                            message.triggerName = reader.string();
+                            break;
+                        case 3:
+                            message.eventId = reader.string();
+                            break;
+                        case 4:
+                            message.outcome = reader.int32();
+                            break;
+                        case 5:
+                            message.executionId = $root.flyteidl.core.WorkflowExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        case 6:
+                            message.message = reader.string();
+                            break;
+                        case 7:
+                            message.receivedAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -42026,49 +44489,77 @@
                 };
     
                 /**
-                 * Verifies a CronSchedule message.
+                 * Verifies a TriggerFiring message.
                  * @function verify
-                 * @memberof flyteidl.admin.CronSchedule
+                 * @memberof flyteidl.admin.TriggerFiring
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                CronSchedule.verify = function verify(message) {
+                TriggerFiring.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    if (message.schedule != null && message.hasOwnProperty("schedule"))
-                        if (!$util.isString(message.schedule))
-                            return "schedule: string expected";
-                    if (message.offset != null && message.hasOwnProperty("offset"))
-                        if (!$util.isString(message.offset))
-                            return "offset: string expected";
+                    if (message.launchPlan != null && message.hasOwnProperty("launchPlan")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.launchPlan);
+                        if (error)
+                            return "launchPlan." + error;
+                    }
+                    if (message.triggerName != null && message.hasOwnProperty("triggerName"))
+                        if (!$util.isString(message.triggerName))
+                            return "triggerName: string expected";
+                    if (message.eventId != null && message.hasOwnProperty("eventId"))
+                        if (!$util.isString(message.eventId))
+                            return "eventId: string expected";
+                    if (message.outcome != null && message.hasOwnProperty("outcome"))
+                        switch (message.outcome) {
+                        default:
+                            return "outcome: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                            break;
+                        }
+                    if (message.executionId != null && message.hasOwnProperty("executionId")) {
+                        var error = $root.flyteidl.core.WorkflowExecutionIdentifier.verify(message.executionId);
+                        if (error)
+                            return "executionId." + error;
+                    }
+                    if (message.message != null && message.hasOwnProperty("message"))
+                        if (!$util.isString(message.message))
+                            return "message: string expected";
+                    if (message.receivedAt != null && message.hasOwnProperty("receivedAt")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.receivedAt);
+                        if (error)
+                            return "receivedAt." + error;
+                    }
                     return null;
                 };
     
-                return CronSchedule;
+                return TriggerFiring;
             })();
     
-            admin.Schedule = (function() {
+            admin.TriggerFiringList = (function() {
     
                 /**
-                 * Properties of a Schedule.
+                 * Properties of a TriggerFiringList.
                  * @memberof flyteidl.admin
-                 * @interface ISchedule
-                 * @property {string|null} [cronExpression] Schedule cronExpression
-                 * @property {flyteidl.admin.IFixedRate|null} [rate] Schedule rate
-                 * @property {flyteidl.admin.ICronSchedule|null} [cronSchedule] Schedule cronSchedule
-                 * @property {string|null} [kickoffTimeInputArg] Schedule kickoffTimeInputArg
+                 * @interface ITriggerFiringList
+                 * @property {Array.<flyteidl.admin.ITriggerFiring>|null} [firings] TriggerFiringList firings
+                 * @property {string|null} [token] TriggerFiringList token
                  */
     
                 /**
-                 * Constructs a new Schedule.
+                 * Constructs a new TriggerFiringList.
                  * @memberof flyteidl.admin
-                 * @classdesc Represents a Schedule.
-                 * @implements ISchedule
+                 * @classdesc Represents a TriggerFiringList.
+                 * @implements ITriggerFiringList
                  * @constructor
-                 * @param {flyteidl.admin.ISchedule=} [properties] Properties to set
+                 * @param {flyteidl.admin.ITriggerFiringList=} [properties] Properties to set
                  */
-                function Schedule(properties) {
+                function TriggerFiringList(properties) {
+                    this.firings = [];
                     if (properties)
                         for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                             if (properties[keys[i]] != null)
@@ -42076,115 +44567,78 @@
                 }
     
                 /**
-                 * Schedule cronExpression.
-                 * @member {string} cronExpression
-                 * @memberof flyteidl.admin.Schedule
-                 * @instance
-                 */
-                Schedule.prototype.cronExpression = "";
-    
-                /**
-                 * Schedule rate.
-                 * @member {flyteidl.admin.IFixedRate|null|undefined} rate
-                 * @memberof flyteidl.admin.Schedule
-                 * @instance
-                 */
-                Schedule.prototype.rate = null;
-    
-                /**
-                 * Schedule cronSchedule.
-                 * @member {flyteidl.admin.ICronSchedule|null|undefined} cronSchedule
-                 * @memberof flyteidl.admin.Schedule
-                 * @instance
-                 */
-                Schedule.prototype.cronSchedule = null;
-    
-                /**
-                 * Schedule kickoffTimeInputArg.
-                 * @member {string} kickoffTimeInputArg
-                 * @memberof flyteidl.admin.Schedule
+                 * TriggerFiringList firings.
+                 * @member {Array.<flyteidl.admin.ITriggerFiring>} firings
+                 * @memberof flyteidl.admin.TriggerFiringList
                  * @instance
                  */
-                Schedule.prototype.kickoffTimeInputArg = "";
-    
-                // OneOf field names bound to virtual getters and setters
-                var $oneOfFields;
+                TriggerFiringList.prototype.firings = $util.emptyArray;
     
                 /**
-                 * Schedule ScheduleExpression.
-                 * @member {"cronExpression"|"rate"|"cronSchedule"|undefined} ScheduleExpression
-                 * @memberof flyteidl.admin.Schedule
+                 * TriggerFiringList token.
+                 * @member {string} token
+                 * @memberof flyteidl.admin.TriggerFiringList
                  * @instance
                  */
-                Object.defineProperty(Schedule.prototype, "ScheduleExpression", {
-                    get: $util.oneOfGetter($oneOfFields = ["cronExpression", "rate", "cronSchedule"]),
-                    set: $util.oneOfSetter($oneOfFields)
-                });
+                TriggerFiringList.prototype.token = "";
     
                 /**
-                 * Creates a new Schedule instance using the specified properties.
+                 * Creates a new TriggerFiringList instance using the specified properties.
                  * @function create
-                 * @memberof flyteidl.admin.Schedule
+                 * @memberof flyteidl.admin.TriggerFiringList
                  * @static
-                 * @param {flyteidl.admin.ISchedule=} [properties] Properties to set
-                 * @returns {flyteidl.admin.Schedule} Schedule instance
+                 * @param {flyteidl.admin.ITriggerFiringList=} [properties] Properties to set
+                 * @returns {flyteidl.admin.TriggerFiringList} TriggerFiringList instance
                  */
-                Schedule.create = function create(properties) {
-                    return new Schedule(properties);
+                TriggerFiringList.create = function create(properties) {
+                    return new TriggerFiringList(properties);
                 };
     
                 /**
-                 * Encodes the specified Schedule message. Does not implicitly {@link flyteidl.admin.Schedule.verify|verify} messages.
+                 * Encodes the specified TriggerFiringList message. Does not implicitly {@link flyteidl.admin.TriggerFiringList.verify|verify} messages.
                  * @function encode
-                 * @memberof flyteidl.admin.Schedule
+                 * @memberof flyteidl.admin.TriggerFiringList
                  * @static
-                 * @param {flyteidl.admin.ISchedule} message Schedule message or plain object to encode
+                 * @param {flyteidl.admin.ITriggerFiringList} message TriggerFiringList message or plain object to encode
                  * @param {$protobuf.Writer} [writer] Writer to encode to
                  * @returns {$protobuf.Writer} Writer
                  */
-                Schedule.encode = function encode(message, writer) {
+                TriggerFiringList.encode = function encode(message, writer) {
                     if (!writer)
                         writer = $Writer.create();
-                    if (message.cronExpression != null && message.hasOwnProperty("cronExpression"))
-                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.cronExpression);
-                    if (message.rate != null && message.hasOwnProperty("rate"))
-                        $root.flyteidl.admin.FixedRate.encode(message.rate, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
-                    if (message.kickoffTimeInputArg != null && message.hasOwnProperty("kickoffTimeInputArg"))
-                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.kickoffTimeInputArg);
-                    if (message.cronSchedule != null && message.hasOwnProperty("cronSchedule"))
-                        $root.flyteidl.admin.CronSchedule.encode(message.cronSchedule, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.firings != null && message.firings.length)
+                        for (var i = 0; i < message.firings.length; ++i)
+                            $root.flyteidl.admin.TriggerFiring.encode(message.firings[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.token != null && message.hasOwnProperty("token"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.token);
                     return writer;
                 };
     
                 /**
-                 * Decodes a Schedule message from the specified reader or buffer.
+                 * Decodes a TriggerFiringList message from the specified reader or buffer.
                  * @function decode
-                 * @memberof flyteidl.admin.Schedule
+                 * @memberof flyteidl.admin.TriggerFiringList
                  * @static
                  * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
                  * @param {number} [length] Message length if known beforehand
-                 * @returns {flyteidl.admin.Schedule} Schedule
+                 * @returns {flyteidl.admin.TriggerFiringList} TriggerFiringList
                  * @throws {Error} If the payload is not a reader or valid buffer
                  * @throws {$protobuf.util.ProtocolError} If required fields are missing
                  */
-                Schedule.decode = function decode(reader, length) {
+                TriggerFiringList.decode = function decode(reader, length) {
                     if (!(reader instanceof $Reader))
                         reader = $Reader.create(reader);
-                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.Schedule();
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.TriggerFiringList();
                     while (reader.pos < end) {
                         var tag = reader.uint32();
                         switch (tag >>> 3) {
                         case 1:
-                            message.cronExpression = reader.string();
+                            if (!(message.firings && message.firings.length))
+                                message.firings = [];
+                            message.firings.push($root.flyteidl.admin.TriggerFiring.decode(reader, reader.uint32()));
                             break;
                         case 2:
-                            message.rate = $root.flyteidl.admin.FixedRate.decode(reader, reader.uint32());
-                            break;
-                        case 4:
-                            message.cronSchedule = $root.flyteidl.admin.CronSchedule.decode(reader, reader.uint32());
-                            break;
-                        case 3:
-                            message.kickoffTimeInputArg = reader.string();
+                            message.token = reader.string();
                             break;
                         default:
                             reader.skipType(tag & 7);
@@ -42195,49 +44649,32 @@
                 };
     
                 /**
-                 * Verifies a Schedule message.
+                 * Verifies a TriggerFiringList message.
                  * @function verify
-                 * @memberof flyteidl.admin.Schedule
+                 * @memberof flyteidl.admin.TriggerFiringList
                  * @static
                  * @param {Object.<string,*>} message Plain object to verify
                  * @returns {string|null} `null` if valid, otherwise the reason why it is not
                  */
-                Schedule.verify = function verify(message) {
+                TriggerFiringList.verify = function verify(message) {
                     if (typeof message !== "object" || message === null)
                         return "object expected";
-                    var properties = {};
-                    if (message.cronExpression != null && message.hasOwnProperty("cronExpression")) {
-                        properties.ScheduleExpression = 1;
-                        if (!$util.isString(message.cronExpression))
-                            return "cronExpression: string expected";
-                    }
-                    if (message.rate != null && message.hasOwnProperty("rate")) {
-                        if (properties.ScheduleExpression === 1)
-                            return "ScheduleExpression: multiple values";
-                        properties.ScheduleExpression = 1;
-                        {
-                            var error = $root.flyteidl.admin.FixedRate.verify(message.rate);
-                            if (error)
-                                return "rate." + error;
-                        }
-                    }
-                    if (message.cronSchedule != null && message.hasOwnProperty("cronSchedule")) {
-                        if (properties.ScheduleExpression === 1)
-                            return "ScheduleExpression: multiple values";
-                        properties.ScheduleExpression = 1;
-                        {
-                            var error = $root.flyteidl.admin.CronSchedule.verify(message.cronSchedule);
+                    if (message.firings != null && message.hasOwnProperty("firings")) {
+                        if (!Array.isArray(message.firings))
+                            return "firings: array expected";
+                        for (var i = 0; i < message.firings.length; ++i) {
+                            var error = $root.flyteidl.admin.TriggerFiring.verify(message.firings[i]);
                             if (error)
-                                return "cronSchedule." + error;
+                                return "firings." + error;
                         }
                     }
-                    if (message.kickoffTimeInputArg != null && message.hasOwnProperty("kickoffTimeInputArg"))
-                        if (!$util.isString(message.kickoffTimeInputArg))
-                            return "kickoffTimeInputArg: string expected";
+                    if (message.token != null && message.hasOwnProperty("token"))
+                        if (!$util.isString(message.token))
+                            return "token: string expected";
                     return null;
                 };
     
-                return Schedule;
+                return TriggerFiringList;
             })();
     
             admin.NodeExecutionGetRequest = (function() {
@@ -47975,6 +50412,473 @@
                 return ProjectDomainAttributesDeleteResponse;
             })();
     
+            admin.WorkflowPromoteRequest = (function() {
+    
+                /**
+                 * Properties of a WorkflowPromoteRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IWorkflowPromoteRequest
+                 * @property {flyteidl.core.IIdentifier|null} [id] WorkflowPromoteRequest id
+                 * @property {string|null} [targetProject] WorkflowPromoteRequest targetProject
+                 * @property {string|null} [targetDomain] WorkflowPromoteRequest targetDomain
+                 * @property {boolean|null} [activateLaunchPlans] WorkflowPromoteRequest activateLaunchPlans
+                 */
+    
+                /**
+                 * Constructs a new WorkflowPromoteRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a WorkflowPromoteRequest.
+                 * @implements IWorkflowPromoteRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IWorkflowPromoteRequest=} [properties] Properties to set
+                 */
+                function WorkflowPromoteRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * WorkflowPromoteRequest id.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @instance
+                 */
+                WorkflowPromoteRequest.prototype.id = null;
+    
+                /**
+                 * WorkflowPromoteRequest targetProject.
+                 * @member {string} targetProject
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @instance
+                 */
+                WorkflowPromoteRequest.prototype.targetProject = "";
+    
+                /**
+                 * WorkflowPromoteRequest targetDomain.
+                 * @member {string} targetDomain
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @instance
+                 */
+                WorkflowPromoteRequest.prototype.targetDomain = "";
+    
+                /**
+                 * WorkflowPromoteRequest activateLaunchPlans.
+                 * @member {boolean} activateLaunchPlans
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @instance
+                 */
+                WorkflowPromoteRequest.prototype.activateLaunchPlans = false;
+    
+                /**
+                 * Creates a new WorkflowPromoteRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowPromoteRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WorkflowPromoteRequest} WorkflowPromoteRequest instance
+                 */
+                WorkflowPromoteRequest.create = function create(properties) {
+                    return new WorkflowPromoteRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified WorkflowPromoteRequest message. Does not implicitly {@link flyteidl.admin.WorkflowPromoteRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowPromoteRequest} message WorkflowPromoteRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                WorkflowPromoteRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.Identifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.targetProject != null && message.hasOwnProperty("targetProject"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.targetProject);
+                    if (message.targetDomain != null && message.hasOwnProperty("targetDomain"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.targetDomain);
+                    if (message.activateLaunchPlans != null && message.hasOwnProperty("activateLaunchPlans"))
+                        writer.uint32(/* id 4, wireType 0 =*/32).bool(message.activateLaunchPlans);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a WorkflowPromoteRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.WorkflowPromoteRequest} WorkflowPromoteRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                WorkflowPromoteRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowPromoteRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.targetProject = reader.string();
+                            break;
+                        case 3:
+                            message.targetDomain = reader.string();
+                            break;
+                        case 4:
+                            message.activateLaunchPlans = reader.bool();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a WorkflowPromoteRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.WorkflowPromoteRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                WorkflowPromoteRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.targetProject != null && message.hasOwnProperty("targetProject"))
+                        if (!$util.isString(message.targetProject))
+                            return "targetProject: string expected";
+                    if (message.targetDomain != null && message.hasOwnProperty("targetDomain"))
+                        if (!$util.isString(message.targetDomain))
+                            return "targetDomain: string expected";
+                    if (message.activateLaunchPlans != null && message.hasOwnProperty("activateLaunchPlans"))
+                        if (typeof message.activateLaunchPlans !== "boolean")
+                            return "activateLaunchPlans: boolean expected";
+                    return null;
+                };
+    
+                return WorkflowPromoteRequest;
+            })();
+    
+            admin.Promotion = (function() {
+    
+                /**
+                 * Properties of a Promotion.
+                 * @memberof flyteidl.admin
+                 * @interface IPromotion
+                 * @property {flyteidl.core.IIdentifier|null} [source] Promotion source
+                 * @property {flyteidl.core.IIdentifier|null} [target] Promotion target
+                 * @property {string|null} [promotedBy] Promotion promotedBy
+                 * @property {google.protobuf.ITimestamp|null} [promotedAt] Promotion promotedAt
+                 * @property {boolean|null} [existed] Promotion existed
+                 */
+    
+                /**
+                 * Constructs a new Promotion.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a Promotion.
+                 * @implements IPromotion
+                 * @constructor
+                 * @param {flyteidl.admin.IPromotion=} [properties] Properties to set
+                 */
+                function Promotion(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * Promotion source.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} source
+                 * @memberof flyteidl.admin.Promotion
+                 * @instance
+                 */
+                Promotion.prototype.source = null;
+    
+                /**
+                 * Promotion target.
+                 * @member {flyteidl.core.IIdentifier|null|undefined} target
+                 * @memberof flyteidl.admin.Promotion
+                 * @instance
+                 */
+                Promotion.prototype.target = null;
+    
+                /**
+                 * Promotion promotedBy.
+                 * @member {string} promotedBy
+                 * @memberof flyteidl.admin.Promotion
+                 * @instance
+                 */
+                Promotion.prototype.promotedBy = "";
+    
+                /**
+                 * Promotion promotedAt.
+                 * @member {google.protobuf.ITimestamp|null|undefined} promotedAt
+                 * @memberof flyteidl.admin.Promotion
+                 * @instance
+                 */
+                Promotion.prototype.promotedAt = null;
+    
+                /**
+                 * Promotion existed.
+                 * @member {boolean} existed
+                 * @memberof flyteidl.admin.Promotion
+                 * @instance
+                 */
+                Promotion.prototype.existed = false;
+    
+                /**
+                 * Creates a new Promotion instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.Promotion
+                 * @static
+                 * @param {flyteidl.admin.IPromotion=} [properties] Properties to set
+                 * @returns {flyteidl.admin.Promotion} Promotion instance
+                 */
+                Promotion.create = function create(properties) {
+                    return new Promotion(properties);
+                };
+    
+                /**
+                 * Encodes the specified Promotion message. Does not implicitly {@link flyteidl.admin.Promotion.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.Promotion
+                 * @static
+                 * @param {flyteidl.admin.IPromotion} message Promotion message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                Promotion.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.source != null && message.hasOwnProperty("source"))
+                        $root.flyteidl.core.Identifier.encode(message.source, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.target != null && message.hasOwnProperty("target"))
+                        $root.flyteidl.core.Identifier.encode(message.target, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.promotedBy != null && message.hasOwnProperty("promotedBy"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.promotedBy);
+                    if (message.promotedAt != null && message.hasOwnProperty("promotedAt"))
+                        $root.google.protobuf.Timestamp.encode(message.promotedAt, writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    if (message.existed != null && message.hasOwnProperty("existed"))
+                        writer.uint32(/* id 5, wireType 0 =*/40).bool(message.existed);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a Promotion message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.Promotion
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.Promotion} Promotion
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                Promotion.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.Promotion();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.source = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.target = $root.flyteidl.core.Identifier.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.promotedBy = reader.string();
+                            break;
+                        case 4:
+                            message.promotedAt = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        case 5:
+                            message.existed = reader.bool();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a Promotion message.
+                 * @function verify
+                 * @memberof flyteidl.admin.Promotion
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                Promotion.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.source != null && message.hasOwnProperty("source")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.source);
+                        if (error)
+                            return "source." + error;
+                    }
+                    if (message.target != null && message.hasOwnProperty("target")) {
+                        var error = $root.flyteidl.core.Identifier.verify(message.target);
+                        if (error)
+                            return "target." + error;
+                    }
+                    if (message.promotedBy != null && message.hasOwnProperty("promotedBy"))
+                        if (!$util.isString(message.promotedBy))
+                            return "promotedBy: string expected";
+                    if (message.promotedAt != null && message.hasOwnProperty("promotedAt")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.promotedAt);
+                        if (error)
+                            return "promotedAt." + error;
+                    }
+                    if (message.existed != null && message.hasOwnProperty("existed"))
+                        if (typeof message.existed !== "boolean")
+                            return "existed: boolean expected";
+                    return null;
+                };
+    
+                return Promotion;
+            })();
+    
+            admin.WorkflowPromoteResponse = (function() {
+    
+                /**
+                 * Properties of a WorkflowPromoteResponse.
+                 * @memberof flyteidl.admin
+                 * @interface IWorkflowPromoteResponse
+                 * @property {Array.<flyteidl.admin.IPromotion>|null} [promotions] WorkflowPromoteResponse promotions
+                 */
+    
+                /**
+                 * Constructs a new WorkflowPromoteResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a WorkflowPromoteResponse.
+                 * @implements IWorkflowPromoteResponse
+                 * @constructor
+                 * @param {flyteidl.admin.IWorkflowPromoteResponse=} [properties] Properties to set
+                 */
+                function WorkflowPromoteResponse(properties) {
+                    this.promotions = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * WorkflowPromoteResponse promotions.
+                 * @member {Array.<flyteidl.admin.IPromotion>} promotions
+                 * @memberof flyteidl.admin.WorkflowPromoteResponse
+                 * @instance
+                 */
+                WorkflowPromoteResponse.prototype.promotions = $util.emptyArray;
+    
+                /**
+                 * Creates a new WorkflowPromoteResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.WorkflowPromoteResponse
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowPromoteResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WorkflowPromoteResponse} WorkflowPromoteResponse instance
+                 */
+                WorkflowPromoteResponse.create = function create(properties) {
+                    return new WorkflowPromoteResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified WorkflowPromoteResponse message. Does not implicitly {@link flyteidl.admin.WorkflowPromoteResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.WorkflowPromoteResponse
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowPromoteResponse} message WorkflowPromoteResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                WorkflowPromoteResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.promotions != null && message.promotions.length)
+                        for (var i = 0; i < message.promotions.length; ++i)
+                            $root.flyteidl.admin.Promotion.encode(message.promotions[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a WorkflowPromoteResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.WorkflowPromoteResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.WorkflowPromoteResponse} WorkflowPromoteResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                WorkflowPromoteResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowPromoteResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            if (!(message.promotions && message.promotions.length))
+                                message.promotions = [];
+                            message.promotions.push($root.flyteidl.admin.Promotion.decode(reader, reader.uint32()));
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a WorkflowPromoteResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.WorkflowPromoteResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                WorkflowPromoteResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.promotions != null && message.hasOwnProperty("promotions")) {
+                        if (!Array.isArray(message.promotions))
+                            return "promotions: array expected";
+                        for (var i = 0; i < message.promotions.length; ++i) {
+                            var error = $root.flyteidl.admin.Promotion.verify(message.promotions[i]);
+                            if (error)
+                                return "promotions." + error;
+                        }
+                    }
+                    return null;
+                };
+    
+                return WorkflowPromoteResponse;
+            })();
+    
             admin.SignalGetOrCreateRequest = (function() {
     
                 /**
@@ -53838,6 +56742,72 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#promoteWorkflow}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef PromoteWorkflowCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.WorkflowPromoteResponse} [response] WorkflowPromoteResponse
+                 */
+    
+                /**
+                 * Calls PromoteWorkflow.
+                 * @function promoteWorkflow
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IWorkflowPromoteRequest} request WorkflowPromoteRequest message or plain object
+                 * @param {flyteidl.service.AdminService.PromoteWorkflowCallback} callback Node-style callback called with the error, if any, and WorkflowPromoteResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.promoteWorkflow = function promoteWorkflow(request, callback) {
+                    return this.rpcCall(promoteWorkflow, $root.flyteidl.admin.WorkflowPromoteRequest, $root.flyteidl.admin.WorkflowPromoteResponse, request, callback);
+                }, "name", { value: "PromoteWorkflow" });
+    
+                /**
+                 * Calls PromoteWorkflow.
+                 * @function promoteWorkflow
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IWorkflowPromoteRequest} request WorkflowPromoteRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.WorkflowPromoteResponse>} Promise
+                 * @variation 2
+                 */
+    
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#getPromotion}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef GetPromotionCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.Promotion} [response] Promotion
+                 */
+    
+                /**
+                 * Calls GetPromotion.
+                 * @function getPromotion
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IObjectGetRequest} request ObjectGetRequest message or plain object
+                 * @param {flyteidl.service.AdminService.GetPromotionCallback} callback Node-style callback called with the error, if any, and Promotion
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.getPromotion = function getPromotion(request, callback) {
+                    return this.rpcCall(getPromotion, $root.flyteidl.admin.ObjectGetRequest, $root.flyteidl.admin.Promotion, request, callback);
+                }, "name", { value: "GetPromotion" });
+    
+                /**
+                 * Calls GetPromotion.
+                 * @function getPromotion
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IObjectGetRequest} request ObjectGetRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.Promotion>} Promise
+                 * @variation 2
+                 */
+    
                 /**
                  * Callback as used by {@link flyteidl.service.AdminService#createLaunchPlan}.
                  * @memberof flyteidl.service.AdminService
@@ -54036,6 +57006,39 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#listTriggerFirings}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef ListTriggerFiringsCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.TriggerFiringList} [response] TriggerFiringList
+                 */
+    
+                /**
+                 * Calls ListTriggerFirings.
+                 * @function listTriggerFirings
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IResourceListRequest} request ResourceListRequest message or plain object
+                 * @param {flyteidl.service.AdminService.ListTriggerFiringsCallback} callback Node-style callback called with the error, if any, and TriggerFiringList
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.listTriggerFirings = function listTriggerFirings(request, callback) {
+                    return this.rpcCall(listTriggerFirings, $root.flyteidl.admin.ResourceListRequest, $root.flyteidl.admin.TriggerFiringList, request, callback);
+                }, "name", { value: "ListTriggerFirings" });
+    
+                /**
+                 * Calls ListTriggerFirings.
+                 * @function listTriggerFirings
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IResourceListRequest} request ResourceListRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.TriggerFiringList>} Promise
+                 * @variation 2
+                 */
+    
                 /**
                  * Callback as used by {@link flyteidl.service.AdminService#updateLaunchPlan}.
                  * @memberof flyteidl.service.AdminService
@@ -54333,6 +57336,72 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#pauseExecution}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef PauseExecutionCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.ExecutionPauseResponse} [response] ExecutionPauseResponse
+                 */
+    
+                /**
+                 * Calls PauseExecution.
+                 * @function pauseExecution
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IExecutionPauseRequest} request ExecutionPauseRequest message or plain object
+                 * @param {flyteidl.service.AdminService.PauseExecutionCallback} callback Node-style callback called with the error, if any, and ExecutionPauseResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.pauseExecution = function pauseExecution(request, callback) {
+                    return this.rpcCall(pauseExecution, $root.flyteidl.admin.ExecutionPauseRequest, $root.flyteidl.admin.ExecutionPauseResponse, request, callback);
+                }, "name", { value: "PauseExecution" });
+    
+                /**
+                 * Calls PauseExecution.
+                 * @function pauseExecution
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IExecutionPauseRequest} request ExecutionPauseRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.ExecutionPauseResponse>} Promise
+                 * @variation 2
+                 */
+    
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#resumeExecution}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef ResumeExecutionCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.ExecutionResumeResponse} [response] ExecutionResumeResponse
+                 */
+    
+                /**
+                 * Calls ResumeExecution.
+                 * @function resumeExecution
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IExecutionResumeRequest} request ExecutionResumeRequest message or plain object
+                 * @param {flyteidl.service.AdminService.ResumeExecutionCallback} callback Node-style callback called with the error, if any, and ExecutionResumeResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.resumeExecution = function resumeExecution(request, callback) {
+                    return this.rpcCall(resumeExecution, $root.flyteidl.admin.ExecutionResumeRequest, $root.flyteidl.admin.ExecutionResumeResponse, request, callback);
+                }, "name", { value: "ResumeExecution" });
+    
+                /**
+                 * Calls ResumeExecution.
+                 * @function resumeExecution
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IExecutionResumeRequest} request ExecutionResumeRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.ExecutionResumeResponse>} Promise
+                 * @variation 2
+                 */
+    
                 /**
                  * Callback as used by {@link flyteidl.service.AdminService#getNodeExecution}.
                  * @memberof flyteidl.service.AdminService
@@ -55422,6 +58491,39 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#watchExecution}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef WatchExecutionCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.WatchExecutionResponse} [response] WatchExecutionResponse
+                 */
+    
+                /**
+                 * Calls WatchExecution.
+                 * @function watchExecution
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IWatchExecutionRequest} request WatchExecutionRequest message or plain object
+                 * @param {flyteidl.service.AdminService.WatchExecutionCallback} callback Node-style callback called with the error, if any, and WatchExecutionResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.watchExecution = function watchExecution(request, callback) {
+                    return this.rpcCall(watchExecution, $root.flyteidl.admin.WatchExecutionRequest, $root.flyteidl.admin.WatchExecutionResponse, request, callback);
+                }, "name", { value: "WatchExecution" });
+    
+                /**
+                 * Calls WatchExecution.
+                 * @function watchExecution
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IWatchExecutionRequest} request WatchExecutionRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.WatchExecutionResponse>} Promise
+                 * @variation 2
+                 */
+    
                 return AdminService;
             })();
     
@@ -58017,6 +61119,459 @@
                 return GetDataResponse;
             })();
     
+            service.PreviewStructuredDatasetRequest = (function() {
+    
+                /**
+                 * Properties of a PreviewStructuredDatasetRequest.
+                 * @memberof flyteidl.service
+                 * @interface IPreviewStructuredDatasetRequest
+                 * @property {string|null} [flyteUrl] PreviewStructuredDatasetRequest flyteUrl
+                 * @property {number|null} [limit] PreviewStructuredDatasetRequest limit
+                 * @property {Array.<string>|null} [columns] PreviewStructuredDatasetRequest columns
+                 */
+    
+                /**
+                 * Constructs a new PreviewStructuredDatasetRequest.
+                 * @memberof flyteidl.service
+                 * @classdesc Represents a PreviewStructuredDatasetRequest.
+                 * @implements IPreviewStructuredDatasetRequest
+                 * @constructor
+                 * @param {flyteidl.service.IPreviewStructuredDatasetRequest=} [properties] Properties to set
+                 */
+                function PreviewStructuredDatasetRequest(properties) {
+                    this.columns = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * PreviewStructuredDatasetRequest flyteUrl.
+                 * @member {string} flyteUrl
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @instance
+                 */
+                PreviewStructuredDatasetRequest.prototype.flyteUrl = "";
+    
+                /**
+                 * PreviewStructuredDatasetRequest limit.
+                 * @member {number} limit
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @instance
+                 */
+                PreviewStructuredDatasetRequest.prototype.limit = 0;
+    
+                /**
+                 * PreviewStructuredDatasetRequest columns.
+                 * @member {Array.<string>} columns
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @instance
+                 */
+                PreviewStructuredDatasetRequest.prototype.columns = $util.emptyArray;
+    
+                /**
+                 * Creates a new PreviewStructuredDatasetRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @static
+                 * @param {flyteidl.service.IPreviewStructuredDatasetRequest=} [properties] Properties to set
+                 * @returns {flyteidl.service.PreviewStructuredDatasetRequest} PreviewStructuredDatasetRequest instance
+                 */
+                PreviewStructuredDatasetRequest.create = function create(properties) {
+                    return new PreviewStructuredDatasetRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified PreviewStructuredDatasetRequest message. Does not implicitly {@link flyteidl.service.PreviewStructuredDatasetRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @static
+                 * @param {flyteidl.service.IPreviewStructuredDatasetRequest} message PreviewStructuredDatasetRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                PreviewStructuredDatasetRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.flyteUrl != null && message.hasOwnProperty("flyteUrl"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.flyteUrl);
+                    if (message.limit != null && message.hasOwnProperty("limit"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).uint32(message.limit);
+                    if (message.columns != null && message.columns.length)
+                        for (var i = 0; i < message.columns.length; ++i)
+                            writer.uint32(/* id 3, wireType 2 =*/26).string(message.columns[i]);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a PreviewStructuredDatasetRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.service.PreviewStructuredDatasetRequest} PreviewStructuredDatasetRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                PreviewStructuredDatasetRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.service.PreviewStructuredDatasetRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.flyteUrl = reader.string();
+                            break;
+                        case 2:
+                            message.limit = reader.uint32();
+                            break;
+                        case 3:
+                            if (!(message.columns && message.columns.length))
+                                message.columns = [];
+                            message.columns.push(reader.string());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a PreviewStructuredDatasetRequest message.
+                 * @function verify
+                 * @memberof flyteidl.service.PreviewStructuredDatasetRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                PreviewStructuredDatasetRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.flyteUrl != null && message.hasOwnProperty("flyteUrl"))
+                        if (!$util.isString(message.flyteUrl))
+                            return "flyteUrl: string expected";
+                    if (message.limit != null && message.hasOwnProperty("limit"))
+                        if (!$util.isInteger(message.limit))
+                            return "limit: integer expected";
+                    if (message.columns != null && message.hasOwnProperty("columns")) {
+                        if (!Array.isArray(message.columns))
+                            return "columns: array expected";
+                        for (var i = 0; i < message.columns.length; ++i)
+                            if (!$util.isString(message.columns[i]))
+                                return "columns: string[] expected";
+                    }
+                    return null;
+                };
+    
+                return PreviewStructuredDatasetRequest;
+            })();
+    
+            service.StructuredDatasetRow = (function() {
+    
+                /**
+                 * Properties of a StructuredDatasetRow.
+                 * @memberof flyteidl.service
+                 * @interface IStructuredDatasetRow
+                 * @property {Array.<flyteidl.core.IScalar>|null} [values] StructuredDatasetRow values
+                 */
+    
+                /**
+                 * Constructs a new StructuredDatasetRow.
+                 * @memberof flyteidl.service
+                 * @classdesc Represents a StructuredDatasetRow.
+                 * @implements IStructuredDatasetRow
+                 * @constructor
+                 * @param {flyteidl.service.IStructuredDatasetRow=} [properties] Properties to set
+                 */
+                function StructuredDatasetRow(properties) {
+                    this.values = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * StructuredDatasetRow values.
+                 * @member {Array.<flyteidl.core.IScalar>} values
+                 * @memberof flyteidl.service.StructuredDatasetRow
+                 * @instance
+                 */
+                StructuredDatasetRow.prototype.values = $util.emptyArray;
+    
+                /**
+                 * Creates a new StructuredDatasetRow instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.service.StructuredDatasetRow
+                 * @static
+                 * @param {flyteidl.service.IStructuredDatasetRow=} [properties] Properties to set
+                 * @returns {flyteidl.service.StructuredDatasetRow} StructuredDatasetRow instance
+                 */
+                StructuredDatasetRow.create = function create(properties) {
+                    return new StructuredDatasetRow(properties);
+                };
+    
+                /**
+                 * Encodes the specified StructuredDatasetRow message. Does not implicitly {@link flyteidl.service.StructuredDatasetRow.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.service.StructuredDatasetRow
+                 * @static
+                 * @param {flyteidl.service.IStructuredDatasetRow} message StructuredDatasetRow message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                StructuredDatasetRow.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.values != null && message.values.length)
+                        for (var i = 0; i < message.values.length; ++i)
+                            $root.flyteidl.core.Scalar.encode(message.values[i], writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a StructuredDatasetRow message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.service.StructuredDatasetRow
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.service.StructuredDatasetRow} StructuredDatasetRow
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                StructuredDatasetRow.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.service.StructuredDatasetRow();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            if (!(message.values && message.values.length))
+                                message.values = [];
+                            message.values.push($root.flyteidl.core.Scalar.decode(reader, reader.uint32()));
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a StructuredDatasetRow message.
+                 * @function verify
+                 * @memberof flyteidl.service.StructuredDatasetRow
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                StructuredDatasetRow.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.values != null && message.hasOwnProperty("values")) {
+                        if (!Array.isArray(message.values))
+                            return "values: array expected";
+                        for (var i = 0; i < message.values.length; ++i) {
+                            var error = $root.flyteidl.core.Scalar.verify(message.values[i]);
+                            if (error)
+                                return "values." + error;
+                        }
+                    }
+                    return null;
+                };
+    
+                return StructuredDatasetRow;
+            })();
+    
+            service.PreviewStructuredDatasetResponse = (function() {
+    
+                /**
+                 * Properties of a PreviewStructuredDatasetResponse.
+                 * @memberof flyteidl.service
+                 * @interface IPreviewStructuredDatasetResponse
+                 * @property {flyteidl.core.IStructuredDatasetType|null} [schema] PreviewStructuredDatasetResponse schema
+                 * @property {Array.<flyteidl.service.IStructuredDatasetRow>|null} [rows] PreviewStructuredDatasetResponse rows
+                 * @property {boolean|null} [truncated] PreviewStructuredDatasetResponse truncated
+                 * @property {Array.<string>|null} [omittedColumns] PreviewStructuredDatasetResponse omittedColumns
+                 */
+    
+                /**
+                 * Constructs a new PreviewStructuredDatasetResponse.
+                 * @memberof flyteidl.service
+                 * @classdesc Represents a PreviewStructuredDatasetResponse.
+                 * @implements IPreviewStructuredDatasetResponse
+                 * @constructor
+                 * @param {flyteidl.service.IPreviewStructuredDatasetResponse=} [properties] Properties to set
+                 */
+                function PreviewStructuredDatasetResponse(properties) {
+                    this.rows = [];
+                    this.omittedColumns = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * PreviewStructuredDatasetResponse schema.
+                 * @member {flyteidl.core.IStructuredDatasetType|null|undefined} schema
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @instance
+                 */
+                PreviewStructuredDatasetResponse.prototype.schema = null;
+    
+                /**
+                 * PreviewStructuredDatasetResponse rows.
+                 * @member {Array.<flyteidl.service.IStructuredDatasetRow>} rows
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @instance
+                 */
+                PreviewStructuredDatasetResponse.prototype.rows = $util.emptyArray;
+    
+                /**
+                 * PreviewStructuredDatasetResponse truncated.
+                 * @member {boolean} truncated
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @instance
+                 */
+                PreviewStructuredDatasetResponse.prototype.truncated = false;
+    
+                /**
+                 * PreviewStructuredDatasetResponse omittedColumns.
+                 * @member {Array.<string>} omittedColumns
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @instance
+                 */
+                PreviewStructuredDatasetResponse.prototype.omittedColumns = $util.emptyArray;
+    
+                /**
+                 * Creates a new PreviewStructuredDatasetResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @static
+                 * @param {flyteidl.service.IPreviewStructuredDatasetResponse=} [properties] Properties to set
+                 * @returns {flyteidl.service.PreviewStructuredDatasetResponse} PreviewStructuredDatasetResponse instance
+                 */
+                PreviewStructuredDatasetResponse.create = function create(properties) {
+                    return new PreviewStructuredDatasetResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified PreviewStructuredDatasetResponse message. Does not implicitly {@link flyteidl.service.PreviewStructuredDatasetResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @static
+                 * @param {flyteidl.service.IPreviewStructuredDatasetResponse} message PreviewStructuredDatasetResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                PreviewStructuredDatasetResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.schema != null && message.hasOwnProperty("schema"))
+                        $root.flyteidl.core.StructuredDatasetType.encode(message.schema, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.rows != null && message.rows.length)
+                        for (var i = 0; i < message.rows.length; ++i)
+                            $root.flyteidl.service.StructuredDatasetRow.encode(message.rows[i], writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.truncated != null && message.hasOwnProperty("truncated"))
+                        writer.uint32(/* id 3, wireType 0 =*/24).bool(message.truncated);
+                    if (message.omittedColumns != null && message.omittedColumns.length)
+                        for (var i = 0; i < message.omittedColumns.length; ++i)
+                            writer.uint32(/* id 4, wireType 2 =*/34).string(message.omittedColumns[i]);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a PreviewStructuredDatasetResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.service.PreviewStructuredDatasetResponse} PreviewStructuredDatasetResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                PreviewStructuredDatasetResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.service.PreviewStructuredDatasetResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.schema = $root.flyteidl.core.StructuredDatasetType.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            if (!(message.rows && message.rows.length))
+                                message.rows = [];
+                            message.rows.push($root.flyteidl.service.StructuredDatasetRow.decode(reader, reader.uint32()));
+                            break;
+                        case 3:
+                            message.truncated = reader.bool();
+                            break;
+                        case 4:
+                            if (!(message.omittedColumns && message.omittedColumns.length))
+                                message.omittedColumns = [];
+                            message.omittedColumns.push(reader.string());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a PreviewStructuredDatasetResponse message.
+                 * @function verify
+                 * @memberof flyteidl.service.PreviewStructuredDatasetResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                PreviewStructuredDatasetResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.schema != null && message.hasOwnProperty("schema")) {
+                        var error = $root.flyteidl.core.StructuredDatasetType.verify(message.schema);
+                        if (error)
+                            return "schema." + error;
+                    }
+                    if (message.rows != null && message.hasOwnProperty("rows")) {
+                        if (!Array.isArray(message.rows))
+                            return "rows: array expected";
+                        for (var i = 0; i < message.rows.length; ++i) {
+                            var error = $root.flyteidl.service.StructuredDatasetRow.verify(message.rows[i]);
+                            if (error)
+                                return "rows." + error;
+                        }
+                    }
+                    if (message.truncated != null && message.hasOwnProperty("truncated"))
+                        if (typeof message.truncated !== "boolean")
+                            return "truncated: boolean expected";
+                    if (message.omittedColumns != null && message.hasOwnProperty("omittedColumns")) {
+                        if (!Array.isArray(message.omittedColumns))
+                            return "omittedColumns: array expected";
+                        for (var i = 0; i < message.omittedColumns.length; ++i)
+                            if (!$util.isString(message.omittedColumns[i]))
+                                return "omittedColumns: string[] expected";
+                    }
+                    return null;
+                };
+    
+                return PreviewStructuredDatasetResponse;
+            })();
+    
             service.DataProxyService = (function() {
     
                 /**
@@ -58181,6 +61736,39 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.DataProxyService#previewStructuredDataset}.
+                 * @memberof flyteidl.service.DataProxyService
+                 * @typedef PreviewStructuredDatasetCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.service.PreviewStructuredDatasetResponse} [response] PreviewStructuredDatasetResponse
+                 */
+    
+                /**
+                 * Calls PreviewStructuredDataset.
+                 * @function previewStructuredDataset
+                 * @memberof flyteidl.service.DataProxyService
+                 * @instance
+                 * @param {flyteidl.service.IPreviewStructuredDatasetRequest} request PreviewStructuredDatasetRequest message or plain object
+                 * @param {flyteidl.service.DataProxyService.PreviewStructuredDatasetCallback} callback Node-style callback called with the error, if any, and PreviewStructuredDatasetResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(DataProxyService.prototype.previewStructuredDataset = function previewStructuredDataset(request, callback) {
+                    return this.rpcCall(previewStructuredDataset, $root.flyteidl.service.PreviewStructuredDatasetRequest, $root.flyteidl.service.PreviewStructuredDatasetResponse, request, callback);
+                }, "name", { value: "PreviewStructuredDataset" });
+    
+                /**
+                 * Calls PreviewStructuredDataset.
+                 * @function previewStructuredDataset
+                 * @memberof flyteidl.service.DataProxyService
+                 * @instance
+                 * @param {flyteidl.service.IPreviewStructuredDatasetRequest} request PreviewStructuredDatasetRequest message or plain object
+                 * @returns {Promise<flyteidl.service.PreviewStructuredDatasetResponse>} Promise
+                 * @variation 2
+                 */
+    
                 return DataProxyService;
             })();
     
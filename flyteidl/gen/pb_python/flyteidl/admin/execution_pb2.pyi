@@ -53,14 +53,26 @@ class ExecutionRelaunchRequest(_message.Message):
     def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., name: _Optional[str] = ..., overwrite_cache: bool = ...) -> None: ...
 
 class ExecutionRecoverRequest(_message.Message):
-    __slots__ = ["id", "name", "metadata"]
+    __slots__ = ["id", "name", "metadata", "rerun_from", "launch_plan"]
     ID_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
+    RERUN_FROM_FIELD_NUMBER: _ClassVar[int]
+    LAUNCH_PLAN_FIELD_NUMBER: _ClassVar[int]
     id: _identifier_pb2.WorkflowExecutionIdentifier
     name: str
     metadata: ExecutionMetadata
-    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., name: _Optional[str] = ..., metadata: _Optional[_Union[ExecutionMetadata, _Mapping]] = ...) -> None: ...
+    rerun_from: RerunFromNode
+    launch_plan: _identifier_pb2.Identifier
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., name: _Optional[str] = ..., metadata: _Optional[_Union[ExecutionMetadata, _Mapping]] = ..., rerun_from: _Optional[_Union[RerunFromNode, _Mapping]] = ..., launch_plan: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ...) -> None: ...
+
+class RerunFromNode(_message.Message):
+    __slots__ = ["node_id", "inputs"]
+    NODE_ID_FIELD_NUMBER: _ClassVar[int]
+    INPUTS_FIELD_NUMBER: _ClassVar[int]
+    node_id: str
+    inputs: _literals_pb2.LiteralMap
+    def __init__(self, node_id: _Optional[str] = ..., inputs: _Optional[_Union[_literals_pb2.LiteralMap, _Mapping]] = ...) -> None: ...
 
 class ExecutionCreateResponse(_message.Message):
     __slots__ = ["id"]
@@ -149,7 +161,7 @@ class SystemMetadata(_message.Message):
     def __init__(self, execution_cluster: _Optional[str] = ..., namespace: _Optional[str] = ...) -> None: ...
 
 class ExecutionMetadata(_message.Message):
-    __slots__ = ["mode", "principal", "nesting", "scheduled_at", "parent_node_execution", "reference_execution", "system_metadata", "artifact_ids"]
+    __slots__ = ["mode", "principal", "nesting", "scheduled_at", "parent_node_execution", "reference_execution", "system_metadata", "artifact_ids", "rerun_from"]
     class ExecutionMode(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = []
         MANUAL: _ClassVar[ExecutionMetadata.ExecutionMode]
@@ -174,6 +186,7 @@ class ExecutionMetadata(_message.Message):
     REFERENCE_EXECUTION_FIELD_NUMBER: _ClassVar[int]
     SYSTEM_METADATA_FIELD_NUMBER: _ClassVar[int]
     ARTIFACT_IDS_FIELD_NUMBER: _ClassVar[int]
+    RERUN_FROM_FIELD_NUMBER: _ClassVar[int]
     mode: ExecutionMetadata.ExecutionMode
     principal: str
     nesting: int
@@ -182,7 +195,8 @@ class ExecutionMetadata(_message.Message):
     reference_execution: _identifier_pb2.WorkflowExecutionIdentifier
     system_metadata: SystemMetadata
     artifact_ids: _containers.RepeatedCompositeFieldContainer[_artifact_id_pb2.ArtifactID]
-    def __init__(self, mode: _Optional[_Union[ExecutionMetadata.ExecutionMode, str]] = ..., principal: _Optional[str] = ..., nesting: _Optional[int] = ..., scheduled_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., parent_node_execution: _Optional[_Union[_identifier_pb2.NodeExecutionIdentifier, _Mapping]] = ..., reference_execution: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., system_metadata: _Optional[_Union[SystemMetadata, _Mapping]] = ..., artifact_ids: _Optional[_Iterable[_Union[_artifact_id_pb2.ArtifactID, _Mapping]]] = ...) -> None: ...
+    rerun_from: RerunFromNode
+    def __init__(self, mode: _Optional[_Union[ExecutionMetadata.ExecutionMode, str]] = ..., principal: _Optional[str] = ..., nesting: _Optional[int] = ..., scheduled_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., parent_node_execution: _Optional[_Union[_identifier_pb2.NodeExecutionIdentifier, _Mapping]] = ..., reference_execution: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., system_metadata: _Optional[_Union[SystemMetadata, _Mapping]] = ..., artifact_ids: _Optional[_Iterable[_Union[_artifact_id_pb2.ArtifactID, _Mapping]]] = ..., rerun_from: _Optional[_Union[RerunFromNode, _Mapping]] = ...) -> None: ...
 
 class NotificationList(_message.Message):
     __slots__ = ["notifications"]
@@ -244,6 +258,28 @@ class ExecutionTerminateResponse(_message.Message):
     __slots__ = []
     def __init__(self) -> None: ...
 
+class ExecutionPauseRequest(_message.Message):
+    __slots__ = ["id", "abort_running_nodes"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    ABORT_RUNNING_NODES_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.WorkflowExecutionIdentifier
+    abort_running_nodes: bool
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., abort_running_nodes: bool = ...) -> None: ...
+
+class ExecutionPauseResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
+class ExecutionResumeRequest(_message.Message):
+    __slots__ = ["id"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.WorkflowExecutionIdentifier
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ...) -> None: ...
+
+class ExecutionResumeResponse(_message.Message):
+    __slots__ = []
+    def __init__(self) -> None: ...
+
 class WorkflowExecutionGetDataRequest(_message.Message):
     __slots__ = ["id"]
     ID_FIELD_NUMBER: _ClassVar[int]
@@ -297,3 +333,55 @@ class WorkflowExecutionGetMetricsResponse(_message.Message):
     SPAN_FIELD_NUMBER: _ClassVar[int]
     span: _metrics_pb2.Span
     def __init__(self, span: _Optional[_Union[_metrics_pb2.Span, _Mapping]] = ...) -> None: ...
+
+class WatchExecutionRequest(_message.Message):
+    __slots__ = ["id", "node_id", "cursor"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    NODE_ID_FIELD_NUMBER: _ClassVar[int]
+    CURSOR_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.WorkflowExecutionIdentifier
+    node_id: str
+    cursor: str
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., node_id: _Optional[str] = ..., cursor: _Optional[str] = ...) -> None: ...
+
+class WatchExecutionResponse(_message.Message):
+    __slots__ = ["cursor", "occurred_at", "workflow_execution", "node_execution", "task_execution"]
+    CURSOR_FIELD_NUMBER: _ClassVar[int]
+    OCCURRED_AT_FIELD_NUMBER: _ClassVar[int]
+    WORKFLOW_EXECUTION_FIELD_NUMBER: _ClassVar[int]
+    NODE_EXECUTION_FIELD_NUMBER: _ClassVar[int]
+    TASK_EXECUTION_FIELD_NUMBER: _ClassVar[int]
+    cursor: str
+    occurred_at: _timestamp_pb2.Timestamp
+    workflow_execution: WorkflowExecutionPhaseChange
+    node_execution: NodeExecutionPhaseChange
+    task_execution: TaskExecutionPhaseChange
+    def __init__(self, cursor: _Optional[str] = ..., occurred_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., workflow_execution: _Optional[_Union[WorkflowExecutionPhaseChange, _Mapping]] = ..., node_execution: _Optional[_Union[NodeExecutionPhaseChange, _Mapping]] = ..., task_execution: _Optional[_Union[TaskExecutionPhaseChange, _Mapping]] = ...) -> None: ...
+
+class WorkflowExecutionPhaseChange(_message.Message):
+    __slots__ = ["id", "phase"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    PHASE_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.WorkflowExecutionIdentifier
+    phase: _execution_pb2.WorkflowExecution.Phase
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., phase: _Optional[_Union[_execution_pb2.WorkflowExecution.Phase, str]] = ...) -> None: ...
+
+class NodeExecutionPhaseChange(_message.Message):
+    __slots__ = ["id", "phase", "parent_node_id"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    PHASE_FIELD_NUMBER: _ClassVar[int]
+    PARENT_NODE_ID_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.NodeExecutionIdentifier
+    phase: _execution_pb2.NodeExecution.Phase
+    parent_node_id: str
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.NodeExecutionIdentifier, _Mapping]] = ..., phase: _Optional[_Union[_execution_pb2.NodeExecution.Phase, str]] = ..., parent_node_id: _Optional[str] = ...) -> None: ...
+
+class TaskExecutionPhaseChange(_message.Message):
+    __slots__ = ["id", "phase", "phase_version"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    PHASE_FIELD_NUMBER: _ClassVar[int]
+    PHASE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.TaskExecutionIdentifier
+    phase: _execution_pb2.TaskExecution.Phase
+    phase_version: int
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.TaskExecutionIdentifier, _Mapping]] = ..., phase: _Optional[_Union[_execution_pb2.TaskExecution.Phase, str]] = ..., phase_version: _Optional[int] = ...) -> None: ...
@@ -7,6 +7,7 @@ from flyteidl.core import security_pb2 as _security_pb2
 from flyteidl.admin import schedule_pb2 as _schedule_pb2
 from flyteidl.admin import cluster_assignment_pb2 as _cluster_assignment_pb2
 from flyteidl.admin import common_pb2 as _common_pb2
+from flyteidl.admin import trigger_pb2 as _trigger_pb2
 from google.protobuf import any_pb2 as _any_pb2
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from google.protobuf import wrappers_pb2 as _wrappers_pb2
@@ -135,14 +136,16 @@ class LaunchPlanClosure(_message.Message):
     def __init__(self, state: _Optional[_Union[LaunchPlanState, str]] = ..., expected_inputs: _Optional[_Union[_interface_pb2.ParameterMap, _Mapping]] = ..., expected_outputs: _Optional[_Union[_interface_pb2.VariableMap, _Mapping]] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
 
 class LaunchPlanMetadata(_message.Message):
-    __slots__ = ["schedule", "notifications", "launch_conditions"]
+    __slots__ = ["schedule", "notifications", "launch_conditions", "triggers"]
     SCHEDULE_FIELD_NUMBER: _ClassVar[int]
     NOTIFICATIONS_FIELD_NUMBER: _ClassVar[int]
     LAUNCH_CONDITIONS_FIELD_NUMBER: _ClassVar[int]
+    TRIGGERS_FIELD_NUMBER: _ClassVar[int]
     schedule: _schedule_pb2.Schedule
     notifications: _containers.RepeatedCompositeFieldContainer[_common_pb2.Notification]
     launch_conditions: _any_pb2.Any
-    def __init__(self, schedule: _Optional[_Union[_schedule_pb2.Schedule, _Mapping]] = ..., notifications: _Optional[_Iterable[_Union[_common_pb2.Notification, _Mapping]]] = ..., launch_conditions: _Optional[_Union[_any_pb2.Any, _Mapping]] = ...) -> None: ...
+    triggers: _containers.RepeatedCompositeFieldContainer[_trigger_pb2.Trigger]
+    def __init__(self, schedule: _Optional[_Union[_schedule_pb2.Schedule, _Mapping]] = ..., notifications: _Optional[_Iterable[_Union[_common_pb2.Notification, _Mapping]]] = ..., launch_conditions: _Optional[_Union[_any_pb2.Any, _Mapping]] = ..., triggers: _Optional[_Iterable[_Union[_trigger_pb2.Trigger, _Mapping]]] = ...) -> None: ...
 
 class LaunchPlanUpdateRequest(_message.Message):
     __slots__ = ["id", "state"]
@@ -0,0 +1,40 @@
+from flyteidl.core import identifier_pb2 as _identifier_pb2
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class WorkflowPromoteRequest(_message.Message):
+    __slots__ = ["id", "target_project", "target_domain", "activate_launch_plans"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    TARGET_PROJECT_FIELD_NUMBER: _ClassVar[int]
+    TARGET_DOMAIN_FIELD_NUMBER: _ClassVar[int]
+    ACTIVATE_LAUNCH_PLANS_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.Identifier
+    target_project: str
+    target_domain: str
+    activate_launch_plans: bool
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ..., target_project: _Optional[str] = ..., target_domain: _Optional[str] = ..., activate_launch_plans: bool = ...) -> None: ...
+
+class Promotion(_message.Message):
+    __slots__ = ["source", "target", "promoted_by", "promoted_at", "existed"]
+    SOURCE_FIELD_NUMBER: _ClassVar[int]
+    TARGET_FIELD_NUMBER: _ClassVar[int]
+    PROMOTED_BY_FIELD_NUMBER: _ClassVar[int]
+    PROMOTED_AT_FIELD_NUMBER: _ClassVar[int]
+    EXISTED_FIELD_NUMBER: _ClassVar[int]
+    source: _identifier_pb2.Identifier
+    target: _identifier_pb2.Identifier
+    promoted_by: str
+    promoted_at: _timestamp_pb2.Timestamp
+    existed: bool
+    def __init__(self, source: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ..., target: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ..., promoted_by: _Optional[str] = ..., promoted_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., existed: bool = ...) -> None: ...
+
+class WorkflowPromoteResponse(_message.Message):
+    __slots__ = ["promotions"]
+    PROMOTIONS_FIELD_NUMBER: _ClassVar[int]
+    promotions: _containers.RepeatedCompositeFieldContainer[Promotion]
+    def __init__(self, promotions: _Optional[_Iterable[_Union[Promotion, _Mapping]]] = ...) -> None: ...
@@ -0,0 +1,91 @@
+from flyteidl.core import identifier_pb2 as _identifier_pb2
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class TriggerFiringOutcome(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = []
+    TRIGGER_FIRING_OUTCOME_UNSPECIFIED: _ClassVar[TriggerFiringOutcome]
+    FIRED: _ClassVar[TriggerFiringOutcome]
+    DUPLICATE: _ClassVar[TriggerFiringOutcome]
+    RATE_LIMITED: _ClassVar[TriggerFiringOutcome]
+    FAILED: _ClassVar[TriggerFiringOutcome]
+TRIGGER_FIRING_OUTCOME_UNSPECIFIED: TriggerFiringOutcome
+FIRED: TriggerFiringOutcome
+DUPLICATE: TriggerFiringOutcome
+RATE_LIMITED: TriggerFiringOutcome
+FAILED: TriggerFiringOutcome
+
+class Trigger(_message.Message):
+    __slots__ = ["name", "storage", "webhook", "cloud_event", "inputs", "max_firings_per_hour"]
+    class InputsEntry(_message.Message):
+        __slots__ = ["key", "value"]
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: str
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    STORAGE_FIELD_NUMBER: _ClassVar[int]
+    WEBHOOK_FIELD_NUMBER: _ClassVar[int]
+    CLOUD_EVENT_FIELD_NUMBER: _ClassVar[int]
+    INPUTS_FIELD_NUMBER: _ClassVar[int]
+    MAX_FIRINGS_PER_HOUR_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    storage: StorageEventTrigger
+    webhook: WebhookTrigger
+    cloud_event: CloudEventTrigger
+    inputs: _containers.ScalarMap[str, str]
+    max_firings_per_hour: int
+    def __init__(self, name: _Optional[str] = ..., storage: _Optional[_Union[StorageEventTrigger, _Mapping]] = ..., webhook: _Optional[_Union[WebhookTrigger, _Mapping]] = ..., cloud_event: _Optional[_Union[CloudEventTrigger, _Mapping]] = ..., inputs: _Optional[_Mapping[str, str]] = ..., max_firings_per_hour: _Optional[int] = ...) -> None: ...
+
+class StorageEventTrigger(_message.Message):
+    __slots__ = ["uri_pattern"]
+    URI_PATTERN_FIELD_NUMBER: _ClassVar[int]
+    uri_pattern: str
+    def __init__(self, uri_pattern: _Optional[str] = ...) -> None: ...
+
+class WebhookTrigger(_message.Message):
+    __slots__ = ["token_sha256"]
+    TOKEN_SHA256_FIELD_NUMBER: _ClassVar[int]
+    token_sha256: str
+    def __init__(self, token_sha256: _Optional[str] = ...) -> None: ...
+
+class CloudEventTrigger(_message.Message):
+    __slots__ = ["type", "source"]
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    SOURCE_FIELD_NUMBER: _ClassVar[int]
+    type: str
+    source: str
+    def __init__(self, type: _Optional[str] = ..., source: _Optional[str] = ...) -> None: ...
+
+class TriggerFiring(_message.Message):
+    __slots__ = ["launch_plan", "trigger_name", "event_id", "outcome", "execution_id", "message", "received_at"]
+    LAUNCH_PLAN_FIELD_NUMBER: _ClassVar[int]
+    TRIGGER_NAME_FIELD_NUMBER: _ClassVar[int]
+    EVENT_ID_FIELD_NUMBER: _ClassVar[int]
+    OUTCOME_FIELD_NUMBER: _ClassVar[int]
+    EXECUTION_ID_FIELD_NUMBER: _ClassVar[int]
+    MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    RECEIVED_AT_FIELD_NUMBER: _ClassVar[int]
+    launch_plan: _identifier_pb2.Identifier
+    trigger_name: str
+    event_id: str
+    outcome: TriggerFiringOutcome
+    execution_id: _identifier_pb2.WorkflowExecutionIdentifier
+    message: str
+    received_at: _timestamp_pb2.Timestamp
+    def __init__(self, launch_plan: _Optional[_Union[_identifier_pb2.Identifier, _Mapping]] = ..., trigger_name: _Optional[str] = ..., event_id: _Optional[str] = ..., outcome: _Optional[_Union[TriggerFiringOutcome, str]] = ..., execution_id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., message: _Optional[str] = ..., received_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class TriggerFiringList(_message.Message):
+    __slots__ = ["firings", "token"]
+    FIRINGS_FIELD_NUMBER: _ClassVar[int]
+    TOKEN_FIELD_NUMBER: _ClassVar[int]
+    firings: _containers.RepeatedCompositeFieldContainer[TriggerFiring]
+    token: str
+    def __init__(self, firings: _Optional[_Iterable[_Union[TriggerFiring, _Mapping]]] = ..., token: _Optional[str] = ...) -> None: ...
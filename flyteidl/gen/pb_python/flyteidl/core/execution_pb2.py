@@ -15,7 +15,7 @@ from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1d\x66lyteidl/core/execution.proto\x12\rflyteidl.core\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n\x11WorkflowExecution\"\x91\x01\n\x05Phase\x12\r\n\tUNDEFINED\x10\x00\x12\n\n\x06QUEUED\x10\x01\x12\x0b\n\x07RUNNING\x10\x02\x12\x0e\n\nSUCCEEDING\x10\x03\x12\r\n\tSUCCEEDED\x10\x04\x12\x0b\n\x07\x46\x41ILING\x10\x05\x12\n\n\x06\x46\x41ILED\x10\x06\x12\x0b\n\x07\x41\x42ORTED\x10\x07\x12\r\n\tTIMED_OUT\x10\x08\x12\x0c\n\x08\x41\x42ORTING\x10\t\"\xb6\x01\n\rNodeExecution\"\xa4\x01\n\x05Phase\x12\r\n\tUNDEFINED\x10\x00\x12\n\n\x06QUEUED\x10\x01\x12\x0b\n\x07RUNNING\x10\x02\x12\r\n\tSUCCEEDED\x10\x03\x12\x0b\n\x07\x46\x41ILING\x10\x04\x12\n\n\x06\x46\x41ILED\x10\x05\x12\x0b\n\x07\x41\x42ORTED\x10\x06\x12\x0b\n\x07SKIPPED\x10\x07\x12\r\n\tTIMED_OUT\x10\x08\x12\x13\n\x0f\x44YNAMIC_RUNNING\x10\t\x12\r\n\tRECOVERED\x10\n\"\xac\x01\n\rTaskExecution\"\x9a\x01\n\x05Phase\x12\r\n\tUNDEFINED\x10\x00\x12\n\n\x06QUEUED\x10\x01\x12\x0b\n\x07RUNNING\x10\x02\x12\r\n\tSUCCEEDED\x10\x03\x12\x0b\n\x07\x41\x42ORTED\x10\x04\x12\n\n\x06\x46\x41ILED\x10\x05\x12\x10\n\x0cINITIALIZING\x10\x06\x12\x19\n\x15WAITING_FOR_RESOURCES\x10\x07\x12\x14\n\x10RETRYABLE_FAILED\x10\x08\"\x9a\x02\n\x0e\x45xecutionError\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12\x18\n\x07message\x18\x02 \x01(\tR\x07message\x12\x1b\n\terror_uri\x18\x03 \x01(\tR\x08\x65rrorUri\x12;\n\x04kind\x18\x04 \x01(\x0e\x32\'.flyteidl.core.ExecutionError.ErrorKindR\x04kind\x12\x38\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n\x06worker\x18\x06 \x01(\tR\x06worker\".\n\tErrorKind\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x08\n\x04USER\x10\x01\x12\n\n\x06SYSTEM\x10\x02\"\xb2\x02\n\x07TaskLog\x12\x10\n\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12K\n\x0emessage_format\x18\x03 \x01(\x0e\x32$.flyteidl.core.TaskLog.MessageFormatR\rmessageFormat\x12+\n\x03ttl\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x03ttl\x12*\n\x10ShowWhilePending\x18\x05 \x01(\x08R\x10ShowWhilePending\x12*\n\x10HideOnceFinished\x18\x06 \x01(\x08R\x10HideOnceFinished\"/\n\rMessageFormat\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x07\n\x03\x43SV\x10\x01\x12\x08\n\x04JSON\x10\x02\"h\n\nLogContext\x12\x30\n\x04pods\x18\x01 \x03(\x0b\x32\x1c.flyteidl.core.PodLogContextR\x04pods\x12(\n\x10primary_pod_name\x18\x02 \x01(\tR\x0eprimaryPodName\"\x89\x02\n\rPodLogContext\x12\x1c\n\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x19\n\x08pod_name\x18\x02 \x01(\tR\x07podName\x12?\n\ncontainers\x18\x03 \x03(\x0b\x32\x1f.flyteidl.core.ContainerContextR\ncontainers\x12\x34\n\x16primary_container_name\x18\x04 \x01(\tR\x14primaryContainerName\x12H\n\x0finit_containers\x18\x05 \x03(\x0b\x32\x1f.flyteidl.core.ContainerContextR\x0einitContainers\"\xae\x02\n\x10\x43ontainerContext\x12%\n\x0e\x63ontainer_name\x18\x01 \x01(\tR\rcontainerName\x12H\n\x07process\x18\x02 \x01(\x0b\x32..flyteidl.core.ContainerContext.ProcessContextR\x07process\x1a\xa8\x01\n\x0eProcessContext\x12L\n\x14\x63ontainer_start_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x12\x63ontainerStartTime\x12H\n\x12\x63ontainer_end_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x10\x63ontainerEndTime\"Z\n\x14QualityOfServiceSpec\x12\x42\n\x0fqueueing_budget\x18\x01 \x01(\x0b\x32\x19.google.protobuf.DurationR\x0equeueingBudget\"\xce\x01\n\x10QualityOfService\x12:\n\x04tier\x18\x01 \x01(\x0e\x32$.flyteidl.core.QualityOfService.TierH\x00R\x04tier\x12\x39\n\x04spec\x18\x02 \x01(\x0b\x32#.flyteidl.core.QualityOfServiceSpecH\x00R\x04spec\"4\n\x04Tier\x12\r\n\tUNDEFINED\x10\x00\x12\x08\n\x04HIGH\x10\x01\x12\n\n\x06MEDIUM\x10\x02\x12\x07\n\x03LOW\x10\x03\x42\r\n\x0b\x64\x65signationB\xb4\x01\n\x11\x63om.flyteidl.coreB\x0e\x45xecutionProtoP\x01Z:github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core\xa2\x02\x03\x46\x43X\xaa\x02\rFlyteidl.Core\xca\x02\rFlyteidl\\Core\xe2\x02\x19\x46lyteidl\\Core\\GPBMetadata\xea\x02\x0e\x46lyteidl::Coreb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1d\x66lyteidl/core/execution.proto\x12\rflyteidl.core\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\x01\n\x11WorkflowExecution\"\x9d\x01\n\x05Phase\x12\r\n\tUNDEFINED\x10\x00\x12\n\n\x06QUEUED\x10\x01\x12\x0b\n\x07RUNNING\x10\x02\x12\x0e\n\nSUCCEEDING\x10\x03\x12\r\n\tSUCCEEDED\x10\x04\x12\x0b\n\x07\x46\x41ILING\x10\x05\x12\n\n\x06\x46\x41ILED\x10\x06\x12\x0b\n\x07\x41\x42ORTED\x10\x07\x12\r\n\tTIMED_OUT\x10\x08\x12\x0c\n\x08\x41\x42ORTING\x10\t\x12\n\n\x06PAUSED\x10\n\"\xb6\x01\n\rNodeExecution\"\xa4\x01\n\x05Phase\x12\r\n\tUNDEFINED\x10\x00\x12\n\n\x06QUEUED\x10\x01\x12\x0b\n\x07RUNNING\x10\x02\x12\r\n\tSUCCEEDED\x10\x03\x12\x0b\n\x07\x46\x41ILING\x10\x04\x12\n\n\x06\x46\x41ILED\x10\x05\x12\x0b\n\x07\x41\x42ORTED\x10\x06\x12\x0b\n\x07SKIPPED\x10\x07\x12\r\n\tTIMED_OUT\x10\x08\x12\x13\n\x0f\x44YNAMIC_RUNNING\x10\t\x12\r\n\tRECOVERED\x10\n\"\xac\x01\n\rTaskExecution\"\x9a\x01\n\x05Phase\x12\r\n\tUNDEFINED\x10\x00\x12\n\n\x06QUEUED\x10\x01\x12\x0b\n\x07RUNNING\x10\x02\x12\r\n\tSUCCEEDED\x10\x03\x12\x0b\n\x07\x41\x42ORTED\x10\x04\x12\n\n\x06\x46\x41ILED\x10\x05\x12\x10\n\x0cINITIALIZING\x10\x06\x12\x19\n\x15WAITING_FOR_RESOURCES\x10\x07\x12\x14\n\x10RETRYABLE_FAILED\x10\x08\"\x9a\x02\n\x0e\x45xecutionError\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12\x18\n\x07message\x18\x02 \x01(\tR\x07message\x12\x1b\n\terror_uri\x18\x03 \x01(\tR\x08\x65rrorUri\x12;\n\x04kind\x18\x04 \x01(\x0e\x32\'.flyteidl.core.ExecutionError.ErrorKindR\x04kind\x12\x38\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n\x06worker\x18\x06 \x01(\tR\x06worker\".\n\tErrorKind\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x08\n\x04USER\x10\x01\x12\n\n\x06SYSTEM\x10\x02\"\xb2\x02\n\x07TaskLog\x12\x10\n\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12K\n\x0emessage_format\x18\x03 \x01(\x0e\x32$.flyteidl.core.TaskLog.MessageFormatR\rmessageFormat\x12+\n\x03ttl\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x03ttl\x12*\n\x10ShowWhilePending\x18\x05 \x01(\x08R\x10ShowWhilePending\x12*\n\x10HideOnceFinished\x18\x06 \x01(\x08R\x10HideOnceFinished\"/\n\rMessageFormat\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x07\n\x03\x43SV\x10\x01\x12\x08\n\x04JSON\x10\x02\"h\n\nLogContext\x12\x30\n\x04pods\x18\x01 \x03(\x0b\x32\x1c.flyteidl.core.PodLogContextR\x04pods\x12(\n\x10primary_pod_name\x18\x02 \x01(\tR\x0eprimaryPodName\"\x89\x02\n\rPodLogContext\x12\x1c\n\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x19\n\x08pod_name\x18\x02 \x01(\tR\x07podName\x12?\n\ncontainers\x18\x03 \x03(\x0b\x32\x1f.flyteidl.core.ContainerContextR\ncontainers\x12\x34\n\x16primary_container_name\x18\x04 \x01(\tR\x14primaryContainerName\x12H\n\x0finit_containers\x18\x05 \x03(\x0b\x32\x1f.flyteidl.core.ContainerContextR\x0einitContainers\"\xae\x02\n\x10\x43ontainerContext\x12%\n\x0e\x63ontainer_name\x18\x01 \x01(\tR\rcontainerName\x12H\n\x07process\x18\x02 \x01(\x0b\x32..flyteidl.core.ContainerContext.ProcessContextR\x07process\x1a\xa8\x01\n\x0eProcessContext\x12L\n\x14\x63ontainer_start_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x12\x63ontainerStartTime\x12H\n\x12\x63ontainer_end_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x10\x63ontainerEndTime\"Z\n\x14QualityOfServiceSpec\x12\x42\n\x0fqueueing_budget\x18\x01 \x01(\x0b\x32\x19.google.protobuf.DurationR\x0equeueingBudget\"\xce\x01\n\x10QualityOfService\x12:\n\x04tier\x18\x01 \x01(\x0e\x32$.flyteidl.core.QualityOfService.TierH\x00R\x04tier\x12\x39\n\x04spec\x18\x02 \x01(\x0b\x32#.flyteidl.core.QualityOfServiceSpecH\x00R\x04spec\"4\n\x04Tier\x12\r\n\tUNDEFINED\x10\x00\x12\x08\n\x04HIGH\x10\x01\x12\n\n\x06MEDIUM\x10\x02\x12\x07\n\x03LOW\x10\x03\x42\r\n\x0b\x64\x65signationB\xb4\x01\n\x11\x63om.flyteidl.coreB\x0e\x45xecutionProtoP\x01Z:github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core\xa2\x02\x03\x46\x43X\xaa\x02\rFlyteidl.Core\xca\x02\rFlyteidl\\Core\xe2\x02\x19\x46lyteidl\\Core\\GPBMetadata\xea\x02\x0e\x46lyteidl::Coreb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -25,37 +25,37 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'\n\021com.flyteidl.coreB\016ExecutionProtoP\001Z:github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core\242\002\003FCX\252\002\rFlyteidl.Core\312\002\rFlyteidl\\Core\342\002\031Flyteidl\\Core\\GPBMetadata\352\002\016Flyteidl::Core'
   _globals['_WORKFLOWEXECUTION']._serialized_start=114
-  _globals['_WORKFLOWEXECUTION']._serialized_end=281
+  _globals['_WORKFLOWEXECUTION']._serialized_end=293
   _globals['_WORKFLOWEXECUTION_PHASE']._serialized_start=136
-  _globals['_WORKFLOWEXECUTION_PHASE']._serialized_end=281
-  _globals['_NODEEXECUTION']._serialized_start=284
-  _globals['_NODEEXECUTION']._serialized_end=466
-  _globals['_NODEEXECUTION_PHASE']._serialized_start=302
-  _globals['_NODEEXECUTION_PHASE']._serialized_end=466
-  _globals['_TASKEXECUTION']._serialized_start=469
-  _globals['_TASKEXECUTION']._serialized_end=641
-  _globals['_TASKEXECUTION_PHASE']._serialized_start=487
-  _globals['_TASKEXECUTION_PHASE']._serialized_end=641
-  _globals['_EXECUTIONERROR']._serialized_start=644
-  _globals['_EXECUTIONERROR']._serialized_end=926
-  _globals['_EXECUTIONERROR_ERRORKIND']._serialized_start=880
-  _globals['_EXECUTIONERROR_ERRORKIND']._serialized_end=926
-  _globals['_TASKLOG']._serialized_start=929
-  _globals['_TASKLOG']._serialized_end=1235
-  _globals['_TASKLOG_MESSAGEFORMAT']._serialized_start=1188
-  _globals['_TASKLOG_MESSAGEFORMAT']._serialized_end=1235
-  _globals['_LOGCONTEXT']._serialized_start=1237
-  _globals['_LOGCONTEXT']._serialized_end=1341
-  _globals['_PODLOGCONTEXT']._serialized_start=1344
-  _globals['_PODLOGCONTEXT']._serialized_end=1609
-  _globals['_CONTAINERCONTEXT']._serialized_start=1612
-  _globals['_CONTAINERCONTEXT']._serialized_end=1914
-  _globals['_CONTAINERCONTEXT_PROCESSCONTEXT']._serialized_start=1746
-  _globals['_CONTAINERCONTEXT_PROCESSCONTEXT']._serialized_end=1914
-  _globals['_QUALITYOFSERVICESPEC']._serialized_start=1916
-  _globals['_QUALITYOFSERVICESPEC']._serialized_end=2006
-  _globals['_QUALITYOFSERVICE']._serialized_start=2009
-  _globals['_QUALITYOFSERVICE']._serialized_end=2215
-  _globals['_QUALITYOFSERVICE_TIER']._serialized_start=2148
-  _globals['_QUALITYOFSERVICE_TIER']._serialized_end=2200
+  _globals['_WORKFLOWEXECUTION_PHASE']._serialized_end=293
+  _globals['_NODEEXECUTION']._serialized_start=296
+  _globals['_NODEEXECUTION']._serialized_end=478
+  _globals['_NODEEXECUTION_PHASE']._serialized_start=314
+  _globals['_NODEEXECUTION_PHASE']._serialized_end=478
+  _globals['_TASKEXECUTION']._serialized_start=481
+  _globals['_TASKEXECUTION']._serialized_end=653
+  _globals['_TASKEXECUTION_PHASE']._serialized_start=499
+  _globals['_TASKEXECUTION_PHASE']._serialized_end=653
+  _globals['_EXECUTIONERROR']._serialized_start=656
+  _globals['_EXECUTIONERROR']._serialized_end=938
+  _globals['_EXECUTIONERROR_ERRORKIND']._serialized_start=892
+  _globals['_EXECUTIONERROR_ERRORKIND']._serialized_end=938
+  _globals['_TASKLOG']._serialized_start=941
+  _globals['_TASKLOG']._serialized_end=1247
+  _globals['_TASKLOG_MESSAGEFORMAT']._serialized_start=1200
+  _globals['_TASKLOG_MESSAGEFORMAT']._serialized_end=1247
+  _globals['_LOGCONTEXT']._serialized_start=1249
+  _globals['_LOGCONTEXT']._serialized_end=1353
+  _globals['_PODLOGCONTEXT']._serialized_start=1356
+  _globals['_PODLOGCONTEXT']._serialized_end=1621
+  _globals['_CONTAINERCONTEXT']._serialized_start=1624
+  _globals['_CONTAINERCONTEXT']._serialized_end=1926
+  _globals['_CONTAINERCONTEXT_PROCESSCONTEXT']._serialized_start=1758
+  _globals['_CONTAINERCONTEXT_PROCESSCONTEXT']._serialized_end=1926
+  _globals['_QUALITYOFSERVICESPEC']._serialized_start=1928
+  _globals['_QUALITYOFSERVICESPEC']._serialized_end=2018
+  _globals['_QUALITYOFSERVICE']._serialized_start=2021
+  _globals['_QUALITYOFSERVICE']._serialized_end=2227
+  _globals['_QUALITYOFSERVICE_TIER']._serialized_start=2160
+  _globals['_QUALITYOFSERVICE_TIER']._serialized_end=2212
 # @@protoc_insertion_point(module_scope)
@@ -22,6 +22,7 @@ class WorkflowExecution(_message.Message):
         ABORTED: _ClassVar[WorkflowExecution.Phase]
         TIMED_OUT: _ClassVar[WorkflowExecution.Phase]
         ABORTING: _ClassVar[WorkflowExecution.Phase]
+        PAUSED: _ClassVar[WorkflowExecution.Phase]
     UNDEFINED: WorkflowExecution.Phase
     QUEUED: WorkflowExecution.Phase
     RUNNING: WorkflowExecution.Phase
@@ -32,6 +33,7 @@ class WorkflowExecution(_message.Message):
     ABORTED: WorkflowExecution.Phase
     TIMED_OUT: WorkflowExecution.Phase
     ABORTING: WorkflowExecution.Phase
+    PAUSED: WorkflowExecution.Phase
     def __init__(self) -> None: ...
 
 class NodeExecution(_message.Message):
@@ -14,6 +14,8 @@ from flyteidl.admin import task_execution_pb2 as _task_execution_pb2
 from flyteidl.admin import version_pb2 as _version_pb2
 from flyteidl.admin import common_pb2 as _common_pb2
 from flyteidl.admin import description_entity_pb2 as _description_entity_pb2
+from flyteidl.admin import trigger_pb2 as _trigger_pb2
+from flyteidl.admin import promotion_pb2 as _promotion_pb2
 from protoc_gen_openapiv2.options import annotations_pb2 as _annotations_pb2_1
 from google.protobuf import descriptor as _descriptor
 from typing import ClassVar as _ClassVar
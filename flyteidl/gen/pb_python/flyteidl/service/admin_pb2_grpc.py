@@ -12,8 +12,10 @@ from flyteidl.admin import node_execution_pb2 as flyteidl_dot_admin_dot_node__ex
 from flyteidl.admin import project_attributes_pb2 as flyteidl_dot_admin_dot_project__attributes__pb2
 from flyteidl.admin import project_domain_attributes_pb2 as flyteidl_dot_admin_dot_project__domain__attributes__pb2
 from flyteidl.admin import project_pb2 as flyteidl_dot_admin_dot_project__pb2
+from flyteidl.admin import promotion_pb2 as flyteidl_dot_admin_dot_promotion__pb2
 from flyteidl.admin import task_execution_pb2 as flyteidl_dot_admin_dot_task__execution__pb2
 from flyteidl.admin import task_pb2 as flyteidl_dot_admin_dot_task__pb2
+from flyteidl.admin import trigger_pb2 as flyteidl_dot_admin_dot_trigger__pb2
 from flyteidl.admin import version_pb2 as flyteidl_dot_admin_dot_version__pb2
 from flyteidl.admin import workflow_attributes_pb2 as flyteidl_dot_admin_dot_workflow__attributes__pb2
 from flyteidl.admin import workflow_pb2 as flyteidl_dot_admin_dot_workflow__pb2
@@ -70,6 +72,16 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_workflow__pb2.WorkflowList.FromString,
                 )
+        self.PromoteWorkflow = channel.unary_unary(
+                '/flyteidl.service.AdminService/PromoteWorkflow',
+                request_serializer=flyteidl_dot_admin_dot_promotion__pb2.WorkflowPromoteRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_promotion__pb2.WorkflowPromoteResponse.FromString,
+                )
+        self.GetPromotion = channel.unary_unary(
+                '/flyteidl.service.AdminService/GetPromotion',
+                request_serializer=flyteidl_dot_admin_dot_common__pb2.ObjectGetRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_promotion__pb2.Promotion.FromString,
+                )
         self.CreateLaunchPlan = channel.unary_unary(
                 '/flyteidl.service.AdminService/CreateLaunchPlan',
                 request_serializer=flyteidl_dot_admin_dot_launch__plan__pb2.LaunchPlanCreateRequest.SerializeToString,
@@ -100,6 +112,11 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_launch__plan__pb2.LaunchPlanList.FromString,
                 )
+        self.ListTriggerFirings = channel.unary_unary(
+                '/flyteidl.service.AdminService/ListTriggerFirings',
+                request_serializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_trigger__pb2.TriggerFiringList.FromString,
+                )
         self.UpdateLaunchPlan = channel.unary_unary(
                 '/flyteidl.service.AdminService/UpdateLaunchPlan',
                 request_serializer=flyteidl_dot_admin_dot_launch__plan__pb2.LaunchPlanUpdateRequest.SerializeToString,
@@ -145,6 +162,16 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionTerminateRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionTerminateResponse.FromString,
                 )
+        self.PauseExecution = channel.unary_unary(
+                '/flyteidl.service.AdminService/PauseExecution',
+                request_serializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionPauseRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionPauseResponse.FromString,
+                )
+        self.ResumeExecution = channel.unary_unary(
+                '/flyteidl.service.AdminService/ResumeExecution',
+                request_serializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionResumeRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionResumeResponse.FromString,
+                )
         self.GetNodeExecution = channel.unary_unary(
                 '/flyteidl.service.AdminService/GetNodeExecution',
                 request_serializer=flyteidl_dot_admin_dot_node__execution__pb2.NodeExecutionGetRequest.SerializeToString,
@@ -310,6 +337,11 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsResponse.FromString,
                 )
+        self.WatchExecution = channel.unary_stream(
+                '/flyteidl.service.AdminService/WatchExecution',
+                request_serializer=flyteidl_dot_admin_dot_execution__pb2.WatchExecutionRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_execution__pb2.WatchExecutionResponse.FromString,
+                )
 
 
 class AdminServiceServicer(object):
@@ -373,6 +405,20 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def PromoteWorkflow(self, request, context):
+        """Promotes a :ref:`ref_flyteidl.admin.Workflow` version, with its tasks, subworkflows and launch plans, to another project and domain.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPromotion(self, request, context):
+        """Fetch the :ref:`ref_flyteidl.admin.Promotion` which registered an entity.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def CreateLaunchPlan(self, request, context):
         """Create and upload a :ref:`ref_flyteidl.admin.LaunchPlan` definition
         """
@@ -415,6 +461,13 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ListTriggerFirings(self, request, context):
+        """Fetch a list of :ref:`ref_flyteidl.admin.TriggerFiring` of the triggers of a launch plan.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def UpdateLaunchPlan(self, request, context):
         """Updates the status of a registered :ref:`ref_flyteidl.admin.LaunchPlan`.
         """
@@ -482,6 +535,20 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def PauseExecution(self, request, context):
+        """Pauses an in-progress :ref:`ref_flyteidl.admin.Execution`, no new nodes of which are started until it is resumed.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ResumeExecution(self, request, context):
+        """Resumes a paused :ref:`ref_flyteidl.admin.Execution`.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetNodeExecution(self, request, context):
         """Fetches a :ref:`ref_flyteidl.admin.NodeExecution`.
         """
@@ -713,6 +780,14 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def WatchExecution(self, request, context):
+        """Streams the phase changes of a :ref:`ref_flyteidl.admin.Execution`, of its nodes and of their tasks as they are
+        recorded, optionally limited to the subtree of a node.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_AdminServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -756,6 +831,16 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_workflow__pb2.WorkflowList.SerializeToString,
             ),
+            'PromoteWorkflow': grpc.unary_unary_rpc_method_handler(
+                    servicer.PromoteWorkflow,
+                    request_deserializer=flyteidl_dot_admin_dot_promotion__pb2.WorkflowPromoteRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_promotion__pb2.WorkflowPromoteResponse.SerializeToString,
+            ),
+            'GetPromotion': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPromotion,
+                    request_deserializer=flyteidl_dot_admin_dot_common__pb2.ObjectGetRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_promotion__pb2.Promotion.SerializeToString,
+            ),
             'CreateLaunchPlan': grpc.unary_unary_rpc_method_handler(
                     servicer.CreateLaunchPlan,
                     request_deserializer=flyteidl_dot_admin_dot_launch__plan__pb2.LaunchPlanCreateRequest.FromString,
@@ -786,6 +871,11 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_launch__plan__pb2.LaunchPlanList.SerializeToString,
             ),
+            'ListTriggerFirings': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListTriggerFirings,
+                    request_deserializer=flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_trigger__pb2.TriggerFiringList.SerializeToString,
+            ),
             'UpdateLaunchPlan': grpc.unary_unary_rpc_method_handler(
                     servicer.UpdateLaunchPlan,
                     request_deserializer=flyteidl_dot_admin_dot_launch__plan__pb2.LaunchPlanUpdateRequest.FromString,
@@ -831,6 +921,16 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionTerminateRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionTerminateResponse.SerializeToString,
             ),
+            'PauseExecution': grpc.unary_unary_rpc_method_handler(
+                    servicer.PauseExecution,
+                    request_deserializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionPauseRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionPauseResponse.SerializeToString,
+            ),
+            'ResumeExecution': grpc.unary_unary_rpc_method_handler(
+                    servicer.ResumeExecution,
+                    request_deserializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionResumeRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_execution__pb2.ExecutionResumeResponse.SerializeToString,
+            ),
             'GetNodeExecution': grpc.unary_unary_rpc_method_handler(
                     servicer.GetNodeExecution,
                     request_deserializer=flyteidl_dot_admin_dot_node__execution__pb2.NodeExecutionGetRequest.FromString,
@@ -996,6 +1096,11 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsResponse.SerializeToString,
             ),
+            'WatchExecution': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchExecution,
+                    request_deserializer=flyteidl_dot_admin_dot_execution__pb2.WatchExecutionRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_execution__pb2.WatchExecutionResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'flyteidl.service.AdminService', rpc_method_handlers)
@@ -1144,6 +1249,40 @@ class AdminService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def PromoteWorkflow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/PromoteWorkflow',
+            flyteidl_dot_admin_dot_promotion__pb2.WorkflowPromoteRequest.SerializeToString,
+            flyteidl_dot_admin_dot_promotion__pb2.WorkflowPromoteResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPromotion(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/GetPromotion',
+            flyteidl_dot_admin_dot_common__pb2.ObjectGetRequest.SerializeToString,
+            flyteidl_dot_admin_dot_promotion__pb2.Promotion.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def CreateLaunchPlan(request,
             target,
@@ -1246,6 +1385,23 @@ class AdminService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ListTriggerFirings(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/ListTriggerFirings',
+            flyteidl_dot_admin_dot_common__pb2.ResourceListRequest.SerializeToString,
+            flyteidl_dot_admin_dot_trigger__pb2.TriggerFiringList.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def UpdateLaunchPlan(request,
             target,
@@ -1399,6 +1555,40 @@ class AdminService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def PauseExecution(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/PauseExecution',
+            flyteidl_dot_admin_dot_execution__pb2.ExecutionPauseRequest.SerializeToString,
+            flyteidl_dot_admin_dot_execution__pb2.ExecutionPauseResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ResumeExecution(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/ResumeExecution',
+            flyteidl_dot_admin_dot_execution__pb2.ExecutionResumeRequest.SerializeToString,
+            flyteidl_dot_admin_dot_execution__pb2.ExecutionResumeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetNodeExecution(request,
             target,
@@ -1959,3 +2149,20 @@ class AdminService(object):
             flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def WatchExecution(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/flyteidl.service.AdminService/WatchExecution',
+            flyteidl_dot_admin_dot_execution__pb2.WatchExecutionRequest.SerializeToString,
+            flyteidl_dot_admin_dot_execution__pb2.WatchExecutionResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -4,6 +4,7 @@ from google.protobuf import duration_pb2 as _duration_pb2
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from flyteidl.core import identifier_pb2 as _identifier_pb2
 from flyteidl.core import literals_pb2 as _literals_pb2
+from flyteidl.core import types_pb2 as _types_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
@@ -117,3 +118,31 @@ class GetDataResponse(_message.Message):
     pre_signed_urls: PreSignedURLs
     literal: _literals_pb2.Literal
     def __init__(self, literal_map: _Optional[_Union[_literals_pb2.LiteralMap, _Mapping]] = ..., pre_signed_urls: _Optional[_Union[PreSignedURLs, _Mapping]] = ..., literal: _Optional[_Union[_literals_pb2.Literal, _Mapping]] = ...) -> None: ...
+
+class PreviewStructuredDatasetRequest(_message.Message):
+    __slots__ = ["flyte_url", "limit", "columns"]
+    FLYTE_URL_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    COLUMNS_FIELD_NUMBER: _ClassVar[int]
+    flyte_url: str
+    limit: int
+    columns: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, flyte_url: _Optional[str] = ..., limit: _Optional[int] = ..., columns: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class StructuredDatasetRow(_message.Message):
+    __slots__ = ["values"]
+    VALUES_FIELD_NUMBER: _ClassVar[int]
+    values: _containers.RepeatedCompositeFieldContainer[_literals_pb2.Scalar]
+    def __init__(self, values: _Optional[_Iterable[_Union[_literals_pb2.Scalar, _Mapping]]] = ...) -> None: ...
+
+class PreviewStructuredDatasetResponse(_message.Message):
+    __slots__ = ["schema", "rows", "truncated", "omitted_columns"]
+    SCHEMA_FIELD_NUMBER: _ClassVar[int]
+    ROWS_FIELD_NUMBER: _ClassVar[int]
+    TRUNCATED_FIELD_NUMBER: _ClassVar[int]
+    OMITTED_COLUMNS_FIELD_NUMBER: _ClassVar[int]
+    schema: _types_pb2.StructuredDatasetType
+    rows: _containers.RepeatedCompositeFieldContainer[StructuredDatasetRow]
+    truncated: bool
+    omitted_columns: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, schema: _Optional[_Union[_types_pb2.StructuredDatasetType, _Mapping]] = ..., rows: _Optional[_Iterable[_Union[StructuredDatasetRow, _Mapping]]] = ..., truncated: bool = ..., omitted_columns: _Optional[_Iterable[str]] = ...) -> None: ...
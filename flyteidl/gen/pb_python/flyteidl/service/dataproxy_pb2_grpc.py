@@ -35,6 +35,11 @@ class DataProxyServiceStub(object):
                 request_serializer=flyteidl_dot_service_dot_dataproxy__pb2.GetDataRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_service_dot_dataproxy__pb2.GetDataResponse.FromString,
                 )
+        self.PreviewStructuredDataset = channel.unary_unary(
+                '/flyteidl.service.DataProxyService/PreviewStructuredDataset',
+                request_serializer=flyteidl_dot_service_dot_dataproxy__pb2.PreviewStructuredDatasetRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_service_dot_dataproxy__pb2.PreviewStructuredDatasetResponse.FromString,
+                )
 
 
 class DataProxyServiceServicer(object):
@@ -68,6 +73,13 @@ class DataProxyServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def PreviewStructuredDataset(self, request, context):
+        """PreviewStructuredDataset reads the first rows and the column schema of a structured dataset.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DataProxyServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -91,6 +103,11 @@ def add_DataProxyServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_service_dot_dataproxy__pb2.GetDataRequest.FromString,
                     response_serializer=flyteidl_dot_service_dot_dataproxy__pb2.GetDataResponse.SerializeToString,
             ),
+            'PreviewStructuredDataset': grpc.unary_unary_rpc_method_handler(
+                    servicer.PreviewStructuredDataset,
+                    request_deserializer=flyteidl_dot_service_dot_dataproxy__pb2.PreviewStructuredDatasetRequest.FromString,
+                    response_serializer=flyteidl_dot_service_dot_dataproxy__pb2.PreviewStructuredDatasetResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'flyteidl.service.DataProxyService', rpc_method_handlers)
@@ -169,3 +186,20 @@ class DataProxyService(object):
             flyteidl_dot_service_dot_dataproxy__pb2.GetDataResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PreviewStructuredDataset(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.DataProxyService/PreviewStructuredDataset',
+            flyteidl_dot_service_dot_dataproxy__pb2.PreviewStructuredDatasetRequest.SerializeToString,
+            flyteidl_dot_service_dot_dataproxy__pb2.PreviewStructuredDatasetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
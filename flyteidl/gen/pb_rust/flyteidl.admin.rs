@@ -1480,6 +1480,28 @@ pub struct ExecutionRecoverRequest {
     /// Additional metadata which will be used to overwrite any metadata in the reference execution when triggering a recovery execution.
     #[prost(message, optional, tag="3")]
     pub metadata: ::core::option::Option<ExecutionMetadata>,
+    /// Re-runs a node of the workflow and all the nodes downstream of it instead of recovering them, even if they
+    /// succeeded in the reference execution.
+    /// +optional
+    #[prost(message, optional, tag="4")]
+    pub rerun_from: ::core::option::Option<RerunFromNode>,
+    /// Launch plan to execute in place of the one of the reference execution, e.g. a version of it registered with
+    /// fixed tasks. The ids of the nodes to recover must be the same in both workflows.
+    /// +optional
+    #[prost(message, optional, tag="5")]
+    pub launch_plan: ::core::option::Option<super::core::Identifier>,
+}
+/// Selects the node a recovered execution re-runs from.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RerunFromNode {
+    /// Id of a node of the workflow, which must not be nested in a sub-workflow or a branch.
+    #[prost(string, tag="1")]
+    pub node_id: ::prost::alloc::string::String,
+    /// Inputs overriding the ones the node is bound to. The inputs missing from it are resolved as usual.
+    /// +optional
+    #[prost(message, optional, tag="2")]
+    pub inputs: ::core::option::Option<super::core::LiteralMap>,
 }
 /// The unique identifier for a successfully created execution.
 /// If the name was *not* specified in the create request, this identifier will include a generated name.
@@ -1671,6 +1693,9 @@ pub struct ExecutionMetadata {
     /// since we don't have a structure to handle nested ones anyways.
     #[prost(message, repeated, tag="18")]
     pub artifact_ids: ::prost::alloc::vec::Vec<super::core::ArtifactId>,
+    /// Optional, the node a recovered execution re-runs from, with all the nodes downstream of it.
+    #[prost(message, optional, tag="20")]
+    pub rerun_from: ::core::option::Option<RerunFromNode>,
 }
 /// Nested message and enum types in `ExecutionMetadata`.
 pub mod execution_metadata {
@@ -1835,6 +1860,36 @@ pub struct ExecutionTerminateRequest {
 #[derive(Clone, Copy, PartialEq, ::prost::Message)]
 pub struct ExecutionTerminateResponse {
 }
+/// Request to pause a running execution with the given identifier.
+/// While paused, no new nodes of the execution are started and the phase "PAUSED" is recorded for it.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExecutionPauseRequest {
+    /// Uniquely identifies the individual workflow execution to be paused.
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::WorkflowExecutionIdentifier>,
+    /// Aborts the running nodes of the execution, to re-queue them once it is resumed, instead of letting them finish.
+    #[prost(bool, tag="2")]
+    pub abort_running_nodes: bool,
+}
+/// Purposefully empty, may be populated in the future.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct ExecutionPauseResponse {
+}
+/// Request to resume a paused execution with the given identifier.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExecutionResumeRequest {
+    /// Uniquely identifies the individual workflow execution to be resumed.
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::WorkflowExecutionIdentifier>,
+}
+/// Purposefully empty, may be populated in the future.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, Copy, PartialEq, ::prost::Message)]
+pub struct ExecutionResumeResponse {
+}
 /// Request structure to fetch inputs, output and other data produced by an execution.
 /// By default this data is not returned inline in :ref:`ref_flyteidl.admin.WorkflowExecutionGetRequest`
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -1912,6 +1967,83 @@ pub struct WorkflowExecutionGetMetricsResponse {
     #[prost(message, optional, tag="1")]
     pub span: ::core::option::Option<super::core::Span>,
 }
+/// Request to watch the phase changes of a workflow execution, of its nodes and of their tasks.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchExecutionRequest {
+    /// Identifier of the execution to watch.
+    /// +required
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::WorkflowExecutionIdentifier>,
+    /// If set, only the phase changes of this node, of the nodes nested in it and of their tasks are sent.
+    /// +optional
+    #[prost(string, tag="2")]
+    pub node_id: ::prost::alloc::string::String,
+    /// Cursor of the last phase change received, to resume watching after a reconnect. The phase changes persisted
+    /// after it are sent before the new ones. If empty, all the phase changes of the execution are sent.
+    /// +optional
+    #[prost(string, tag="3")]
+    pub cursor: ::prost::alloc::string::String,
+}
+/// Phase change of a workflow execution, of one of its nodes or of one of their tasks.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WatchExecutionResponse {
+    /// Cursor of the phase change, to resume watching after it.
+    #[prost(string, tag="1")]
+    pub cursor: ::prost::alloc::string::String,
+    /// Time at which the phase change occurred.
+    #[prost(message, optional, tag="2")]
+    pub occurred_at: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(oneof="watch_execution_response::Change", tags="3, 4, 5")]
+    pub change: ::core::option::Option<watch_execution_response::Change>,
+}
+/// Nested message and enum types in `WatchExecutionResponse`.
+pub mod watch_execution_response {
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum Change {
+        #[prost(message, tag="3")]
+        WorkflowExecution(super::WorkflowExecutionPhaseChange),
+        #[prost(message, tag="4")]
+        NodeExecution(super::NodeExecutionPhaseChange),
+        #[prost(message, tag="5")]
+        TaskExecution(super::TaskExecutionPhaseChange),
+    }
+}
+/// Phase change of a workflow execution.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkflowExecutionPhaseChange {
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::WorkflowExecutionIdentifier>,
+    #[prost(enumeration="super::core::workflow_execution::Phase", tag="2")]
+    pub phase: i32,
+}
+/// Phase change of a node execution.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct NodeExecutionPhaseChange {
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::NodeExecutionIdentifier>,
+    #[prost(enumeration="super::core::node_execution::Phase", tag="2")]
+    pub phase: i32,
+    /// Id of the node this node is nested in, e.g. a sub-workflow or dynamic node. Empty for the top level nodes.
+    #[prost(string, tag="3")]
+    pub parent_node_id: ::prost::alloc::string::String,
+}
+/// Phase change of a task execution.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TaskExecutionPhaseChange {
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::TaskExecutionIdentifier>,
+    #[prost(enumeration="super::core::task_execution::Phase", tag="2")]
+    pub phase: i32,
+    /// Version of the phase, incremented when the task reports progress without changing phase.
+    #[prost(uint32, tag="3")]
+    pub phase_version: u32,
+}
 /// The state of the execution is used to control its visibility in the UI/CLI.
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
@@ -2019,6 +2151,146 @@ impl FixedRateUnit {
         }
     }
 }
+/// Trigger launches an execution of the active version of a launch plan whenever an event matching it is received.
+/// See :ref:`ref_flyteidl.admin.LaunchPlanMetadata` for more details
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Trigger {
+    /// Name of the trigger, unique among the triggers of a launch plan.
+    #[prost(string, tag="1")]
+    pub name: ::prost::alloc::string::String,
+    /// Maps names of launch plan inputs onto the fields of the event payload holding their values, as dot separated
+    /// paths such as "key" or "data.date". Inputs which are not mapped take their default or fixed values.
+    #[prost(map="string, string", tag="5")]
+    pub inputs: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+    /// Maximum number of executions the trigger launches in an hour. Events received past the limit are recorded and
+    /// dropped. Zero means no limit.
+    #[prost(uint32, tag="6")]
+    pub max_firings_per_hour: u32,
+    /// Events the trigger fires on.
+    #[prost(oneof="trigger::Event", tags="2, 3, 4")]
+    pub event: ::core::option::Option<trigger::Event>,
+}
+/// Nested message and enum types in `Trigger`.
+pub mod trigger {
+    /// Events the trigger fires on.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum Event {
+        #[prost(message, tag="2")]
+        Storage(super::StorageEventTrigger),
+        #[prost(message, tag="3")]
+        Webhook(super::WebhookTrigger),
+        #[prost(message, tag="4")]
+        CloudEvent(super::CloudEventTrigger),
+    }
+}
+/// StorageEventTrigger fires when an object is created at a location matching a pattern. The payload of the event has
+/// the fields uri, bucket, key, size and etag of the created object.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StorageEventTrigger {
+    /// Pattern the uri of created objects must match, such as s3://bucket/prefix/*.csv. A * matches any sequence of
+    /// characters but /, and a ** any sequence of characters.
+    #[prost(string, tag="1")]
+    pub uri_pattern: ::prost::alloc::string::String,
+}
+/// WebhookTrigger fires on POST requests to /triggers/v1/{project}/{domain}/{launch_plan}/{trigger} carrying the token
+/// of the trigger as a bearer token. The payload of the event is the json body of the request.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WebhookTrigger {
+    /// Hex encoded SHA-256 digest of the token of the trigger.
+    #[prost(string, tag="1")]
+    pub token_sha256: ::prost::alloc::string::String,
+}
+/// CloudEventTrigger fires on CloudEvents posted to /triggers/v1/cloudevents with a matching type and source. The
+/// payload of the event is the structured json representation of the CloudEvent, holding its data in the data field.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CloudEventTrigger {
+    /// Type of the events, such as com.example.object.created.
+    #[prost(string, tag="1")]
+    pub r#type: ::prost::alloc::string::String,
+    /// Source of the events. Events from any source match when unset.
+    #[prost(string, tag="2")]
+    pub source: ::prost::alloc::string::String,
+}
+/// TriggerFiring records an event which matched a trigger.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TriggerFiring {
+    /// Version of the launch plan which was active when the event was received.
+    #[prost(message, optional, tag="1")]
+    pub launch_plan: ::core::option::Option<super::core::Identifier>,
+    /// Name of the trigger.
+    #[prost(string, tag="2")]
+    pub trigger_name: ::prost::alloc::string::String,
+    /// Identifier of the event, shared by the deliveries of the same event.
+    #[prost(string, tag="3")]
+    pub event_id: ::prost::alloc::string::String,
+    #[prost(enumeration="TriggerFiringOutcome", tag="4")]
+    pub outcome: i32,
+    /// Execution the event launched.
+    #[prost(message, optional, tag="5")]
+    pub execution_id: ::core::option::Option<super::core::WorkflowExecutionIdentifier>,
+    /// Reason of a failure to launch the execution.
+    #[prost(string, tag="6")]
+    pub message: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="7")]
+    pub received_at: ::core::option::Option<::prost_types::Timestamp>,
+}
+/// TriggerFiringList is a page of the firings of the triggers of a launch plan.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TriggerFiringList {
+    #[prost(message, repeated, tag="1")]
+    pub firings: ::prost::alloc::vec::Vec<TriggerFiring>,
+    /// In the case of multiple pages of results, the server-provided token can be used to fetch the next page
+    /// in a query. If there are no more results, this value will be empty.
+    #[prost(string, tag="2")]
+    pub token: ::prost::alloc::string::String,
+}
+/// TriggerFiringOutcome is what came out of an event matching a trigger.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum TriggerFiringOutcome {
+    Unspecified = 0,
+    /// An execution was launched.
+    Fired = 1,
+    /// The event was already received, and the execution it launched was not launched again.
+    Duplicate = 2,
+    /// The trigger had already launched the maximum number of executions it launches in an hour.
+    RateLimited = 3,
+    /// The execution failed to launch.
+    Failed = 4,
+}
+impl TriggerFiringOutcome {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            TriggerFiringOutcome::Unspecified => "TRIGGER_FIRING_OUTCOME_UNSPECIFIED",
+            TriggerFiringOutcome::Fired => "FIRED",
+            TriggerFiringOutcome::Duplicate => "DUPLICATE",
+            TriggerFiringOutcome::RateLimited => "RATE_LIMITED",
+            TriggerFiringOutcome::Failed => "FAILED",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "TRIGGER_FIRING_OUTCOME_UNSPECIFIED" => Some(Self::Unspecified),
+            "FIRED" => Some(Self::Fired),
+            "DUPLICATE" => Some(Self::Duplicate),
+            "RATE_LIMITED" => Some(Self::RateLimited),
+            "FAILED" => Some(Self::Failed),
+            _ => None,
+        }
+    }
+}
 /// Request to register a launch plan. The included LaunchPlanSpec may have a complete or incomplete set of inputs required
 /// to launch a workflow execution. By default all launch plans are registered in state INACTIVE. If you wish to
 /// set the state to ACTIVE, you must submit a LaunchPlanUpdateRequest, after you have successfully created a launch plan.
@@ -2199,6 +2471,9 @@ pub struct LaunchPlanMetadata {
     /// Additional metadata for how to launch the launch plan
     #[prost(message, optional, tag="3")]
     pub launch_conditions: ::core::option::Option<::prost_types::Any>,
+    /// Triggers launching executions of the active version of the launch plan on events
+    #[prost(message, repeated, tag="4")]
+    pub triggers: ::prost::alloc::vec::Vec<Trigger>,
 }
 /// Request to set the referenced launch plan state to the configured value.
 /// See :ref:`ref_flyteidl.admin.LaunchPlan` for more details
@@ -2950,6 +3225,54 @@ pub struct ProjectDomainAttributesDeleteRequest {
 #[derive(Clone, Copy, PartialEq, ::prost::Message)]
 pub struct ProjectDomainAttributesDeleteResponse {
 }
+/// Request to promote a version of a workflow, with the tasks, subworkflows and launch plans it was registered with, to
+/// another project and domain.
+/// See :ref:`ref_flyteidl.admin.Promotion` for more details
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkflowPromoteRequest {
+    /// Identifier of the workflow version to promote.
+    /// +required
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::Identifier>,
+    /// Project to promote the workflow to. Defaults to the project of the workflow when unset.
+    #[prost(string, tag="2")]
+    pub target_project: ::prost::alloc::string::String,
+    /// Domain to promote the workflow to.
+    /// +required
+    #[prost(string, tag="3")]
+    pub target_domain: ::prost::alloc::string::String,
+    /// Whether to activate the promoted launch plans in the target domain.
+    #[prost(bool, tag="4")]
+    pub activate_launch_plans: bool,
+}
+/// Promotion records where an entity registered by a promotion was promoted from.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct Promotion {
+    /// Identifier of the entity the promotion copied.
+    #[prost(message, optional, tag="1")]
+    pub source: ::core::option::Option<super::core::Identifier>,
+    /// Identifier of the entity in the project and domain it was promoted to.
+    #[prost(message, optional, tag="2")]
+    pub target: ::core::option::Option<super::core::Identifier>,
+    /// Identity of the user who promoted the entity.
+    #[prost(string, tag="3")]
+    pub promoted_by: ::prost::alloc::string::String,
+    /// Time at which the entity was promoted.
+    #[prost(message, optional, tag="4")]
+    pub promoted_at: ::core::option::Option<::prost_types::Timestamp>,
+    /// Whether the target entity was already registered with an identical structure, in which case it was left as is.
+    #[prost(bool, tag="5")]
+    pub existed: bool,
+}
+/// Response of a workflow promotion, holding a promotion for each entity registered in the target project and domain.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkflowPromoteResponse {
+    #[prost(message, repeated, tag="1")]
+    pub promotions: ::prost::alloc::vec::Vec<Promotion>,
+}
 /// SignalGetOrCreateRequest represents a request structure to retrieve or create a signal.
 /// See :ref:`ref_flyteidl.admin.Signal` for more details
 #[allow(clippy::derive_partial_eq_without_eq)]
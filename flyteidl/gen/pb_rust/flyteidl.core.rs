@@ -2011,6 +2011,7 @@ pub mod workflow_execution {
         Aborted = 7,
         TimedOut = 8,
         Aborting = 9,
+        Paused = 10,
     }
     impl Phase {
         /// String value of the enum field names used in the ProtoBuf definition.
@@ -2029,6 +2030,7 @@ pub mod workflow_execution {
                 Phase::Aborted => "ABORTED",
                 Phase::TimedOut => "TIMED_OUT",
                 Phase::Aborting => "ABORTING",
+                Phase::Paused => "PAUSED",
             }
         }
         /// Creates an enum from field names used in the ProtoBuf definition.
@@ -2044,6 +2046,7 @@ pub mod workflow_execution {
                 "ABORTED" => Some(Self::Aborted),
                 "TIMED_OUT" => Some(Self::TimedOut),
                 "ABORTING" => Some(Self::Aborting),
+                "PAUSED" => Some(Self::Paused),
                 _ => None,
             }
         }
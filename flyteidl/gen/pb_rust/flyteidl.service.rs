@@ -246,6 +246,46 @@ pub mod get_data_response {
         Literal(super::super::core::Literal),
     }
 }
+/// Request to preview the first rows of a structured dataset, or of a parquet or CSV blob.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PreviewStructuredDatasetRequest {
+    /// A flyte url addressing a single structured dataset or blob input or output of an execution.
+    /// e.g. flyte://v1/proj/development/execid/n2/0/o/o0
+    #[prost(string, tag="1")]
+    pub flyte_url: ::prost::alloc::string::String,
+    /// The maximum number of rows to return. Defaults to, and is capped at, the limit configured in admin.
+    #[prost(uint32, tag="2")]
+    pub limit: u32,
+    /// The names of the columns to return, in order. All columns are returned if empty.
+    #[prost(string, repeated, tag="3")]
+    pub columns: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+/// A row of a structured dataset preview.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StructuredDatasetRow {
+    /// The values of the row, in the order of the columns of the schema. Null values are set to none_type.
+    #[prost(message, repeated, tag="1")]
+    pub values: ::prost::alloc::vec::Vec<super::core::Scalar>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PreviewStructuredDatasetResponse {
+    /// The schema of the dataset, restricted to the returned columns.
+    #[prost(message, optional, tag="1")]
+    pub schema: ::core::option::Option<super::core::StructuredDatasetType>,
+    /// The first rows of the dataset.
+    #[prost(message, repeated, tag="2")]
+    pub rows: ::prost::alloc::vec::Vec<StructuredDatasetRow>,
+    /// Whether the dataset holds more rows than returned, left out because of the requested limit or because reading
+    /// them would exceed the memory limit of previews.
+    #[prost(bool, tag="3")]
+    pub truncated: bool,
+    /// The top-level columns of the dataset left out of the preview, as nested and repeated columns can't be previewed.
+    #[prost(string, repeated, tag="4")]
+    pub omitted_columns: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
 /// ArtifactType
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
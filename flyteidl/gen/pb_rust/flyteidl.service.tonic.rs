@@ -313,6 +313,64 @@ pub mod admin_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** Promotes a :ref:`ref_flyteidl.admin.Workflow` version, with its tasks, subworkflows and launch plans, to another project and domain.
+*/
+        pub async fn promote_workflow(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::WorkflowPromoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::WorkflowPromoteResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/PromoteWorkflow",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("flyteidl.service.AdminService", "PromoteWorkflow"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** Fetch the :ref:`ref_flyteidl.admin.Promotion` which registered an entity.
+*/
+        pub async fn get_promotion(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::ObjectGetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::Promotion>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/GetPromotion",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("flyteidl.service.AdminService", "GetPromotion"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /** Create and upload a :ref:`ref_flyteidl.admin.LaunchPlan` definition
 */
         pub async fn create_launch_plan(
@@ -501,6 +559,38 @@ pub mod admin_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** Fetch a list of :ref:`ref_flyteidl.admin.TriggerFiring` of the triggers of a launch plan.
+*/
+        pub async fn list_trigger_firings(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::ResourceListRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::TriggerFiringList>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/ListTriggerFirings",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "flyteidl.service.AdminService",
+                        "ListTriggerFirings",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /** Updates the status of a registered :ref:`ref_flyteidl.admin.LaunchPlan`.
 */
         pub async fn update_launch_plan(
@@ -781,6 +871,64 @@ pub mod admin_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** Pauses an in-progress :ref:`ref_flyteidl.admin.Execution`, no new nodes of which are started until it is resumed.
+*/
+        pub async fn pause_execution(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::ExecutionPauseRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::ExecutionPauseResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/PauseExecution",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("flyteidl.service.AdminService", "PauseExecution"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /** Resumes a paused :ref:`ref_flyteidl.admin.Execution`.
+*/
+        pub async fn resume_execution(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::ExecutionResumeRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::ExecutionResumeResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/ResumeExecution",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("flyteidl.service.AdminService", "ResumeExecution"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /** Fetches a :ref:`ref_flyteidl.admin.NodeExecution`.
 */
         pub async fn get_node_execution(
@@ -1840,6 +1988,38 @@ pub mod admin_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** Streams the phase changes of a :ref:`ref_flyteidl.admin.Execution`, of its nodes and of their tasks as they are
+ recorded, optionally limited to the subtree of a node.
+*/
+        pub async fn watch_execution(
+            &mut self,
+            request: impl tonic::IntoRequest<super::super::admin::WatchExecutionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<
+                tonic::codec::Streaming<super::super::admin::WatchExecutionResponse>,
+            >,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/WatchExecution",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("flyteidl.service.AdminService", "WatchExecution"),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -1925,6 +2105,24 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::WorkflowList>,
             tonic::Status,
         >;
+        /** Promotes a :ref:`ref_flyteidl.admin.Workflow` version, with its tasks, subworkflows and launch plans, to another project and domain.
+*/
+        async fn promote_workflow(
+            &self,
+            request: tonic::Request<super::super::admin::WorkflowPromoteRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::WorkflowPromoteResponse>,
+            tonic::Status,
+        >;
+        /** Fetch the :ref:`ref_flyteidl.admin.Promotion` which registered an entity.
+*/
+        async fn get_promotion(
+            &self,
+            request: tonic::Request<super::super::admin::ObjectGetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::Promotion>,
+            tonic::Status,
+        >;
         /** Create and upload a :ref:`ref_flyteidl.admin.LaunchPlan` definition
 */
         async fn create_launch_plan(
@@ -1981,6 +2179,15 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::LaunchPlanList>,
             tonic::Status,
         >;
+        /** Fetch a list of :ref:`ref_flyteidl.admin.TriggerFiring` of the triggers of a launch plan.
+*/
+        async fn list_trigger_firings(
+            &self,
+            request: tonic::Request<super::super::admin::ResourceListRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::TriggerFiringList>,
+            tonic::Status,
+        >;
         /** Updates the status of a registered :ref:`ref_flyteidl.admin.LaunchPlan`.
 */
         async fn update_launch_plan(
@@ -2066,6 +2273,24 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::ExecutionTerminateResponse>,
             tonic::Status,
         >;
+        /** Pauses an in-progress :ref:`ref_flyteidl.admin.Execution`, no new nodes of which are started until it is resumed.
+*/
+        async fn pause_execution(
+            &self,
+            request: tonic::Request<super::super::admin::ExecutionPauseRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::ExecutionPauseResponse>,
+            tonic::Status,
+        >;
+        /** Resumes a paused :ref:`ref_flyteidl.admin.Execution`.
+*/
+        async fn resume_execution(
+            &self,
+            request: tonic::Request<super::super::admin::ExecutionResumeRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::ExecutionResumeResponse>,
+            tonic::Status,
+        >;
         /** Fetches a :ref:`ref_flyteidl.admin.NodeExecution`.
 */
         async fn get_node_execution(
@@ -2371,6 +2596,25 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::WorkflowExecutionGetMetricsResponse>,
             tonic::Status,
         >;
+        /// Server streaming response type for the WatchExecution method.
+        type WatchExecutionStream: tonic::codegen::tokio_stream::Stream<
+                Item = std::result::Result<
+                    super::super::admin::WatchExecutionResponse,
+                    tonic::Status,
+                >,
+            >
+            + Send
+            + 'static;
+        /** Streams the phase changes of a :ref:`ref_flyteidl.admin.Execution`, of its nodes and of their tasks as they are
+ recorded, optionally limited to the subtree of a node.
+*/
+        async fn watch_execution(
+            &self,
+            request: tonic::Request<super::super::admin::WatchExecutionRequest>,
+        ) -> std::result::Result<
+            tonic::Response<Self::WatchExecutionStream>,
+            tonic::Status,
+        >;
     }
     /** The following defines an RPC service that is also served over HTTP via grpc-gateway.
  Standard response codes for both are defined here: https://github.com/grpc-ecosystem/grpc-gateway/blob/master/runtime/errors.go
@@ -2844,6 +3088,103 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/PromoteWorkflow" => {
+                    #[allow(non_camel_case_types)]
+                    struct PromoteWorkflowSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<
+                        super::super::admin::WorkflowPromoteRequest,
+                    > for PromoteWorkflowSvc<T> {
+                        type Response = super::super::admin::WorkflowPromoteResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::WorkflowPromoteRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::promote_workflow(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = PromoteWorkflowSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/flyteidl.service.AdminService/GetPromotion" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetPromotionSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<super::super::admin::ObjectGetRequest>
+                    for GetPromotionSvc<T> {
+                        type Response = super::super::admin::Promotion;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::ObjectGetRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::get_promotion(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetPromotionSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/flyteidl.service.AdminService/CreateLaunchPlan" => {
                     #[allow(non_camel_case_types)]
                     struct CreateLaunchPlanSvc<T: AdminService>(pub Arc<T>);
@@ -3145,6 +3486,56 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/ListTriggerFirings" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListTriggerFiringsSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<
+                        super::super::admin::ResourceListRequest,
+                    > for ListTriggerFiringsSvc<T> {
+                        type Response = super::super::admin::TriggerFiringList;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::ResourceListRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::list_trigger_firings(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ListTriggerFiringsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/flyteidl.service.AdminService/UpdateLaunchPlan" => {
                     #[allow(non_camel_case_types)]
                     struct UpdateLaunchPlanSvc<T: AdminService>(pub Arc<T>);
@@ -3591,6 +3982,104 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/PauseExecution" => {
+                    #[allow(non_camel_case_types)]
+                    struct PauseExecutionSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<
+                        super::super::admin::ExecutionPauseRequest,
+                    > for PauseExecutionSvc<T> {
+                        type Response = super::super::admin::ExecutionPauseResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::ExecutionPauseRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::pause_execution(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = PauseExecutionSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/flyteidl.service.AdminService/ResumeExecution" => {
+                    #[allow(non_camel_case_types)]
+                    struct ResumeExecutionSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<
+                        super::super::admin::ExecutionResumeRequest,
+                    > for ResumeExecutionSvc<T> {
+                        type Response = super::super::admin::ExecutionResumeResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::ExecutionResumeRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::resume_execution(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ResumeExecutionSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/flyteidl.service.AdminService/GetNodeExecution" => {
                     #[allow(non_camel_case_types)]
                     struct GetNodeExecutionSvc<T: AdminService>(pub Arc<T>);
@@ -5269,6 +5758,56 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/WatchExecution" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchExecutionSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::ServerStreamingService<
+                        super::super::admin::WatchExecutionRequest,
+                    > for WatchExecutionSvc<T> {
+                        type Response = super::super::admin::WatchExecutionResponse;
+                        type ResponseStream = T::WatchExecutionStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::WatchExecutionRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::watch_execution(&inner, request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = WatchExecutionSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
@@ -7324,6 +7863,38 @@ pub mod data_proxy_service_client {
                 .insert(GrpcMethod::new("flyteidl.service.DataProxyService", "GetData"));
             self.inner.unary(req, path, codec).await
         }
+        /** PreviewStructuredDataset reads the first rows and the column schema of a structured dataset.
+*/
+        pub async fn preview_structured_dataset(
+            &mut self,
+            request: impl tonic::IntoRequest<super::PreviewStructuredDatasetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::PreviewStructuredDatasetResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.DataProxyService/PreviewStructuredDataset",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "flyteidl.service.DataProxyService",
+                        "PreviewStructuredDataset",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -7365,6 +7936,15 @@ pub mod data_proxy_service_server {
             &self,
             request: tonic::Request<super::GetDataRequest>,
         ) -> std::result::Result<tonic::Response<super::GetDataResponse>, tonic::Status>;
+        /** PreviewStructuredDataset reads the first rows and the column schema of a structured dataset.
+*/
+        async fn preview_structured_dataset(
+            &self,
+            request: tonic::Request<super::PreviewStructuredDatasetRequest>,
+        ) -> std::result::Result<
+            tonic::Response<super::PreviewStructuredDatasetResponse>,
+            tonic::Status,
+        >;
     }
     /** DataProxyService defines an RPC Service that allows access to user-data in a controlled manner.
 */
@@ -7643,6 +8223,58 @@ pub mod data_proxy_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.DataProxyService/PreviewStructuredDataset" => {
+                    #[allow(non_camel_case_types)]
+                    struct PreviewStructuredDatasetSvc<T: DataProxyService>(pub Arc<T>);
+                    impl<
+                        T: DataProxyService,
+                    > tonic::server::UnaryService<super::PreviewStructuredDatasetRequest>
+                    for PreviewStructuredDatasetSvc<T> {
+                        type Response = super::PreviewStructuredDatasetResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::PreviewStructuredDatasetRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as DataProxyService>::preview_structured_dataset(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = PreviewStructuredDatasetSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
@@ -6,6 +6,7 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -67,6 +68,17 @@ var (
 		UpdateBaseBackoffDuration:          10,
 		UpdateBackoffRetries:               5,
 		AddTolerationsForExtendedResources: []string{},
+		InterruptiblePreemption: InterruptiblePreemptionConfig{
+			NodeTaints: []string{
+				"aws-node-termination-handler/spot-itn",
+				"cloud.google.com/impending-node-termination",
+				"karpenter.sh/disrupted",
+			},
+			EventReasons: []string{"Preempted"},
+			GracePeriod: config2.Duration{
+				Duration: 30 * time.Second,
+			},
+		},
 	}
 
 	// K8sPluginConfigSection provides a singular top level config section for all plugins.
@@ -220,6 +232,26 @@ type K8sPluginConfig struct {
 	AddTolerationsForExtendedResources []string `json:"add-tolerations-for-extended-resources" pflag:",Name of the extended resources for which tolerations should be added."`
 
 	EnableDistributedErrorAggregation bool `json:"enable-distributed-error-aggregation" pflag:",If true, will aggregate errors of different worker pods for distributed tasks."`
+
+	// InterruptiblePreemption configures how upcoming terminations of nodes running interruptible pods are detected
+	// and handled.
+	InterruptiblePreemption InterruptiblePreemptionConfig `json:"interruptible-preemption" pflag:"-,Graceful preemption handling for interruptible pods."`
+}
+
+// InterruptiblePreemptionConfig allows interruptible pods to be shut down gracefully, as soon as a termination notice
+// for their node is detected, instead of being killed when the node goes away. The pod receives a SIGTERM and has
+// GracePeriod to write a checkpoint, after which the task is retried. Such retries are reported with the Preempted
+// error code and are counted as system failures, so they do not consume user retries.
+type InterruptiblePreemptionConfig struct {
+	Enabled bool `json:"enabled" pflag:",Enables detection of termination notices for interruptible pods."`
+	// Taints put on nodes that are about to be terminated, e.g. by the aws-node-termination-handler or karpenter.
+	// Checking taints requires FlytePropeller to be allowed to list and watch nodes.
+	NodeTaints []string `json:"node-taints" pflag:",Keys of node taints that announce an upcoming termination of the node."`
+	// Reasons of k8s events on the pod that announce an upcoming termination, e.g. Preempted by the scheduler.
+	// Requires the event watcher to be enabled through send-object-events.
+	EventReasons []string `json:"event-reasons" pflag:",Reasons of pod events that announce an upcoming termination of the pod."`
+	// Time the pod has to shut down gracefully after a termination notice is detected.
+	GracePeriod config2.Duration `json:"grace-period" pflag:",Time the pod has to shut down gracefully after a termination notice."`
 }
 
 // FlyteCoPilotConfig specifies configuration for the Flyte CoPilot system. FlyteCoPilot, allows running flytekit-less containers
@@ -246,6 +278,11 @@ type FlyteCoPilotConfig struct {
 	CPU     string `json:"cpu" pflag:",Used to set cpu for co-pilot containers"`
 	Memory  string `json:"memory" pflag:",Used to set memory for co-pilot containers"`
 	Storage string `json:"storage" pflag:",Default storage limit for individual inputs / outputs"`
+	// Watcher used by the co-pilot sidecar to wait for the primary container to start and exit. The kube-api watcher
+	// polls the pod through the kube api, so the service account of the task pods needs permission to get pods in their
+	// namespace. The file watcher wraps the command of the primary container with /bin/sh, so the container has to set a
+	// command and its image has to provide a shell.
+	WatcherType string `json:"watcher-type" pflag:",Watcher used by the co-pilot sidecar to wait for the primary container (signal, file or kube-api). Uses the co-pilot default if empty."`
 }
 
 // GetK8sPluginConfig retrieves the current k8s plugin config or default.
@@ -253,6 +290,38 @@ func GetK8sPluginConfig() *K8sPluginConfig {
 	return K8sPluginConfigSection.GetConfig().(*K8sPluginConfig)
 }
 
+// Validate checks the default resources, and the resources and watcher of co-pilot containers. Reloads with invalid values are
+// rejected.
+func (c *K8sPluginConfig) Validate() error {
+	if c.DefaultCPURequest.Sign() < 0 || c.DefaultMemoryRequest.Sign() < 0 {
+		return fmt.Errorf("default cpu [%v] and memory [%v] must not be negative", c.DefaultCPURequest.String(),
+			c.DefaultMemoryRequest.String())
+	}
+
+	for name, value := range map[string]string{"cpu": c.CoPilot.CPU, "memory": c.CoPilot.Memory, "storage": c.CoPilot.Storage} {
+		if len(value) == 0 {
+			continue
+		}
+
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("invalid co-pilot %v [%v]: %w", name, value, err)
+		}
+	}
+
+	switch c.CoPilot.WatcherType {
+	case "", "signal", "file", "kube-api":
+	default:
+		return fmt.Errorf("unsupported co-pilot watcher-type [%v]", c.CoPilot.WatcherType)
+	}
+
+	if c.UpdateBaseBackoffDuration < 0 || c.UpdateBackoffRetries < 0 {
+		return fmt.Errorf("update-base-backoff-duration [%v] and update-backoff-retries [%v] must not be negative",
+			c.UpdateBaseBackoffDuration, c.UpdateBackoffRetries)
+	}
+
+	return nil
+}
+
 // SetK8sPluginConfig should be used for TESTING ONLY, It Sets current value for the config.
 func SetK8sPluginConfig(cfg *K8sPluginConfig) error {
 	return K8sPluginConfigSection.SetConfig(cfg)
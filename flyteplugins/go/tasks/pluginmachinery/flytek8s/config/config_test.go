@@ -4,9 +4,30 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestGetK8sPluginConfig(t *testing.T) {
 	assert.Equal(t, GetK8sPluginConfig().DefaultCPURequest, defaultCPURequest)
 	assert.Equal(t, GetK8sPluginConfig().DefaultMemoryRequest, defaultMemoryRequest)
 }
+
+func TestK8sPluginConfig_Validate(t *testing.T) {
+	assert.NilError(t, defaultK8sConfig.Validate())
+
+	cfg := defaultK8sConfig
+	cfg.CoPilot.Memory = "a lot"
+	assert.ErrorContains(t, cfg.Validate(), "co-pilot memory")
+
+	cfg = defaultK8sConfig
+	cfg.CoPilot.WatcherType = "poll"
+	assert.ErrorContains(t, cfg.Validate(), "watcher-type")
+
+	cfg = defaultK8sConfig
+	cfg.DefaultCPURequest = resource.MustParse("-1")
+	assert.Assert(t, cfg.Validate() != nil)
+
+	cfg = defaultK8sConfig
+	cfg.UpdateBackoffRetries = -1
+	assert.Assert(t, cfg.Validate() != nil)
+}
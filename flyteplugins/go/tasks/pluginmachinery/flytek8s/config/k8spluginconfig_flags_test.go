@@ -281,6 +281,20 @@ func TestK8sPluginConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_co-pilot.watcher-type", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("co-pilot.watcher-type", testValue)
+			if vString, err := cmdFlags.GetString("co-pilot.watcher-type"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.CoPilot.WatcherType)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 	t.Run("Test_delete-resource-on-finalize", func(t *testing.T) {
 
 		t.Run("Override", func(t *testing.T) {
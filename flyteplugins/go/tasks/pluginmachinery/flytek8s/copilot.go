@@ -24,6 +24,19 @@ import (
 const (
 	flyteSidecarContainerName    = "uploader"
 	flyteDownloaderContainerName = "downloader"
+
+	// Watcher of the co-pilot sidecar that polls the status of the pod through the kube api.
+	coPilotWatcherTypeKubeAPI = "kube-api"
+	coPilotPodNameEnvVar      = "POD_NAME"
+	coPilotPodNamespaceEnvVar = "POD_NAMESPACE"
+
+	// Watcher of the co-pilot sidecar that waits for the marker files written by the entrypoint wrapper of the primary
+	// container.
+	coPilotWatcherTypeFile = "file"
+	// Runs the original command, passed as its args, between writing the start and the exit marker to the directory
+	// passed as $0. The exit marker is written to a temporary file first so that the sidecar never reads it partially.
+	fileWatcherEntrypointScript = `touch "$0/_START" && "$@"; code=$?; ` +
+		`echo "$code" > "$0/_EXIT.tmp" && mv "$0/_EXIT.tmp" "$0/_EXIT"; exit "$code"`
 )
 
 func FlyteCoPilotContainer(name string, cfg config.FlyteCoPilotConfig, args []string, volumeMounts ...v1.VolumeMount) (v1.Container, error) {
@@ -112,6 +125,61 @@ func SidecarCommandArgs(fromLocalPath string, outputPrefix, rawOutputPath storag
 	}, nil
 }
 
+// WatcherCommandArgs returns the args that select how the sidecar waits for the primary container to start and exit.
+// No args are returned if no watcher type is configured, in which case the sidecar uses its default watcher.
+func WatcherCommandArgs(watcherType, primaryContainerName string) []string {
+	if len(watcherType) == 0 {
+		return nil
+	}
+
+	args := []string{
+		"--start-watcher-type",
+		watcherType,
+		"--exit-watcher-type",
+		watcherType,
+	}
+	if watcherType == coPilotWatcherTypeKubeAPI {
+		args = append(args, "--watch-container", primaryContainerName)
+	}
+
+	return args
+}
+
+// Wraps the command of the primary container so that it writes the start and exit markers the file watcher of the
+// sidecar waits for. The original command and args are passed through as the args of the wrapper.
+func addFileWatcherEntrypoint(c *v1.Container, markerDir string) error {
+	if len(c.Command) == 0 {
+		return fmt.Errorf("the %v co-pilot watcher requires the primary container [%v] to set a command",
+			coPilotWatcherTypeFile, c.Name)
+	}
+
+	args := make([]string, 0, len(c.Command)+len(c.Args))
+	args = append(args, c.Command...)
+	args = append(args, c.Args...)
+	c.Command = []string{"/bin/sh", "-c", fileWatcherEntrypointScript, markerDir}
+	c.Args = args
+	return nil
+}
+
+// Exposes the name and namespace of the pod to the sidecar through the downward API, as required by the kube-api
+// watcher.
+func podInfoEnvVars() []v1.EnvVar {
+	return []v1.EnvVar{
+		{
+			Name: coPilotPodNameEnvVar,
+			ValueFrom: &v1.EnvVarSource{
+				FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"},
+			},
+		},
+		{
+			Name: coPilotPodNamespaceEnvVar,
+			ValueFrom: &v1.EnvVarSource{
+				FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+			},
+		},
+	}
+}
+
 func DownloadCommandArgs(fromInputsPath, outputPrefix storage.DataReference, toLocalPath string, format core.DataLoadingConfig_LiteralMapFormat, inputInterface *core.VariableMap) ([]string, error) {
 	if inputInterface == nil {
 		return nil, fmt.Errorf("input Interface is required for CoPilot Downloader")
@@ -201,7 +269,7 @@ func AddCoPilotToContainer(ctx context.Context, cfg config.FlyteCoPilotConfig, c
 	return nil
 }
 
-func AddCoPilotToPod(ctx context.Context, cfg config.FlyteCoPilotConfig, coPilotPod *v1.PodSpec, iFace *core.TypedInterface, taskExecMetadata core2.TaskExecutionMetadata, inputPaths io.InputFilePaths, outputPaths io.OutputFilePaths, pilot *core.DataLoadingConfig) (string, error) {
+func AddCoPilotToPod(ctx context.Context, cfg config.FlyteCoPilotConfig, coPilotPod *v1.PodSpec, primaryContainerName string, iFace *core.TypedInterface, taskExecMetadata core2.TaskExecutionMetadata, inputPaths io.InputFilePaths, outputPaths io.OutputFilePaths, pilot *core.DataLoadingConfig) (string, error) {
 	if pilot == nil || !pilot.GetEnabled() {
 		return "", nil
 	}
@@ -210,6 +278,12 @@ func AddCoPilotToPod(ctx context.Context, cfg config.FlyteCoPilotConfig, coPilot
 	logger.Infof(ctx, "CoPilot Enabled for task [%s]", taskExecMetadata.GetTaskExecutionID().GetID().TaskId.GetName())
 	primaryInitContainerName := ""
 	if iFace != nil {
+		hasOutputs := iFace.GetOutputs() != nil && len(iFace.GetOutputs().GetVariables()) > 0
+		// Streamed inputs are fed by the sidecar, so streaming is only possible when there are outputs to upload.
+		streamInputs := hasOutputs && pilot.GetIoStrategy().GetDownloadMode() == core.IOStrategy_DOWNLOAD_STREAM
+		var streamInputsMount *v1.VolumeMount
+		streamInputsPath := ""
+
 		if iFace.GetInputs() != nil && len(iFace.GetInputs().GetVariables()) > 0 {
 			inPath := cfg.DefaultInputDataPath
 			if pilot.GetInputPath() != "" {
@@ -234,6 +308,11 @@ func AddCoPilotToPod(ctx context.Context, cfg config.FlyteCoPilotConfig, coPilot
 			if err != nil {
 				return primaryInitContainerName, err
 			}
+			if streamInputs {
+				args = append(args, "--download-mode", core.IOStrategy_DOWNLOAD_STREAM.String())
+				streamInputsMount = &inputsVolumeMount
+				streamInputsPath = inPath
+			}
 			downloader, err := FlyteCoPilotContainer(flyteDownloaderContainerName, cfg, args, inputsVolumeMount)
 			if err != nil {
 				return primaryInitContainerName, err
@@ -242,7 +321,7 @@ func AddCoPilotToPod(ctx context.Context, cfg config.FlyteCoPilotConfig, coPilot
 			primaryInitContainerName = downloader.Name
 		}
 
-		if iFace.GetOutputs() != nil && len(iFace.GetOutputs().GetVariables()) > 0 {
+		if hasOutputs {
 			outPath := cfg.DefaultOutputPath
 			if pilot.GetOutputPath() != "" {
 				outPath = pilot.GetOutputPath()
@@ -265,12 +344,39 @@ func AddCoPilotToPod(ctx context.Context, cfg config.FlyteCoPilotConfig, coPilot
 			if err != nil {
 				return primaryInitContainerName, err
 			}
-			sidecar, err := FlyteCoPilotContainer(flyteSidecarContainerName, cfg, args, outputsVolumeMount)
+			if pilot.GetIoStrategy().GetUploadMode() == core.IOStrategy_UPLOAD_EAGER {
+				args = append(args, "--upload-mode", core.IOStrategy_UPLOAD_EAGER.String())
+			}
+			args = append(args, WatcherCommandArgs(cfg.WatcherType, primaryContainerName)...)
+			sidecarVolumeMounts := []v1.VolumeMount{outputsVolumeMount}
+			if streamInputsMount != nil {
+				args = append(args, "--stream-inputs-dir", streamInputsPath)
+				sidecarVolumeMounts = append(sidecarVolumeMounts, *streamInputsMount)
+			}
+			sidecar, err := FlyteCoPilotContainer(flyteSidecarContainerName, cfg, args, sidecarVolumeMounts...)
 			// Make it into sidecar container
 			sidecar.RestartPolicy = ptr.To(v1.ContainerRestartPolicyAlways)
 			if err != nil {
 				return primaryInitContainerName, err
 			}
+			switch cfg.WatcherType {
+			case coPilotWatcherTypeKubeAPI:
+				sidecar.Env = append(sidecar.Env, podInfoEnvVars()...)
+			case coPilotWatcherTypeFile:
+				// The sidecar looks for the markers in the outputs directory, which the primary container mounts too.
+				var primaryContainer *v1.Container
+				for i := range coPilotPod.Containers {
+					if coPilotPod.Containers[i].Name == primaryContainerName {
+						primaryContainer = &coPilotPod.Containers[i]
+					}
+				}
+				if primaryContainer == nil {
+					return primaryInitContainerName, fmt.Errorf("primary container [%v] not found", primaryContainerName)
+				}
+				if err := addFileWatcherEntrypoint(primaryContainer, outPath); err != nil {
+					return primaryInitContainerName, err
+				}
+			}
 			// Let the sidecar container start before the downloader; it will ensure the signal watcher is started before the main container finishes.
 			coPilotPod.InitContainers = append([]v1.Container{sidecar}, coPilotPod.InitContainers...)
 
@@ -149,6 +149,13 @@ func TestDownloadCommandArgs(t *testing.T) {
 	}
 }
 
+func TestWatcherCommandArgs(t *testing.T) {
+	assert.Empty(t, WatcherCommandArgs("", "main"))
+	assert.Equal(t, []string{"--start-watcher-type", "file", "--exit-watcher-type", "file"}, WatcherCommandArgs("file", "main"))
+	assert.Equal(t, []string{"--start-watcher-type", "kube-api", "--exit-watcher-type", "kube-api", "--watch-container", "main"},
+		WatcherCommandArgs("kube-api", "main"))
+}
+
 func TestSidecarCommandArgs(t *testing.T) {
 	_, err := SidecarCommandArgs("", "", "", time.Second*10, nil)
 	assert.Error(t, err)
@@ -508,7 +515,7 @@ func TestAddCoPilotToPod(t *testing.T) {
 			InputPath:  "in",
 			OutputPath: "out",
 		}
-		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, iface, taskMetadata, inputPaths, opath, pilot)
+		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
 		assert.NoError(t, err)
 		assert.Equal(t, "test-downloader", primaryInitContainerName)
 		assert.Equal(t, pod.InitContainers[0].Name, cfg.NamePrefix+flyteSidecarContainerName)
@@ -516,6 +523,100 @@ func TestAddCoPilotToPod(t *testing.T) {
 		assertPodHasCoPilot(t, cfg, pilot, iface, &pod)
 	})
 
+	t.Run("happy-io-strategy", func(t *testing.T) {
+		pod := v1.PodSpec{}
+		iface := &core.TypedInterface{
+			Inputs: &core.VariableMap{
+				Variables: map[string]*core.Variable{
+					"x": {Type: &core.LiteralType{Type: &core.LiteralType_Blob{Blob: &core.BlobType{Dimensionality: core.BlobType_SINGLE}}}},
+				},
+			},
+			Outputs: &core.VariableMap{
+				Variables: map[string]*core.Variable{
+					"o": {Type: &core.LiteralType{Type: &core.LiteralType_Blob{Blob: &core.BlobType{Dimensionality: core.BlobType_SINGLE}}}},
+				},
+			},
+		}
+		pilot := &core.DataLoadingConfig{
+			Enabled:    true,
+			InputPath:  "in",
+			OutputPath: "out",
+			IoStrategy: &core.IOStrategy{
+				DownloadMode: core.IOStrategy_DOWNLOAD_STREAM,
+				UploadMode:   core.IOStrategy_UPLOAD_EAGER,
+			},
+		}
+		_, err := AddCoPilotToPod(ctx, cfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
+		assert.NoError(t, err)
+		sidecar := pod.InitContainers[0]
+		downloader := pod.InitContainers[1]
+		assert.Subset(t, downloader.Args, []string{"--download-mode", "DOWNLOAD_STREAM"})
+		assert.Subset(t, sidecar.Args, []string{"--upload-mode", "UPLOAD_EAGER", "--stream-inputs-dir", "in"})
+		assert.Len(t, sidecar.VolumeMounts, 2)
+		assert.Equal(t, "in", sidecar.VolumeMounts[1].MountPath)
+	})
+
+	t.Run("kube-api-watcher", func(t *testing.T) {
+		pod := v1.PodSpec{}
+		iface := &core.TypedInterface{
+			Outputs: &core.VariableMap{
+				Variables: map[string]*core.Variable{
+					"o": {Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}},
+				},
+			},
+		}
+		pilot := &core.DataLoadingConfig{
+			Enabled:    true,
+			OutputPath: "out",
+		}
+		watcherCfg := cfg
+		watcherCfg.WatcherType = "kube-api"
+		_, err := AddCoPilotToPod(ctx, watcherCfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
+		assert.NoError(t, err)
+		sidecar := pod.InitContainers[0]
+		assert.Subset(t, sidecar.Args, []string{"--start-watcher-type", "kube-api", "--exit-watcher-type", "kube-api", "--watch-container", "main"})
+		assert.Len(t, sidecar.Env, 2)
+		assert.Equal(t, "POD_NAME", sidecar.Env[0].Name)
+		assert.Equal(t, "metadata.name", sidecar.Env[0].ValueFrom.FieldRef.FieldPath)
+		assert.Equal(t, "POD_NAMESPACE", sidecar.Env[1].Name)
+		assert.Equal(t, "metadata.namespace", sidecar.Env[1].ValueFrom.FieldRef.FieldPath)
+	})
+
+	t.Run("file-watcher", func(t *testing.T) {
+		iface := &core.TypedInterface{
+			Outputs: &core.VariableMap{
+				Variables: map[string]*core.Variable{
+					"o": {Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}},
+				},
+			},
+		}
+		pilot := &core.DataLoadingConfig{
+			Enabled:    true,
+			OutputPath: "out",
+		}
+		watcherCfg := cfg
+		watcherCfg.WatcherType = "file"
+
+		pod := v1.PodSpec{Containers: []v1.Container{
+			{Name: "other", Command: []string{"sleep"}},
+			{Name: "main", Command: []string{"python", "-m"}, Args: []string{"main", "--x", "1"}},
+		}}
+		_, err := AddCoPilotToPod(ctx, watcherCfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
+		assert.NoError(t, err)
+		assert.Subset(t, pod.InitContainers[0].Args, []string{"--start-watcher-type", "file", "--exit-watcher-type", "file"})
+		assert.Equal(t, []string{"sleep"}, pod.Containers[0].Command)
+		assert.Equal(t, []string{"/bin/sh", "-c", fileWatcherEntrypointScript, "out"}, pod.Containers[1].Command)
+		assert.Equal(t, []string{"python", "-m", "main", "--x", "1"}, pod.Containers[1].Args)
+
+		pod = v1.PodSpec{Containers: []v1.Container{{Name: "main", Args: []string{"main"}}}}
+		_, err = AddCoPilotToPod(ctx, watcherCfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
+		assert.Error(t, err)
+
+		pod = v1.PodSpec{}
+		_, err = AddCoPilotToPod(ctx, watcherCfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
+		assert.Error(t, err)
+	})
+
 	t.Run("happy-nil-iface", func(t *testing.T) {
 		pod := v1.PodSpec{}
 		pilot := &core.DataLoadingConfig{
@@ -523,7 +624,7 @@ func TestAddCoPilotToPod(t *testing.T) {
 			InputPath:  "in",
 			OutputPath: "out",
 		}
-		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, nil, taskMetadata, inputPaths, opath, pilot)
+		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, "main", nil, taskMetadata, inputPaths, opath, pilot)
 		assert.NoError(t, err)
 		assert.Empty(t, primaryInitContainerName)
 		assertPodHasCoPilot(t, cfg, pilot, nil, &pod)
@@ -544,7 +645,7 @@ func TestAddCoPilotToPod(t *testing.T) {
 			InputPath:  "in",
 			OutputPath: "out",
 		}
-		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, iface, taskMetadata, inputPaths, opath, pilot)
+		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
 		assert.NoError(t, err)
 		assert.Equal(t, "test-downloader", primaryInitContainerName)
 		assertPodHasCoPilot(t, cfg, pilot, iface, &pod)
@@ -564,7 +665,7 @@ func TestAddCoPilotToPod(t *testing.T) {
 			InputPath:  "in",
 			OutputPath: "out",
 		}
-		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, iface, taskMetadata, inputPaths, opath, pilot)
+		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
 		assert.NoError(t, err)
 		assert.Empty(t, primaryInitContainerName)
 		assertPodHasCoPilot(t, cfg, pilot, iface, &pod)
@@ -584,14 +685,14 @@ func TestAddCoPilotToPod(t *testing.T) {
 			InputPath:  "in",
 			OutputPath: "out",
 		}
-		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, iface, taskMetadata, inputPaths, opath, pilot)
+		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
 		assert.NoError(t, err)
 		assert.Empty(t, primaryInitContainerName)
 		assert.Len(t, pod.Volumes, 0)
 	})
 
 	t.Run("nil", func(t *testing.T) {
-		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, nil, nil, taskMetadata, inputPaths, opath, nil)
+		primaryInitContainerName, err := AddCoPilotToPod(ctx, cfg, nil, "main", nil, taskMetadata, inputPaths, opath, nil)
 		assert.NoError(t, err)
 		assert.Empty(t, primaryInitContainerName)
 	})
@@ -614,7 +715,7 @@ func TestAddCoPilotToPod(t *testing.T) {
 			Enabled:    true,
 			OutputPath: "out",
 		}
-		_, err := AddCoPilotToPod(ctx, cfgWithTimeout, &pod, iface, taskMetadata, inputPaths, opath, pilot)
+		_, err := AddCoPilotToPod(ctx, cfgWithTimeout, &pod, "main", iface, taskMetadata, inputPaths, opath, pilot)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(3600), *pod.TerminationGracePeriodSeconds)
 	})
@@ -125,6 +125,22 @@ type PluginAbortOverride interface {
 	OnAbort(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, resource client.Object) (behavior AbortBehavior, err error)
 }
 
+// An optional interface a Plugin can implement to manage k8s resources that are shared by the resources of several
+// tasks, e.g. a long-running cluster that tasks submit jobs to. Shared resources outlive the tasks that use them, so
+// the plugin is responsible for tearing them down.
+type PluginSharedResources interface {
+	// EnsureSharedResources is called right before the resource built by BuildResource is created. It creates or
+	// refreshes the shared resources the task depends on, and may update the resource to reference them.
+	EnsureSharedResources(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, kubeClient client.Client, resource client.Object) error
+
+	// GarbageCollectSharedResources is called periodically to delete the shared resources that are no longer in use.
+	GarbageCollectSharedResources(ctx context.Context, kubeClient client.Client) error
+
+	// SharedResourcesEnabled reports whether the plugin is configured to use shared resources. Shared resources are
+	// only garbage collected if it returns true when the plugin is loaded.
+	SharedResourcesEnabled() bool
+}
+
 // Defines the overridden OnAbort behavior. The resource (by default, the underlying resource, although this
 // can be overridden) can be either patched, updated, or deleted.
 type AbortBehavior struct {
@@ -21,6 +21,9 @@ var (
 type Config struct {
 	// If kubeflow operator doesn't update the status of the task after this timeout, the task will be considered failed.
 	Timeout config.Duration `json:"timeout,omitempty"`
+
+	// Additional rendezvous configuration of elastic PyTorch jobs, e.g. join_timeout or last_call_timeout.
+	ElasticRendezvousConfig map[string]string `json:"elastic-rdzv-config,omitempty" pflag:"-,Additional rendezvous configuration of elastic PyTorch jobs."`
 }
 
 func GetConfig() *Config {
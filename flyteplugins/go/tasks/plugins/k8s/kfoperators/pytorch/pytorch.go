@@ -3,6 +3,7 @@ package pytorch
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
@@ -11,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins"
 	kfplugins "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins/kubeflow"
 	flyteerr "github.com/flyteorg/flyte/flyteplugins/go/tasks/errors"
@@ -18,9 +20,11 @@ import (
 	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	pluginsK8s "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	k8sConfig "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/plugins/k8s/kfoperators/common"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
 )
 
 type pytorchOperatorResourceHandler struct {
@@ -158,6 +162,7 @@ func (p pytorchOperatorResourceHandler) BuildResource(ctx context.Context, taskC
 	}
 
 	if elasticPolicy != nil {
+		setElasticFaultTolerance(workerReplicaSpec, &jobSpec.RunPolicy, elasticPolicy)
 		jobSpec.ElasticPolicy = elasticPolicy
 		// Remove master replica spec if elastic policy is set
 		delete(jobSpec.PyTorchReplicaSpecs, kubeflowv1.PyTorchJobReplicaTypeMaster)
@@ -200,10 +205,103 @@ func ParseElasticConfig(elasticConfig ElasticConfig) *kubeflowv1.ElasticPolicy {
 	}
 }
 
+// Elastic jobs tolerate the loss of workers. Unless another restart policy is set, worker pods that are killed (e.g.
+// because their node went away) are recreated by the training operator and rejoin the rendezvous, instead of failing
+// the whole job and causing a Flyte retry. Pods failing with a permanent exit code still fail the job. The max restarts
+// of the elastic config are used as the restart budget of the job, unless a backoff limit is set in the run policy.
+func setElasticFaultTolerance(workerReplicaSpec *kubeflowv1.ReplicaSpec, runPolicy *kubeflowv1.RunPolicy, elasticPolicy *kubeflowv1.ElasticPolicy) {
+	if workerReplicaSpec.RestartPolicy == "" || workerReplicaSpec.RestartPolicy == kubeflowv1.RestartPolicyNever {
+		workerReplicaSpec.RestartPolicy = kubeflowv1.RestartPolicyExitCode
+	}
+
+	if runPolicy.BackoffLimit == nil && elasticPolicy.MaxRestarts != nil && *elasticPolicy.MaxRestarts > 0 {
+		backoffLimit := *elasticPolicy.MaxRestarts
+		runPolicy.BackoffLimit = &backoffLimit
+	}
+
+	rdzvConfig := common.GetConfig().ElasticRendezvousConfig
+	keys := make([]string, 0, len(rdzvConfig))
+	for key := range rdzvConfig {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		elasticPolicy.RDZVConf = append(elasticPolicy.RDZVConf, kubeflowv1.RDZVConf{Key: key, Value: rdzvConfig[key]})
+	}
+}
+
+// Reports the current and desired world size of an elastic job as the reason of its running phase. The phase version
+// is bumped whenever the world size changes, so that the update is sent as an event.
+func elasticRunningPhaseInfo(pluginContext k8s.PluginContext, app *kubeflowv1.PyTorchJob, phaseInfo pluginsCore.PhaseInfo) (pluginsCore.PhaseInfo, error) {
+	desired := *common.GetReplicaCount(app.Spec.PyTorchReplicaSpecs, kubeflowv1.PyTorchJobReplicaTypeWorker)
+	current := int32(0)
+	if status, ok := app.Status.ReplicaStatuses[kubeflowv1.PyTorchJobReplicaTypeWorker]; ok && status != nil {
+		current = status.Active
+	}
+
+	minReplicas, maxReplicas := desired, desired
+	if app.Spec.ElasticPolicy.MinReplicas != nil {
+		minReplicas = *app.Spec.ElasticPolicy.MinReplicas
+	}
+	if app.Spec.ElasticPolicy.MaxReplicas != nil {
+		maxReplicas = *app.Spec.ElasticPolicy.MaxReplicas
+	}
+	reason := fmt.Sprintf("world size [%d/%d] workers, elastic between [%d] and [%d]", current, desired, minReplicas, maxReplicas)
+
+	pluginState := k8s.PluginState{}
+	if _, err := pluginContext.PluginStateReader().Get(&pluginState); err != nil {
+		return phaseInfo, err
+	}
+
+	version := phaseInfo.Version()
+	if pluginState.Phase == pluginsCore.PhaseRunning && pluginState.PhaseVersion >= version {
+		version = pluginState.PhaseVersion
+		if pluginState.Reason != reason {
+			version++
+		}
+	}
+
+	return pluginsCore.PhaseInfoRunningWithReason(version, reason, phaseInfo.Info()), nil
+}
+
+// With distributed error aggregation, every worker uploads its own error file. The failures of the other workers are
+// usually a consequence of the first one (e.g. timeouts of collective operations), so the error of the worker that
+// failed first is surfaced.
+func withEarliestWorkerError(ctx context.Context, pluginContext k8s.PluginContext, phaseInfo pluginsCore.PhaseInfo) pluginsCore.PhaseInfo {
+	reader, err := ioutils.NewRemoteFileOutputReaderWithErrorAggregationStrategy(ctx, pluginContext.DataStore(),
+		pluginContext.OutputWriter(), 0, k8s.EarliestErrorAggregationStrategy)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to create error reader, err: %v", err)
+		return phaseInfo
+	}
+
+	if isError, err := reader.IsError(ctx); err != nil || !isError {
+		if err != nil {
+			logger.Warnf(ctx, "Failed to look up worker errors, err: %v", err)
+		}
+		return phaseInfo
+	}
+
+	workerErr, err := reader.ReadError(ctx)
+	if err != nil || workerErr.ExecutionError == nil {
+		logger.Warnf(ctx, "Failed to read worker errors, err: %v", err)
+		return phaseInfo
+	}
+
+	return pluginsCore.PhaseInfoFailed(phaseInfo.Phase(), &core.ExecutionError{
+		Code: workerErr.GetCode(),
+		Message: fmt.Sprintf("%s\n\nWorker [%s] failed first:\n%s", phaseInfo.Err().GetMessage(),
+			workerErr.GetWorker(), workerErr.GetMessage()),
+		Kind:      workerErr.GetKind(),
+		Timestamp: workerErr.GetTimestamp(),
+		Worker:    workerErr.GetWorker(),
+	}, phaseInfo.Info())
+}
+
 // Analyses the k8s resource and reports the status as TaskPhase. This call is expected to be relatively fast,
 // any operations that might take a long time (limits are configured system-wide) should be offloaded to the
 // background.
-func (pytorchOperatorResourceHandler) GetTaskPhase(ctx context.Context, pluginContext k8s.PluginContext, resource client.Object) (pluginsCore.PhaseInfo, error) {
+func (p pytorchOperatorResourceHandler) GetTaskPhase(ctx context.Context, pluginContext k8s.PluginContext, resource client.Object) (pluginsCore.PhaseInfo, error) {
 	app, ok := resource.(*kubeflowv1.PyTorchJob)
 	if !ok {
 		return pluginsCore.PhaseInfoUndefined, fmt.Errorf("failed to convert resource data type")
@@ -245,6 +343,17 @@ func (pytorchOperatorResourceHandler) GetTaskPhase(ctx context.Context, pluginCo
 	}
 
 	phaseInfo, err := common.GetPhaseInfo(currentCondition, occurredAt, taskPhaseInfo)
+	if err != nil {
+		return phaseInfo, err
+	}
+
+	if app.Spec.ElasticPolicy != nil && phaseInfo.Phase() == pluginsCore.PhaseRunning {
+		return elasticRunningPhaseInfo(pluginContext, app, phaseInfo)
+	}
+
+	if phaseInfo.Phase().IsFailure() && p.GetProperties().ErrorAggregationStrategy == k8s.EarliestErrorAggregationStrategy {
+		phaseInfo = withEarliestWorkerError(ctx, pluginContext, phaseInfo)
+	}
 
 	phaseVersionUpdateErr := k8s.MaybeUpdatePhaseVersionFromPluginContext(&phaseInfo, &pluginContext)
 	if phaseVersionUpdateErr != nil {
@@ -11,6 +11,7 @@ import (
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	apiv1 "k8s.io/api/core/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -30,6 +31,10 @@ import (
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/plugins/k8s/kfoperators/common"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
 	stdlibUtils "github.com/flyteorg/flyte/flytestdlib/utils"
 )
 
@@ -68,8 +73,20 @@ var (
 
 	jobName      = "the-job"
 	jobNamespace = "pytorch-namespace"
+
+	dataStore *storage.DataStore
 )
 
+func init() {
+	labeled.SetMetricKeys(contextutils.NamespaceKey)
+
+	var err error
+	dataStore, err = storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	if err != nil {
+		panic(err)
+	}
+}
+
 func dummyPytorchCustomObj(workers int32) *plugins.DistributedPyTorchTrainingTask {
 	return &plugins.DistributedPyTorchTrainingTask{
 		Workers: workers,
@@ -140,7 +157,9 @@ func dummyPytorchTaskContext(taskTemplate *core.TaskTemplate, resources *corev1.
 	outputReader.EXPECT().GetRawOutputPrefix().Return("")
 	outputReader.EXPECT().GetCheckpointPrefix().Return("/checkpoint")
 	outputReader.EXPECT().GetPreviousCheckpointsPrefix().Return("/prev")
+	outputReader.EXPECT().GetErrorPath().Return("s3://bucket/data/error.pb")
 	taskCtx.EXPECT().OutputWriter().Return(outputReader)
+	taskCtx.EXPECT().DataStore().Return(dataStore)
 
 	taskReader := &mocks.TaskReader{}
 	taskReader.EXPECT().Read(mock.Anything).Return(taskTemplate, nil)
@@ -354,6 +373,8 @@ func TestBuildResourcePytorchElastic(t *testing.T) {
 	assert.Equal(t, int32(2), *pytorchJob.Spec.ElasticPolicy.MaxReplicas)
 	assert.Equal(t, int32(4), *pytorchJob.Spec.ElasticPolicy.NProcPerNode)
 	assert.Equal(t, kubeflowv1.RDZVBackend("c10d"), *pytorchJob.Spec.ElasticPolicy.RDZVBackend)
+	assert.Equal(t, kubeflowv1.RestartPolicyExitCode, pytorchJob.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].RestartPolicy)
+	assert.Nil(t, pytorchJob.Spec.RunPolicy.BackoffLimit)
 
 	assert.Equal(t, 1, len(pytorchJob.Spec.PyTorchReplicaSpecs))
 	assert.Contains(t, pytorchJob.Spec.PyTorchReplicaSpecs, kubeflowv1.PyTorchJobReplicaTypeWorker)
@@ -379,6 +400,63 @@ func TestBuildResourcePytorchElastic(t *testing.T) {
 	}
 }
 
+func TestBuildResourcePytorchElasticFaultTolerance(t *testing.T) {
+	prevCfg := *common.GetConfig()
+	cfg := prevCfg
+	cfg.ElasticRendezvousConfig = map[string]string{
+		"last_call_timeout": "30",
+		"join_timeout":      "900",
+	}
+	assert.NoError(t, common.SetConfig(&cfg))
+	defer func() {
+		assert.NoError(t, common.SetConfig(&prevCfg))
+	}()
+
+	taskConfigs := []*kfplugins.DistributedPyTorchTrainingTask{
+		{
+			WorkerReplicas: &kfplugins.DistributedPyTorchTrainingReplicaSpec{
+				Replicas: 4,
+			},
+			ElasticConfig: &kfplugins.ElasticConfig{MinReplicas: 2, MaxReplicas: 4, NprocPerNode: 8, MaxRestarts: 3, RdzvBackend: "c10d"},
+		},
+		{
+			WorkerReplicas: &kfplugins.DistributedPyTorchTrainingReplicaSpec{
+				Common: &kfplugins.CommonReplicaSpec{
+					Replicas:      4,
+					RestartPolicy: plugins.RestartPolicy_RESTART_POLICY_ON_FAILURE,
+				},
+			},
+			RunPolicy: &kfplugins.RunPolicy{
+				BackoffLimit: 10,
+			},
+			ElasticConfig: &kfplugins.ElasticConfig{MinReplicas: 2, MaxReplicas: 4, NprocPerNode: 8, MaxRestarts: 3, RdzvBackend: "c10d"},
+		},
+	}
+	expectedRestartPolicies := []kubeflowv1.RestartPolicy{kubeflowv1.RestartPolicyExitCode, kubeflowv1.RestartPolicyOnFailure}
+	expectedBackoffLimits := []int32{3, 10}
+
+	for i, taskConfig := range taskConfigs {
+		t.Run(fmt.Sprintf("Case %d", i+1), func(t *testing.T) {
+			taskTemplate := dummyPytorchTaskTemplate("job", taskConfig)
+			taskTemplate.TaskTypeVersion = 1
+
+			pytorchResourceHandler := pytorchOperatorResourceHandler{}
+			resource, err := pytorchResourceHandler.BuildResource(context.TODO(), dummyPytorchTaskContext(taskTemplate, resourceRequirements, nil, "", k8s.PluginState{}))
+			assert.NoError(t, err)
+
+			pytorchJob, ok := resource.(*kubeflowv1.PyTorchJob)
+			assert.True(t, ok)
+			assert.Equal(t, expectedRestartPolicies[i], pytorchJob.Spec.PyTorchReplicaSpecs[kubeflowv1.PyTorchJobReplicaTypeWorker].RestartPolicy)
+			assert.Equal(t, expectedBackoffLimits[i], *pytorchJob.Spec.RunPolicy.BackoffLimit)
+			assert.Equal(t, int32(3), *pytorchJob.Spec.ElasticPolicy.MaxRestarts)
+			assert.Equal(t, []kubeflowv1.RDZVConf{
+				{Key: "join_timeout", Value: "900"},
+				{Key: "last_call_timeout", Value: "30"},
+			}, pytorchJob.Spec.ElasticPolicy.RDZVConf)
+		})
+	}
+}
+
 func TestBuildResourcePytorch(t *testing.T) {
 	pytorchResourceHandler := pytorchOperatorResourceHandler{}
 
@@ -742,6 +820,106 @@ func TestGetTaskPhaseIncreasePhaseVersion(t *testing.T) {
 	assert.Equal(t, taskPhase.Version(), pluginsCore.DefaultPhaseVersion+1)
 }
 
+func TestGetTaskPhaseElasticWorldSize(t *testing.T) {
+	pytorchResourceHandler := pytorchOperatorResourceHandler{}
+	ctx := context.TODO()
+
+	taskTemplate := dummyPytorchTaskTemplate("job", dummyElasticPytorchCustomObj(4, plugins.ElasticConfig{MinReplicas: 2, MaxReplicas: 4, RdzvBackend: "c10d"}))
+	resource, err := pytorchResourceHandler.BuildResource(ctx, dummyPytorchTaskContext(taskTemplate, resourceRequirements, nil, "", k8s.PluginState{}))
+	assert.NoError(t, err)
+
+	pytorchJob := dummyPytorchJobResource(pytorchResourceHandler, 4, kubeflowv1.JobRunning)
+	pytorchJob.Spec = resource.(*kubeflowv1.PyTorchJob).Spec
+	pytorchJob.Status.ReplicaStatuses = map[kubeflowv1.ReplicaType]*kubeflowv1.ReplicaStatus{
+		kubeflowv1.PyTorchJobReplicaTypeWorker: {Active: 3},
+	}
+	expectedReason := "world size [3/4] workers, elastic between [2] and [4]"
+
+	t.Run("FirstRunning", func(t *testing.T) {
+		taskCtx := dummyPytorchTaskContext(taskTemplate, resourceRequirements, nil, "", k8s.PluginState{Phase: pluginsCore.PhaseQueued})
+		taskPhase, err := pytorchResourceHandler.GetTaskPhase(ctx, taskCtx, pytorchJob)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRunning, taskPhase.Phase())
+		assert.Equal(t, expectedReason, taskPhase.Reason())
+		assert.Equal(t, pluginsCore.DefaultPhaseVersion, taskPhase.Version())
+	})
+
+	t.Run("WorldSizeUnchanged", func(t *testing.T) {
+		taskCtx := dummyPytorchTaskContext(taskTemplate, resourceRequirements, nil, "", k8s.PluginState{
+			Phase:        pluginsCore.PhaseRunning,
+			PhaseVersion: 2,
+			Reason:       expectedReason,
+		})
+		taskPhase, err := pytorchResourceHandler.GetTaskPhase(ctx, taskCtx, pytorchJob)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), taskPhase.Version())
+	})
+
+	t.Run("WorldSizeChanged", func(t *testing.T) {
+		taskCtx := dummyPytorchTaskContext(taskTemplate, resourceRequirements, nil, "", k8s.PluginState{
+			Phase:        pluginsCore.PhaseRunning,
+			PhaseVersion: 2,
+			Reason:       "world size [4/4] workers, elastic between [2] and [4]",
+		})
+		taskPhase, err := pytorchResourceHandler.GetTaskPhase(ctx, taskCtx, pytorchJob)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedReason, taskPhase.Reason())
+		assert.Equal(t, uint32(3), taskPhase.Version())
+	})
+}
+
+func TestGetTaskPhaseEarliestWorkerError(t *testing.T) {
+	pytorchResourceHandler := pytorchOperatorResourceHandler{}
+	ctx := context.TODO()
+
+	config := k8sConfig.GetK8sPluginConfig()
+	config.EnableDistributedErrorAggregation = true
+	defer func() {
+		config.EnableDistributedErrorAggregation = false
+	}()
+
+	taskCtx := dummyPytorchTaskContext(dummyPytorchTaskTemplate("", dummyPytorchCustomObj(2)), resourceRequirements, nil, "", k8s.PluginState{})
+	pytorchJob := dummyPytorchJobResource(pytorchResourceHandler, 2, kubeflowv1.JobFailed)
+
+	t.Run("NoErrorFiles", func(t *testing.T) {
+		taskPhase, err := pytorchResourceHandler.GetTaskPhase(ctx, taskCtx, pytorchJob)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, taskPhase.Phase())
+		assert.Empty(t, taskPhase.Err().GetWorker())
+	})
+
+	t.Run("EarliestWorker", func(t *testing.T) {
+		now := time.Now()
+		for worker, ts := range map[string]time.Time{
+			"the-job-worker-0": now,
+			"the-job-worker-1": now.Add(-time.Minute),
+		} {
+			errorDoc := &core.ErrorDocument{
+				Error: &core.ContainerError{
+					Code:      "USER:RuntimeError",
+					Message:   fmt.Sprintf("%s failed", worker),
+					Kind:      core.ContainerError_RECOVERABLE,
+					Origin:    core.ExecutionError_USER,
+					Timestamp: timestamppb.New(ts),
+					Worker:    worker,
+				},
+			}
+			// The in-memory store lists references under the prefix as if it was a directory
+			ref := storage.DataReference(fmt.Sprintf("s3://bucket/data/error/%s.pb", worker))
+			assert.NoError(t, taskCtx.DataStore().WriteProtobuf(ctx, ref, storage.Options{}, errorDoc))
+		}
+
+		taskPhase, err := pytorchResourceHandler.GetTaskPhase(ctx, taskCtx, pytorchJob)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, taskPhase.Phase())
+		assert.Equal(t, "the-job-worker-1", taskPhase.Err().GetWorker())
+		assert.Equal(t, "USER:RuntimeError", taskPhase.Err().GetCode())
+		assert.Equal(t, core.ExecutionError_USER, taskPhase.Err().GetKind())
+		assert.Contains(t, taskPhase.Err().GetMessage(), "PyTorchJob the-job is failed.")
+		assert.Contains(t, taskPhase.Err().GetMessage(), "Worker [the-job-worker-1] failed first:\nthe-job-worker-1 failed")
+	})
+}
+
 func TestGetLogs(t *testing.T) {
 	assert.NoError(t, logs.SetLogConfig(&logs.LogConfig{
 		IsKubernetesEnabled: true,
@@ -2,6 +2,7 @@ package ray
 
 import (
 	"context"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -24,6 +25,9 @@ var (
 		DashboardHost:            "0.0.0.0",
 		EnableUsageStats:         false,
 		ServiceAccount:           "",
+		WarmClusters: WarmClustersConfig{
+			IdleTimeout: config.Duration{Duration: 10 * time.Minute},
+		},
 		Defaults: DefaultConfig{
 			HeadNode: NodeConfig{
 				StartParameters: map[string]string{
@@ -93,6 +97,22 @@ type Config struct {
 	Defaults             DefaultConfig                 `json:"defaults" pflag:"-,Default configuration for ray jobs"`
 	EnableUsageStats     bool                          `json:"enableUsageStats" pflag:",Enable usage stats for ray jobs. These stats are submitted to usage-stats.ray.io per https://docs.ray.io/en/latest/cluster/usage-stats.html"`
 	ServiceAccount       string                        `json:"serviceAccount" pflag:",The k8s service account to run as"`
+	WarmClusters         WarmClustersConfig            `json:"warmClusters" pflag:"-,Configuration of ray clusters that are shared by the ray tasks of an execution or a project"`
+}
+
+// WarmClustersConfig configures ray clusters that are created on first use and kept running, so that later ray tasks
+// submit their jobs to them instead of starting a cluster of their own.
+type WarmClustersConfig struct {
+	// Enabled allows ray clusters to be shared. Tasks can only opt into a warm cluster if it's set, and shared clusters
+	// are only garbage collected if it's set when propeller starts.
+	Enabled bool `json:"enabled" pflag:"-,Allows ray clusters to be shared by the ray tasks of an execution or a project."`
+
+	// Scope is the default scope ray clusters are shared within. Tasks can override it with the "ray_warm_cluster" key
+	// of their task config. Clusters are not shared by default.
+	Scope WarmClusterScope `json:"scope" pflag:"-,Default scope ray clusters are shared within, one of [execution, project]. Empty disables sharing."`
+
+	// IdleTimeout is how long a shared cluster is kept running after its last job finished.
+	IdleTimeout config.Duration `json:"idleTimeout" pflag:"-,How long a shared ray cluster is kept running after its last job finished."`
 }
 
 type DefaultConfig struct {
@@ -125,8 +125,27 @@ func (rayJobResourceHandler) BuildResource(ctx context.Context, taskCtx pluginsC
 	podSpec.ServiceAccountName = serviceAccountName
 
 	rayjob, err := constructRayJob(taskCtx, &rayJob, objectMeta, *podSpec, headNodeRayStartParams, primaryContainerIdx, *primaryContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := getWarmClusterScope(taskTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope != WarmClusterScopeNone {
+		clusterName, err := warmClusterName(taskCtx, scope, &rayJob, *primaryContainer, serviceAccountName)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = toWarmClusterJob(rayjob, clusterName, scope, *primaryContainer); err != nil {
+			return nil, err
+		}
+	}
 
-	return rayjob, err
+	return rayjob, nil
 }
 
 func constructRayJob(taskCtx pluginsCore.TaskExecutionContext, rayJob *plugins.RayJob, objectMeta *metav1.ObjectMeta, taskPodSpec v1.PodSpec, headNodeRayStartParams map[string]string, primaryContainerIdx int, primaryContainer v1.Container) (*rayv1.RayJob, error) {
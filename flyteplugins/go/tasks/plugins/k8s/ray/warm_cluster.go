@@ -0,0 +1,337 @@
+package ray
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins"
+	flyteerr "github.com/flyteorg/flyte/flyteplugins/go/tasks/errors"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	pluginsUtils "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// WarmClusterScope is the scope a ray cluster is shared within.
+type WarmClusterScope string
+
+const (
+	// WarmClusterScopeNone starts a dedicated ray cluster for every task.
+	WarmClusterScopeNone WarmClusterScope = ""
+	// WarmClusterScopeExecution shares a ray cluster between the ray tasks of an execution. The cluster is torn down
+	// once the execution terminates, or when it has been idle for the configured idle timeout.
+	WarmClusterScopeExecution WarmClusterScope = "execution"
+	// WarmClusterScopeProject shares a ray cluster between the ray tasks of all executions in a project and domain.
+	// The cluster is torn down when it has been idle for the configured idle timeout.
+	WarmClusterScopeProject WarmClusterScope = "project"
+)
+
+const (
+	// The task config key a task can set to override the configured warm cluster scope. "none" disables sharing.
+	warmClusterConfigKey    = "ray_warm_cluster"
+	warmClusterScopeNoneVal = "none"
+	warmClusterNamePrefix   = "flyte-warm-"
+	warmClusterHashLength   = 16
+
+	// Set on the shared RayClusters and on the RayJobs submitted to them. The RayJobs that have not finished yet are
+	// the references that keep a shared cluster alive.
+	warmClusterScopeLabel = "flyte.org/ray-warm-cluster-scope"
+	warmClusterNameLabel  = "flyte.org/ray-warm-cluster"
+	// Updated every time a job is submitted to a shared RayCluster.
+	warmClusterLastUsedAnnotation = "flyte.org/ray-warm-cluster-last-used"
+
+	// KubeRay submits a RayJob to the existing RayCluster with this name, instead of creating a new cluster.
+	rayClusterSelectorKey = "ray.io/cluster"
+
+	// Propeller labels workflows that have terminated, see flytepropeller/pkg/controller/completed_workflows.go.
+	workflowTerminationStatusLabel = "termination-status"
+	workflowTerminatedValue        = "terminated"
+)
+
+// getWarmClusterScope returns the scope the ray cluster of the task is shared within, the task config takes precedence
+// over the plugin config. Clusters are never shared if warm clusters are disabled.
+func getWarmClusterScope(taskTemplate *core.TaskTemplate) (WarmClusterScope, error) {
+	if !GetConfig().WarmClusters.Enabled {
+		return WarmClusterScopeNone, nil
+	}
+
+	value, found := taskTemplate.GetConfig()[warmClusterConfigKey]
+	if !found {
+		return GetConfig().WarmClusters.Scope, nil
+	}
+
+	switch scope := WarmClusterScope(strings.ToLower(value)); scope {
+	case WarmClusterScopeExecution, WarmClusterScopeProject:
+		return scope, nil
+	case warmClusterScopeNoneVal, WarmClusterScopeNone:
+		return WarmClusterScopeNone, nil
+	default:
+		return WarmClusterScopeNone, flyteerr.Errorf(flyteerr.BadTaskSpecification,
+			"invalid value [%s] for task config [%s], expected one of [%s, %s, %s]", value, warmClusterConfigKey,
+			WarmClusterScopeExecution, WarmClusterScopeProject, warmClusterScopeNoneVal)
+	}
+}
+
+// warmClusterName derives the name of the shared cluster from the scope and everything that shapes the cluster, so
+// that tasks only share clusters that they would have created identically.
+func warmClusterName(taskCtx pluginsCore.TaskExecutionContext, scope WarmClusterScope, rayJob *plugins.RayJob,
+	primaryContainer v1.Container, serviceAccountName string) (string, error) {
+
+	rayCluster, err := proto.MarshalOptions{Deterministic: true}.Marshal(rayJob.GetRayCluster())
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ray cluster spec. Error: %w", err)
+	}
+
+	scopeKey := ""
+	if scope == WarmClusterScopeExecution {
+		taskExecID := taskCtx.TaskExecutionMetadata().GetTaskExecutionID().GetID()
+		scopeKey = taskExecID.GetNodeExecutionId().GetExecutionId().GetName()
+	}
+
+	hash := sha256.New()
+	for _, part := range [][]byte{
+		[]byte(scope), []byte(scopeKey), rayCluster, []byte(primaryContainer.Image),
+		[]byte(primaryContainer.Resources.String()), []byte(serviceAccountName),
+	} {
+		// Separate the parts so that different splits of the same bytes do not collide
+		hash.Write(part)
+		hash.Write([]byte{0})
+	}
+
+	return warmClusterNamePrefix + hex.EncodeToString(hash.Sum(nil))[:warmClusterHashLength], nil
+}
+
+// withRuntimeEnvVars adds the environment variables of the task to the env_vars of the runtime environment. A shared
+// cluster is started with the environment of the task that created it, so the job has to carry its own environment.
+// Variables already set in the runtime environment are kept.
+func withRuntimeEnvVars(runtimeEnvYAML string, envVars []v1.EnvVar) (string, error) {
+	runtimeEnv := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(runtimeEnvYAML), &runtimeEnv); err != nil {
+		return "", flyteerr.Errorf(flyteerr.BadTaskSpecification, "invalid runtime env yaml [%v]", err.Error())
+	}
+
+	runtimeEnvVars := map[interface{}]interface{}{}
+	if existing, ok := runtimeEnv["env_vars"].(map[interface{}]interface{}); ok {
+		runtimeEnvVars = existing
+	}
+
+	for _, envVar := range envVars {
+		// Variables sourced from the pod, e.g. its IP, cannot be resolved for a job
+		if envVar.ValueFrom != nil {
+			continue
+		}
+		if _, exists := runtimeEnvVars[envVar.Name]; !exists {
+			runtimeEnvVars[envVar.Name] = envVar.Value
+		}
+	}
+
+	if len(runtimeEnvVars) == 0 {
+		return runtimeEnvYAML, nil
+	}
+
+	runtimeEnv["env_vars"] = runtimeEnvVars
+	y, err := yaml.Marshal(runtimeEnv)
+	if err != nil {
+		return "", err
+	}
+
+	return string(y), nil
+}
+
+// toWarmClusterJob turns the RayJob into a job that is submitted to the shared cluster through the job submission API.
+// The cluster spec is kept on the job until EnsureSharedResources moves it to the shared RayCluster.
+func toWarmClusterJob(rayJob *rayv1.RayJob, clusterName string, scope WarmClusterScope, primaryContainer v1.Container) error {
+	runtimeEnvYAML, err := withRuntimeEnvVars(rayJob.Spec.RuntimeEnvYAML, primaryContainer.Env)
+	if err != nil {
+		return err
+	}
+
+	rayJob.SetLabels(pluginsUtils.UnionMaps(rayJob.GetLabels(), map[string]string{
+		warmClusterScopeLabel: string(scope),
+		warmClusterNameLabel:  clusterName,
+	}))
+	rayJob.Spec.ClusterSelector = map[string]string{rayClusterSelectorKey: clusterName}
+	rayJob.Spec.SubmissionMode = rayv1.HTTPMode
+	rayJob.Spec.SubmitterPodTemplate = nil
+	rayJob.Spec.ShutdownAfterJobFinishes = false
+	rayJob.Spec.TTLSecondsAfterFinished = 0
+	rayJob.Spec.RuntimeEnvYAML = runtimeEnvYAML
+	return nil
+}
+
+// EnsureSharedResources creates the shared RayCluster of a warm cluster job on first use, or marks it as used.
+func (rayJobResourceHandler) EnsureSharedResources(ctx context.Context, _ pluginsCore.TaskExecutionContext, kubeClient client.Client, resource client.Object) error {
+	rayJob, ok := resource.(*rayv1.RayJob)
+	if !ok || len(rayJob.GetLabels()[warmClusterNameLabel]) == 0 || rayJob.Spec.RayClusterSpec == nil {
+		return nil
+	}
+
+	scope := WarmClusterScope(rayJob.GetLabels()[warmClusterScopeLabel])
+	now := time.Now().UTC().Format(time.RFC3339)
+	cluster := &rayv1.RayCluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RayCluster",
+			APIVersion: rayv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        rayJob.GetLabels()[warmClusterNameLabel],
+			Namespace:   rayJob.GetNamespace(),
+			Labels:      map[string]string{warmClusterScopeLabel: string(scope)},
+			Annotations: map[string]string{warmClusterLastUsedAnnotation: now},
+		},
+		Spec: *rayJob.Spec.RayClusterSpec,
+	}
+
+	// Execution scoped clusters are owned by the workflow, so that they do not outlive it
+	if scope == WarmClusterScopeExecution {
+		if owner := metav1.GetControllerOf(rayJob); owner != nil {
+			cluster.SetOwnerReferences([]metav1.OwnerReference{*owner})
+		}
+	}
+
+	err := kubeClient.Create(ctx, cluster)
+	if k8serrors.IsAlreadyExists(err) {
+		existing := &rayv1.RayCluster{}
+		if err = kubeClient.Get(ctx, client.ObjectKeyFromObject(cluster), existing); err != nil {
+			return fmt.Errorf("failed to get shared ray cluster [%s/%s]. Error: %w", cluster.Namespace, cluster.Name, err)
+		}
+
+		// The job would wait for a cluster that is about to disappear, try again once it is gone
+		if !existing.GetDeletionTimestamp().IsZero() {
+			return fmt.Errorf("shared ray cluster [%s/%s] is being deleted", cluster.Namespace, cluster.Name)
+		}
+
+		patched := existing.DeepCopy()
+		patched.SetAnnotations(pluginsUtils.UnionMaps(existing.GetAnnotations(), map[string]string{warmClusterLastUsedAnnotation: now}))
+		err = kubeClient.Patch(ctx, patched, client.MergeFrom(existing))
+	} else if err == nil {
+		logger.Infof(ctx, "Created shared ray cluster [%s/%s] with scope [%s]", cluster.Namespace, cluster.Name, scope)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to ensure shared ray cluster [%s/%s]. Error: %w", cluster.Namespace, cluster.Name, err)
+	}
+
+	rayJob.Spec.RayClusterSpec = nil
+	return nil
+}
+
+// SharedResourcesEnabled reports whether ray clusters can be shared.
+func (rayJobResourceHandler) SharedResourcesEnabled() bool {
+	return GetConfig().WarmClusters.Enabled
+}
+
+// GarbageCollectSharedResources deletes the shared RayClusters that no unfinished RayJob references anymore and that
+// have been idle for longer than the idle timeout, or whose execution has terminated.
+func (rayJobResourceHandler) GarbageCollectSharedResources(ctx context.Context, kubeClient client.Client) error {
+	// The clusters are listed as unstructured objects, which are read from the API server filtered by label rather
+	// than through an informer that would cache every RayCluster of the cluster.
+	clusters := &unstructured.UnstructuredList{}
+	clusters.SetGroupVersionKind(rayv1.SchemeGroupVersion.WithKind("RayClusterList"))
+	if err := kubeClient.List(ctx, clusters, client.HasLabels{warmClusterScopeLabel}); err != nil {
+		return fmt.Errorf("failed to list shared ray clusters. Error: %w", err)
+	}
+
+	idleTimeout := GetConfig().WarmClusters.IdleTimeout.Duration
+	now := time.Now()
+	for i := range clusters.Items {
+		cluster := &rayv1.RayCluster{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(clusters.Items[i].UnstructuredContent(), cluster); err != nil {
+			logger.Warnf(ctx, "Failed to convert shared ray cluster [%s/%s]. Error: %v", clusters.Items[i].GetNamespace(),
+				clusters.Items[i].GetName(), err)
+			continue
+		}
+
+		if !cluster.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		expired, err := isWarmClusterExpired(ctx, kubeClient, cluster, idleTimeout, now)
+		if err != nil {
+			logger.Warnf(ctx, "Failed to check whether shared ray cluster [%s/%s] is in use. Error: %v", cluster.Namespace, cluster.Name, err)
+			continue
+		}
+
+		if !expired {
+			continue
+		}
+
+		logger.Infof(ctx, "Deleting shared ray cluster [%s/%s]", cluster.Namespace, cluster.Name)
+		if err := kubeClient.Delete(ctx, cluster, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+			logger.Warnf(ctx, "Failed to delete shared ray cluster [%s/%s]. Error: %v", cluster.Namespace, cluster.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func isWarmClusterExpired(ctx context.Context, kubeClient client.Client, cluster *rayv1.RayCluster, idleTimeout time.Duration, now time.Time) (bool, error) {
+	jobs := &rayv1.RayJobList{}
+	if err := kubeClient.List(ctx, jobs, client.InNamespace(cluster.Namespace), client.MatchingLabels{warmClusterNameLabel: cluster.Name}); err != nil {
+		return false, err
+	}
+
+	idleSince := cluster.GetCreationTimestamp().Time
+	if lastUsed, err := time.Parse(time.RFC3339, cluster.GetAnnotations()[warmClusterLastUsedAnnotation]); err == nil && lastUsed.After(idleSince) {
+		idleSince = lastUsed
+	}
+
+	for _, job := range jobs.Items {
+		if !isRayJobFinished(&job) {
+			return false, nil
+		}
+		if job.Status.EndTime != nil && job.Status.EndTime.After(idleSince) {
+			idleSince = job.Status.EndTime.Time
+		}
+	}
+
+	if WarmClusterScope(cluster.GetLabels()[warmClusterScopeLabel]) == WarmClusterScopeExecution {
+		terminated, err := isOwnerTerminated(ctx, kubeClient, cluster)
+		if err != nil || terminated {
+			return terminated, err
+		}
+	}
+
+	return now.Sub(idleSince) >= idleTimeout, nil
+}
+
+func isRayJobFinished(job *rayv1.RayJob) bool {
+	return !job.GetDeletionTimestamp().IsZero() ||
+		job.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete ||
+		job.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed
+}
+
+// isOwnerTerminated checks whether the workflow that owns an execution scoped cluster has terminated, either
+// successfully, with a failure or because it was aborted.
+func isOwnerTerminated(ctx context.Context, kubeClient client.Client, cluster *rayv1.RayCluster) (bool, error) {
+	owner := metav1.GetControllerOf(cluster)
+	if owner == nil {
+		return false, nil
+	}
+
+	workflow := &metav1.PartialObjectMetadata{}
+	workflow.SetGroupVersionKind(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
+	err := kubeClient.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: owner.Name}, workflow)
+	if k8serrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return workflow.GetUID() != owner.UID || workflow.GetLabels()[workflowTerminationStatusLabel] == workflowTerminatedValue, nil
+}
@@ -0,0 +1,328 @@
+package ray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	flyteerr "github.com/flyteorg/flyte/flyteplugins/go/tasks/errors"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/errors"
+)
+
+func buildWarmClusterRayJob(t *testing.T, scope string) *rayv1.RayJob {
+	taskTemplate := dummyRayTaskTemplate("ray-id", dummyRayCustomObj())
+	taskTemplate.Config = map[string]string{warmClusterConfigKey: scope}
+	rayCtx := dummyRayTaskContext(taskTemplate, resourceRequirements, nil, "", serviceAccount)
+
+	resource, err := rayJobResourceHandler{}.BuildResource(context.TODO(), rayCtx)
+	require.NoError(t, err)
+	rayJob, ok := resource.(*rayv1.RayJob)
+	require.True(t, ok)
+	return rayJob
+}
+
+func TestBuildResourceRayWarmCluster(t *testing.T) {
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+	prevCfg := *GetConfig()
+	defer func() { assert.NoError(t, SetConfig(&prevCfg)) }()
+	cfg := prevCfg
+	cfg.WarmClusters.Enabled = true
+	assert.NoError(t, SetConfig(&cfg))
+
+	t.Run("execution scope", func(t *testing.T) {
+		rayJob := buildWarmClusterRayJob(t, "execution")
+
+		clusterName := rayJob.Labels[warmClusterNameLabel]
+		assert.Regexp(t, "^flyte-warm-[0-9a-f]{16}$", clusterName)
+		assert.Equal(t, "execution", rayJob.Labels[warmClusterScopeLabel])
+		assert.Equal(t, map[string]string{rayClusterSelectorKey: clusterName}, rayJob.Spec.ClusterSelector)
+		assert.Equal(t, rayv1.HTTPMode, rayJob.Spec.SubmissionMode)
+		assert.Nil(t, rayJob.Spec.SubmitterPodTemplate)
+		assert.False(t, rayJob.Spec.ShutdownAfterJobFinishes)
+		assert.Equal(t, int32(0), rayJob.Spec.TTLSecondsAfterFinished)
+		// The cluster spec is kept until the shared cluster is created
+		assert.NotNil(t, rayJob.Spec.RayClusterSpec)
+
+		runtimeEnv := map[string]interface{}{}
+		assert.NoError(t, yaml.Unmarshal([]byte(rayJob.Spec.RuntimeEnvYAML), &runtimeEnv))
+		envVars, ok := runtimeEnv["env_vars"].(map[interface{}]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Env_Val", envVars["Env_Var"])
+
+		// Tasks of the same execution with the same cluster spec share the cluster
+		assert.Equal(t, clusterName, buildWarmClusterRayJob(t, "execution").Labels[warmClusterNameLabel])
+	})
+
+	t.Run("project scope", func(t *testing.T) {
+		rayJob := buildWarmClusterRayJob(t, "Project")
+		assert.Equal(t, "project", rayJob.Labels[warmClusterScopeLabel])
+		assert.NotEqual(t, buildWarmClusterRayJob(t, "execution").Labels[warmClusterNameLabel], rayJob.Labels[warmClusterNameLabel])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		rayJob := buildWarmClusterRayJob(t, "none")
+		assert.NotContains(t, rayJob.Labels, warmClusterNameLabel)
+		assert.Empty(t, rayJob.Spec.ClusterSelector)
+		assert.True(t, rayJob.Spec.ShutdownAfterJobFinishes)
+	})
+
+	t.Run("warm clusters disabled", func(t *testing.T) {
+		disabledCfg := cfg
+		disabledCfg.WarmClusters.Enabled = false
+		assert.NoError(t, SetConfig(&disabledCfg))
+		defer func() { assert.NoError(t, SetConfig(&cfg)) }()
+
+		rayJob := buildWarmClusterRayJob(t, "execution")
+		assert.NotContains(t, rayJob.Labels, warmClusterNameLabel)
+		assert.False(t, rayJobResourceHandler{}.SharedResourcesEnabled())
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		taskTemplate := dummyRayTaskTemplate("ray-id", dummyRayCustomObj())
+		taskTemplate.Config = map[string]string{warmClusterConfigKey: "cluster"}
+		rayCtx := dummyRayTaskContext(taskTemplate, resourceRequirements, nil, "", serviceAccount)
+
+		_, err := rayJobResourceHandler{}.BuildResource(context.TODO(), rayCtx)
+		assert.True(t, errors.IsCausedBy(err, flyteerr.BadTaskSpecification))
+	})
+}
+
+func TestWithRuntimeEnvVars(t *testing.T) {
+	runtimeEnvYAML, err := withRuntimeEnvVars("pip:\n- numpy\nenv_vars:\n  FOO: user\n", []corev1.EnvVar{
+		{Name: "FOO", Value: "task"},
+		{Name: "BAR", Value: "task"},
+		{Name: "POD_IP", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+	})
+	assert.NoError(t, err)
+
+	runtimeEnv := map[string]interface{}{}
+	assert.NoError(t, yaml.Unmarshal([]byte(runtimeEnvYAML), &runtimeEnv))
+	assert.Equal(t, []interface{}{"numpy"}, runtimeEnv["pip"])
+	assert.Equal(t, map[interface{}]interface{}{"FOO": "user", "BAR": "task"}, runtimeEnv["env_vars"])
+}
+
+func TestEnsureSharedResources(t *testing.T) {
+	ctx := context.TODO()
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+	prevCfg := *GetConfig()
+	defer func() { assert.NoError(t, SetConfig(&prevCfg)) }()
+	cfg := prevCfg
+	cfg.WarmClusters.Enabled = true
+	assert.NoError(t, SetConfig(&cfg))
+	owner := metav1.OwnerReference{
+		APIVersion: "flyte.lyft.com/v1alpha1",
+		Kind:       "FlyteWorkflow",
+		Name:       "my_name",
+		UID:        "workflow-uid",
+		Controller: func() *bool { b := true; return &b }(),
+	}
+
+	newJob := func() *rayv1.RayJob {
+		rayJob := buildWarmClusterRayJob(t, "execution")
+		rayJob.SetNamespace("test-namespace")
+		rayJob.SetOwnerReferences([]metav1.OwnerReference{owner})
+		return rayJob
+	}
+
+	t.Run("creates the shared cluster on first use", func(t *testing.T) {
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		rayJob := newJob()
+		clusterSpec := rayJob.Spec.RayClusterSpec.DeepCopy()
+
+		assert.NoError(t, rayJobResourceHandler{}.EnsureSharedResources(ctx, nil, kubeClient, rayJob))
+		assert.Nil(t, rayJob.Spec.RayClusterSpec)
+
+		cluster := &rayv1.RayCluster{}
+		require.NoError(t, kubeClient.Get(ctx, types.NamespacedName{Namespace: "test-namespace", Name: rayJob.Labels[warmClusterNameLabel]}, cluster))
+		assert.Equal(t, clusterSpec.HeadGroupSpec.RayStartParams, cluster.Spec.HeadGroupSpec.RayStartParams)
+		require.Len(t, clusterSpec.WorkerGroupSpecs, 1)
+		require.Len(t, cluster.Spec.WorkerGroupSpecs, 1)
+		assert.Equal(t, clusterSpec.WorkerGroupSpecs[0].GroupName, cluster.Spec.WorkerGroupSpecs[0].GroupName)
+		assert.Equal(t, "execution", cluster.Labels[warmClusterScopeLabel])
+		assert.NotEmpty(t, cluster.Annotations[warmClusterLastUsedAnnotation])
+		assert.Equal(t, []metav1.OwnerReference{owner}, cluster.OwnerReferences)
+	})
+
+	t.Run("reuses the shared cluster", func(t *testing.T) {
+		rayJob := newJob()
+		existing := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test-namespace",
+			Name:        rayJob.Labels[warmClusterNameLabel],
+			Annotations: map[string]string{warmClusterLastUsedAnnotation: "2020-01-01T00:00:00Z", "other": "value"},
+		}}
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+
+		assert.NoError(t, rayJobResourceHandler{}.EnsureSharedResources(ctx, nil, kubeClient, rayJob))
+		assert.Nil(t, rayJob.Spec.RayClusterSpec)
+
+		cluster := &rayv1.RayCluster{}
+		assert.NoError(t, kubeClient.Get(ctx, client.ObjectKeyFromObject(existing), cluster))
+		assert.NotEqual(t, "2020-01-01T00:00:00Z", cluster.Annotations[warmClusterLastUsedAnnotation])
+		assert.Equal(t, "value", cluster.Annotations["other"])
+	})
+
+	t.Run("shared cluster is being deleted", func(t *testing.T) {
+		rayJob := newJob()
+		existing := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "test-namespace",
+			Name:       rayJob.Labels[warmClusterNameLabel],
+			Finalizers: []string{"test"},
+		}}
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+		assert.NoError(t, kubeClient.Delete(ctx, existing))
+
+		assert.Error(t, rayJobResourceHandler{}.EnsureSharedResources(ctx, nil, kubeClient, rayJob))
+		assert.NotNil(t, rayJob.Spec.RayClusterSpec)
+	})
+
+	t.Run("dedicated cluster", func(t *testing.T) {
+		kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		rayJob := buildWarmClusterRayJob(t, "none")
+
+		assert.NoError(t, rayJobResourceHandler{}.EnsureSharedResources(ctx, nil, kubeClient, rayJob))
+		assert.NotNil(t, rayJob.Spec.RayClusterSpec)
+
+		clusters := &rayv1.RayClusterList{}
+		assert.NoError(t, kubeClient.List(ctx, clusters))
+		assert.Empty(t, clusters.Items)
+	})
+}
+
+func TestGarbageCollectSharedResources(t *testing.T) {
+	ctx := context.TODO()
+	prevCfg := *GetConfig()
+	defer func() { assert.NoError(t, SetConfig(&prevCfg)) }()
+	cfg := prevCfg
+	cfg.WarmClusters.IdleTimeout = stdConfig.Duration{Duration: 10 * time.Minute}
+	assert.NoError(t, SetConfig(&cfg))
+
+	now := time.Now()
+	recently := now.Add(-time.Minute).UTC().Format(time.RFC3339)
+	longAgo := now.Add(-time.Hour).UTC().Format(time.RFC3339)
+	owner := metav1.OwnerReference{
+		APIVersion: "flyte.lyft.com/v1alpha1",
+		Kind:       "FlyteWorkflow",
+		Name:       "my-execution",
+		UID:        "workflow-uid",
+		Controller: func() *bool { b := true; return &b }(),
+	}
+
+	newCluster := func(scope WarmClusterScope, lastUsed string, owners ...metav1.OwnerReference) *rayv1.RayCluster {
+		return &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test-namespace",
+			Name:              "flyte-warm-cluster",
+			Labels:            map[string]string{warmClusterScopeLabel: string(scope)},
+			Annotations:       map[string]string{warmClusterLastUsedAnnotation: lastUsed},
+			CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+			OwnerReferences:   owners,
+		}}
+	}
+	newJob := func(name string, status rayv1.JobDeploymentStatus, endTime *metav1.Time) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-namespace",
+				Name:      name,
+				Labels:    map[string]string{warmClusterNameLabel: "flyte-warm-cluster"},
+			},
+			Status: rayv1.RayJobStatus{JobDeploymentStatus: status, EndTime: endTime},
+		}
+	}
+	newWorkflow := func(labels map[string]string) client.Object {
+		workflow := &unstructured.Unstructured{}
+		workflow.SetAPIVersion(owner.APIVersion)
+		workflow.SetKind(owner.Kind)
+		workflow.SetNamespace("test-namespace")
+		workflow.SetName(owner.Name)
+		workflow.SetUID(owner.UID)
+		workflow.SetLabels(labels)
+		return workflow
+	}
+	recentEndTime := metav1.NewTime(now.Add(-time.Minute))
+
+	tests := []struct {
+		name    string
+		objects []client.Object
+		kept    bool
+	}{
+		{
+			name:    "idle for longer than the timeout",
+			objects: []client.Object{newCluster(WarmClusterScopeProject, longAgo), newJob("done", rayv1.JobDeploymentStatusComplete, nil)},
+			kept:    false,
+		},
+		{
+			name:    "recently used",
+			objects: []client.Object{newCluster(WarmClusterScopeProject, recently)},
+			kept:    true,
+		},
+		{
+			name: "recently finished job",
+			objects: []client.Object{
+				newCluster(WarmClusterScopeProject, longAgo),
+				newJob("failed", rayv1.JobDeploymentStatusFailed, &recentEndTime),
+			},
+			kept: true,
+		},
+		{
+			name:    "running job",
+			objects: []client.Object{newCluster(WarmClusterScopeProject, longAgo), newJob("running", rayv1.JobDeploymentStatusRunning, nil)},
+			kept:    true,
+		},
+		{
+			name:    "execution is running",
+			objects: []client.Object{newCluster(WarmClusterScopeExecution, recently, owner), newWorkflow(nil)},
+			kept:    true,
+		},
+		{
+			name: "execution terminated",
+			objects: []client.Object{
+				newCluster(WarmClusterScopeExecution, recently, owner),
+				newWorkflow(map[string]string{workflowTerminationStatusLabel: workflowTerminatedValue}),
+			},
+			kept: false,
+		},
+		{
+			name:    "execution deleted",
+			objects: []client.Object{newCluster(WarmClusterScopeExecution, recently, owner)},
+			kept:    false,
+		},
+		{
+			name: "execution terminated with a running job",
+			objects: []client.Object{
+				newCluster(WarmClusterScopeExecution, recently, owner),
+				newWorkflow(map[string]string{workflowTerminationStatusLabel: workflowTerminatedValue}),
+				newJob("running", rayv1.JobDeploymentStatusRunning, nil),
+			},
+			kept: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tt.objects...).Build()
+
+			assert.NoError(t, rayJobResourceHandler{}.GarbageCollectSharedResources(ctx, kubeClient))
+
+			err := kubeClient.Get(ctx, types.NamespacedName{Namespace: "test-namespace", Name: "flyte-warm-cluster"}, &rayv1.RayCluster{})
+			if tt.kept {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, k8serrors.IsNotFound(err))
+			}
+		})
+	}
+}
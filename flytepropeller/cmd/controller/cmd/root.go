@@ -88,8 +88,9 @@ func init() {
 
 func initConfig(cmd *cobra.Command, _ []string) error {
 	configAccessor = viper.NewAccessor(config.Options{
-		StrictMode:  false,
-		SearchPaths: []string{cfgFile},
+		StrictMode:   false,
+		SearchPaths:  []string{cfgFile},
+		MetricsScope: promutils.NewScope("flyte:propeller"),
 	})
 
 	configAccessor.InitializePflags(cmd.PersistentFlags())
@@ -144,6 +145,7 @@ func executeRootCmd(baseCtx context.Context, cfg *config2.Config) error {
 			DefaultNamespaces: namespaceConfigs,
 		},
 		NewCache:  executors.NewCache,
+		Client:    executors.ClientOptions(),
 		NewClient: executors.BuildNewClientFunc(propellerScope),
 		Metrics: metricsserver.Options{
 			// Disable metrics serving
@@ -147,53 +147,63 @@ func compileTasks(tasks []*core.TaskTemplate) ([]*core.CompiledTask, error) {
 	return res, nil
 }
 
-func (c *CreateOpts) createWorkflowFromProto() error {
-	fmt.Printf("Received protofiles : [%v] [%v].\n", c.protoFile, c.inputsPath)
-	rawWf, err := ioutil.ReadFile(c.protoFile)
+// buildFlyteWorkflow compiles the workflow closure in the proto file and builds the FlyteWorkflow executing it with the
+// inputs, if any.
+func buildFlyteWorkflow(protoFile string, format format, inputsPath, execID, namespace string) (*v1alpha1.FlyteWorkflow, error) {
+	rawWf, err := ioutil.ReadFile(protoFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	wfClosure := core.WorkflowClosure{}
-	err = unmarshal(rawWf, c.format, &wfClosure)
+	err = unmarshal(rawWf, format, &wfClosure)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	compiledTasks, err := compileTasks(wfClosure.GetTasks())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	wf, err := compiler.CompileWorkflow(wfClosure.GetWorkflow(), []*core.WorkflowTemplate{}, compiledTasks, []common.InterfaceProvider{})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var inputs *core.LiteralMap
-	if c.inputsPath != "" {
-		inputs, err = loadInputs(c.inputsPath, c.format)
+	if inputsPath != "" {
+		inputs, err = loadInputs(inputsPath, format)
 		if err != nil {
-			return errors.Wrapf(err, "Failed to load inputs.")
+			return nil, errors.Wrapf(err, "Failed to load inputs.")
 		}
 	}
 
 	var executionID *core.WorkflowExecutionIdentifier
-	if len(c.execID) > 0 {
+	if len(execID) > 0 {
 		executionID = &core.WorkflowExecutionIdentifier{
-			Name:    c.execID,
+			Name:    execID,
 			Domain:  wfClosure.GetWorkflow().GetId().GetDomain(),
 			Project: wfClosure.GetWorkflow().GetId().GetProject(),
 		}
 	}
 
-	flyteWf, err := k8s.BuildFlyteWorkflow(wf, inputs, executionID, c.ConfigOverrides.Context.Namespace)
+	flyteWf, err := k8s.BuildFlyteWorkflow(wf, inputs, executionID, namespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	flyteWf.ExecutionID = v1alpha1.WorkflowExecutionIdentifier{
 		WorkflowExecutionIdentifier: executionID,
 	}
+	return flyteWf, nil
+}
+
+func (c *CreateOpts) createWorkflowFromProto() error {
+	fmt.Printf("Received protofiles : [%v] [%v].\n", c.protoFile, c.inputsPath)
+	flyteWf, err := buildFlyteWorkflow(c.protoFile, c.format, c.inputsPath, c.execID, c.ConfigOverrides.Context.Namespace)
+	if err != nil {
+		return err
+	}
 	if flyteWf.Annotations == nil {
 		flyteWf.Annotations = *c.annotations.value
 	} else {
@@ -99,6 +99,7 @@ func NewFlyteCommand() *cobra.Command {
 	command.AddCommand(NewVisualizeCommand(rootOpts))
 	command.AddCommand(NewCreateCommand(rootOpts))
 	command.AddCommand(NewCompileCommand(rootOpts))
+	command.AddCommand(NewRunCommand(rootOpts))
 
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	loadingRules.DefaultClientConfig = &clientcmd.DefaultClientConfig
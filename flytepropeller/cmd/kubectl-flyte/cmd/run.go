@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	compilerErrors "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/errors"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/local"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const (
+	recordedOutputsKey = "recorded-outputs"
+	dockerKey          = "docker"
+)
+
+type RunOpts struct {
+	*RootOptions
+	format          format
+	execID          string
+	inputsPath      string
+	protoFile       string
+	recordedOutputs string
+	docker          bool
+	workDir         string
+}
+
+func NewRunCommand(opts *RootOptions) *cobra.Command {
+
+	runOpts := &RunOpts{
+		RootOptions: opts,
+	}
+
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs a workflow from proto-buffer files in-process, without a cluster.",
+		Long: `Compiles the workflow and executes it in-process with the node executor of propeller, keeping its data in memory.
+The container tasks are either run with the local Docker daemon, exchanging their inputs and outputs in the work
+directory, or replaced by the outputs recorded for them in a json or yaml file mapping the names of the tasks to their
+outputs, e.g.
+
+  my.module.task:
+    literals:
+      out:
+        scalar:
+          primitive:
+            integer: 42
+`,
+		// The workflow is not created in a cluster.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requiredFlags(cmd, protofileKey, formatKey); err != nil {
+				return err
+			}
+			if runOpts.docker == (runOpts.recordedOutputs != "") {
+				return fmt.Errorf("exactly one of --%s and --%s must be set", dockerKey, recordedOutputsKey)
+			}
+
+			compilerErrors.SetIncludeSource()
+
+			return runOpts.runWorkflowFromProto(cmd.Context())
+		},
+	}
+
+	runCmd.Flags().StringVarP(&runOpts.protoFile, protofileKey, "p", "", "Path of the workflow package proto-buffer file to be run")
+	runCmd.Flags().StringVarP(&runOpts.format, formatKey, "f", formatProto, "Format of the provided file. Supported formats: proto (default), json, yaml")
+	runCmd.Flags().StringVarP(&runOpts.execID, executionIDKey, "", "local", "Execution Id of the Workflow to run.")
+	runCmd.Flags().StringVarP(&runOpts.inputsPath, inputsKey, "i", "", "Path to inputs file.")
+	runCmd.Flags().StringVarP(&runOpts.recordedOutputs, recordedOutputsKey, "r", "", "Path to the json or yaml file with the recorded outputs of the tasks.")
+	runCmd.Flags().BoolVarP(&runOpts.docker, dockerKey, "", false, "Runs the container tasks with the local Docker daemon.")
+	runCmd.Flags().StringVarP(&runOpts.workDir, "work-dir", "w", ".flyte-local", "Directory the container tasks exchange their inputs and outputs in.")
+
+	return runCmd
+}
+
+func (r *RunOpts) taskRunner() (local.TaskRunner, error) {
+	if r.docker {
+		return local.NewDocker(r.workDir)
+	}
+	return local.LoadRecordedOutputs(r.recordedOutputs)
+}
+
+func (r *RunOpts) runWorkflowFromProto(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	flyteWf, err := buildFlyteWorkflow(r.protoFile, r.format, r.inputsPath, r.execID, "")
+	if err != nil {
+		return err
+	}
+
+	taskRunner, err := r.taskRunner()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create the task runner.")
+	}
+	runner, err := local.NewRunner(ctx, taskRunner, promutils.NewScope("kubectl_flyte").NewSubScope("run"))
+	if err != nil {
+		return errors.Wrapf(err, "Failed to create the local runner.")
+	}
+
+	result, err := runner.Run(ctx, flyteWf)
+	if err != nil {
+		return err
+	}
+
+	nodeIDs := make([]string, 0, len(result.Nodes))
+	for id := range result.Nodes {
+		nodeIDs = append(nodeIDs, id)
+	}
+	sort.Strings(nodeIDs)
+	for _, id := range nodeIDs {
+		fmt.Printf("Node [%s]: %s\n", id, result.Nodes[id])
+	}
+
+	if result.Phase != v1alpha1.WorkflowPhaseSuccess {
+		return fmt.Errorf("workflow [%s] %s: %s", flyteWf.GetID(), result.Phase, result.Message)
+	}
+	fmt.Printf("Workflow [%s] succeeded.\n", flyteWf.GetID())
+	if result.Outputs != nil {
+		o, err := marshal(result.Outputs, formatYaml)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to marshal the outputs of the workflow.")
+		}
+		_, err = os.Stdout.Write(o)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+)
+
+func TestRun(t *testing.T) {
+	labeled.SetMetricKeys(contextutils.ProjectKey, contextutils.DomainKey, contextutils.WorkflowIDKey, contextutils.TaskIDKey)
+
+	t.Run("recorded outputs", func(t *testing.T) {
+		recordings := filepath.Join(t.TempDir(), "outputs.yaml")
+		require.NoError(t, os.WriteFile(recordings, []byte("{}"), 0600))
+		runOpts := &RunOpts{
+			RootOptions:     &RootOptions{},
+			format:          formatYaml,
+			execID:          "local",
+			protoFile:       filepath.Join("testdata", "workflow_w_inputs.yaml.golden"),
+			inputsPath:      filepath.Join("testdata", "inputs.yaml.golden"),
+			recordedOutputs: recordings,
+		}
+		assert.NoError(t, runOpts.runWorkflowFromProto(context.Background()))
+	})
+
+	t.Run("task runner required", func(t *testing.T) {
+		cmd := NewRunCommand(&RootOptions{})
+		cmd.SetArgs([]string{"-p", filepath.Join("testdata", "workflow.yaml.golden"), "-f", formatYaml})
+		assert.EqualError(t, cmd.Execute(), "exactly one of --docker and --recorded-outputs must be set")
+	})
+}
@@ -116,8 +116,9 @@ func init() {
 
 func initConfig(cmd *cobra.Command, _ []string) error {
 	configAccessor = viper.NewAccessor(config.Options{
-		StrictMode:  false,
-		SearchPaths: []string{cfgFile},
+		StrictMode:   false,
+		SearchPaths:  []string{cfgFile},
+		MetricsScope: promutils.NewScope("flyte:propeller_manager"),
 	})
 
 	configAccessor.InitializePflags(cmd.PersistentFlags())
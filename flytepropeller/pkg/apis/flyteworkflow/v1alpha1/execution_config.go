@@ -35,6 +35,26 @@ type ExecutionConfig struct {
 	OverwriteCache bool
 	// Defines a map of environment variable name / value pairs that are applied to all tasks.
 	EnvironmentVariables map[string]string
+	// Set while the workflow is paused, in which case none of its nodes are started until it is resumed.
+	Pause *PauseConfig
+	// Set when re-running RecoveryExecution from a node, in which case the node and the ones downstream of it are
+	// executed again instead of being recovered.
+	RerunFrom *RerunConfig
+}
+
+// Defines how a paused workflow treats the nodes it is running.
+type PauseConfig struct {
+	// Aborts the running task nodes to re-queue them once the workflow is resumed, instead of letting them finish.
+	// Dynamic nodes running the workflow they generated are not aborted as a whole, only the task nodes of that workflow.
+	AbortRunningNodes bool
+}
+
+// Defines the node of the workflow an execution re-runs from.
+type RerunConfig struct {
+	// Id of a node of the top-level workflow.
+	NodeID NodeID
+	// Overrides the inputs the node is bound to.
+	Inputs *Inputs
 }
 
 type TaskPluginOverride struct {
@@ -119,6 +119,10 @@ const (
 	// its failure reason. In other words, its failure will mask the original failure for the workflow. It's imperative
 	// failure nodes should be very simple, very resilient and very well tested.
 	WorkflowPhaseHandlingFailureNode
+	// WorkflowPhasePaused is the phase of a running workflow which has been paused. None of its nodes are started until
+	// it is resumed and moves back to running, but the ones already running carry on unless they are aborted to be
+	// re-queued upon resumption.
+	WorkflowPhasePaused
 )
 
 func (p WorkflowPhase) String() string {
@@ -139,6 +143,8 @@ func (p WorkflowPhase) String() string {
 		return "Aborted"
 	case WorkflowPhaseHandlingFailureNode:
 		return "HandlingFailureNode"
+	case WorkflowPhasePaused:
+		return "Paused"
 	}
 	return "Unknown"
 }
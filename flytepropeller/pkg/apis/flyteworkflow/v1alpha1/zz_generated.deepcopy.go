@@ -153,6 +153,16 @@ func (in *ExecutionConfig) DeepCopyInto(out *ExecutionConfig) {
 		}
 	}
 	out.MaxParallelism = in.MaxParallelism
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(PauseConfig)
+		**out = **in
+	}
+	if in.RerunFrom != nil {
+		in, out := &in.RerunFrom, &out.RerunFrom
+		*out = new(RerunConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -582,6 +592,22 @@ func (in *OutputVarMap) DeepCopy() *OutputVarMap {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauseConfig) DeepCopyInto(out *PauseConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseConfig.
+func (in *PauseConfig) DeepCopy() *PauseConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawOutputDataConfig.
 func (in *RawOutputDataConfig) DeepCopy() *RawOutputDataConfig {
 	if in == nil {
@@ -592,6 +618,26 @@ func (in *RawOutputDataConfig) DeepCopy() *RawOutputDataConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RerunConfig) DeepCopyInto(out *RerunConfig) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RerunConfig.
+func (in *RerunConfig) DeepCopy() *RerunConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RerunConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -145,14 +145,20 @@ func (b *BatchingWorkQueue) AddToSubQueueRateLimited(item interface{}) {
 	b.subQueue.AddRateLimited(item)
 }
 
+// NewCompositeWorkQueue creates the workqueues of the controller. The rate limits of the workqueues follow the updates
+// of the queue config of propeller.
 func NewCompositeWorkQueue(ctx context.Context, cfg config.CompositeQueueConfig, scope promutils.Scope) (CompositeWorkQueue, error) {
-	workQ, err := NewWorkQueue(ctx, cfg.Queue, scope.NewScopedMetricName("main"))
+	workQ, err := newWorkQueue(ctx, cfg.Queue, scope.NewScopedMetricName("main"), func(c *config.Config) config.WorkqueueConfig {
+		return c.Queue.Queue
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create WorkQueue in CompositeQueue type Batch")
 	}
 	switch cfg.Type {
 	case config.CompositeQueueBatch:
-		subQ, err := NewWorkQueue(ctx, cfg.Sub, scope.NewScopedMetricName("sub"))
+		subQ, err := newWorkQueue(ctx, cfg.Sub, scope.NewScopedMetricName("sub"), func(c *config.Config) config.WorkqueueConfig {
+			return c.Queue.Sub
+		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to create SubQueue in CompositeQueue type Batch")
 		}
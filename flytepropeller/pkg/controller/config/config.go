@@ -358,6 +358,44 @@ func GetConfig() *Config {
 	return configSection.GetConfig().(*Config)
 }
 
+// MustSubscribe registers a handler to be invoked with the new configuration whenever it's reloaded at runtime.
+func MustSubscribe(handler func(ctx context.Context, newValue *Config)) {
+	config.MustSubscribe(configSection, handler)
+}
+
+// Validate checks the rate limits of the workqueues and the kubernetes client. Reloads with invalid rate limits are
+// rejected.
+func (c *Config) Validate() error {
+	if err := c.Queue.Queue.validate(); err != nil {
+		return fmt.Errorf("invalid queue config: %w", err)
+	}
+
+	if c.Queue.Type == CompositeQueueBatch {
+		if err := c.Queue.Sub.validate(); err != nil {
+			return fmt.Errorf("invalid sub-queue config: %w", err)
+		}
+	}
+
+	if c.KubeConfig.QPS < 0 || c.KubeConfig.Burst < 0 {
+		return fmt.Errorf("kube client qps [%v] and burst [%v] must not be negative", c.KubeConfig.QPS,
+			c.KubeConfig.Burst)
+	}
+
+	return nil
+}
+
+func (w WorkqueueConfig) validate() error {
+	switch w.Type {
+	case WorkqueueTypeBucketRateLimiter, WorkqueueTypeMaxOfRateLimiter:
+		if w.Rate <= 0 || w.Capacity <= 0 {
+			return fmt.Errorf("rate [%v] and capacity [%v] of a [%v] workqueue must be positive", w.Rate, w.Capacity,
+				w.Type)
+		}
+	}
+
+	return nil
+}
+
 // MustRegisterSubSection can be used to configure any subsections the the propeller configuration
 func MustRegisterSubSection(subSectionKey string, section config.Config) config.Section {
 	return configSection.MustRegisterSection(subSectionKey, section)
@@ -124,3 +124,28 @@ func TestIsSupportedSDKVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, defaultConfig.Validate())
+
+	t.Run("queue rate", func(t *testing.T) {
+		cfg := *defaultConfig
+		cfg.Queue.Queue.Rate = 0
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("sub-queue capacity", func(t *testing.T) {
+		cfg := *defaultConfig
+		cfg.Queue.Sub.Capacity = -1
+		assert.Error(t, cfg.Validate())
+
+		cfg.Queue.Type = CompositeQueueSimple
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("kube client", func(t *testing.T) {
+		cfg := *defaultConfig
+		cfg.KubeConfig.Burst = -1
+		assert.Error(t, cfg.Validate())
+	})
+}
@@ -60,6 +60,17 @@ func (e execContext) GetParentInfo() ImmutableParentInfo {
 	return e.parentInfo
 }
 
+// nonRecoveringExecutionContext hides the execution the nodes of an execution context are recovered from.
+type nonRecoveringExecutionContext struct {
+	ImmutableExecutionContext
+}
+
+func (e nonRecoveringExecutionContext) GetExecutionConfig() v1alpha1.ExecutionConfig {
+	executionConfig := e.ImmutableExecutionContext.GetExecutionConfig()
+	executionConfig.RecoveryExecution = v1alpha1.WorkflowExecutionIdentifier{}
+	return executionConfig
+}
+
 type parentExecutionInfo struct {
 	uniqueID         v1alpha1.NodeID
 	currentAttempts  uint32
@@ -125,6 +136,13 @@ func NewExecutionContextWithParentInfo(prevExecContext ExecutionContext, parentI
 	return NewExecutionContext(prevExecContext, prevExecContext, prevExecContext, parentInfo, prevExecContext)
 }
 
+// NewExecutionContextWithoutRecovery returns an execution context whose nodes, and the ones nested in them, are executed
+// instead of being recovered from the RecoveryExecution of the execution config of prevExecContext.
+func NewExecutionContextWithoutRecovery(prevExecContext ExecutionContext) ExecutionContext {
+	return NewExecutionContext(nonRecoveringExecutionContext{prevExecContext}, prevExecContext, prevExecContext,
+		prevExecContext.GetParentInfo(), prevExecContext)
+}
+
 func NewExecutionContext(immExecContext ImmutableExecutionContext, tasksGetter TaskDetailsGetter, workflowGetter SubWorkflowGetter, parentInfo ImmutableParentInfo, flow ControlFlow) ExecutionContext {
 	return execContext{
 		ImmutableExecutionContext: immExecContext,
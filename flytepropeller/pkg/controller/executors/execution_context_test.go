@@ -4,12 +4,24 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
 )
 
 type immExecContext struct {
 	ImmutableExecutionContext
 }
 
+type configExecContext struct {
+	ImmutableExecutionContext
+	executionConfig v1alpha1.ExecutionConfig
+}
+
+func (c configExecContext) GetExecutionConfig() v1alpha1.ExecutionConfig {
+	return c.executionConfig
+}
+
 type tdGetter struct {
 	TaskDetailsGetter
 }
@@ -98,3 +110,20 @@ func TestNewParentInfo(t *testing.T) {
 	assert.Equal(t, expectedID, parentInfo.uniqueID)
 	assert.Equal(t, expectedAttempt, parentInfo.currentAttempts)
 }
+
+func TestNewExecutionContextWithoutRecovery(t *testing.T) {
+	immutableExecContext := configExecContext{executionConfig: v1alpha1.ExecutionConfig{
+		MaxParallelism: 2,
+		RecoveryExecution: v1alpha1.WorkflowExecutionIdentifier{
+			WorkflowExecutionIdentifier: &core.WorkflowExecutionIdentifier{Name: "recovered"},
+		},
+	}}
+	parentInfo := NewParentInfo("parent", 1, false)
+	ec := NewExecutionContext(immutableExecContext, tdGetter{}, subWfGetter{}, parentInfo, InitializeControlFlow())
+
+	nonRecovering := NewExecutionContextWithoutRecovery(ec)
+	assert.Nil(t, nonRecovering.GetExecutionConfig().RecoveryExecution.WorkflowExecutionIdentifier)
+	assert.Equal(t, uint32(2), nonRecovering.GetExecutionConfig().MaxParallelism)
+	assert.Equal(t, parentInfo, nonRecovering.GetParentInfo())
+	assert.NotNil(t, ec.GetExecutionConfig().RecoveryExecution.WorkflowExecutionIdentifier)
+}
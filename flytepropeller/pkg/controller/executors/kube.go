@@ -3,10 +3,15 @@ package executors
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"github.com/flyteorg/flyte/flytestdlib/fastcheck"
 	"github.com/flyteorg/flyte/flytestdlib/otelutils"
@@ -33,12 +38,27 @@ var NewCache = func(config *rest.Config, options cache.Options) (cache.Cache, er
 	return otelutils.WrapK8sCache(k8sCache), nil
 }
 
+// ClientOptions returns the options of the kube client of the controller manager. Nodes are only read occasionally (e.g.
+// to look for termination taints) and are always read from the API server, as caching them would watch every node of
+// the cluster.
+func ClientOptions() client.Options {
+	return client.Options{
+		Cache: &client.CacheOptions{
+			DisableFor: []client.Object{&v1.Node{}},
+		},
+	}
+}
+
 func BuildNewClientFunc(scope promutils.Scope) func(config *rest.Config, options client.Options) (client.Client, error) {
 	return func(config *rest.Config, options client.Options) (client.Client, error) {
 		var cacheReader client.Reader
+		var disableFor []client.Object
+		cacheUnstructured := false
 		cachelessOptions := options
 		if options.Cache != nil && options.Cache.Reader != nil {
 			cacheReader = options.Cache.Reader
+			disableFor = options.Cache.DisableFor
+			cacheUnstructured = options.Cache.Unstructured
 			cachelessOptions.Cache = nil
 		}
 
@@ -47,18 +67,64 @@ func BuildNewClientFunc(scope promutils.Scope) func(config *rest.Config, options
 			return nil, err
 		}
 
-		return newFlyteK8sClient(kubeClient, cacheReader, scope)
+		f, err := newFlyteK8sClient(kubeClient, cacheReader, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		f.cacheUnstructured = cacheUnstructured
+
+		// Objects listed in DisableFor are always read from the API server, so that reading them doesn't start an
+		// informer for them.
+		for _, obj := range disableFor {
+			gvk, err := apiutil.GVKForObject(obj, kubeClient.Scheme())
+			if err != nil {
+				return nil, err
+			}
+
+			f.uncachedKinds[gvk] = struct{}{}
+		}
+
+		return f, nil
 	}
 }
 
 type flyteK8sClient struct {
 	client.Client
-	cacheReader client.Reader
-	writeFilter fastcheck.Filter
+	cacheReader       client.Reader
+	uncachedKinds     map[schema.GroupVersionKind]struct{}
+	cacheUnstructured bool
+	writeFilter       fastcheck.Filter
+}
+
+// readsFromCache returns true if obj should be read from the cache reader before falling back to the API server. As with
+// the default controller-runtime client, unstructured objects are only read from the cache if CacheOptions.Unstructured
+// is set.
+func (f flyteK8sClient) readsFromCache(obj runtime.Object) bool {
+	if f.cacheReader == nil {
+		return false
+	}
+
+	if _, isUnstructured := obj.(runtime.Unstructured); isUnstructured && !f.cacheUnstructured {
+		return false
+	}
+
+	if len(f.uncachedKinds) == 0 {
+		return true
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, f.Scheme())
+	if err != nil {
+		return true
+	}
+
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	_, uncached := f.uncachedKinds[gvk]
+	return !uncached
 }
 
 func (f flyteK8sClient) Get(ctx context.Context, key client.ObjectKey, out client.Object, opts ...client.GetOption) (err error) {
-	if f.cacheReader != nil {
+	if f.readsFromCache(out) {
 		if err = f.cacheReader.Get(ctx, key, out, opts...); err == nil {
 			return nil
 		}
@@ -68,7 +134,7 @@ func (f flyteK8sClient) Get(ctx context.Context, key client.ObjectKey, out clien
 }
 
 func (f flyteK8sClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (err error) {
-	if f.cacheReader != nil {
+	if f.readsFromCache(list) {
 		if err = f.cacheReader.List(ctx, list, opts...); err == nil {
 			return nil
 		}
@@ -120,8 +186,9 @@ func newFlyteK8sClient(kubeClient client.Client, cacheReader client.Reader, scop
 	}
 
 	return flyteK8sClient{
-		Client:      kubeClient,
-		cacheReader: cacheReader,
-		writeFilter: writeFilter,
+		Client:        kubeClient,
+		cacheReader:   cacheReader,
+		uncachedKinds: map[schema.GroupVersionKind]struct{}{},
+		writeFilter:   writeFilter,
 	}, nil
 }
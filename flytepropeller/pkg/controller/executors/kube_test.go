@@ -9,8 +9,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
@@ -136,6 +138,25 @@ func TestFlyteK8sClient(t *testing.T) {
 		})
 	}
 
+	t.Run("uncached", func(t *testing.T) {
+		cacheReader := &mockKubeClient{}
+		kubeClient := &mockKubeClient{Client: fake.NewClientBuilder().Build()}
+		flyteK8sClient, err := newFlyteK8sClient(kubeClient, cacheReader, scope.NewSubScope("uncached"))
+		assert.NoError(t, err)
+		flyteK8sClient.uncachedKinds[v1.SchemeGroupVersion.WithKind("Node")] = struct{}{}
+
+		assert.NoError(t, flyteK8sClient.Get(ctx, types.NamespacedName{Name: "node"}, &v1.Node{}))
+		assert.NoError(t, flyteK8sClient.Get(ctx, objectKey, pod))
+		assert.Equal(t, 1, kubeClient.getCalledCount)
+		assert.Equal(t, 1, cacheReader.getCalledCount)
+
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("Pod"))
+		assert.NoError(t, flyteK8sClient.Get(ctx, objectKey, u))
+		assert.Equal(t, 2, kubeClient.getCalledCount)
+		assert.Equal(t, 1, cacheReader.getCalledCount)
+	})
+
 	// test create
 	t.Run("create", func(t *testing.T) {
 		kubeClient := &mockKubeClient{}
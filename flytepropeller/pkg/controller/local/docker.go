@@ -0,0 +1,189 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/template"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+// ContainerWorkDir is the path the work directory of a Docker runner is mounted at in the containers. The work
+// directory is mounted at the same path in all the containers, so that the blobs a task writes can be read by the
+// tasks downstream.
+const ContainerWorkDir = "/var/flyte/local"
+
+// pathConstructor constructs the references of the files the containers read and write as plain paths.
+type pathConstructor struct{}
+
+func (pathConstructor) ConstructReference(_ context.Context, reference storage.DataReference, nestedKeys ...string) (storage.DataReference, error) {
+	return storage.DataReference(path.Join(append([]string{string(reference)}, nestedKeys...)...)), nil
+}
+
+// containerInputs reads the inputs of the task from the engine, while the container reads them from the work directory.
+type containerInputs struct {
+	io.InputReader
+	paths io.InputFilePaths
+}
+
+func (i containerInputs) GetInputPrefixPath() storage.DataReference {
+	return i.paths.GetInputPrefixPath()
+}
+
+func (i containerInputs) GetInputPath() storage.DataReference {
+	return i.paths.GetInputPath()
+}
+
+// Docker is a TaskRunner running the container tasks with the local Docker daemon.
+type Docker struct {
+	// WorkDir is the directory the inputs and outputs of the tasks are exchanged in.
+	WorkDir string
+	// Binary is the docker binary, docker in the PATH by default.
+	Binary string
+	// run runs the command, exec.CommandContext by default.
+	run func(ctx context.Context, name string, args ...string) error
+}
+
+// NewDocker returns a Docker runner exchanging the inputs and outputs of the tasks in the work directory.
+func NewDocker(workDir string) (*Docker, error) {
+	dir, err := filepath.Abs(workDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &Docker{WorkDir: dir, Binary: "docker"}, nil
+}
+
+func runCommand(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (d *Docker) Run(ctx context.Context, tCtx pluginCore.TaskExecutionContext) (*core.LiteralMap, *core.ExecutionError, error) {
+	task, err := tCtx.TaskReader().Read(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	container := task.GetContainer()
+	if container == nil {
+		return nil, &core.ExecutionError{
+			Code:    "UnsupportedTask",
+			Message: fmt.Sprintf("task [%s] of type [%s] is not a container task", task.GetId().GetName(), task.GetType()),
+			Kind:    core.ExecutionError_USER,
+		}, nil
+	}
+
+	name := tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName()
+	hostDir := filepath.Join(d.WorkDir, name)
+	containerDir := storage.DataReference(path.Join(ContainerWorkDir, name))
+	// Leftovers of a previous attempt must not be mistaken for its outputs.
+	if err := os.RemoveAll(hostDir); err != nil {
+		return nil, nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(hostDir, "outputs"), os.ModePerm); err != nil {
+		return nil, nil, err
+	}
+
+	inputs, err := tCtx.InputReader().Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := writeProto(filepath.Join(hostDir, ioutils.InputsSuffix), inputs); err != nil {
+		return nil, nil, err
+	}
+
+	var constructor pathConstructor
+	outputPaths := ioutils.NewCheckpointRemoteFilePaths(ctx, constructor, containerDir+"/outputs",
+		ioutils.NewRawOutputPaths(ctx, containerDir+"/raw"), "")
+	cmdAndArgs := append(append([]string{}, container.GetCommand()...), container.GetArgs()...)
+	args, err := template.Render(ctx, cmdAndArgs, template.Parameters{
+		TaskExecMetadata: tCtx.TaskExecutionMetadata(),
+		Inputs:           containerInputs{InputReader: tCtx.InputReader(), paths: ioutils.NewInputFilePaths(ctx, constructor, containerDir)},
+		OutputPath:       outputPaths,
+		Task:             tCtx.TaskReader(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dockerArgs := []string{"run", "--rm", "-v", fmt.Sprintf("%s:%s", d.WorkDir, ContainerWorkDir)}
+	for _, env := range container.GetEnv() {
+		dockerArgs = append(dockerArgs, "-e", fmt.Sprintf("%s=%s", env.GetKey(), env.GetValue()))
+	}
+	dockerArgs = append(dockerArgs, container.GetImage())
+	dockerArgs = append(dockerArgs, args...)
+
+	logger.Infof(ctx, "Running task [%s] in image [%s]", name, container.GetImage())
+	run := d.run
+	if run == nil {
+		run = runCommand
+	}
+	binary := d.Binary
+	if binary == "" {
+		binary = "docker"
+	}
+	runErr := run(ctx, binary, dockerArgs...)
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+
+	outputsDir := filepath.Join(hostDir, "outputs")
+	errorDoc := &core.ErrorDocument{}
+	if found, err := readProto(filepath.Join(outputsDir, ioutils.ErrorsSuffix), errorDoc); err != nil {
+		return nil, nil, err
+	} else if found {
+		return nil, &core.ExecutionError{
+			Code:    errorDoc.GetError().GetCode(),
+			Message: errorDoc.GetError().GetMessage(),
+			Kind:    errorDoc.GetError().GetOrigin(),
+		}, nil
+	}
+	if runErr != nil {
+		return nil, &core.ExecutionError{
+			Code:    "ContainerFailed",
+			Message: fmt.Sprintf("container of task [%s] failed: %v", name, runErr),
+			Kind:    core.ExecutionError_USER,
+		}, nil
+	}
+
+	outputs := &core.LiteralMap{}
+	if found, err := readProto(filepath.Join(outputsDir, ioutils.OutputsSuffix), outputs); err != nil || !found {
+		return nil, nil, err
+	}
+	return outputs, nil, nil
+}
+
+func writeProto(file string, msg proto.Message) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, b, 0644) // #nosec G306 the containers may not run as the same user
+}
+
+// readProto reads the message from the file, returning false if the file does not exist.
+func readProto(file string, msg proto.Message) (bool, error) {
+	b, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, proto.Unmarshal(b, msg)
+}
@@ -0,0 +1,101 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+)
+
+// fakeContainer returns a docker run func evaluating the tasks of the test workflow, reading their inputs from and
+// writing their outputs to the work directory mounted in the container.
+func fakeContainer(t *testing.T, workDir string, eval func(image string, input int64) (*core.LiteralMap, *core.ErrorDocument)) func(ctx context.Context, name string, args ...string) error {
+	return func(ctx context.Context, name string, args ...string) error {
+		require.Equal(t, "docker", name)
+		require.Equal(t, []string{"run", "--rm", "-v", workDir + ":" + ContainerWorkDir}, args[:4])
+		hostPath := func(p string) string {
+			return filepath.Join(workDir, strings.TrimPrefix(p, ContainerWorkDir))
+		}
+		image, flags := args[4], args[5:]
+		require.Len(t, flags, 4)
+		inputsPath, outputPrefix := hostPath(flags[1]), hostPath(flags[3])
+
+		inputs := &core.LiteralMap{}
+		found, err := readProto(inputsPath, inputs)
+		require.NoError(t, err)
+		require.True(t, found)
+		var input int64
+		for _, literal := range inputs.GetLiterals() {
+			input = literal.GetScalar().GetPrimitive().GetInteger()
+		}
+
+		outputs, errorDoc := eval(image, input)
+		if errorDoc != nil {
+			require.NoError(t, writeProto(filepath.Join(outputPrefix, "error.pb"), errorDoc))
+			return fmt.Errorf("exit status 1")
+		}
+		if outputs == nil {
+			return fmt.Errorf("exit status 137")
+		}
+		return writeProto(filepath.Join(outputPrefix, "outputs.pb"), outputs)
+	}
+}
+
+func TestDocker_Run(t *testing.T) {
+	ctx := context.Background()
+
+	newDocker := func(t *testing.T, eval func(image string, input int64) (*core.LiteralMap, *core.ErrorDocument)) *Docker {
+		d, err := NewDocker(t.TempDir())
+		require.NoError(t, err)
+		d.run = fakeContainer(t, d.WorkDir, eval)
+		return d
+	}
+
+	t.Run("success", func(t *testing.T) {
+		d := newDocker(t, func(image string, input int64) (*core.LiteralMap, *core.ErrorDocument) {
+			assert.Equal(t, "image:v1", image)
+			if input == 3 {
+				return intOutputs("y", 6), nil
+			}
+			return intOutputs("z", int(input*input)), nil
+		})
+
+		result, err := newRunner(t, d).Run(ctx, newWorkflow(t))
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseSuccess, result.Phase, result.Message)
+		assert.Equal(t, int64(36), result.Outputs.GetLiterals()["z"].GetScalar().GetPrimitive().GetInteger())
+	})
+
+	t.Run("error document", func(t *testing.T) {
+		d := newDocker(t, func(image string, input int64) (*core.LiteralMap, *core.ErrorDocument) {
+			return nil, &core.ErrorDocument{Error: &core.ContainerError{
+				Code:    "ValueError",
+				Message: "input too small",
+				Origin:  core.ExecutionError_USER,
+			}}
+		})
+
+		result, err := newRunner(t, d).Run(ctx, newWorkflow(t))
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseFailed, result.Phase)
+		assert.Contains(t, result.Message, "input too small")
+	})
+
+	t.Run("container failure", func(t *testing.T) {
+		d := newDocker(t, func(image string, input int64) (*core.LiteralMap, *core.ErrorDocument) {
+			return nil, nil
+		})
+
+		result, err := newRunner(t, d).Run(ctx, newWorkflow(t))
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseFailed, result.Phase)
+		assert.Contains(t, result.Message, "exit status 137")
+	})
+}
@@ -0,0 +1,133 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// TaskRunner runs the tasks of a local execution.
+type TaskRunner interface {
+	// Run runs the task to completion and returns its outputs, or the error it failed with. The returned error is
+	// reserved for system failures, which are retried.
+	Run(ctx context.Context, tCtx pluginCore.TaskExecutionContext) (*core.LiteralMap, *core.ExecutionError, error)
+}
+
+// PluginID is the id of the plugin running the tasks of all types in local executions.
+const PluginID = "local"
+
+type taskRunnerKey struct{}
+
+// withTaskRunner returns a context with the task runner the local plugin runs the tasks of the workflow with.
+func withTaskRunner(ctx context.Context, runner TaskRunner) context.Context {
+	return context.WithValue(ctx, taskRunnerKey{}, runner)
+}
+
+var registerPlugin sync.Once
+
+// RegisterPlugin registers the local plugin as the default plugin for all task types. The plugin runs the tasks with
+// the TaskRunner of the Runner executing the workflow.
+func RegisterPlugin() {
+	registerPlugin.Do(func() {
+		pluginmachinery.PluginRegistry().RegisterCorePlugin(pluginCore.PluginEntry{
+			ID: PluginID,
+			// The plugin is the default for the task types it is not explicitly registered for.
+			RegisteredTaskTypes: []pluginCore.TaskType{"container", "python-task", "raw-container", "sidecar"},
+			LoadPlugin: func(ctx context.Context, iCtx pluginCore.SetupContext) (pluginCore.Plugin, error) {
+				return &plugin{runs: map[string]*taskRun{}}, nil
+			},
+			IsDefault: true,
+		})
+	})
+}
+
+type taskRun struct {
+	cancel  context.CancelFunc
+	done    chan struct{}
+	outputs *core.LiteralMap
+	execErr *core.ExecutionError
+	err     error
+}
+
+// plugin runs the tasks asynchronously, so that the independent nodes of a workflow run concurrently.
+type plugin struct {
+	mutex sync.Mutex
+	runs  map[string]*taskRun
+}
+
+func (p *plugin) GetID() string {
+	return PluginID
+}
+
+func (p *plugin) GetProperties() pluginCore.PluginProperties {
+	return pluginCore.PluginProperties{}
+}
+
+func (p *plugin) Handle(ctx context.Context, tCtx pluginCore.TaskExecutionContext) (pluginCore.Transition, error) {
+	runner, ok := ctx.Value(taskRunnerKey{}).(TaskRunner)
+	if !ok {
+		return pluginCore.UnknownTransition, fmt.Errorf("plugin [%s] only runs the tasks of local executions", PluginID)
+	}
+
+	name := tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName()
+	p.mutex.Lock()
+	run, ok := p.runs[name]
+	if !ok {
+		// The run outlives the round that started it.
+		runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		run = &taskRun{cancel: cancel, done: make(chan struct{})}
+		p.runs[name] = run
+		go func() {
+			defer close(run.done)
+			run.outputs, run.execErr, run.err = runner.Run(runCtx, tCtx)
+		}()
+	}
+	p.mutex.Unlock()
+
+	select {
+	case <-run.done:
+	default:
+		return pluginCore.DoTransition(pluginCore.PhaseInfoRunning(pluginCore.DefaultPhaseVersion, nil)), nil
+	}
+	p.remove(name)
+	run.cancel()
+
+	if run.err != nil {
+		return pluginCore.UnknownTransition, run.err
+	}
+	if run.execErr != nil {
+		logger.Infof(ctx, "Task [%s] failed: %s", name, run.execErr.GetMessage())
+		return pluginCore.DoTransition(pluginCore.PhaseInfoFailed(pluginCore.PhasePermanentFailure, run.execErr, nil)), nil
+	}
+	if run.outputs != nil {
+		if err := tCtx.OutputWriter().Put(ctx, ioutils.NewInMemoryOutputReader(run.outputs, nil, nil)); err != nil {
+			return pluginCore.UnknownTransition, err
+		}
+	}
+	return pluginCore.DoTransition(pluginCore.PhaseInfoSuccess(nil)), nil
+}
+
+func (p *plugin) remove(name string) *taskRun {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	run := p.runs[name]
+	delete(p.runs, name)
+	return run
+}
+
+func (p *plugin) Abort(ctx context.Context, tCtx pluginCore.TaskExecutionContext) error {
+	if run := p.remove(tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName()); run != nil {
+		run.cancel()
+	}
+	return nil
+}
+
+func (p *plugin) Finalize(ctx context.Context, tCtx pluginCore.TaskExecutionContext) error {
+	return nil
+}
@@ -0,0 +1,66 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytestdlib/utils"
+)
+
+// RecordedOutputs is a TaskRunner returning the recorded outputs of the tasks, by the name of the task, instead of
+// running them. It allows testing the control flow of workflows without any container runtime.
+type RecordedOutputs map[string]*core.LiteralMap
+
+func (r RecordedOutputs) Run(ctx context.Context, tCtx pluginCore.TaskExecutionContext) (*core.LiteralMap, *core.ExecutionError, error) {
+	task, err := tCtx.TaskReader().Read(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	name := task.GetId().GetName()
+	outputs, ok := r[name]
+	if !ok {
+		if len(task.GetInterface().GetOutputs().GetVariables()) == 0 {
+			return nil, nil, nil
+		}
+		return nil, &core.ExecutionError{
+			Code:    "RecordingNotFound",
+			Message: fmt.Sprintf("no recorded outputs for task [%s]", name),
+			Kind:    core.ExecutionError_USER,
+		}, nil
+	}
+	return outputs, nil, nil
+}
+
+// LoadRecordedOutputs reads the recorded outputs of the tasks from a json or yaml file, mapping the names of the tasks
+// to their outputs in the json representation of a LiteralMap.
+func LoadRecordedOutputs(path string) (RecordedOutputs, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse recorded outputs [%s]: %w", path, err)
+	}
+
+	recordings := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &recordings); err != nil {
+		return nil, fmt.Errorf("failed to parse recorded outputs [%s]: %w", path, err)
+	}
+	outputs := make(RecordedOutputs, len(recordings))
+	for name, recording := range recordings {
+		literals := &core.LiteralMap{}
+		if err := utils.UnmarshalBytesToPb(recording, literals); err != nil {
+			return nil, fmt.Errorf("failed to parse recorded outputs of task [%s]: %w", name, err)
+		}
+		outputs[name] = literals
+	}
+	return outputs, nil
+}
@@ -0,0 +1,187 @@
+// Package local executes workflows in-process with the node executor of propeller, without a Kubernetes cluster nor
+// flyteadmin. The workflow is kept in memory, its data in an in-memory store and its tasks are run by a TaskRunner,
+// e.g. with the local Docker daemon or from recorded outputs.
+package local
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytepropeller/events"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/executors"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/catalog"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/factory"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/subworkflow/launchplan"
+	taskConfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/config"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/workflow"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/workflowstore"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+const (
+	clusterID            = "local"
+	defaultRoundInterval = 100 * time.Millisecond
+)
+
+// signalClient fails the gate nodes waiting on signals, as there is no flyteadmin to set them.
+type signalClient struct{}
+
+func (signalClient) GetOrCreateSignal(context.Context, *admin.SignalGetOrCreateRequest, ...grpc.CallOption) (*admin.Signal, error) {
+	return nil, status.Error(codes.Unimplemented, "signals are not supported in local executions")
+}
+
+func (signalClient) ListSignals(context.Context, *admin.SignalListRequest, ...grpc.CallOption) (*admin.SignalList, error) {
+	return nil, status.Error(codes.Unimplemented, "signals are not supported in local executions")
+}
+
+func (signalClient) SetSignal(context.Context, *admin.SignalSetRequest, ...grpc.CallOption) (*admin.SignalSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "signals are not supported in local executions")
+}
+
+var _ service.SignalServiceClient = signalClient{}
+
+// Result of a local execution.
+type Result struct {
+	Phase v1alpha1.WorkflowPhase
+	// Message explains the phase of the workflow, e.g. the error it failed with.
+	Message string
+	// Nodes are the phases of the top level nodes of the workflow, by node id.
+	Nodes map[v1alpha1.NodeID]v1alpha1.NodePhase
+	// Outputs of the workflow, if it succeeded.
+	Outputs *core.LiteralMap
+}
+
+// Runner executes workflows in-process, evaluating them round after round like the controller does until they
+// terminate.
+type Runner struct {
+	store      *storage.DataStore
+	executor   executors.Workflow
+	taskRunner TaskRunner
+	// Interval between the rounds of evaluation of a workflow.
+	Interval time.Duration
+}
+
+// NewRunner returns a runner executing all the tasks with the task runner. It overrides the enabled task plugins of
+// the process, so it must not be used in a process running the controller.
+func NewRunner(ctx context.Context, taskRunner TaskRunner, scope promutils.Scope) (*Runner, error) {
+	RegisterPlugin()
+	taskPlugins := &taskConfig.GetConfig().TaskPlugins
+	taskPlugins.EnabledPlugins = []string{PluginID}
+	taskPlugins.DefaultForTaskTypes = map[string]string{}
+
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, scope.NewSubScope("data_store"))
+	if err != nil {
+		return nil, err
+	}
+	rawOutputPrefix, err := store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx), "raw")
+	if err != nil {
+		return nil, err
+	}
+
+	eventSink, err := events.NewLogSink()
+	if err != nil {
+		return nil, err
+	}
+	catalogClient, err := catalog.NewCatalogClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	launchPlanExecutor := launchplan.NewFailFastLaunchPlanExecutor()
+	eventConfig := &config.EventConfig{RawOutputPolicy: config.RawOutputPolicyReference}
+	enqueueWorkflow := func(v1alpha1.WorkflowID) {}
+
+	// The kubernetes clients are only used by the kubernetes plugins, which are not enabled, and the recovery client
+	// only when recovering executions from flyteadmin.
+	handlerFactory, err := factory.NewHandlerFactory(ctx, launchPlanExecutor, launchPlanExecutor, nil, nil,
+		catalogClient, nil, eventConfig, config.LiteralOffloadingConfig{}, clusterID, signalClient{}, scope.NewSubScope("handlers"))
+	if err != nil {
+		return nil, err
+	}
+	nodeExecutor, err := nodes.NewExecutor(ctx, config.GetConfig().NodeConfig, store, enqueueWorkflow, eventSink,
+		launchPlanExecutor, launchPlanExecutor, rawOutputPrefix, nil, catalogClient, nil, config.LiteralOffloadingConfig{},
+		eventConfig, clusterID, signalClient{}, handlerFactory, scope.NewSubScope("node"))
+	if err != nil {
+		return nil, err
+	}
+	statsHolder, err := workflowstore.NewExecutionStatsHolder()
+	if err != nil {
+		return nil, err
+	}
+	executor, err := workflow.NewExecutor(ctx, store, enqueueWorkflow, eventSink, &record.FakeRecorder{}, "",
+		nodeExecutor, eventConfig, clusterID, scope.NewSubScope("workflow"), statsHolder)
+	if err != nil {
+		return nil, err
+	}
+	if err := executor.Initialize(ctx); err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		store:      store,
+		executor:   executor,
+		taskRunner: taskRunner,
+		Interval:   defaultRoundInterval,
+	}, nil
+}
+
+// Run executes the workflow until it terminates, or the context is canceled.
+func (r *Runner) Run(ctx context.Context, w *v1alpha1.FlyteWorkflow) (*Result, error) {
+	ctx = withTaskRunner(ctx, r.taskRunner)
+	for !w.GetExecutionStatus().IsTerminated() {
+		if err := r.executor.HandleFlyteWorkflow(ctx, w); err != nil {
+			return nil, fmt.Errorf("failed to evaluate workflow [%s]: %w", w.GetID(), err)
+		}
+		for _, s := range w.Status.NodeStatus {
+			s.ResetDirty()
+		}
+		if w.GetExecutionStatus().IsTerminated() {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(r.Interval):
+		}
+	}
+
+	result := &Result{
+		Phase:   w.Status.Phase,
+		Message: w.Status.Message,
+		Nodes:   make(map[v1alpha1.NodeID]v1alpha1.NodePhase, len(w.Status.NodeStatus)),
+	}
+	if w.Status.Error != nil {
+		result.Message = w.Status.Error.GetMessage()
+	}
+	for id, s := range w.Status.NodeStatus {
+		result.Nodes[id] = s.Phase
+	}
+	if w.Status.Phase != v1alpha1.WorkflowPhaseSuccess || w.Status.GetOutputReference() == "" {
+		return result, nil
+	}
+	// The end node of a workflow without outputs does not write any.
+	metadata, err := r.store.Head(ctx, w.Status.GetOutputReference())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the outputs of workflow [%s]: %w", w.GetID(), err)
+	}
+	if metadata.Exists() {
+		result.Outputs = &core.LiteralMap{}
+		if err := r.store.ReadProtobuf(ctx, w.Status.GetOutputReference(), result.Outputs); err != nil {
+			return nil, fmt.Errorf("failed to read the outputs of workflow [%s]: %w", w.GetID(), err)
+		}
+	}
+	return result, nil
+}
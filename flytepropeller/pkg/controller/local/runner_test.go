@@ -0,0 +1,185 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+)
+
+func init() {
+	labeled.SetMetricKeys(contextutils.ProjectKey, contextutils.DomainKey, contextutils.WorkflowIDKey, contextutils.TaskIDKey)
+}
+
+func intVariables(names ...string) *core.VariableMap {
+	vars := &core.VariableMap{Variables: map[string]*core.Variable{}}
+	for _, name := range names {
+		vars.Variables[name] = &core.Variable{Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}}
+	}
+	return vars
+}
+
+func newTask(name, input, output string) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id:   &core.Identifier{ResourceType: core.ResourceType_TASK, Project: "p", Domain: "d", Name: name, Version: "v1"},
+		Type: "python-task",
+		Interface: &core.TypedInterface{
+			Inputs:  intVariables(input),
+			Outputs: intVariables(output),
+		},
+		Target: &core.TaskTemplate_Container{Container: &core.Container{
+			Image: "image:v1",
+			Args:  []string{"--inputs", "{{.input}}", "--outputs", "{{.outputPrefix}}"},
+		}},
+	}
+}
+
+func promise(nodeID, variable string) *core.BindingData {
+	return &core.BindingData{Value: &core.BindingData_Promise{Promise: &core.OutputReference{NodeId: nodeID, Var: variable}}}
+}
+
+func taskNode(id string, task *core.TaskTemplate, input string, from *core.BindingData) *core.Node {
+	return &core.Node{
+		Id:     id,
+		Inputs: []*core.Binding{{Var: input, Binding: from}},
+		Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{
+			Reference: &core.TaskNode_ReferenceId{ReferenceId: task.GetId()},
+		}},
+	}
+}
+
+// newWorkflow returns a workflow chaining two tasks, double and square, and the task templates it runs.
+func newWorkflow(t *testing.T) *v1alpha1.FlyteWorkflow {
+	double := newTask("double", "x", "y")
+	square := newTask("square", "y", "z")
+	wf := &core.WorkflowTemplate{
+		Id: &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Project: "p", Domain: "d", Name: "wf", Version: "v1"},
+		Interface: &core.TypedInterface{
+			Inputs:  intVariables("x"),
+			Outputs: intVariables("z"),
+		},
+		Nodes: []*core.Node{
+			taskNode("n0", double, "x", promise(v1alpha1.StartNodeID, "x")),
+			taskNode("n1", square, "y", promise("n0", "y")),
+		},
+		Outputs: []*core.Binding{{Var: "z", Binding: promise("n1", "z")}},
+	}
+
+	var tasks []*core.CompiledTask
+	for _, task := range []*core.TaskTemplate{double, square} {
+		compiled, err := compiler.CompileTask(task)
+		require.NoError(t, err)
+		tasks = append(tasks, compiled)
+	}
+	closure, err := compiler.CompileWorkflow(wf, []*core.WorkflowTemplate{}, tasks, []common.InterfaceProvider{})
+	require.NoError(t, err)
+
+	inputs := &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(3)}}
+	w, err := k8s.BuildFlyteWorkflow(closure, inputs, &core.WorkflowExecutionIdentifier{Project: "p", Domain: "d", Name: "exec"}, "ns")
+	require.NoError(t, err)
+	return w
+}
+
+func newRunner(t *testing.T, taskRunner TaskRunner) *Runner {
+	r, err := NewRunner(context.Background(), taskRunner, promutils.NewTestScope())
+	require.NoError(t, err)
+	r.Interval = time.Millisecond
+	return r
+}
+
+func intOutputs(name string, value int) *core.LiteralMap {
+	return &core.LiteralMap{Literals: map[string]*core.Literal{name: coreutils.MustMakeLiteral(value)}}
+}
+
+func TestRunner_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("recorded outputs", func(t *testing.T) {
+		r := newRunner(t, RecordedOutputs{
+			"double": intOutputs("y", 6),
+			"square": intOutputs("z", 36),
+		})
+
+		result, err := r.Run(ctx, newWorkflow(t))
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseSuccess, result.Phase, result.Message)
+		assert.Equal(t, v1alpha1.NodePhaseSucceeded, result.Nodes["n0"])
+		assert.Equal(t, v1alpha1.NodePhaseSucceeded, result.Nodes["n1"])
+		assert.Equal(t, int64(36), result.Outputs.GetLiterals()["z"].GetScalar().GetPrimitive().GetInteger())
+	})
+
+	t.Run("missing recording", func(t *testing.T) {
+		r := newRunner(t, RecordedOutputs{
+			"double": intOutputs("y", 6),
+		})
+
+		result, err := r.Run(ctx, newWorkflow(t))
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowPhaseFailed, result.Phase)
+		assert.Equal(t, v1alpha1.NodePhaseSucceeded, result.Nodes["n0"])
+		assert.Equal(t, v1alpha1.NodePhaseFailed, result.Nodes["n1"])
+		assert.Contains(t, result.Message, "no recorded outputs for task [square]")
+		assert.Nil(t, result.Outputs)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		r := newRunner(t, blockingRunner{release: release})
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := r.Run(ctx, newWorkflow(t))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// blockingRunner runs the tasks until they are released.
+type blockingRunner struct {
+	release chan struct{}
+}
+
+func (r blockingRunner) Run(ctx context.Context, _ pluginCore.TaskExecutionContext) (*core.LiteralMap, *core.ExecutionError, error) {
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case <-r.release:
+		return nil, nil, nil
+	}
+}
+
+func TestLoadRecordedOutputs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outputs.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join([]string{
+		"double:",
+		"  literals:",
+		"    doubled:",
+		"      scalar:",
+		"        primitive:",
+		"          integer: 6",
+	}, "\n")), 0600))
+
+	outputs, err := LoadRecordedOutputs(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), outputs["double"].GetLiterals()["doubled"].GetScalar().GetPrimitive().GetInteger())
+
+	require.NoError(t, os.WriteFile(path, []byte("double: [1]"), 0600))
+	_, err = LoadRecordedOutputs(path)
+	assert.ErrorContains(t, err, "failed to parse recorded outputs of task [double]")
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flytepropeller/events"
 	eventsErr "github.com/flyteorg/flyte/flytepropeller/events/errors"
@@ -154,8 +155,7 @@ func IsMaxParallelismAchieved(ctx context.Context, currentNode v1alpha1.Executab
 		return false
 	}
 
-	if currentNode.GetKind() == v1alpha1.NodeKindTask || currentNode.GetKind() == v1alpha1.NodeKindArray ||
-		(currentNode.GetKind() == v1alpha1.NodeKindWorkflow && currentNode.GetWorkflowNode() != nil && currentNode.GetWorkflowNode().GetLaunchPlanRefID() != nil) {
+	if launchesExecution(currentNode) {
 		// If we are queued, let us see if we can proceed within the node parallelism bounds
 		if execContext.CurrentParallelism() >= maxParallelism {
 			logger.Infof(ctx, "Maximum Parallelism for task/launch-plan nodes achieved [%d] >= Max [%d], Round will be short-circuited.", execContext.CurrentParallelism(), maxParallelism)
@@ -172,6 +172,42 @@ func IsMaxParallelismAchieved(ctx context.Context, currentNode v1alpha1.Executab
 	return false
 }
 
+// launchesExecution returns true for the nodes executing tasks or launch plans, which are the ones parallelism control and
+// pausing apply to.
+func launchesExecution(node v1alpha1.ExecutableNode) bool {
+	return node.GetKind() == v1alpha1.NodeKindTask || node.GetKind() == v1alpha1.NodeKindArray ||
+		(node.GetKind() == v1alpha1.NodeKindWorkflow && node.GetWorkflowNode() != nil && node.GetWorkflowNode().GetLaunchPlanRefID() != nil)
+}
+
+// isRerun returns whether a node of the top-level workflow is the one its execution re-runs from, or is downstream of
+// it.
+func isRerun(execContext executors.ExecutionContext, dag executors.DAGStructure, nodeID v1alpha1.NodeID) bool {
+	executionConfig := execContext.GetExecutionConfig()
+	if executionConfig.RerunFrom == nil || executionConfig.RecoveryExecution.WorkflowExecutionIdentifier == nil ||
+		execContext.GetParentInfo() != nil {
+		return false
+	}
+	visited := make(map[v1alpha1.NodeID]bool)
+	toVisit := []v1alpha1.NodeID{nodeID}
+	for len(toVisit) > 0 {
+		id := toVisit[0]
+		toVisit = toVisit[1:]
+		if id == executionConfig.RerunFrom.NodeID {
+			return true
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		upstream, err := dag.ToNode(id)
+		if err != nil {
+			return false
+		}
+		toVisit = append(toVisit, upstream...)
+	}
+	return false
+}
+
 // RecursiveNodeHandler This is the entrypoint of executing a node in a workflow. A workflow consists of nodes, that are
 // nested within other nodes. The system follows an actor model, where the parent nodes control the execution of nested nodes
 // The recursive node-handler uses a modified depth-first type of algorithm to execute non-blocked nodes.
@@ -223,7 +259,13 @@ func (c *recursiveNodeExecutor) RecursiveNodeHandler(ctx context.Context, execCo
 			return interfaces.NodeStatusRunning, nil
 		}
 
-		nCtx, err := c.nCtxBuilder.BuildNodeExecutionContext(ctx, execContext, nl, currentNode.GetID())
+		nodeExecContext := execContext
+		if isRerun(execContext, dag, currentNode.GetID()) {
+			// The node re-runs, and so do the ones nested in it.
+			nodeExecContext = executors.NewExecutionContextWithoutRecovery(execContext)
+		}
+
+		nCtx, err := c.nCtxBuilder.BuildNodeExecutionContext(ctx, nodeExecContext, nl, currentNode.GetID())
 		if err != nil {
 			// NodeExecution creation failure is a permanent fail / system error.
 			// Should a system failure always return an err?
@@ -734,6 +776,19 @@ func (c *nodeExecutor) attemptRecovery(ctx context.Context, nCtx interfaces.Node
 	return handler.PhaseInfoRecovered(info), nil
 }
 
+// overrideInputs returns the inputs resolved for a node with the ones in overrides in place of the ones of the same
+// name.
+func overrideInputs(inputs *core.LiteralMap, overrides *core.LiteralMap) *core.LiteralMap {
+	literals := make(map[string]*core.Literal, len(inputs.GetLiterals())+len(overrides.GetLiterals()))
+	for name, literal := range inputs.GetLiterals() {
+		literals[name] = literal
+	}
+	for name, literal := range overrides.GetLiterals() {
+		literals[name] = literal
+	}
+	return &core.LiteralMap{Literals: literals}
+}
+
 // In this method we check if the queue is ready to be processed and if so, we prime it in Admin as queued
 // Before we start the node execution, we need to transition this Node status to Queued.
 // This is because a node execution has to exist before task/wf executions can start.
@@ -773,6 +828,11 @@ func (c *nodeExecutor) preExecute(ctx context.Context, dag executors.DAGStructur
 				return handler.PhaseInfoFailure(core.ExecutionError_SYSTEM, "BindingResolutionFailure", err.Error(), nil), nil
 			}
 
+			if rerunFrom := nCtx.ExecutionContext().GetExecutionConfig().RerunFrom; rerunFrom != nil &&
+				rerunFrom.NodeID == node.GetID() && rerunFrom.Inputs != nil && nCtx.ExecutionContext().GetParentInfo() == nil {
+				nodeInputs = overrideInputs(nodeInputs, rerunFrom.Inputs.LiteralMap)
+			}
+
 			if nodeInputs != nil {
 				// Resolve error input if current node is an on failure node
 				failureNodeLookup, ok := nCtx.ContextualNodeLookup().(executors.FailureNodeLookup)
@@ -1337,6 +1397,42 @@ func (c *nodeExecutor) handleQueuedOrRunningNode(ctx context.Context, nCtx inter
 	return finalStatus, nil
 }
 
+// isRunningDynamicWorkflow returns true for the dynamic nodes whose parent task completed, and which are running the
+// workflow it generated. Pausing doesn't abort these, since that would abort the whole dynamic workflow: their task
+// nodes are aborted one by one instead, as for any other workflow.
+func isRunningDynamicWorkflow(nodeStatus v1alpha1.ExecutableNodeStatus) bool {
+	dynamicStatus := nodeStatus.GetDynamicNodeStatus()
+	return dynamicStatus != nil && dynamicStatus.GetDynamicNodePhase() != v1alpha1.DynamicNodePhaseNone
+}
+
+// handlePausedNode aborts the current attempt of a task node of a paused workflow, which re-queues the node to start a
+// new attempt once the workflow is resumed. The aborted attempt counts as a system failure of the node, so that it does
+// not use up the retries of the task.
+func (c *nodeExecutor) handlePausedNode(ctx context.Context, nCtx interfaces.NodeExecutionContext, h interfaces.NodeHandler) (interfaces.NodeStatus, error) {
+	nodeStatus := nCtx.NodeStatus()
+	taskStatus := nodeStatus.GetTaskNodeStatus()
+	if taskStatus == nil {
+		// The node was re-queued already, or has not launched its attempt yet.
+		return interfaces.NodeStatusPending, nil
+	}
+	if pluginCore.Phase(taskStatus.GetPhase()).IsSuccess() {
+		// Let the node complete rather than discarding the outputs of its attempt.
+		return c.handleQueuedOrRunningNode(ctx, nCtx, h)
+	}
+
+	logger.Infof(ctx, "Workflow is paused, aborting the current attempt of the node to re-queue it")
+	if err := c.Abort(ctx, h, nCtx, "workflow paused", false); err != nil {
+		return interfaces.NodeStatusUndefined, err
+	}
+
+	// As for retries, attempts must only be incremented after abort has been called.
+	nodeStatus.IncrementAttempts()
+	nodeStatus.IncrementSystemFailures()
+	nodeStatus.UpdatePhase(v1alpha1.NodePhaseRunning, metav1.Now(), "re-queued, workflow paused", c.enableCRDebugMetadata, nil)
+	nodeStatus.ClearTaskStatus()
+	return interfaces.NodeStatusPending, nil
+}
+
 func (c *nodeExecutor) handleRetryableFailure(ctx context.Context, nCtx interfaces.NodeExecutionContext, h interfaces.NodeHandler) (interfaces.NodeStatus, error) {
 	nodeStatus := nCtx.NodeStatus()
 	logger.Debugf(ctx, "node failed with retryable failure, aborting and finalizing, message: %s", nodeStatus.GetMessage())
@@ -1368,6 +1464,17 @@ func (c *nodeExecutor) HandleNode(ctx context.Context, dag executors.DAGStructur
 	nodeStatus := nCtx.NodeStatus()
 	currentPhase := nodeStatus.GetPhase()
 
+	if pause := nCtx.ExecutionContext().GetExecutionConfig().Pause; pause != nil && launchesExecution(nCtx.Node()) {
+		if currentPhase == v1alpha1.NodePhaseNotYetStarted {
+			logger.Debugf(ctx, "Workflow is paused, node will be started once it is resumed")
+			return interfaces.NodeStatusPending, nil
+		}
+		if pause.AbortRunningNodes && nCtx.Node().GetKind() == v1alpha1.NodeKindTask && !isRunningDynamicWorkflow(nodeStatus) &&
+			(currentPhase == v1alpha1.NodePhaseQueued || currentPhase == v1alpha1.NodePhaseRunning) {
+			return c.handlePausedNode(ctx, nCtx, h)
+		}
+	}
+
 	// Optimization!
 	// If it is start node we directly move it to Queued without needing to run preExecute
 	if currentPhase == v1alpha1.NodePhaseNotYetStarted && !nCtx.Node().IsStartNode() {
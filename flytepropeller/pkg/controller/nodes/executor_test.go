@@ -21,6 +21,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
 	pluginscatalog "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
 	catalogmocks "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog/mocks"
+	pluginCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	mocks3 "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/events"
 	eventsErr "github.com/flyteorg/flyte/flytepropeller/events/errors"
@@ -2860,3 +2861,123 @@ func TestNodeExecutor_IsEligibleForRetry(t *testing.T) {
 	}
 
 }
+
+func TestNodeExecutor_HandleNode_Paused(t *testing.T) {
+	ctx := context.Background()
+	exec := &nodeExecutor{}
+	pausedExecContext := func(abortRunningNodes bool) *mocks4.ExecutionContext {
+		execContext := &mocks4.ExecutionContext{}
+		execContext.EXPECT().GetExecutionConfig().Return(v1alpha1.ExecutionConfig{
+			Pause: &v1alpha1.PauseConfig{AbortRunningNodes: abortRunningNodes},
+		})
+		return execContext
+	}
+	taskNode := &mocks.ExecutableNode{}
+	taskNode.EXPECT().GetKind().Return(v1alpha1.NodeKindTask)
+	taskNode.EXPECT().GetID().Return("node")
+
+	t.Run("not-yet-started", func(t *testing.T) {
+		nodeStatus := &v1alpha1.NodeStatus{Phase: v1alpha1.NodePhaseNotYetStarted}
+		nCtx := &nodeExecContext{node: taskNode, nodeStatus: nodeStatus, ic: pausedExecContext(false)}
+
+		s, err := exec.HandleNode(ctx, nil, nCtx, &nodemocks.NodeHandler{})
+		assert.NoError(t, err)
+		assert.Equal(t, interfaces.NodePhasePending, s.NodePhase)
+		assert.Equal(t, v1alpha1.NodePhaseNotYetStarted, nodeStatus.GetPhase())
+	})
+
+	t.Run("abort-running", func(t *testing.T) {
+		nodeStatus := &v1alpha1.NodeStatus{
+			Phase:          v1alpha1.NodePhaseRunning,
+			TaskNodeStatus: &v1alpha1.TaskNodeStatus{Phase: int(pluginCore.PhaseRunning)},
+		}
+		nCtx := &nodeExecContext{node: taskNode, nodeStatus: nodeStatus, ic: pausedExecContext(true)}
+		h := &nodemocks.NodeHandler{}
+		h.EXPECT().Abort(mock.Anything, mock.Anything, "workflow paused").Return(nil)
+		h.EXPECT().Finalize(mock.Anything, mock.Anything).Return(nil)
+
+		s, err := exec.HandleNode(ctx, nil, nCtx, h)
+		assert.NoError(t, err)
+		assert.Equal(t, interfaces.NodePhasePending, s.NodePhase)
+		assert.Equal(t, v1alpha1.NodePhaseRunning, nodeStatus.GetPhase())
+		assert.Equal(t, uint32(1), nodeStatus.GetAttempts())
+		assert.Equal(t, uint32(1), nodeStatus.GetSystemFailures())
+		assert.Nil(t, nodeStatus.GetTaskNodeStatus())
+
+		// The re-queued node is not started again while the workflow is paused.
+		s, err = exec.HandleNode(ctx, nil, nCtx, h)
+		assert.NoError(t, err)
+		assert.Equal(t, interfaces.NodePhasePending, s.NodePhase)
+		assert.Equal(t, uint32(1), nodeStatus.GetAttempts())
+		h.AssertNumberOfCalls(t, "Abort", 1)
+	})
+}
+
+func TestIsRunningDynamicWorkflow(t *testing.T) {
+	assert.False(t, isRunningDynamicWorkflow(&v1alpha1.NodeStatus{}))
+	assert.False(t, isRunningDynamicWorkflow(&v1alpha1.NodeStatus{
+		DynamicNodeStatus: &v1alpha1.DynamicNodeStatus{Phase: v1alpha1.DynamicNodePhaseNone},
+	}))
+	assert.True(t, isRunningDynamicWorkflow(&v1alpha1.NodeStatus{
+		DynamicNodeStatus: &v1alpha1.DynamicNodeStatus{Phase: v1alpha1.DynamicNodePhaseExecuting},
+	}))
+}
+
+func TestIsRerun(t *testing.T) {
+	// n0 -> n1 -> n2, n0 -> n3
+	dag := &mocks4.DAGStructure{}
+	dag.EXPECT().ToNode(v1alpha1.StartNodeID).Return(nil, nil)
+	dag.EXPECT().ToNode("n0").Return([]v1alpha1.NodeID{v1alpha1.StartNodeID}, nil)
+	dag.EXPECT().ToNode("n1").Return([]v1alpha1.NodeID{"n0"}, nil)
+	dag.EXPECT().ToNode("n2").Return([]v1alpha1.NodeID{"n1"}, nil)
+	dag.EXPECT().ToNode("n3").Return([]v1alpha1.NodeID{"n0"}, nil)
+
+	createExecContext := func(rerunFrom *v1alpha1.RerunConfig, recovering bool, parentInfo executors.ImmutableParentInfo) executors.ExecutionContext {
+		executionConfig := v1alpha1.ExecutionConfig{RerunFrom: rerunFrom}
+		if recovering {
+			executionConfig.RecoveryExecution.WorkflowExecutionIdentifier = &core.WorkflowExecutionIdentifier{Name: "recovered"}
+		}
+		m := &mocks4.ExecutionContext{}
+		m.EXPECT().GetExecutionConfig().Return(executionConfig)
+		m.EXPECT().GetParentInfo().Return(parentInfo)
+		return m
+	}
+
+	rerunFrom := &v1alpha1.RerunConfig{NodeID: "n1"}
+	execContext := createExecContext(rerunFrom, true, nil)
+	assert.False(t, isRerun(execContext, dag, "n0"))
+	assert.True(t, isRerun(execContext, dag, "n1"))
+	assert.True(t, isRerun(execContext, dag, "n2"))
+	assert.False(t, isRerun(execContext, dag, "n3"))
+
+	t.Run("no-rerun", func(t *testing.T) {
+		assert.False(t, isRerun(createExecContext(nil, true, nil), dag, "n1"))
+	})
+
+	t.Run("not-recovering", func(t *testing.T) {
+		assert.False(t, isRerun(createExecContext(rerunFrom, false, nil), dag, "n1"))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		assert.False(t, isRerun(createExecContext(rerunFrom, true, executors.NewParentInfo("n5", 0, false)), dag, "n1"))
+	})
+}
+
+func TestOverrideInputs(t *testing.T) {
+	inputs := &core.LiteralMap{Literals: map[string]*core.Literal{
+		"x": coreutils.MustMakeLiteral(1),
+		"y": coreutils.MustMakeLiteral("a"),
+	}}
+	overridden := overrideInputs(inputs, &core.LiteralMap{Literals: map[string]*core.Literal{
+		"y": coreutils.MustMakeLiteral("b"),
+	}})
+	assert.True(t, proto.Equal(&core.LiteralMap{Literals: map[string]*core.Literal{
+		"x": coreutils.MustMakeLiteral(1),
+		"y": coreutils.MustMakeLiteral("b"),
+	}}, overridden))
+	assert.True(t, proto.Equal(coreutils.MustMakeLiteral("a"), inputs.GetLiterals()["y"]))
+
+	assert.Len(t, overrideInputs(nil, &core.LiteralMap{Literals: map[string]*core.Literal{
+		"x": coreutils.MustMakeLiteral(1),
+	}}).GetLiterals(), 1)
+}
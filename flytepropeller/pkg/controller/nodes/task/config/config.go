@@ -107,3 +107,13 @@ func (p TaskPluginConfig) GetEnabledPlugins() (PluginsConfigMeta, error) {
 func GetConfig() *Config {
 	return section.GetConfig().(*Config)
 }
+
+// Validate checks that the default plugins of task types are enabled. Reloads with invalid plugin configs are rejected.
+func (c *Config) Validate() error {
+	if c.MaxPluginPhaseVersions <= 0 {
+		return fmt.Errorf("max-plugin-phase-versions must be positive, got [%v]", c.MaxPluginPhaseVersions)
+	}
+
+	_, err := c.TaskPlugins.GetEnabledPlugins()
+	return err
+}
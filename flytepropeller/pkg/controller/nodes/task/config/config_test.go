@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, defaultConfig.Validate())
+
+	t.Run("default plugin not enabled", func(t *testing.T) {
+		cfg := *defaultConfig
+		cfg.TaskPlugins = TaskPluginConfig{
+			EnabledPlugins:      []string{"container"},
+			DefaultForTaskTypes: map[string]string{"sidecar": "sidecar"},
+		}
+		assert.Error(t, cfg.Validate())
+	})
+
+	t.Run("max plugin phase versions", func(t *testing.T) {
+		cfg := *defaultConfig
+		cfg.MaxPluginPhaseVersions = 0
+		assert.Error(t, cfg.Validate())
+	})
+}
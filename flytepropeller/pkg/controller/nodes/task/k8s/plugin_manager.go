@@ -53,6 +53,9 @@ const (
 
 const pluginStateVersion = 1
 
+// How often the shared resources of plugins that implement k8s.PluginSharedResources are garbage collected.
+const sharedResourcesGCInterval = time.Minute
+
 type PluginPhase uint8
 
 const (
@@ -65,6 +68,8 @@ type PluginState struct {
 	Phase           PluginPhase
 	K8sPluginState  k8s.PluginState
 	LastEventUpdate time.Time
+	// PreemptedAt is the time a termination notice was detected for an interruptible pod, zero if there was none.
+	PreemptedAt time.Time
 }
 
 type PluginMetrics struct {
@@ -73,6 +78,7 @@ type PluginMetrics struct {
 	GetCacheHit     labeled.StopWatch
 	GetAPILatency   labeled.StopWatch
 	ResourceDeleted labeled.Counter
+	Preemptions     labeled.Counter
 	TaskPodErrors   *prometheus.CounterVec
 }
 
@@ -87,6 +93,8 @@ func newPluginMetrics(s promutils.Scope) PluginMetrics {
 			time.Millisecond, s),
 		ResourceDeleted: labeled.NewCounter("pods_deleted", "Counts how many times CheckTaskStatus is"+
 			" called with a deleted resource.", s),
+		Preemptions: labeled.NewCounter("pods_preempted", "Counts how many interruptible pods were shut down"+
+			" gracefully because of a termination notice.", s),
 		TaskPodErrors: s.MustNewCounterVec("task_pod_errors", "Counts how many times task pods failed in given phase with given code",
 			"phase", "error_code"),
 	}
@@ -226,6 +234,14 @@ func (e *PluginManager) launchResource(ctx context.Context, tCtx pluginsCore.Tas
 	}
 
 	e.addObjectMetadata(k8sTaskCtxMetadata, o, config.GetK8sPluginConfig(), taskTemplate)
+
+	if sharedResources, ok := e.plugin.(k8s.PluginSharedResources); ok {
+		if err := sharedResources.EnsureSharedResources(ctx, k8sTaskCtx, e.kubeClient.GetClient(), o); err != nil {
+			logger.Errorf(ctx, "Failed to ensure shared resources for plugin [%s], err %s", e.id, err)
+			return pluginsCore.UnknownTransition, errors.Wrapf(errors.RuntimeFailure, err, "failed to ensure shared resources")
+		}
+	}
+
 	logger.Infof(ctx, "Creating Object: Type:[%v], Object:[%v/%v]", o.GetObjectKind().GroupVersionKind(), o.GetNamespace(), o.GetName())
 
 	key := backoff.ComposeResourceKey(o)
@@ -290,7 +306,7 @@ func (e *PluginManager) getResource(ctx context.Context, tCtx pluginsCore.TaskEx
 	return o, nil
 }
 
-func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object, k8sPluginState *k8s.PluginState) (pluginsCore.Transition, error) {
+func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object, k8sPluginState *k8s.PluginState, preempting bool) (pluginsCore.Transition, error) {
 	nsName := k8stypes.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
 	// Attempt to get resource from informer cache, if not found, retrieve it from API server.
 	if err := e.kubeClient.GetClient().Get(ctx, nsName, o); err != nil {
@@ -338,11 +354,12 @@ func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore
 		return pluginsCore.DoTransition(p), nil
 	}
 
-	if !p.Phase().IsTerminal() && !o.GetDeletionTimestamp().IsZero() {
+	if !p.Phase().IsTerminal() && !o.GetDeletionTimestamp().IsZero() && !preempting {
 		// If the object has been deleted, that is, it has a deletion timestamp, but is not in a terminal state, we should
 		// mark the task as a retryable failure.  We've seen this happen when a kubelet disappears - all pods running on
 		// the node are marked with a deletionTimestamp, but our finalizer prevents the pod from being deleted.
-		// This can also happen when a user deletes a Pod directly.
+		// This can also happen when a user deletes a Pod directly. Preempted pods are deleted on purpose and are given
+		// time to shut down gracefully.
 		failureReason := fmt.Sprintf("object [%s] terminated in the background, manually", nsName.String())
 		return pluginsCore.DoTransition(pluginsCore.PhaseInfoSystemRetryableFailure("UnexpectedObjectDeletion", failureReason, nil)), nil
 	}
@@ -366,6 +383,7 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 	var transition pluginsCore.Transition
 	var o client.Object
 	pluginPhase := pluginState.Phase
+	preemptedAt := pluginState.PreemptedAt
 	if pluginState.Phase == PluginPhaseNotStarted {
 		transition, err = e.launchResource(ctx, tCtx)
 		if err == nil && transition.Info().Phase() == pluginsCore.PhaseQueued {
@@ -377,7 +395,10 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 			transition, err = pluginsCore.DoTransition(pluginsCore.PhaseInfoFailure("BadTaskDefinition",
 				fmt.Sprintf("Failed to build resource, caused by: %s", err.Error()), nil)), nil
 		} else {
-			transition, err = e.checkResourcePhase(ctx, tCtx, o, &pluginState.K8sPluginState)
+			transition, err = e.checkResourcePhase(ctx, tCtx, o, &pluginState.K8sPluginState, !preemptedAt.IsZero())
+			if err == nil {
+				transition, preemptedAt, err = e.handlePreemption(ctx, tCtx, o, preemptedAt, transition)
+			}
 		}
 	}
 
@@ -418,6 +439,7 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 			Reason:       phaseInfo.Reason(),
 		},
 		LastEventUpdate: lastEventUpdate,
+		PreemptedAt:     preemptedAt,
 	}
 	if pluginState != newPluginState {
 		if err := tCtx.PluginStateWriter().Put(pluginStateVersion, &newPluginState); err != nil {
@@ -719,6 +741,14 @@ func NewPluginManager(ctx context.Context, iCtx pluginsCore.SetupContext, entry
 	// Start the poller and gauge emitter
 	rm.RunCollectorOnce(ctx)
 
+	if sharedResources, ok := entry.Plugin.(k8s.PluginSharedResources); ok && sharedResources.SharedResourcesEnabled() {
+		go wait.UntilWithContext(ctx, func(ctx context.Context) {
+			if err := sharedResources.GarbageCollectSharedResources(ctx, kubeClient.GetClient()); err != nil {
+				logger.Warnf(ctx, "Failed to garbage collect shared resources of plugin [%s]. Error: %v", entry.ID, err)
+			}
+		}, sharedResourcesGCInterval)
+	}
+
 	return &PluginManager{
 		id:                        entry.ID,
 		plugin:                    entry.Plugin,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,6 +30,7 @@ import (
 	pluginsk8sMock "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/executors/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/backoff"
+	flytestdlibConfig "github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
@@ -127,6 +129,11 @@ func (p *pluginWithAbortOverride) OnAbort(ctx context.Context, tCtx pluginsCore.
 	return args.Get(0).(k8s.AbortBehavior), args.Error(1)
 }
 
+type pluginWithSharedResources struct {
+	*pluginsk8sMock.Plugin
+	*pluginsk8sMock.PluginSharedResources
+}
+
 func ExampleNewPluginManager() {
 	sCtx := &pluginsCoreMock.SetupContext{}
 	fakeKubeClient := mocks.NewFakeKubeClient()
@@ -340,6 +347,65 @@ func TestK8sTaskExecutor_Handle_LaunchResource(t *testing.T) {
 		assert.NoError(t, fakeClient.Delete(ctx, createdPod))
 	})
 
+	t.Run("sharedResourcesEnsured", func(t *testing.T) {
+		tCtx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseStarted)
+		mockResourceHandler := &pluginsk8sMock.Plugin{}
+		mockResourceHandler.EXPECT().GetProperties().Return(k8s.PluginProperties{})
+		mockResourceHandler.EXPECT().BuildResource(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		fakeClient := fake.NewClientBuilder().WithRuntimeObjects().Build()
+		sharedResources := &pluginsk8sMock.PluginSharedResources{}
+		sharedResources.EXPECT().SharedResourcesEnabled().Return(false)
+		sharedResources.EXPECT().EnsureSharedResources(mock.Anything, mock.Anything, fakeClient, mock.Anything).
+			RunAndReturn(func(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, kubeClient client.Client, o client.Object) error {
+				// The resource is complete by the time the shared resources are ensured
+				assert.Equal(t, tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName(), o.GetName())
+				o.SetAnnotations(map[string]string{"shared": "true"})
+				return nil
+			})
+		mockClientset := k8sfake.NewSimpleClientset()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          pluginWithSharedResources{mockResourceHandler, sharedResources},
+		}, NewResourceMonitorIndex(), mockClientset)
+		assert.NoError(t, err)
+
+		transition, err := pluginManager.Handle(ctx, tCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseQueued, transition.Info().Phase())
+
+		createdPod := &v1.Pod{}
+		assert.NoError(t, fakeClient.Get(ctx, k8stypes.NamespacedName{Namespace: tCtx.TaskExecutionMetadata().GetNamespace(),
+			Name: tCtx.TaskExecutionMetadata().GetTaskExecutionID().GetGeneratedName()}, createdPod))
+		assert.Equal(t, "true", createdPod.GetAnnotations()["shared"])
+	})
+
+	t.Run("sharedResourcesFailed", func(t *testing.T) {
+		tCtx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseStarted)
+		mockResourceHandler := &pluginsk8sMock.Plugin{}
+		mockResourceHandler.EXPECT().GetProperties().Return(k8s.PluginProperties{})
+		mockResourceHandler.EXPECT().BuildResource(mock.Anything, mock.Anything).Return(&v1.Pod{}, nil)
+		sharedResources := &pluginsk8sMock.PluginSharedResources{}
+		sharedResources.EXPECT().SharedResourcesEnabled().Return(false)
+		sharedResources.EXPECT().EnsureSharedResources(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("shared cluster is being deleted"))
+		fakeClient := fake.NewClientBuilder().WithRuntimeObjects().Build()
+		mockClientset := k8sfake.NewSimpleClientset()
+		pluginManager, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+			ID:              "x",
+			ResourceToWatch: &v1.Pod{},
+			Plugin:          pluginWithSharedResources{mockResourceHandler, sharedResources},
+		}, NewResourceMonitorIndex(), mockClientset)
+		assert.NoError(t, err)
+
+		_, err = pluginManager.Handle(ctx, tCtx)
+		assert.Error(t, err)
+
+		pods := &v1.PodList{}
+		assert.NoError(t, fakeClient.List(ctx, pods))
+		assert.Empty(t, pods.Items)
+	})
+
 	t.Run("jobQuotaExceeded", func(t *testing.T) {
 		tctx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseNotStarted)
 		// common setup code
@@ -869,6 +935,218 @@ func TestPluginManager_Handle_PluginState(t *testing.T) {
 	}
 }
 
+func TestPluginManager_Handle_Preemption(t *testing.T) {
+	ctx := context.TODO()
+	prevCfg := *config.GetK8sPluginConfig()
+	defer func() {
+		assert.NoError(t, config.SetK8sPluginConfig(&prevCfg))
+	}()
+	cfg := prevCfg
+	cfg.InterruptiblePreemption = config.InterruptiblePreemptionConfig{
+		Enabled:     true,
+		NodeTaints:  []string{"karpenter.sh/disrupted"},
+		GracePeriod: flytestdlibConfig.Duration{Duration: time.Minute},
+	}
+	assert.NoError(t, config.SetK8sPluginConfig(&cfg))
+
+	newPod := func(nodeName string, conditions ...v1.PodCondition) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+			},
+			Spec: v1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: v1.PodStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+	disruptionTarget := v1.PodCondition{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: "PreemptionByScheduler"}
+	taintedNode := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "tainted"},
+		Spec: v1.NodeSpec{
+			Taints: []v1.Taint{{Key: "karpenter.sh/disrupted", Effect: v1.TaintEffectNoSchedule}},
+		},
+	}
+	healthyNode := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "healthy"},
+	}
+
+	tests := []struct {
+		name              string
+		pod               *v1.Pod
+		interruptible     bool
+		preemptedAt       time.Time
+		reportedPhaseInfo pluginsCore.PhaseInfo
+		wantPhase         pluginsCore.Phase
+		wantErrCode       string
+		wantPreempted     bool
+		wantPodDeleted    bool
+		wantReason        string
+	}{
+		{
+			name:              "DisruptionTargetCondition",
+			pod:               newPod("healthy", disruptionTarget),
+			interruptible:     true,
+			reportedPhaseInfo: pluginsCore.PhaseInfoRunning(1, nil),
+			wantPhase:         pluginsCore.PhaseRunning,
+			wantPreempted:     true,
+			wantPodDeleted:    true,
+			wantReason:        "Preempted: pod is targeted for disruption",
+		},
+		{
+			name:              "NodeTaint",
+			pod:               newPod("tainted"),
+			interruptible:     true,
+			reportedPhaseInfo: pluginsCore.PhaseInfoRunning(1, nil),
+			wantPhase:         pluginsCore.PhaseRunning,
+			wantPreempted:     true,
+			wantPodDeleted:    true,
+		},
+		{
+			name:              "NoNotice",
+			pod:               newPod("healthy"),
+			interruptible:     true,
+			reportedPhaseInfo: pluginsCore.PhaseInfoRunning(1, nil),
+			wantPhase:         pluginsCore.PhaseRunning,
+		},
+		{
+			name:              "NotInterruptible",
+			pod:               newPod("tainted", disruptionTarget),
+			reportedPhaseInfo: pluginsCore.PhaseInfoRunning(1, nil),
+			wantPhase:         pluginsCore.PhaseRunning,
+		},
+		{
+			name:              "FailedWhilePreempting",
+			pod:               newPod("tainted"),
+			interruptible:     true,
+			preemptedAt:       time.Now(),
+			reportedPhaseInfo: pluginsCore.PhaseInfoRetryableFailure("OOMKilled", "", nil),
+			wantPhase:         pluginsCore.PhaseRetryableFailure,
+			wantErrCode:       preemptedErrorCode,
+			wantPreempted:     true,
+		},
+		{
+			name:              "RunningWithinGracePeriod",
+			pod:               newPod("tainted"),
+			interruptible:     true,
+			preemptedAt:       time.Now(),
+			reportedPhaseInfo: pluginsCore.PhaseInfoRunning(2, nil),
+			wantPhase:         pluginsCore.PhaseRunning,
+			wantPreempted:     true,
+		},
+		{
+			name:              "GracePeriodExceeded",
+			pod:               newPod("tainted"),
+			interruptible:     true,
+			preemptedAt:       time.Now().Add(-2 * time.Minute),
+			reportedPhaseInfo: pluginsCore.PhaseInfoRunning(2, nil),
+			wantPhase:         pluginsCore.PhaseRetryableFailure,
+			wantErrCode:       preemptedErrorCode,
+			wantPreempted:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := getMockTaskExecutionMetadata().(*pluginsCoreMock.TaskExecutionMetadata)
+			tm.On("IsInterruptible").Return(tt.interruptible)
+			tCtx := &pluginsCoreMock.TaskExecutionContext{}
+			tCtx.EXPECT().TaskExecutionMetadata().Return(tm)
+
+			tReader := &pluginsCoreMock.TaskReader{}
+			tReader.EXPECT().Read(mock.Anything).Return(&core.TaskTemplate{}, nil)
+			tCtx.EXPECT().TaskReader().Return(tReader)
+
+			pluginState := &PluginState{
+				Phase:       PluginPhaseStarted,
+				PreemptedAt: tt.preemptedAt,
+			}
+			customStateReader := &pluginsCoreMock.PluginStateReader{}
+			customStateReader.EXPECT().Get(mock.MatchedBy(func(i interface{}) bool {
+				ps, ok := i.(*PluginState)
+				if ok {
+					*ps = *pluginState
+					return true
+				}
+				return false
+			})).Return(uint8(0), nil)
+			tCtx.EXPECT().PluginStateReader().Return(customStateReader)
+
+			customStateWriter := &pluginsCoreMock.PluginStateWriter{}
+			customStateWriter.EXPECT().Put(mock.Anything, mock.MatchedBy(func(i interface{}) bool {
+				ps, ok := i.(*PluginState)
+				if ok {
+					*pluginState = *ps
+				}
+				return ok
+			})).Return(nil)
+			tCtx.EXPECT().PluginStateWriter().Return(customStateWriter)
+
+			fc := extendedFakeClient{Client: fake.NewFakeClient(tt.pod, taintedNode, healthyNode)}
+			mockResourceHandler := &pluginsk8sMock.Plugin{}
+			mockResourceHandler.EXPECT().GetProperties().Return(k8s.PluginProperties{})
+			mockResourceHandler.On("BuildIdentityResource", mock.Anything, tCtx.TaskExecutionMetadata()).Return(&v1.Pod{}, nil)
+			mockResourceHandler.On("GetTaskPhase", mock.Anything, mock.Anything, mock.Anything).Return(tt.reportedPhaseInfo, nil)
+
+			pluginManager, err := NewPluginManager(ctx, dummySetupContext(fc), k8s.PluginEntry{
+				ID:              "x",
+				ResourceToWatch: &v1.Pod{},
+				Plugin:          mockResourceHandler,
+			}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+			assert.NoError(t, err)
+
+			transition, err := pluginManager.Handle(ctx, tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPhase, transition.Info().Phase())
+			if len(tt.wantErrCode) > 0 {
+				assert.Equal(t, tt.wantErrCode, transition.Info().Err().GetCode())
+				assert.Equal(t, core.ExecutionError_SYSTEM, transition.Info().Err().GetKind())
+			}
+			assert.Equal(t, tt.wantPreempted, !pluginState.PreemptedAt.IsZero())
+			if len(tt.wantReason) > 0 {
+				assert.Contains(t, transition.Info().Reason(), tt.wantReason)
+			}
+
+			err = fc.Get(ctx, k8stypes.NamespacedName{Namespace: "ns", Name: "test"}, &v1.Pod{})
+			assert.Equal(t, tt.wantPodDeleted, k8serrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestPluginManager_GarbageCollectSharedResources(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	collected := make(chan struct{})
+	mockResourceHandler := &pluginsk8sMock.Plugin{}
+	mockResourceHandler.EXPECT().GetProperties().Return(k8s.PluginProperties{})
+	sharedResources := &pluginsk8sMock.PluginSharedResources{}
+	sharedResources.EXPECT().SharedResourcesEnabled().Return(true)
+	sharedResources.EXPECT().GarbageCollectSharedResources(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, kubeClient client.Client) error {
+			cancel()
+			close(collected)
+			return nil
+		}).Once()
+	fakeClient := fake.NewClientBuilder().WithRuntimeObjects().Build()
+
+	_, err := NewPluginManager(ctx, dummySetupContext(fakeClient), k8s.PluginEntry{
+		ID:              "x",
+		ResourceToWatch: &v1.Pod{},
+		Plugin:          pluginWithSharedResources{mockResourceHandler, sharedResources},
+	}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+	assert.NoError(t, err)
+
+	select {
+	case <-collected:
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "shared resources were not garbage collected")
+	}
+}
+
 func TestPluginManager_CustomKubeClient(t *testing.T) {
 	ctx := context.TODO()
 	tctx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseStarted)
@@ -0,0 +1,110 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// preemptedErrorCode is reported when an interruptible pod was shut down because its node is about to be terminated.
+// The failure is a system failure, so the retry does not consume the user retries of the task.
+const preemptedErrorCode = "Preempted"
+
+// preemptionNotice returns a description of the termination notice for the pod, or an empty string if there is none.
+// A notice is either a DisruptionTarget pod condition, a pod event with one of the configured reasons or one of the
+// configured taints on the node the pod runs on.
+func (e *PluginManager) preemptionNotice(ctx context.Context, pod *v1.Pod, cfg config.InterruptiblePreemptionConfig) string {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.DisruptionTarget && condition.Status == v1.ConditionTrue {
+			return fmt.Sprintf("pod is targeted for disruption, reason: %s", condition.Reason)
+		}
+	}
+
+	if e.eventWatcher != nil && len(cfg.EventReasons) > 0 {
+		reasons := sets.New[string](cfg.EventReasons...)
+		nsName := k8stypes.NamespacedName{Namespace: pod.GetNamespace(), Name: pod.GetName()}
+		for _, event := range e.eventWatcher.List(nsName, pod.GetCreationTimestamp().Time) {
+			if reasons.Has(event.Reason) {
+				return fmt.Sprintf("pod received a [%s] event: %s", event.Reason, event.Note)
+			}
+		}
+	}
+
+	if len(cfg.NodeTaints) > 0 && len(pod.Spec.NodeName) > 0 {
+		// Nodes are excluded from the informer cache (see executors.ClientOptions), so this reads from the API server
+		// rather than watching every node of the cluster.
+		node := &v1.Node{}
+		if err := e.kubeClient.GetClient().Get(ctx, k8stypes.NamespacedName{Name: pod.Spec.NodeName}, node); err != nil {
+			logger.Debugf(ctx, "Failed to get node [%s] to check for termination taints. Error: %v", pod.Spec.NodeName, err)
+			return ""
+		}
+
+		taints := sets.New[string](cfg.NodeTaints...)
+		for _, taint := range node.Spec.Taints {
+			if taints.Has(taint.Key) {
+				return fmt.Sprintf("node [%s] is about to be terminated, taint [%s]", pod.Spec.NodeName, taint.Key)
+			}
+		}
+	}
+
+	return ""
+}
+
+// handlePreemption shuts down running interruptible pods gracefully as soon as a termination notice is detected, so
+// that they get a chance to write a checkpoint that the next attempt can resume from. preemptedAt is the time the
+// notice was first detected, or zero if the pod has not been preempted. It returns the transition to report and the
+// updated preemptedAt.
+func (e *PluginManager) handlePreemption(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object,
+	preemptedAt time.Time, transition pluginsCore.Transition) (pluginsCore.Transition, time.Time, error) {
+
+	cfg := config.GetK8sPluginConfig().InterruptiblePreemption
+	pod, isPod := o.(*v1.Pod)
+	if !cfg.Enabled || !isPod || !tCtx.TaskExecutionMetadata().IsInterruptible() {
+		return transition, preemptedAt, nil
+	}
+
+	phaseInfo := transition.Info()
+	if !preemptedAt.IsZero() {
+		if phaseInfo.Phase().IsSuccess() {
+			return transition, preemptedAt, nil
+		}
+
+		deadline := preemptedAt.Add(cfg.GracePeriod.Duration)
+		if phaseInfo.Phase().IsFailure() || time.Now().After(deadline) {
+			reason := fmt.Sprintf("pod [%s/%s] was preempted at [%s]", pod.GetNamespace(), pod.GetName(), preemptedAt.Format(time.RFC3339))
+			return pluginsCore.DoTransition(pluginsCore.PhaseInfoSystemRetryableFailure(preemptedErrorCode, reason, phaseInfo.Info())), preemptedAt, nil
+		}
+
+		return transition, preemptedAt, nil
+	}
+
+	if phaseInfo.Phase() != pluginsCore.PhaseRunning || !pod.GetDeletionTimestamp().IsZero() {
+		return transition, preemptedAt, nil
+	}
+
+	notice := e.preemptionNotice(ctx, pod, cfg)
+	if len(notice) == 0 {
+		return transition, preemptedAt, nil
+	}
+
+	logger.Infof(ctx, "Gracefully shutting down interruptible pod [%s/%s], %s", pod.GetNamespace(), pod.GetName(), notice)
+	gracePeriodSeconds := int64(cfg.GracePeriod.Seconds())
+	if err := e.kubeClient.GetClient().Delete(ctx, pod, client.GracePeriodSeconds(gracePeriodSeconds)); err != nil && !isK8sObjectNotExists(err) {
+		logger.Warningf(ctx, "Failed to delete preempted pod [%s/%s]. Error: %v", pod.GetNamespace(), pod.GetName(), err)
+		return pluginsCore.UnknownTransition, preemptedAt, err
+	}
+	e.metrics.Preemptions.Inc(ctx)
+
+	reason := fmt.Sprintf("Preempted: %s, shutting down within [%s]", notice, cfg.GracePeriod.Duration)
+	// Bump the version to ensure the notice is reported
+	return pluginsCore.DoTransition(pluginsCore.PhaseInfoRunningWithReason(phaseInfo.Version()+1, reason, phaseInfo.Info())), time.Now(), nil
+}
@@ -1,7 +1,11 @@
 package config
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
 )
 
 //go:generate pflags Config --default-var=defaultConfig
@@ -30,6 +34,8 @@ type Config struct {
 	Type             Type        `json:"type" pflag:"noop, Which resource manager to use, redis or noop. Default is noop."`
 	ResourceMaxQuota int         `json:"resourceMaxQuota" pflag:",Global limit for concurrent Qubole queries"`
 	RedisConfig      RedisConfig `json:"redis" pflag:",Config for Redis resourcemanager."`
+	// Overrides, keyed by resource namespace, of the quotas registered by plugins. Changes are applied at runtime.
+	ResourceQuotas map[string]int `json:"resourceQuotas" pflag:"-,Overrides of the quotas registered by plugins, keyed by resource namespace."`
 }
 
 // Specific configs for Redis resource manager
@@ -51,3 +57,35 @@ func GetConfig() *Config {
 func SetConfig(cfg *Config) error {
 	return configSection.SetConfig(cfg)
 }
+
+// MustSubscribe registers a handler to be invoked with the new config whenever it's reloaded at runtime.
+func MustSubscribe(handler func(ctx context.Context, newValue *Config)) {
+	stdConfig.MustSubscribe(configSection, handler)
+}
+
+// Validate checks the quotas. Reloads with invalid quotas are rejected.
+func (c *Config) Validate() error {
+	if c.ResourceMaxQuota <= 0 {
+		return fmt.Errorf("resourceMaxQuota must be positive, got [%v]", c.ResourceMaxQuota)
+	}
+
+	for namespace, quota := range c.ResourceQuotas {
+		if quota <= 0 || quota > c.ResourceMaxQuota {
+			return fmt.Errorf("quota of resource namespace [%v] must be within (0, %v], got [%v]", namespace,
+				c.ResourceMaxQuota, quota)
+		}
+	}
+
+	return nil
+}
+
+// GetQuota returns the quota of a resource namespace, which is the override configured for it if any, or the quota
+// registered by the plugin otherwise, capped by ResourceMaxQuota.
+func (c *Config) GetQuota(namespace string, registeredQuota int) int {
+	quota := registeredQuota
+	if override, ok := c.ResourceQuotas[namespace]; ok {
+		quota = override
+	}
+
+	return min(quota, c.ResourceMaxQuota)
+}
@@ -77,19 +77,22 @@ func (r *RedisResourceManagerBuilder) BuildResourceManager(ctx context.Context)
 	}
 
 	logger.Infof(ctx, "Building a resource manager: creating metrics and namespacedResourcesMap")
+	config := rmConfig.GetConfig()
 	// building the resources and insert them into the resource manager
 	for namespace, quota := range r.namespacedResourcesQuotaMap {
 		// `namespace` is always prefixed with the RedisSetKeyPrefix and the plugin ID. Each plugin can then affix additional sub-namespaces to it to create different resource pools.
 		// For example, hive qubole plugin's namespaces contain plugin ID and qubole cluster (e.g., "redisresourcemanager:qubole-hive-executor:default-cluster").
 		metrics := NewRedisResourceManagerMetrics(r.MetricsScope.NewSubScope(getValidMetricScopeName(string(namespace))))
 		rm.namespacedResourcesMap[namespace] = &Resource{
-			quota:          BaseResourceConstraint{Value: int64(quota)},
-			metrics:        metrics,
-			rejectedTokens: sync.Map{},
+			quota:           BaseResourceConstraint{Value: int64(config.GetQuota(string(namespace), quota))},
+			metrics:         metrics,
+			rejectedTokens:  sync.Map{},
+			registeredQuota: quota,
 		}
 		logger.Infof(ctx, "Creating namespacedResourcesMap: added namespace [%v] and resource [%v]", namespace, rm.namespacedResourcesMap[namespace])
 	}
 	rm.startMetricsGathering(ctx)
+	rmConfig.MustSubscribe(rm.updateQuotas)
 	return rm, nil
 }
 
@@ -150,6 +153,17 @@ func (r *RedisResourceManager) pollRedis(ctx context.Context, namespace pluginCo
 	metrics.ApproximateBackedUpLength.Set(float64(rejectedTokensCount))
 }
 
+// updateQuotas applies the quotas of a reloaded config to the resources.
+func (r *RedisResourceManager) updateQuotas(ctx context.Context, config *rmConfig.Config) {
+	for namespace, resource := range r.namespacedResourcesMap {
+		quota := int64(config.GetQuota(string(namespace), resource.registeredQuota))
+		if current := resource.getQuota().Value; current != quota {
+			logger.Infof(ctx, "Updating quota of resource namespace [%v] from [%v] to [%v]", namespace, current, quota)
+			resource.setQuota(quota)
+		}
+	}
+}
+
 func (r *RedisResourceManager) startMetricsGathering(ctx context.Context) {
 	go wait.Until(func() {
 		for namespace := range r.namespacedResourcesMap {
@@ -237,7 +251,7 @@ func (r *RedisResourceManager) AllocateResource(ctx context.Context, namespace p
 		return pluginCore.AllocationUndefined, err
 	}
 
-	if !namespacedResource.quota.IsAllowed(size) {
+	if quota := namespacedResource.getQuota(); !quota.IsAllowed(size) {
 		logger.Infof(ctx, "Too many allocations (total [%d]), rejecting [%s:%s]", size, namespace, allocationToken)
 		namespacedResource.rejectedTokens.Store(allocationToken, struct{}{})
 		return pluginCore.AllocationStatusExhausted, nil
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	rmConfig "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/resourcemanager/config"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/resourcemanager/mocks"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
@@ -135,3 +136,27 @@ func TestRedisResourceManager_checkAgainstConstraints(t *testing.T) {
 		assert.Equal(t, 0, idx)
 	})
 }
+
+func TestRedisResourceManager_updateQuotas(t *testing.T) {
+	mockScope := promutils.NewTestScope()
+	resources := createMockNamespacedResourcesMap(mockScope)
+	resources["test-resource1"].registeredQuota = 3
+	resources["test-resource2"].registeredQuota = 4
+	r := &RedisResourceManager{
+		client:                 &mocks.RedisClient{},
+		MetricsScope:           mockScope,
+		namespacedResourcesMap: resources,
+	}
+
+	r.updateQuotas(context.TODO(), &rmConfig.Config{
+		ResourceMaxQuota: 10,
+		ResourceQuotas:   map[string]int{"test-resource1": 5},
+	})
+	assert.Equal(t, int64(5), resources["test-resource1"].getQuota().Value)
+	assert.Equal(t, int64(4), resources["test-resource2"].getQuota().Value)
+
+	// Quotas are capped by the max quota.
+	r.updateQuotas(context.TODO(), &rmConfig.Config{ResourceMaxQuota: 2})
+	assert.Equal(t, int64(2), resources["test-resource1"].getQuota().Value)
+	assert.Equal(t, int64(2), resources["test-resource2"].getQuota().Value)
+}
@@ -50,6 +50,21 @@ type Resource struct {
 	quota          BaseResourceConstraint
 	metrics        Metrics
 	rejectedTokens sync.Map
+	// The quota registered by the plugin, before the overrides of the config are applied.
+	registeredQuota int
+	quotaLock       sync.RWMutex
+}
+
+func (r *Resource) getQuota() BaseResourceConstraint {
+	r.quotaLock.RLock()
+	defer r.quotaLock.RUnlock()
+	return r.quota
+}
+
+func (r *Resource) setQuota(quota int64) {
+	r.quotaLock.Lock()
+	defer r.quotaLock.Unlock()
+	r.quota = BaseResourceConstraint{Value: quota}
 }
 
 type Metrics interface {
@@ -46,6 +46,7 @@ var StatusReady = Status{TransitionToPhase: v1alpha1.WorkflowPhaseReady}
 var StatusRunning = Status{TransitionToPhase: v1alpha1.WorkflowPhaseRunning}
 var StatusSucceeding = Status{TransitionToPhase: v1alpha1.WorkflowPhaseSucceeding}
 var StatusSuccess = Status{TransitionToPhase: v1alpha1.WorkflowPhaseSuccess}
+var StatusPaused = Status{TransitionToPhase: v1alpha1.WorkflowPhasePaused}
 
 func StatusFailureNode(originalErr *core.ExecutionError) Status {
 	return Status{TransitionToPhase: v1alpha1.WorkflowPhaseHandlingFailureNode, Err: originalErr}
@@ -194,6 +195,9 @@ func (c *workflowExecutor) handleRunningWorkflow(ctx context.Context, w *v1alpha
 	if state.PartiallyComplete() {
 		c.enqueueWorkflow(w.GetK8sWorkflowID().String())
 	}
+	if w.GetExecutionConfig().Pause != nil {
+		return StatusPaused, nil
+	}
 	return StatusRunning, nil
 }
 
@@ -316,8 +320,17 @@ func (c *workflowExecutor) TransitionToPhase(ctx context.Context, execID *core.W
 			return nil
 		case v1alpha1.WorkflowPhaseRunning:
 			wfEvent.Phase = core.WorkflowExecution_RUNNING
+			if wStatus.GetPhase() == v1alpha1.WorkflowPhasePaused {
+				wStatus.UpdatePhase(v1alpha1.WorkflowPhaseRunning, "Workflow resumed", nil)
+				wfEvent.OccurredAt = utils.GetProtoTime(nil)
+				break
+			}
 			wStatus.UpdatePhase(v1alpha1.WorkflowPhaseRunning, "Workflow Started", nil)
 			wfEvent.OccurredAt = utils.GetProtoTime(wStatus.GetStartedAt())
+		case v1alpha1.WorkflowPhasePaused:
+			wfEvent.Phase = core.WorkflowExecution_PAUSED
+			wStatus.UpdatePhase(v1alpha1.WorkflowPhasePaused, "Workflow paused", nil)
+			wfEvent.OccurredAt = utils.GetProtoTime(nil)
 		case v1alpha1.WorkflowPhaseFailing:
 			wfEvent.Phase = core.WorkflowExecution_FAILING
 			wfEvent.OutputResult = convertToExecutionError(toStatus.Err, previousError)
@@ -428,15 +441,25 @@ func (c *workflowExecutor) HandleFlyteWorkflow(ctx context.Context, w *v1alpha1.
 		c.metrics.AcceptanceLatency.Observe(ctx, acceptedAt, time.Now())
 		return nil
 
-	case v1alpha1.WorkflowPhaseRunning:
+	case v1alpha1.WorkflowPhaseRunning, v1alpha1.WorkflowPhasePaused:
+		// Paused workflows keep handling the nodes they are running, and move back to running once resumed.
 		newStatus, err := c.handleRunningWorkflow(ctx, w)
 		if err != nil {
 			logger.Warningf(ctx, "Error in handling running workflow [%v]", err.Error())
 			return err
 		}
+		previousPhase := wStatus.GetPhase()
 		if err := c.TransitionToPhase(ctx, w.ExecutionID.WorkflowExecutionIdentifier, wStatus, newStatus); err != nil {
 			return err
 		}
+		if previousPhase != newStatus.TransitionToPhase {
+			switch newStatus.TransitionToPhase {
+			case v1alpha1.WorkflowPhasePaused:
+				c.k8sRecorder.Event(w, corev1.EventTypeNormal, v1alpha1.WorkflowPhasePaused.String(), "Workflow paused")
+			case v1alpha1.WorkflowPhaseRunning:
+				c.k8sRecorder.Event(w, corev1.EventTypeNormal, v1alpha1.WorkflowPhaseRunning.String(), "Workflow resumed")
+			}
+		}
 		return nil
 	case v1alpha1.WorkflowPhaseSucceeding:
 		newStatus := c.handleSucceedingWorkflow(ctx, w)
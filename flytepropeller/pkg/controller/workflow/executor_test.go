@@ -390,6 +390,97 @@ func TestWorkflowExecutor_HandleFlyteWorkflow(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutor_HandleFlyteWorkflow_Paused(t *testing.T) {
+	ctx := context.Background()
+	scope := testScope.NewSubScope("paused")
+	store := createInmemoryDataStore(t, scope.NewSubScope("data_store"))
+	recorder := StdOutEventRecorder()
+
+	te := createHappyPathTaskExecutor(t, true)
+	pluginmachinery.PluginRegistry().RegisterCorePlugin(te)
+
+	enqueueWorkflow := func(workflowId v1alpha1.WorkflowID) {}
+
+	var recordedPhases []core.WorkflowExecution_Phase
+	eventSink := eventMocks.NewMockEventSink()
+	eventSink.SinkCb = func(ctx context.Context, message proto.Message) error {
+		if e, ok := message.(*event.WorkflowExecutionEvent); ok {
+			recordedPhases = append(recordedPhases, e.GetPhase())
+		}
+		return nil
+	}
+	catalogClient, err := catalog.NewCatalogClient(ctx, nil)
+	assert.NoError(t, err)
+	recoveryClient := &recoveryMocks.Client{}
+	adminClient := launchplan.NewFailFastLaunchPlanExecutor()
+
+	handlerFactory, err := factory.NewHandlerFactory(ctx, adminClient, adminClient, fakeKubeClient, mockClientset, catalogClient, recoveryClient, eventConfig, config.LiteralOffloadingConfig{}, testClusterID, signalClient, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	nodeExec, err := nodes.NewExecutor(ctx, config.GetConfig().NodeConfig, store, enqueueWorkflow, eventSink, adminClient, adminClient,
+		"s3://bucket", fakeKubeClient, catalogClient, recoveryClient, config.LiteralOffloadingConfig{}, eventConfig, testClusterID, signalClient, handlerFactory, promutils.NewTestScope())
+	assert.NoError(t, err)
+
+	execStatsHolder, err := execStats.NewExecutionStatsHolder()
+	assert.NoError(t, err)
+	executor, err := NewExecutor(ctx, store, enqueueWorkflow, eventSink, recorder, "", nodeExec, eventConfig, testClusterID, promutils.NewTestScope(), execStatsHolder)
+	assert.NoError(t, err)
+
+	assert.NoError(t, executor.Initialize(ctx))
+
+	wJSON, err := yamlutils.ReadYamlFileAsJSON("testdata/benchmark_wf.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	w := &v1alpha1.FlyteWorkflow{
+		RawOutputDataConfig: v1alpha1.RawOutputDataConfig{RawOutputDataConfig: &admin.RawOutputDataConfig{}},
+	}
+	if !assert.NoError(t, json.Unmarshal(wJSON, w)) {
+		return
+	}
+	handleRounds := func(rounds int) {
+		for i := 0; i < rounds && !w.Status.IsTerminated(); i++ {
+			assert.NoError(t, executor.HandleFlyteWorkflow(ctx, w))
+			for _, v := range w.Status.NodeStatus {
+				// Reset dirty manually for tests.
+				v.ResetDirty()
+			}
+		}
+	}
+	startedNodes := func() int {
+		started := 0
+		for _, v := range w.Status.NodeStatus {
+			if v.GetPhase() != v1alpha1.NodePhaseNotYetStarted {
+				started++
+			}
+		}
+		return started
+	}
+
+	handleRounds(2)
+	assert.Equal(t, v1alpha1.WorkflowPhaseRunning, w.Status.Phase)
+
+	w.ExecutionConfig.Pause = &v1alpha1.PauseConfig{}
+	handleRounds(1)
+	assert.Equal(t, v1alpha1.WorkflowPhasePaused, w.Status.Phase)
+	started := startedNodes()
+	// The running nodes complete, but no new ones are started.
+	handleRounds(28)
+	assert.Equal(t, v1alpha1.WorkflowPhasePaused, w.Status.Phase)
+	assert.Equal(t, started, startedNodes())
+
+	w.ExecutionConfig.Pause = nil
+	handleRounds(28)
+	assert.Equal(t, v1alpha1.WorkflowPhaseSuccess, w.Status.Phase, "Message: [%v]", w.Status.Message)
+	assert.Equal(t, []core.WorkflowExecution_Phase{
+		core.WorkflowExecution_RUNNING,
+		core.WorkflowExecution_PAUSED,
+		core.WorkflowExecution_RUNNING,
+		core.WorkflowExecution_SUCCEEDING,
+		core.WorkflowExecution_SUCCEEDED,
+	}, recordedPhases)
+}
+
 func BenchmarkWorkflowExecutor(b *testing.B) {
 	scope := promutils.NewScope("test3")
 	ctx := context.Background()
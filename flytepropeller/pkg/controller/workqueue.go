@@ -7,18 +7,28 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	_ "github.com/flyteorg/flyte/flytestdlib/promutils" // Setup workqueue metrics
 )
 
+// Selects the config of a workqueue from the propeller config.
+type workqueueConfigSelector func(cfg *config.Config) config.WorkqueueConfig
+
 func NewWorkQueue(ctx context.Context, cfg config.WorkqueueConfig, name string) (workqueue.RateLimitingInterface, error) {
-	// TODO introduce bounds checks
+	return newWorkQueue(ctx, cfg, name, nil)
+}
+
+// newWorkQueue creates a workqueue. If selectConfig is set, the rate and capacity of the bucket rate limiter of the
+// workqueue follow the values selected from the propeller config whenever it's reloaded.
+func newWorkQueue(ctx context.Context, cfg config.WorkqueueConfig, name string, selectConfig workqueueConfigSelector) (
+	workqueue.RateLimitingInterface, error) {
 	logger.Infof(ctx, "WorkQueue type [%v] configured", cfg.Type)
 	switch cfg.Type {
 	case config.WorkqueueTypeBucketRateLimiter:
 		logger.Infof(ctx, "Using Bucket Ratelimited Workqueue, Rate [%v] Capacity [%v]", cfg.Rate, cfg.Capacity)
 		return workqueue.NewNamedRateLimitingQueue(
-			NewDedupingBucketRateLimiter(NewLimiter(rate.Limit(cfg.Rate), cfg.Capacity)),
+			NewDedupingBucketRateLimiter(newBucketLimiter(cfg, name, selectConfig)),
 			name), nil
 	case config.WorkqueueTypeExponentialFailureRateLimiter:
 		logger.Infof(ctx, "Using Exponential failure backoff Ratelimited Workqueue, Base Delay [%v], max Delay [%v]", cfg.BaseDelay, cfg.MaxDelay)
@@ -29,7 +39,7 @@ func NewWorkQueue(ctx context.Context, cfg config.WorkqueueConfig, name string)
 		logger.Infof(ctx, "Using Max-of Ratelimited Workqueue, Bucket {Rate [%v] Capacity [%v]} | FailureBackoff {Base Delay [%v], max Delay [%v]}", cfg.Rate, cfg.Capacity, cfg.BaseDelay, cfg.MaxDelay)
 		return workqueue.NewNamedRateLimitingQueue(
 			workqueue.NewMaxOfRateLimiter(
-				NewDedupingBucketRateLimiter(NewLimiter(rate.Limit(cfg.Rate), cfg.Capacity)),
+				NewDedupingBucketRateLimiter(newBucketLimiter(cfg, name, selectConfig)),
 				workqueue.NewItemExponentialFailureRateLimiter(cfg.BaseDelay.Duration,
 					cfg.MaxDelay.Duration),
 			), name), nil
@@ -41,3 +51,35 @@ func NewWorkQueue(ctx context.Context, cfg config.WorkqueueConfig, name string)
 		return workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name), nil
 	}
 }
+
+// newBucketLimiter creates the limiter of a bucket rate limited workqueue. If selectConfig is set, the limiter is
+// updated when the rate or capacity of the workqueue change at runtime. Changing the type of the workqueue still
+// requires a restart.
+func newBucketLimiter(cfg config.WorkqueueConfig, name string, selectConfig workqueueConfigSelector) interfaces.Limiter {
+	limiter := NewLimiter(rate.Limit(cfg.Rate), cfg.Capacity)
+	if selectConfig == nil {
+		return limiter
+	}
+
+	current := cfg
+	config.MustSubscribe(func(ctx context.Context, newValue *config.Config) {
+		updated := selectConfig(newValue)
+		if updated.Type != current.Type {
+			logger.Warnf(ctx, "WorkQueue [%v] type changed from [%v] to [%v]. A restart is required to apply it.",
+				name, current.Type, updated.Type)
+			return
+		}
+
+		if updated.Rate == current.Rate && updated.Capacity == current.Capacity {
+			return
+		}
+
+		logger.Infof(ctx, "Updating WorkQueue [%v] rate limits from Rate [%v] Capacity [%v] to Rate [%v] Capacity [%v]",
+			name, current.Rate, current.Capacity, updated.Rate, updated.Capacity)
+		limiter.SetLimit(rate.Limit(updated.Rate))
+		limiter.SetBurst(updated.Capacity)
+		current.Rate, current.Capacity = updated.Rate, updated.Capacity
+	})
+
+	return limiter
+}
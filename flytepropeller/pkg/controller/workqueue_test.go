@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 
 	config2 "github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
 	"github.com/flyteorg/flyte/flytestdlib/config"
@@ -52,3 +53,34 @@ func TestNewWorkQueue(t *testing.T) {
 		assert.NotNil(t, w)
 	})
 }
+
+func TestNewBucketLimiter_Updates(t *testing.T) {
+	ctx := context.TODO()
+	cfg := config2.WorkqueueConfig{
+		Type:     config2.WorkqueueTypeBucketRateLimiter,
+		Capacity: 5,
+		Rate:     1,
+	}
+	limiter := newBucketLimiter(cfg, "q_test_updates", func(c *config2.Config) config2.WorkqueueConfig {
+		return c.Queue.Sub
+	})
+	notifyUpdate := config.GetSection("propeller").GetConfigUpdatedHandler()
+
+	t.Run("rate limits updated", func(t *testing.T) {
+		notifyUpdate(ctx, &config2.Config{Queue: config2.CompositeQueueConfig{Sub: config2.WorkqueueConfig{
+			Type:     config2.WorkqueueTypeBucketRateLimiter,
+			Capacity: 20,
+			Rate:     10,
+		}}})
+		assert.Equal(t, rate.Limit(10), limiter.Limit())
+		assert.Equal(t, 20, limiter.Burst())
+	})
+
+	t.Run("type changed", func(t *testing.T) {
+		notifyUpdate(ctx, &config2.Config{Queue: config2.CompositeQueueConfig{Sub: config2.WorkqueueConfig{
+			Type: config2.WorkqueueTypeDefault,
+		}}})
+		assert.Equal(t, rate.Limit(10), limiter.Limit())
+		assert.Equal(t, 20, limiter.Burst())
+	})
+}
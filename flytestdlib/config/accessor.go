@@ -17,6 +17,10 @@
 // from that particular config file are parsed. It follows that if there are inter-dependent sections (e.g. changing one
 // MUST be followed by a change in another), then make sure those sections are placed in the same config file.
 //
+// Sections can validate their values by implementing the Validator interface, and consumers can subscribe to the typed
+// values of a section with Subscribe. A reload is applied all or nothing: the new values of all sections are validated first
+// and, if any of them is invalid, the whole reload is rejected and every section keeps its previous value.
+//
 // A convenience tool is also provided in cli package (pflags) that generates an implementation for PFlagProvider interface
 // based on json names of the fields.
 package config
@@ -26,6 +30,8 @@ import (
 	"flag"
 
 	"github.com/spf13/pflag"
+
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 // Provides a simple config parser interface.
@@ -58,4 +64,8 @@ type Options struct {
 
 	// Defines the root section to use with the accessor.
 	RootSection Section
+
+	// Scope to emit config reload metrics under. Metrics are registered when the accessor is created, so a scope can only
+	// be given to a single accessor. No metrics are emitted if not specified.
+	MetricsScope promutils.Scope
 }
@@ -18,9 +18,19 @@ type Section interface {
 	// section registered.
 	GetConfig() Config
 
-	// Gets a function pointer to call when the config has been updated.
+	// Gets a function pointer to call when the config has been updated. It invokes the handler the section was
+	// registered with as well as the handlers of every subscriber of the section.
 	GetConfigUpdatedHandler() SectionUpdated
 
+	// Subscribes to the updates of the section. The validate function, if non-nil, is invoked with every new value of
+	// the section before it's applied and can reject it by returning an error. The handler, if non-nil, is invoked after
+	// the new value has been applied.
+	AddSubscriber(validate func(newValue Config) error, handler SectionUpdated)
+
+	// Validates a new value of the section using the Validator the config implements, if any, and the validate functions
+	// of the subscribers of the section.
+	ValidateConfig(config Config) error
+
 	// Sets the config and sets a bit indicating whether the new config is different when compared to the existing value.
 	SetConfig(config Config) error
 
@@ -72,11 +82,18 @@ type SectionUpdated func(ctx context.Context, newValue Config)
 var rootSection = NewRootSection()
 
 type section struct {
-	config   Config
+	// The config the section was registered with. Values set afterwards are held in the configs snapshot.
+	config      Config
+	handler     SectionUpdated
+	subscribers []subscriber
+	isDirty     atomic.Bool
+	sections    SectionMap
+	lockObj     sync.RWMutex
+}
+
+type subscriber struct {
+	validate func(newValue Config) error
 	handler  SectionUpdated
-	isDirty  atomic.Bool
-	sections SectionMap
-	lockObj  sync.RWMutex
 }
 
 // Gets the global root section.
@@ -189,30 +206,86 @@ func (r *section) GetSections() SectionMap {
 }
 
 func (r *section) GetConfig() Config {
-	r.lockObj.RLock()
-	defer r.lockObj.RUnlock()
+	if c, loaded := configs.Load().get(r); loaded {
+		return c
+	}
 
 	return r.config
 }
 
 func (r *section) SetConfig(c Config) error {
-	r.lockObj.Lock()
-	defer r.lockObj.Unlock()
-
 	if reflect.TypeOf(c).Kind() != reflect.Ptr {
 		return fmt.Errorf("config must be a Pointer")
 	}
 
-	if !DeepEqual(r.config, c) {
-		r.config = c
-		r.isDirty.Store(true)
-	}
+	swapLock.Lock()
+	defer swapLock.Unlock()
 
+	publishConfigs(map[*section]Config{r: c})
 	return nil
 }
 
 func (r *section) GetConfigUpdatedHandler() SectionUpdated {
-	return r.handler
+	r.lockObj.RLock()
+	defer r.lockObj.RUnlock()
+
+	handlers := make([]SectionUpdated, 0, len(r.subscribers)+1)
+	if r.handler != nil {
+		handlers = append(handlers, r.handler)
+	}
+
+	for _, s := range r.subscribers {
+		if s.handler != nil {
+			handlers = append(handlers, s.handler)
+		}
+	}
+
+	switch len(handlers) {
+	case 0:
+		return nil
+	case 1:
+		return handlers[0]
+	default:
+		return func(ctx context.Context, newValue Config) {
+			for _, handler := range handlers {
+				handler(ctx, newValue)
+			}
+		}
+	}
+}
+
+func (r *section) AddSubscriber(validate func(newValue Config) error, handler SectionUpdated) {
+	r.lockObj.Lock()
+	defer r.lockObj.Unlock()
+
+	r.subscribers = append(r.subscribers, subscriber{
+		validate: validate,
+		handler:  handler,
+	})
+}
+
+func (r *section) ValidateConfig(c Config) error {
+	if validator, ok := c.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	r.lockObj.RLock()
+	subscribers := r.subscribers
+	r.lockObj.RUnlock()
+
+	for _, s := range subscribers {
+		if s.validate == nil {
+			continue
+		}
+
+		if err := s.validate(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (r *section) GetConfigChangedAndClear() bool {
@@ -0,0 +1,214 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// A section can optionally implement this interface to validate its values. Values that fail validation are never
+// applied, and a reload that contains one is rejected as a whole. The first value a section is loaded with isn't
+// validated; see SetConfigs.
+type Validator interface {
+	Validate() error
+}
+
+// SectionValue holds a new value for a section.
+type SectionValue struct {
+	// Fully qualified key of the section (e.g. propeller.resourcemanager), used to report validation errors.
+	Key     SectionKey
+	Section Section
+	Config  Config
+}
+
+// ValidationErrors holds the validation errors of the sections of a rejected update, keyed by the fully qualified key
+// of the section.
+type ValidationErrors map[SectionKey]error
+
+func (e ValidationErrors) Error() string {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	msgs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		msgs = append(msgs, fmt.Sprintf("section [%v]: %v", key, e[key]))
+	}
+
+	return fmt.Sprintf("%v: %v", ErrInvalidConfig, strings.Join(msgs, "; "))
+}
+
+func (e ValidationErrors) Unwrap() error {
+	return ErrInvalidConfig
+}
+
+// Serializes the updates of the configs snapshot.
+var swapLock sync.Mutex
+
+// Holds the current config of every section that has been set. Updates publish a whole new snapshot, so a reader never
+// observes some of the sections of an update with their new value and others with their previous one. Sections that
+// have never been set aren't in the snapshot and hold the config they were registered with.
+var configs atomic.Pointer[configSnapshot]
+
+type configSnapshot map[*section]Config
+
+// Gets the config of a section from the snapshot, if the section has been set.
+func (s *configSnapshot) get(r *section) (c Config, loaded bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	c, loaded = (*s)[r]
+	return c, loaded
+}
+
+// Publishes a new snapshot with the given configs set and marks the sections whose config changed as dirty. Sections set
+// to a value equal to their current one keep their current value. Must be called with swapLock held.
+func publishConfigs(values map[*section]Config) {
+	current := configs.Load()
+	next := configSnapshot{}
+	if current != nil {
+		maps.Copy(next, *current)
+	}
+
+	changed := make([]*section, 0, len(values))
+	for r, c := range values {
+		if existing := r.GetConfig(); DeepEqual(existing, c) {
+			next[r] = existing
+		} else {
+			next[r] = c
+			changed = append(changed, r)
+		}
+	}
+
+	configs.Store(&next)
+	for _, r := range changed {
+		r.isDirty.Store(true)
+	}
+}
+
+// GetConfigs gets the configs of several sections from a single snapshot, so that they either all predate or all
+// include any update applied through SetConfigs.
+func GetConfigs(sections ...Section) []Config {
+	snapshot := configs.Load()
+	res := make([]Config, 0, len(sections))
+	for _, s := range sections {
+		r, ok := s.(*section)
+		if !ok {
+			res = append(res, s.GetConfig())
+			continue
+		}
+
+		if c, loaded := snapshot.get(r); loaded {
+			res = append(res, c)
+		} else {
+			res = append(res, r.config)
+		}
+	}
+
+	return res
+}
+
+// SetConfigs validates the new values of several sections and sets them all if they're all valid, or none of them
+// otherwise. The new values are published as a single snapshot: GetConfig and GetConfigs observe either all of them or
+// none. Errors are returned as ValidationErrors. Update handlers aren't invoked; callers are expected to fire them once
+// all sections are set so that handlers observe the new values of every other section.
+//
+// Only values that differ from the current value of a section that has been set before are validated. The first value
+// set into a section, i.e. the config a process starts with, is applied as is so that configs accepted before the
+// section gained a Validator keep loading; an invalid value is only rejected when reloading.
+func SetConfigs(values []SectionValue) error {
+	swapLock.Lock()
+	defer swapLock.Unlock()
+
+	snapshot := configs.Load()
+	errs := ValidationErrors{}
+	for _, v := range values {
+		if DeepEqual(v.Section.GetConfig(), v.Config) {
+			continue
+		}
+
+		// Checked here, rather than failing halfway through setting the sections.
+		if t := reflect.TypeOf(v.Config); t == nil || t.Kind() != reflect.Ptr {
+			errs[v.Key] = fmt.Errorf("config must be a Pointer")
+			continue
+		}
+
+		if r, ok := v.Section.(*section); ok {
+			if _, loaded := snapshot.get(r); !loaded {
+				continue
+			}
+		}
+
+		if err := v.Section.ValidateConfig(v.Config); err != nil {
+			errs[v.Key] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	updates := make(map[*section]Config, len(values))
+	for _, v := range values {
+		if r, ok := v.Section.(*section); ok {
+			updates[r] = v.Config
+		} else if err := v.Section.SetConfig(v.Config); err != nil {
+			// Sections implemented outside of this package can't be part of the snapshot and are set one at a time.
+			return err
+		}
+	}
+
+	publishConfigs(updates)
+	return nil
+}
+
+// Subscribe registers a handler to be invoked with the strongly typed value of a section whenever it's updated, after
+// the new value has passed the Validator of the config, if any, and the validate functions. As with the Validator, the
+// validate functions only apply to the values a section is reloaded with. The section must hold a *T.
+func Subscribe[T any](section Section, handler func(ctx context.Context, newValue *T),
+	validate ...func(newValue *T) error) error {
+	if _, ok := section.GetConfig().(*T); !ok {
+		return fmt.Errorf("section holds a config of type [%T], expected [%T]", section.GetConfig(), new(T))
+	}
+
+	var updated SectionUpdated
+	if handler != nil {
+		updated = func(ctx context.Context, newValue Config) {
+			handler(ctx, newValue.(*T))
+		}
+	}
+
+	section.AddSubscriber(
+		func(newValue Config) error {
+			typed, ok := newValue.(*T)
+			if !ok {
+				return fmt.Errorf("received a config of type [%T], expected [%T]", newValue, new(T))
+			}
+
+			for _, fn := range validate {
+				if err := fn(typed); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, updated)
+
+	return nil
+}
+
+// MustSubscribe is the same as Subscribe but panics if the section doesn't hold a *T.
+func MustSubscribe[T any](section Section, handler func(ctx context.Context, newValue *T),
+	validate ...func(newValue *T) error) {
+	if err := Subscribe(section, handler, validate...); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validatedConfig struct {
+	Value int `json:"value"`
+}
+
+func (c validatedConfig) Validate() error {
+	if c.Value < 0 {
+		return fmt.Errorf("value must be non-negative, got [%v]", c.Value)
+	}
+
+	return nil
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Typed updates", func(t *testing.T) {
+		s := NewSection(&validatedConfig{}, nil)
+		var received *validatedConfig
+		assert.NoError(t, Subscribe(s, func(ctx context.Context, newValue *validatedConfig) {
+			received = newValue
+		}))
+
+		assert.NoError(t, s.SetConfig(&validatedConfig{Value: 2}))
+		s.GetConfigUpdatedHandler()(ctx, s.GetConfig())
+		assert.Equal(t, &validatedConfig{Value: 2}, received)
+	})
+
+	t.Run("All handlers invoked", func(t *testing.T) {
+		calls := 0
+		s := NewSection(&validatedConfig{}, func(ctx context.Context, newValue Config) {
+			calls++
+		})
+		MustSubscribe(s, func(ctx context.Context, newValue *validatedConfig) {
+			calls++
+		})
+
+		s.GetConfigUpdatedHandler()(ctx, s.GetConfig())
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		s := NewSection(&validatedConfig{}, nil)
+		assert.NoError(t, Subscribe(s, nil, func(newValue *validatedConfig) error {
+			if newValue.Value > 10 {
+				return fmt.Errorf("value must be at most 10")
+			}
+
+			return nil
+		}))
+
+		assert.NoError(t, s.ValidateConfig(&validatedConfig{Value: 5}))
+		assert.Error(t, s.ValidateConfig(&validatedConfig{Value: -1}))
+		assert.Error(t, s.ValidateConfig(&validatedConfig{Value: 11}))
+		assert.Error(t, s.ValidateConfig(&TestConfig{}))
+		assert.Nil(t, s.GetConfigUpdatedHandler())
+	})
+
+	t.Run("Mismatched type", func(t *testing.T) {
+		s := NewSection(&TestConfig{}, nil)
+		assert.Error(t, Subscribe(s, func(ctx context.Context, newValue *validatedConfig) {}))
+		assert.Panics(t, func() {
+			MustSubscribe(s, func(ctx context.Context, newValue *validatedConfig) {})
+		})
+	})
+}
+
+func TestSetConfigs(t *testing.T) {
+	first := NewSection(&validatedConfig{Value: 1}, nil)
+	second := NewSection(&validatedConfig{Value: -2}, nil)
+
+	t.Run("First load", func(t *testing.T) {
+		assert.NoError(t, SetConfigs([]SectionValue{
+			{Key: "first", Section: first, Config: &validatedConfig{Value: 1}},
+			{Key: "second", Section: second, Config: &validatedConfig{Value: -1}},
+		}))
+		assert.Equal(t, &validatedConfig{Value: 1}, first.GetConfig())
+		assert.Equal(t, &validatedConfig{Value: -1}, second.GetConfig())
+		assert.False(t, first.GetConfigChangedAndClear())
+		assert.True(t, second.GetConfigChangedAndClear())
+
+		// Reloads are validated, including those that keep an invalid value the section was first loaded with.
+		assert.NoError(t, SetConfigs([]SectionValue{
+			{Key: "second", Section: second, Config: &validatedConfig{Value: -1}},
+		}))
+		assert.False(t, second.GetConfigChangedAndClear())
+		assert.NoError(t, SetConfigs([]SectionValue{
+			{Key: "second", Section: second, Config: &validatedConfig{Value: 1}},
+		}))
+		assert.True(t, second.GetConfigChangedAndClear())
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		err := SetConfigs([]SectionValue{
+			{Key: "first", Section: first, Config: &validatedConfig{Value: 2}},
+			{Key: "second", Section: second, Config: &validatedConfig{Value: -1}},
+		})
+		assert.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.(ValidationErrors), "second")
+		assert.NotContains(t, err.(ValidationErrors), "first")
+		assert.Equal(t, &validatedConfig{Value: 1}, first.GetConfig())
+		assert.Equal(t, &validatedConfig{Value: 1}, second.GetConfig())
+		assert.False(t, first.GetConfigChangedAndClear())
+	})
+
+	t.Run("Not a pointer", func(t *testing.T) {
+		err := SetConfigs([]SectionValue{
+			{Key: "first", Section: first, Config: &validatedConfig{Value: 2}},
+			{Key: "second", Section: second, Config: validatedConfig{Value: 3}},
+		})
+		assert.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.(ValidationErrors), "second")
+		assert.Equal(t, &validatedConfig{Value: 1}, first.GetConfig())
+		assert.False(t, first.GetConfigChangedAndClear())
+	})
+
+	t.Run("Applied", func(t *testing.T) {
+		assert.NoError(t, SetConfigs([]SectionValue{
+			{Key: "first", Section: first, Config: &validatedConfig{Value: 2}},
+			{Key: "second", Section: second, Config: &validatedConfig{Value: 3}},
+		}))
+		assert.Equal(t, &validatedConfig{Value: 2}, first.GetConfig())
+		assert.Equal(t, &validatedConfig{Value: 3}, second.GetConfig())
+		assert.True(t, first.GetConfigChangedAndClear())
+		assert.True(t, second.GetConfigChangedAndClear())
+	})
+}
+
+func TestSetConfigs_Snapshot(t *testing.T) {
+	first := NewSection(&validatedConfig{}, nil)
+	second := NewSection(&validatedConfig{}, nil)
+
+	const updates = 1000
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 1; i <= updates; i++ {
+			assert.NoError(t, SetConfigs([]SectionValue{
+				{Key: "first", Section: first, Config: &validatedConfig{Value: i}},
+				{Key: "second", Section: second, Config: &validatedConfig{Value: i}},
+			}))
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			assert.Equal(t, []Config{&validatedConfig{Value: updates}, &validatedConfig{Value: updates}},
+				GetConfigs(first, second))
+			return
+		default:
+			values := GetConfigs(first, second)
+			assert.Equal(t, values[0], values[1])
+		}
+	}
+}
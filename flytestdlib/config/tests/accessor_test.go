@@ -461,6 +461,52 @@ func TestAccessor_UpdateConfig(t *testing.T) {
 			assert.True(t, called)
 		})
 
+		t.Run(fmt.Sprintf("[%v] Reject invalid reload", provider(config.Options{}).ID()), func(t *testing.T) {
+			configFile := tempFileName("", "config-*.yaml")
+			defer func() { assert.NoError(t, os.Remove(configFile)) }()
+			writeConfig := func(str string, intValue int) {
+				raw := fmt.Sprintf("my-component:\n  str: %v\nother-component:\n  int-val: %v\n", str, intValue)
+				assert.NoError(t, os.WriteFile(configFile, []byte(raw), os.ModePerm)) // #nosec G306
+			}
+
+			reg := config.NewRootSection()
+			mySection, err := reg.RegisterSection(MyComponentSectionKey, &MyComponentConfig{})
+			assert.NoError(t, err)
+			otherSection, err := reg.RegisterSection(OtherComponentSectionKey, &OtherComponentConfig{})
+			assert.NoError(t, err)
+
+			var updates []int
+			config.MustSubscribe(otherSection, func(ctx context.Context, newValue *OtherComponentConfig) {
+				updates = append(updates, newValue.IntValue)
+			}, func(newValue *OtherComponentConfig) error {
+				if newValue.IntValue < 0 {
+					return fmt.Errorf("int-val must be non-negative")
+				}
+
+				return nil
+			})
+
+			writeConfig("first", 1)
+			v := provider(config.Options{
+				SearchPaths: []string{configFile},
+				RootSection: reg,
+			})
+			assert.NoError(t, v.UpdateConfig(context.TODO()))
+			assert.Equal(t, []int{1}, updates)
+
+			// Neither section gets updated when one of them is invalid.
+			writeConfig("second", -1)
+			assert.ErrorIs(t, v.UpdateConfig(context.TODO()), config.ErrInvalidConfig)
+			assert.Equal(t, "first", mySection.GetConfig().(*MyComponentConfig).StringValue)
+			assert.Equal(t, 1, otherSection.GetConfig().(*OtherComponentConfig).IntValue)
+			assert.Equal(t, []int{1}, updates)
+
+			writeConfig("third", 3)
+			assert.NoError(t, v.UpdateConfig(context.TODO()))
+			assert.Equal(t, "third", mySection.GetConfig().(*MyComponentConfig).StringValue)
+			assert.Equal(t, []int{1, 3}, updates)
+		})
+
 		t.Run(fmt.Sprintf("[%v] Change handler k8s configmaps", provider(config.Options{}).ID()), func(t *testing.T) {
 			reg := config.NewRootSection()
 			section, err := reg.RegisterSection(MyComponentSectionKey, &MyComponentConfig{})
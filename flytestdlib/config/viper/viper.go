@@ -14,6 +14,7 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	viperLib "github.com/spf13/viper"
@@ -24,18 +25,54 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/config/files"
 	stdLibErrs "github.com/flyteorg/flyte/flytestdlib/errors"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 const (
 	keyDelim = "."
 )
 
+const (
+	reloadResultApplied  = "applied"
+	reloadResultRejected = "rejected"
+	reloadResultFailed   = "failed"
+)
+
 var (
 	dereferencableKinds = map[reflect.Kind]struct{}{
 		reflect.Array: {}, reflect.Chan: {}, reflect.Map: {}, reflect.Ptr: {}, reflect.Slice: {},
 	}
 )
 
+type reloadMetrics struct {
+	reloadsTotal           *prometheus.CounterVec
+	sectionRejectionsTotal *prometheus.CounterVec
+}
+
+func newReloadMetrics(scope promutils.Scope) *reloadMetrics {
+	return &reloadMetrics{
+		reloadsTotal: scope.MustNewCounterVec("config_reloads_total",
+			"Number of config loads by result: applied, rejected (failed validation) or failed (couldn't be parsed)",
+			"result"),
+		sectionRejectionsTotal: scope.MustNewCounterVec("config_section_rejections_total",
+			"Number of config loads rejected because the new value of the section failed validation", "section"),
+	}
+}
+
+func (m *reloadMetrics) reloaded(result string) {
+	if m != nil {
+		m.reloadsTotal.WithLabelValues(result).Inc()
+	}
+}
+
+func (m *reloadMetrics) rejected(errs config.ValidationErrors) {
+	if m != nil {
+		for key := range errs {
+			m.sectionRejectionsTotal.WithLabelValues(key).Inc()
+		}
+	}
+}
+
 type viperAccessor struct {
 	// Determines whether parsing config should fail if it contains un-registered sections.
 	strictMode bool
@@ -44,6 +81,8 @@ type viperAccessor struct {
 	// Ensures we initialize the file Watcher once.
 	watcherInitializer *sync.Once
 	existingFlagKeys   sets.String
+	// Nil if no metrics scope was given.
+	metrics *reloadMetrics
 }
 
 func (viperAccessor) ID() string {
@@ -265,7 +304,8 @@ func jsonUnmarshallerHook(_, to reflect.Type, data interface{}) (interface{}, er
 }
 
 // Parses RootType config from parsed Viper settings. This should be called after viper has parsed config file/pflags...etc.
-func (v viperAccessor) parseViperConfig(root config.Section) error {
+// The parsed values are returned, for all sections to be validated and applied together, rather than set on sections.
+func (v viperAccessor) parseViperConfig(root config.Section) ([]config.SectionValue, error) {
 	// We use AllSettings instead of AllKeys to get the root level keys folded.
 	settings := v.viper.AllSettings()
 
@@ -280,18 +320,23 @@ func (v viperAccessor) parseViperConfig(root config.Section) error {
 
 		data, err := os.ReadFile(configFile)
 		if err != nil {
-			return fmt.Errorf("failed to read config file %q for case-sensitive key restoration: %w", configFile, err)
+			return nil, fmt.Errorf("failed to read config file %q for case-sensitive key restoration: %w", configFile, err)
 		}
 
 		var rawSettings map[string]interface{}
 		if err := yaml.Unmarshal(data, &rawSettings); err != nil {
-			return fmt.Errorf("failed to parse config file %q for case-sensitive key restoration: %w", configFile, err)
+			return nil, fmt.Errorf("failed to parse config file %q for case-sensitive key restoration: %w", configFile, err)
 		}
 
 		restoreCaseSensitiveArrayKeys(settings, rawSettings)
 	}
 
-	return v.parseViperConfigRecursive(root, settings)
+	var values []config.SectionValue
+	if err := v.parseViperConfigRecursive(root, settings, "", &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
 }
 
 // restoreCaseSensitiveArrayKeys walks viperData and rawData in parallel.
@@ -324,7 +369,8 @@ func restoreCaseSensitiveArrayKeys(viperData, rawData map[string]interface{}) {
 	}
 }
 
-func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings interface{}) error {
+func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings interface{}, key config.SectionKey,
+	values *[]config.SectionValue) error {
 	errs := stdLibErrs.ErrorCollection{}
 	var mine interface{}
 	myKeysCount := 0
@@ -333,7 +379,7 @@ func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings i
 		myMap := map[string]interface{}{}
 		for childKey, childValue := range asMap {
 			if childSection, found := root.GetSections()[childKey]; found {
-				errs.Append(v.parseViperConfigRecursive(childSection, childValue))
+				errs.Append(v.parseViperConfigRecursive(childSection, childValue, key+childKey+keyDelim, values))
 			} else {
 				discoveredKeys.Insert(childKey)
 				myMap[childKey] = childValue
@@ -361,7 +407,11 @@ func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings i
 		}
 
 		errs.Append(decode(mine, defaultDecoderConfig(c, v.decoderConfigs()...)))
-		errs.Append(root.SetConfig(c))
+		*values = append(*values, config.SectionValue{
+			Key:     strings.TrimSuffix(key, keyDelim),
+			Section: root,
+			Config:  c,
+		})
 
 		return errs.ErrorOrDefault()
 	} else if myKeysCount > 0 {
@@ -434,12 +484,30 @@ func (v viperAccessor) configChangeHandler() {
 	}
 }
 
+// RefreshFromConfig parses the config of all sections, validates the new values and applies them all or none of them:
+// if any section fails validation, every section keeps its previous value. The values sections are first loaded with
+// aren't validated; see config.SetConfigs. Update handlers are only fired once all sections have been updated.
 func (v viperAccessor) RefreshFromConfig(ctx context.Context, r config.Section, forceSendUpdates bool) error {
-	err := v.parseViperConfig(r)
+	values, err := v.parseViperConfig(r)
 	if err != nil {
+		v.metrics.reloaded(reloadResultFailed)
+		return err
+	}
+
+	if err = config.SetConfigs(values); err != nil {
+		var validationErrs config.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			v.metrics.reloaded(reloadResultRejected)
+			v.metrics.rejected(validationErrs)
+		} else {
+			v.metrics.reloaded(reloadResultFailed)
+		}
+
 		return err
 	}
 
+	v.metrics.reloaded(reloadResultApplied)
+
 	v.sendUpdatedEvents(ctx, r, forceSendUpdates, "")
 
 	return nil
@@ -490,11 +558,17 @@ func newAccessor(opts config.Options) *viperAccessor {
 		r = config.GetRootSection()
 	}
 
+	var metrics *reloadMetrics
+	if opts.MetricsScope != nil {
+		metrics = newReloadMetrics(opts.MetricsScope)
+	}
+
 	return &viperAccessor{
 		strictMode:         opts.StrictMode,
 		rootConfig:         r,
 		viper:              &CollectionProxy{underlying: vipers},
 		watcherInitializer: &sync.Once{},
+		metrics:            metrics,
 	}
 }
 
@@ -1,11 +1,19 @@
 package viper
 
 import (
+	"context"
 	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 func Test_stringToByteArray(t *testing.T) {
@@ -52,3 +60,63 @@ func Test_stringToByteArray(t *testing.T) {
 		assert.NotEqual(t, []byte("hello"), res)
 	})
 }
+
+type limitsConfig struct {
+	Rate int `json:"rate"`
+}
+
+func (c limitsConfig) Validate() error {
+	if c.Rate <= 0 {
+		return fmt.Errorf("rate must be positive")
+	}
+
+	return nil
+}
+
+func TestRefreshFromConfig_Rejected(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig := func(rate int) {
+		assert.NoError(t, os.WriteFile(configFile, []byte(fmt.Sprintf("parent:\n  limits:\n    rate: %v\n", rate)),
+			os.ModePerm)) // #nosec G306
+	}
+
+	// The config a process starts with is applied without being validated, as it was before sections could validate
+	// their values.
+	writeConfig(0)
+	root := config.NewRootSection()
+	parent, err := root.RegisterSection("parent", &limitsConfig{Rate: 1})
+	assert.NoError(t, err)
+	limits, err := parent.RegisterSection("limits", &limitsConfig{Rate: 1})
+	assert.NoError(t, err)
+
+	v := newAccessor(config.Options{
+		SearchPaths:  []string{configFile},
+		RootSection:  root,
+		MetricsScope: promutils.NewTestScope(),
+	})
+	assert.NoError(t, v.viper.ReadInConfig())
+	assert.NoError(t, v.RefreshFromConfig(context.Background(), root, false))
+	assert.Equal(t, &limitsConfig{Rate: 0}, limits.GetConfig())
+
+	// Reloads the file as the watcher does when it changes.
+	writeConfig(2)
+	assert.NoError(t, v.viper.ReadInConfig())
+	assert.NoError(t, v.RefreshFromConfig(context.Background(), root, false))
+	assert.Equal(t, &limitsConfig{Rate: 2}, limits.GetConfig())
+	assert.Equal(t, float64(2), testutil.ToFloat64(v.metrics.reloadsTotal.WithLabelValues(reloadResultApplied)))
+
+	writeConfig(0)
+	assert.NoError(t, v.viper.ReadInConfig())
+	err = v.RefreshFromConfig(context.Background(), root, false)
+	assert.ErrorIs(t, err, config.ErrInvalidConfig)
+	assert.Equal(t, &limitsConfig{Rate: 2}, limits.GetConfig())
+	assert.Equal(t, float64(1), testutil.ToFloat64(v.metrics.reloadsTotal.WithLabelValues(reloadResultRejected)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(v.metrics.reloadsTotal.WithLabelValues(reloadResultApplied)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(v.metrics.sectionRejectionsTotal.WithLabelValues("parent.limits")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(v.metrics.sectionRejectionsTotal.WithLabelValues("parent")))
+
+	t.Run("without metrics", func(t *testing.T) {
+		v := newAccessor(config.Options{SearchPaths: []string{configFile}, RootSection: root})
+		assert.ErrorIs(t, v.RefreshFromConfig(context.Background(), root, false), config.ErrInvalidConfig)
+	})
+}
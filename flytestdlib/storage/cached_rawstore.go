@@ -50,6 +50,13 @@ func (s *cachedRawStore) Head(ctx context.Context, reference DataReference) (Met
 	return s.RawStore.Head(ctx, reference)
 }
 
+// ReadRawRange retrieves length bytes of the referenced data, starting at byte offset start. Ranges are read from the
+// underlying store, bypassing the cache.
+func (s *cachedRawStore) ReadRawRange(ctx context.Context, reference DataReference, start, length int64) (
+	io.ReadCloser, error) {
+	return ReadRawRange(ctx, s.RawStore, reference, start, length)
+}
+
 // ReadRaw retrieves a byte array from the Blob store or an error
 func (s *cachedRawStore) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
 	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.cachedRawStore/ReadRaw")
@@ -88,6 +88,20 @@ func (s *InMemoryStore) ReadRaw(ctx context.Context, reference DataReference) (i
 	return nil, os.ErrNotExist
 }
 
+// ReadRawRange retrieves length bytes of the referenced data, starting at byte offset start.
+func (s *InMemoryStore) ReadRawRange(ctx context.Context, reference DataReference, start, length int64) (
+	io.ReadCloser, error) {
+	s.rwMutex.RLock()
+	defer s.rwMutex.RUnlock()
+
+	raw, found := s.cache[reference]
+	if !found {
+		return nil, os.ErrNotExist
+	}
+	start = min(start, int64(len(raw)))
+	return ioutil.NopCloser(bytes.NewReader(raw[start:min(start+length, int64(len(raw)))])), nil
+}
+
 // Delete removes the referenced data from the cache map.
 func (s *InMemoryStore) Delete(ctx context.Context, reference DataReference) error {
 	s.rwMutex.Lock()
@@ -104,14 +118,15 @@ func (s *InMemoryStore) Delete(ctx context.Context, reference DataReference) err
 
 func (s *InMemoryStore) WriteRaw(ctx context.Context, reference DataReference, size int64, opts Options, raw io.Reader) (
 	err error) {
-	s.rwMutex.Lock()
-	defer s.rwMutex.Unlock()
-
+	// The data is read before locking the store, as it may be read from the store itself.
 	rawBytes, err := ioutil.ReadAll(raw)
 	if err != nil {
 		return err
 	}
 
+	s.rwMutex.Lock()
+	defer s.rwMutex.Unlock()
+
 	s.cache[reference] = rawBytes
 	return nil
 }
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// ReadRawRange retrieves length bytes of the referenced data, starting at byte offset start. Stores which don't
+// implement RangeReader are read from the start, skipping the bytes before the range.
+func ReadRawRange(ctx context.Context, store RawStore, reference DataReference, start, length int64) (
+	io.ReadCloser, error) {
+	if rangeReader, ok := store.(RangeReader); ok {
+		return rangeReader.ReadRawRange(ctx, reference, start, length)
+	}
+	reader, err := store.ReadRaw(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(io.Discard, reader, start); err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+	return limitedReadCloser{Reader: io.LimitReader(reader, length), Closer: reader}, nil
+}
+
+// ReadRawRange retrieves length bytes of the referenced data, starting at byte offset start.
+func (ds *DataStore) ReadRawRange(ctx context.Context, reference DataReference, start, length int64) (
+	io.ReadCloser, error) {
+	return ReadRawRange(ctx, ds.ComposedProtobufStore, reference, start, length)
+}
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRawRange(t *testing.T) {
+	ctx := context.TODO()
+	s, err := NewInMemoryRawStore(ctx, &Config{}, metrics)
+	assert.NoError(t, err)
+	assert.NoError(t, s.WriteRaw(ctx, "hello", 10, Options{}, bytes.NewReader([]byte("0123456789"))))
+
+	for name, store := range map[string]RawStore{
+		"range reader": s,
+		// Hides the ReadRawRange method of the store, to read from the start.
+		"raw store": struct{ RawStore }{s},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, tc := range []struct {
+				start, length int64
+				expected      string
+			}{
+				{start: 0, length: 10, expected: "0123456789"},
+				{start: 2, length: 3, expected: "234"},
+				{start: 8, length: 5, expected: "89"},
+				{start: 4, length: 0, expected: ""},
+			} {
+				reader, err := ReadRawRange(ctx, store, "hello", tc.start, tc.length)
+				assert.NoError(t, err)
+				raw, err := io.ReadAll(reader)
+				assert.NoError(t, err)
+				assert.NoError(t, reader.Close())
+				assert.Equal(t, tc.expected, string(raw))
+			}
+
+			_, err := ReadRawRange(ctx, store, "missing", 0, 1)
+			assert.True(t, IsNotFound(err))
+		})
+	}
+}
@@ -134,6 +134,13 @@ type RawStore interface {
 	Delete(ctx context.Context, reference DataReference) error
 }
 
+// RangeReader is implemented by the RawStores which read byte ranges of the referenced data, rather than reading it
+// from the start.
+type RangeReader interface {
+	// ReadRawRange retrieves length bytes of the referenced data, starting at byte offset start.
+	ReadRawRange(ctx context.Context, reference DataReference, start, length int64) (io.ReadCloser, error)
+}
+
 //go:generate mockery --name ReferenceConstructor --case=underscore --with-expecter
 
 // ReferenceConstructor defines an interface for building data reference paths.